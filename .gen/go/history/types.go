@@ -1841,6 +1841,320 @@ func (v *GetMutableStateResponse) IsSetReplicationInfo() bool {
 	return v != nil && v.ReplicationInfo != nil
 }
 
+type MutableStateDifference struct {
+	Category     *string `json:"category,omitempty"`
+	Key          *string `json:"key,omitempty"`
+	Field        *string `json:"field,omitempty"`
+	StoredValue  *string `json:"storedValue,omitempty"`
+	RebuiltValue *string `json:"rebuiltValue,omitempty"`
+}
+
+// ToWire translates a MutableStateDifference struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MutableStateDifference) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Category != nil {
+		w, err = wire.NewValueString(*(v.Category)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Field != nil {
+		w, err = wire.NewValueString(*(v.Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.StoredValue != nil {
+		w, err = wire.NewValueString(*(v.StoredValue)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RebuiltValue != nil {
+		w, err = wire.NewValueString(*(v.RebuiltValue)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MutableStateDifference struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MutableStateDifference struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MutableStateDifference
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MutableStateDifference) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Category = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Field = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StoredValue = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RebuiltValue = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MutableStateDifference
+// struct.
+func (v *MutableStateDifference) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Category != nil {
+		fields[i] = fmt.Sprintf("Category: %v", *(v.Category))
+		i++
+	}
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.Field != nil {
+		fields[i] = fmt.Sprintf("Field: %v", *(v.Field))
+		i++
+	}
+	if v.StoredValue != nil {
+		fields[i] = fmt.Sprintf("StoredValue: %v", *(v.StoredValue))
+		i++
+	}
+	if v.RebuiltValue != nil {
+		fields[i] = fmt.Sprintf("RebuiltValue: %v", *(v.RebuiltValue))
+		i++
+	}
+
+	return fmt.Sprintf("MutableStateDifference{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MutableStateDifference match the
+// provided MutableStateDifference.
+//
+// This function performs a deep comparison.
+func (v *MutableStateDifference) Equals(rhs *MutableStateDifference) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Category, rhs.Category) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Field, rhs.Field) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StoredValue, rhs.StoredValue) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RebuiltValue, rhs.RebuiltValue) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MutableStateDifference.
+func (v *MutableStateDifference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Category != nil {
+		enc.AddString("category", *v.Category)
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.Field != nil {
+		enc.AddString("field", *v.Field)
+	}
+	if v.StoredValue != nil {
+		enc.AddString("storedValue", *v.StoredValue)
+	}
+	if v.RebuiltValue != nil {
+		enc.AddString("rebuiltValue", *v.RebuiltValue)
+	}
+	return err
+}
+
+// GetCategory returns the value of Category if it is set or its
+// zero value if it is unset.
+func (v *MutableStateDifference) GetCategory() (o string) {
+	if v != nil && v.Category != nil {
+		return *v.Category
+	}
+
+	return
+}
+
+// IsSetCategory returns true if Category is not nil.
+func (v *MutableStateDifference) IsSetCategory() bool {
+	return v != nil && v.Category != nil
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *MutableStateDifference) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *MutableStateDifference) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetField returns the value of Field if it is set or its
+// zero value if it is unset.
+func (v *MutableStateDifference) GetField() (o string) {
+	if v != nil && v.Field != nil {
+		return *v.Field
+	}
+
+	return
+}
+
+// IsSetField returns true if Field is not nil.
+func (v *MutableStateDifference) IsSetField() bool {
+	return v != nil && v.Field != nil
+}
+
+// GetStoredValue returns the value of StoredValue if it is set or its
+// zero value if it is unset.
+func (v *MutableStateDifference) GetStoredValue() (o string) {
+	if v != nil && v.StoredValue != nil {
+		return *v.StoredValue
+	}
+
+	return
+}
+
+// IsSetStoredValue returns true if StoredValue is not nil.
+func (v *MutableStateDifference) IsSetStoredValue() bool {
+	return v != nil && v.StoredValue != nil
+}
+
+// GetRebuiltValue returns the value of RebuiltValue if it is set or its
+// zero value if it is unset.
+func (v *MutableStateDifference) GetRebuiltValue() (o string) {
+	if v != nil && v.RebuiltValue != nil {
+		return *v.RebuiltValue
+	}
+
+	return
+}
+
+// IsSetRebuiltValue returns true if RebuiltValue is not nil.
+func (v *MutableStateDifference) IsSetRebuiltValue() bool {
+	return v != nil && v.RebuiltValue != nil
+}
+
 type ParentExecutionInfo struct {
 	DomainUUID  *string                   `json:"domainUUID,omitempty"`
 	Domain      *string                   `json:"domain,omitempty"`
@@ -9935,3 +10249,486 @@ func (v *TerminateWorkflowExecutionRequest) GetTerminateRequest() (o *shared.Ter
 func (v *TerminateWorkflowExecutionRequest) IsSetTerminateRequest() bool {
 	return v != nil && v.TerminateRequest != nil
 }
+
+type VerifyMutableStateRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+	Repair     *bool                     `json:"repair,omitempty"`
+}
+
+// ToWire translates a VerifyMutableStateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *VerifyMutableStateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Repair != nil {
+		w, err = wire.NewValueBool(*(v.Repair)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a VerifyMutableStateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a VerifyMutableStateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v VerifyMutableStateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *VerifyMutableStateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Repair = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a VerifyMutableStateRequest
+// struct.
+func (v *VerifyMutableStateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.Repair != nil {
+		fields[i] = fmt.Sprintf("Repair: %v", *(v.Repair))
+		i++
+	}
+
+	return fmt.Sprintf("VerifyMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this VerifyMutableStateRequest match the
+// provided VerifyMutableStateRequest.
+//
+// This function performs a deep comparison.
+func (v *VerifyMutableStateRequest) Equals(rhs *VerifyMutableStateRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Repair, rhs.Repair) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VerifyMutableStateRequest.
+func (v *VerifyMutableStateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.Repair != nil {
+		enc.AddBool("repair", *v.Repair)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *VerifyMutableStateRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *VerifyMutableStateRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *VerifyMutableStateRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *VerifyMutableStateRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetRepair returns the value of Repair if it is set or its
+// zero value if it is unset.
+func (v *VerifyMutableStateRequest) GetRepair() (o bool) {
+	if v != nil && v.Repair != nil {
+		return *v.Repair
+	}
+
+	return
+}
+
+// IsSetRepair returns true if Repair is not nil.
+func (v *VerifyMutableStateRequest) IsSetRepair() bool {
+	return v != nil && v.Repair != nil
+}
+
+type VerifyMutableStateResponse struct {
+	Differences []*MutableStateDifference `json:"differences,omitempty"`
+	Repaired    *bool                     `json:"repaired,omitempty"`
+}
+
+type _List_MutableStateDifference_ValueList []*MutableStateDifference
+
+func (v _List_MutableStateDifference_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_MutableStateDifference_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_MutableStateDifference_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_MutableStateDifference_ValueList) Close() {}
+
+// ToWire translates a VerifyMutableStateResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *VerifyMutableStateResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Differences != nil {
+		w, err = wire.NewValueList(_List_MutableStateDifference_ValueList(v.Differences)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Repaired != nil {
+		w, err = wire.NewValueBool(*(v.Repaired)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _MutableStateDifference_Read(w wire.Value) (*MutableStateDifference, error) {
+	var v MutableStateDifference
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_MutableStateDifference_Read(l wire.ValueList) ([]*MutableStateDifference, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*MutableStateDifference, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _MutableStateDifference_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a VerifyMutableStateResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a VerifyMutableStateResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v VerifyMutableStateResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *VerifyMutableStateResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Differences, err = _List_MutableStateDifference_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Repaired = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a VerifyMutableStateResponse
+// struct.
+func (v *VerifyMutableStateResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Differences != nil {
+		fields[i] = fmt.Sprintf("Differences: %v", v.Differences)
+		i++
+	}
+	if v.Repaired != nil {
+		fields[i] = fmt.Sprintf("Repaired: %v", *(v.Repaired))
+		i++
+	}
+
+	return fmt.Sprintf("VerifyMutableStateResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_MutableStateDifference_Equals(lhs, rhs []*MutableStateDifference) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this VerifyMutableStateResponse match the
+// provided VerifyMutableStateResponse.
+//
+// This function performs a deep comparison.
+func (v *VerifyMutableStateResponse) Equals(rhs *VerifyMutableStateResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Differences == nil && rhs.Differences == nil) || (v.Differences != nil && rhs.Differences != nil && _List_MutableStateDifference_Equals(v.Differences, rhs.Differences))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Repaired, rhs.Repaired) {
+		return false
+	}
+
+	return true
+}
+
+type _List_MutableStateDifference_Zapper []*MutableStateDifference
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_MutableStateDifference_Zapper.
+func (l _List_MutableStateDifference_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VerifyMutableStateResponse.
+func (v *VerifyMutableStateResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Differences != nil {
+		err = multierr.Append(err, enc.AddArray("differences", (_List_MutableStateDifference_Zapper)(v.Differences)))
+	}
+	if v.Repaired != nil {
+		enc.AddBool("repaired", *v.Repaired)
+	}
+	return err
+}
+
+// GetDifferences returns the value of Differences if it is set or its
+// zero value if it is unset.
+func (v *VerifyMutableStateResponse) GetDifferences() (o []*MutableStateDifference) {
+	if v != nil && v.Differences != nil {
+		return v.Differences
+	}
+
+	return
+}
+
+// IsSetDifferences returns true if Differences is not nil.
+func (v *VerifyMutableStateResponse) IsSetDifferences() bool {
+	return v != nil && v.Differences != nil
+}
+
+// GetRepaired returns the value of Repaired if it is set or its
+// zero value if it is unset.
+func (v *VerifyMutableStateResponse) GetRepaired() (o bool) {
+	if v != nil && v.Repaired != nil {
+		return *v.Repaired
+	}
+
+	return
+}
+
+// IsSetRepaired returns true if Repaired is not nil.
+func (v *VerifyMutableStateResponse) IsSetRepaired() bool {
+	return v != nil && v.Repaired != nil
+}
@@ -37,188 +37,39 @@ import (
 	strings "strings"
 )
 
-type DomainOperation int32
-
-const (
-	DomainOperationCreate DomainOperation = 0
-	DomainOperationUpdate DomainOperation = 1
-)
-
-// DomainOperation_Values returns all recognized values of DomainOperation.
-func DomainOperation_Values() []DomainOperation {
-	return []DomainOperation{
-		DomainOperationCreate,
-		DomainOperationUpdate,
-	}
+type DescribeReplicationStatusRequest struct {
+	TargetCluster *string `json:"targetCluster,omitempty"`
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+	Domain        *string `json:"domain,omitempty"`
 }
 
-// UnmarshalText tries to decode DomainOperation from a byte slice
-// containing its name.
-//
-//   var v DomainOperation
-//   err := v.UnmarshalText([]byte("Create"))
-func (v *DomainOperation) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "Create":
-		*v = DomainOperationCreate
-		return nil
-	case "Update":
-		*v = DomainOperationUpdate
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainOperation", err)
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
 		}
-		*v = DomainOperation(val)
-		return nil
-	}
-}
-
-// MarshalText encodes DomainOperation to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v DomainOperation) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("Create"), nil
-	case 1:
-		return []byte("Update"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainOperation.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v DomainOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "Create")
-	case 1:
-		enc.AddString("name", "Update")
 	}
 	return nil
 }
 
-// Ptr returns a pointer to this enum value.
-func (v DomainOperation) Ptr() *DomainOperation {
-	return &v
-}
-
-// ToWire translates DomainOperation into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v DomainOperation) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes DomainOperation from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return DomainOperation(0), err
-//   }
-//
-//   var v DomainOperation
-//   if err := v.FromWire(x); err != nil {
-//     return DomainOperation(0), err
-//   }
-//   return v, nil
-func (v *DomainOperation) FromWire(w wire.Value) error {
-	*v = (DomainOperation)(w.GetI32())
-	return nil
-}
-
-// String returns a readable string representation of DomainOperation.
-func (v DomainOperation) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "Create"
-	case 1:
-		return "Update"
-	}
-	return fmt.Sprintf("DomainOperation(%d)", w)
-}
-
-// Equals returns true if this DomainOperation value matches the provided
-// value.
-func (v DomainOperation) Equals(rhs DomainOperation) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes DomainOperation into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v DomainOperation) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"Create\""), nil
-	case 1:
-		return ([]byte)("\"Update\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
 }
 
-// UnmarshalJSON attempts to decode DomainOperation from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *DomainOperation) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainOperation")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainOperation")
-		}
-		*v = (DomainOperation)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainOperation")
-	}
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
 }
 
-type DomainTaskAttributes struct {
-	DomainOperation   *DomainOperation                       `json:"domainOperation,omitempty"`
-	ID                *string                                `json:"id,omitempty"`
-	Info              *shared.DomainInfo                     `json:"info,omitempty"`
-	Config            *shared.DomainConfiguration            `json:"config,omitempty"`
-	ReplicationConfig *shared.DomainReplicationConfiguration `json:"replicationConfig,omitempty"`
-	ConfigVersion     *int64                                 `json:"configVersion,omitempty"`
-	FailoverVersion   *int64                                 `json:"failoverVersion,omitempty"`
-}
+func (_List_I32_ValueList) Close() {}
 
-// ToWire translates a DomainTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a DescribeReplicationStatusRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -233,103 +84,65 @@ type DomainTaskAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainTaskAttributes) ToWire() (wire.Value, error) {
+func (v *DescribeReplicationStatusRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainOperation != nil {
-		w, err = v.DomainOperation.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.ID != nil {
-		w, err = wire.NewValueString(*(v.ID)), error(nil)
+	if v.TargetCluster != nil {
+		w, err = wire.NewValueString(*(v.TargetCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Info != nil {
-		w, err = v.Info.ToWire()
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Config != nil {
-		w, err = v.Config.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ReplicationConfig != nil {
-		w, err = v.ReplicationConfig.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ConfigVersion != nil {
-		w, err = wire.NewValueI64(*(v.ConfigVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.FailoverVersion != nil {
-		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainOperation_Read(w wire.Value) (DomainOperation, error) {
-	var v DomainOperation
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _DomainInfo_Read(w wire.Value) (*shared.DomainInfo, error) {
-	var v shared.DomainInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainConfiguration_Read(w wire.Value) (*shared.DomainConfiguration, error) {
-	var v shared.DomainConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
 
-func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicationConfiguration, error) {
-	var v shared.DomainReplicationConfiguration
-	err := v.FromWire(w)
-	return &v, err
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a DomainTaskAttributes struct from its Thrift-level
+// FromWire deserializes a DescribeReplicationStatusRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainTaskAttributes struct
+// An error is returned if we were unable to build a DescribeReplicationStatusRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -337,75 +150,39 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicati
 //     return nil, err
 //   }
 //
-//   var v DomainTaskAttributes
+//   var v DescribeReplicationStatusRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
+func (v *DescribeReplicationStatusRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TI32 {
-				var x DomainOperation
-				x, err = _DomainOperation_Read(field.Value)
-				v.DomainOperation = &x
-				if err != nil {
-					return err
-				}
-
-			}
 		case 10:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ID = &x
+				v.TargetCluster = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Info, err = _DomainInfo_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Config, err = _DomainConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfig, err = _DomainReplicationConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ConfigVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FailoverVersion = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
@@ -417,55 +194,29 @@ func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainTaskAttributes
+// String returns a readable string representation of a DescribeReplicationStatusRequest
 // struct.
-func (v *DomainTaskAttributes) String() string {
+func (v *DescribeReplicationStatusRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [3]string
 	i := 0
-	if v.DomainOperation != nil {
-		fields[i] = fmt.Sprintf("DomainOperation: %v", *(v.DomainOperation))
-		i++
-	}
-	if v.ID != nil {
-		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
-		i++
-	}
-	if v.Info != nil {
-		fields[i] = fmt.Sprintf("Info: %v", v.Info)
-		i++
-	}
-	if v.Config != nil {
-		fields[i] = fmt.Sprintf("Config: %v", v.Config)
-		i++
-	}
-	if v.ReplicationConfig != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfig: %v", v.ReplicationConfig)
+	if v.TargetCluster != nil {
+		fields[i] = fmt.Sprintf("TargetCluster: %v", *(v.TargetCluster))
 		i++
 	}
-	if v.ConfigVersion != nil {
-		fields[i] = fmt.Sprintf("ConfigVersion: %v", *(v.ConfigVersion))
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
 		i++
 	}
-	if v.FailoverVersion != nil {
-		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
 
-	return fmt.Sprintf("DomainTaskAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DescribeReplicationStatusRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -478,200 +229,162 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return true
 }
 
-// Equals returns true if all the fields of this DomainTaskAttributes match the
-// provided DomainTaskAttributes.
+// Equals returns true if all the fields of this DescribeReplicationStatusRequest match the
+// provided DescribeReplicationStatusRequest.
 //
 // This function performs a deep comparison.
-func (v *DomainTaskAttributes) Equals(rhs *DomainTaskAttributes) bool {
+func (v *DescribeReplicationStatusRequest) Equals(rhs *DescribeReplicationStatusRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_DomainOperation_EqualsPtr(v.DomainOperation, rhs.DomainOperation) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ID, rhs.ID) {
-		return false
-	}
-	if !((v.Info == nil && rhs.Info == nil) || (v.Info != nil && rhs.Info != nil && v.Info.Equals(rhs.Info))) {
-		return false
-	}
-	if !((v.Config == nil && rhs.Config == nil) || (v.Config != nil && rhs.Config != nil && v.Config.Equals(rhs.Config))) {
-		return false
-	}
-	if !((v.ReplicationConfig == nil && rhs.ReplicationConfig == nil) || (v.ReplicationConfig != nil && rhs.ReplicationConfig != nil && v.ReplicationConfig.Equals(rhs.ReplicationConfig))) {
+	if !_String_EqualsPtr(v.TargetCluster, rhs.TargetCluster) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ConfigVersion, rhs.ConfigVersion) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 
 	return true
 }
 
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainTaskAttributes.
-func (v *DomainTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeReplicationStatusRequest.
+func (v *DescribeReplicationStatusRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainOperation != nil {
-		err = multierr.Append(err, enc.AddObject("domainOperation", *v.DomainOperation))
-	}
-	if v.ID != nil {
-		enc.AddString("id", *v.ID)
-	}
-	if v.Info != nil {
-		err = multierr.Append(err, enc.AddObject("info", v.Info))
-	}
-	if v.Config != nil {
-		err = multierr.Append(err, enc.AddObject("config", v.Config))
+	if v.TargetCluster != nil {
+		enc.AddString("targetCluster", *v.TargetCluster)
 	}
-	if v.ReplicationConfig != nil {
-		err = multierr.Append(err, enc.AddObject("replicationConfig", v.ReplicationConfig))
-	}
-	if v.ConfigVersion != nil {
-		enc.AddInt64("configVersion", *v.ConfigVersion)
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
 	}
-	if v.FailoverVersion != nil {
-		enc.AddInt64("failoverVersion", *v.FailoverVersion)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
 	return err
 }
 
-// GetDomainOperation returns the value of DomainOperation if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetDomainOperation() (o DomainOperation) {
-	if v != nil && v.DomainOperation != nil {
-		return *v.DomainOperation
-	}
-
-	return
-}
-
-// IsSetDomainOperation returns true if DomainOperation is not nil.
-func (v *DomainTaskAttributes) IsSetDomainOperation() bool {
-	return v != nil && v.DomainOperation != nil
-}
-
-// GetID returns the value of ID if it is set or its
+// GetTargetCluster returns the value of TargetCluster if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetID() (o string) {
-	if v != nil && v.ID != nil {
-		return *v.ID
+func (v *DescribeReplicationStatusRequest) GetTargetCluster() (o string) {
+	if v != nil && v.TargetCluster != nil {
+		return *v.TargetCluster
 	}
 
 	return
 }
 
-// IsSetID returns true if ID is not nil.
-func (v *DomainTaskAttributes) IsSetID() bool {
-	return v != nil && v.ID != nil
+// IsSetTargetCluster returns true if TargetCluster is not nil.
+func (v *DescribeReplicationStatusRequest) IsSetTargetCluster() bool {
+	return v != nil && v.TargetCluster != nil
 }
 
-// GetInfo returns the value of Info if it is set or its
+// GetShardIDs returns the value of ShardIDs if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetInfo() (o *shared.DomainInfo) {
-	if v != nil && v.Info != nil {
-		return v.Info
+func (v *DescribeReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
 	}
 
 	return
 }
 
-// IsSetInfo returns true if Info is not nil.
-func (v *DomainTaskAttributes) IsSetInfo() bool {
-	return v != nil && v.Info != nil
+// IsSetShardIDs returns true if ShardIDs is not nil.
+func (v *DescribeReplicationStatusRequest) IsSetShardIDs() bool {
+	return v != nil && v.ShardIDs != nil
 }
 
-// GetConfig returns the value of Config if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetConfig() (o *shared.DomainConfiguration) {
-	if v != nil && v.Config != nil {
-		return v.Config
+func (v *DescribeReplicationStatusRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetConfig returns true if Config is not nil.
-func (v *DomainTaskAttributes) IsSetConfig() bool {
-	return v != nil && v.Config != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *DescribeReplicationStatusRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetReplicationConfig returns the value of ReplicationConfig if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetReplicationConfig() (o *shared.DomainReplicationConfiguration) {
-	if v != nil && v.ReplicationConfig != nil {
-		return v.ReplicationConfig
-	}
-
-	return
+type DescribeReplicationStatusResponse struct {
+	Shards       []*ShardReplicationStatus  `json:"shards,omitempty"`
+	Domains      []*DomainReplicationStatus `json:"domains,omitempty"`
+	PendingTasks *int64                     `json:"pendingTasks,omitempty"`
+	Truncated    *bool                      `json:"truncated,omitempty"`
 }
 
-// IsSetReplicationConfig returns true if ReplicationConfig is not nil.
-func (v *DomainTaskAttributes) IsSetReplicationConfig() bool {
-	return v != nil && v.ReplicationConfig != nil
-}
+type _List_ShardReplicationStatus_ValueList []*ShardReplicationStatus
 
-// GetConfigVersion returns the value of ConfigVersion if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetConfigVersion() (o int64) {
-	if v != nil && v.ConfigVersion != nil {
-		return *v.ConfigVersion
+func (v _List_ShardReplicationStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
-}
-
-// IsSetConfigVersion returns true if ConfigVersion is not nil.
-func (v *DomainTaskAttributes) IsSetConfigVersion() bool {
-	return v != nil && v.ConfigVersion != nil
+	return nil
 }
 
-// GetFailoverVersion returns the value of FailoverVersion if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetFailoverVersion() (o int64) {
-	if v != nil && v.FailoverVersion != nil {
-		return *v.FailoverVersion
-	}
-
-	return
+func (v _List_ShardReplicationStatus_ValueList) Size() int {
+	return len(v)
 }
 
-// IsSetFailoverVersion returns true if FailoverVersion is not nil.
-func (v *DomainTaskAttributes) IsSetFailoverVersion() bool {
-	return v != nil && v.FailoverVersion != nil
+func (_List_ShardReplicationStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type HistoryMetadataTaskAttributes struct {
-	TargetClusters []string `json:"targetClusters,omitempty"`
-	DomainId       *string  `json:"domainId,omitempty"`
-	WorkflowId     *string  `json:"workflowId,omitempty"`
-	RunId          *string  `json:"runId,omitempty"`
-	FirstEventId   *int64   `json:"firstEventId,omitempty"`
-	NextEventId    *int64   `json:"nextEventId,omitempty"`
-}
+func (_List_ShardReplicationStatus_ValueList) Close() {}
 
-type _List_String_ValueList []string
+type _List_DomainReplicationStatus_ValueList []*DomainReplicationStatus
 
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
+func (v _List_DomainReplicationStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
@@ -683,17 +396,17 @@ func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_String_ValueList) Size() int {
+func (v _List_DomainReplicationStatus_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+func (_List_DomainReplicationStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-func (_List_String_ValueList) Close() {}
+func (_List_DomainReplicationStatus_ValueList) Close() {}
 
-// ToWire translates a HistoryMetadataTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a DescribeReplicationStatusResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -708,74 +421,88 @@ func (_List_String_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *HistoryMetadataTaskAttributes) ToWire() (wire.Value, error) {
+func (v *DescribeReplicationStatusResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TargetClusters != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
+	if v.Shards != nil {
+		w, err = wire.NewValueList(_List_ShardReplicationStatus_ValueList(v.Shards)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.Domains != nil {
+		w, err = wire.NewValueList(_List_DomainReplicationStatus_ValueList(v.Domains)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.PendingTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+	if v.Truncated != nil {
+		w, err = wire.NewValueBool(*(v.Truncated)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ShardReplicationStatus_Read(w wire.Value) (*ShardReplicationStatus, error) {
+	var v ShardReplicationStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ShardReplicationStatus_Read(l wire.ValueList) ([]*ShardReplicationStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ShardReplicationStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ShardReplicationStatus_Read(x)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+func _DomainReplicationStatus_Read(w wire.Value) (*DomainReplicationStatus, error) {
+	var v DomainReplicationStatus
+	err := v.FromWire(w)
+	return &v, err
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
+func _List_DomainReplicationStatus_Read(l wire.ValueList) ([]*DomainReplicationStatus, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]string, 0, l.Size())
+	o := make([]*DomainReplicationStatus, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
+		i, err := _DomainReplicationStatus_Read(x)
 		if err != nil {
 			return err
 		}
@@ -786,11 +513,11 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 	return o, err
 }
 
-// FromWire deserializes a HistoryMetadataTaskAttributes struct from its Thrift-level
+// FromWire deserializes a DescribeReplicationStatusResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryMetadataTaskAttributes struct
+// An error is returned if we were unable to build a DescribeReplicationStatusResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -798,69 +525,47 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryMetadataTaskAttributes
+//   var v DescribeReplicationStatusResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HistoryMetadataTaskAttributes) FromWire(w wire.Value) error {
+func (v *DescribeReplicationStatusResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
+			if field.Value.Type() == wire.TList {
+				v.Shards, err = _List_ShardReplicationStatus_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+			if field.Value.Type() == wire.TList {
+				v.Domains, err = _List_DomainReplicationStatus_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
+				v.PendingTasks = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Truncated = &x
 				if err != nil {
 					return err
 				}
@@ -872,51 +577,58 @@ func (v *HistoryMetadataTaskAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a HistoryMetadataTaskAttributes
+// String returns a readable string representation of a DescribeReplicationStatusResponse
 // struct.
-func (v *HistoryMetadataTaskAttributes) String() string {
+func (v *DescribeReplicationStatusResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
-	if v.TargetClusters != nil {
-		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
+	if v.Shards != nil {
+		fields[i] = fmt.Sprintf("Shards: %v", v.Shards)
 		i++
 	}
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+	if v.Domains != nil {
+		fields[i] = fmt.Sprintf("Domains: %v", v.Domains)
 		i++
 	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+	if v.PendingTasks != nil {
+		fields[i] = fmt.Sprintf("PendingTasks: %v", *(v.PendingTasks))
 		i++
 	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.Truncated != nil {
+		fields[i] = fmt.Sprintf("Truncated: %v", *(v.Truncated))
 		i++
 	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
+
+	return fmt.Sprintf("DescribeReplicationStatusResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ShardReplicationStatus_Equals(lhs, rhs []*ShardReplicationStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
 
-	return fmt.Sprintf("HistoryMetadataTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+	return true
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
+func _List_DomainReplicationStatus_Equals(lhs, rhs []*DomainReplicationStatus) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
 	for i, lv := range lhs {
 		rv := rhs[i]
-		if !(lv == rv) {
+		if !lv.Equals(rv) {
 			return false
 		}
 	}
@@ -924,221 +636,332 @@ func _List_String_Equals(lhs, rhs []string) bool {
 	return true
 }
 
-// Equals returns true if all the fields of this HistoryMetadataTaskAttributes match the
-// provided HistoryMetadataTaskAttributes.
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeReplicationStatusResponse match the
+// provided DescribeReplicationStatusResponse.
 //
 // This function performs a deep comparison.
-func (v *HistoryMetadataTaskAttributes) Equals(rhs *HistoryMetadataTaskAttributes) bool {
+func (v *DescribeReplicationStatusResponse) Equals(rhs *DescribeReplicationStatusResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+	if !((v.Shards == nil && rhs.Shards == nil) || (v.Shards != nil && rhs.Shards != nil && _List_ShardReplicationStatus_Equals(v.Shards, rhs.Shards))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !((v.Domains == nil && rhs.Domains == nil) || (v.Domains != nil && rhs.Domains != nil && _List_DomainReplicationStatus_Equals(v.Domains, rhs.Domains))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+	if !_I64_EqualsPtr(v.PendingTasks, rhs.PendingTasks) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+	if !_Bool_EqualsPtr(v.Truncated, rhs.Truncated) {
 		return false
 	}
 
 	return true
 }
 
-type _List_String_Zapper []string
+type _List_ShardReplicationStatus_Zapper []*ShardReplicationStatus
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_ShardReplicationStatus_Zapper.
+func (l _List_ShardReplicationStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
-		enc.AppendString(v)
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_DomainReplicationStatus_Zapper []*DomainReplicationStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainReplicationStatus_Zapper.
+func (l _List_DomainReplicationStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryMetadataTaskAttributes.
-func (v *HistoryMetadataTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeReplicationStatusResponse.
+func (v *DescribeReplicationStatusResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TargetClusters != nil {
-		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
-	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
+	if v.Shards != nil {
+		err = multierr.Append(err, enc.AddArray("shards", (_List_ShardReplicationStatus_Zapper)(v.Shards)))
 	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.Domains != nil {
+		err = multierr.Append(err, enc.AddArray("domains", (_List_DomainReplicationStatus_Zapper)(v.Domains)))
 	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
+	if v.PendingTasks != nil {
+		enc.AddInt64("pendingTasks", *v.PendingTasks)
 	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
+	if v.Truncated != nil {
+		enc.AddBool("truncated", *v.Truncated)
 	}
 	return err
 }
 
-// GetTargetClusters returns the value of TargetClusters if it is set or its
+// GetShards returns the value of Shards if it is set or its
 // zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetTargetClusters() (o []string) {
-	if v != nil && v.TargetClusters != nil {
-		return v.TargetClusters
+func (v *DescribeReplicationStatusResponse) GetShards() (o []*ShardReplicationStatus) {
+	if v != nil && v.Shards != nil {
+		return v.Shards
 	}
 
 	return
 }
 
-// IsSetTargetClusters returns true if TargetClusters is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetTargetClusters() bool {
-	return v != nil && v.TargetClusters != nil
+// IsSetShards returns true if Shards is not nil.
+func (v *DescribeReplicationStatusResponse) IsSetShards() bool {
+	return v != nil && v.Shards != nil
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
+// GetDomains returns the value of Domains if it is set or its
 // zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
+func (v *DescribeReplicationStatusResponse) GetDomains() (o []*DomainReplicationStatus) {
+	if v != nil && v.Domains != nil {
+		return v.Domains
 	}
 
 	return
 }
 
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
+// IsSetDomains returns true if Domains is not nil.
+func (v *DescribeReplicationStatusResponse) IsSetDomains() bool {
+	return v != nil && v.Domains != nil
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetPendingTasks returns the value of PendingTasks if it is set or its
 // zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *DescribeReplicationStatusResponse) GetPendingTasks() (o int64) {
+	if v != nil && v.PendingTasks != nil {
+		return *v.PendingTasks
 	}
 
 	return
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
+// IsSetPendingTasks returns true if PendingTasks is not nil.
+func (v *DescribeReplicationStatusResponse) IsSetPendingTasks() bool {
+	return v != nil && v.PendingTasks != nil
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetTruncated returns the value of Truncated if it is set or its
 // zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *DescribeReplicationStatusResponse) GetTruncated() (o bool) {
+	if v != nil && v.Truncated != nil {
+		return *v.Truncated
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetTruncated returns true if Truncated is not nil.
+func (v *DescribeReplicationStatusResponse) IsSetTruncated() bool {
+	return v != nil && v.Truncated != nil
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
-	}
+type DomainOperation int32
 
-	return
-}
+const (
+	DomainOperationCreate DomainOperation = 0
+	DomainOperationUpdate DomainOperation = 1
+)
 
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
+// DomainOperation_Values returns all recognized values of DomainOperation.
+func DomainOperation_Values() []DomainOperation {
+	return []DomainOperation{
+		DomainOperationCreate,
+		DomainOperationUpdate,
+	}
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+// UnmarshalText tries to decode DomainOperation from a byte slice
+// containing its name.
+//
+//   var v DomainOperation
+//   err := v.UnmarshalText([]byte("Create"))
+func (v *DomainOperation) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Create":
+		*v = DomainOperationCreate
+		return nil
+	case "Update":
+		*v = DomainOperationUpdate
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainOperation", err)
+		}
+		*v = DomainOperation(val)
+		return nil
 	}
-
-	return
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
+// MarshalText encodes DomainOperation to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v DomainOperation) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Create"), nil
+	case 1:
+		return []byte("Update"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-type HistoryTaskAttributes struct {
-	TargetClusters          []string                           `json:"targetClusters,omitempty"`
-	DomainId                *string                            `json:"domainId,omitempty"`
-	WorkflowId              *string                            `json:"workflowId,omitempty"`
-	RunId                   *string                            `json:"runId,omitempty"`
-	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
-	NextEventId             *int64                             `json:"nextEventId,omitempty"`
-	Version                 *int64                             `json:"version,omitempty"`
-	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	History                 *shared.History                    `json:"history,omitempty"`
-	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
-	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
-	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
-	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainOperation.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v DomainOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Create")
+	case 1:
+		enc.AddString("name", "Update")
+	}
+	return nil
 }
 
-type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+// Ptr returns a pointer to this enum value.
+func (v DomainOperation) Ptr() *DomainOperation {
+	return &v
+}
 
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+// ToWire translates DomainOperation into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v DomainOperation) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
 
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
+// FromWire deserializes DomainOperation from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return DomainOperation(0), err
+//   }
+//
+//   var v DomainOperation
+//   if err := v.FromWire(x); err != nil {
+//     return DomainOperation(0), err
+//   }
+//   return v, nil
+func (v *DomainOperation) FromWire(w wire.Value) error {
+	*v = (DomainOperation)(w.GetI32())
 	return nil
 }
 
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
+// String returns a readable string representation of DomainOperation.
+func (v DomainOperation) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Create"
+	case 1:
+		return "Update"
+	}
+	return fmt.Sprintf("DomainOperation(%d)", w)
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
+// Equals returns true if this DomainOperation value matches the provided
+// value.
+func (v DomainOperation) Equals(rhs DomainOperation) bool {
+	return v == rhs
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// MarshalJSON serializes DomainOperation into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DomainOperation) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Create\""), nil
+	case 1:
+		return ([]byte)("\"Update\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+// UnmarshalJSON attempts to decode DomainOperation from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DomainOperation) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
 
-// ToWire translates a HistoryTaskAttributes struct into a Thrift-level intermediate
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainOperation")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainOperation")
+		}
+		*v = (DomainOperation)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainOperation")
+	}
+}
+
+type DomainReplicationStatus struct {
+	Domain       *string `json:"domain,omitempty"`
+	PendingTasks *int64  `json:"pendingTasks,omitempty"`
+}
+
+// ToWire translates a DomainReplicationStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1153,167 +976,290 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
+func (v *DomainReplicationStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TargetClusters != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.PendingTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DomainReplicationStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainReplicationStatus struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainReplicationStatus
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainReplicationStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainReplicationStatus
+// struct.
+func (v *DomainReplicationStatus) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+	if v.PendingTasks != nil {
+		fields[i] = fmt.Sprintf("PendingTasks: %v", *(v.PendingTasks))
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
+
+	return fmt.Sprintf("DomainReplicationStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DomainReplicationStatus match the
+// provided DomainReplicationStatus.
+//
+// This function performs a deep comparison.
+func (v *DomainReplicationStatus) Equals(rhs *DomainReplicationStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PendingTasks, rhs.PendingTasks) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainReplicationStatus.
+func (v *DomainReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.PendingTasks != nil {
+		enc.AddInt64("pendingTasks", *v.PendingTasks)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *DomainReplicationStatus) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetPendingTasks returns the value of PendingTasks if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetPendingTasks() (o int64) {
+	if v != nil && v.PendingTasks != nil {
+		return *v.PendingTasks
+	}
+
+	return
+}
+
+// IsSetPendingTasks returns true if PendingTasks is not nil.
+func (v *DomainReplicationStatus) IsSetPendingTasks() bool {
+	return v != nil && v.PendingTasks != nil
+}
+
+type DomainTaskAttributes struct {
+	DomainOperation   *DomainOperation                       `json:"domainOperation,omitempty"`
+	ID                *string                                `json:"id,omitempty"`
+	Info              *shared.DomainInfo                     `json:"info,omitempty"`
+	Config            *shared.DomainConfiguration            `json:"config,omitempty"`
+	ReplicationConfig *shared.DomainReplicationConfiguration `json:"replicationConfig,omitempty"`
+	ConfigVersion     *int64                                 `json:"configVersion,omitempty"`
+	FailoverVersion   *int64                                 `json:"failoverVersion,omitempty"`
+}
+
+// ToWire translates a DomainTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainOperation != nil {
+		w, err = v.DomainOperation.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
-	if v.NewRunHistory != nil {
-		w, err = v.NewRunHistory.ToWire()
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+	if v.Info != nil {
+		w, err = v.Info.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.NewRunEventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+	if v.Config != nil {
+		w, err = v.Config.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ResetWorkflow != nil {
-		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
+	if v.ReplicationConfig != nil {
+		w, err = v.ReplicationConfig.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ConfigVersion != nil {
+		w, err = wire.NewValueI64(*(v.ConfigVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
-	var v shared.ReplicationInfo
+func _DomainOperation_Read(w wire.Value) (DomainOperation, error) {
+	var v DomainOperation
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[string]*shared.ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _ReplicationInfo_Read(x.Value)
-		if err != nil {
-			return err
-		}
+func _DomainInfo_Read(w wire.Value) (*shared.DomainInfo, error) {
+	var v shared.DomainInfo
+	err := v.FromWire(w)
+	return &v, err
+}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
+func _DomainConfiguration_Read(w wire.Value) (*shared.DomainConfiguration, error) {
+	var v shared.DomainConfiguration
+	err := v.FromWire(w)
+	return &v, err
 }
 
-func _History_Read(w wire.Value) (*shared.History, error) {
-	var v shared.History
+func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicationConfiguration, error) {
+	var v shared.DomainReplicationConfiguration
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a HistoryTaskAttributes struct from its Thrift-level
+// FromWire deserializes a DomainTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryTaskAttributes struct
+// An error is returned if we were unable to build a DomainTaskAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1321,19 +1267,21 @@ func _History_Read(w wire.Value) (*shared.History, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryTaskAttributes
+//   var v DomainTaskAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
+func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 5:
-			if field.Value.Type() == wire.TList {
-				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI32 {
+				var x DomainOperation
+				x, err = _DomainOperation_Read(field.Value)
+				v.DomainOperation = &x
 				if err != nil {
 					return err
 				}
@@ -1343,37 +1291,31 @@ func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
+				v.ID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Info, err = _DomainInfo_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Config, err = _DomainConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfig, err = _DomainReplicationConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1383,7 +1325,7 @@ func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+				v.ConfigVersion = &x
 				if err != nil {
 					return err
 				}
@@ -1393,491 +1335,5354 @@ func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
+				v.FailoverVersion = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunHistory, err = _History_Read(field.Value)
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.NewRunEventStoreVersion = &x
-				if err != nil {
-					return err
-				}
+// String returns a readable string representation of a DomainTaskAttributes
+// struct.
+func (v *DomainTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-			}
-		case 120:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ResetWorkflow = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a HistoryTaskAttributes
-// struct.
-func (v *HistoryTaskAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [13]string
+	var fields [7]string
 	i := 0
-	if v.TargetClusters != nil {
-		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
-		i++
-	}
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+	if v.DomainOperation != nil {
+		fields[i] = fmt.Sprintf("DomainOperation: %v", *(v.DomainOperation))
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
 		i++
 	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
+	if v.Info != nil {
+		fields[i] = fmt.Sprintf("Info: %v", v.Info)
 		i++
 	}
-	if v.NewRunHistory != nil {
-		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+	if v.Config != nil {
+		fields[i] = fmt.Sprintf("Config: %v", v.Config)
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+	if v.ReplicationConfig != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfig: %v", v.ReplicationConfig)
 		i++
 	}
-	if v.NewRunEventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+	if v.ConfigVersion != nil {
+		fields[i] = fmt.Sprintf("ConfigVersion: %v", *(v.ConfigVersion))
 		i++
 	}
-	if v.ResetWorkflow != nil {
-		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
 		i++
 	}
 
-	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DomainTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
 		y := *rhs
-		return (x == y)
+		return x.Equals(y)
 	}
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this HistoryTaskAttributes match the
-// provided HistoryTaskAttributes.
+// Equals returns true if all the fields of this DomainTaskAttributes match the
+// provided DomainTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
+func (v *DomainTaskAttributes) Equals(rhs *DomainTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+	if !_DomainOperation_EqualsPtr(v.DomainOperation, rhs.DomainOperation) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
 		return false
 	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+	if !((v.Info == nil && rhs.Info == nil) || (v.Info != nil && rhs.Info != nil && v.Info.Equals(rhs.Info))) {
 		return false
 	}
-	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+	if !((v.Config == nil && rhs.Config == nil) || (v.Config != nil && rhs.Config != nil && v.Config.Equals(rhs.Config))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !((v.ReplicationConfig == nil && rhs.ReplicationConfig == nil) || (v.ReplicationConfig != nil && rhs.ReplicationConfig != nil && v.ReplicationConfig.Equals(rhs.ReplicationConfig))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+	if !_I64_EqualsPtr(v.ConfigVersion, rhs.ConfigVersion) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
 		return false
 	}
 
 	return true
 }
 
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryTaskAttributes.
-func (v *HistoryTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainTaskAttributes.
+func (v *DomainTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TargetClusters != nil {
-		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
-	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
+	if v.DomainOperation != nil {
+		err = multierr.Append(err, enc.AddObject("domainOperation", *v.DomainOperation))
 	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
 	}
-	if v.History != nil {
-		err = multierr.Append(err, enc.AddObject("history", v.History))
+	if v.Info != nil {
+		err = multierr.Append(err, enc.AddObject("info", v.Info))
 	}
-	if v.NewRunHistory != nil {
-		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	if v.Config != nil {
+		err = multierr.Append(err, enc.AddObject("config", v.Config))
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.ReplicationConfig != nil {
+		err = multierr.Append(err, enc.AddObject("replicationConfig", v.ReplicationConfig))
 	}
-	if v.NewRunEventStoreVersion != nil {
-		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	if v.ConfigVersion != nil {
+		enc.AddInt64("configVersion", *v.ConfigVersion)
 	}
-	if v.ResetWorkflow != nil {
-		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
+	if v.FailoverVersion != nil {
+		enc.AddInt64("failoverVersion", *v.FailoverVersion)
 	}
 	return err
 }
 
-// GetTargetClusters returns the value of TargetClusters if it is set or its
+// GetDomainOperation returns the value of DomainOperation if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetTargetClusters() (o []string) {
-	if v != nil && v.TargetClusters != nil {
-		return v.TargetClusters
+func (v *DomainTaskAttributes) GetDomainOperation() (o DomainOperation) {
+	if v != nil && v.DomainOperation != nil {
+		return *v.DomainOperation
 	}
 
 	return
 }
 
-// IsSetTargetClusters returns true if TargetClusters is not nil.
-func (v *HistoryTaskAttributes) IsSetTargetClusters() bool {
-	return v != nil && v.TargetClusters != nil
+// IsSetDomainOperation returns true if DomainOperation is not nil.
+func (v *DomainTaskAttributes) IsSetDomainOperation() bool {
+	return v != nil && v.DomainOperation != nil
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
+// GetID returns the value of ID if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
+func (v *DomainTaskAttributes) GetID() (o string) {
+	if v != nil && v.ID != nil {
+		return *v.ID
 	}
 
 	return
 }
 
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *HistoryTaskAttributes) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
+// IsSetID returns true if ID is not nil.
+func (v *DomainTaskAttributes) IsSetID() bool {
+	return v != nil && v.ID != nil
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetInfo returns the value of Info if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *DomainTaskAttributes) GetInfo() (o *shared.DomainInfo) {
+	if v != nil && v.Info != nil {
+		return v.Info
+	}
+
+	return
+}
+
+// IsSetInfo returns true if Info is not nil.
+func (v *DomainTaskAttributes) IsSetInfo() bool {
+	return v != nil && v.Info != nil
+}
+
+// GetConfig returns the value of Config if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetConfig() (o *shared.DomainConfiguration) {
+	if v != nil && v.Config != nil {
+		return v.Config
+	}
+
+	return
+}
+
+// IsSetConfig returns true if Config is not nil.
+func (v *DomainTaskAttributes) IsSetConfig() bool {
+	return v != nil && v.Config != nil
+}
+
+// GetReplicationConfig returns the value of ReplicationConfig if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetReplicationConfig() (o *shared.DomainReplicationConfiguration) {
+	if v != nil && v.ReplicationConfig != nil {
+		return v.ReplicationConfig
+	}
+
+	return
+}
+
+// IsSetReplicationConfig returns true if ReplicationConfig is not nil.
+func (v *DomainTaskAttributes) IsSetReplicationConfig() bool {
+	return v != nil && v.ReplicationConfig != nil
+}
+
+// GetConfigVersion returns the value of ConfigVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetConfigVersion() (o int64) {
+	if v != nil && v.ConfigVersion != nil {
+		return *v.ConfigVersion
+	}
+
+	return
+}
+
+// IsSetConfigVersion returns true if ConfigVersion is not nil.
+func (v *DomainTaskAttributes) IsSetConfigVersion() bool {
+	return v != nil && v.ConfigVersion != nil
+}
+
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetFailoverVersion() (o int64) {
+	if v != nil && v.FailoverVersion != nil {
+		return *v.FailoverVersion
+	}
+
+	return
+}
+
+// IsSetFailoverVersion returns true if FailoverVersion is not nil.
+func (v *DomainTaskAttributes) IsSetFailoverVersion() bool {
+	return v != nil && v.FailoverVersion != nil
+}
+
+type GetReplicationMessagesRequest struct {
+	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName *string             `json:"clusterName,omitempty"`
+}
+
+type _List_ReplicationToken_ValueList []*ReplicationToken
+
+func (v _List_ReplicationToken_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationToken_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationToken_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationToken_ValueList) Close() {}
+
+// ToWire translates a GetReplicationMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tokens != nil {
+		w, err = wire.NewValueList(_List_ReplicationToken_ValueList(v.Tokens)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationToken_Read(w wire.Value) (*ReplicationToken, error) {
+	var v ReplicationToken
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationToken_Read(l wire.ValueList) ([]*ReplicationToken, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationToken, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationToken_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Tokens, err = _List_ReplicationToken_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationMessagesRequest
+// struct.
+func (v *GetReplicationMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Tokens != nil {
+		fields[i] = fmt.Sprintf("Tokens: %v", v.Tokens)
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationToken_Equals(lhs, rhs []*ReplicationToken) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationMessagesRequest match the
+// provided GetReplicationMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesRequest) Equals(rhs *GetReplicationMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Tokens == nil && rhs.Tokens == nil) || (v.Tokens != nil && rhs.Tokens != nil && _List_ReplicationToken_Equals(v.Tokens, rhs.Tokens))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationToken_Zapper []*ReplicationToken
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationToken_Zapper.
+func (l _List_ReplicationToken_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationMessagesRequest.
+func (v *GetReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Tokens != nil {
+		err = multierr.Append(err, enc.AddArray("tokens", (_List_ReplicationToken_Zapper)(v.Tokens)))
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	return err
+}
+
+// GetTokens returns the value of Tokens if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetTokens() (o []*ReplicationToken) {
+	if v != nil && v.Tokens != nil {
+		return v.Tokens
+	}
+
+	return
+}
+
+// IsSetTokens returns true if Tokens is not nil.
+func (v *GetReplicationMessagesRequest) IsSetTokens() bool {
+	return v != nil && v.Tokens != nil
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *GetReplicationMessagesRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
+}
+
+type GetReplicationMessagesResponse struct {
+	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
+}
+
+type _Map_I32_ReplicationMessages_MapItemList map[int32]*ReplicationMessages
+
+func (m _Map_I32_ReplicationMessages_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_ReplicationMessages_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_ReplicationMessages_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_ReplicationMessages_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_I32_ReplicationMessages_MapItemList) Close() {}
+
+// ToWire translates a GetReplicationMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.MessagesByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_ReplicationMessages_MapItemList(v.MessagesByShard)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationMessages_Read(w wire.Value) (*ReplicationMessages, error) {
+	var v ReplicationMessages
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_I32_ReplicationMessages_Read(m wire.MapItemList) (map[int32]*ReplicationMessages, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[int32]*ReplicationMessages, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationMessages_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.MessagesByShard, err = _Map_I32_ReplicationMessages_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationMessagesResponse
+// struct.
+func (v *GetReplicationMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.MessagesByShard != nil {
+		fields[i] = fmt.Sprintf("MessagesByShard: %v", v.MessagesByShard)
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_I32_ReplicationMessages_Equals(lhs, rhs map[int32]*ReplicationMessages) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationMessagesResponse match the
+// provided GetReplicationMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesResponse) Equals(rhs *GetReplicationMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.MessagesByShard == nil && rhs.MessagesByShard == nil) || (v.MessagesByShard != nil && rhs.MessagesByShard != nil && _Map_I32_ReplicationMessages_Equals(v.MessagesByShard, rhs.MessagesByShard))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_I32_ReplicationMessages_Item_Zapper struct {
+	Key   int32
+	Value *ReplicationMessages
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationMessages_Item_Zapper.
+func (v _Map_I32_ReplicationMessages_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
+
+type _Map_I32_ReplicationMessages_Zapper map[int32]*ReplicationMessages
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationMessages_Zapper.
+func (m _Map_I32_ReplicationMessages_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_ReplicationMessages_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationMessagesResponse.
+func (v *GetReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MessagesByShard != nil {
+		err = multierr.Append(err, enc.AddArray("messagesByShard", (_Map_I32_ReplicationMessages_Zapper)(v.MessagesByShard)))
+	}
+	return err
+}
+
+// GetMessagesByShard returns the value of MessagesByShard if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*ReplicationMessages) {
+	if v != nil && v.MessagesByShard != nil {
+		return v.MessagesByShard
+	}
+
+	return
+}
+
+// IsSetMessagesByShard returns true if MessagesByShard is not nil.
+func (v *GetReplicationMessagesResponse) IsSetMessagesByShard() bool {
+	return v != nil && v.MessagesByShard != nil
+}
+
+type HistoryMetadataTaskAttributes struct {
+	TargetClusters []string `json:"targetClusters,omitempty"`
+	DomainId       *string  `json:"domainId,omitempty"`
+	WorkflowId     *string  `json:"workflowId,omitempty"`
+	RunId          *string  `json:"runId,omitempty"`
+	FirstEventId   *int64   `json:"firstEventId,omitempty"`
+	NextEventId    *int64   `json:"nextEventId,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a HistoryMetadataTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryMetadataTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TargetClusters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a HistoryMetadataTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryMetadataTaskAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryMetadataTaskAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryMetadataTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryMetadataTaskAttributes
+// struct.
+func (v *HistoryMetadataTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.TargetClusters != nil {
+		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
+		i++
+	}
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryMetadataTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this HistoryMetadataTaskAttributes match the
+// provided HistoryMetadataTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *HistoryMetadataTaskAttributes) Equals(rhs *HistoryMetadataTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryMetadataTaskAttributes.
+func (v *HistoryMetadataTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TargetClusters != nil {
+		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	return err
+}
+
+// GetTargetClusters returns the value of TargetClusters if it is set or its
+// zero value if it is unset.
+func (v *HistoryMetadataTaskAttributes) GetTargetClusters() (o []string) {
+	if v != nil && v.TargetClusters != nil {
+		return v.TargetClusters
+	}
+
+	return
+}
+
+// IsSetTargetClusters returns true if TargetClusters is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetTargetClusters() bool {
+	return v != nil && v.TargetClusters != nil
+}
+
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *HistoryMetadataTaskAttributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *HistoryMetadataTaskAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *HistoryMetadataTaskAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryMetadataTaskAttributes) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryMetadataTaskAttributes) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
+}
+
+type HistoryTaskAttributes struct {
+	TargetClusters          []string                           `json:"targetClusters,omitempty"`
+	DomainId                *string                            `json:"domainId,omitempty"`
+	WorkflowId              *string                            `json:"workflowId,omitempty"`
+	RunId                   *string                            `json:"runId,omitempty"`
+	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
+	NextEventId             *int64                             `json:"nextEventId,omitempty"`
+	Version                 *int64                             `json:"version,omitempty"`
+	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	History                 *shared.History                    `json:"history,omitempty"`
+	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
+	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
+	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
+	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
+}
+
+type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+
+// ToWire translates a HistoryTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TargetClusters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
+	var v shared.ReplicationInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _History_Read(w wire.Value) (*shared.History, error) {
+	var v shared.History
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a HistoryTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryTaskAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryTaskAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NewRunEventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ResetWorkflow = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryTaskAttributes
+// struct.
+func (v *HistoryTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [13]string
+	i := 0
+	if v.TargetClusters != nil {
+		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
+		i++
+	}
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this HistoryTaskAttributes match the
+// provided HistoryTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryTaskAttributes.
+func (v *HistoryTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TargetClusters != nil {
+		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddObject("history", v.History))
+	}
+	if v.NewRunHistory != nil {
+		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.NewRunEventStoreVersion != nil {
+		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	}
+	if v.ResetWorkflow != nil {
+		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
+	}
+	return err
+}
+
+// GetTargetClusters returns the value of TargetClusters if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetTargetClusters() (o []string) {
+	if v != nil && v.TargetClusters != nil {
+		return v.TargetClusters
+	}
+
+	return
+}
+
+// IsSetTargetClusters returns true if TargetClusters is not nil.
+func (v *HistoryTaskAttributes) IsSetTargetClusters() bool {
+	return v != nil && v.TargetClusters != nil
+}
+
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *HistoryTaskAttributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *HistoryTaskAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *HistoryTaskAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *HistoryTaskAttributes) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *HistoryTaskAttributes) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *HistoryTaskAttributes) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
+}
+
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *HistoryTaskAttributes) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetHistory() (o *shared.History) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+func (v *HistoryTaskAttributes) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetNewRunHistory returns the value of NewRunHistory if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunHistory() (o *shared.History) {
+	if v != nil && v.NewRunHistory != nil {
+		return v.NewRunHistory
+	}
+
+	return
+}
+
+// IsSetNewRunHistory returns true if NewRunHistory is not nil.
+func (v *HistoryTaskAttributes) IsSetNewRunHistory() bool {
+	return v != nil && v.NewRunHistory != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *HistoryTaskAttributes) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunEventStoreVersion() (o int32) {
+	if v != nil && v.NewRunEventStoreVersion != nil {
+		return *v.NewRunEventStoreVersion
+	}
+
+	return
+}
+
+// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
+func (v *HistoryTaskAttributes) IsSetNewRunEventStoreVersion() bool {
+	return v != nil && v.NewRunEventStoreVersion != nil
+}
+
+// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetResetWorkflow() (o bool) {
+	if v != nil && v.ResetWorkflow != nil {
+		return *v.ResetWorkflow
+	}
+
+	return
+}
+
+// IsSetResetWorkflow returns true if ResetWorkflow is not nil.
+func (v *HistoryTaskAttributes) IsSetResetWorkflow() bool {
+	return v != nil && v.ResetWorkflow != nil
+}
+
+type MergeDLQMessagesRequest struct {
+	SourceCluster         *string `json:"sourceCluster,omitempty"`
+	ShardID               *int32  `json:"shardID,omitempty"`
+	InclusiveEndMessageID *int64  `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte  `json:"nextPageToken,omitempty"`
+	Domain                *string `json:"domain,omitempty"`
+	WorkflowID            *string `json:"workflowID,omitempty"`
+	RunID                 *string `json:"runID,omitempty"`
+}
+
+// ToWire translates a MergeDLQMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MergeDLQMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MergeDLQMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MergeDLQMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MergeDLQMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MergeDLQMessagesRequest
+// struct.
+func (v *MergeDLQMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+
+	return fmt.Sprintf("MergeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MergeDLQMessagesRequest match the
+// provided MergeDLQMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *MergeDLQMessagesRequest) Equals(rhs *MergeDLQMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MergeDLQMessagesRequest.
+func (v *MergeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	return err
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *MergeDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *MergeDLQMessagesRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeDLQMessagesRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *MergeDLQMessagesRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+type MergeDLQMessagesResponse struct {
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a MergeDLQMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MergeDLQMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MergeDLQMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MergeDLQMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MergeDLQMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MergeDLQMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MergeDLQMessagesResponse
+// struct.
+func (v *MergeDLQMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("MergeDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MergeDLQMessagesResponse match the
+// provided MergeDLQMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *MergeDLQMessagesResponse) Equals(rhs *MergeDLQMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MergeDLQMessagesResponse.
+func (v *MergeDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeDLQMessagesResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type PurgeDLQMessagesRequest struct {
+	SourceCluster         *string `json:"sourceCluster,omitempty"`
+	ShardID               *int32  `json:"shardID,omitempty"`
+	InclusiveEndMessageID *int64  `json:"inclusiveEndMessageID,omitempty"`
+	Domain                *string `json:"domain,omitempty"`
+	WorkflowID            *string `json:"workflowID,omitempty"`
+	RunID                 *string `json:"runID,omitempty"`
+}
+
+// ToWire translates a PurgeDLQMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PurgeDLQMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PurgeDLQMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PurgeDLQMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PurgeDLQMessagesRequest
+// struct.
+func (v *PurgeDLQMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+
+	return fmt.Sprintf("PurgeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PurgeDLQMessagesRequest match the
+// provided PurgeDLQMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *PurgeDLQMessagesRequest) Equals(rhs *PurgeDLQMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PurgeDLQMessagesRequest.
+func (v *PurgeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	return err
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+type ReadDLQMessagesRequest struct {
+	SourceCluster         *string `json:"sourceCluster,omitempty"`
+	ShardID               *int32  `json:"shardID,omitempty"`
+	InclusiveEndMessageID *int64  `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte  `json:"nextPageToken,omitempty"`
+	Domain                *string `json:"domain,omitempty"`
+	WorkflowID            *string `json:"workflowID,omitempty"`
+	RunID                 *string `json:"runID,omitempty"`
+}
+
+// ToWire translates a ReadDLQMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReadDLQMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadDLQMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadDLQMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadDLQMessagesRequest
+// struct.
+func (v *ReadDLQMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+
+	return fmt.Sprintf("ReadDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReadDLQMessagesRequest match the
+// provided ReadDLQMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadDLQMessagesRequest.
+func (v *ReadDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	return err
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *ReadDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ReadDLQMessagesRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadDLQMessagesRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ReadDLQMessagesRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+type ReadDLQMessagesResponse struct {
+	ReplicationTasks []*ReplicationTaskInfo `json:"replicationTasks,omitempty"`
+	NextPageToken    []byte                 `json:"nextPageToken,omitempty"`
+}
+
+type _List_ReplicationTaskInfo_ValueList []*ReplicationTaskInfo
+
+func (v _List_ReplicationTaskInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationTaskInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationTaskInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationTaskInfo_ValueList) Close() {}
+
+// ToWire translates a ReadDLQMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTaskInfo_ValueList(v.ReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskInfo_Read(w wire.Value) (*ReplicationTaskInfo, error) {
+	var v ReplicationTaskInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationTaskInfo_Read(l wire.ValueList) ([]*ReplicationTaskInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationTaskInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationTaskInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReadDLQMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadDLQMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadDLQMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTaskInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadDLQMessagesResponse
+// struct.
+func (v *ReadDLQMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationTaskInfo_Equals(lhs, rhs []*ReplicationTaskInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReadDLQMessagesResponse match the
+// provided ReadDLQMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *ReadDLQMessagesResponse) Equals(rhs *ReadDLQMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTaskInfo_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationTaskInfo_Zapper []*ReplicationTaskInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationTaskInfo_Zapper.
+func (l _List_ReplicationTaskInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadDLQMessagesResponse.
+func (v *ReadDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTaskInfo_Zapper)(v.ReplicationTasks)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesResponse) GetReplicationTasks() (o []*ReplicationTaskInfo) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
+	}
+
+	return
+}
+
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *ReadDLQMessagesResponse) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadDLQMessagesResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReplicationMessages struct {
+	ReplicationTasks       []*ReplicationTask `json:"replicationTasks,omitempty"`
+	LastRetrievedMessageId *int64             `json:"lastRetrievedMessageId,omitempty"`
+	HasMore                *bool              `json:"hasMore,omitempty"`
+	SyncShardStatus        *SyncShardStatus   `json:"syncShardStatus,omitempty"`
+}
+
+type _List_ReplicationTask_ValueList []*ReplicationTask
+
+func (v _List_ReplicationTask_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationTask_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationTask_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationTask_ValueList) Close() {}
+
+// ToWire translates a ReplicationMessages struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationMessages) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SyncShardStatus != nil {
+		w, err = v.SyncShardStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTask_Read(w wire.Value) (*ReplicationTask, error) {
+	var v ReplicationTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationTask_Read(l wire.ValueList) ([]*ReplicationTask, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationTask, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationTask_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _SyncShardStatus_Read(w wire.Value) (*SyncShardStatus, error) {
+	var v SyncShardStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicationMessages struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationMessages struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationMessages
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationMessages) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatus, err = _SyncShardStatus_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationMessages
+// struct.
+func (v *ReplicationMessages) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		i++
+	}
+	if v.SyncShardStatus != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatus: %v", v.SyncShardStatus)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationMessages{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationTask_Equals(lhs, rhs []*ReplicationTask) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicationMessages match the
+// provided ReplicationMessages.
+//
+// This function performs a deep comparison.
+func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+		return false
+	}
+	if !((v.SyncShardStatus == nil && rhs.SyncShardStatus == nil) || (v.SyncShardStatus != nil && rhs.SyncShardStatus != nil && v.SyncShardStatus.Equals(rhs.SyncShardStatus))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationTask_Zapper []*ReplicationTask
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationTask_Zapper.
+func (l _List_ReplicationTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationMessages.
+func (v *ReplicationMessages) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
+	}
+	if v.LastRetrievedMessageId != nil {
+		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	}
+	if v.HasMore != nil {
+		enc.AddBool("hasMore", *v.HasMore)
+	}
+	if v.SyncShardStatus != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatus", v.SyncShardStatus))
+	}
+	return err
+}
+
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetReplicationTasks() (o []*ReplicationTask) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
+	}
+
+	return
+}
+
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *ReplicationMessages) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
+}
+
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetLastRetrievedMessageId() (o int64) {
+	if v != nil && v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
+	}
+
+	return
+}
+
+// IsSetLastRetrievedMessageId returns true if LastRetrievedMessageId is not nil.
+func (v *ReplicationMessages) IsSetLastRetrievedMessageId() bool {
+	return v != nil && v.LastRetrievedMessageId != nil
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetHasMore() (o bool) {
+	if v != nil && v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+// IsSetHasMore returns true if HasMore is not nil.
+func (v *ReplicationMessages) IsSetHasMore() bool {
+	return v != nil && v.HasMore != nil
+}
+
+// GetSyncShardStatus returns the value of SyncShardStatus if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetSyncShardStatus() (o *SyncShardStatus) {
+	if v != nil && v.SyncShardStatus != nil {
+		return v.SyncShardStatus
+	}
+
+	return
+}
+
+// IsSetSyncShardStatus returns true if SyncShardStatus is not nil.
+func (v *ReplicationMessages) IsSetSyncShardStatus() bool {
+	return v != nil && v.SyncShardStatus != nil
+}
+
+type ReplicationTask struct {
+	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
+	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
+	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
+	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
+	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
+	HistoryMetadataTaskAttributes *HistoryMetadataTaskAttributes `json:"historyMetadataTaskAttributes,omitempty"`
+	SourceTaskId                  *int64                         `json:"sourceTaskId,omitempty"`
+	CreationTime                  *int64                         `json:"creationTime,omitempty"`
+}
+
+// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTask) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		w, err = v.DomainTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryTaskAttributes != nil {
+		w, err = v.HistoryTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		w, err = v.SyncActicvityTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryMetadataTaskAttributes != nil {
+		w, err = v.HistoryMetadataTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.SourceTaskId != nil {
+		w, err = wire.NewValueI64(*(v.SourceTaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.CreationTime != nil {
+		w, err = wire.NewValueI64(*(v.CreationTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
+	var v DomainTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryTaskAttributes_Read(w wire.Value) (*HistoryTaskAttributes, error) {
+	var v HistoryTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
+	var v SyncShardStatusTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttributes, error) {
+	var v SyncActicvityTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryMetadataTaskAttributes_Read(w wire.Value) (*HistoryMetadataTaskAttributes, error) {
+	var v HistoryMetadataTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTask struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTask
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTask) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x ReplicationTaskType
+				x, err = _ReplicationTaskType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryTaskAttributes, err = _HistoryTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncActicvityTaskAttributes, err = _SyncActicvityTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryMetadataTaskAttributes, err = _HistoryMetadataTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SourceTaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreationTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTask
+// struct.
+func (v *ReplicationTask) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+		i++
+	}
+	if v.HistoryTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("HistoryTaskAttributes: %v", v.HistoryTaskAttributes)
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+		i++
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
+		i++
+	}
+	if v.HistoryMetadataTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("HistoryMetadataTaskAttributes: %v", v.HistoryMetadataTaskAttributes)
+		i++
+	}
+	if v.SourceTaskId != nil {
+		fields[i] = fmt.Sprintf("SourceTaskId: %v", *(v.SourceTaskId))
+		i++
+	}
+	if v.CreationTime != nil {
+		fields[i] = fmt.Sprintf("CreationTime: %v", *(v.CreationTime))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ReplicationTask match the
+// provided ReplicationTask.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryTaskAttributes == nil && rhs.HistoryTaskAttributes == nil) || (v.HistoryTaskAttributes != nil && rhs.HistoryTaskAttributes != nil && v.HistoryTaskAttributes.Equals(rhs.HistoryTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryMetadataTaskAttributes == nil && rhs.HistoryMetadataTaskAttributes == nil) || (v.HistoryMetadataTaskAttributes != nil && rhs.HistoryMetadataTaskAttributes != nil && v.HistoryMetadataTaskAttributes.Equals(rhs.HistoryMetadataTaskAttributes))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SourceTaskId, rhs.SourceTaskId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CreationTime, rhs.CreationTime) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTask.
+func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.DomainTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
+	}
+	if v.HistoryTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyTaskAttributes", v.HistoryTaskAttributes))
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
+	}
+	if v.HistoryMetadataTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyMetadataTaskAttributes", v.HistoryMetadataTaskAttributes))
+	}
+	if v.SourceTaskId != nil {
+		enc.AddInt64("sourceTaskId", *v.SourceTaskId)
+	}
+	if v.CreationTime != nil {
+		enc.AddInt64("creationTime", *v.CreationTime)
+	}
+	return err
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *ReplicationTask) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
+	if v != nil && v.DomainTaskAttributes != nil {
+		return v.DomainTaskAttributes
+	}
+
+	return
+}
+
+// IsSetDomainTaskAttributes returns true if DomainTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetDomainTaskAttributes() bool {
+	return v != nil && v.DomainTaskAttributes != nil
+}
+
+// GetHistoryTaskAttributes returns the value of HistoryTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryTaskAttributes() (o *HistoryTaskAttributes) {
+	if v != nil && v.HistoryTaskAttributes != nil {
+		return v.HistoryTaskAttributes
+	}
+
+	return
+}
+
+// IsSetHistoryTaskAttributes returns true if HistoryTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetHistoryTaskAttributes() bool {
+	return v != nil && v.HistoryTaskAttributes != nil
+}
+
+// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
+	if v != nil && v.SyncShardStatusTaskAttributes != nil {
+		return v.SyncShardStatusTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncShardStatusTaskAttributes returns true if SyncShardStatusTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncShardStatusTaskAttributes() bool {
+	return v != nil && v.SyncShardStatusTaskAttributes != nil
+}
+
+// GetSyncActicvityTaskAttributes returns the value of SyncActicvityTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncActicvityTaskAttributes() (o *SyncActicvityTaskAttributes) {
+	if v != nil && v.SyncActicvityTaskAttributes != nil {
+		return v.SyncActicvityTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncActicvityTaskAttributes returns true if SyncActicvityTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncActicvityTaskAttributes() bool {
+	return v != nil && v.SyncActicvityTaskAttributes != nil
+}
+
+// GetHistoryMetadataTaskAttributes returns the value of HistoryMetadataTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryMetadataTaskAttributes() (o *HistoryMetadataTaskAttributes) {
+	if v != nil && v.HistoryMetadataTaskAttributes != nil {
+		return v.HistoryMetadataTaskAttributes
+	}
+
+	return
+}
+
+// IsSetHistoryMetadataTaskAttributes returns true if HistoryMetadataTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetHistoryMetadataTaskAttributes() bool {
+	return v != nil && v.HistoryMetadataTaskAttributes != nil
+}
+
+// GetSourceTaskId returns the value of SourceTaskId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSourceTaskId() (o int64) {
+	if v != nil && v.SourceTaskId != nil {
+		return *v.SourceTaskId
+	}
+
+	return
+}
+
+// IsSetSourceTaskId returns true if SourceTaskId is not nil.
+func (v *ReplicationTask) IsSetSourceTaskId() bool {
+	return v != nil && v.SourceTaskId != nil
+}
+
+// GetCreationTime returns the value of CreationTime if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetCreationTime() (o int64) {
+	if v != nil && v.CreationTime != nil {
+		return *v.CreationTime
+	}
+
+	return
+}
+
+// IsSetCreationTime returns true if CreationTime is not nil.
+func (v *ReplicationTask) IsSetCreationTime() bool {
+	return v != nil && v.CreationTime != nil
+}
+
+type ReplicationTaskInfo struct {
+	DomainID     *string              `json:"domainID,omitempty"`
+	WorkflowID   *string              `json:"workflowID,omitempty"`
+	RunID        *string              `json:"runID,omitempty"`
+	TaskType     *ReplicationTaskType `json:"taskType,omitempty"`
+	TaskID       *int64               `json:"taskID,omitempty"`
+	Version      *int64               `json:"version,omitempty"`
+	FirstEventID *int64               `json:"firstEventID,omitempty"`
+	NextEventID  *int64               `json:"nextEventID,omitempty"`
+	ScheduledID  *int64               `json:"scheduledID,omitempty"`
+}
+
+// ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.FirstEventID != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.NextEventID != nil {
+		w, err = wire.NewValueI64(*(v.NextEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ScheduledID != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationTaskInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTaskInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTaskInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x ReplicationTaskType
+				x, err = _ReplicationTaskType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTaskInfo
+// struct.
+func (v *ReplicationTaskInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.FirstEventID != nil {
+		fields[i] = fmt.Sprintf("FirstEventID: %v", *(v.FirstEventID))
+		i++
+	}
+	if v.NextEventID != nil {
+		fields[i] = fmt.Sprintf("NextEventID: %v", *(v.NextEventID))
+		i++
+	}
+	if v.ScheduledID != nil {
+		fields[i] = fmt.Sprintf("ScheduledID: %v", *(v.ScheduledID))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationTaskInfo match the
+// provided ReplicationTaskInfo.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventID, rhs.FirstEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventID, rhs.NextEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledID, rhs.ScheduledID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskInfo.
+func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.FirstEventID != nil {
+		enc.AddInt64("firstEventID", *v.FirstEventID)
+	}
+	if v.NextEventID != nil {
+		enc.AddInt64("nextEventID", *v.NextEventID)
+	}
+	if v.ScheduledID != nil {
+		enc.AddInt64("scheduledID", *v.ScheduledID)
+	}
+	return err
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ReplicationTaskInfo) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *ReplicationTaskInfo) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *ReplicationTaskInfo) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetTaskType() (o ReplicationTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *ReplicationTaskInfo) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *ReplicationTaskInfo) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *ReplicationTaskInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetFirstEventID returns the value of FirstEventID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetFirstEventID() (o int64) {
+	if v != nil && v.FirstEventID != nil {
+		return *v.FirstEventID
+	}
+
+	return
+}
+
+// IsSetFirstEventID returns true if FirstEventID is not nil.
+func (v *ReplicationTaskInfo) IsSetFirstEventID() bool {
+	return v != nil && v.FirstEventID != nil
+}
+
+// GetNextEventID returns the value of NextEventID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetNextEventID() (o int64) {
+	if v != nil && v.NextEventID != nil {
+		return *v.NextEventID
+	}
+
+	return
+}
+
+// IsSetNextEventID returns true if NextEventID is not nil.
+func (v *ReplicationTaskInfo) IsSetNextEventID() bool {
+	return v != nil && v.NextEventID != nil
+}
+
+// GetScheduledID returns the value of ScheduledID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
+	if v != nil && v.ScheduledID != nil {
+		return *v.ScheduledID
+	}
+
+	return
+}
+
+// IsSetScheduledID returns true if ScheduledID is not nil.
+func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
+	return v != nil && v.ScheduledID != nil
+}
+
+type ReplicationTaskType int32
+
+const (
+	ReplicationTaskTypeDomain          ReplicationTaskType = 0
+	ReplicationTaskTypeHistory         ReplicationTaskType = 1
+	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
+	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
+	ReplicationTaskTypeHistoryMetadata ReplicationTaskType = 4
+)
+
+// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
+func ReplicationTaskType_Values() []ReplicationTaskType {
+	return []ReplicationTaskType{
+		ReplicationTaskTypeDomain,
+		ReplicationTaskTypeHistory,
+		ReplicationTaskTypeSyncShardStatus,
+		ReplicationTaskTypeSyncActivity,
+		ReplicationTaskTypeHistoryMetadata,
+	}
+}
+
+// UnmarshalText tries to decode ReplicationTaskType from a byte slice
+// containing its name.
+//
+//   var v ReplicationTaskType
+//   err := v.UnmarshalText([]byte("Domain"))
+func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Domain":
+		*v = ReplicationTaskTypeDomain
+		return nil
+	case "History":
+		*v = ReplicationTaskTypeHistory
+		return nil
+	case "SyncShardStatus":
+		*v = ReplicationTaskTypeSyncShardStatus
+		return nil
+	case "SyncActivity":
+		*v = ReplicationTaskTypeSyncActivity
+		return nil
+	case "HistoryMetadata":
+		*v = ReplicationTaskTypeHistoryMetadata
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
+		}
+		*v = ReplicationTaskType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ReplicationTaskType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v ReplicationTaskType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Domain"), nil
+	case 1:
+		return []byte("History"), nil
+	case 2:
+		return []byte("SyncShardStatus"), nil
+	case 3:
+		return []byte("SyncActivity"), nil
+	case 4:
+		return []byte("HistoryMetadata"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Domain")
+	case 1:
+		enc.AddString("name", "History")
+	case 2:
+		enc.AddString("name", "SyncShardStatus")
+	case 3:
+		enc.AddString("name", "SyncActivity")
+	case 4:
+		enc.AddString("name", "HistoryMetadata")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
+	return &v
+}
+
+// ToWire translates ReplicationTaskType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ReplicationTaskType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ReplicationTaskType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//
+//   var v ReplicationTaskType
+//   if err := v.FromWire(x); err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//   return v, nil
+func (v *ReplicationTaskType) FromWire(w wire.Value) error {
+	*v = (ReplicationTaskType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of ReplicationTaskType.
+func (v ReplicationTaskType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Domain"
+	case 1:
+		return "History"
+	case 2:
+		return "SyncShardStatus"
+	case 3:
+		return "SyncActivity"
+	case 4:
+		return "HistoryMetadata"
+	}
+	return fmt.Sprintf("ReplicationTaskType(%d)", w)
+}
+
+// Equals returns true if this ReplicationTaskType value matches the provided
+// value.
+func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ReplicationTaskType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Domain\""), nil
+	case 1:
+		return ([]byte)("\"History\""), nil
+	case 2:
+		return ([]byte)("\"SyncShardStatus\""), nil
+	case 3:
+		return ([]byte)("\"SyncActivity\""), nil
+	case 4:
+		return ([]byte)("\"HistoryMetadata\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		*v = (ReplicationTaskType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
+	}
+}
+
+type ReplicationToken struct {
+	ShardID                *int32 `json:"shardID,omitempty"`
+	LastRetrievedMessageId *int64 `json:"lastRetrievedMessageId,omitempty"`
+	LastProcessedMessageId *int64 `json:"lastProcessedMessageId,omitempty"`
+}
+
+// ToWire translates a ReplicationToken struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationToken) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-
-	return
-}
-
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *HistoryTaskAttributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
-}
-
-// GetRunId returns the value of RunId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+	if v.LastProcessedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *HistoryTaskAttributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
-}
+// FromWire deserializes a ReplicationToken struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationToken struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationToken
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationToken) FromWire(w wire.Value) error {
+	var err error
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
-	}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
 
-	return
-}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
 
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *HistoryTaskAttributes) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
-}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedMessageId = &x
+				if err != nil {
+					return err
+				}
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+			}
+		}
 	}
 
-	return
-}
-
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *HistoryTaskAttributes) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
+	return nil
 }
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
+// String returns a readable string representation of a ReplicationToken
+// struct.
+func (v *ReplicationToken) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
-
-// IsSetVersion returns true if Version is not nil.
-func (v *HistoryTaskAttributes) IsSetVersion() bool {
-	return v != nil && v.Version != nil
-}
-
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+	var fields [3]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
 	}
-
-	return
-}
-
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *HistoryTaskAttributes) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
-}
-
-// GetHistory returns the value of History if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetHistory() (o *shared.History) {
-	if v != nil && v.History != nil {
-		return v.History
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+		i++
 	}
 
-	return
-}
-
-// IsSetHistory returns true if History is not nil.
-func (v *HistoryTaskAttributes) IsSetHistory() bool {
-	return v != nil && v.History != nil
+	return fmt.Sprintf("ReplicationToken{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetNewRunHistory returns the value of NewRunHistory if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunHistory() (o *shared.History) {
-	if v != nil && v.NewRunHistory != nil {
-		return v.NewRunHistory
+// Equals returns true if all the fields of this ReplicationToken match the
+// provided ReplicationToken.
+//
+// This function performs a deep comparison.
+func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetNewRunHistory returns true if NewRunHistory is not nil.
-func (v *HistoryTaskAttributes) IsSetNewRunHistory() bool {
-	return v != nil && v.NewRunHistory != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationToken.
+func (v *ReplicationToken) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.LastRetrievedMessageId != nil {
+		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	}
+	if v.LastProcessedMessageId != nil {
+		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	}
+	return err
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *ReplicationToken) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *HistoryTaskAttributes) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReplicationToken) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunEventStoreVersion() (o int32) {
-	if v != nil && v.NewRunEventStoreVersion != nil {
-		return *v.NewRunEventStoreVersion
+func (v *ReplicationToken) GetLastRetrievedMessageId() (o int64) {
+	if v != nil && v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
 	}
 
 	return
 }
 
-// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
-func (v *HistoryTaskAttributes) IsSetNewRunEventStoreVersion() bool {
-	return v != nil && v.NewRunEventStoreVersion != nil
+// IsSetLastRetrievedMessageId returns true if LastRetrievedMessageId is not nil.
+func (v *ReplicationToken) IsSetLastRetrievedMessageId() bool {
+	return v != nil && v.LastRetrievedMessageId != nil
 }
 
-// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
+// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetResetWorkflow() (o bool) {
-	if v != nil && v.ResetWorkflow != nil {
-		return *v.ResetWorkflow
+func (v *ReplicationToken) GetLastProcessedMessageId() (o int64) {
+	if v != nil && v.LastProcessedMessageId != nil {
+		return *v.LastProcessedMessageId
 	}
 
 	return
 }
 
-// IsSetResetWorkflow returns true if ResetWorkflow is not nil.
-func (v *HistoryTaskAttributes) IsSetResetWorkflow() bool {
-	return v != nil && v.ResetWorkflow != nil
+// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
+func (v *ReplicationToken) IsSetLastProcessedMessageId() bool {
+	return v != nil && v.LastProcessedMessageId != nil
 }
 
-type ReplicationTask struct {
-	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
-	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
-	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
-	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
-	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
-	HistoryMetadataTaskAttributes *HistoryMetadataTaskAttributes `json:"historyMetadataTaskAttributes,omitempty"`
+type ShardReplicationStatus struct {
+	ShardID      *int32 `json:"shardID,omitempty"`
+	AckLevel     *int64 `json:"ackLevel,omitempty"`
+	MaxTaskID    *int64 `json:"maxTaskID,omitempty"`
+	PendingTasks *int64 `json:"pendingTasks,omitempty"`
+	Truncated    *bool  `json:"truncated,omitempty"`
 }
 
-// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+// ToWire translates a ShardReplicationStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1892,107 +6697,63 @@ type ReplicationTask struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicationTask) ToWire() (wire.Value, error) {
+func (v *ShardReplicationStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskType != nil {
-		w, err = v.TaskType.ToWire()
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		w, err = v.DomainTaskAttributes.ToWire()
+	if v.AckLevel != nil {
+		w, err = wire.NewValueI64(*(v.AckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.HistoryTaskAttributes != nil {
-		w, err = v.HistoryTaskAttributes.ToWire()
+	if v.MaxTaskID != nil {
+		w, err = wire.NewValueI64(*(v.MaxTaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+	if v.PendingTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		w, err = v.SyncActicvityTaskAttributes.ToWire()
+	if v.Truncated != nil {
+		w, err = wire.NewValueBool(*(v.Truncated)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.HistoryMetadataTaskAttributes != nil {
-		w, err = v.HistoryMetadataTaskAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
-	var v DomainTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _HistoryTaskAttributes_Read(w wire.Value) (*HistoryTaskAttributes, error) {
-	var v HistoryTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
-	var v SyncShardStatusTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttributes, error) {
-	var v SyncActicvityTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _HistoryMetadataTaskAttributes_Read(w wire.Value) (*HistoryMetadataTaskAttributes, error) {
-	var v HistoryMetadataTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// FromWire deserializes a ShardReplicationStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationTask struct
+// An error is returned if we were unable to build a ShardReplicationStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2000,61 +6761,61 @@ func _HistoryMetadataTaskAttributes_Read(w wire.Value) (*HistoryMetadataTaskAttr
 //     return nil, err
 //   }
 //
-//   var v ReplicationTask
+//   var v ShardReplicationStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicationTask) FromWire(w wire.Value) error {
+func (v *ShardReplicationStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TI32 {
-				var x ReplicationTaskType
-				x, err = _ReplicationTaskType_Read(field.Value)
-				v.TaskType = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AckLevel = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.HistoryTaskAttributes, err = _HistoryTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxTaskID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingTasks = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncActicvityTaskAttributes, err = _SyncActicvityTaskAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.HistoryMetadataTaskAttributes, err = _HistoryMetadataTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Truncated = &x
 				if err != nil {
 					return err
 				}
@@ -2066,79 +6827,62 @@ func (v *ReplicationTask) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationTask
+// String returns a readable string representation of a ShardReplicationStatus
 // struct.
-func (v *ReplicationTask) String() string {
+func (v *ShardReplicationStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [5]string
 	i := 0
-	if v.TaskType != nil {
-		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
-		i++
-	}
-	if v.DomainTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.HistoryTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("HistoryTaskAttributes: %v", v.HistoryTaskAttributes)
+	if v.AckLevel != nil {
+		fields[i] = fmt.Sprintf("AckLevel: %v", *(v.AckLevel))
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+	if v.MaxTaskID != nil {
+		fields[i] = fmt.Sprintf("MaxTaskID: %v", *(v.MaxTaskID))
 		i++
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
+	if v.PendingTasks != nil {
+		fields[i] = fmt.Sprintf("PendingTasks: %v", *(v.PendingTasks))
 		i++
 	}
-	if v.HistoryMetadataTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("HistoryMetadataTaskAttributes: %v", v.HistoryMetadataTaskAttributes)
+	if v.Truncated != nil {
+		fields[i] = fmt.Sprintf("Truncated: %v", *(v.Truncated))
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("ShardReplicationStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicationTask match the
-// provided ReplicationTask.
+// Equals returns true if all the fields of this ShardReplicationStatus match the
+// provided ShardReplicationStatus.
 //
 // This function performs a deep comparison.
-func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+func (v *ShardReplicationStatus) Equals(rhs *ShardReplicationStatus) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
-		return false
-	}
-	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !((v.HistoryTaskAttributes == nil && rhs.HistoryTaskAttributes == nil) || (v.HistoryTaskAttributes != nil && rhs.HistoryTaskAttributes != nil && v.HistoryTaskAttributes.Equals(rhs.HistoryTaskAttributes))) {
+	if !_I64_EqualsPtr(v.AckLevel, rhs.AckLevel) {
 		return false
 	}
-	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+	if !_I64_EqualsPtr(v.MaxTaskID, rhs.MaxTaskID) {
 		return false
 	}
-	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
+	if !_I64_EqualsPtr(v.PendingTasks, rhs.PendingTasks) {
 		return false
 	}
-	if !((v.HistoryMetadataTaskAttributes == nil && rhs.HistoryMetadataTaskAttributes == nil) || (v.HistoryMetadataTaskAttributes != nil && rhs.HistoryMetadataTaskAttributes != nil && v.HistoryMetadataTaskAttributes.Equals(rhs.HistoryMetadataTaskAttributes))) {
+	if !_Bool_EqualsPtr(v.Truncated, rhs.Truncated) {
 		return false
 	}
 
@@ -2146,330 +6890,102 @@ func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTask.
-func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ShardReplicationStatus.
+func (v *ShardReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskType != nil {
-		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
-	}
-	if v.DomainTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
-	}
-	if v.HistoryTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyTaskAttributes", v.HistoryTaskAttributes))
-	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
-	}
-	if v.SyncActicvityTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
-	}
-	if v.HistoryMetadataTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyMetadataTaskAttributes", v.HistoryMetadataTaskAttributes))
-	}
-	return err
-}
-
-// GetTaskType returns the value of TaskType if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
-	if v != nil && v.TaskType != nil {
-		return *v.TaskType
-	}
-
-	return
-}
-
-// IsSetTaskType returns true if TaskType is not nil.
-func (v *ReplicationTask) IsSetTaskType() bool {
-	return v != nil && v.TaskType != nil
-}
-
-// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
-	if v != nil && v.DomainTaskAttributes != nil {
-		return v.DomainTaskAttributes
-	}
-
-	return
-}
-
-// IsSetDomainTaskAttributes returns true if DomainTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetDomainTaskAttributes() bool {
-	return v != nil && v.DomainTaskAttributes != nil
-}
-
-// GetHistoryTaskAttributes returns the value of HistoryTaskAttributes if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetHistoryTaskAttributes() (o *HistoryTaskAttributes) {
-	if v != nil && v.HistoryTaskAttributes != nil {
-		return v.HistoryTaskAttributes
-	}
-
-	return
-}
-
-// IsSetHistoryTaskAttributes returns true if HistoryTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetHistoryTaskAttributes() bool {
-	return v != nil && v.HistoryTaskAttributes != nil
-}
-
-// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
-	if v != nil && v.SyncShardStatusTaskAttributes != nil {
-		return v.SyncShardStatusTaskAttributes
-	}
-
-	return
-}
-
-// IsSetSyncShardStatusTaskAttributes returns true if SyncShardStatusTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetSyncShardStatusTaskAttributes() bool {
-	return v != nil && v.SyncShardStatusTaskAttributes != nil
-}
-
-// GetSyncActicvityTaskAttributes returns the value of SyncActicvityTaskAttributes if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetSyncActicvityTaskAttributes() (o *SyncActicvityTaskAttributes) {
-	if v != nil && v.SyncActicvityTaskAttributes != nil {
-		return v.SyncActicvityTaskAttributes
-	}
-
-	return
-}
-
-// IsSetSyncActicvityTaskAttributes returns true if SyncActicvityTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetSyncActicvityTaskAttributes() bool {
-	return v != nil && v.SyncActicvityTaskAttributes != nil
-}
-
-// GetHistoryMetadataTaskAttributes returns the value of HistoryMetadataTaskAttributes if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetHistoryMetadataTaskAttributes() (o *HistoryMetadataTaskAttributes) {
-	if v != nil && v.HistoryMetadataTaskAttributes != nil {
-		return v.HistoryMetadataTaskAttributes
-	}
-
-	return
-}
-
-// IsSetHistoryMetadataTaskAttributes returns true if HistoryMetadataTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetHistoryMetadataTaskAttributes() bool {
-	return v != nil && v.HistoryMetadataTaskAttributes != nil
-}
-
-type ReplicationTaskType int32
-
-const (
-	ReplicationTaskTypeDomain          ReplicationTaskType = 0
-	ReplicationTaskTypeHistory         ReplicationTaskType = 1
-	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
-	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
-	ReplicationTaskTypeHistoryMetadata ReplicationTaskType = 4
-)
-
-// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
-func ReplicationTaskType_Values() []ReplicationTaskType {
-	return []ReplicationTaskType{
-		ReplicationTaskTypeDomain,
-		ReplicationTaskTypeHistory,
-		ReplicationTaskTypeSyncShardStatus,
-		ReplicationTaskTypeSyncActivity,
-		ReplicationTaskTypeHistoryMetadata,
-	}
-}
-
-// UnmarshalText tries to decode ReplicationTaskType from a byte slice
-// containing its name.
-//
-//   var v ReplicationTaskType
-//   err := v.UnmarshalText([]byte("Domain"))
-func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "Domain":
-		*v = ReplicationTaskTypeDomain
-		return nil
-	case "History":
-		*v = ReplicationTaskTypeHistory
-		return nil
-	case "SyncShardStatus":
-		*v = ReplicationTaskTypeSyncShardStatus
-		return nil
-	case "SyncActivity":
-		*v = ReplicationTaskTypeSyncActivity
-		return nil
-	case "HistoryMetadata":
-		*v = ReplicationTaskTypeHistoryMetadata
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
-		}
-		*v = ReplicationTaskType(val)
-		return nil
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-}
-
-// MarshalText encodes ReplicationTaskType to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v ReplicationTaskType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("Domain"), nil
-	case 1:
-		return []byte("History"), nil
-	case 2:
-		return []byte("SyncShardStatus"), nil
-	case 3:
-		return []byte("SyncActivity"), nil
-	case 4:
-		return []byte("HistoryMetadata"), nil
+	if v.AckLevel != nil {
+		enc.AddInt64("ackLevel", *v.AckLevel)
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+	if v.MaxTaskID != nil {
+		enc.AddInt64("maxTaskID", *v.MaxTaskID)
+	}
+	if v.PendingTasks != nil {
+		enc.AddInt64("pendingTasks", *v.PendingTasks)
+	}
+	if v.Truncated != nil {
+		enc.AddBool("truncated", *v.Truncated)
+	}
+	return err
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTaskType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "Domain")
-	case 1:
-		enc.AddString("name", "History")
-	case 2:
-		enc.AddString("name", "SyncShardStatus")
-	case 3:
-		enc.AddString("name", "SyncActivity")
-	case 4:
-		enc.AddString("name", "HistoryMetadata")
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
-	return nil
+
+	return
 }
 
-// Ptr returns a pointer to this enum value.
-func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
-	return &v
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ShardReplicationStatus) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// ToWire translates ReplicationTaskType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v ReplicationTaskType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+// GetAckLevel returns the value of AckLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetAckLevel() (o int64) {
+	if v != nil && v.AckLevel != nil {
+		return *v.AckLevel
+	}
+
+	return
 }
 
-// FromWire deserializes ReplicationTaskType from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//
-//   var v ReplicationTaskType
-//   if err := v.FromWire(x); err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//   return v, nil
-func (v *ReplicationTaskType) FromWire(w wire.Value) error {
-	*v = (ReplicationTaskType)(w.GetI32())
-	return nil
+// IsSetAckLevel returns true if AckLevel is not nil.
+func (v *ShardReplicationStatus) IsSetAckLevel() bool {
+	return v != nil && v.AckLevel != nil
 }
 
-// String returns a readable string representation of ReplicationTaskType.
-func (v ReplicationTaskType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "Domain"
-	case 1:
-		return "History"
-	case 2:
-		return "SyncShardStatus"
-	case 3:
-		return "SyncActivity"
-	case 4:
-		return "HistoryMetadata"
+// GetMaxTaskID returns the value of MaxTaskID if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetMaxTaskID() (o int64) {
+	if v != nil && v.MaxTaskID != nil {
+		return *v.MaxTaskID
 	}
-	return fmt.Sprintf("ReplicationTaskType(%d)", w)
+
+	return
 }
 
-// Equals returns true if this ReplicationTaskType value matches the provided
-// value.
-func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
-	return v == rhs
+// IsSetMaxTaskID returns true if MaxTaskID is not nil.
+func (v *ShardReplicationStatus) IsSetMaxTaskID() bool {
+	return v != nil && v.MaxTaskID != nil
 }
 
-// MarshalJSON serializes ReplicationTaskType into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"Domain\""), nil
-	case 1:
-		return ([]byte)("\"History\""), nil
-	case 2:
-		return ([]byte)("\"SyncShardStatus\""), nil
-	case 3:
-		return ([]byte)("\"SyncActivity\""), nil
-	case 4:
-		return ([]byte)("\"HistoryMetadata\""), nil
+// GetPendingTasks returns the value of PendingTasks if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetPendingTasks() (o int64) {
+	if v != nil && v.PendingTasks != nil {
+		return *v.PendingTasks
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+
+	return
 }
 
-// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
+// IsSetPendingTasks returns true if PendingTasks is not nil.
+func (v *ShardReplicationStatus) IsSetPendingTasks() bool {
+	return v != nil && v.PendingTasks != nil
+}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		*v = (ReplicationTaskType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
+// GetTruncated returns the value of Truncated if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetTruncated() (o bool) {
+	if v != nil && v.Truncated != nil {
+		return *v.Truncated
 	}
+
+	return
+}
+
+// IsSetTruncated returns true if Truncated is not nil.
+func (v *ShardReplicationStatus) IsSetTruncated() bool {
+	return v != nil && v.Truncated != nil
 }
 
 type SyncActicvityTaskAttributes struct {
@@ -3048,6 +7564,144 @@ func (v *SyncActicvityTaskAttributes) IsSetAttempt() bool {
 	return v != nil && v.Attempt != nil
 }
 
+type SyncShardStatus struct {
+	Timestamp *int64 `json:"timestamp,omitempty"`
+}
+
+// ToWire translates a SyncShardStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SyncShardStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SyncShardStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SyncShardStatus struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SyncShardStatus
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SyncShardStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SyncShardStatus
+// struct.
+func (v *SyncShardStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+
+	return fmt.Sprintf("SyncShardStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SyncShardStatus match the
+// provided SyncShardStatus.
+//
+// This function performs a deep comparison.
+func (v *SyncShardStatus) Equals(rhs *SyncShardStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SyncShardStatus.
+func (v *SyncShardStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	return err
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *SyncShardStatus) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *SyncShardStatus) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
 type SyncShardStatusTaskAttributes struct {
 	SourceCluster *string `json:"sourceCluster,omitempty"`
 	ShardId       *int64  `json:"shardId,omitempty"`
@@ -11804,8 +11804,38 @@ type DescribeDomainResponse struct {
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	FailoverHistory          []*FailoverEvent                `json:"failoverHistory,omitempty"`
 }
 
+type _List_FailoverEvent_ValueList []*FailoverEvent
+
+func (v _List_FailoverEvent_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_FailoverEvent_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_FailoverEvent_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_FailoverEvent_ValueList) Close() {}
+
 // ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -11823,7 +11853,7 @@ type DescribeDomainResponse struct {
 //   }
 func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11869,6 +11899,14 @@ func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailoverHistory != nil {
+		w, err = wire.NewValueList(_List_FailoverEvent_ValueList(v.FailoverHistory)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -11891,6 +11929,30 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfi
 	return &v, err
 }
 
+func _FailoverEvent_Read(w wire.Value) (*FailoverEvent, error) {
+	var v FailoverEvent
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_FailoverEvent_Read(l wire.ValueList) ([]*FailoverEvent, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*FailoverEvent, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _FailoverEvent_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -11956,6 +12018,14 @@ func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.FailoverHistory, err = _List_FailoverEvent_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -11970,7 +12040,7 @@ func (v *DescribeDomainResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.DomainInfo != nil {
 		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
@@ -11992,6 +12062,10 @@ func (v *DescribeDomainResponse) String() string {
 		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
+	if v.FailoverHistory != nil {
+		fields[i] = fmt.Sprintf("FailoverHistory: %v", v.FailoverHistory)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -12006,6 +12080,21 @@ func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _List_FailoverEvent_Equals(lhs, rhs []*FailoverEvent) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeDomainResponse match the
 // provided DescribeDomainResponse.
 //
@@ -12031,10 +12120,24 @@ func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
 	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
+	if !((v.FailoverHistory == nil && rhs.FailoverHistory == nil) || (v.FailoverHistory != nil && rhs.FailoverHistory != nil && _List_FailoverEvent_Equals(v.FailoverHistory, rhs.FailoverHistory))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_FailoverEvent_Zapper []*FailoverEvent
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_FailoverEvent_Zapper.
+func (l _List_FailoverEvent_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeDomainResponse.
 func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -12056,6 +12159,9 @@ func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.IsGlobalDomain != nil {
 		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
+	if v.FailoverHistory != nil {
+		err = multierr.Append(err, enc.AddArray("failoverHistory", (_List_FailoverEvent_Zapper)(v.FailoverHistory)))
+	}
 	return err
 }
 
@@ -12134,6 +12240,21 @@ func (v *DescribeDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
+// GetFailoverHistory returns the value of FailoverHistory if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainResponse) GetFailoverHistory() (o []*FailoverEvent) {
+	if v != nil && v.FailoverHistory != nil {
+		return v.FailoverHistory
+	}
+
+	return
+}
+
+// IsSetFailoverHistory returns true if FailoverHistory is not nil.
+func (v *DescribeDomainResponse) IsSetFailoverHistory() bool {
+	return v != nil && v.FailoverHistory != nil
+}
+
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
 	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
@@ -17220,6 +17341,320 @@ func (v *FailWorkflowExecutionDecisionAttributes) IsSetDetails() bool {
 	return v != nil && v.Details != nil
 }
 
+type FailoverEvent struct {
+	FailoverTimeNano *int64  `json:"failoverTimeNano,omitempty"`
+	FromCluster      *string `json:"fromCluster,omitempty"`
+	ToCluster        *string `json:"toCluster,omitempty"`
+	Operator         *string `json:"operator,omitempty"`
+	Reason           *string `json:"reason,omitempty"`
+}
+
+// ToWire translates a FailoverEvent struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *FailoverEvent) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.FailoverTimeNano != nil {
+		w, err = wire.NewValueI64(*(v.FailoverTimeNano)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.FromCluster != nil {
+		w, err = wire.NewValueString(*(v.FromCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ToCluster != nil {
+		w, err = wire.NewValueString(*(v.ToCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Operator != nil {
+		w, err = wire.NewValueString(*(v.Operator)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a FailoverEvent struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a FailoverEvent struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v FailoverEvent
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *FailoverEvent) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverTimeNano = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FromCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ToCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Operator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a FailoverEvent
+// struct.
+func (v *FailoverEvent) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.FailoverTimeNano != nil {
+		fields[i] = fmt.Sprintf("FailoverTimeNano: %v", *(v.FailoverTimeNano))
+		i++
+	}
+	if v.FromCluster != nil {
+		fields[i] = fmt.Sprintf("FromCluster: %v", *(v.FromCluster))
+		i++
+	}
+	if v.ToCluster != nil {
+		fields[i] = fmt.Sprintf("ToCluster: %v", *(v.ToCluster))
+		i++
+	}
+	if v.Operator != nil {
+		fields[i] = fmt.Sprintf("Operator: %v", *(v.Operator))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+
+	return fmt.Sprintf("FailoverEvent{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this FailoverEvent match the
+// provided FailoverEvent.
+//
+// This function performs a deep comparison.
+func (v *FailoverEvent) Equals(rhs *FailoverEvent) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailoverTimeNano, rhs.FailoverTimeNano) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FromCluster, rhs.FromCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ToCluster, rhs.ToCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Operator, rhs.Operator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of FailoverEvent.
+func (v *FailoverEvent) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.FailoverTimeNano != nil {
+		enc.AddInt64("failoverTimeNano", *v.FailoverTimeNano)
+	}
+	if v.FromCluster != nil {
+		enc.AddString("fromCluster", *v.FromCluster)
+	}
+	if v.ToCluster != nil {
+		enc.AddString("toCluster", *v.ToCluster)
+	}
+	if v.Operator != nil {
+		enc.AddString("operator", *v.Operator)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	return err
+}
+
+// GetFailoverTimeNano returns the value of FailoverTimeNano if it is set or its
+// zero value if it is unset.
+func (v *FailoverEvent) GetFailoverTimeNano() (o int64) {
+	if v != nil && v.FailoverTimeNano != nil {
+		return *v.FailoverTimeNano
+	}
+
+	return
+}
+
+// IsSetFailoverTimeNano returns true if FailoverTimeNano is not nil.
+func (v *FailoverEvent) IsSetFailoverTimeNano() bool {
+	return v != nil && v.FailoverTimeNano != nil
+}
+
+// GetFromCluster returns the value of FromCluster if it is set or its
+// zero value if it is unset.
+func (v *FailoverEvent) GetFromCluster() (o string) {
+	if v != nil && v.FromCluster != nil {
+		return *v.FromCluster
+	}
+
+	return
+}
+
+// IsSetFromCluster returns true if FromCluster is not nil.
+func (v *FailoverEvent) IsSetFromCluster() bool {
+	return v != nil && v.FromCluster != nil
+}
+
+// GetToCluster returns the value of ToCluster if it is set or its
+// zero value if it is unset.
+func (v *FailoverEvent) GetToCluster() (o string) {
+	if v != nil && v.ToCluster != nil {
+		return *v.ToCluster
+	}
+
+	return
+}
+
+// IsSetToCluster returns true if ToCluster is not nil.
+func (v *FailoverEvent) IsSetToCluster() bool {
+	return v != nil && v.ToCluster != nil
+}
+
+// GetOperator returns the value of Operator if it is set or its
+// zero value if it is unset.
+func (v *FailoverEvent) GetOperator() (o string) {
+	if v != nil && v.Operator != nil {
+		return *v.Operator
+	}
+
+	return
+}
+
+// IsSetOperator returns true if Operator is not nil.
+func (v *FailoverEvent) IsSetOperator() bool {
+	return v != nil && v.Operator != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *FailoverEvent) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *FailoverEvent) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
 type GetSearchAttributesResponse struct {
 	Keys map[string]IndexedValueType `json:"keys,omitempty"`
 }
@@ -45934,12 +46369,15 @@ func (v *UpdateDomainInfo) IsSetData() bool {
 }
 
 type UpdateDomainRequest struct {
-	Name                     *string                         `json:"name,omitempty"`
-	UpdatedInfo              *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	SecurityToken            *string                         `json:"securityToken,omitempty"`
-	DeleteBadBinary          *string                         `json:"deleteBadBinary,omitempty"`
+	Name                          *string                         `json:"name,omitempty"`
+	UpdatedInfo                   *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
+	Configuration                 *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration      *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	SecurityToken                 *string                         `json:"securityToken,omitempty"`
+	DeleteBadBinary               *string                         `json:"deleteBadBinary,omitempty"`
+	FailoverDrainTimeoutInSeconds *int32                          `json:"failoverDrainTimeoutInSeconds,omitempty"`
+	FailoverOperator              *string                         `json:"failoverOperator,omitempty"`
+	FailoverReason                *string                         `json:"failoverReason,omitempty"`
 }
 
 // ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
@@ -45959,7 +46397,7 @@ type UpdateDomainRequest struct {
 //   }
 func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -46013,6 +46451,30 @@ func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.FailoverDrainTimeoutInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FailoverDrainTimeoutInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.FailoverOperator != nil {
+		w, err = wire.NewValueString(*(v.FailoverOperator)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.FailoverReason != nil {
+		w, err = wire.NewValueString(*(v.FailoverReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -46098,6 +46560,36 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FailoverDrainTimeoutInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailoverOperator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailoverReason = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -46112,7 +46604,7 @@ func (v *UpdateDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [9]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -46138,6 +46630,18 @@ func (v *UpdateDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("DeleteBadBinary: %v", *(v.DeleteBadBinary))
 		i++
 	}
+	if v.FailoverDrainTimeoutInSeconds != nil {
+		fields[i] = fmt.Sprintf("FailoverDrainTimeoutInSeconds: %v", *(v.FailoverDrainTimeoutInSeconds))
+		i++
+	}
+	if v.FailoverOperator != nil {
+		fields[i] = fmt.Sprintf("FailoverOperator: %v", *(v.FailoverOperator))
+		i++
+	}
+	if v.FailoverReason != nil {
+		fields[i] = fmt.Sprintf("FailoverReason: %v", *(v.FailoverReason))
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -46170,6 +46674,15 @@ func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 	if !_String_EqualsPtr(v.DeleteBadBinary, rhs.DeleteBadBinary) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.FailoverDrainTimeoutInSeconds, rhs.FailoverDrainTimeoutInSeconds) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailoverOperator, rhs.FailoverOperator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailoverReason, rhs.FailoverReason) {
+		return false
+	}
 
 	return true
 }
@@ -46198,6 +46711,15 @@ func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.DeleteBadBinary != nil {
 		enc.AddString("deleteBadBinary", *v.DeleteBadBinary)
 	}
+	if v.FailoverDrainTimeoutInSeconds != nil {
+		enc.AddInt32("failoverDrainTimeoutInSeconds", *v.FailoverDrainTimeoutInSeconds)
+	}
+	if v.FailoverOperator != nil {
+		enc.AddString("failoverOperator", *v.FailoverOperator)
+	}
+	if v.FailoverReason != nil {
+		enc.AddString("failoverReason", *v.FailoverReason)
+	}
 	return err
 }
 
@@ -46291,6 +46813,51 @@ func (v *UpdateDomainRequest) IsSetDeleteBadBinary() bool {
 	return v != nil && v.DeleteBadBinary != nil
 }
 
+// GetFailoverDrainTimeoutInSeconds returns the value of FailoverDrainTimeoutInSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverDrainTimeoutInSeconds() (o int32) {
+	if v != nil && v.FailoverDrainTimeoutInSeconds != nil {
+		return *v.FailoverDrainTimeoutInSeconds
+	}
+
+	return
+}
+
+// IsSetFailoverDrainTimeoutInSeconds returns true if FailoverDrainTimeoutInSeconds is not nil.
+func (v *UpdateDomainRequest) IsSetFailoverDrainTimeoutInSeconds() bool {
+	return v != nil && v.FailoverDrainTimeoutInSeconds != nil
+}
+
+// GetFailoverOperator returns the value of FailoverOperator if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverOperator() (o string) {
+	if v != nil && v.FailoverOperator != nil {
+		return *v.FailoverOperator
+	}
+
+	return
+}
+
+// IsSetFailoverOperator returns true if FailoverOperator is not nil.
+func (v *UpdateDomainRequest) IsSetFailoverOperator() bool {
+	return v != nil && v.FailoverOperator != nil
+}
+
+// GetFailoverReason returns the value of FailoverReason if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverReason() (o string) {
+	if v != nil && v.FailoverReason != nil {
+		return *v.FailoverReason
+	}
+
+	return
+}
+
+// IsSetFailoverReason returns true if FailoverReason is not nil.
+func (v *UpdateDomainRequest) IsSetFailoverReason() bool {
+	return v != nil && v.FailoverReason != nil
+}
+
 type UpdateDomainResponse struct {
 	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
 	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
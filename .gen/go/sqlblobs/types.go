@@ -2108,6 +2108,7 @@ type DomainInfo struct {
 	Data                        map[string]string `json:"data,omitempty"`
 	BadBinaries                 []byte            `json:"badBinaries,omitempty"`
 	BadBinariesEncoding         *string           `json:"badBinariesEncoding,omitempty"`
+	FailoverDrainEndTime        *int64            `json:"failoverDrainEndTime,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -2162,7 +2163,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [17]wire.Field
+		fields [18]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2304,6 +2305,14 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.FailoverDrainEndTime != nil {
+		w, err = wire.NewValueI64(*(v.FailoverDrainEndTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 42, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2521,6 +2530,16 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 42:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverDrainEndTime = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2535,7 +2554,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [17]string
+	var fields [18]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -2605,6 +2624,10 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("BadBinariesEncoding: %v", *(v.BadBinariesEncoding))
 		i++
 	}
+	if v.FailoverDrainEndTime != nil {
+		fields[i] = fmt.Sprintf("FailoverDrainEndTime: %v", *(v.FailoverDrainEndTime))
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2697,6 +2720,9 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_String_EqualsPtr(v.BadBinariesEncoding, rhs.BadBinariesEncoding) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.FailoverDrainEndTime, rhs.FailoverDrainEndTime) {
+		return false
+	}
 
 	return true
 }
@@ -2769,6 +2795,9 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.BadBinariesEncoding != nil {
 		enc.AddString("badBinariesEncoding", *v.BadBinariesEncoding)
 	}
+	if v.FailoverDrainEndTime != nil {
+		enc.AddInt64("failoverDrainEndTime", *v.FailoverDrainEndTime)
+	}
 	return err
 }
 
@@ -3027,6 +3056,21 @@ func (v *DomainInfo) IsSetBadBinariesEncoding() bool {
 	return v != nil && v.BadBinariesEncoding != nil
 }
 
+// GetFailoverDrainEndTime returns the value of FailoverDrainEndTime if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetFailoverDrainEndTime() (o int64) {
+	if v != nil && v.FailoverDrainEndTime != nil {
+		return *v.FailoverDrainEndTime
+	}
+
+	return
+}
+
+// IsSetFailoverDrainEndTime returns true if FailoverDrainEndTime is not nil.
+func (v *DomainInfo) IsSetFailoverDrainEndTime() bool {
+	return v != nil && v.FailoverDrainEndTime != nil
+}
+
 type HistoryTreeInfo struct {
 	CreatedTimeNanos *int64                       `json:"createdTimeNanos,omitempty"`
 	Ancestors        []*shared.HistoryBranchRange `json:"ancestors,omitempty"`
@@ -4504,6 +4548,7 @@ type ShardInfo struct {
 	ClusterTransferAckLevel   map[string]int64 `json:"clusterTransferAckLevel,omitempty"`
 	ClusterTimerAckLevel      map[string]int64 `json:"clusterTimerAckLevel,omitempty"`
 	Owner                     *string          `json:"owner,omitempty"`
+	ClusterReplicationLevel   map[string]int64 `json:"clusterReplicationLevel,omitempty"`
 }
 
 type _Map_String_I64_MapItemList map[string]int64
@@ -4558,7 +4603,7 @@ func (_Map_String_I64_MapItemList) Close() {}
 //   }
 func (v *ShardInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4636,6 +4681,14 @@ func (v *ShardInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 38, Value: w}
 		i++
 	}
+	if v.ClusterReplicationLevel != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.ClusterReplicationLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4775,6 +4828,14 @@ func (v *ShardInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TMap {
+				v.ClusterReplicationLevel, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4789,7 +4850,7 @@ func (v *ShardInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [10]string
 	i := 0
 	if v.StolenSinceRenew != nil {
 		fields[i] = fmt.Sprintf("StolenSinceRenew: %v", *(v.StolenSinceRenew))
@@ -4827,6 +4888,10 @@ func (v *ShardInfo) String() string {
 		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
 		i++
 	}
+	if v.ClusterReplicationLevel != nil {
+		fields[i] = fmt.Sprintf("ClusterReplicationLevel: %v", v.ClusterReplicationLevel)
+		i++
+	}
 
 	return fmt.Sprintf("ShardInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4885,6 +4950,9 @@ func (v *ShardInfo) Equals(rhs *ShardInfo) bool {
 	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
 		return false
 	}
+	if !((v.ClusterReplicationLevel == nil && rhs.ClusterReplicationLevel == nil) || (v.ClusterReplicationLevel != nil && rhs.ClusterReplicationLevel != nil && _Map_String_I64_Equals(v.ClusterReplicationLevel, rhs.ClusterReplicationLevel))) {
+		return false
+	}
 
 	return true
 }
@@ -4933,6 +5001,9 @@ func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Owner != nil {
 		enc.AddString("owner", *v.Owner)
 	}
+	if v.ClusterReplicationLevel != nil {
+		err = multierr.Append(err, enc.AddObject("clusterReplicationLevel", (_Map_String_I64_Zapper)(v.ClusterReplicationLevel)))
+	}
 	return err
 }
 
@@ -5071,6 +5142,21 @@ func (v *ShardInfo) IsSetOwner() bool {
 	return v != nil && v.Owner != nil
 }
 
+// GetClusterReplicationLevel returns the value of ClusterReplicationLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetClusterReplicationLevel() (o map[string]int64) {
+	if v != nil && v.ClusterReplicationLevel != nil {
+		return v.ClusterReplicationLevel
+	}
+
+	return
+}
+
+// IsSetClusterReplicationLevel returns true if ClusterReplicationLevel is not nil.
+func (v *ShardInfo) IsSetClusterReplicationLevel() bool {
+	return v != nil && v.ClusterReplicationLevel != nil
+}
+
 type SignalInfo struct {
 	Version   *int64  `json:"version,omitempty"`
 	RequestID *string `json:"requestID,omitempty"`
@@ -7346,6 +7432,9 @@ type WorkflowExecutionInfo struct {
 	AutoResetPoints                 []byte                      `json:"autoResetPoints,omitempty"`
 	AutoResetPointsEncoding         *string                     `json:"autoResetPointsEncoding,omitempty"`
 	SearchAttributes                map[string][]byte           `json:"searchAttributes,omitempty"`
+	ChecksumVersion                 *int32                      `json:"checksumVersion,omitempty"`
+	ChecksumFlavor                  *int32                      `json:"checksumFlavor,omitempty"`
+	Checksum                        []byte                      `json:"checksum,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -7403,7 +7492,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [56]wire.Field
+		fields [59]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7857,6 +7946,30 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 118, Value: w}
 		i++
 	}
+	if v.ChecksumVersion != nil {
+		w, err = wire.NewValueI32(*(v.ChecksumVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ChecksumFlavor != nil {
+		w, err = wire.NewValueI32(*(v.ChecksumFlavor)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 122, Value: w}
+		i++
+	}
+	if v.Checksum != nil {
+		w, err = wire.NewValueBinary(v.Checksum), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 124, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -8452,6 +8565,34 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ChecksumVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 122:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ChecksumFlavor = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 124:
+			if field.Value.Type() == wire.TBinary {
+				v.Checksum, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8466,7 +8607,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [56]string
+	var fields [59]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -8692,6 +8833,18 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
+	if v.ChecksumVersion != nil {
+		fields[i] = fmt.Sprintf("ChecksumVersion: %v", *(v.ChecksumVersion))
+		i++
+	}
+	if v.ChecksumFlavor != nil {
+		fields[i] = fmt.Sprintf("ChecksumFlavor: %v", *(v.ChecksumFlavor))
+		i++
+	}
+	if v.Checksum != nil {
+		fields[i] = fmt.Sprintf("Checksum: %v", v.Checksum)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8891,6 +9044,15 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && _Map_String_Binary_Equals(v.SearchAttributes, rhs.SearchAttributes))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.ChecksumVersion, rhs.ChecksumVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ChecksumFlavor, rhs.ChecksumFlavor) {
+		return false
+	}
+	if !((v.Checksum == nil && rhs.Checksum == nil) || (v.Checksum != nil && rhs.Checksum != nil && bytes.Equal(v.Checksum, rhs.Checksum))) {
+		return false
+	}
 
 	return true
 }
@@ -9080,6 +9242,15 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.SearchAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("searchAttributes", (_Map_String_Binary_Zapper)(v.SearchAttributes)))
 	}
+	if v.ChecksumVersion != nil {
+		enc.AddInt32("checksumVersion", *v.ChecksumVersion)
+	}
+	if v.ChecksumFlavor != nil {
+		enc.AddInt32("checksumFlavor", *v.ChecksumFlavor)
+	}
+	if v.Checksum != nil {
+		enc.AddString("checksum", base64.StdEncoding.EncodeToString(v.Checksum))
+	}
 	return err
 }
 
@@ -9922,3 +10093,48 @@ func (v *WorkflowExecutionInfo) GetSearchAttributes() (o map[string][]byte) {
 func (v *WorkflowExecutionInfo) IsSetSearchAttributes() bool {
 	return v != nil && v.SearchAttributes != nil
 }
+
+// GetChecksumVersion returns the value of ChecksumVersion if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetChecksumVersion() (o int32) {
+	if v != nil && v.ChecksumVersion != nil {
+		return *v.ChecksumVersion
+	}
+
+	return
+}
+
+// IsSetChecksumVersion returns true if ChecksumVersion is not nil.
+func (v *WorkflowExecutionInfo) IsSetChecksumVersion() bool {
+	return v != nil && v.ChecksumVersion != nil
+}
+
+// GetChecksumFlavor returns the value of ChecksumFlavor if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetChecksumFlavor() (o int32) {
+	if v != nil && v.ChecksumFlavor != nil {
+		return *v.ChecksumFlavor
+	}
+
+	return
+}
+
+// IsSetChecksumFlavor returns true if ChecksumFlavor is not nil.
+func (v *WorkflowExecutionInfo) IsSetChecksumFlavor() bool {
+	return v != nil && v.ChecksumFlavor != nil
+}
+
+// GetChecksum returns the value of Checksum if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetChecksum() (o []byte) {
+	if v != nil && v.Checksum != nil {
+		return v.Checksum
+	}
+
+	return
+}
+
+// IsSetChecksum returns true if Checksum is not nil.
+func (v *WorkflowExecutionInfo) IsSetChecksum() bool {
+	return v != nil && v.Checksum != nil
+}
@@ -0,0 +1,2544 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go
+// source: uber/cadence/api/v1/workflow_service.proto
+// DO NOT EDIT!
+
+package apiv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkflowServiceYARPCClient is the YARPC client-side interface for the WorkflowService service.
+type WorkflowServiceYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest, ...yarpc.CallOption) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error)
+	ScanWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest, ...yarpc.CallOption) (*CountWorkflowExecutionsResponse, error)
+	GetSearchAttributes(context.Context, *GetSearchAttributesRequest, ...yarpc.CallOption) (*GetSearchAttributesResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
+}
+
+// NewWorkflowServiceYARPCClient builds a new YARPC client for the WorkflowService service.
+func NewWorkflowServiceYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkflowServiceYARPCClient {
+	return &_WorkflowServiceYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.WorkflowService",
+			ClientConfig: clientConfig,
+			Options:      options,
+		},
+	)}
+}
+
+// WorkflowServiceYARPCServer is the YARPC server-side interface for the WorkflowService service.
+type WorkflowServiceYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest) (*ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
+	ScanWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+	GetSearchAttributes(context.Context, *GetSearchAttributesRequest) (*GetSearchAttributesResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
+}
+
+// BuildWorkflowServiceYARPCProcedures prepares an implementation of the WorkflowService service for YARPC registration.
+func BuildWorkflowServiceYARPCProcedures(server WorkflowServiceYARPCServer) []transport.Procedure {
+	handler := &_WorkflowServiceYARPCHandler{server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.WorkflowService",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RegisterDomain,
+							NewRequest: newWorkflowServiceServiceRegisterDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeDomain,
+							NewRequest: newWorkflowServiceServiceDescribeDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomains",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListDomains,
+							NewRequest: newWorkflowServiceServiceListDomainsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.UpdateDomain,
+							NewRequest: newWorkflowServiceServiceUpdateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DeprecateDomain,
+							NewRequest: newWorkflowServiceServiceDeprecateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "StartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.StartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.GetWorkflowExecutionHistory,
+							NewRequest: newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForDecisionTask,
+							NewRequest: newWorkflowServiceServicePollForDecisionTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForActivityTask,
+							NewRequest: newWorkflowServiceServicePollForActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeat,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeatByID,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompletedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceled,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceledByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RequestCancelWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RequestCancelWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWithStartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWithStartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResetWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResetWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "TerminateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.TerminateWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListOpenWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListOpenWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListClosedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListClosedWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ScanWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ScanWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "CountWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.CountWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "GetSearchAttributes",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.GetSearchAttributes,
+							NewRequest: newWorkflowServiceServiceGetSearchAttributesYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondQueryTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResetStickyTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResetStickyTaskList,
+							NewRequest: newWorkflowServiceServiceResetStickyTaskListYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "QueryWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.QueryWorkflow,
+							NewRequest: newWorkflowServiceServiceQueryWorkflowYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeTaskList,
+							NewRequest: newWorkflowServiceServiceDescribeTaskListYARPCRequest,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// FxWorkflowServiceYARPCClientParams defines the input
+// for NewFxWorkflowServiceYARPCClient. It provides the
+// paramaters to get a WorkflowServiceYARPCClient in an
+// Fx application.
+type FxWorkflowServiceYARPCClientParams struct {
+	fx.In
+
+	Provider yarpc.ClientConfig
+}
+
+// FxWorkflowServiceYARPCClientResult defines the output
+// of NewFxWorkflowServiceYARPCClient. It provides a
+// WorkflowServiceYARPCClient to an Fx application.
+type FxWorkflowServiceYARPCClientResult struct {
+	fx.Out
+
+	Client WorkflowServiceYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkflowServiceYARPCClient provides a WorkflowServiceYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  apiv1.NewFxWorkflowServiceYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkflowServiceYARPCClientParams) FxWorkflowServiceYARPCClientResult {
+		return FxWorkflowServiceYARPCClientResult{
+			Client: NewWorkflowServiceYARPCClient(params.Provider.ClientConfig(name), options...),
+		}
+	}
+}
+
+// FxWorkflowServiceYARPCProceduresParams defines the input
+// for NewFxWorkflowServiceYARPCProcedures. It provides the
+// paramaters to get WorkflowServiceYARPCServer procedures in an
+// Fx application.
+type FxWorkflowServiceYARPCProceduresParams struct {
+	fx.In
+
+	Server WorkflowServiceYARPCServer
+}
+
+// FxWorkflowServiceYARPCProceduresResult defines the output
+// of NewFxWorkflowServiceYARPCProcedures. It provides
+// WorkflowServiceYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkflowServiceYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkflowServiceYARPCProcedures provides WorkflowServiceYARPCServer procedures to an Fx application.
+// It expects a WorkflowServiceYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  apiv1.NewFxWorkflowServiceYARPCProcedures(),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCProcedures() interface{} {
+	return func(params FxWorkflowServiceYARPCProceduresParams) FxWorkflowServiceYARPCProceduresResult {
+		return FxWorkflowServiceYARPCProceduresResult{
+			Procedures: BuildWorkflowServiceYARPCProcedures(params.Server),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.WorkflowService",
+				FileDescriptors: yarpcFileDescriptorClosuredfc66e7e7bd77c3a,
+			},
+		}
+	}
+}
+
+type _WorkflowServiceYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkflowServiceYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RegisterDomain", request, newWorkflowServiceServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomain", request, newWorkflowServiceServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListDomains(ctx context.Context, request *ListDomainsRequest, options ...yarpc.CallOption) (*ListDomainsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomains", request, newWorkflowServiceServiceListDomainsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomain", request, newWorkflowServiceServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeprecateDomain", request, newWorkflowServiceServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) StartWorkflowExecution(ctx context.Context, request *StartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StartWorkflowExecution", request, newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest, options ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionHistory", request, newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForDecisionTask", request, newWorkflowServiceServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskFailed", request, newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForActivityTask", request, newWorkflowServiceServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompleted", request, newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailed", request, newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceled", request, newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RequestCancelWorkflowExecution(ctx context.Context, request *RequestCancelWorkflowExecutionRequest, options ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RequestCancelWorkflowExecution", request, newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RequestCancelWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWorkflowExecution(ctx context.Context, request *SignalWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWorkflowExecution", request, newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SignalWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWithStartWorkflowExecution", request, newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest, options ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetWorkflowExecution", request, newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) TerminateWorkflowExecution(ctx context.Context, request *TerminateWorkflowExecutionRequest, options ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "TerminateWorkflowExecution", request, newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*TerminateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListOpenWorkflowExecutions(ctx context.Context, request *ListOpenWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListOpenWorkflowExecutions", request, newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListOpenWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListClosedWorkflowExecutions(ctx context.Context, request *ListClosedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListClosedWorkflowExecutions", request, newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListClosedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListWorkflowExecutions", request, newWorkflowServiceServiceListWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ScanWorkflowExecutions", request, newWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest, options ...yarpc.CallOption) (*CountWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CountWorkflowExecutions", request, newWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CountWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetSearchAttributes(ctx context.Context, request *GetSearchAttributesRequest, options ...yarpc.CallOption) (*GetSearchAttributesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetSearchAttributes", request, newWorkflowServiceServiceGetSearchAttributesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetSearchAttributesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetSearchAttributesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondQueryTaskCompleted", request, newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetStickyTaskList(ctx context.Context, request *ResetStickyTaskListRequest, options ...yarpc.CallOption) (*ResetStickyTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetStickyTaskList", request, newWorkflowServiceServiceResetStickyTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetStickyTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) QueryWorkflow(ctx context.Context, request *QueryWorkflowRequest, options ...yarpc.CallOption) (*QueryWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "QueryWorkflow", request, newWorkflowServiceServiceQueryWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*QueryWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newWorkflowServiceServiceDescribeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkflowServiceYARPCHandler struct {
+	server WorkflowServiceYARPCServer
+}
+
+func (h *_WorkflowServiceYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListDomains(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomains(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) StartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetWorkflowExecutionHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RequestCancelWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RequestCancelWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RequestCancelWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RequestCancelWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWithStartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWithStartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWithStartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) TerminateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *TerminateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*TerminateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.TerminateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListOpenWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListOpenWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListOpenWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListOpenWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListClosedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListClosedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListClosedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListClosedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ScanWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ScanWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) CountWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CountWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CountWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CountWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetSearchAttributes(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetSearchAttributesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetSearchAttributesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetSearchAttributesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetSearchAttributes(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetStickyTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetStickyTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetStickyTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetStickyTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) QueryWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *QueryWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*QueryWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.QueryWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCRequest() proto.Message {
+	return &ListDomainsRequest{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCResponse() proto.Message {
+	return &ListDomainsResponse{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &StartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest() proto.Message {
+	return &GetWorkflowExecutionHistoryRequest{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse() proto.Message {
+	return &GetWorkflowExecutionHistoryResponse{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest() proto.Message {
+	return &RequestCancelWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse() proto.Message {
+	return &RequestCancelWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
+	return &SignalWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest() proto.Message {
+	return &ResetWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse() proto.Message {
+	return &ResetWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest() proto.Message {
+	return &TerminateWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse() proto.Message {
+	return &TerminateWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListOpenWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListOpenWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListClosedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListClosedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest() proto.Message {
+	return &CountWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse() proto.Message {
+	return &CountWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceGetSearchAttributesYARPCRequest() proto.Message {
+	return &GetSearchAttributesRequest{}
+}
+
+func newWorkflowServiceServiceGetSearchAttributesYARPCResponse() proto.Message {
+	return &GetSearchAttributesResponse{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCRequest() proto.Message {
+	return &ResetStickyTaskListRequest{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCResponse() proto.Message {
+	return &ResetStickyTaskListResponse{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCRequest() proto.Message {
+	return &QueryWorkflowRequest{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCResponse() proto.Message {
+	return &QueryWorkflowResponse{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCRequest() proto.Message {
+	return &DescribeTaskListRequest{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCResponse() proto.Message {
+	return &DescribeTaskListResponse{}
+}
+
+var (
+	emptyWorkflowServiceServiceRegisterDomainYARPCRequest                    = &RegisterDomainRequest{}
+	emptyWorkflowServiceServiceRegisterDomainYARPCResponse                   = &RegisterDomainResponse{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCRequest                    = &DescribeDomainRequest{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCResponse                   = &DescribeDomainResponse{}
+	emptyWorkflowServiceServiceListDomainsYARPCRequest                       = &ListDomainsRequest{}
+	emptyWorkflowServiceServiceListDomainsYARPCResponse                      = &ListDomainsResponse{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCRequest                      = &UpdateDomainRequest{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCResponse                     = &UpdateDomainResponse{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCRequest                   = &DeprecateDomainRequest{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCResponse                  = &DeprecateDomainResponse{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest            = &StartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse           = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest       = &GetWorkflowExecutionHistoryRequest{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse      = &GetWorkflowExecutionHistoryResponse{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest    = &RequestCancelWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse   = &RequestCancelWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest           = &SignalWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse          = &SignalWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest  = &SignalWithStartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest            = &ResetWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse           = &ResetWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest        = &TerminateWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse       = &TerminateWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest        = &ListOpenWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse       = &ListOpenWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest      = &ListClosedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse     = &ListClosedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListWorkflowExecutionsYARPCRequest            = &ListWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListWorkflowExecutionsYARPCResponse           = &ListWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest            = &ListWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse           = &ListWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest           = &CountWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse          = &CountWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceGetSearchAttributesYARPCRequest               = &GetSearchAttributesRequest{}
+	emptyWorkflowServiceServiceGetSearchAttributesYARPCResponse              = &GetSearchAttributesResponse{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest               = &ResetStickyTaskListRequest{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse              = &ResetStickyTaskListResponse{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCResponse                    = &QueryWorkflowResponse{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosuredfc66e7e7bd77c3a = [][]byte{
+	// uber/cadence/api/v1/workflow_service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x1c, 0x45,
+		0x10, 0xd5, 0x1c, 0xb8, 0x14, 0x1f, 0x41, 0x1d, 0x29, 0x26, 0x4b, 0x20, 0x8e, 0xe3, 0xc4, 0x8e,
+		0x09, 0xbb, 0xb1, 0x43, 0x12, 0x87, 0x98, 0x43, 0xe2, 0x25, 0x09, 0x12, 0x12, 0x61, 0x37, 0x08,
+		0x89, 0x0b, 0xb4, 0x67, 0x2b, 0x71, 0xcb, 0xeb, 0xe9, 0xa1, 0xa7, 0x77, 0x13, 0x1f, 0x91, 0x38,
+		0x21, 0x21, 0x21, 0x21, 0x10, 0x48, 0x48, 0xfc, 0x48, 0xfe, 0x00, 0x9a, 0x9d, 0x1e, 0xa7, 0x67,
+		0xa7, 0xab, 0x3c, 0xb3, 0x3e, 0xe4, 0x66, 0x79, 0xdf, 0xab, 0x7a, 0xaa, 0xae, 0xaa, 0x7e, 0x3d,
+		0xb0, 0x31, 0xd9, 0x43, 0xd3, 0x8b, 0xe5, 0x08, 0x93, 0x18, 0x7b, 0x32, 0x55, 0xbd, 0xe9, 0x66,
+		0xef, 0x85, 0x36, 0x07, 0xcf, 0xc6, 0xfa, 0xc5, 0xf7, 0x19, 0x9a, 0xa9, 0x8a, 0xb1, 0x9b, 0x1a,
+		0x6d, 0xb5, 0x38, 0x9b, 0x63, 0xbb, 0x0e, 0xdb, 0x95, 0xa9, 0xea, 0x4e, 0x37, 0x3b, 0xcb, 0xa1,
+		0x00, 0xd9, 0xbe, 0x34, 0x38, 0x2a, 0x68, 0x2b, 0xef, 0xc1, 0xb9, 0x01, 0x3e, 0x57, 0x99, 0x45,
+		0xd3, 0xd7, 0x87, 0x52, 0x25, 0x03, 0xcc, 0x52, 0x9d, 0x64, 0xb8, 0x72, 0x1e, 0x96, 0xfa, 0x98,
+		0x1a, 0x8c, 0xa5, 0xc5, 0xb9, 0x9f, 0x2e, 0xc3, 0xa5, 0xe2, 0xef, 0x51, 0x1f, 0x63, 0x95, 0x29,
+		0x9d, 0x3c, 0x95, 0xd9, 0xc1, 0x43, 0xa9, 0xc6, 0x38, 0x3a, 0x06, 0x5d, 0x85, 0x55, 0x07, 0xba,
+		0x1f, 0x5b, 0x35, 0x55, 0xf6, 0x28, 0x07, 0xed, 0xea, 0xc3, 0x74, 0x8c, 0xd6, 0xc3, 0x6d, 0xc0,
+		0x3a, 0x87, 0x7b, 0x70, 0xf4, 0x45, 0x3f, 0x90, 0xd8, 0xc7, 0xce, 0x25, 0x5e, 0x83, 0x2b, 0x24,
+		0xa8, 0x12, 0xed, 0x0a, 0x5c, 0x0e, 0x65, 0x96, 0x49, 0x8c, 0x7e, 0xbc, 0x6b, 0xb0, 0xc6, 0xc0,
+		0x2a, 0x11, 0xd7, 0xe1, 0xea, 0x00, 0x7f, 0x9c, 0x60, 0x66, 0x8b, 0x9f, 0xbf, 0x75, 0x67, 0xf5,
+		0xf9, 0x4b, 0x8c, 0x27, 0x56, 0xe9, 0x57, 0x25, 0xbc, 0x04, 0x17, 0x87, 0xea, 0x79, 0x22, 0x19,
+		0xc8, 0x2a, 0xac, 0x3c, 0x45, 0x73, 0xa8, 0x12, 0x69, 0x91, 0x46, 0x5d, 0x80, 0xce, 0x23, 0xb4,
+		0x43, 0x94, 0x26, 0xde, 0xbf, 0x6f, 0xad, 0x51, 0x7b, 0x13, 0x8b, 0x99, 0x53, 0xe1, 0x15, 0xec,
+		0xeb, 0x09, 0x9a, 0xf0, 0x09, 0x6c, 0xfd, 0xb7, 0x0a, 0x67, 0xca, 0x04, 0xc3, 0xa2, 0xa9, 0x84,
+		0x82, 0x77, 0xaa, 0x7d, 0x21, 0x36, 0xba, 0x81, 0x0e, 0xeb, 0xce, 0x37, 0xcf, 0x2c, 0x6d, 0xe7,
+		0xa3, 0x46, 0xd8, 0x22, 0x7d, 0x9e, 0xaa, 0x8f, 0x59, 0x6c, 0xd4, 0x1e, 0xb2, 0xa9, 0xaa, 0x20,
+		0x3e, 0xd5, 0x3c, 0xd6, 0xa5, 0xfa, 0x01, 0xde, 0xfc, 0x52, 0x65, 0xb6, 0xf8, 0x6f, 0x26, 0xd6,
+		0x82, 0x5c, 0x0f, 0x51, 0x26, 0x59, 0x3f, 0x19, 0xe8, 0x32, 0xc4, 0xf0, 0xd6, 0x37, 0xe9, 0xe8,
+		0x78, 0x64, 0x44, 0x98, 0xe9, 0x43, 0xca, 0x1c, 0xd7, 0x1a, 0x20, 0x5d, 0x92, 0x31, 0x9c, 0x99,
+		0x1b, 0x4d, 0x41, 0x95, 0x61, 0x6e, 0x80, 0x8b, 0x54, 0xd7, 0x9b, 0x81, 0x5d, 0xb6, 0x9f, 0x22,
+		0x38, 0x37, 0xb4, 0xd2, 0xd8, 0x5a, 0x13, 0x8a, 0xad, 0x60, 0xa0, 0x30, 0xb8, 0x4c, 0x7e, 0xb3,
+		0x15, 0xc7, 0x69, 0xf8, 0x2d, 0x82, 0xf7, 0x1f, 0x61, 0x1d, 0xf0, 0x58, 0x65, 0x56, 0x9b, 0x23,
+		0x71, 0x27, 0x18, 0x94, 0x61, 0x94, 0x6a, 0xb6, 0xdb, 0x13, 0x9d, 0xa4, 0x97, 0x70, 0xf6, 0x89,
+		0x1e, 0x8f, 0x1f, 0x6a, 0xe3, 0x2f, 0x41, 0xd1, 0x0b, 0x06, 0x0c, 0x20, 0x4b, 0x05, 0x37, 0x9a,
+		0x13, 0x5c, 0xe6, 0xdf, 0x23, 0xb8, 0x10, 0xd8, 0xbf, 0xc7, 0x83, 0x2d, 0xb6, 0x89, 0xf1, 0xa3,
+		0x29, 0xa5, 0x98, 0xbb, 0x0b, 0x30, 0x9d, 0xaa, 0x5f, 0x22, 0x38, 0x4f, 0xde, 0x0a, 0xe2, 0x56,
+		0xd3, 0xc0, 0xe5, 0x32, 0x2f, 0xf4, 0xdc, 0x6e, 0x4b, 0xab, 0x1d, 0x8e, 0xbf, 0xb3, 0xf9, 0xc3,
+		0xf1, 0x91, 0x8d, 0x0e, 0xa7, 0x4a, 0xf0, 0x3a, 0x75, 0x80, 0xb1, 0x36, 0x95, 0xdb, 0xe2, 0x31,
+		0x4a, 0x63, 0xf7, 0x50, 0x5a, 0xa2, 0x53, 0x19, 0x06, 0xdf, 0xa9, 0x2c, 0xd1, 0x49, 0xfa, 0x2b,
+		0x82, 0x8b, 0x0c, 0x2e, 0xbf, 0xc1, 0xc4, 0xbd, 0xb6, 0xd1, 0x8b, 0x7b, 0xef, 0xb4, 0xd2, 0xbc,
+		0x56, 0x0e, 0xde, 0xfe, 0x7c, 0x2b, 0x13, 0xc6, 0xa2, 0x41, 0x2b, 0xb3, 0x96, 0x44, 0xfc, 0x1b,
+		0xc1, 0xf2, 0x49, 0x9e, 0x44, 0xec, 0xb4, 0x8e, 0xef, 0x97, 0xec, 0xb3, 0x05, 0xd9, 0xf5, 0x61,
+		0xab, 0x9b, 0x1c, 0x7e, 0xd8, 0x42, 0xce, 0xa9, 0xc1, 0xb0, 0xd1, 0x86, 0x4b, 0xfc, 0x19, 0xc1,
+		0x07, 0xac, 0xe3, 0x12, 0x77, 0xdb, 0x45, 0xf6, 0x0b, 0xf5, 0xe9, 0x22, 0xd4, 0xca, 0x2c, 0x92,
+		0xd6, 0x8d, 0x9c, 0x45, 0xc6, 0x13, 0xf2, 0x0d, 0x7f, 0xa2, 0x99, 0x14, 0xff, 0xcc, 0x66, 0x91,
+		0x75, 0x93, 0xe4, 0x2c, 0x9e, 0xe0, 0x41, 0x0b, 0x69, 0x3b, 0x8b, 0x91, 0x9d, 0xbc, 0xbf, 0x23,
+		0xf8, 0x90, 0x77, 0xb0, 0x82, 0x3a, 0x10, 0xde, 0xf6, 0x16, 0xe2, 0xee, 0x2d, 0xc4, 0x75, 0xda,
+		0x7e, 0x8e, 0x60, 0x89, 0xf0, 0xcc, 0x82, 0x30, 0x15, 0x94, 0xc3, 0x2e, 0xd4, 0x7c, 0xd2, 0x8e,
+		0xe4, 0x64, 0xfc, 0x11, 0xc1, 0xb2, 0xc3, 0x28, 0xbb, 0x4f, 0x18, 0xa3, 0x1d, 0x2e, 0x34, 0x49,
+		0x3b, 0x95, 0x45, 0xca, 0x6d, 0xda, 0x00, 0x33, 0x6c, 0x6c, 0xd3, 0xc2, 0x60, 0x5e, 0x03, 0xc5,
+		0x71, 0x1a, 0x7e, 0x8d, 0xa0, 0x43, 0xbf, 0x59, 0x44, 0x78, 0xc1, 0x70, 0x8f, 0x9c, 0x42, 0xcb,
+		0x9d, 0xd6, 0x3c, 0x4f, 0x4f, 0xee, 0xd2, 0xbf, 0x4a, 0x31, 0xa9, 0xa1, 0x32, 0x42, 0x0f, 0x4d,
+		0xe0, 0xf5, 0x70, 0x3c, 0xef, 0xba, 0xcb, 0x61, 0xbb, 0x63, 0x9d, 0xe1, 0x28, 0xa0, 0x68, 0x9b,
+		0x8c, 0x4c, 0x51, 0xf8, 0xeb, 0x8e, 0x67, 0x7a, 0x9d, 0x93, 0x03, 0x03, 0x7a, 0xb6, 0xc8, 0xa8,
+		0xb4, 0x92, 0x9b, 0xad, 0x38, 0xfe, 0x23, 0x23, 0x96, 0xc9, 0xeb, 0xd4, 0x90, 0x2f, 0x98, 0x5d,
+		0x3d, 0x49, 0x42, 0x85, 0x08, 0x07, 0x24, 0xd0, 0xfc, 0x82, 0x21, 0x49, 0xaf, 0xbc, 0x6b, 0xe0,
+		0x45, 0x4f, 0x78, 0x57, 0xfa, 0xed, 0xdf, 0xb9, 0xd1, 0x9c, 0x50, 0x77, 0x15, 0xf5, 0xcf, 0x05,
+		0xbc, 0xab, 0xa8, 0xe3, 0x1b, 0xb9, 0x0a, 0xfa, 0xab, 0x44, 0x5e, 0x86, 0xd9, 0xb6, 0x19, 0x5a,
+		0x15, 0x1f, 0xcc, 0x30, 0xf9, 0xf1, 0x11, 0x65, 0x08, 0x20, 0xf9, 0x32, 0x04, 0x09, 0x2e, 0xf3,
+		0x33, 0x78, 0x7b, 0xa6, 0xab, 0x3c, 0x23, 0x11, 0x7e, 0x9a, 0x57, 0x30, 0x65, 0xb6, 0x8d, 0x26,
+		0x50, 0xaf, 0xdc, 0xe5, 0x87, 0x8a, 0xfa, 0xb2, 0xbc, 0xc5, 0x7e, 0xd8, 0x20, 0x77, 0xe5, 0xed,
+		0xb6, 0x34, 0x27, 0x46, 0xc3, 0xbb, 0x25, 0xe8, 0xb8, 0xd6, 0xd7, 0xd9, 0x58, 0xf3, 0x85, 0xfe,
+		0xb8, 0x21, 0xba, 0x48, 0xf8, 0x60, 0x05, 0x96, 0x62, 0x7d, 0x18, 0xe2, 0x3c, 0x89, 0xbe, 0x7b,
+		0x43, 0xa6, 0x6a, 0xba, 0xf9, 0xff, 0x00, 0x2e, 0x07, 0x2f, 0x1d, 0x01, 0x15, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/shared.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x6c, 0x1c, 0xdb,
+		0x79, 0x98, 0x86, 0x14, 0x25, 0xf1, 0xe3, 0x43, 0xab, 0xa3, 0x95, 0x44, 0xea, 0xb1, 0x12, 0x87,
+		0x7a, 0xac, 0x28, 0x89, 0x92, 0x78, 0xe5, 0xab, 0x17, 0x75, 0xaf, 0x97, 0xbb, 0x4b, 0x69, 0x2d,
+		0x6a, 0x49, 0x0f, 0x97, 0x57, 0x96, 0x0c, 0x64, 0x3a, 0xda, 0x19, 0x4a, 0x03, 0x2e, 0x67, 0x99,
+		0x99, 0xa1, 0x48, 0x3a, 0x4d, 0x11, 0xb8, 0x4e, 0x50, 0x27, 0xd7, 0x8d, 0xe3, 0xb6, 0xae, 0xeb,
+		0x02, 0x85, 0x1d, 0xb8, 0x4d, 0x60, 0xb7, 0x37, 0x68, 0x9a, 0x34, 0xee, 0x03, 0x85, 0x63, 0xa4,
+		0x6d, 0x1a, 0xc0, 0x31, 0xda, 0x06, 0x75, 0x5a, 0xa4, 0x41, 0x82, 0xa2, 0x45, 0x8a, 0x02, 0x49,
+		0xda, 0x3f, 0x45, 0xd3, 0xa2, 0x28, 0xce, 0x63, 0xde, 0xe7, 0xcc, 0xce, 0x92, 0xd4, 0xd5, 0x6d,
+		0xa2, 0x7f, 0xbb, 0xe7, 0x7c, 0xe7, 0xcc, 0x77, 0xbe, 0xd7, 0xf9, 0xce, 0x39, 0xdf, 0xf9, 0x0e,
+		0x9c, 0x59, 0x7f, 0x66, 0xd8, 0x57, 0x9b, 0x9a, 0x6e, 0x58, 0x4d, 0xe3, 0xaa, 0xb6, 0x66, 0x5e,
+		0x7d, 0x79, 0xfd, 0xaa, 0xf3, 0x42, 0xb3, 0x0d, 0x7d, 0x72, 0xcd, 0x6e, 0xbb, 0x6d, 0x74, 0x18,
+		0x43, 0x4c, 0x32, 0x88, 0x49, 0x6d, 0xcd, 0x9c, 0x7c, 0x79, 0x5d, 0xfe, 0x9c, 0x04, 0xfb, 0x1e,
+		0x18, 0x9a, 0x6e, 0xd8, 0xe8, 0x5d, 0xd8, 0xb7, 0x6c, 0x1a, 0x2d, 0xdd, 0x19, 0x81, 0x33, 0xbd,
+		0xc5, 0x81, 0xa9, 0x0b, 0x93, 0x9c, 0x06, 0x93, 0x14, 0x78, 0x72, 0x96, 0x40, 0x56, 0x2d, 0xd7,
+		0xde, 0x52, 0x58, 0xb3, 0xe3, 0xb7, 0x61, 0x20, 0x54, 0x8c, 0x72, 0xd0, 0xbb, 0x62, 0x6c, 0x8d,
+		0x48, 0x67, 0xa4, 0x62, 0xbf, 0x82, 0x7f, 0xa2, 0x3c, 0xf4, 0xbd, 0xd4, 0x5a, 0xeb, 0xc6, 0x48,
+		0xcf, 0x19, 0xa9, 0x38, 0xa8, 0xd0, 0x3f, 0x77, 0x7a, 0x6e, 0x49, 0xb2, 0x0c, 0x83, 0x8f, 0xdb,
+		0xf6, 0xca, 0x72, 0xab, 0xbd, 0xd1, 0xd8, 0x5a, 0x33, 0x10, 0x82, 0xbd, 0x96, 0xb6, 0x6a, 0x8c,
+		0x00, 0x69, 0x4c, 0x7e, 0x63, 0x98, 0x52, 0xd3, 0x35, 0x5f, 0x9a, 0xee, 0x96, 0x10, 0x66, 0x09,
+		0x0e, 0x34, 0x34, 0x67, 0x65, 0xce, 0x74, 0x5c, 0x5e, 0x3d, 0xfa, 0x18, 0xec, 0x5d, 0x31, 0x2d,
+		0x7d, 0x24, 0x7f, 0x46, 0x2a, 0x0e, 0x4f, 0x8d, 0x71, 0x47, 0xe8, 0x75, 0xf0, 0xd0, 0xb4, 0x74,
+		0x85, 0x80, 0xcb, 0x65, 0xc8, 0x79, 0xa5, 0x8f, 0x0c, 0x57, 0xd3, 0x35, 0x57, 0x43, 0x57, 0x21,
+		0xbf, 0xaa, 0x6d, 0xaa, 0xae, 0xe6, 0xac, 0x38, 0xea, 0x9a, 0x61, 0xab, 0x8e, 0xd1, 0x6c, 0x5b,
+		0x3a, 0xf9, 0x9c, 0xa4, 0x1c, 0x5a, 0xd5, 0x36, 0x71, 0x13, 0x67, 0xc1, 0xb0, 0x17, 0x49, 0x85,
+		0xfc, 0x10, 0x0e, 0x79, 0x63, 0xac, 0x6e, 0x1a, 0xcd, 0x75, 0xd7, 0x6c, 0x5b, 0xe8, 0x34, 0x0c,
+		0x6c, 0xb0, 0x42, 0xd5, 0xd4, 0x19, 0xae, 0xe0, 0x15, 0xd5, 0x74, 0x74, 0x04, 0xf6, 0xd9, 0xeb,
+		0x96, 0x6a, 0x52, 0x9c, 0xfb, 0x95, 0x3e, 0x7b, 0xdd, 0xaa, 0xe9, 0xf2, 0x8f, 0x49, 0xb0, 0xf7,
+		0x91, 0xb1, 0xda, 0x46, 0xf7, 0x62, 0x5c, 0x3b, 0xc7, 0x1d, 0x13, 0x06, 0xdd, 0x6d, 0x9e, 0xfd,
+		0xb2, 0x04, 0xb9, 0x45, 0x43, 0xb3, 0x9b, 0x2f, 0x4a, 0xae, 0x6b, 0x9b, 0xcf, 0xd6, 0x5d, 0xc3,
+		0x41, 0x2a, 0x0c, 0x9b, 0x96, 0x6e, 0x6c, 0x1a, 0xba, 0x1a, 0x41, 0xeb, 0x16, 0x17, 0xad, 0x78,
+		0xf3, 0xc9, 0x1a, 0x6d, 0x1b, 0xc6, 0x74, 0xc8, 0x0c, 0x97, 0x1d, 0xff, 0x38, 0xa0, 0x24, 0x50,
+		0x57, 0x78, 0xff, 0x5a, 0x1f, 0x1c, 0x49, 0x30, 0xa2, 0x66, 0x2d, 0xb7, 0x51, 0x05, 0xfa, 0x0d,
+		0xaf, 0x80, 0xb0, 0x62, 0x60, 0xea, 0x3c, 0x17, 0xef, 0x44, 0x73, 0x25, 0x68, 0x88, 0x65, 0xcc,
+		0xdd, 0x5a, 0x33, 0x08, 0xbf, 0x06, 0xa6, 0xc6, 0x52, 0x3b, 0xc0, 0x82, 0xac, 0x10, 0x70, 0x74,
+		0x0a, 0xc0, 0x71, 0x35, 0xdb, 0x55, 0x5d, 0x73, 0xd5, 0x18, 0x29, 0x9c, 0x91, 0x8a, 0xbd, 0x4a,
+		0x3f, 0x29, 0x69, 0x98, 0xab, 0xa4, 0xba, 0xd9, 0x6a, 0x3b, 0x06, 0xad, 0x2e, 0xd2, 0x6a, 0x52,
+		0x42, 0xaa, 0x1b, 0x30, 0x48, 0xab, 0x1d, 0x57, 0x73, 0xd7, 0x9d, 0x91, 0x29, 0x22, 0xe0, 0xd7,
+		0xb3, 0x61, 0x5f, 0xc6, 0x2d, 0x17, 0x49, 0x43, 0x65, 0xa0, 0x19, 0xfc, 0x41, 0xe7, 0x60, 0xf8,
+		0x85, 0xe9, 0xb8, 0x6d, 0x7b, 0x4b, 0x6d, 0x19, 0xd6, 0x73, 0xf7, 0xc5, 0xc8, 0x34, 0xf9, 0xf0,
+		0x10, 0x2b, 0x9d, 0x23, 0x85, 0xa8, 0x08, 0xb9, 0x35, 0xcd, 0x36, 0x2c, 0x57, 0xd5, 0xdb, 0xab,
+		0x9a, 0x49, 0xa4, 0x75, 0x96, 0xb0, 0x62, 0x98, 0x96, 0x57, 0x48, 0x71, 0x4d, 0x47, 0x9f, 0xf4,
+		0x21, 0x03, 0x42, 0x2f, 0x74, 0x45, 0xe8, 0x83, 0xb4, 0x7d, 0xa0, 0x41, 0xe7, 0x60, 0xd8, 0xef,
+		0x8b, 0x12, 0xe7, 0x29, 0xc5, 0xd1, 0x2f, 0x25, 0x04, 0xba, 0x02, 0x7b, 0x57, 0x8d, 0xd5, 0xf6,
+		0x88, 0x4e, 0xbe, 0x36, 0x2a, 0xd4, 0x12, 0x85, 0x80, 0x21, 0x05, 0x0e, 0x39, 0x44, 0x38, 0x55,
+		0xcd, 0x97, 0xce, 0x11, 0xe3, 0x8c, 0x24, 0xd4, 0xb0, 0xb8, 0x28, 0x2b, 0x39, 0x27, 0xae, 0x1b,
+		0x73, 0x70, 0x48, 0x5b, 0x77, 0xdb, 0xaa, 0x6d, 0x38, 0x86, 0xab, 0xae, 0xb5, 0x4d, 0xcb, 0x75,
+		0x46, 0x2c, 0xd2, 0xe7, 0x19, 0x6e, 0x9f, 0x0a, 0x06, 0x5c, 0x20, 0x70, 0xca, 0x41, 0xdc, 0x34,
+		0x54, 0x20, 0xff, 0xf3, 0x1e, 0x28, 0x24, 0x39, 0xd9, 0xb6, 0x96, 0xcd, 0xe7, 0xeb, 0xb6, 0x46,
+		0x48, 0x73, 0x07, 0xfa, 0xb1, 0x79, 0x52, 0x5b, 0xa6, 0xe3, 0x32, 0x79, 0x3e, 0x95, 0x6a, 0xf2,
+		0x94, 0x03, 0x2e, 0xfb, 0x85, 0x96, 0xa0, 0x18, 0x90, 0x95, 0x09, 0x66, 0x5b, 0x0d, 0x44, 0xb0,
+		0xbd, 0xee, 0x32, 0x8b, 0xe7, 0x10, 0x49, 0xef, 0x53, 0xc6, 0x7d, 0xf8, 0x45, 0x22, 0xb5, 0xed,
+		0xb2, 0x27, 0x9d, 0xed, 0x75, 0x97, 0xda, 0x40, 0x07, 0x3d, 0x84, 0x71, 0x82, 0x52, 0x87, 0x1e,
+		0x0b, 0xa4, 0xc7, 0x02, 0x06, 0x4d, 0xe9, 0xac, 0x0c, 0x83, 0xcd, 0x17, 0x66, 0x4b, 0x57, 0xd7,
+		0xda, 0x2d, 0xb3, 0xb9, 0x45, 0xb4, 0x62, 0x58, 0x40, 0xcb, 0x32, 0x06, 0x5c, 0x20, 0x70, 0xca,
+		0x40, 0x33, 0xf8, 0x23, 0x7f, 0xbe, 0x0f, 0xce, 0x2f, 0x36, 0x5f, 0x18, 0xfa, 0x7a, 0xcb, 0xf0,
+		0xe7, 0x17, 0xcd, 0x59, 0xa9, 0x18, 0x4d, 0xd3, 0x31, 0xdb, 0x56, 0x88, 0x81, 0xa7, 0x61, 0x40,
+		0x63, 0x10, 0x21, 0x63, 0xed, 0x15, 0xd5, 0x74, 0x34, 0x0b, 0x43, 0x3e, 0x40, 0x47, 0x1b, 0x10,
+		0x9e, 0xcc, 0x94, 0x41, 0x2d, 0xf4, 0x0f, 0x1d, 0x85, 0x7d, 0x54, 0x93, 0x46, 0x46, 0xc9, 0x37,
+		0xd8, 0xbf, 0x28, 0x43, 0x0b, 0xdd, 0x31, 0x34, 0x0f, 0x7d, 0xa6, 0xb5, 0xb6, 0xee, 0x12, 0x2a,
+		0x0d, 0x2a, 0xf4, 0x0f, 0x7a, 0x00, 0x63, 0x0e, 0x1b, 0xbc, 0x98, 0x1b, 0x57, 0x08, 0x37, 0x4e,
+		0x79, 0x80, 0x7c, 0x66, 0xc4, 0x7a, 0x0a, 0x6c, 0x59, 0xb8, 0xa7, 0xa9, 0x78, 0x4f, 0x8b, 0x9e,
+		0x81, 0x0b, 0xf5, 0x34, 0x03, 0x85, 0x0e, 0xe2, 0x71, 0x93, 0x74, 0x73, 0xdc, 0x11, 0x8b, 0xc6,
+		0x1d, 0x18, 0x7d, 0x61, 0x68, 0xb6, 0xfb, 0xcc, 0xd0, 0x92, 0x58, 0x4c, 0x93, 0xe6, 0xc7, 0x7c,
+		0x80, 0xa4, 0x58, 0xd9, 0x86, 0x6b, 0x6f, 0x79, 0x62, 0x35, 0x9b, 0xaa, 0xa2, 0xae, 0xbd, 0xe5,
+		0x89, 0x95, 0x1d, 0xfc, 0x41, 0x6f, 0xc1, 0xbe, 0x17, 0xc4, 0x55, 0x62, 0xf6, 0xed, 0x44, 0x8a,
+		0x37, 0xa5, 0x30, 0x50, 0xb9, 0x0e, 0x97, 0x14, 0xe3, 0x87, 0xd7, 0x0d, 0xc7, 0x2d, 0x6b, 0x56,
+		0xd3, 0x68, 0xed, 0x50, 0x1e, 0xe5, 0x1f, 0x81, 0x93, 0x3e, 0x81, 0x6d, 0x4e, 0x07, 0xa3, 0x70,
+		0x00, 0xd3, 0xc6, 0x0e, 0x5a, 0xef, 0x27, 0xff, 0x6b, 0x3a, 0xfa, 0x38, 0x9c, 0xf2, 0x99, 0xb0,
+		0x6c, 0xda, 0x7c, 0xa5, 0xef, 0x55, 0x46, 0x19, 0x0f, 0x66, 0x4d, 0x3b, 0xc6, 0x02, 0xb9, 0x0a,
+		0x97, 0xca, 0xed, 0xd5, 0xb5, 0x96, 0xe1, 0x1a, 0x09, 0x3b, 0xc5, 0xc1, 0xe5, 0x28, 0xec, 0xb3,
+		0x0d, 0x67, 0xbd, 0x45, 0x2d, 0xd5, 0xa0, 0xc2, 0xfe, 0xc9, 0x9f, 0x86, 0x0b, 0xb3, 0x9a, 0xd9,
+		0xca, 0xdc, 0x85, 0xe6, 0xb0, 0xc9, 0xbb, 0x5f, 0x61, 0xff, 0xd0, 0x08, 0xec, 0xd7, 0x0d, 0x57,
+		0x33, 0x5b, 0x14, 0xeb, 0x41, 0xc5, 0xfb, 0x2b, 0xdf, 0x81, 0x53, 0x94, 0xd2, 0xdd, 0x53, 0x48,
+		0xae, 0xc2, 0x45, 0xda, 0x36, 0x0b, 0x6a, 0x21, 0x14, 0x20, 0x8a, 0xc2, 0x6f, 0x49, 0x70, 0x2b,
+		0xc2, 0xf4, 0xea, 0xa6, 0x6b, 0xd8, 0x96, 0x96, 0x75, 0xc4, 0xcc, 0x50, 0x40, 0xc4, 0x50, 0xc4,
+		0xdc, 0xca, 0x7c, 0x8a, 0x5b, 0x59, 0x08, 0xb9, 0x95, 0x18, 0xcd, 0x66, 0xdb, 0x72, 0xed, 0x76,
+		0x8b, 0x99, 0x09, 0xef, 0x2f, 0x9a, 0x84, 0xc3, 0xd4, 0xd6, 0xfa, 0xfd, 0xb6, 0xad, 0xd6, 0x16,
+		0x51, 0xe8, 0x03, 0xca, 0x21, 0x52, 0xe5, 0x21, 0x3c, 0x6f, 0xb5, 0xb6, 0xe4, 0x2f, 0xf5, 0xc0,
+		0xf5, 0x45, 0xf3, 0xb9, 0xa5, 0xed, 0xca, 0x78, 0x22, 0x9e, 0x59, 0x7e, 0xbb, 0x9e, 0xd9, 0x69,
+		0x18, 0x70, 0x08, 0x4a, 0x2a, 0x59, 0x18, 0xd0, 0x91, 0x03, 0x2d, 0xaa, 0xe3, 0xe5, 0x01, 0xdf,
+		0x46, 0x86, 0x88, 0x32, 0x95, 0x89, 0x28, 0xd3, 0x22, 0xa2, 0x7c, 0x51, 0x82, 0x82, 0x62, 0x34,
+		0xdb, 0xb6, 0xfe, 0x48, 0xb3, 0x57, 0xb8, 0x02, 0x77, 0x1a, 0x06, 0x56, 0x49, 0x9d, 0x1a, 0x5a,
+		0xbc, 0x00, 0x2d, 0x22, 0x38, 0x0a, 0x85, 0x39, 0x64, 0x72, 0x0a, 0xd9, 0x4d, 0xce, 0xb7, 0xf6,
+		0xc1, 0xb5, 0x72, 0xdb, 0x72, 0x4d, 0x6b, 0xdd, 0x28, 0x39, 0x75, 0x63, 0x23, 0x0b, 0x9b, 0x66,
+		0x61, 0xc8, 0x1f, 0x31, 0x99, 0xe7, 0x20, 0xab, 0xaf, 0x3b, 0xb8, 0x11, 0xfa, 0x17, 0x9d, 0xcf,
+		0xf2, 0xdb, 0x9c, 0xcf, 0x0a, 0x61, 0x5e, 0x75, 0xe3, 0xb6, 0x14, 0x77, 0xdd, 0x6d, 0x99, 0xca,
+		0xe4, 0xb6, 0x3c, 0x80, 0xb1, 0x67, 0x5a, 0x73, 0xa5, 0xbd, 0xbc, 0xcc, 0xfa, 0x33, 0x2d, 0xd7,
+		0xb0, 0x5f, 0x6a, 0x2d, 0xd5, 0xb4, 0x62, 0x73, 0xd4, 0x29, 0x06, 0x48, 0x7a, 0xab, 0x31, 0xb0,
+		0x9a, 0xb5, 0xab, 0x33, 0x55, 0x0d, 0xfa, 0x4d, 0xcb, 0x74, 0x4d, 0xcd, 0x6d, 0xd3, 0xc9, 0x6a,
+		0x78, 0xea, 0x12, 0xdf, 0x85, 0x0a, 0x8b, 0x49, 0xcd, 0x6b, 0xa2, 0x04, 0xad, 0xb1, 0x2f, 0xbe,
+		0xac, 0x99, 0xad, 0x75, 0xdb, 0x50, 0x99, 0x21, 0x7e, 0x4a, 0xe4, 0x77, 0x88, 0x95, 0x2a, 0xa4,
+		0x10, 0x5d, 0x80, 0x83, 0x1e, 0x98, 0x27, 0xca, 0x3a, 0x61, 0xa2, 0xd7, 0xba, 0xc2, 0x24, 0xfa,
+		0x06, 0x1c, 0x6d, 0x69, 0x8e, 0xab, 0x36, 0xe9, 0x3c, 0x82, 0x79, 0xca, 0xe6, 0x08, 0x8b, 0xc0,
+		0xe7, 0x71, 0x6d, 0xd9, 0xaf, 0x54, 0x48, 0x1d, 0x1a, 0x87, 0xa1, 0xa6, 0x8d, 0xd9, 0xcf, 0xbc,
+		0x8c, 0x91, 0x4d, 0x82, 0xc4, 0x20, 0x2e, 0xf4, 0x3c, 0x3d, 0x74, 0xc3, 0x57, 0x96, 0xcf, 0x4a,
+		0xd9, 0xb5, 0xe5, 0x27, 0xf7, 0xc1, 0x15, 0xc2, 0x8b, 0x72, 0x58, 0xb7, 0x5f, 0x89, 0x85, 0x4e,
+		0xe8, 0x58, 0x61, 0x17, 0x74, 0xac, 0xb8, 0x4d, 0x1d, 0x9b, 0xda, 0xae, 0x8e, 0x4d, 0xef, 0xba,
+		0x8e, 0xcd, 0x6e, 0x6b, 0x69, 0xb0, 0xb0, 0x8d, 0xa5, 0x41, 0xd8, 0xf0, 0x3f, 0x8d, 0x1a, 0xfe,
+		0x26, 0x8c, 0x84, 0xb8, 0xa7, 0xda, 0xc6, 0xba, 0x63, 0x78, 0x9f, 0xd2, 0xc9, 0xa7, 0x26, 0x52,
+		0xf9, 0x54, 0xd3, 0x15, 0xdc, 0x84, 0x7d, 0xf4, 0xc8, 0x06, 0xaf, 0x38, 0xa1, 0xdd, 0xd6, 0x76,
+		0xb4, 0xfb, 0x15, 0x2a, 0xc3, 0x1f, 0x1e, 0x84, 0x03, 0x9e, 0xc4, 0x63, 0x71, 0xd5, 0xd9, 0xef,
+		0x60, 0x4a, 0x10, 0x6d, 0xb1, 0x79, 0xad, 0xa8, 0xb8, 0xea, 0xa1, 0x7f, 0xe8, 0xaf, 0x4b, 0x30,
+		0xe1, 0xaf, 0x23, 0x82, 0xc5, 0x14, 0x16, 0x0c, 0xbf, 0xff, 0xd0, 0x92, 0x9c, 0x4e, 0x1a, 0x77,
+		0xf9, 0x4b, 0xf2, 0x4c, 0xab, 0x3a, 0xe5, 0xbc, 0x93, 0x09, 0x0e, 0x6d, 0xc2, 0xe9, 0x60, 0x51,
+		0x63, 0x73, 0xb1, 0x19, 0x25, 0xd8, 0xf0, 0x77, 0x5d, 0xd2, 0x1c, 0x71, 0xe5, 0xa4, 0x93, 0x52,
+		0x8b, 0xfe, 0x8e, 0x04, 0x57, 0x99, 0x09, 0x34, 0x02, 0x57, 0x23, 0xd0, 0x41, 0x1e, 0x2a, 0xd4,
+		0x5a, 0x7c, 0x5c, 0x60, 0xc8, 0x33, 0xbb, 0xe5, 0xca, 0xa5, 0x66, 0x76, 0x60, 0xf4, 0x55, 0x09,
+		0x2e, 0x61, 0x13, 0x9e, 0x15, 0xc9, 0x71, 0x82, 0xe4, 0x34, 0x17, 0xc9, 0x8c, 0x4e, 0xbf, 0x72,
+		0x61, 0x39, 0x1b, 0x20, 0xfa, 0x39, 0x09, 0xae, 0xd9, 0xd4, 0xd1, 0x56, 0x9b, 0xc4, 0xd3, 0xce,
+		0x20, 0x5f, 0xc5, 0x14, 0x32, 0x76, 0xb1, 0x54, 0x53, 0x2e, 0xd9, 0xd9, 0x81, 0xd1, 0x8f, 0xc0,
+		0x19, 0x86, 0xa0, 0x58, 0xd4, 0xa6, 0x08, 0x62, 0x53, 0x7c, 0xfe, 0xa6, 0x2d, 0x69, 0x94, 0x53,
+		0xcd, 0xb4, 0x6a, 0xf4, 0x35, 0x09, 0xae, 0xb0, 0xaf, 0x67, 0xe4, 0xe2, 0x34, 0x41, 0xe5, 0x9d,
+		0x14, 0x54, 0xb2, 0xf0, 0xf1, 0x62, 0x33, 0x2b, 0x28, 0xfa, 0xbe, 0x04, 0xef, 0xc4, 0x38, 0x69,
+		0xb0, 0x45, 0x46, 0x56, 0x9c, 0xa9, 0xa7, 0xf4, 0xa8, 0x33, 0x5f, 0xbb, 0x58, 0xbd, 0x28, 0xb7,
+		0xec, 0x6d, 0xb6, 0x44, 0x7f, 0x01, 0xc6, 0x6c, 0xb2, 0x2e, 0x50, 0x99, 0xf3, 0xcf, 0xc3, 0x99,
+		0x6e, 0x24, 0xbc, 0x25, 0xc0, 0x39, 0x6d, 0x55, 0xa1, 0x14, 0xec, 0xd4, 0x7a, 0xf4, 0x2b, 0x12,
+		0xbc, 0xdd, 0x64, 0xee, 0x9d, 0xaa, 0x39, 0xaa, 0x65, 0x6c, 0x64, 0xa5, 0xe4, 0x53, 0x82, 0x55,
+		0xb5, 0xb3, 0xc7, 0x98, 0x85, 0x82, 0xd7, 0x9a, 0x5d, 0xb6, 0x40, 0x7f, 0x4f, 0x82, 0x29, 0x6a,
+		0x96, 0x63, 0x2b, 0xb1, 0x74, 0xac, 0xe9, 0x36, 0xf0, 0x8c, 0xd8, 0x52, 0x67, 0x75, 0xf0, 0x94,
+		0x2b, 0x4e, 0x37, 0xe0, 0xe8, 0x1f, 0x49, 0xf0, 0x36, 0x5b, 0x84, 0x76, 0x2b, 0xb3, 0x74, 0xfe,
+		0x9f, 0xe5, 0xe3, 0xdc, 0xed, 0x52, 0x5b, 0xb9, 0xee, 0x74, 0xdb, 0x44, 0xfe, 0xc1, 0x20, 0x5c,
+		0x48, 0xc0, 0x11, 0x6a, 0x19, 0x7a, 0xf5, 0xa5, 0x61, 0xb9, 0xaf, 0x60, 0x89, 0x78, 0x03, 0x8e,
+		0xb2, 0x13, 0x03, 0xbf, 0x3b, 0xe6, 0x4f, 0x0f, 0x12, 0x47, 0x26, 0x4f, 0x6b, 0xbd, 0x1e, 0xe8,
+		0x49, 0x03, 0x7a, 0x06, 0xa3, 0xf1, 0x56, 0xc1, 0xfe, 0xc1, 0x70, 0x57, 0xfb, 0x07, 0xc7, 0xa2,
+		0x1f, 0xf0, 0x2b, 0xd0, 0x6d, 0xff, 0x1b, 0x6c, 0x01, 0x64, 0xe8, 0xaa, 0xf1, 0x92, 0xfc, 0xd7,
+		0x47, 0x72, 0x64, 0x77, 0x8c, 0xa1, 0x5e, 0xf3, 0xea, 0x09, 0x91, 0x6a, 0xfa, 0x9f, 0xd1, 0x75,
+		0x6f, 0xdc, 0x27, 0xbf, 0xb1, 0x1d, 0x9f, 0xfc, 0x2e, 0x1c, 0xf7, 0x6c, 0x84, 0x1e, 0xd2, 0x17,
+		0xb6, 0x99, 0xf5, 0x36, 0x91, 0x89, 0x63, 0x3e, 0x44, 0xc0, 0x46, 0xb2, 0xbd, 0x15, 0x59, 0xea,
+		0xde, 0xdc, 0xd1, 0x52, 0xf7, 0x16, 0x8c, 0x04, 0x78, 0xc4, 0x16, 0xbd, 0xb7, 0x08, 0x16, 0x47,
+		0xfd, 0xfa, 0xd9, 0xc8, 0xea, 0xf7, 0x0e, 0x8c, 0x26, 0x5b, 0x7a, 0xeb, 0xe0, 0xdb, 0x84, 0xa9,
+		0xc7, 0xe2, 0x4d, 0x3b, 0x2f, 0x88, 0xef, 0xa4, 0x2c, 0x88, 0x6f, 0xc3, 0x68, 0xdb, 0x36, 0x9f,
+		0x9b, 0xd4, 0xe8, 0xc4, 0x48, 0x76, 0x97, 0x22, 0xeb, 0x01, 0xc4, 0x28, 0x76, 0x1c, 0x0e, 0x98,
+		0xba, 0x61, 0xb9, 0xa6, 0x4b, 0xb7, 0xb5, 0xfa, 0x15, 0xff, 0x3f, 0x7a, 0x0b, 0x8e, 0x2e, 0x9b,
+		0xb6, 0xe3, 0x26, 0xfb, 0xbc, 0x47, 0x20, 0x0f, 0x93, 0xda, 0x58, 0x87, 0xbb, 0xb2, 0x65, 0x31,
+		0x02, 0xfb, 0x35, 0xd7, 0x35, 0x56, 0xd7, 0x5c, 0x32, 0x29, 0xf6, 0x29, 0xde, 0x5f, 0x74, 0x1d,
+		0xf2, 0xc6, 0xe6, 0x9a, 0x49, 0x0f, 0xc0, 0x88, 0x9c, 0x3a, 0xae, 0xb6, 0xba, 0xc6, 0xce, 0x04,
+		0x0f, 0x07, 0x75, 0x0d, 0xaf, 0x2a, 0xb9, 0x42, 0xd2, 0x39, 0x2b, 0xa4, 0x39, 0x18, 0xa7, 0x63,
+		0x0d, 0x16, 0x39, 0x58, 0x2f, 0xfc, 0x7d, 0x1c, 0xa6, 0x08, 0x16, 0xc1, 0xe6, 0x34, 0x01, 0xf5,
+		0x17, 0x39, 0x9a, 0xb3, 0x32, 0xc3, 0xb6, 0x71, 0x98, 0x26, 0x78, 0x87, 0x91, 0x9b, 0x3b, 0x38,
+		0x8c, 0xdc, 0xda, 0xd9, 0x61, 0xe4, 0x7b, 0x70, 0x74, 0xcd, 0x36, 0x5e, 0xaa, 0xc9, 0x13, 0xc9,
+		0xcf, 0x4a, 0xa9, 0x2c, 0x09, 0x8e, 0x24, 0x0f, 0xe3, 0x0e, 0x4a, 0xd1, 0x63, 0xc9, 0xd0, 0x52,
+		0xf2, 0xfd, 0x2e, 0x96, 0x92, 0x9f, 0x80, 0x81, 0x70, 0x27, 0x77, 0x61, 0x1f, 0x43, 0x86, 0x46,
+		0x0f, 0x8c, 0x77, 0xc0, 0x05, 0x9f, 0xde, 0x2b, 0xac, 0x89, 0xfc, 0xf9, 0x1e, 0x18, 0x8e, 0x56,
+		0xe1, 0x0d, 0xa7, 0x67, 0xa6, 0xa5, 0xd9, 0x5b, 0x6a, 0xf3, 0x85, 0xd1, 0x5c, 0x71, 0xd6, 0x57,
+		0xd9, 0x6e, 0xcc, 0x30, 0x2d, 0x2e, 0xb3, 0x52, 0x41, 0xb4, 0x05, 0xba, 0x07, 0x27, 0x62, 0xdc,
+		0xf7, 0x56, 0x45, 0xba, 0xb7, 0x85, 0xde, 0xab, 0x8c, 0x44, 0xb8, 0xee, 0xad, 0xb1, 0xf4, 0x9a,
+		0x8e, 0x26, 0xe0, 0x50, 0xd3, 0x36, 0xc8, 0x04, 0x81, 0x25, 0x52, 0xb5, 0x34, 0xab, 0xcd, 0x8e,
+		0xf0, 0x0f, 0xb2, 0x0a, 0x2c, 0x8e, 0x75, 0xcd, 0x6a, 0xa3, 0xcb, 0x80, 0x88, 0x90, 0x9a, 0xd6,
+		0xf3, 0x10, 0xf0, 0x14, 0x01, 0xce, 0x79, 0x35, 0x3e, 0x74, 0x01, 0x80, 0xf0, 0xce, 0xd5, 0x9e,
+		0xb5, 0x0c, 0xb6, 0xef, 0x1c, 0x2a, 0x91, 0x7f, 0x4a, 0x82, 0x8b, 0x9c, 0x43, 0x62, 0x86, 0x5a,
+		0x7c, 0xce, 0x16, 0x1c, 0xc1, 0xa0, 0x59, 0x38, 0x13, 0x15, 0xfb, 0x60, 0xf4, 0xfe, 0x84, 0x47,
+		0x8f, 0x83, 0x4e, 0xea, 0x21, 0xa1, 0x8f, 0x7e, 0xa7, 0xa6, 0xcb, 0x3f, 0x2b, 0xc1, 0xf9, 0x04,
+		0x36, 0xd8, 0xc2, 0x09, 0x50, 0xe9, 0xe6, 0x28, 0x27, 0x13, 0x92, 0x85, 0x0c, 0x48, 0xb6, 0xa1,
+		0x98, 0xc0, 0x11, 0xd3, 0x5b, 0x9f, 0x5f, 0x77, 0xe3, 0x58, 0x96, 0x61, 0xd0, 0x9b, 0x0a, 0x43,
+		0x7b, 0x1e, 0x7c, 0xcd, 0x61, 0x93, 0x21, 0x71, 0x71, 0x06, 0xdc, 0xe0, 0x8f, 0xfc, 0xed, 0x7d,
+		0xc0, 0x09, 0xc9, 0xf0, 0x26, 0x00, 0x32, 0x03, 0xc5, 0xbf, 0x7b, 0x15, 0xf2, 0xd8, 0x43, 0x4f,
+		0xd8, 0x5d, 0x4a, 0xab, 0x43, 0x96, 0xb1, 0x11, 0xb3, 0xba, 0x09, 0x6f, 0x2c, 0xbf, 0x0b, 0x9b,
+		0x89, 0xbb, 0x72, 0x00, 0xdd, 0x8d, 0xe3, 0x32, 0xb5, 0xeb, 0x8e, 0xcb, 0x74, 0x26, 0xc7, 0x25,
+		0x8b, 0x68, 0xcd, 0x76, 0x16, 0xad, 0x6c, 0x1b, 0xff, 0x0b, 0x59, 0x36, 0xfe, 0x23, 0x8e, 0xcc,
+		0xd3, 0x5d, 0xde, 0xb3, 0xd7, 0x33, 0xee, 0xd9, 0x5b, 0x5d, 0xee, 0xd9, 0x6f, 0xa6, 0xb8, 0x28,
+		0xdb, 0xdb, 0x81, 0xfc, 0x8e, 0x04, 0x67, 0xc3, 0xd3, 0xac, 0x37, 0x5d, 0x27, 0xcc, 0xc9, 0x4e,
+		0x22, 0x61, 0x3a, 0x87, 0x23, 0xe4, 0x3b, 0x86, 0x23, 0x84, 0x1c, 0x16, 0x6a, 0x83, 0xbc, 0xbf,
+		0xf2, 0x17, 0x24, 0x90, 0x23, 0x43, 0xe0, 0x2f, 0xa7, 0x2e, 0x03, 0xf2, 0xfc, 0x93, 0x90, 0xd0,
+		0x01, 0x9d, 0x16, 0x9c, 0xc8, 0xb0, 0x63, 0x5e, 0x5b, 0x3e, 0xe6, 0xb5, 0x9d, 0x02, 0x60, 0xdb,
+		0x14, 0xc1, 0xe9, 0x6f, 0x3f, 0x2b, 0xa9, 0xe9, 0xf2, 0x1f, 0xc7, 0x48, 0x2a, 0x9c, 0x2c, 0x2e,
+		0xc1, 0xa1, 0x40, 0x71, 0x9b, 0x6d, 0xcb, 0x35, 0x36, 0xbd, 0x79, 0x23, 0x67, 0x84, 0xcd, 0x98,
+		0xb1, 0xe9, 0x0a, 0xd0, 0xcf, 0x0b, 0xd0, 0x2f, 0x42, 0xce, 0xa1, 0x64, 0x88, 0x9b, 0xee, 0x61,
+		0x27, 0x44, 0x9e, 0xd8, 0x40, 0x8b, 0xb1, 0x81, 0x72, 0x26, 0xfd, 0x29, 0xde, 0xa4, 0x2f, 0x7f,
+		0x57, 0x82, 0xf1, 0xf0, 0x90, 0x45, 0xd6, 0xbe, 0x3b, 0x1e, 0xf0, 0x06, 0x91, 0xe7, 0x0e, 0x22,
+		0x3e, 0x8b, 0x14, 0xb6, 0x33, 0x8b, 0xfc, 0xaf, 0x1e, 0x18, 0x0b, 0x0f, 0x82, 0x3f, 0xad, 0xbe,
+		0xaa, 0x21, 0xcc, 0x40, 0x5f, 0x53, 0x5b, 0x77, 0x3c, 0xdc, 0x2f, 0xa7, 0xef, 0xfa, 0xfb, 0xe8,
+		0x95, 0x71, 0x1b, 0x85, 0x36, 0x0d, 0x4f, 0xed, 0xe3, 0xd1, 0xa9, 0x3d, 0x8d, 0xcb, 0x81, 0xa3,
+		0x30, 0x15, 0x71, 0x14, 0x0a, 0x30, 0xf0, 0x4c, 0x73, 0x0c, 0x6f, 0x66, 0xa4, 0x6b, 0x97, 0x7e,
+		0x5c, 0x44, 0x67, 0xc4, 0x93, 0x00, 0x78, 0x0a, 0x65, 0xd5, 0x34, 0x5a, 0xf1, 0x80, 0x65, 0x6c,
+		0xd0, 0xda, 0xcb, 0x80, 0x96, 0xdb, 0xf6, 0x0a, 0x1b, 0xf6, 0x4b, 0xc3, 0x76, 0xbc, 0x48, 0xc5,
+		0x5e, 0x25, 0x87, 0x6b, 0xc8, 0xc0, 0xdf, 0xa3, 0xe5, 0xf2, 0xf7, 0xfb, 0xe0, 0x6c, 0x78, 0x4b,
+		0x57, 0x68, 0x86, 0xde, 0x04, 0x90, 0xfd, 0x99, 0x08, 0x20, 0xcb, 0xe2, 0x2f, 0x3c, 0xcd, 0xe0,
+		0x2f, 0xec, 0xca, 0x01, 0x60, 0x10, 0x15, 0xb2, 0x99, 0x7d, 0x62, 0xfd, 0x39, 0x09, 0xe4, 0x88,
+		0x44, 0xbf, 0xde, 0x59, 0x29, 0x3c, 0x7f, 0x16, 0x23, 0x0b, 0x7e, 0xf9, 0x17, 0xa5, 0xa8, 0xee,
+		0x75, 0xbd, 0xb8, 0x79, 0x0d, 0x53, 0x93, 0xfc, 0xaf, 0x24, 0x18, 0x0b, 0x23, 0xbd, 0x5b, 0x6b,
+		0x20, 0xfe, 0x58, 0x0a, 0x5d, 0x8c, 0xa5, 0xd8, 0x71, 0x2c, 0x53, 0xb1, 0xb1, 0xfc, 0x8e, 0x04,
+		0xe3, 0xe1, 0xb1, 0x88, 0x66, 0xcf, 0x10, 0xd6, 0x7d, 0x59, 0xb0, 0xfe, 0x88, 0xcc, 0xab, 0x7f,
+		0x4d, 0x82, 0x89, 0x88, 0x7c, 0x91, 0xb3, 0x1d, 0x76, 0x44, 0xb4, 0x2d, 0x0b, 0xbf, 0x3b, 0x6b,
+		0xe9, 0x5f, 0x90, 0x40, 0x7c, 0x00, 0xc9, 0x97, 0xa7, 0x8e, 0xb8, 0xe5, 0xbd, 0x59, 0x9c, 0x6d,
+		0x7e, 0x90, 0x3f, 0xbb, 0xb6, 0xb0, 0xfe, 0xdf, 0x31, 0x41, 0xa1, 0x68, 0x1b, 0x7a, 0x8a, 0xa0,
+		0x40, 0x62, 0x89, 0xdf, 0xd2, 0xdc, 0xd0, 0xa9, 0x9f, 0xed, 0xb1, 0x21, 0x41, 0x3b, 0x0a, 0xc7,
+		0x63, 0x16, 0x9d, 0xdd, 0x3f, 0x74, 0x35, 0xf9, 0xae, 0x04, 0x27, 0xc8, 0x91, 0xab, 0xc0, 0x94,
+		0xbe, 0xca, 0x50, 0xdc, 0x5d, 0x63, 0xe1, 0x9f, 0x83, 0x51, 0x32, 0x06, 0xfc, 0x89, 0x6e, 0x46,
+		0x90, 0x59, 0x67, 0xe5, 0x7f, 0x29, 0xc1, 0x49, 0xf2, 0x09, 0x91, 0x74, 0xec, 0xc6, 0x57, 0x76,
+		0x8b, 0x1e, 0xa9, 0x36, 0xfe, 0x57, 0x24, 0x38, 0x13, 0x3a, 0x88, 0xe7, 0xab, 0x64, 0xca, 0x68,
+		0x5e, 0xa9, 0x32, 0xa6, 0x62, 0xfe, 0x93, 0x3d, 0x70, 0x35, 0xb9, 0x21, 0x95, 0x6e, 0xf7, 0x7c,
+		0x6c, 0x21, 0x8c, 0xed, 0x3d, 0x38, 0xe1, 0x1f, 0x72, 0x72, 0x0e, 0xc9, 0x28, 0x73, 0x46, 0x3c,
+		0x90, 0xc4, 0x31, 0xd9, 0x72, 0xa8, 0x39, 0xe7, 0x1c, 0xaf, 0xd0, 0xd5, 0x39, 0xde, 0xa8, 0x21,
+		0x3a, 0xe9, 0x4c, 0x25, 0xc6, 0xfb, 0x12, 0x14, 0x05, 0xc4, 0xe0, 0x1d, 0x7a, 0x76, 0xe6, 0x0e,
+		0x64, 0xe0, 0x8e, 0x38, 0x60, 0xfd, 0x07, 0x12, 0x9c, 0xa2, 0xa7, 0xf8, 0xf4, 0xc4, 0x9f, 0x6b,
+		0xe5, 0xb7, 0x1b, 0x40, 0xbc, 0x5b, 0xc2, 0x15, 0xb8, 0x9c, 0xc5, 0xec, 0x2e, 0xe7, 0x8f, 0x72,
+		0xe8, 0x4c, 0x4f, 0xb5, 0xb9, 0x63, 0x0c, 0x07, 0x72, 0x83, 0x38, 0x90, 0x3b, 0x1f, 0x5e, 0xab,
+		0x84, 0xf9, 0x5c, 0x88, 0xf1, 0xf9, 0x33, 0x30, 0x91, 0xdc, 0xf6, 0x35, 0xec, 0x55, 0xd3, 0xd2,
+		0xdc, 0xdd, 0x70, 0xcd, 0xd2, 0xbe, 0xfd, 0xab, 0x3d, 0xf0, 0x4e, 0xb6, 0xa0, 0x93, 0xa8, 0x8a,
+		0xbc, 0x02, 0xc9, 0x0b, 0x16, 0x8e, 0xf9, 0xc8, 0xc2, 0x71, 0x09, 0xd0, 0x8e, 0x35, 0xf0, 0xd0,
+		0x46, 0x42, 0xf3, 0x76, 0xef, 0xbe, 0xc1, 0x5f, 0xe9, 0x85, 0xbb, 0xd9, 0x68, 0xc8, 0xb7, 0xc4,
+		0x4b, 0x61, 0x03, 0x36, 0x3c, 0xf5, 0x6e, 0x4a, 0x34, 0x53, 0x87, 0x9e, 0x23, 0x9b, 0x1a, 0xbb,
+		0xe4, 0xee, 0x85, 0xf8, 0x52, 0xc8, 0xc0, 0x97, 0xe2, 0x4e, 0xf9, 0x72, 0x19, 0x10, 0xc7, 0x5e,
+		0xb3, 0x53, 0x28, 0x33, 0x6e, 0xa7, 0x43, 0x5c, 0x9c, 0x8e, 0x70, 0x51, 0xfe, 0x77, 0x12, 0xdc,
+		0x14, 0x92, 0xab, 0xc3, 0x94, 0xc2, 0xc7, 0x01, 0x04, 0x38, 0x7c, 0xb8, 0x82, 0x2b, 0xff, 0xf7,
+		0x1e, 0xb8, 0xd9, 0x21, 0xe6, 0xe6, 0x4f, 0x9b, 0xae, 0xc6, 0x8c, 0x6e, 0x51, 0x6c, 0x74, 0xa7,
+		0x04, 0xb7, 0x67, 0xa6, 0x33, 0xa9, 0xf8, 0xac, 0x48, 0xc5, 0xdf, 0xef, 0x85, 0x1b, 0x1d, 0x68,
+		0xbe, 0x03, 0xdd, 0xce, 0xd4, 0xf3, 0x1b, 0xdd, 0x0e, 0x74, 0xfb, 0xbf, 0x4a, 0x70, 0x4d, 0x48,
+		0x2e, 0xd1, 0xcc, 0xfd, 0x51, 0x56, 0x6a, 0xf1, 0x6c, 0x24, 0xff, 0xfb, 0x7d, 0xf0, 0x56, 0x4a,
+		0x58, 0xa0, 0x50, 0xd5, 0xdf, 0xdc, 0xfe, 0xf8, 0xd3, 0x78, 0xfb, 0x23, 0x8b, 0xe2, 0xeb, 0x19,
+		0x14, 0x3f, 0xed, 0x16, 0x89, 0xf5, 0xaa, 0x6e, 0x91, 0x6c, 0x6e, 0x67, 0x13, 0xf9, 0x6c, 0x3c,
+		0x46, 0xea, 0xb3, 0x52, 0xea, 0x35, 0x92, 0x6e, 0x62, 0x7f, 0x7e, 0xa6, 0x17, 0xae, 0xa5, 0xe8,
+		0x96, 0x70, 0x6f, 0xf4, 0xf5, 0x2a, 0xd6, 0x9c, 0x37, 0xa7, 0xd0, 0xa4, 0x03, 0x6f, 0x8b, 0x65,
+		0x2b, 0xcb, 0x54, 0x22, 0xbe, 0x62, 0xca, 0x37, 0x98, 0xd3, 0x02, 0x83, 0xb9, 0x4b, 0x91, 0x0a,
+		0xf2, 0xaf, 0xf7, 0xc0, 0x65, 0x3e, 0xf2, 0x82, 0xed, 0x2b, 0x11, 0x3f, 0xf8, 0xe8, 0xe7, 0x05,
+		0xe8, 0xbf, 0x22, 0xbb, 0x9e, 0xe0, 0x79, 0x71, 0x7b, 0x3c, 0x0f, 0xd6, 0xb5, 0x53, 0xd9, 0xc5,
+		0xfb, 0x07, 0x3d, 0x20, 0x90, 0x83, 0xae, 0x8f, 0x2a, 0x3e, 0xe4, 0xe9, 0x70, 0xb7, 0xc8, 0xd6,
+		0x9d, 0xc3, 0xc1, 0xdb, 0xc5, 0x9b, 0xe6, 0xee, 0x15, 0xfe, 0x61, 0x0f, 0x5c, 0xea, 0xd2, 0x66,
+		0x74, 0xb9, 0x68, 0xff, 0x90, 0x3d, 0xb3, 0x04, 0xc1, 0xa7, 0x76, 0x93, 0xe0, 0xd3, 0x5d, 0x10,
+		0x7c, 0x96, 0x4b, 0xf0, 0xdf, 0xee, 0x81, 0x2b, 0x02, 0x51, 0xee, 0x7a, 0x2f, 0xff, 0x8d, 0x2c,
+		0x47, 0x48, 0xfb, 0x17, 0x7b, 0x45, 0xa4, 0x7d, 0x95, 0xb1, 0x87, 0x6f, 0xb8, 0x10, 0xe5, 0xc2,
+		0x77, 0x7a, 0xe0, 0xaa, 0x80, 0x0b, 0x69, 0x5b, 0x81, 0xdc, 0x99, 0x8f, 0x4f, 0xc2, 0xfc, 0xae,
+		0x93, 0xb0, 0xb0, 0x9b, 0x24, 0x2c, 0x76, 0x41, 0xc2, 0x29, 0x2e, 0x09, 0xff, 0xc6, 0xdb, 0x30,
+		0xf8, 0x80, 0x66, 0x07, 0x23, 0x45, 0xf8, 0x88, 0x23, 0xb6, 0xce, 0xdb, 0x6f, 0xb0, 0x5e, 0x4f,
+		0x42, 0x7f, 0x10, 0xa1, 0x4f, 0x7d, 0x82, 0xa0, 0x00, 0xdd, 0x03, 0xa0, 0x0d, 0x43, 0x87, 0xb7,
+		0x05, 0xee, 0x30, 0xc9, 0x87, 0xc8, 0x18, 0xfb, 0x0d, 0xef, 0x27, 0x36, 0x3d, 0x5e, 0xdc, 0xce,
+		0x38, 0xfd, 0x2c, 0xfb, 0x8b, 0x8e, 0xc1, 0x7e, 0xe2, 0x1b, 0x99, 0xfa, 0xc8, 0x59, 0x52, 0xb3,
+		0x0f, 0xff, 0xad, 0xe9, 0xe4, 0xfa, 0x6a, 0x92, 0x67, 0x6a, 0x74, 0xe4, 0x89, 0xcb, 0xa1, 0xd3,
+		0xd9, 0x98, 0xc9, 0x77, 0x9c, 0x94, 0x0b, 0x1b, 0xd9, 0x00, 0xc9, 0xbd, 0x4c, 0x0e, 0x72, 0x71,
+		0x07, 0x2f, 0x71, 0x45, 0xf4, 0x9d, 0x8c, 0x39, 0xe0, 0x04, 0xce, 0x88, 0x72, 0x71, 0x23, 0x2b,
+		0x28, 0xb9, 0xbb, 0xcd, 0x41, 0x71, 0x99, 0xcc, 0xc6, 0x49, 0xfc, 0xa6, 0x53, 0xee, 0x6e, 0x67,
+		0x9b, 0xd2, 0x95, 0xf3, 0x1b, 0xd9, 0xa6, 0xfe, 0xbf, 0xc5, 0x27, 0x1e, 0x16, 0x36, 0x5d, 0xc5,
+		0x26, 0x34, 0x81, 0x1c, 0xbd, 0x97, 0x72, 0x2f, 0x1b, 0x72, 0x02, 0x1b, 0xad, 0x14, 0x37, 0xb2,
+		0x5a, 0xf3, 0x9f, 0x91, 0xa0, 0x18, 0xf5, 0xdc, 0xe3, 0x27, 0xd4, 0x89, 0x8b, 0xa0, 0xb7, 0x3b,
+		0x06, 0xd9, 0x89, 0xe2, 0xd0, 0x94, 0xb3, 0x7a, 0x06, 0x28, 0xf4, 0x53, 0x12, 0x9c, 0x8f, 0xe1,
+		0x24, 0xd2, 0x04, 0x7a, 0x09, 0xf4, 0x66, 0x67, 0x8c, 0xf8, 0x4a, 0x20, 0xeb, 0x1d, 0x61, 0x38,
+		0x14, 0x4a, 0x11, 0x7d, 0x3d, 0x23, 0x85, 0x84, 0x52, 0x7f, 0x56, 0xcf, 0x00, 0x85, 0x7e, 0x3a,
+		0x81, 0x53, 0x8a, 0x44, 0xd1, 0xe8, 0xad, 0x5b, 0x1d, 0x71, 0x12, 0x09, 0xd3, 0xb8, 0xde, 0x19,
+		0x08, 0xfd, 0x25, 0x09, 0xce, 0x45, 0x31, 0x12, 0x69, 0x1f, 0xdd, 0x07, 0x78, 0x3b, 0x63, 0xa4,
+		0x66, 0x1c, 0x99, 0x31, 0xbd, 0x13, 0x08, 0xfa, 0x92, 0x04, 0xc5, 0xe8, 0x05, 0xfb, 0x14, 0x91,
+		0xfe, 0xac, 0x94, 0xc2, 0xb1, 0x2c, 0xb1, 0x95, 0xca, 0x59, 0x2d, 0x03, 0x14, 0x7a, 0x5f, 0x82,
+		0xf3, 0x31, 0xa4, 0x44, 0x32, 0xfd, 0xbe, 0x94, 0x22, 0xd4, 0x9d, 0x83, 0xe3, 0x14, 0x59, 0xeb,
+		0x08, 0xc3, 0xa1, 0x51, 0x8a, 0x50, 0x7f, 0x39, 0x2b, 0x8d, 0xc4, 0x52, 0xad, 0x65, 0x80, 0x42,
+		0x9f, 0x97, 0xe0, 0x5c, 0x14, 0x29, 0x91, 0x0c, 0x7d, 0x4d, 0x4a, 0x11, 0xa2, 0x8e, 0x01, 0x49,
+		0xca, 0x98, 0xd6, 0x09, 0x04, 0x7d, 0x31, 0x41, 0xa0, 0x14, 0x0d, 0xfb, 0xa6, 0x94, 0xa2, 0x62,
+		0x19, 0x62, 0xd4, 0x94, 0x71, 0xad, 0x33, 0x10, 0x7a, 0x09, 0x05, 0x1a, 0xb3, 0x21, 0x94, 0x9c,
+		0x5f, 0xa2, 0x78, 0x5c, 0x13, 0xfa, 0xe2, 0x82, 0x20, 0x20, 0xe5, 0x84, 0x2b, 0xae, 0x44, 0x6b,
+		0x70, 0x92, 0x7e, 0x77, 0xd9, 0xb4, 0x79, 0x5f, 0xfd, 0x67, 0xf4, 0xab, 0x93, 0xe2, 0xaf, 0xf2,
+		0xc2, 0x76, 0x94, 0x51, 0x57, 0x54, 0x85, 0xfe, 0xb6, 0x04, 0x57, 0x63, 0xd2, 0xc9, 0x8f, 0xbb,
+		0x0a, 0x61, 0xf1, 0xeb, 0x14, 0x8b, 0x77, 0x3b, 0x0b, 0x69, 0xea, 0xe9, 0x9f, 0x32, 0xa1, 0x65,
+		0x86, 0x45, 0xbf, 0x28, 0xc1, 0x8d, 0xd4, 0xcc, 0x1e, 0x22, 0xf9, 0xfd, 0x37, 0x14, 0xd9, 0x72,
+		0x77, 0xe9, 0x3d, 0xf8, 0xc2, 0x3c, 0x69, 0x77, 0x05, 0x8f, 0xe7, 0x8e, 0x0b, 0x3c, 0xe2, 0xf2,
+		0xf0, 0xfc, 0x9d, 0xac, 0x82, 0x2d, 0x58, 0x87, 0x47, 0x05, 0x5b, 0x00, 0x84, 0xb6, 0xe0, 0x34,
+		0x15, 0x30, 0x31, 0x22, 0xff, 0x45, 0x4a, 0xc9, 0x70, 0x93, 0x16, 0xb7, 0xa5, 0x9c, 0x74, 0x53,
+		0x6a, 0xd1, 0x4f, 0x48, 0x70, 0x36, 0x92, 0xf2, 0x44, 0xc4, 0xb1, 0x3f, 0xa6, 0x08, 0x7c, 0xac,
+		0x53, 0xde, 0x13, 0x3e, 0x8f, 0xce, 0x34, 0x3b, 0x40, 0xa0, 0x3f, 0x0f, 0x67, 0x58, 0xf4, 0x8c,
+		0xcd, 0xe2, 0x6b, 0x92, 0x38, 0xfc, 0x89, 0x94, 0x92, 0x7b, 0x25, 0x35, 0x38, 0x47, 0x39, 0xb5,
+		0x9a, 0x56, 0x8d, 0xdd, 0xd4, 0xcb, 0xbc, 0x05, 0x08, 0x3b, 0x4c, 0x4b, 0xa2, 0xf2, 0xb9, 0x9e,
+		0x6e, 0xdc, 0x54, 0xc1, 0xa1, 0x1c, 0xc7, 0x4d, 0x15, 0x40, 0xa2, 0x6f, 0x48, 0x30, 0xc9, 0x41,
+		0xd0, 0xf5, 0x57, 0xc7, 0x49, 0x14, 0xff, 0x72, 0x4f, 0x8a, 0x41, 0xc8, 0xbe, 0xce, 0x56, 0x26,
+		0x36, 0x32, 0xc3, 0xa2, 0x5f, 0x92, 0xe0, 0x06, 0x6f, 0xad, 0xd4, 0xd1, 0x7a, 0x7d, 0x85, 0x22,
+		0x5b, 0xc9, 0xb8, 0x66, 0x4a, 0x37, 0x61, 0x57, 0x37, 0xba, 0x6b, 0x20, 0x62, 0xbf, 0x58, 0x1d,
+		0x7f, 0xb6, 0x2b, 0xf6, 0x8b, 0x54, 0xb3, 0xb8, 0x91, 0x11, 0x12, 0xfd, 0x27, 0x09, 0xaa, 0x5d,
+		0x24, 0xde, 0x89, 0x6f, 0x30, 0x84, 0x30, 0xff, 0xbb, 0x14, 0xf3, 0xc5, 0x1d, 0x24, 0xe0, 0x11,
+		0x1d, 0xba, 0x2a, 0xef, 0xd8, 0x3b, 0x6a, 0x8f, 0xfe, 0x83, 0x04, 0x33, 0x5d, 0x8c, 0x52, 0x64,
+		0xaa, 0xfe, 0x21, 0x1d, 0xe2, 0xc2, 0x0e, 0x86, 0xc8, 0xb7, 0x62, 0x77, 0xed, 0xed, 0x37, 0x46,
+		0xdf, 0x93, 0xe0, 0x5e, 0xda, 0x68, 0x3a, 0xeb, 0xc8, 0x77, 0xe8, 0xb8, 0xe6, 0xf8, 0x5b, 0x31,
+		0xdb, 0x0b, 0xf6, 0x51, 0x6e, 0x1a, 0xdb, 0x6b, 0x48, 0xe6, 0x7e, 0xde, 0x30, 0xfc, 0x0c, 0x1b,
+		0x2c, 0x9b, 0x51, 0x62, 0x18, 0xbf, 0xd1, 0x93, 0x32, 0xf7, 0x77, 0x77, 0x1f, 0x5b, 0x99, 0xdc,
+		0xe8, 0x0a, 0x1e, 0xfd, 0xaa, 0x04, 0xb7, 0x3b, 0x24, 0x2d, 0x4a, 0xd1, 0x9d, 0xdf, 0xa2, 0x98,
+		0x3f, 0xe8, 0x36, 0x79, 0x91, 0x50, 0x61, 0xde, 0x72, 0xba, 0x6f, 0x84, 0xbe, 0x8d, 0x13, 0x19,
+		0xa5, 0x8f, 0x41, 0xa4, 0x19, 0xbf, 0xdb, 0x93, 0x92, 0x33, 0xaa, 0xdb, 0xa3, 0x60, 0xe5, 0x9a,
+		0xd3, 0x65, 0x0b, 0xf4, 0x2d, 0x09, 0xae, 0x0b, 0x91, 0x16, 0x7a, 0xf5, 0x7f, 0x40, 0xb1, 0x2e,
+		0x75, 0x71, 0xd2, 0x2b, 0x70, 0xf3, 0x2f, 0x37, 0xbb, 0x80, 0x46, 0x7f, 0x5f, 0x82, 0xb7, 0x84,
+		0xd8, 0xa6, 0x2c, 0x17, 0xff, 0x47, 0x9a, 0x80, 0x77, 0x77, 0x22, 0xa9, 0x4c, 0x36, 0xbb, 0x82,
+		0x47, 0x3f, 0x2f, 0xc1, 0xb5, 0xae, 0xc5, 0xe2, 0xff, 0xf4, 0xa4, 0xe5, 0x2c, 0xec, 0x42, 0x22,
+		0x2e, 0x35, 0xbb, 0x10, 0x86, 0x0f, 0x24, 0x98, 0x12, 0x93, 0x57, 0x38, 0xf5, 0xfe, 0x44, 0x6f,
+		0x4a, 0x06, 0xb1, 0xae, 0x0e, 0xc9, 0x94, 0x2b, 0xcd, 0x6e, 0xc0, 0xd1, 0x2f, 0xa4, 0xc9, 0x43,
+		0xca, 0xea, 0xf8, 0x8b, 0xdd, 0x63, 0x2c, 0x5a, 0x27, 0x5f, 0x69, 0x76, 0x03, 0x4e, 0xdc, 0x31,
+		0x31, 0xc6, 0x29, 0xbe, 0xe3, 0x57, 0x7b, 0x53, 0xdc, 0xb1, 0x2e, 0x0f, 0x6a, 0x94, 0xab, 0xcd,
+		0xee, 0x1a, 0x90, 0xa9, 0x32, 0x43, 0xaa, 0xb6, 0x14, 0x4b, 0xfd, 0x8d, 0xde, 0x94, 0xa9, 0x72,
+		0x9b, 0xe1, 0xa3, 0xca, 0x4d, 0x67, 0x7b, 0x0d, 0xd1, 0xaf, 0x49, 0x70, 0x27, 0xc3, 0x78, 0x44,
+		0xea, 0xf9, 0x01, 0x1d, 0x4c, 0x6d, 0xfb, 0xd1, 0x93, 0xf1, 0x91, 0xdc, 0x70, 0xb6, 0xd1, 0x0a,
+		0xe7, 0x2a, 0xfc, 0x58, 0x1a, 0xfe, 0xe2, 0xd5, 0xd2, 0xb7, 0x7b, 0x53, 0xe6, 0x9d, 0x6e, 0x43,
+		0x19, 0x95, 0x6b, 0x46, 0x97, 0x2d, 0xe4, 0x0a, 0xec, 0x67, 0x47, 0x63, 0xe8, 0x36, 0xec, 0x23,
+		0xe8, 0x79, 0x19, 0x8e, 0xf8, 0xe7, 0x77, 0xe1, 0x83, 0x34, 0x85, 0x35, 0x90, 0xef, 0xc0, 0xb1,
+		0x24, 0xa5, 0xcc, 0x96, 0x6b, 0xd8, 0x1d, 0x5f, 0x93, 0x92, 0x8b, 0x80, 0xc2, 0x67, 0x82, 0xac,
+		0x19, 0xef, 0x25, 0xad, 0xc7, 0x70, 0xd0, 0xbf, 0x5a, 0xcd, 0xc0, 0xc6, 0x61, 0xc8, 0xd0, 0xec,
+		0x96, 0x89, 0xfd, 0x62, 0xd7, 0x64, 0xf0, 0xbd, 0xca, 0xa0, 0x57, 0x88, 0x41, 0x31, 0x0a, 0xec,
+		0x8e, 0x1e, 0x01, 0xa1, 0xa7, 0x7a, 0x40, 0x8b, 0x30, 0x80, 0xfc, 0xcd, 0x1e, 0x00, 0xf6, 0x1e,
+		0x10, 0x4e, 0xcd, 0xc4, 0xf9, 0x36, 0x26, 0x0e, 0x7b, 0xc6, 0x28, 0xed, 0x9d, 0x2e, 0xda, 0x09,
+		0x7b, 0xb6, 0x88, 0x35, 0x40, 0x67, 0x60, 0x40, 0x37, 0x9c, 0xa6, 0x6d, 0xae, 0xf9, 0x27, 0xd6,
+		0xfd, 0x4a, 0xb8, 0x08, 0x23, 0xd8, 0xde, 0xb0, 0x0c, 0x5b, 0x35, 0x56, 0x35, 0xb3, 0xe5, 0x85,
+		0x31, 0x93, 0xa2, 0x2a, 0x2e, 0x41, 0xf7, 0x60, 0xaf, 0xae, 0xb9, 0xda, 0xc8, 0x14, 0x61, 0xcc,
+		0xc5, 0x94, 0x6f, 0xe3, 0x01, 0x4c, 0x56, 0x34, 0x57, 0xa3, 0x2f, 0x55, 0x91, 0x66, 0x78, 0x40,
+		0xeb, 0xeb, 0x7e, 0xc6, 0x01, 0xf2, 0xfb, 0xf8, 0x4d, 0xe8, 0xf7, 0xc1, 0x3a, 0xbd, 0x55, 0xd5,
+		0x1f, 0x7e, 0xab, 0xea, 0xb7, 0x7b, 0xe0, 0x30, 0xfd, 0x56, 0xf4, 0x65, 0x9f, 0x4f, 0x73, 0x0f,
+		0x2a, 0x6d, 0xc3, 0x35, 0x2c, 0xf2, 0x6b, 0xcd, 0xb0, 0xcd, 0xb6, 0xae, 0x9a, 0x96, 0xaa, 0x6b,
+		0x5b, 0x34, 0xf6, 0xa2, 0x8f, 0x73, 0x58, 0xa6, 0x78, 0x0d, 0x16, 0x08, 0x7c, 0xcd, 0xaa, 0x68,
+		0x5b, 0xe4, 0x76, 0x8d, 0xb1, 0x6a, 0xba, 0xea, 0xaa, 0xe1, 0xda, 0x66, 0x93, 0xf0, 0xe0, 0x80,
+		0x02, 0xb8, 0xe8, 0x11, 0x29, 0x41, 0xd7, 0x20, 0x8f, 0xb3, 0x89, 0x99, 0x38, 0x73, 0xcd, 0xb3,
+		0xf5, 0xe6, 0x8a, 0xe1, 0x86, 0x1f, 0x54, 0x40, 0x5e, 0xdd, 0x0c, 0xa9, 0x22, 0xa1, 0xe1, 0x73,
+		0x70, 0xd0, 0x6f, 0x11, 0x79, 0xa1, 0x8a, 0x9f, 0xd9, 0xab, 0xc4, 0x60, 0x19, 0x73, 0x87, 0xb5,
+		0xc8, 0x7f, 0x1c, 0xfa, 0xf0, 0x4c, 0xd3, 0x55, 0x92, 0xc6, 0xc3, 0xf4, 0xcf, 0xea, 0xf8, 0xa1,
+		0x0f, 0x33, 0x9a, 0x3e, 0xc3, 0xe0, 0x94, 0x81, 0x67, 0xc1, 0x1f, 0xf9, 0x1f, 0x4b, 0x30, 0x10,
+		0xaa, 0x44, 0x9f, 0x80, 0x03, 0x7e, 0x87, 0x54, 0x27, 0x27, 0x3b, 0x75, 0x38, 0xe9, 0xfd, 0xa0,
+		0xfc, 0xf7, 0xdb, 0x1f, 0x57, 0x61, 0x28, 0x52, 0xc5, 0xe1, 0xf9, 0xad, 0x30, 0xcf, 0x07, 0xa6,
+		0xe4, 0xd4, 0x6f, 0x6d, 0x91, 0x04, 0x67, 0x21, 0xb9, 0x68, 0xc3, 0x50, 0xa4, 0x4e, 0x18, 0xdb,
+		0x74, 0x1c, 0x0e, 0xb4, 0xd7, 0x0c, 0x9b, 0xe4, 0x09, 0x62, 0x77, 0xee, 0xbd, 0xff, 0xfc, 0xb4,
+		0x64, 0x05, 0x6e, 0x5a, 0x32, 0xf9, 0xdf, 0x4a, 0x90, 0x5b, 0x5a, 0xd3, 0x35, 0xd7, 0x08, 0xe9,
+		0x6e, 0x4c, 0xd9, 0xa0, 0xa3, 0xb2, 0xe5, 0x13, 0xca, 0x56, 0x66, 0xca, 0x56, 0x20, 0x14, 0xbf,
+		0xca, 0xa5, 0x42, 0xfc, 0xbb, 0x71, 0x95, 0xdb, 0xbe, 0x7a, 0x55, 0xe0, 0x74, 0xb9, 0xb5, 0xee,
+		0xb8, 0x86, 0xad, 0x18, 0x6b, 0x2d, 0xb3, 0xa9, 0x25, 0xdf, 0xd0, 0x1a, 0xc3, 0x0f, 0xab, 0x11,
+		0x90, 0xf0, 0x5d, 0xb3, 0x01, 0x56, 0x86, 0x85, 0x1b, 0xa7, 0x35, 0x2b, 0x50, 0xec, 0x84, 0xbd,
+		0x4c, 0xc2, 0x61, 0xb2, 0x03, 0x6b, 0xa8, 0x9c, 0xce, 0x0e, 0xd1, 0xaa, 0x72, 0xd0, 0x25, 0x5a,
+		0x80, 0x03, 0x0c, 0x10, 0xdb, 0x40, 0x4c, 0x9a, 0x1b, 0x7c, 0x1f, 0x28, 0x1d, 0x7b, 0xc5, 0xef,
+		0x45, 0xfe, 0x6e, 0x1f, 0x1c, 0x51, 0x8c, 0xe7, 0x26, 0xfe, 0xe7, 0x21, 0x4b, 0x96, 0xd4, 0x5c,
+		0x0b, 0x1c, 0xe3, 0x6c, 0xbe, 0x23, 0x67, 0x0b, 0x09, 0xce, 0x76, 0x69, 0xa2, 0x8a, 0x3b, 0x31,
+		0x51, 0x53, 0x09, 0x13, 0x15, 0x26, 0xe0, 0xf4, 0x6e, 0x10, 0x50, 0xc4, 0xc2, 0x59, 0x11, 0x0b,
+		0x1f, 0x30, 0xc9, 0x5e, 0x48, 0xf9, 0x3a, 0x97, 0x21, 0x89, 0x19, 0xe5, 0x1c, 0x0c, 0x3b, 0x46,
+		0x73, 0xdd, 0x26, 0x07, 0x05, 0xed, 0x15, 0xc3, 0x7f, 0x55, 0xc3, 0x2b, 0x6d, 0xe0, 0x42, 0x9e,
+		0x8d, 0xd5, 0xb7, 0x6f, 0x63, 0x45, 0x36, 0xde, 0x12, 0xda, 0xf8, 0x22, 0xe4, 0x4c, 0x47, 0x7d,
+		0xde, 0x6a, 0x3f, 0xd3, 0x5a, 0x5e, 0x8e, 0xde, 0x4d, 0xc2, 0x98, 0x61, 0xd3, 0xb9, 0x4f, 0x8a,
+		0xe9, 0x38, 0xb7, 0xaf, 0xaf, 0x4f, 0x00, 0xe1, 0x9b, 0x09, 0xb4, 0x1b, 0xc7, 0x13, 0xe0, 0x13,
+		0xd0, 0xbf, 0xa6, 0x3d, 0x37, 0x54, 0xc7, 0xfc, 0x8c, 0xc1, 0xa6, 0xba, 0x03, 0xb8, 0x60, 0xd1,
+		0xfc, 0x8c, 0x81, 0xce, 0xc3, 0x41, 0xcb, 0xd8, 0x74, 0x55, 0x02, 0x41, 0xa9, 0x47, 0x83, 0x3c,
+		0x87, 0x70, 0xf1, 0x82, 0xf6, 0xdc, 0x20, 0xd4, 0xc3, 0x0f, 0xa1, 0x1e, 0x8e, 0xf4, 0xed, 0xac,
+		0xb5, 0x2d, 0xc7, 0x40, 0x55, 0xd8, 0x4f, 0xc7, 0xe2, 0xcd, 0x0a, 0x97, 0x04, 0x27, 0xe6, 0x58,
+		0x35, 0x9e, 0x19, 0x1e, 0x27, 0x69, 0x6b, 0xc5, 0x6b, 0x9b, 0x19, 0x8d, 0x77, 0xe1, 0x48, 0xbc,
+		0x2b, 0xb1, 0x96, 0x7a, 0xae, 0x46, 0x3e, 0x70, 0x35, 0xe4, 0xef, 0xf4, 0xc2, 0x51, 0x3e, 0x32,
+		0xe8, 0xe3, 0x30, 0xe0, 0xbd, 0xcf, 0x68, 0x2d, 0xb7, 0x59, 0x1a, 0xb4, 0xd3, 0x1d, 0xfc, 0x1b,
+		0x05, 0x74, 0xff, 0x37, 0xaa, 0xc3, 0x50, 0x33, 0xac, 0x1e, 0x2c, 0x9c, 0xad, 0x98, 0xd2, 0x47,
+		0x54, 0x9d, 0xa2, 0xcd, 0xd1, 0x1a, 0x8c, 0xda, 0x81, 0xe6, 0xa9, 0xd1, 0xbe, 0x0b, 0x29, 0xe9,
+		0xce, 0xd3, 0xcd, 0xad, 0x32, 0x62, 0x0b, 0x6a, 0xd0, 0x45, 0xc8, 0xe1, 0xf5, 0x4d, 0xfb, 0xa5,
+		0x61, 0xfb, 0x69, 0x9d, 0x58, 0x26, 0x4e, 0xaf, 0x9c, 0x65, 0x75, 0xe2, 0xca, 0xf3, 0x14, 0x4f,
+		0x9e, 0xd1, 0xa3, 0x50, 0xa7, 0xec, 0x65, 0x4c, 0x66, 0x74, 0x64, 0xe1, 0xd3, 0x06, 0x18, 0x98,
+		0xfa, 0xf5, 0xfe, 0x87, 0x99, 0xb7, 0x2f, 0xff, 0x03, 0x09, 0x86, 0x22, 0x20, 0x24, 0x1d, 0x95,
+		0xf7, 0x81, 0x60, 0xaa, 0x66, 0xb7, 0xa6, 0xbc, 0x1a, 0x3f, 0x29, 0xe8, 0x18, 0x0c, 0x2e, 0xdb,
+		0xed, 0x55, 0xcf, 0x4e, 0x79, 0xd6, 0x1b, 0x97, 0x31, 0x03, 0x85, 0xd3, 0xed, 0x90, 0x2b, 0x36,
+		0x14, 0x80, 0xa5, 0xdb, 0x71, 0xdb, 0x5e, 0x75, 0xd8, 0x6b, 0x28, 0xc6, 0xbc, 0x06, 0x41, 0xc2,
+		0x2d, 0xf9, 0xe7, 0xf7, 0xc2, 0xe1, 0xf0, 0x4c, 0x9d, 0x26, 0xb8, 0x0f, 0x60, 0x70, 0x9d, 0x80,
+		0xea, 0x54, 0x14, 0xf3, 0x29, 0xb9, 0x6c, 0xe3, 0xb3, 0xbf, 0x32, 0xc0, 0x9a, 0xf2, 0x25, 0xb2,
+		0xf0, 0x0a, 0x25, 0xb2, 0xf8, 0x2a, 0x24, 0x32, 0x69, 0xdd, 0xa7, 0x78, 0xd6, 0x7d, 0x02, 0x0e,
+		0xe9, 0x06, 0x79, 0x00, 0xc4, 0x77, 0x7d, 0xbd, 0x8c, 0xcc, 0x07, 0x69, 0x85, 0xef, 0x10, 0xe2,
+		0x4c, 0x5a, 0xbe, 0xb8, 0xe8, 0x36, 0x56, 0x78, 0x2f, 0x52, 0x38, 0x94, 0x67, 0x92, 0xde, 0xa4,
+		0x3a, 0xe5, 0x01, 0x56, 0x30, 0x1c, 0x8b, 0x13, 0x0e, 0xf2, 0x4c, 0x5e, 0x82, 0x43, 0x7e, 0x4f,
+		0xbe, 0x44, 0x2c, 0x90, 0xaf, 0xfa, 0x72, 0x37, 0xef, 0x49, 0x06, 0x4b, 0x11, 0x49, 0x80, 0x23,
+		0xcf, 0x3f, 0x0d, 0x7b, 0xc5, 0x34, 0x97, 0xa4, 0xfc, 0x47, 0x3d, 0x90, 0x8f, 0x8a, 0xca, 0x1b,
+		0x0b, 0xf5, 0x4a, 0x2d, 0x94, 0xbc, 0x88, 0x27, 0x85, 0x35, 0xdb, 0x68, 0x66, 0x52, 0xcf, 0xa4,
+		0x48, 0xe6, 0x39, 0x22, 0x29, 0x7f, 0x7d, 0x3f, 0x9c, 0x22, 0x7b, 0x04, 0xc9, 0xc0, 0x65, 0xd6,
+		0xf9, 0x9b, 0xeb, 0x8f, 0xaf, 0xe5, 0xfa, 0x63, 0x38, 0xd7, 0xc4, 0x42, 0x6a, 0xa2, 0xb5, 0xa7,
+		0xf1, 0x44, 0x6b, 0x1f, 0xd6, 0xc3, 0x56, 0x91, 0xeb, 0x99, 0xd6, 0x76, 0xae, 0x67, 0x7e, 0x88,
+		0xf7, 0x1a, 0x27, 0x59, 0xba, 0xf6, 0xf7, 0xa5, 0x6c, 0xf9, 0xda, 0x17, 0x79, 0xf9, 0xda, 0xbf,
+		0x20, 0xed, 0x2c, 0x61, 0x7b, 0x70, 0xb9, 0xf2, 0xcb, 0x5d, 0x5c, 0xae, 0xbc, 0x09, 0x05, 0x91,
+		0x8a, 0x32, 0x9b, 0x1b, 0xa4, 0x3c, 0x87, 0xf0, 0x03, 0xf3, 0xbf, 0x2c, 0xc1, 0xf1, 0x85, 0x76,
+		0xab, 0x35, 0xdb, 0xb6, 0xc3, 0xd1, 0xa0, 0x9d, 0x34, 0x7b, 0x27, 0x2f, 0x5b, 0xa4, 0xa4, 0x48,
+		0xe1, 0x25, 0x73, 0x2d, 0x72, 0x93, 0xb9, 0xfe, 0x5e, 0x1f, 0x9c, 0xe0, 0xe2, 0xcd, 0x86, 0x8b,
+		0x3d, 0x1f, 0x8c, 0x20, 0xb5, 0x6b, 0xf4, 0x52, 0x12, 0x41, 0x99, 0x4e, 0xb3, 0x1f, 0xf1, 0x5b,
+		0x1b, 0xf8, 0xcd, 0x11, 0xdb, 0x78, 0x69, 0xb6, 0xd7, 0x1d, 0x55, 0x90, 0x94, 0xec, 0xa8, 0x07,
+		0xb0, 0x18, 0xcd, 0x79, 0x95, 0xf9, 0x0a, 0x47, 0x38, 0xd9, 0xe2, 0x5b, 0x91, 0x64, 0xc5, 0xd8,
+		0x0f, 0xc5, 0x79, 0xa9, 0x5b, 0xed, 0xe7, 0x6a, 0xb3, 0xbd, 0x6e, 0xb9, 0xea, 0x0b, 0xd3, 0x72,
+		0xc9, 0xa3, 0x1b, 0xbd, 0x4a, 0x8e, 0xd5, 0x94, 0x71, 0xc5, 0x03, 0xd3, 0x72, 0xd1, 0xdb, 0xb0,
+		0x3f, 0xf0, 0x86, 0xf1, 0x70, 0x4f, 0xa6, 0x6d, 0x72, 0x2b, 0x1e, 0x30, 0x6f, 0xad, 0x34, 0xcb,
+		0x59, 0x2b, 0xe1, 0x2d, 0xb4, 0x1f, 0x5e, 0x37, 0xec, 0x2d, 0x16, 0x0f, 0x2f, 0xa7, 0x12, 0xf3,
+		0x93, 0x18, 0x52, 0xa1, 0x0d, 0xd0, 0x0f, 0xc1, 0x49, 0xde, 0x49, 0x94, 0x2f, 0xb8, 0x4f, 0xb3,
+		0x08, 0xee, 0x68, 0x32, 0x20, 0xc9, 0x93, 0xe4, 0xab, 0x70, 0x38, 0x88, 0x75, 0x0e, 0xae, 0xb8,
+		0xd0, 0x3b, 0xe1, 0x41, 0xee, 0xb9, 0xe0, 0x0d, 0x8a, 0x4b, 0x70, 0xc8, 0x63, 0x4e, 0x00, 0x6e,
+		0x51, 0xba, 0xb2, 0x0a, 0x1f, 0x58, 0xfe, 0x40, 0x82, 0xd1, 0x45, 0xd7, 0x6c, 0xae, 0x6c, 0xf9,
+		0x5f, 0x0e, 0xd9, 0x89, 0xfb, 0x90, 0xc3, 0x88, 0x19, 0x76, 0x68, 0x3c, 0x99, 0x32, 0x5e, 0x0f,
+		0xd3, 0x66, 0xfe, 0x20, 0x32, 0xe5, 0x63, 0xcd, 0x67, 0xc8, 0xc7, 0x2a, 0x7f, 0xaf, 0x17, 0xc6,
+		0xa9, 0x02, 0xea, 0xdc, 0xe0, 0x7b, 0xcf, 0xa8, 0x74, 0xd0, 0xcd, 0xbb, 0xd0, 0xef, 0x45, 0x9d,
+		0x7b, 0xbb, 0x62, 0xa7, 0x52, 0xc3, 0xd7, 0x95, 0x00, 0x9e, 0x9f, 0xae, 0xba, 0x20, 0x48, 0x57,
+		0x9d, 0x96, 0x70, 0xf8, 0xd3, 0x98, 0x55, 0x98, 0xf8, 0xc9, 0xab, 0x36, 0x93, 0x82, 0x80, 0x06,
+		0x01, 0xab, 0x30, 0x6b, 0x71, 0x55, 0x88, 0x79, 0x37, 0x61, 0xc4, 0x36, 0xdc, 0x75, 0xdb, 0x22,
+		0x31, 0x2b, 0x91, 0x40, 0x7e, 0xf6, 0xba, 0xc3, 0x11, 0x5a, 0x5f, 0x37, 0x36, 0xc2, 0xa4, 0xc4,
+		0x29, 0x6f, 0x97, 0xdb, 0x76, 0xd3, 0x50, 0xe9, 0xc6, 0x2d, 0xa7, 0x39, 0x4d, 0xab, 0x72, 0x9c,
+		0x40, 0x95, 0x09, 0x50, 0xbc, 0x0f, 0x8e, 0x8d, 0x5d, 0xe0, 0xda, 0xd8, 0x1f, 0x85, 0xb3, 0xe9,
+		0xec, 0x64, 0xb6, 0x76, 0x09, 0x86, 0xa2, 0x38, 0x40, 0x4a, 0x5c, 0x74, 0x8a, 0xd1, 0x0e, 0x3d,
+		0x11, 0xa9, 0x39, 0x2b, 0xf2, 0x3f, 0x91, 0xe0, 0x0c, 0xe7, 0xfb, 0xf4, 0xb4, 0x30, 0xa3, 0x2c,
+		0xcd, 0x84, 0xb3, 0xeb, 0xed, 0x3c, 0x61, 0x75, 0x21, 0x73, 0xc2, 0x6a, 0xf9, 0xf7, 0x83, 0x89,
+		0x35, 0x1c, 0xb9, 0xfb, 0xba, 0x26, 0xd6, 0x45, 0x40, 0x7e, 0xbf, 0x78, 0x3b, 0x55, 0x23, 0x9b,
+		0x96, 0xc5, 0x14, 0x5f, 0xc5, 0xfb, 0xc0, 0x23, 0x06, 0xac, 0xe4, 0xdc, 0x58, 0x89, 0xfc, 0x27,
+		0xfb, 0xe0, 0x04, 0x77, 0x8c, 0xaf, 0x75, 0x12, 0x8e, 0xe5, 0x3f, 0x2d, 0x74, 0xce, 0xbe, 0x5d,
+		0xdc, 0x5e, 0xf6, 0x6d, 0xfe, 0xca, 0x41, 0x30, 0x29, 0xcc, 0x0a, 0x27, 0x85, 0x4c, 0xa9, 0xb5,
+		0x17, 0xb2, 0xa4, 0xd6, 0xe6, 0x4e, 0x2f, 0x4f, 0xf9, 0xd3, 0x4b, 0x86, 0xec, 0xd9, 0xfa, 0xce,
+		0xb2, 0x67, 0x5b, 0xe9, 0xd9, 0xb3, 0x43, 0xee, 0xc7, 0x66, 0xf4, 0x71, 0xa7, 0x47, 0x30, 0xce,
+		0xa1, 0xa0, 0xda, 0x5e, 0x56, 0xdd, 0x17, 0xa6, 0xa3, 0x7a, 0xad, 0x88, 0x0f, 0xdf, 0xab, 0x9c,
+		0x4e, 0x92, 0x74, 0x7e, 0xb9, 0xf1, 0xc2, 0x74, 0x4a, 0xbe, 0x37, 0x73, 0x28, 0x40, 0xd2, 0xd3,
+		0x64, 0xe2, 0xe3, 0x0f, 0x2a, 0x39, 0xbf, 0xc6, 0x7b, 0xd7, 0xe2, 0x7e, 0xdc, 0x85, 0xfb, 0xb2,
+		0x94, 0x22, 0x1d, 0x29, 0x3e, 0x5c, 0x11, 0x0e, 0xc6, 0x9f, 0xb2, 0xfb, 0x1a, 0x5d, 0x75, 0x0c,
+		0x6f, 0x44, 0x5f, 0xb1, 0x0b, 0x5c, 0xfe, 0x6f, 0x76, 0xe1, 0xf2, 0x6f, 0x81, 0x4c, 0xe3, 0xcb,
+		0xc3, 0xaa, 0xf7, 0xc0, 0x1b, 0x4e, 0x46, 0xfb, 0xb8, 0xbd, 0x44, 0x86, 0xdf, 0x97, 0xe0, 0x7c,
+		0xca, 0xb7, 0x67, 0xb6, 0x6a, 0x95, 0x1d, 0x6f, 0x0d, 0x04, 0xeb, 0x95, 0x42, 0xf8, 0x89, 0xa6,
+		0x98, 0x72, 0x17, 0x13, 0xca, 0x1d, 0x1a, 0xd1, 0x94, 0x78, 0x44, 0xb1, 0x37, 0xce, 0xe4, 0x05,
+		0x18, 0x4f, 0x19, 0x90, 0x6f, 0xd0, 0x2e, 0x42, 0x2e, 0x1e, 0x38, 0x4b, 0xc6, 0x75, 0x40, 0x39,
+		0xd8, 0x8c, 0xc6, 0xaf, 0xca, 0x9b, 0xbe, 0x2f, 0xc4, 0xbd, 0xb2, 0x95, 0x91, 0x3f, 0x41, 0x8a,
+		0x90, 0x7c, 0x24, 0x45, 0x48, 0x1a, 0x77, 0x7e, 0x3a, 0x98, 0x37, 0x93, 0xd7, 0x53, 0xba, 0xf9,
+		0x2e, 0xd9, 0xda, 0xcb, 0x8b, 0x72, 0x68, 0x74, 0x31, 0x17, 0x12, 0x51, 0x4d, 0xd2, 0x82, 0x05,
+		0xbe, 0xbd, 0x52, 0x51, 0xfd, 0x4d, 0x09, 0x2e, 0xa4, 0xf1, 0xe1, 0xb5, 0xca, 0x6a, 0xc0, 0xdd,
+		0x29, 0x21, 0x77, 0xe3, 0x92, 0xfa, 0x9f, 0x25, 0x38, 0xcb, 0x19, 0x10, 0xe5, 0xee, 0xeb, 0x1f,
+		0x0d, 0xe7, 0x89, 0x8e, 0x10, 0xe3, 0xa6, 0xc5, 0x8c, 0x9b, 0xe5, 0xda, 0x18, 0xa1, 0xd0, 0xfc,
+		0x7f, 0x68, 0x63, 0xfe, 0xb5, 0x04, 0xe7, 0x22, 0xf7, 0x01, 0xba, 0xde, 0x4f, 0x7d, 0x45, 0x0e,
+		0x53, 0x9a, 0xdf, 0x18, 0xdd, 0x47, 0x2c, 0xc6, 0x9f, 0x11, 0xfa, 0x6f, 0x3d, 0x20, 0xdf, 0x37,
+		0x92, 0xdb, 0x4e, 0xde, 0x62, 0xbf, 0xc3, 0x80, 0x2a, 0xd0, 0xbf, 0xdd, 0x71, 0x04, 0x0d, 0xf1,
+		0x99, 0xc9, 0xaa, 0xb6, 0x69, 0xae, 0xae, 0xaf, 0xaa, 0xc1, 0x01, 0x71, 0x81, 0xf8, 0x14, 0x07,
+		0x59, 0xc5, 0x42, 0xca, 0x39, 0x71, 0x91, 0xb7, 0xe9, 0x70, 0x09, 0xd0, 0x86, 0x66, 0xba, 0xea,
+		0x72, 0xdb, 0x0e, 0xee, 0x15, 0xb0, 0x5d, 0xf7, 0x83, 0xb8, 0x66, 0xb6, 0x6d, 0x7b, 0x11, 0xff,
+		0x68, 0x19, 0x46, 0xd9, 0xa6, 0x06, 0x85, 0x53, 0x97, 0x49, 0x24, 0x1d, 0xf5, 0x1f, 0xa6, 0x53,
+		0x9e, 0xed, 0x0a, 0x07, 0xfe, 0xd1, 0xe8, 0x3b, 0xe2, 0x48, 0x1c, 0x7d, 0xc1, 0x2d, 0x97, 0xbf,
+		0x2e, 0xc1, 0x78, 0x2a, 0xb5, 0xd9, 0x34, 0x15, 0xda, 0x91, 0x81, 0x1d, 0xee, 0xc8, 0xf0, 0x4e,
+		0xaf, 0xb1, 0xc0, 0xd0, 0xc0, 0x00, 0x83, 0x6a, 0xce, 0x01, 0xc5, 0xff, 0x2f, 0xff, 0xcd, 0x1e,
+		0x28, 0xd0, 0xc0, 0xc8, 0x8f, 0x8a, 0x78, 0xc7, 0x12, 0x9a, 0x16, 0xc4, 0x09, 0x4d, 0x8b, 0xa2,
+		0x2c, 0xd2, 0x53, 0xa9, 0x5a, 0x31, 0xcd, 0x79, 0xc6, 0xc4, 0x4b, 0xf3, 0x36, 0x1b, 0x4d, 0x30,
+		0xf9, 0x2f, 0xf6, 0xc3, 0x05, 0x46, 0x1d, 0xd3, 0x7d, 0xf1, 0xe6, 0x54, 0xe5, 0xcd, 0xa9, 0x4a,
+		0xd7, 0xa7, 0x2a, 0x31, 0x71, 0xb6, 0x12, 0xe2, 0x3c, 0x06, 0x83, 0x0c, 0x80, 0x72, 0x80, 0xbe,
+		0xc7, 0xc7, 0x1a, 0xd5, 0x08, 0x1f, 0x46, 0x03, 0x01, 0xfd, 0xac, 0x14, 0x4d, 0x44, 0x58, 0x89,
+		0x9d, 0xb7, 0xbc, 0x2f, 0xed, 0xca, 0x81, 0xcb, 0x97, 0xd3, 0x0e, 0x5c, 0xbe, 0xb6, 0x93, 0x03,
+		0x97, 0xaf, 0xef, 0xda, 0x81, 0x4b, 0x37, 0xab, 0xaf, 0xdf, 0x93, 0x60, 0xcc, 0xbf, 0x52, 0xf0,
+		0x51, 0xb1, 0x74, 0x81, 0x07, 0x56, 0x10, 0x79, 0x60, 0x45, 0xb1, 0xbf, 0x12, 0x7f, 0xca, 0xe4,
+		0xff, 0x4a, 0x70, 0x8a, 0x3c, 0xb0, 0xfb, 0x51, 0x1f, 0xde, 0x4d, 0x18, 0xf1, 0xf7, 0x1f, 0x97,
+		0x4d, 0xcb, 0x74, 0x5e, 0xc4, 0x0f, 0x4b, 0x8e, 0x78, 0xf5, 0xb3, 0xa4, 0xda, 0x3b, 0x01, 0x89,
+		0xaa, 0xf3, 0x54, 0xdc, 0xb9, 0xb9, 0x09, 0x05, 0xd1, 0xf8, 0xd3, 0x0f, 0xd5, 0x3e, 0xd7, 0x0b,
+		0x63, 0xd8, 0x0a, 0xce, 0xaf, 0x19, 0x56, 0xa2, 0xb1, 0xd3, 0x89, 0x7a, 0x5c, 0x77, 0x26, 0x9f,
+		0xd9, 0x9d, 0x29, 0xf0, 0x66, 0xec, 0x05, 0xb6, 0x33, 0x44, 0xc3, 0x8a, 0xa8, 0x7b, 0xc2, 0x4c,
+		0xfa, 0x59, 0xf1, 0xf5, 0xbc, 0xe0, 0x52, 0x80, 0x72, 0xd0, 0x89, 0x16, 0xa0, 0xc7, 0x90, 0x0b,
+		0xdd, 0x25, 0xa1, 0x1d, 0xd2, 0xed, 0xf1, 0xcb, 0x19, 0x33, 0x3d, 0xb1, 0x8e, 0x8d, 0x68, 0x01,
+		0x7a, 0x00, 0x03, 0x78, 0xd2, 0xf2, 0xfa, 0xa4, 0x47, 0x4a, 0x17, 0x3a, 0xce, 0x5d, 0xac, 0x3b,
+		0x70, 0xfd, 0xdf, 0xf2, 0x57, 0x24, 0x90, 0xd3, 0xd8, 0xc0, 0x98, 0xf8, 0x09, 0x00, 0x1f, 0x07,
+		0x2f, 0xfa, 0x6f, 0x22, 0xdb, 0x18, 0x68, 0x5c, 0x4a, 0xd0, 0x3a, 0x73, 0xfc, 0xdf, 0xef, 0xf6,
+		0xc2, 0x38, 0x46, 0x8d, 0x4c, 0x40, 0xfa, 0x1b, 0x19, 0x79, 0x5d, 0x32, 0x82, 0xde, 0x83, 0x21,
+		0x1a, 0x44, 0xeb, 0xf5, 0x35, 0x4b, 0xe6, 0xe9, 0xeb, 0x19, 0xaf, 0x0b, 0x63, 0xf6, 0xb1, 0xc8,
+		0xda, 0x41, 0xda, 0x0f, 0x93, 0xbd, 0xaf, 0x4a, 0x70, 0x36, 0x9d, 0xc1, 0xaf, 0x51, 0xfa, 0xbe,
+		0x24, 0xc1, 0x29, 0x8c, 0x5c, 0xf7, 0x72, 0x17, 0x89, 0xc1, 0xcd, 0x77, 0x8e, 0xc1, 0xe5, 0x0a,
+		0x5a, 0xde, 0x3b, 0xd0, 0xa5, 0xcb, 0x49, 0xfa, 0x47, 0xfe, 0xab, 0x12, 0x14, 0x44, 0x48, 0xbd,
+		0x46, 0x5a, 0xd5, 0xa1, 0x40, 0x8e, 0xba, 0xbb, 0xa7, 0x95, 0x3f, 0xcc, 0x7c, 0x78, 0x98, 0x37,
+		0xe1, 0xb4, 0xb0, 0x3f, 0x36, 0x4c, 0xfc, 0xc8, 0x12, 0x06, 0x61, 0x91, 0x9f, 0xf4, 0x8f, 0xfc,
+		0x3d, 0x09, 0x4e, 0xdc, 0x37, 0xdc, 0x84, 0x47, 0xe3, 0xb5, 0xaa, 0xc3, 0xde, 0x15, 0x63, 0xcb,
+		0x23, 0xcb, 0x1d, 0x2e, 0x59, 0x52, 0xda, 0x4f, 0x3e, 0x34, 0xb6, 0xd8, 0x05, 0x17, 0xd2, 0xcf,
+		0xf1, 0x1f, 0x82, 0x7e, 0xbf, 0x88, 0x13, 0xbd, 0x7d, 0x37, 0x1c, 0xbd, 0x3d, 0x2c, 0x70, 0xbf,
+		0x6a, 0x96, 0x6e, 0x6c, 0x1a, 0xfa, 0x7b, 0x18, 0x90, 0x2c, 0x30, 0x42, 0x41, 0xde, 0x1f, 0x48,
+		0x90, 0x27, 0xa7, 0xf5, 0x1e, 0x25, 0x3e, 0x9c, 0xcd, 0x02, 0x3f, 0x9a, 0xa0, 0xd0, 0x65, 0x34,
+		0x81, 0x7c, 0x07, 0x8e, 0xc4, 0xf0, 0x65, 0x94, 0x1f, 0x83, 0x41, 0x02, 0xa1, 0x46, 0xb2, 0x39,
+		0x0f, 0x90, 0x32, 0xfa, 0xee, 0xb5, 0xfc, 0x08, 0x86, 0x22, 0x7d, 0x62, 0xd7, 0x83, 0xb6, 0xf1,
+		0x93, 0xba, 0xf6, 0x2b, 0xfd, 0xa4, 0x84, 0x2c, 0xbd, 0xfc, 0x6a, 0xcd, 0x7e, 0xee, 0xed, 0x77,
+		0xd2, 0xea, 0x92, 0xfd, 0xdc, 0x91, 0x3f, 0x03, 0xc7, 0x89, 0x67, 0x42, 0xcf, 0x9c, 0xfd, 0xe5,
+		0xd7, 0x87, 0x41, 0x40, 0xf9, 0x14, 0x9c, 0xe0, 0x7e, 0x9b, 0x12, 0x43, 0xfe, 0x8f, 0xc1, 0xee,
+		0x33, 0x19, 0xe9, 0x76, 0x76, 0xbd, 0x15, 0x18, 0x0e, 0x2e, 0xbc, 0xfb, 0xef, 0xe3, 0x8a, 0x36,
+		0x51, 0x92, 0x9f, 0x21, 0xa2, 0x36, 0xd4, 0x0c, 0xff, 0x4d, 0x30, 0xa9, 0x90, 0x60, 0x12, 0xb9,
+		0xf8, 0x68, 0xdb, 0x6d, 0x5b, 0x5d, 0x35, 0x1c, 0x47, 0x7b, 0xee, 0xbd, 0x7d, 0x32, 0x48, 0x0a,
+		0x1f, 0xd1, 0x32, 0xf9, 0xc7, 0x24, 0x38, 0xe3, 0x05, 0xde, 0x77, 0xed, 0x18, 0xef, 0x0e, 0x07,
+		0x7e, 0x7c, 0x2f, 0x1c, 0x5e, 0x30, 0x2c, 0xdd, 0xb4, 0x9e, 0x7b, 0x5b, 0xa3, 0x24, 0x28, 0xf6,
+		0x43, 0x7b, 0x76, 0xf8, 0x5d, 0xe8, 0xc3, 0x93, 0x9d, 0x97, 0xad, 0x95, 0x7f, 0x77, 0x32, 0x86,
+		0x21, 0x9e, 0x25, 0x0d, 0x85, 0xb6, 0xc3, 0x07, 0x95, 0xc9, 0x23, 0xb9, 0xa2, 0xe0, 0x44, 0xee,
+		0x16, 0x8c, 0x90, 0x97, 0xe6, 0xa3, 0x27, 0x8d, 0xf4, 0x70, 0x93, 0x46, 0x36, 0x91, 0x97, 0xe8,
+		0x1f, 0x84, 0xcf, 0x19, 0x49, 0xad, 0xff, 0x46, 0x7d, 0xf2, 0x50, 0x94, 0xe6, 0x05, 0x26, 0x6f,
+		0xd4, 0x2f, 0xc6, 0x0f, 0x46, 0x43, 0x07, 0x93, 0xb3, 0xd1, 0x83, 0xc9, 0x8b, 0x90, 0xf3, 0xbc,
+		0x2e, 0x56, 0xe4, 0x1d, 0xcc, 0x7a, 0x4e, 0x17, 0x3b, 0x73, 0x74, 0x44, 0xa7, 0xc0, 0x4f, 0x85,
+		0xa7, 0xc0, 0xd7, 0x21, 0x6f, 0x6c, 0xae, 0x99, 0x34, 0x3a, 0x38, 0x11, 0x4c, 0x74, 0x38, 0xa8,
+		0x0b, 0x02, 0x84, 0x7e, 0x93, 0xbc, 0x05, 0x2e, 0x14, 0x45, 0x66, 0x9d, 0x5a, 0x70, 0x2c, 0x12,
+		0x11, 0x13, 0x0a, 0x6c, 0x86, 0x94, 0xc0, 0x66, 0x5e, 0x6a, 0x94, 0xa0, 0xa9, 0x72, 0xd4, 0xe0,
+		0x96, 0xa3, 0x67, 0x70, 0x8c, 0x7b, 0x77, 0xde, 0x8f, 0xfe, 0xef, 0x66, 0xbe, 0x3e, 0xb2, 0xc1,
+		0x2b, 0x46, 0x8f, 0x01, 0xad, 0x51, 0xe1, 0xf2, 0xf2, 0xbf, 0x99, 0x86, 0xc3, 0xae, 0x16, 0x16,
+		0xb3, 0xc8, 0x22, 0xe9, 0xfc, 0xd0, 0x5a, 0xa4, 0x10, 0xdf, 0x15, 0xfd, 0x23, 0x09, 0x8e, 0x79,
+		0x04, 0xcd, 0x6a, 0x54, 0x77, 0x12, 0xb0, 0x71, 0x1f, 0x86, 0xfd, 0xb6, 0xe1, 0xf4, 0xc7, 0x63,
+		0xa9, 0x1d, 0x50, 0x85, 0x74, 0x43, 0xff, 0xf0, 0x0a, 0xd8, 0xb4, 0x9a, 0xad, 0x75, 0xdd, 0x08,
+		0xa2, 0xc1, 0xbc, 0xcb, 0x61, 0x45, 0x1a, 0x4f, 0xc4, 0xea, 0xbd, 0x4e, 0xa8, 0xd3, 0x2a, 0x7f,
+		0x43, 0x82, 0x91, 0xe4, 0x88, 0x99, 0xe4, 0xdc, 0x86, 0xfd, 0x6b, 0xed, 0x56, 0xcb, 0xb0, 0x3d,
+		0xa7, 0xe2, 0xb4, 0x30, 0xa2, 0xc7, 0xb0, 0x09, 0x4d, 0x3d, 0x78, 0x7c, 0x55, 0x26, 0x81, 0x08,
+		0x25, 0xce, 0x78, 0xea, 0xd8, 0xbc, 0x5b, 0x6a, 0x6e, 0x14, 0xcd, 0x3f, 0x90, 0x60, 0x38, 0x0a,
+		0x22, 0x88, 0x51, 0x04, 0x41, 0x8c, 0x22, 0x59, 0xe9, 0x6b, 0xba, 0xda, 0x32, 0x5e, 0x1a, 0x2d,
+		0x2f, 0x07, 0x35, 0x2e, 0x99, 0xc3, 0x05, 0xd8, 0xad, 0xd5, 0x9a, 0x2b, 0xac, 0x96, 0x5e, 0x8d,
+		0x3d, 0xa0, 0x35, 0x57, 0x68, 0xe5, 0x79, 0x38, 0x68, 0x6b, 0xae, 0x81, 0x6f, 0x32, 0xb2, 0xad,
+		0x44, 0x92, 0x69, 0x5a, 0x52, 0x86, 0x70, 0xf1, 0x82, 0x61, 0xd3, 0x9d, 0x43, 0x54, 0x81, 0x21,
+		0x96, 0x6f, 0x5a, 0x7d, 0xd6, 0x6a, 0x37, 0x57, 0x46, 0x8a, 0x29, 0x3b, 0x67, 0x78, 0x34, 0xb5,
+		0xca, 0x0c, 0x86, 0x53, 0x06, 0x68, 0x5e, 0x6a, 0xf2, 0x47, 0x7e, 0x17, 0x06, 0x42, 0x75, 0x38,
+		0xad, 0x36, 0x5d, 0x94, 0x05, 0x69, 0xb5, 0xc9, 0x7f, 0x7a, 0x7a, 0x65, 0x58, 0x7a, 0xf0, 0xce,
+		0x46, 0x9f, 0x61, 0xe9, 0x35, 0x5d, 0xfe, 0x0c, 0x40, 0xc0, 0x11, 0x1c, 0x0e, 0x4a, 0x4c, 0xa0,
+		0xd6, 0x6c, 0x1a, 0x8e, 0x13, 0xbe, 0xcf, 0x3f, 0x8c, 0xcb, 0x4b, 0xa4, 0x98, 0xdc, 0xe8, 0x4f,
+		0x7b, 0xb6, 0x9b, 0x43, 0x82, 0x02, 0x87, 0x04, 0xf8, 0x38, 0x68, 0x20, 0xb4, 0x27, 0x88, 0x1f,
+		0x06, 0xa5, 0x69, 0x33, 0xf0, 0x5e, 0xa4, 0x6b, 0xd8, 0x2f, 0xc9, 0x0f, 0x7f, 0x43, 0x96, 0x5e,
+		0xe2, 0x1b, 0x61, 0x20, 0x35, 0x06, 0x11, 0x5c, 0x4b, 0xb9, 0x0a, 0x87, 0x31, 0x27, 0xdb, 0xcb,
+		0xcb, 0x6a, 0xb3, 0x6d, 0x2c, 0x2f, 0x9b, 0x4d, 0xd3, 0xb0, 0xa8, 0x96, 0x49, 0x0a, 0x62, 0x55,
+		0xe5, 0xa0, 0x06, 0x7f, 0xcf, 0x33, 0xd0, 0xbc, 0xef, 0xd1, 0x33, 0xa1, 0x11, 0x06, 0x92, 0xfc,
+		0x1e, 0xcf, 0xbe, 0x17, 0xf9, 0xf6, 0xfd, 0x1e, 0x9c, 0xb0, 0xe8, 0x3d, 0x57, 0xdb, 0xd4, 0x9e,
+		0xb5, 0x0c, 0x95, 0x7a, 0x0e, 0x74, 0x47, 0xca, 0x21, 0x49, 0x05, 0xfa, 0x95, 0x11, 0x8b, 0x5c,
+		0x6c, 0xa5, 0x10, 0x55, 0x0c, 0x40, 0x6f, 0xc6, 0xe0, 0xab, 0xed, 0x85, 0x90, 0xb5, 0xe7, 0xe1,
+		0x4a, 0xb7, 0xbf, 0x4f, 0x04, 0x50, 0x09, 0x74, 0x27, 0x3e, 0x90, 0xe0, 0x08, 0x77, 0xeb, 0x18,
+		0xdd, 0x82, 0x1b, 0x8f, 0xe7, 0x95, 0x87, 0xb3, 0x73, 0xf3, 0x8f, 0xd5, 0x5a, 0x45, 0x55, 0xaa,
+		0x4b, 0x8b, 0x55, 0x75, 0x61, 0x7e, 0xae, 0x56, 0x7e, 0xa2, 0x96, 0xe6, 0x70, 0x69, 0x65, 0x69,
+		0x61, 0xae, 0x56, 0x2e, 0x35, 0xaa, 0xea, 0x6c, 0xa9, 0x36, 0x57, 0xad, 0xa8, 0xf3, 0xf5, 0xb9,
+		0x27, 0xb9, 0x3d, 0xe8, 0x32, 0x14, 0xb3, 0xb6, 0xcc, 0x49, 0xe8, 0x0a, 0x5c, 0x14, 0x42, 0x2b,
+		0xd5, 0x4f, 0x54, 0xcb, 0x8d, 0x10, 0x78, 0xcf, 0x84, 0x01, 0x83, 0xe1, 0x6c, 0x0e, 0xe8, 0x24,
+		0x8c, 0x54, 0xe6, 0x1f, 0x95, 0x6a, 0x75, 0x75, 0xb1, 0x51, 0x6a, 0x2c, 0x2d, 0xaa, 0x4a, 0xf5,
+		0x7e, 0x6d, 0xb1, 0x51, 0x55, 0xaa, 0x95, 0xdc, 0x9e, 0x64, 0x6d, 0xa5, 0xba, 0xa0, 0x54, 0x71,
+		0x57, 0x95, 0x9c, 0x84, 0x46, 0xe1, 0x48, 0xbc, 0x76, 0xae, 0x8a, 0xab, 0x7a, 0x26, 0xbe, 0x24,
+		0xc1, 0x40, 0xe8, 0x29, 0x04, 0x74, 0x1a, 0x4e, 0x34, 0x6a, 0x8f, 0xaa, 0xf3, 0x4b, 0x0d, 0xb5,
+		0xf1, 0x64, 0xa1, 0x8a, 0x1b, 0x28, 0x0d, 0xb5, 0x31, 0xaf, 0x96, 0xe7, 0xe6, 0x17, 0xab, 0xb9,
+		0x3d, 0x48, 0x86, 0x42, 0x14, 0xa0, 0xfc, 0xa0, 0x5a, 0x59, 0x9a, 0xab, 0x62, 0x18, 0x02, 0x9c,
+		0x93, 0x52, 0x61, 0x68, 0x3f, 0x3d, 0xe8, 0x38, 0x1c, 0x8d, 0xc0, 0x3c, 0xa8, 0x96, 0x94, 0xc6,
+		0x4c, 0xb5, 0xd4, 0xc8, 0xf5, 0x4e, 0x7c, 0x6b, 0x2f, 0x0c, 0xfa, 0x81, 0x86, 0x34, 0x3e, 0xe8,
+		0x6c, 0xa5, 0x5a, 0xae, 0x2d, 0xd6, 0xe6, 0xeb, 0xb1, 0x1e, 0x4b, 0xe5, 0x46, 0xed, 0xbd, 0x5a,
+		0xe3, 0x89, 0xda, 0x28, 0x2d, 0x3e, 0xcc, 0xed, 0x41, 0x93, 0x30, 0x11, 0x85, 0x54, 0xaa, 0x9f,
+		0x5c, 0xaa, 0x2e, 0x36, 0xd4, 0x72, 0xa9, 0x5e, 0xae, 0xce, 0xc5, 0xe0, 0x25, 0x74, 0x0a, 0x46,
+		0x63, 0x3d, 0xd3, 0x01, 0xd7, 0x1e, 0x55, 0x95, 0x5c, 0x0f, 0x66, 0x5a, 0xb4, 0xba, 0x3c, 0xff,
+		0x68, 0x01, 0xd3, 0x4e, 0xf5, 0x79, 0x59, 0xfd, 0x54, 0xb5, 0xbc, 0xd4, 0xa8, 0xcd, 0xd7, 0x73,
+		0xbd, 0xe8, 0x22, 0x9c, 0x8b, 0x82, 0x63, 0x81, 0xe1, 0x81, 0xee, 0x45, 0x05, 0x38, 0x1e, 0xeb,
+		0x99, 0x22, 0x48, 0xbf, 0xdc, 0x87, 0x2e, 0xc1, 0x05, 0x6e, 0x3d, 0xa7, 0xb3, 0x7d, 0x68, 0x1a,
+		0x6e, 0xa5, 0x8e, 0xba, 0xfa, 0xa9, 0x46, 0x55, 0xa9, 0x97, 0xb8, 0xad, 0xf7, 0x63, 0x9e, 0xc7,
+		0x5b, 0x97, 0xe7, 0x95, 0x8a, 0xfa, 0xa8, 0xa4, 0x3c, 0xac, 0x2a, 0xb9, 0x03, 0xe8, 0x06, 0x5c,
+		0x8b, 0x53, 0xa1, 0xde, 0xa8, 0xd5, 0x97, 0xaa, 0x6a, 0x69, 0x51, 0xad, 0x57, 0x1f, 0xf3, 0xba,
+		0xed, 0x47, 0xd7, 0xe0, 0x32, 0x8f, 0xb4, 0xe5, 0x07, 0xb5, 0xb9, 0x0a, 0xaf, 0x05, 0x24, 0xbf,
+		0xb3, 0x58, 0xbb, 0x5f, 0x2f, 0xa5, 0xa3, 0x3f, 0x30, 0xf1, 0xfb, 0xc3, 0xd0, 0xef, 0x3f, 0x77,
+		0x80, 0x59, 0x50, 0x7d, 0xaf, 0x5a, 0x67, 0x52, 0x95, 0x6c, 0x40, 0xb1, 0x20, 0x4a, 0x73, 0x09,
+		0x2e, 0xa4, 0x83, 0x7a, 0xcc, 0xc6, 0x3a, 0x54, 0x84, 0xb3, 0xe9, 0xc0, 0xd4, 0x36, 0xe4, 0x7a,
+		0x3a, 0x77, 0x8b, 0x59, 0x5c, 0x51, 0xe7, 0x97, 0x1a, 0xb9, 0x5e, 0x74, 0x1e, 0xe4, 0x10, 0x70,
+		0x30, 0xfa, 0xd2, 0xe2, 0x43, 0x5f, 0xc8, 0x2b, 0xb9, 0xbd, 0xe8, 0x2c, 0x9c, 0x11, 0xc3, 0xb1,
+		0x11, 0xf5, 0xa5, 0xf6, 0x16, 0x0c, 0x66, 0x5f, 0x2a, 0x5c, 0x80, 0xdd, 0x7e, 0x34, 0x0e, 0xa7,
+		0x85, 0x70, 0x6c, 0xbc, 0x07, 0x62, 0x9d, 0x45, 0x14, 0x2c, 0x34, 0x84, 0xfe, 0xd8, 0x10, 0x62,
+		0x70, 0x6c, 0x08, 0x90, 0xda, 0x5b, 0x30, 0x84, 0x81, 0x18, 0x6a, 0x51, 0x38, 0x86, 0xda, 0x60,
+		0x6a, 0x67, 0xc1, 0x38, 0x87, 0xb0, 0x9a, 0x8b, 0x3f, 0x4a, 0x55, 0x88, 0x69, 0x54, 0xb5, 0x92,
+		0x1b, 0x46, 0x53, 0x30, 0x19, 0x02, 0x4f, 0xb3, 0x30, 0x1e, 0x2a, 0x07, 0xd1, 0x39, 0x18, 0xeb,
+		0xf0, 0x89, 0x6a, 0x25, 0x97, 0xc3, 0x86, 0x3c, 0x04, 0x46, 0x8c, 0x81, 0x4f, 0x9c, 0x43, 0xd8,
+		0x68, 0x26, 0x6a, 0x67, 0x6b, 0x78, 0x0a, 0x40, 0xd8, 0xe8, 0x86, 0xea, 0xc2, 0xd6, 0xc4, 0x43,
+		0xe2, 0x30, 0xb6, 0x76, 0x89, 0xf6, 0xfe, 0xc7, 0xf3, 0xb1, 0x71, 0xf1, 0x14, 0x22, 0x4e, 0x8b,
+		0x23, 0x68, 0x02, 0xce, 0x67, 0x69, 0x53, 0xad, 0xe4, 0x8e, 0xa2, 0x32, 0xbc, 0x2b, 0xa6, 0x5b,
+		0x8a, 0x92, 0xab, 0xb5, 0x7a, 0xad, 0x51, 0x23, 0x93, 0xd9, 0x31, 0xf4, 0x71, 0x98, 0xde, 0x5e,
+		0x27, 0x8c, 0x0a, 0x23, 0xe8, 0x2e, 0xdc, 0x0c, 0xf5, 0x90, 0xd6, 0x24, 0x31, 0xde, 0x51, 0x6c,
+		0xb7, 0x43, 0x8d, 0xa9, 0x89, 0x64, 0x06, 0xb3, 0x5a, 0xc9, 0x1d, 0xef, 0x4c, 0x0f, 0x6a, 0xd3,
+		0xaa, 0x95, 0xdc, 0x09, 0xec, 0x40, 0x74, 0xb0, 0x14, 0x55, 0xe5, 0x51, 0xad, 0x4e, 0x06, 0x7e,
+		0x32, 0x03, 0x77, 0x98, 0x55, 0xae, 0x30, 0xb3, 0x9c, 0x3b, 0x85, 0x6e, 0xc2, 0x5b, 0xa1, 0x36,
+		0xe9, 0x06, 0x38, 0x44, 0xe5, 0x02, 0x36, 0xc5, 0xd9, 0x1b, 0x32, 0xca, 0x9e, 0x46, 0x57, 0xe1,
+		0x52, 0x58, 0x06, 0x45, 0xf0, 0x9e, 0x40, 0x9f, 0x41, 0xd7, 0xe1, 0x4a, 0x96, 0x06, 0x81, 0xe2,
+		0x8f, 0xe1, 0x19, 0x3e, 0x4b, 0x13, 0x86, 0x93, 0x8c, 0xa7, 0xa1, 0x4c, 0x9f, 0xf0, 0xc4, 0x74,
+		0x3c, 0x2b, 0x52, 0x81, 0x01, 0x39, 0x1b, 0xe3, 0x8d, 0xb8, 0x49, 0xc0, 0xcf, 0x73, 0x31, 0x31,
+		0xec, 0x3c, 0xd5, 0x85, 0xf8, 0x73, 0x1e, 0x7b, 0xad, 0xdd, 0x35, 0x66, 0xf4, 0xb8, 0x80, 0xde,
+		0x82, 0xab, 0x19, 0xa5, 0xdf, 0x97, 0xd4, 0xe2, 0xc4, 0xff, 0x04, 0x38, 0xe6, 0x7b, 0x64, 0xd1,
+		0xab, 0x1f, 0x98, 0x5c, 0xbc, 0x99, 0x41, 0x2d, 0x97, 0xb0, 0x87, 0xbb, 0x54, 0x7f, 0x50, 0xaa,
+		0x57, 0xf0, 0x7f, 0x0f, 0x28, 0xb7, 0x07, 0xbd, 0x0b, 0x77, 0x53, 0x9a, 0xcc, 0x94, 0x2a, 0x1c,
+		0x07, 0xaf, 0xd4, 0x68, 0x28, 0xb5, 0x99, 0xa5, 0x46, 0x75, 0x31, 0x27, 0xa1, 0x2a, 0x94, 0x3a,
+		0x74, 0x20, 0xb2, 0xca, 0xa1, 0x6e, 0x7a, 0xd0, 0x6d, 0xf8, 0x58, 0x27, 0x3c, 0x02, 0x77, 0x30,
+		0xdc, 0xb4, 0x17, 0xdd, 0x81, 0xb7, 0x3b, 0x34, 0x8d, 0x98, 0xe0, 0x50, 0xdb, 0xbd, 0x98, 0xf3,
+		0x1d, 0xb1, 0x0f, 0x79, 0x60, 0xe1, 0xc6, 0x7d, 0xa8, 0x06, 0xd5, 0x4e, 0x1f, 0x16, 0xfb, 0xa8,
+		0xe1, 0xae, 0xf6, 0x65, 0xa0, 0xa2, 0xc0, 0x7f, 0x0d, 0x77, 0xb3, 0x1f, 0xdd, 0x87, 0x72, 0x36,
+		0x52, 0xa4, 0x77, 0x74, 0x00, 0x7d, 0x0a, 0x1a, 0xdd, 0x71, 0x35, 0x4d, 0x7a, 0x43, 0x3d, 0xf7,
+		0xa3, 0x7b, 0x70, 0xbb, 0x23, 0xd1, 0xa2, 0x2e, 0x6d, 0xa8, 0x39, 0x60, 0x33, 0x9a, 0xd2, 0x3c,
+		0x2c, 0x23, 0xc1, 0x5a, 0xb1, 0x86, 0xbd, 0x94, 0x88, 0x47, 0x9b, 0x68, 0xa8, 0x54, 0x17, 0xab,
+		0x0d, 0x75, 0xb1, 0x51, 0x2b, 0x3f, 0xa4, 0x9e, 0xc0, 0x5c, 0x6d, 0xb1, 0x91, 0x1b, 0xc4, 0x53,
+		0x5c, 0x4a, 0x2b, 0x7f, 0xac, 0xf8, 0x47, 0x55, 0x09, 0x69, 0x18, 0x06, 0x5b, 0x52, 0xaa, 0xb9,
+		0xa1, 0x0c, 0x2c, 0x61, 0x26, 0x23, 0x9d, 0x70, 0xc3, 0x78, 0xca, 0xce, 0xa4, 0x21, 0xd4, 0xe4,
+		0x71, 0x3b, 0x39, 0x88, 0x4d, 0x4e, 0x4a, 0x27, 0xb3, 0xf3, 0x4a, 0xb9, 0x4a, 0xd7, 0x85, 0x81,
+		0x8d, 0xc8, 0xa1, 0xb7, 0x61, 0x2a, 0xad, 0x51, 0xa9, 0x36, 0x37, 0xff, 0x5e, 0x55, 0x89, 0xb7,
+		0x3b, 0xd4, 0x81, 0xe4, 0xa1, 0xa1, 0xd7, 0xea, 0x0b, 0x4b, 0x0d, 0x75, 0xb1, 0xf6, 0xb4, 0x9a,
+		0x43, 0xd1, 0x85, 0x9e, 0x80, 0x51, 0x1e, 0xad, 0x72, 0x87, 0xa3, 0x0b, 0x3d, 0xee, 0x47, 0x66,
+		0x6a, 0xf5, 0x92, 0xf2, 0x24, 0x97, 0xef, 0x20, 0x7a, 0x49, 0x3b, 0x17, 0x91, 0xa0, 0x23, 0x13,
+		0x3f, 0x2e, 0xc1, 0x44, 0xa6, 0xac, 0xe6, 0xd4, 0x18, 0x3f, 0x86, 0xc5, 0xcc, 0xae, 0x90, 0x6f,
+		0xa1, 0x1f, 0xd6, 0xe7, 0x1f, 0xd7, 0x53, 0x57, 0x59, 0x7b, 0x08, 0x1e, 0xd9, 0xdf, 0x83, 0xc7,
+		0x78, 0x64, 0x9e, 0x94, 0xba, 0xc2, 0xe3, 0x39, 0x8c, 0x75, 0x7c, 0x42, 0x18, 0xcd, 0xc0, 0x3b,
+		0x1d, 0x1c, 0x83, 0xb8, 0x2e, 0x95, 0xe6, 0x94, 0x6a, 0xa9, 0xf2, 0x44, 0x55, 0x96, 0xea, 0xf5,
+		0x5a, 0xfd, 0x7e, 0x6e, 0xcf, 0xc4, 0x3f, 0xed, 0x81, 0x93, 0x69, 0x41, 0x0c, 0x91, 0x0d, 0x9d,
+		0x90, 0x1f, 0x41, 0xe4, 0x91, 0xed, 0xb3, 0x04, 0x7e, 0xcb, 0x1e, 0xec, 0x18, 0x76, 0x02, 0x67,
+		0x73, 0xb4, 0x14, 0xd9, 0x59, 0x12, 0x75, 0xed, 0xf9, 0x2b, 0x3d, 0xd8, 0x23, 0xea, 0x04, 0x1d,
+		0x72, 0x3c, 0x7a, 0xb1, 0x86, 0x74, 0x46, 0x3c, 0xe6, 0x4a, 0xee, 0xcd, 0x32, 0xdc, 0xc0, 0x23,
+		0xea, 0x9b, 0xd0, 0x61, 0x20, 0x94, 0xa7, 0x02, 0xaf, 0x5c, 0x28, 0x47, 0xd8, 0x8e, 0x97, 0x8f,
+		0x50, 0x6e, 0x0f, 0xde, 0x7f, 0x88, 0xd4, 0x45, 0x8d, 0x7c, 0x4e, 0x42, 0x23, 0x90, 0x8f, 0x00,
+		0x94, 0x66, 0x4a, 0xf5, 0xca, 0x7c, 0x3d, 0xd7, 0x33, 0xf1, 0x1c, 0x8e, 0xf2, 0x8f, 0x34, 0xd1,
+		0x05, 0x18, 0xff, 0xe4, 0x52, 0x55, 0x89, 0xaf, 0x1c, 0xa3, 0xbb, 0x38, 0x98, 0x2f, 0x67, 0xe1,
+		0x8c, 0x18, 0xd0, 0xe3, 0xc8, 0xc4, 0x17, 0x24, 0xc8, 0xf3, 0x4e, 0xe9, 0x70, 0xf3, 0x85, 0x6a,
+		0xbd, 0x52, 0xab, 0xdf, 0x0f, 0x54, 0x18, 0x93, 0xa3, 0x1a, 0x5a, 0xfb, 0x92, 0x5d, 0x33, 0x11,
+		0x14, 0xf3, 0x85, 0x25, 0xbc, 0x6f, 0x20, 0x80, 0x49, 0x2c, 0x43, 0x7a, 0x26, 0x5a, 0x70, 0x94,
+		0x1f, 0x10, 0x8f, 0x07, 0xfe, 0xa0, 0xb6, 0xd8, 0x98, 0x57, 0x9e, 0xa8, 0xd4, 0xcf, 0x9b, 0xad,
+		0xcd, 0x35, 0xaa, 0x0a, 0x5b, 0x77, 0xce, 0xcd, 0xd1, 0xd2, 0xdc, 0x1e, 0x6c, 0xc3, 0xc4, 0x80,
+		0x94, 0xaf, 0x14, 0x54, 0x9a, 0xa8, 0xc0, 0xa0, 0x77, 0xa4, 0xf0, 0xd0, 0xb4, 0x74, 0xbc, 0xa1,
+		0x48, 0xa8, 0x85, 0xe7, 0x2b, 0xf5, 0x61, 0xad, 0x5e, 0x51, 0xeb, 0xf3, 0xca, 0xa3, 0xd2, 0x5c,
+		0x6e, 0x0f, 0xa7, 0x8a, 0xce, 0x6f, 0x39, 0x69, 0xe2, 0x21, 0x0c, 0x47, 0x33, 0xec, 0xe1, 0xd5,
+		0x6e, 0x49, 0x29, 0x3f, 0xa8, 0xbd, 0x57, 0x9a, 0xf3, 0xb7, 0x26, 0x6b, 0x8b, 0xa5, 0x19, 0x4a,
+		0xb4, 0x13, 0x70, 0x2c, 0x5e, 0x5b, 0xad, 0xd3, 0x4a, 0x69, 0xe2, 0x37, 0x24, 0xc8, 0xc5, 0x43,
+		0x26, 0xf0, 0xfa, 0xb6, 0x56, 0xaf, 0x54, 0x3f, 0x55, 0xad, 0xa8, 0xef, 0x95, 0xe6, 0x96, 0xaa,
+		0xde, 0xea, 0x45, 0x21, 0x2a, 0x8d, 0xd7, 0x6e, 0x9c, 0xea, 0x87, 0xd5, 0x27, 0x8f, 0xe7, 0x15,
+		0xcc, 0x81, 0xe3, 0x70, 0x94, 0x53, 0x5f, 0xab, 0x37, 0x72, 0x3d, 0x82, 0xae, 0x2b, 0xf3, 0x4b,
+		0x33, 0x73, 0xd5, 0x5c, 0x2f, 0xc6, 0x95, 0x53, 0x3d, 0x33, 0x3f, 0x3f, 0x97, 0xdb, 0x8b, 0x05,
+		0x9c, 0xd7, 0xb6, 0xd4, 0xa8, 0x62, 0x8d, 0xc9, 0xf5, 0x4d, 0x7c, 0x45, 0x82, 0xa3, 0xde, 0xf3,
+		0x08, 0xe4, 0x75, 0x04, 0x96, 0xb0, 0xba, 0x6d, 0xe3, 0x7d, 0x83, 0xb8, 0x6b, 0xc2, 0xd6, 0x01,
+		0xf3, 0x0a, 0x99, 0xf6, 0x2a, 0x55, 0x85, 0x5a, 0x17, 0x31, 0x98, 0x52, 0x6d, 0x28, 0x4f, 0x98,
+		0xf2, 0x50, 0x41, 0x13, 0xc3, 0x96, 0x95, 0xf9, 0xba, 0x2f, 0xba, 0xb9, 0x9e, 0x89, 0x07, 0x01,
+		0xeb, 0x09, 0x89, 0x4f, 0xc0, 0xb1, 0x80, 0xbf, 0x91, 0x6d, 0xa1, 0xdc, 0x1e, 0x4e, 0xa5, 0x27,
+		0xc9, 0x39, 0x69, 0x46, 0x86, 0x63, 0xcd, 0xf6, 0x2a, 0xef, 0x84, 0x67, 0x41, 0x7a, 0xda, 0xa7,
+		0xad, 0x99, 0x2f, 0xaf, 0xff, 0xbf, 0x01, 0x00, 0x15, 0x48, 0xac, 0x2b, 0xb3, 0xc5, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkflowServiceYARPCClient {
+			return NewWorkflowServiceYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
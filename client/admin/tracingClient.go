@@ -0,0 +1,150 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/tracing"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*tracingClient)(nil)
+
+type tracingClient struct {
+	client Client
+	tracer opentracing.Tracer
+}
+
+// NewTracingClient creates a new instance of Client that records a span for each call
+func NewTracingClient(client Client, tracer opentracing.Tracer) Client {
+	return &tracingClient{
+		client: client,
+		tracer: tracer,
+	}
+}
+
+func (c *tracingClient) DescribeHistoryHost(
+	ctx context.Context,
+	request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (resp *shared.DescribeHistoryHostResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeHistoryHost")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeHistoryHost(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *admin.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *admin.DescribeWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	request *admin.GetWorkflowExecutionRawHistoryRequest,
+	opts ...yarpc.CallOption) (resp *admin.GetWorkflowExecutionRawHistoryResponse, err error) {
+	span, ctx := c.startSpan(ctx, "GetWorkflowExecutionRawHistory")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
+}
+
+func (c *tracingClient) VerifyMutableState(
+	ctx context.Context,
+	request *admin.VerifyMutableStateRequest,
+	opts ...yarpc.CallOption) (resp *admin.VerifyMutableStateResponse, err error) {
+	span, ctx := c.startSpan(ctx, "VerifyMutableState")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.VerifyMutableState(ctx, request, opts...)
+}
+
+func (c *tracingClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (resp *replicator.GetReplicationMessagesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "GetReplicationMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.GetReplicationMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption) (resp *replicator.ReadDLQMessagesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ReadDLQMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ReadDLQMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "PurgeDLQMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.PurgeDLQMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption) (resp *replicator.MergeDLQMessagesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "MergeDLQMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.MergeDLQMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (resp *replicator.DescribeReplicationStatusResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeReplicationStatus")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeReplicationStatus(ctx, request, opts...)
+}
+
+func (c *tracingClient) FailoverDomains(
+	ctx context.Context,
+	request *admin.FailoverDomainsRequest,
+	opts ...yarpc.CallOption) (resp *admin.FailoverDomainsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "FailoverDomains")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.FailoverDomains(ctx, request, opts...)
+}
+
+func (c *tracingClient) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "AddSearchAttribute")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.AddSearchAttribute(ctx, request, opts...)
+}
+
+func (c *tracingClient) startSpan(ctx context.Context, operation string) (opentracing.Span, context.Context) {
+	return tracing.StartSpan(ctx, c.tracer, "admin-client."+operation)
+}
@@ -23,6 +23,7 @@ package client
 import (
 	"time"
 
+	"github.com/opentracing/opentracing-go"
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
@@ -67,16 +68,18 @@ type rpcClientFactory struct {
 	rpcFactory            common.RPCFactory
 	monitor               membership.Monitor
 	metricsClient         metrics.Client
+	tracer                opentracing.Tracer
 	numberOfHistoryShards int
 }
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
 func NewRPCClientFactory(rpcFactory common.RPCFactory, monitor membership.Monitor,
-	metricsClient metrics.Client, numberOfHistoryShards int) Factory {
+	metricsClient metrics.Client, tracer opentracing.Tracer, numberOfHistoryShards int) Factory {
 	return &rpcClientFactory{
 		rpcFactory:            rpcFactory,
 		monitor:               monitor,
 		metricsClient:         metricsClient,
+		tracer:                tracer,
 		numberOfHistoryShards: numberOfHistoryShards,
 	}
 }
@@ -113,6 +116,9 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 	}
 
 	client := history.NewClient(cf.numberOfHistoryShards, timeout, common.NewClientCache(keyResolver, clientProvider))
+	if cf.tracer != nil {
+		client = history.NewTracingClient(client, cf.tracer)
+	}
 	if cf.metricsClient != nil {
 		client = history.NewMetricClient(client, cf.metricsClient)
 	}
@@ -142,6 +148,9 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 	}
 
 	client := matching.NewClient(timeout, longPollTimeout, common.NewClientCache(keyResolver, clientProvider))
+	if cf.tracer != nil {
+		client = matching.NewTracingClient(client, cf.tracer)
+	}
 	if cf.metricsClient != nil {
 		client = matching.NewMetricClient(client, cf.metricsClient)
 	}
@@ -173,6 +182,9 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeout(
 	}
 
 	client := frontend.NewClient(timeout, longPollTimeout, common.NewClientCache(keyResolver, clientProvider))
+	if cf.tracer != nil {
+		client = frontend.NewTracingClient(client, cf.tracer)
+	}
 	if cf.metricsClient != nil {
 		client = frontend.NewMetricClient(client, cf.metricsClient)
 	}
@@ -193,6 +205,9 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeoutAndDispatcher(
 	}
 
 	client := admin.NewClient(timeout, common.NewClientCache(keyResolver, clientProvider))
+	if cf.tracer != nil {
+		client = admin.NewTracingClient(client, cf.tracer)
+	}
 	if cf.metricsClient != nil {
 		client = admin.NewMetricClient(client, cf.metricsClient)
 	}
@@ -214,6 +229,9 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeoutAndDispatcher(
 	}
 
 	client := frontend.NewClient(timeout, longPollTimeout, common.NewClientCache(keyResolver, clientProvider))
+	if cf.tracer != nil {
+		client = frontend.NewTracingClient(client, cf.tracer)
+	}
 	if cf.metricsClient != nil {
 		client = frontend.NewMetricClient(client, cf.metricsClient)
 	}
@@ -0,0 +1,460 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common/protoconv"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+)
+
+var _ Client = (*grpcClient)(nil)
+
+type grpcClient struct {
+	client apiv1.WorkflowServiceYARPCClient
+}
+
+// NewGRPCClient creates a new frontend service client which calls the protobuf mirror of the
+// WorkflowService, the client config must be backed by a gRPC outbound
+func NewGRPCClient(clientConfig transport.ClientConfig) Client {
+	return &grpcClient{
+		client: apiv1.NewWorkflowServiceYARPCClient(clientConfig),
+	}
+}
+
+func (c *grpcClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.CountWorkflowExecutions(ctx, protoconv.ToProtoCountWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoCountWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.DeprecateDomain(ctx, protoconv.ToProtoDeprecateDomainRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDomainResponse, error) {
+
+	response, err := c.client.DescribeDomain(ctx, protoconv.ToProtoDescribeDomainRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoDescribeDomainResponse(response), nil
+}
+
+func (c *grpcClient) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListResponse, error) {
+
+	response, err := c.client.DescribeTaskList(ctx, protoconv.ToProtoDescribeTaskListRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoDescribeTaskListResponse(response), nil
+}
+
+func (c *grpcClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	response, err := c.client.DescribeWorkflowExecution(ctx, protoconv.ToProtoDescribeWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoDescribeWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) GetSearchAttributes(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	response, err := c.client.GetSearchAttributes(ctx, &apiv1.GetSearchAttributesRequest{}, opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoGetSearchAttributesResponse(response), nil
+}
+
+func (c *grpcClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	response, err := c.client.GetWorkflowExecutionHistory(ctx, protoconv.ToProtoGetWorkflowExecutionHistoryRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+func (c *grpcClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ListClosedWorkflowExecutions(ctx, protoconv.ToProtoListClosedWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoListClosedWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDomainsResponse, error) {
+
+	response, err := c.client.ListDomains(ctx, protoconv.ToProtoListDomainsRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoListDomainsResponse(response), nil
+}
+
+func (c *grpcClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ListOpenWorkflowExecutions(ctx, protoconv.ToProtoListOpenWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoListOpenWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ListWorkflowExecutions(ctx, protoconv.ToProtoListWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoListWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	response, err := c.client.PollForActivityTask(ctx, protoconv.ToProtoPollForActivityTaskRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoPollForActivityTaskResponse(response), nil
+}
+
+func (c *grpcClient) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	response, err := c.client.PollForDecisionTask(ctx, protoconv.ToProtoPollForDecisionTaskRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoPollForDecisionTaskResponse(response), nil
+}
+
+func (c *grpcClient) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.QueryWorkflowResponse, error) {
+
+	response, err := c.client.QueryWorkflow(ctx, protoconv.ToProtoQueryWorkflowRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoQueryWorkflowResponse(response), nil
+}
+
+func (c *grpcClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := c.client.RecordActivityTaskHeartbeat(ctx, protoconv.ToProtoRecordActivityTaskHeartbeatRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (c *grpcClient) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := c.client.RecordActivityTaskHeartbeatByID(ctx, protoconv.ToProtoRecordActivityTaskHeartbeatByIDRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (c *grpcClient) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RegisterDomain(ctx, protoconv.ToProtoRegisterDomainRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RequestCancelWorkflowExecution(ctx, protoconv.ToProtoRequestCancelWorkflowExecutionRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	response, err := c.client.ResetStickyTaskList(ctx, protoconv.ToProtoResetStickyTaskListRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoResetStickyTaskListResponse(response), nil
+}
+
+func (c *grpcClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	response, err := c.client.ResetWorkflowExecution(ctx, protoconv.ToProtoResetWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoResetWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCanceled(ctx, protoconv.ToProtoRespondActivityTaskCanceledRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCanceledByID(ctx, protoconv.ToProtoRespondActivityTaskCanceledByIDRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCompleted(ctx, protoconv.ToProtoRespondActivityTaskCompletedRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCompletedByID(ctx, protoconv.ToProtoRespondActivityTaskCompletedByIDRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskFailed(ctx, protoconv.ToProtoRespondActivityTaskFailedRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskFailedByID(ctx, protoconv.ToProtoRespondActivityTaskFailedByIDRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	response, err := c.client.RespondDecisionTaskCompleted(ctx, protoconv.ToProtoRespondDecisionTaskCompletedRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoRespondDecisionTaskCompletedResponse(response), nil
+}
+
+func (c *grpcClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondDecisionTaskFailed(ctx, protoconv.ToProtoRespondDecisionTaskFailedRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondQueryTaskCompleted(ctx, protoconv.ToProtoRespondQueryTaskCompletedRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ScanWorkflowExecutions(ctx, protoconv.ToProtoListWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoListWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	response, err := c.client.SignalWithStartWorkflowExecution(ctx, protoconv.ToProtoSignalWithStartWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoStartWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.SignalWorkflowExecution(ctx, protoconv.ToProtoSignalWorkflowExecutionRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	response, err := c.client.StartWorkflowExecution(ctx, protoconv.ToProtoStartWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoStartWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.TerminateWorkflowExecution(ctx, protoconv.ToProtoTerminateWorkflowExecutionRequest(request), opts...)
+	return protoconv.FromProtoError(err)
+}
+
+func (c *grpcClient) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateDomainResponse, error) {
+
+	response, err := c.client.UpdateDomain(ctx, protoconv.ToProtoUpdateDomainRequest(request), opts...)
+	if err != nil {
+		return nil, protoconv.FromProtoError(err)
+	}
+	return protoconv.FromProtoUpdateDomainResponse(response), nil
+}
@@ -0,0 +1,363 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/tracing"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*tracingClient)(nil)
+
+type tracingClient struct {
+	client Client
+	tracer opentracing.Tracer
+}
+
+// NewTracingClient creates a new instance of Client that records a span for each call
+func NewTracingClient(client Client, tracer opentracing.Tracer) Client {
+	return &tracingClient{
+		client: client,
+		tracer: tracer,
+	}
+}
+
+func (c *tracingClient) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "DeprecateDomain")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DeprecateDomain(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+	opts ...yarpc.CallOption) (resp *shared.DescribeDomainResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeDomain")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeDomain(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+	opts ...yarpc.CallOption) (resp *shared.DescribeTaskListResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeTaskList")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeTaskList(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.DescribeWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption) (resp *shared.GetWorkflowExecutionHistoryResponse, err error) {
+	span, ctx := c.startSpan(ctx, "GetWorkflowExecutionHistory")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.GetWorkflowExecutionHistory(ctx, request, opts...)
+}
+
+func (c *tracingClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption) (resp *shared.ListClosedWorkflowExecutionsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ListClosedWorkflowExecutions")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ListClosedWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *tracingClient) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+	opts ...yarpc.CallOption) (resp *shared.ListDomainsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ListDomains")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ListDomains(ctx, request, opts...)
+}
+
+func (c *tracingClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption) (resp *shared.ListOpenWorkflowExecutionsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ListOpenWorkflowExecutions")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ListOpenWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *tracingClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption) (resp *shared.ListWorkflowExecutionsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ListWorkflowExecutions")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ListWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *tracingClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption) (resp *shared.ListWorkflowExecutionsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ScanWorkflowExecutions")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ScanWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *tracingClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption) (resp *shared.CountWorkflowExecutionsResponse, err error) {
+	span, ctx := c.startSpan(ctx, "CountWorkflowExecutions")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.CountWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *tracingClient) GetSearchAttributes(
+	ctx context.Context,
+	opts ...yarpc.CallOption) (resp *shared.GetSearchAttributesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "GetSearchAttributes")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.GetSearchAttributes(ctx, opts...)
+}
+
+func (c *tracingClient) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption) (resp *shared.PollForActivityTaskResponse, err error) {
+	span, ctx := c.startSpan(ctx, "PollForActivityTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.PollForActivityTask(ctx, request, opts...)
+}
+
+func (c *tracingClient) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption) (resp *shared.PollForDecisionTaskResponse, err error) {
+	span, ctx := c.startSpan(ctx, "PollForDecisionTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.PollForDecisionTask(ctx, request, opts...)
+}
+
+func (c *tracingClient) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (resp *shared.QueryWorkflowResponse, err error) {
+	span, ctx := c.startSpan(ctx, "QueryWorkflow")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.QueryWorkflow(ctx, request, opts...)
+}
+
+func (c *tracingClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption) (resp *shared.RecordActivityTaskHeartbeatResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RecordActivityTaskHeartbeat")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+}
+
+func (c *tracingClient) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+	opts ...yarpc.CallOption) (resp *shared.RecordActivityTaskHeartbeatResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RecordActivityTaskHeartbeatByID")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RecordActivityTaskHeartbeatByID(ctx, request, opts...)
+}
+
+func (c *tracingClient) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RegisterDomain")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RegisterDomain(ctx, request, opts...)
+}
+
+func (c *tracingClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RequestCancelWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption) (resp *shared.ResetStickyTaskListResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ResetStickyTaskList")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ResetStickyTaskList(ctx, request, opts...)
+}
+
+func (c *tracingClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.ResetWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ResetWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ResetWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskCanceled")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskCanceled(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskCanceledByID")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskCanceledByID(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskCompletedByID")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskCompletedByID(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskFailed")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskFailed(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskFailedByID")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskFailedByID(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption) (resp *shared.RespondDecisionTaskCompletedResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RespondDecisionTaskCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondDecisionTaskCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondDecisionTaskFailed")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondDecisionTaskFailed(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondQueryTaskCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondQueryTaskCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.StartWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "SignalWithStartWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "SignalWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.SignalWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.StartWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "StartWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.StartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "TerminateWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.TerminateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+	opts ...yarpc.CallOption) (resp *shared.UpdateDomainResponse, err error) {
+	span, ctx := c.startSpan(ctx, "UpdateDomain")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.UpdateDomain(ctx, request, opts...)
+}
+
+func (c *tracingClient) startSpan(ctx context.Context, operation string) (opentracing.Span, context.Context) {
+	return tracing.StartSpan(ctx, c.tracer, "frontend-client."+operation)
+}
@@ -22,10 +22,13 @@ package history
 
 import (
 	"context"
+	"sort"
+	"sync"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
@@ -36,6 +39,8 @@ var _ Client = (*clientImpl)(nil)
 const (
 	// DefaultTimeout is the default timeout used to make calls
 	DefaultTimeout = time.Second * 30
+
+	describeReplicationStatusConcurrency = 32
 )
 
 type clientImpl struct {
@@ -170,6 +175,30 @@ func (c *clientImpl) DescribeMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) VerifyMutableState(
+	ctx context.Context,
+	request *h.VerifyMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.VerifyMutableStateResponse, error) {
+	client, err := c.getClientForWorkflowID(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.VerifyMutableStateResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.VerifyMutableState(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetStickyTaskList(
 	ctx context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -638,6 +667,194 @@ func (c *clientImpl) SyncActivity(
 	return err
 }
 
+func (c *clientImpl) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// tokens are owned by different hosts, fetch messages of each shard separately
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var lastErr error
+	messagesByShard := make(map[int32]*replicator.ReplicationMessages)
+	for _, token := range request.Tokens {
+		client, err := c.getClientForShardID(int(token.GetShardID()))
+		if err != nil {
+			return nil, err
+		}
+
+		wg.Add(1)
+		go func(client historyserviceclient.Interface, token *replicator.ReplicationToken) {
+			defer wg.Done()
+
+			shardRequest := &replicator.GetReplicationMessagesRequest{
+				Tokens:      []*replicator.ReplicationToken{token},
+				ClusterName: request.ClusterName,
+			}
+			var response *replicator.GetReplicationMessagesResponse
+			op := func(ctx context.Context, client historyserviceclient.Interface) error {
+				var err error
+				ctx, cancel := c.createContext(ctx)
+				defer cancel()
+				response, err = client.GetReplicationMessages(ctx, shardRequest, opts...)
+				return err
+			}
+			err := c.executeWithRedirect(ctx, client, op)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				lastErr = err
+				return
+			}
+			for shardID, messages := range response.GetMessagesByShard() {
+				messagesByShard[shardID] = messages
+			}
+		}(client, token)
+	}
+	wg.Wait()
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return &replicator.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+}
+
+func (c *clientImpl) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.ReadDLQMessagesResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *replicator.ReadDLQMessagesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		var err error
+		response, err = client.ReadDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PurgeDLQMessages(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.MergeDLQMessagesResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *replicator.MergeDLQMessagesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		var err error
+		response, err = client.MergeDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// shards are owned by different hosts, describe each shard separately
+	shardIDs := make(chan int32, len(request.ShardIDs))
+	for _, shardID := range request.ShardIDs {
+		shardIDs <- shardID
+	}
+	close(shardIDs)
+
+	concurrency := describeReplicationStatusConcurrency
+	if len(request.ShardIDs) < concurrency {
+		concurrency = len(request.ShardIDs)
+	}
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var lastErr error
+	var responses []*replicator.DescribeReplicationStatusResponse
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for shardID := range shardIDs {
+				shardRequest := &replicator.DescribeReplicationStatusRequest{
+					TargetCluster: request.TargetCluster,
+					ShardIDs:      []int32{shardID},
+					Domain:        request.Domain,
+				}
+				var response *replicator.DescribeReplicationStatusResponse
+				op := func(ctx context.Context, client historyserviceclient.Interface) error {
+					var err error
+					ctx, cancel := c.createContext(ctx)
+					defer cancel()
+					response, err = client.DescribeReplicationStatus(ctx, shardRequest, opts...)
+					return err
+				}
+				client, err := c.getClientForShardID(int(shardID))
+				if err == nil {
+					err = c.executeWithRedirect(ctx, client, op)
+				}
+
+				lock.Lock()
+				if err != nil {
+					lastErr = err
+				} else {
+					responses = append(responses, response)
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return MergeReplicationStatus(responses), nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -686,3 +903,36 @@ redirectLoop:
 	}
 	return err
 }
+
+// MergeReplicationStatus merges the replication status of different shards into one
+func MergeReplicationStatus(responses []*replicator.DescribeReplicationStatusResponse) *replicator.DescribeReplicationStatusResponse {
+	result := &replicator.DescribeReplicationStatusResponse{
+		Shards:       []*replicator.ShardReplicationStatus{},
+		Domains:      []*replicator.DomainReplicationStatus{},
+		PendingTasks: common.Int64Ptr(0),
+		Truncated:    common.BoolPtr(false),
+	}
+	pendingTasksByDomain := make(map[string]int64)
+	for _, response := range responses {
+		result.Shards = append(result.Shards, response.Shards...)
+		for _, domainStatus := range response.Domains {
+			pendingTasksByDomain[domainStatus.GetDomain()] += domainStatus.GetPendingTasks()
+		}
+		result.PendingTasks = common.Int64Ptr(result.GetPendingTasks() + response.GetPendingTasks())
+		result.Truncated = common.BoolPtr(result.GetTruncated() || response.GetTruncated())
+	}
+	for domain, pendingTasks := range pendingTasksByDomain {
+		result.Domains = append(result.Domains, &replicator.DomainReplicationStatus{
+			Domain:       common.StringPtr(domain),
+			PendingTasks: common.Int64Ptr(pendingTasks),
+		})
+	}
+
+	sort.Slice(result.Shards, func(i, j int) bool {
+		return result.Shards[i].GetShardID() < result.Shards[j].GetShardID()
+	})
+	sort.Slice(result.Domains, func(i, j int) bool {
+		return result.Domains[i].GetDomain() < result.Domains[j].GetDomain()
+	})
+	return result
+}
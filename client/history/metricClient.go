@@ -24,6 +24,7 @@ import (
 	"context"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
 	"go.uber.org/yarpc"
@@ -79,6 +80,15 @@ func (c *metricClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *metricClient) VerifyMutableState(
+	context context.Context,
+	request *h.VerifyMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.VerifyMutableStateResponse, error) {
+	resp, err := c.client.VerifyMutableState(context, request, opts...)
+
+	return resp, err
+}
+
 func (c *metricClient) GetMutableState(
 	context context.Context,
 	request *h.GetMutableStateRequest,
@@ -469,3 +479,88 @@ func (c *metricClient) SyncActivity(
 
 	return err
 }
+
+func (c *metricClient) GetReplicationMessages(
+	context context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ReadDLQMessages(
+	context context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.ReadDLQMessagesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientReadDLQMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReadDLQMessagesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadDLQMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReadDLQMessagesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) PurgeDLQMessages(
+	context context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPurgeDLQMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPurgeDLQMessagesScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeDLQMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPurgeDLQMessagesScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) MergeDLQMessages(
+	context context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.MergeDLQMessagesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientMergeDLQMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientMergeDLQMessagesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MergeDLQMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientMergeDLQMessagesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeReplicationStatus(
+	context context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeReplicationStatus(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
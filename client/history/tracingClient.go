@@ -0,0 +1,339 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/tracing"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*tracingClient)(nil)
+
+type tracingClient struct {
+	client Client
+	tracer opentracing.Tracer
+}
+
+// NewTracingClient creates a new instance of Client that records a span for each call
+func NewTracingClient(client Client, tracer opentracing.Tracer) Client {
+	return &tracingClient{
+		client: client,
+		tracer: tracer,
+	}
+}
+
+func (c *tracingClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *h.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.StartWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "StartWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.StartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeHistoryHost(
+	ctx context.Context,
+	request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (resp *shared.DescribeHistoryHostResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeHistoryHost")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeHistoryHost(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeMutableState(
+	ctx context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption) (resp *h.DescribeMutableStateResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeMutableState")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeMutableState(ctx, request, opts...)
+}
+
+func (c *tracingClient) VerifyMutableState(
+	ctx context.Context,
+	request *h.VerifyMutableStateRequest,
+	opts ...yarpc.CallOption) (resp *h.VerifyMutableStateResponse, err error) {
+	span, ctx := c.startSpan(ctx, "VerifyMutableState")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.VerifyMutableState(ctx, request, opts...)
+}
+
+func (c *tracingClient) GetMutableState(
+	ctx context.Context,
+	request *h.GetMutableStateRequest,
+	opts ...yarpc.CallOption) (resp *h.GetMutableStateResponse, err error) {
+	span, ctx := c.startSpan(ctx, "GetMutableState")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.GetMutableState(ctx, request, opts...)
+}
+
+func (c *tracingClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *h.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption) (resp *h.ResetStickyTaskListResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ResetStickyTaskList")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ResetStickyTaskList(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *h.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.DescribeWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) RecordDecisionTaskStarted(
+	ctx context.Context,
+	request *h.RecordDecisionTaskStartedRequest,
+	opts ...yarpc.CallOption) (resp *h.RecordDecisionTaskStartedResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RecordDecisionTaskStarted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RecordDecisionTaskStarted(ctx, request, opts...)
+}
+
+func (c *tracingClient) RecordActivityTaskStarted(
+	ctx context.Context,
+	request *h.RecordActivityTaskStartedRequest,
+	opts ...yarpc.CallOption) (resp *h.RecordActivityTaskStartedResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RecordActivityTaskStarted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RecordActivityTaskStarted(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *h.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption) (resp *h.RespondDecisionTaskCompletedResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RespondDecisionTaskCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondDecisionTaskCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *h.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondDecisionTaskFailed")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondDecisionTaskFailed(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *h.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *h.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskFailed")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskFailed(ctx, request, opts...)
+}
+
+func (c *tracingClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *h.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondActivityTaskCanceled")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondActivityTaskCanceled(ctx, request, opts...)
+}
+
+func (c *tracingClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *h.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption) (resp *shared.RecordActivityTaskHeartbeatResponse, err error) {
+	span, ctx := c.startSpan(ctx, "RecordActivityTaskHeartbeat")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+}
+
+func (c *tracingClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *h.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RequestCancelWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *h.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "SignalWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.SignalWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.StartWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "SignalWithStartWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) RemoveSignalMutableState(
+	ctx context.Context,
+	request *h.RemoveSignalMutableStateRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RemoveSignalMutableState")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RemoveSignalMutableState(ctx, request, opts...)
+}
+
+func (c *tracingClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *h.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "TerminateWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.TerminateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *h.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (resp *shared.ResetWorkflowExecutionResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ResetWorkflowExecution")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ResetWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *tracingClient) ScheduleDecisionTask(
+	ctx context.Context,
+	request *h.ScheduleDecisionTaskRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "ScheduleDecisionTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ScheduleDecisionTask(ctx, request, opts...)
+}
+
+func (c *tracingClient) RecordChildExecutionCompleted(
+	ctx context.Context,
+	request *h.RecordChildExecutionCompletedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RecordChildExecutionCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RecordChildExecutionCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) ReplicateEvents(
+	ctx context.Context,
+	request *h.ReplicateEventsRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "ReplicateEvents")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ReplicateEvents(ctx, request, opts...)
+}
+
+func (c *tracingClient) ReplicateRawEvents(
+	ctx context.Context,
+	request *h.ReplicateRawEventsRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "ReplicateRawEvents")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ReplicateRawEvents(ctx, request, opts...)
+}
+
+func (c *tracingClient) SyncShardStatus(
+	ctx context.Context,
+	request *h.SyncShardStatusRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "SyncShardStatus")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.SyncShardStatus(ctx, request, opts...)
+}
+
+func (c *tracingClient) SyncActivity(
+	ctx context.Context,
+	request *h.SyncActivityRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "SyncActivity")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.SyncActivity(ctx, request, opts...)
+}
+
+func (c *tracingClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (resp *replicator.GetReplicationMessagesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "GetReplicationMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.GetReplicationMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption) (resp *replicator.ReadDLQMessagesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "ReadDLQMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.ReadDLQMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "PurgeDLQMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.PurgeDLQMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption) (resp *replicator.MergeDLQMessagesResponse, err error) {
+	span, ctx := c.startSpan(ctx, "MergeDLQMessages")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.MergeDLQMessages(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (resp *replicator.DescribeReplicationStatusResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeReplicationStatus")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeReplicationStatus(ctx, request, opts...)
+}
+
+func (c *tracingClient) startSpan(ctx context.Context, operation string) (opentracing.Span, context.Context) {
+	return tracing.StartSpan(ctx, c.tracer, "history-client."+operation)
+}
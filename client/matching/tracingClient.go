@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	m "github.com/uber/cadence/.gen/go/matching"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/tracing"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*tracingClient)(nil)
+
+type tracingClient struct {
+	client Client
+	tracer opentracing.Tracer
+}
+
+// NewTracingClient creates a new instance of Client that records a span for each call
+func NewTracingClient(client Client, tracer opentracing.Tracer) Client {
+	return &tracingClient{
+		client: client,
+		tracer: tracer,
+	}
+}
+
+func (c *tracingClient) AddActivityTask(
+	ctx context.Context,
+	addRequest *m.AddActivityTaskRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "AddActivityTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.AddActivityTask(ctx, addRequest, opts...)
+}
+
+func (c *tracingClient) AddDecisionTask(
+	ctx context.Context,
+	addRequest *m.AddDecisionTaskRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "AddDecisionTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.AddDecisionTask(ctx, addRequest, opts...)
+}
+
+func (c *tracingClient) PollForActivityTask(
+	ctx context.Context,
+	pollRequest *m.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption) (resp *workflow.PollForActivityTaskResponse, err error) {
+	span, ctx := c.startSpan(ctx, "PollForActivityTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.PollForActivityTask(ctx, pollRequest, opts...)
+}
+
+func (c *tracingClient) PollForDecisionTask(
+	ctx context.Context,
+	pollRequest *m.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption) (resp *m.PollForDecisionTaskResponse, err error) {
+	span, ctx := c.startSpan(ctx, "PollForDecisionTask")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.PollForDecisionTask(ctx, pollRequest, opts...)
+}
+
+func (c *tracingClient) QueryWorkflow(
+	ctx context.Context,
+	queryRequest *m.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (resp *workflow.QueryWorkflowResponse, err error) {
+	span, ctx := c.startSpan(ctx, "QueryWorkflow")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.QueryWorkflow(ctx, queryRequest, opts...)
+}
+
+func (c *tracingClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *m.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "RespondQueryTaskCompleted")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.RespondQueryTaskCompleted(ctx, request, opts...)
+}
+
+func (c *tracingClient) CancelOutstandingPoll(
+	ctx context.Context,
+	request *m.CancelOutstandingPollRequest,
+	opts ...yarpc.CallOption) (err error) {
+	span, ctx := c.startSpan(ctx, "CancelOutstandingPoll")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.CancelOutstandingPoll(ctx, request, opts...)
+}
+
+func (c *tracingClient) DescribeTaskList(
+	ctx context.Context,
+	request *m.DescribeTaskListRequest,
+	opts ...yarpc.CallOption) (resp *workflow.DescribeTaskListResponse, err error) {
+	span, ctx := c.startSpan(ctx, "DescribeTaskList")
+	defer tracing.FinishSpan(span, &err)
+	return c.client.DescribeTaskList(ctx, request, opts...)
+}
+
+func (c *tracingClient) startSpan(ctx context.Context, operation string) (opentracing.Span, context.Context) {
+	return tracing.StartSpan(ctx, c.tracer, "matching-client."+operation)
+}
@@ -42,7 +42,11 @@ func main() {
 
 // startHandler is the handler for the cli start command
 func startHandler(c *cli.Context) {
+	dev := c.Bool("dev")
 	env := getEnvironment(c)
+	if dev && !c.GlobalIsSet("env") {
+		env = devEnvironment
+	}
 	zone := getZone(c)
 	configDir := getConfigDir(c)
 
@@ -63,6 +67,11 @@ func startHandler(c *cli.Context) {
 	if err != nil {
 		log.Fatal("Unable to get current directory")
 	}
+	if dev {
+		if err := setupDevSchema(&cfg.Persistence, getRootDir(c)); err != nil {
+			log.Fatal("Unable to setup schema", err)
+		}
+	}
 	if err := cassandra.VerifyCompatibleVersion(cfg.Persistence, dir); err != nil {
 		log.Fatal("Incompatible versions", err)
 	}
@@ -76,6 +85,10 @@ func startHandler(c *cli.Context) {
 		server.Start()
 	}
 
+	if dev {
+		registerDevDomain(&cfg)
+	}
+
 	select {}
 }
 
@@ -181,6 +194,10 @@ func buildCLI() *cli.App {
 					Value: strings.Join(validServices, ","),
 					Usage: "list of services to start",
 				},
+				cli.BoolFlag{
+					Name:  "dev",
+					Usage: "run all services in one process on a local sqlite database, creating the schema and a default domain on first start",
+				},
 			},
 			Action: func(c *cli.Context) {
 				startHandler(c)
@@ -0,0 +1,120 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/tools/common/schema"
+	"github.com/uber/cadence/tools/sql"
+)
+
+const (
+	// devEnvironment is the config environment used when the server is started with --dev
+	devEnvironment = "development_sqlite"
+	// devDomainName is the domain registered on startup in dev mode
+	devDomainName          = "default"
+	devDomainRetentionDays = 1
+
+	devCadenceSchemaFile       = "schema/sqlite/cadence/schema.sql"
+	devCadenceSchemaVersion    = "0.3"
+	devVisibilitySchemaFile    = "schema/sqlite/visibility/schema.sql"
+	devVisibilitySchemaVersion = "0.1"
+)
+
+// setupDevSchema creates the schema in the sqlite databases of the default
+// and visibility stores, databases which already have a schema are left as is
+func setupDevSchema(cfg *config.Persistence, rootDir string) error {
+	if err := setupDevStoreSchema(cfg, cfg.DefaultStore, filepath.Join(rootDir, devCadenceSchemaFile), devCadenceSchemaVersion); err != nil {
+		return err
+	}
+	return setupDevStoreSchema(cfg, cfg.VisibilityStore, filepath.Join(rootDir, devVisibilitySchemaFile), devVisibilitySchemaVersion)
+}
+
+func setupDevStoreSchema(cfg *config.Persistence, storeName string, schemaFile string, version string) error {
+	ds, ok := cfg.DataStores[storeName]
+	if !ok || ds.SQL == nil || ds.SQL.DriverName != sqlite.DriverName {
+		return fmt.Errorf("dev mode requires store %q to be a sqlite store", storeName)
+	}
+	if ds.SQL.ConnectProtocol == "memory" {
+		return fmt.Errorf("dev mode requires store %q to be file backed", storeName)
+	}
+	return sql.SetupSQLiteSchema(filepath.Join(ds.SQL.ConnectAddr, ds.SQL.DatabaseName), &schema.SetupConfig{
+		SchemaFilePath: schemaFile,
+		InitialVersion: version,
+	})
+}
+
+// registerDevDomain registers the default domain used in dev mode, it is
+// a no-op if the domain already exists from a previous run
+func registerDevDomain(cfg *config.Config) {
+	clusterMetadata := cfg.ClusterMetadata
+	if len(cfg.ClustersInfo.CurrentClusterName) != 0 {
+		clusterMetadata = cfg.ClustersInfo.ToClusterMetadata()
+	}
+	clusterName := clusterMetadata.CurrentClusterName
+
+	logger := loggerimpl.NewLogger(cfg.Log.NewZapLogger())
+	pFactory := persistencefactory.New(&cfg.Persistence, clusterName, nil, nil, logger)
+	defer pFactory.Close()
+	metadataMgr, err := pFactory.NewMetadataManager(persistencefactory.MetadataV2)
+	if err != nil {
+		log.Fatalf("error creating metadata manager: %v", err)
+	}
+	defer metadataMgr.Close()
+
+	_, err = metadataMgr.CreateDomain(context.Background(), &persistence.CreateDomainRequest{
+		Info: &persistence.DomainInfo{
+			ID:          uuid.New(),
+			Name:        devDomainName,
+			Status:      persistence.DomainStatusRegistered,
+			Description: "Default domain for local development",
+		},
+		Config: &persistence.DomainConfig{
+			Retention:  devDomainRetentionDays,
+			EmitMetric: true,
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: clusterName,
+			Clusters:          persistence.GetOrUseDefaultClusters(clusterName, nil),
+		},
+		IsGlobalDomain:  false,
+		FailoverVersion: common.EmptyVersion,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.DomainAlreadyExistsError); ok {
+			return
+		}
+		log.Fatalf("failed to register domain %q: %v", devDomainName, err)
+	}
+	log.Printf("Registered domain %q\n", devDomainName)
+}
@@ -21,6 +21,7 @@
 package main
 
 import (
+	"io"
 	"log"
 	"time"
 
@@ -33,8 +34,10 @@ import (
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership/dbmembership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -52,6 +55,10 @@ type (
 		cfg    *config.Config
 		doneC  chan struct{}
 		daemon common.Daemon
+		// tracerCloser flushes the spans of the service once it is stopped
+		tracerCloser io.Closer
+		// searchAttributes reloads the search attributes registered in ElasticSearch
+		searchAttributes common.Daemon
 	}
 )
 
@@ -97,6 +104,16 @@ func (s *server) Stop() {
 			log.Printf("timed out waiting for server %v to exit\n", s.name)
 		}
 	}
+
+	if s.searchAttributes != nil {
+		s.searchAttributes.Stop()
+	}
+
+	if s.tracerCloser != nil {
+		if err := s.tracerCloser.Close(); err != nil {
+			log.Printf("error flushing the spans of server %v: %v\n", s.name, err)
+		}
+	}
 }
 
 // startService starts a service with the given name and config
@@ -109,11 +126,6 @@ func (s *server) startService() common.Daemon {
 	params.Logger = loggerimpl.NewLogger(s.cfg.Log.NewZapLogger())
 	params.PersistenceConfig = s.cfg.Persistence
 
-	params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
-	if err != nil {
-		log.Fatalf("error creating ringpop factory: %v", err)
-	}
-
 	params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger.WithTags(tag.Service(params.Name)), s.doneC)
 	if err != nil {
 		log.Printf("error creating file based dynamic config client, use no-op config client instead. error: %v", err)
@@ -121,9 +133,16 @@ func (s *server) startService() common.Daemon {
 	}
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)
 
+	params.Tracer, s.tracerCloser, err = s.cfg.Tracing.NewTracer(params.Name, params.Logger)
+	if err != nil {
+		log.Fatalf("error creating tracer: %v", err)
+	}
+
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger)
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	rpcFactory := svcCfg.RPC.NewFactory(params.Name, params.Logger, params.Tracer)
+	params.RPCFactory = rpcFactory
+	params.HTTPGatewayAddress = rpcFactory.GetHTTPGatewayAddress()
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 
 	archivalStatus := dc.GetStringProperty(dynamicconfig.ArchivalStatus, s.cfg.Archival.Status)
@@ -152,6 +171,9 @@ func (s *server) startService() common.Daemon {
 		s.cfg.Archival.DefaultBucket,
 		enableReadFromArchival(),
 	)
+
+	params.MembershipFactory = s.newMembershipFactory(&params)
+
 	params.DispatcherProvider = client.NewIPYarpcDispatcherProvider()
 	params.ESConfig = &s.cfg.ElasticSearch
 	params.ESConfig.Enable = dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, params.ESConfig.Enable)() // force override with dynamic config
@@ -175,6 +197,25 @@ func (s *server) startService() common.Daemon {
 		if !ok || len(indexName) == 0 {
 			log.Fatalf("elastic search config missing visibility index")
 		}
+
+		clusterClients, err := elasticsearch.NewClusterClients(&s.cfg.ElasticSearch)
+		if err != nil {
+			log.Fatalf("error creating elastic search cluster clients: %v", err)
+		}
+		params.ESRouter = elasticsearch.NewRouter(
+			esClient,
+			indexName,
+			clusterClients,
+			s.cfg.ElasticSearch.GetClusterIndices(),
+			dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.ESVisibilityCluster, elasticsearch.DefaultCluster),
+			dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.ESVisibilityIndex, ""),
+		)
+
+		// search attributes added through the admin API are read back from the visibility index mapping
+		searchAttributesClient := elasticsearch.NewSearchAttributesClient(params.DynamicConfig, esClient, indexName, params.Logger)
+		searchAttributesClient.Start()
+		s.searchAttributes = searchAttributesClient
+		params.DynamicConfig = searchAttributesClient
 	}
 
 	dispatcher, err := params.DispatcherProvider.Get(common.FrontendServiceName, s.cfg.PublicClient.HostPort)
@@ -228,6 +269,28 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newMembershipFactory returns the membership factory selected by the ringpop config
+func (s *server) newMembershipFactory(params *service.BootstrapParams) service.MembershipMonitorFactory {
+	if s.cfg.Ringpop.Membership != config.MembershipModeDB {
+		factory, err := s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
+		if err != nil {
+			log.Fatalf("error creating ringpop factory: %v", err)
+		}
+		return factory
+	}
+
+	pFactory := persistencefactory.New(&params.PersistenceConfig, params.ClusterMetadata.GetCurrentClusterName(), params.MetricsClient, params.Tracer, params.Logger)
+	manager, err := pFactory.NewClusterMembershipManager()
+	if err != nil {
+		log.Fatalf("error creating cluster membership manager: %v", err)
+	}
+	factory, err := dbmembership.NewFactory(&s.cfg.Ringpop, manager, params.Logger, params.Name)
+	if err != nil {
+		log.Fatalf("error creating db membership factory: %v", err)
+	}
+	return factory
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
@@ -0,0 +1,401 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const generatedHeader = "// Code generated by thrift2proto. DO NOT EDIT.\n"
+
+var protoBaseTypes = map[string]string{
+	"bool":   "bool",
+	"i32":    "int32",
+	"i64":    "int64",
+	"double": "double",
+	"string": "string",
+	"binary": "bytes",
+}
+
+func (g *generator) protoPreamble(buf *bytes.Buffer, imports ...string) {
+	buf.WriteString(g.license)
+	buf.WriteString(generatedHeader)
+	buf.WriteString("\nsyntax = \"proto2\";\n\n")
+	fmt.Fprintf(buf, "package %v;\n\n", protoPackage)
+	for _, imp := range imports {
+		fmt.Fprintf(buf, "import \"%v/%v\";\n", protoImportPath, imp)
+	}
+	if len(imports) > 0 {
+		buf.WriteString("\n")
+	}
+	fmt.Fprintf(buf, "option go_package = %q;\n", protoGoPackage)
+	buf.WriteString("option java_multiple_files = true;\n")
+	fmt.Fprintf(buf, "option java_package = %q;\n", protoJavaPackage)
+}
+
+// sharedProto generates the messages and enums mirroring the shared.thrift types used by the service
+func (g *generator) sharedProto() (string, error) {
+	var buf bytes.Buffer
+	g.protoPreamble(&buf)
+	for _, name := range g.types {
+		buf.WriteString("\n")
+		if enum, ok := g.shared.Enums[name]; ok {
+			fmt.Fprintf(&buf, "enum %v {\n", name)
+			for _, v := range enum.Values {
+				fmt.Fprintf(&buf, "  %v = %v;\n", protoEnumValueName(name, v.Name), v.Value)
+			}
+			buf.WriteString("}\n")
+			continue
+		}
+		st := g.shared.Structs[name]
+		fmt.Fprintf(&buf, "message %v {\n", name)
+		for _, f := range st.Fields {
+			fieldType, err := protoFieldType(f.Type)
+			if err != nil {
+				return "", fmt.Errorf("%v.%v: %v", name, f.Name, err)
+			}
+			fmt.Fprintf(&buf, "  %v %v = %v;\n", fieldType, protoFieldName(f.Name), f.ID)
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.String(), nil
+}
+
+// serviceProto generates the service definition along with the messages
+// for the methods which take no argument or return nothing
+func (g *generator) serviceProto() (string, error) {
+	var buf bytes.Buffer
+	g.protoPreamble(&buf, "shared.proto")
+	fmt.Fprintf(&buf, "\n// %v mirrors the %v defined in cadence.thrift.\n", g.service.Name, g.service.Name)
+	fmt.Fprintf(&buf, "service %v {\n", g.service.Name)
+	for i, m := range g.service.Methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		for _, line := range strings.Split(m.Doc, "\n") {
+			if line != "" {
+				fmt.Fprintf(&buf, "  // %v\n", line)
+			}
+		}
+		fmt.Fprintf(&buf, "  rpc %v(%v) returns (%v);\n", m.Name, m.protoRequest(), m.protoResponse())
+	}
+	buf.WriteString("}\n")
+	for _, m := range g.service.Methods {
+		if m.Request == "" {
+			fmt.Fprintf(&buf, "\n// %v is the empty request of %v.\n", m.protoRequest(), m.Name)
+			fmt.Fprintf(&buf, "message %v {\n}\n", m.protoRequest())
+		}
+		if m.Response == "void" {
+			fmt.Fprintf(&buf, "\n// %v is the empty response of %v.\n", m.protoResponse(), m.Name)
+			fmt.Fprintf(&buf, "message %v {\n}\n", m.protoResponse())
+		}
+	}
+	return buf.String(), nil
+}
+
+// converters generates the go functions converting the thriftrw types to the protobuf types and back
+func (g *generator) converters() (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(g.license)
+	buf.WriteString(generatedHeader)
+	buf.WriteString("\npackage protoconv\n\n")
+	buf.WriteString("import (\n")
+	buf.WriteString("\t\"github.com/uber/cadence/.gen/go/shared\"\n")
+	buf.WriteString("\tapiv1 \"github.com/uber/cadence/.gen/proto/api/v1\"\n")
+	buf.WriteString(")\n")
+
+	helpers := make(map[string]string)
+	for _, name := range g.types {
+		st, ok := g.shared.Structs[name]
+		if !ok {
+			continue
+		}
+		for _, dir := range []direction{toProto, fromProto} {
+			from, to := dir.types()
+			fmt.Fprintf(&buf, "\n// %v%v converts a %v %v to %v\n", dir, name, dir.fromName(), name, dir.toName())
+			fmt.Fprintf(&buf, "func %v%v(in *%v.%v) *%v.%v {\n", dir, name, from, name, to, name)
+			buf.WriteString("\tif in == nil {\n\t\treturn nil\n\t}\n")
+			fmt.Fprintf(&buf, "\treturn &%v.%v{\n", to, name)
+			for _, f := range st.Fields {
+				thriftName := g.goFieldNames[name][f.Name]
+				protoName := goCamelCase(protoFieldName(f.Name))
+				fromField, toField := thriftName, protoName
+				if dir == fromProto {
+					fromField, toField = protoName, thriftName
+				}
+				fmt.Fprintf(&buf, "\t\t%v: %v,\n", toField, g.convertExpr(dir, f.Type, "in."+fromField, helpers))
+			}
+			buf.WriteString("\t}\n}\n")
+		}
+	}
+
+	names := make([]string, 0, len(helpers))
+	for name := range helpers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		buf.WriteString(helpers[name])
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+type direction string
+
+const (
+	toProto   direction = "ToProto"
+	fromProto direction = "FromProto"
+)
+
+// types returns the go package names of the source and destination types
+func (d direction) types() (string, string) {
+	if d == toProto {
+		return "shared", "apiv1"
+	}
+	return "apiv1", "shared"
+}
+
+func (d direction) fromName() string {
+	if d == toProto {
+		return "thrift"
+	}
+	return "protobuf"
+}
+
+func (d direction) toName() string {
+	if d == toProto {
+		return "protobuf"
+	}
+	return "thrift"
+}
+
+// helperPrefix is the prefix of the unexported helpers converting in this direction
+func (d direction) helperPrefix() string {
+	if d == toProto {
+		return "toProto"
+	}
+	return "fromProto"
+}
+
+// convertExpr returns the go expression converting the value of the given type
+func (g *generator) convertExpr(dir direction, t *thriftType, value string, helpers map[string]string) string {
+	switch {
+	case t.isBase():
+		return value
+	case t.Name == "list" && t.Elem.isBase(), t.Name == "map" && t.Elem.isBase():
+		return value
+	case t.Name == "list":
+		return fmt.Sprintf("%v(%v)", g.listHelper(dir, t.Elem, helpers), value)
+	case t.Name == "map":
+		return fmt.Sprintf("%v(%v)", g.mapHelper(dir, t.Elem, helpers), value)
+	case g.isEnum(t.Name):
+		return fmt.Sprintf("%v(%v)", g.enumHelper(dir, t.Name, helpers), value)
+	}
+	return fmt.Sprintf("%v%v(%v)", dir, t.Name, value)
+}
+
+func (g *generator) isEnum(name string) bool {
+	_, ok := g.shared.Enums[name]
+	return ok
+}
+
+// enumHelper generates the conversion of an optional enum, the numeric values of the
+// protobuf enums are kept identical to the thrift ones so that a cast is sufficient
+func (g *generator) enumHelper(dir direction, name string, helpers map[string]string) string {
+	from, to := dir.types()
+	fn := dir.helperPrefix() + name
+	helpers[fn] = fmt.Sprintf(`
+func %v(in *%v.%v) *%v.%v {
+	if in == nil {
+		return nil
+	}
+	out := %v.%v(*in)
+	return &out
+}
+`, fn, from, name, to, name, to, name)
+	return fn
+}
+
+func (g *generator) listHelper(dir direction, elem *thriftType, helpers map[string]string) string {
+	from, to := dir.types()
+	fn := dir.helperPrefix() + elem.Name + "List"
+	if g.isEnum(elem.Name) {
+		helpers[fn] = fmt.Sprintf(`
+func %v(in []%v.%v) []%v.%v {
+	if in == nil {
+		return nil
+	}
+	out := make([]%v.%v, len(in))
+	for i, v := range in {
+		out[i] = %v.%v(v)
+	}
+	return out
+}
+`, fn, from, elem.Name, to, elem.Name, to, elem.Name, to, elem.Name)
+		return fn
+	}
+	helpers[fn] = fmt.Sprintf(`
+func %v(in []*%v.%v) []*%v.%v {
+	if in == nil {
+		return nil
+	}
+	out := make([]*%v.%v, len(in))
+	for i, v := range in {
+		out[i] = %v%v(v)
+	}
+	return out
+}
+`, fn, from, elem.Name, to, elem.Name, to, elem.Name, dir, elem.Name)
+	return fn
+}
+
+func (g *generator) mapHelper(dir direction, elem *thriftType, helpers map[string]string) string {
+	from, to := dir.types()
+	fn := dir.helperPrefix() + elem.Name + "Map"
+	if g.isEnum(elem.Name) {
+		helpers[fn] = fmt.Sprintf(`
+func %v(in map[string]%v.%v) map[string]%v.%v {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]%v.%v, len(in))
+	for k, v := range in {
+		out[k] = %v.%v(v)
+	}
+	return out
+}
+`, fn, from, elem.Name, to, elem.Name, to, elem.Name, to, elem.Name)
+		return fn
+	}
+	helpers[fn] = fmt.Sprintf(`
+func %v(in map[string]*%v.%v) map[string]*%v.%v {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]*%v.%v, len(in))
+	for k, v := range in {
+		out[k] = %v%v(v)
+	}
+	return out
+}
+`, fn, from, elem.Name, to, elem.Name, to, elem.Name, dir, elem.Name)
+	return fn
+}
+
+func protoFieldType(t *thriftType) (string, error) {
+	switch t.Name {
+	case "list":
+		if t.Elem.Name == "list" || t.Elem.Name == "map" {
+			return "", fmt.Errorf("nested containers are not supported")
+		}
+		elem, err := protoFieldType(t.Elem)
+		if err != nil {
+			return "", err
+		}
+		return "repeated " + strings.TrimPrefix(elem, "optional "), nil
+	case "map":
+		if t.Elem.Name == "list" || t.Elem.Name == "map" {
+			return "", fmt.Errorf("nested containers are not supported")
+		}
+		elem, err := protoFieldType(t.Elem)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("map<string, %v>", strings.TrimPrefix(elem, "optional ")), nil
+	}
+	if base, ok := protoBaseTypes[t.Name]; ok {
+		return "optional " + base, nil
+	}
+	if t.isBase() {
+		// thriftrw maps these to go types which have no protobuf counterpart
+		return "", fmt.Errorf("type %v is not supported", t.Name)
+	}
+	return "optional " + t.Name, nil
+}
+
+// protoFieldName converts a thrift camel case field name to the protobuf snake case style,
+// a run of upper case letters is kept as a single word, e.g. shardIDs becomes shard_ids
+func protoFieldName(name string) string {
+	runes := []rune(name)
+	var out []rune
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				plural := nextLower && runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2]))
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower && !plural) {
+					out = append(out, '_')
+				}
+			}
+			r = unicode.ToLower(r)
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// protoEnumValueName prefixes the upper snake case enum value with the enum name,
+// since protobuf enum values share the scope of the package
+func protoEnumValueName(enum string, value string) string {
+	if strings.ToUpper(value) != value {
+		value = protoFieldName(value)
+	}
+	return strings.ToUpper(protoFieldName(enum) + "_" + value)
+}
+
+// goCamelCase mirrors the naming of the fields in the protobuf generated go code
+func goCamelCase(s string) string {
+	var out []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' && i+1 < len(s) && isASCIILower(s[i+1]) {
+			continue
+		}
+		if '0' <= c && c <= '9' {
+			out = append(out, c)
+			continue
+		}
+		if isASCIILower(c) {
+			c ^= ' '
+		}
+		out = append(out, c)
+		for i+1 < len(s) && isASCIILower(s[i+1]) {
+			i++
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type (
+	// command line config params
+	config struct {
+		rootDir      string
+		serviceName  string
+		protoDir     string
+		converterOut string
+	}
+
+	// generator mirrors the thrift WorkflowService in protobuf
+	generator struct {
+		config  *config
+		license string
+		shared  *thriftModule
+		service *thriftService
+		// goFieldNames maps a thrift struct name and field name to the
+		// name of the field in the thriftrw generated go struct
+		goFieldNames map[string]map[string]string
+		// types are the shared types reachable from the service, in declaration order
+		types []string
+	}
+)
+
+const (
+	licenseFileName   = "LICENSE"
+	sharedThriftFile  = "idl/github.com/uber/cadence/shared.thrift"
+	serviceThriftFile = "idl/github.com/uber/cadence/cadence.thrift"
+	sharedGoTypesFile = ".gen/go/shared/types.go"
+
+	protoPackage     = "uber.cadence.api.v1"
+	protoGoPackage   = "apiv1"
+	protoJavaPackage = "com.uber.cadence.api.v1"
+	protoImportPath  = "uber/cadence/api/v1"
+)
+
+// command line utility that generates the protobuf IDL mirroring the
+// thrift WorkflowService, along with the converters between the thriftrw
+// and the protobuf generated types. Usage as follows:
+//
+//	go run ./cmd/tools/thrift2proto
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.rootDir, "rootDir", ".", "project root directory")
+	flag.StringVar(&cfg.serviceName, "service", "WorkflowService", "name of the thrift service to mirror")
+	flag.StringVar(&cfg.protoDir, "protoDir", "idl/github.com/uber/cadence/proto/"+protoImportPath,
+		"directory to write the protobuf IDL to")
+	flag.StringVar(&cfg.converterOut, "converterOut", "common/protoconv/types.go",
+		"file to write the thrift/protobuf converters to")
+	flag.Parse()
+
+	if err := run(&cfg); err != nil {
+		fmt.Println(err)
+		os.Exit(-1)
+	}
+}
+
+func run(cfg *config) error {
+	license, err := ioutil.ReadFile(filepath.Join(cfg.rootDir, licenseFileName))
+	if err != nil {
+		return fmt.Errorf("error reading license file, err=%v", err)
+	}
+	shared, err := parseThriftFile(filepath.Join(cfg.rootDir, sharedThriftFile))
+	if err != nil {
+		return err
+	}
+	services, err := parseThriftFile(filepath.Join(cfg.rootDir, serviceThriftFile))
+	if err != nil {
+		return err
+	}
+	service, ok := services.Services[cfg.serviceName]
+	if !ok {
+		return fmt.Errorf("service %v not found in %v", cfg.serviceName, serviceThriftFile)
+	}
+	goFieldNames, err := parseGoFieldNames(filepath.Join(cfg.rootDir, sharedGoTypesFile))
+	if err != nil {
+		return err
+	}
+
+	gen := &generator{
+		config:       cfg,
+		license:      string(license),
+		shared:       shared,
+		service:      service,
+		goFieldNames: goFieldNames,
+	}
+	if err := gen.resolveTypes(); err != nil {
+		return err
+	}
+
+	outputs := map[string]func() (string, error){
+		filepath.Join(cfg.protoDir, "shared.proto"):           gen.sharedProto,
+		filepath.Join(cfg.protoDir, "workflow_service.proto"): gen.serviceProto,
+		cfg.converterOut: gen.converters,
+	}
+	for path, fn := range outputs {
+		content, err := fn()
+		if err != nil {
+			return fmt.Errorf("failed to generate %v, err=%v", path, err)
+		}
+		path = filepath.Join(cfg.rootDir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveTypes collects the shared types reachable from the service methods
+func (g *generator) resolveTypes() error {
+	reachable := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if name == "" || reachable[name] {
+			return nil
+		}
+		if _, ok := g.shared.Enums[name]; ok {
+			reachable[name] = true
+			return nil
+		}
+		st, ok := g.shared.Structs[name]
+		if !ok {
+			return fmt.Errorf("type %v not found in %v", name, sharedThriftFile)
+		}
+		reachable[name] = true
+		for _, f := range st.Fields {
+			if f.Required {
+				return fmt.Errorf("required field %v.%v is not supported", name, f.Name)
+			}
+			if _, ok := g.goFieldNames[name][f.Name]; !ok {
+				return fmt.Errorf("field %v.%v not found in %v", name, f.Name, sharedGoTypesFile)
+			}
+			for t := f.Type; t != nil; t = t.Elem {
+				if t.Key != nil && t.Key.Name != "string" {
+					return fmt.Errorf("map field %v.%v must have string keys", name, f.Name)
+				}
+				if t.Name != "list" && t.Name != "map" && !t.isBase() {
+					if err := visit(t.Name); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	for _, m := range g.service.Methods {
+		if err := visit(m.Request); err != nil {
+			return err
+		}
+		if m.Response != "void" {
+			if err := visit(m.Response); err != nil {
+				return err
+			}
+		}
+	}
+	for _, name := range g.shared.Order {
+		if reachable[name] {
+			g.types = append(g.types, name)
+		}
+	}
+	for _, m := range g.service.Methods {
+		for _, name := range []string{m.protoRequest(), m.protoResponse()} {
+			if st, ok := g.shared.Structs[name]; ok && !reachable[name] && !st.IsException {
+				return fmt.Errorf("generated message %v collides with a shared type", name)
+			}
+		}
+	}
+	return nil
+}
+
+// parseGoFieldNames reads the thriftrw generated go types and maps the
+// json name of each field to its go name
+func parseGoFieldNames(path string) (map[string]map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]map[string]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			st, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			fields := make(map[string]string)
+			for _, f := range st.Fields.List {
+				if f.Tag == nil || len(f.Names) != 1 {
+					continue
+				}
+				tag, err := strconv.Unquote(f.Tag.Value)
+				if err != nil {
+					return nil, err
+				}
+				jsonName := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+				fields[jsonName] = f.Names[0].Name
+			}
+			result[typeSpec.Name.Name] = fields
+		}
+	}
+	return result, nil
+}
+
+// protoRequest is the name of the protobuf request message of the method
+func (m *thriftMethod) protoRequest() string {
+	if m.Request == "" {
+		return m.Name + "Request"
+	}
+	return m.Request
+}
+
+// protoResponse is the name of the protobuf response message of the method
+func (m *thriftMethod) protoResponse() string {
+	if m.Response == "void" {
+		return m.Name + "Response"
+	}
+	return m.Response
+}
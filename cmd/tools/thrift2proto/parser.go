@@ -0,0 +1,264 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// thriftType is a (possibly container) type referenced by a thrift field
+	thriftType struct {
+		// Name is the base type or the user defined type name for
+		// non container types, "list" or "map" otherwise
+		Name string
+		Key  *thriftType
+		Elem *thriftType
+	}
+
+	thriftField struct {
+		ID       int
+		Name     string
+		Type     *thriftType
+		Required bool
+	}
+
+	thriftStruct struct {
+		Name        string
+		IsException bool
+		Fields      []*thriftField
+	}
+
+	thriftEnumValue struct {
+		Name  string
+		Value int
+	}
+
+	thriftEnum struct {
+		Name   string
+		Values []*thriftEnumValue
+	}
+
+	thriftMethod struct {
+		Name     string
+		Doc      string
+		Request  string
+		Response string
+	}
+
+	thriftService struct {
+		Name    string
+		Methods []*thriftMethod
+	}
+
+	// thriftModule is the subset of a thrift file needed to mirror it in protobuf
+	thriftModule struct {
+		Structs  map[string]*thriftStruct
+		Enums    map[string]*thriftEnum
+		Services map[string]*thriftService
+		// Order keeps the declaration order of structs and enums
+		Order []string
+	}
+)
+
+var (
+	lineCommentRegex   = regexp.MustCompile(`(?m)(//|#).*$`)
+	annotationRegex    = regexp.MustCompile(`\([^()]*=[^()]*\)`)
+	declarationRegex   = regexp.MustCompile(`(?s)(struct|exception|union|enum|service)\s+(\w+)\s*\{(.*?)\n\}`)
+	fieldRegex         = regexp.MustCompile(`^(\d+)\s*:\s*(optional|required)?\s*([\w.]+(?:<[\w.,\s]+>)?)\s+(\w+)`)
+	enumValueRegex     = regexp.MustCompile(`^(\w+)(?:\s*=\s*(\d+))?$`)
+	enumSeparatorRegex = regexp.MustCompile(`[,;]|\n`)
+	methodRegex        = regexp.MustCompile(`(?s)(/\*\*.*?\*/)?\s*([\w.]+)\s+(\w+)\s*\(\s*(?:\d+\s*:\s*([\w.]+)\s+\w+)?\s*\)\s*throws\s*\(.*?\)`)
+	blockCommentRegex  = regexp.MustCompile(`(?s)/\*.*?\*/`)
+)
+
+// parseThriftFile parses the structs, exceptions, enums and services declared in a thrift file,
+// types qualified with an included module name are resolved by dropping the module prefix
+func parseThriftFile(path string) (*thriftModule, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	content := lineCommentRegex.ReplaceAllString(string(data), "")
+	content = annotationRegex.ReplaceAllString(content, "")
+
+	module := &thriftModule{
+		Structs:  make(map[string]*thriftStruct),
+		Enums:    make(map[string]*thriftEnum),
+		Services: make(map[string]*thriftService),
+	}
+	for _, match := range declarationRegex.FindAllStringSubmatch(content, -1) {
+		kind, name, body := match[1], match[2], match[3]
+		switch kind {
+		case "service":
+			service, err := parseService(name, body)
+			if err != nil {
+				return nil, err
+			}
+			module.Services[name] = service
+		case "enum":
+			enum, err := parseEnum(name, blockCommentRegex.ReplaceAllString(body, ""))
+			if err != nil {
+				return nil, err
+			}
+			module.Enums[name] = enum
+			module.Order = append(module.Order, name)
+		default:
+			st, err := parseStruct(name, kind == "exception", blockCommentRegex.ReplaceAllString(body, ""))
+			if err != nil {
+				return nil, err
+			}
+			module.Structs[name] = st
+			module.Order = append(module.Order, name)
+		}
+	}
+	return module, nil
+}
+
+func parseStruct(name string, isException bool, body string) (*thriftStruct, error) {
+	st := &thriftStruct{Name: name, IsException: isException}
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(strings.TrimRight(strings.TrimSpace(line), ",;"))
+		if line == "" {
+			continue
+		}
+		match := fieldRegex.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("unable to parse field %q of %v", line, name)
+		}
+		id, _ := strconv.Atoi(match[1])
+		fieldType, err := parseType(match[3])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse field %q of %v: %v", line, name, err)
+		}
+		st.Fields = append(st.Fields, &thriftField{
+			ID:       id,
+			Name:     match[4],
+			Type:     fieldType,
+			Required: match[2] == "required",
+		})
+	}
+	return st, nil
+}
+
+func parseEnum(name string, body string) (*thriftEnum, error) {
+	enum := &thriftEnum{Name: name}
+	next := 0
+	// separators between enum values are optional in thrift
+	for _, item := range enumSeparatorRegex.Split(body, -1) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		match := enumValueRegex.FindStringSubmatch(item)
+		if match == nil {
+			return nil, fmt.Errorf("unable to parse value %q of enum %v", item, name)
+		}
+		if match[2] != "" {
+			next, _ = strconv.Atoi(match[2])
+		}
+		enum.Values = append(enum.Values, &thriftEnumValue{Name: match[1], Value: next})
+		next++
+	}
+	return enum, nil
+}
+
+func parseService(name string, body string) (*thriftService, error) {
+	service := &thriftService{Name: name}
+	for _, match := range methodRegex.FindAllStringSubmatch(body, -1) {
+		service.Methods = append(service.Methods, &thriftMethod{
+			Name:     match[3],
+			Doc:      parseDocComment(match[1]),
+			Request:  unqualify(match[4]),
+			Response: unqualify(match[2]),
+		})
+	}
+	if len(service.Methods) == 0 {
+		return nil, fmt.Errorf("no methods found in service %v", name)
+	}
+	return service, nil
+}
+
+func parseType(s string) (*thriftType, error) {
+	s = strings.Replace(s, " ", "", -1)
+	switch {
+	case strings.HasPrefix(s, "list<") && strings.HasSuffix(s, ">"):
+		elem, err := parseType(s[len("list<") : len(s)-1])
+		if err != nil {
+			return nil, err
+		}
+		return &thriftType{Name: "list", Elem: elem}, nil
+	case strings.HasPrefix(s, "map<") && strings.HasSuffix(s, ">"):
+		parts := strings.SplitN(s[len("map<"):len(s)-1], ",", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid map type %v", s)
+		}
+		key, err := parseType(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		elem, err := parseType(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		return &thriftType{Name: "map", Key: key, Elem: elem}, nil
+	case strings.ContainsAny(s, "<>"):
+		return nil, fmt.Errorf("unsupported container type %v", s)
+	}
+	return &thriftType{Name: unqualify(s)}, nil
+}
+
+// parseDocComment strips the comment markers from a /** ... **/ block
+func parseDocComment(comment string) string {
+	if comment == "" {
+		return ""
+	}
+	var lines []string
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimLeft(line, "/*")
+		line = strings.TrimRight(line, "*/")
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func unqualify(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func (t *thriftType) isBase() bool {
+	switch t.Name {
+	case "bool", "byte", "i16", "i32", "i64", "double", "string", "binary":
+		return true
+	}
+	return false
+}
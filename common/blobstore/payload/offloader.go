@@ -0,0 +1,325 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	keyExtension       = "payload"
+	referenceSeparator = "/"
+)
+
+var (
+	// referencePrefix marks a payload as a reference to a blob, it starts with a zero byte
+	// so that it can never be confused with a JSON or thrift encoded payload
+	referencePrefix = []byte("\x00cadence-offloaded-payload:")
+
+	// ErrOffloadNotConfigured is returned when a reference is found but no blobstore is configured
+	ErrOffloadNotConfigured = errors.New("payload is offloaded to blobstore but blobstore is not configured")
+	// ErrInvalidReference is returned when a reference cannot be parsed
+	ErrInvalidReference = errors.New("invalid offloaded payload reference")
+)
+
+type (
+	// Offloader moves payloads which are too large to be kept in history to the blobstore,
+	// leaving a reference to the blob in the event in their place
+	Offloader interface {
+		// IsEnabled returns true if payloads of the given domain are offloaded
+		IsEnabled(domainName string) bool
+		// OffloadEvents replaces the payloads of events above the domain threshold with references,
+		// events are modified in place
+		OffloadEvents(ctx context.Context, domainID string, domainName string, runID string, events []*shared.HistoryEvent) error
+		// HydrateEvents replaces references in events with the payloads they point to,
+		// events are modified in place
+		HydrateEvents(ctx context.Context, events []*shared.HistoryEvent) error
+		// Hydrate returns the payload a reference points to, data is returned as is when it is not a reference
+		Hydrate(ctx context.Context, data []byte) ([]byte, error)
+		// DeleteWorkflowPayloads deletes all payloads offloaded for the given run
+		DeleteWorkflowPayloads(ctx context.Context, domainID string, runID string) error
+	}
+
+	// Config is the config for payload offloading
+	Config struct {
+		// EnableOffload enables offloading payloads of a domain
+		EnableOffload dynamicconfig.BoolPropertyFnWithDomainFilter
+		// Threshold is the size above which payloads of a domain are offloaded
+		Threshold dynamicconfig.IntPropertyFnWithDomainFilter
+		// Bucket is the blobstore bucket payloads are offloaded to
+		Bucket dynamicconfig.StringPropertyFn
+	}
+
+	offloaderImpl struct {
+		blobstoreClient blobstore.Client
+		config          *Config
+	}
+)
+
+var _ Offloader = (*offloaderImpl)(nil)
+
+// NewOffloader returns a new Offloader, blobstoreClient can be nil in which case nothing is offloaded
+func NewOffloader(blobstoreClient blobstore.Client, config *Config) Offloader {
+	return &offloaderImpl{
+		blobstoreClient: blobstoreClient,
+		config:          config,
+	}
+}
+
+// IsReference returns true if the payload is a reference to an offloaded payload
+func IsReference(data []byte) bool {
+	return bytes.HasPrefix(data, referencePrefix)
+}
+
+func (o *offloaderImpl) IsEnabled(domainName string) bool {
+	return o.blobstoreClient != nil &&
+		o.config.EnableOffload(domainName) &&
+		o.config.Threshold(domainName) > 0 &&
+		o.config.Bucket() != ""
+}
+
+func (o *offloaderImpl) OffloadEvents(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	runID string,
+	events []*shared.HistoryEvent,
+) error {
+
+	if !o.IsEnabled(domainName) {
+		return nil
+	}
+	bucket := o.config.Bucket()
+	threshold := o.config.Threshold(domainName)
+
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			data := *field
+			if IsReference(data) {
+				if belongsToRun(data, domainID, runID) {
+					continue
+				}
+				// payload was copied over from another run, e.g. the input of a child workflow,
+				// give this run its own copy so that it outlives the retention of the other run
+				hydrated, err := o.Hydrate(ctx, data)
+				if err != nil {
+					return err
+				}
+				data = hydrated
+				*field = data
+			}
+			if len(data) <= threshold {
+				continue
+			}
+			reference, err := o.upload(ctx, bucket, domainID, runID, data)
+			if err != nil {
+				return err
+			}
+			*field = reference
+		}
+	}
+	return nil
+}
+
+func (o *offloaderImpl) HydrateEvents(ctx context.Context, events []*shared.HistoryEvent) error {
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			if !IsReference(*field) {
+				continue
+			}
+			data, err := o.Hydrate(ctx, *field)
+			if err != nil {
+				return err
+			}
+			*field = data
+		}
+	}
+	return nil
+}
+
+func (o *offloaderImpl) Hydrate(ctx context.Context, data []byte) ([]byte, error) {
+	if !IsReference(data) {
+		return data, nil
+	}
+	if o.blobstoreClient == nil {
+		return nil, ErrOffloadNotConfigured
+	}
+	bucket, key, err := parseReference(data)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := o.blobstoreClient.Download(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return payload.Body, nil
+}
+
+func (o *offloaderImpl) DeleteWorkflowPayloads(ctx context.Context, domainID string, runID string) error {
+	if o.blobstoreClient == nil {
+		return nil
+	}
+	bucket := o.config.Bucket()
+	if bucket == "" {
+		return nil
+	}
+	keys, err := o.blobstoreClient.ListByPrefix(ctx, bucket, keyPrefix(domainID, runID))
+	if err != nil {
+		if err == blobstore.ErrBucketNotExists {
+			return nil
+		}
+		return err
+	}
+	for _, key := range keys {
+		if _, err := o.blobstoreClient.Delete(ctx, bucket, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *offloaderImpl) upload(ctx context.Context, bucket string, domainID string, runID string, data []byte) ([]byte, error) {
+	key, err := blob.NewKey(keyExtension, domainID, runID, uuid.New())
+	if err != nil {
+		return nil, err
+	}
+	if err := o.blobstoreClient.Upload(ctx, bucket, key, blob.NewBlob(data, nil)); err != nil {
+		return nil, err
+	}
+	reference := make([]byte, 0, len(referencePrefix)+len(bucket)+len(referenceSeparator)+len(key.String()))
+	reference = append(reference, referencePrefix...)
+	reference = append(reference, bucket+referenceSeparator+key.String()...)
+	return reference, nil
+}
+
+func parseReference(data []byte) (string, blob.Key, error) {
+	reference := string(data[len(referencePrefix):])
+	index := strings.LastIndex(reference, referenceSeparator)
+	if index <= 0 {
+		return "", nil, ErrInvalidReference
+	}
+	key, err := blob.NewKeyFromString(reference[index+1:])
+	if err != nil {
+		return "", nil, fmt.Errorf("%v: %v", ErrInvalidReference, err)
+	}
+	return reference[:index], key, nil
+}
+
+func belongsToRun(data []byte, domainID string, runID string) bool {
+	_, key, err := parseReference(data)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(key.String(), keyPrefix(domainID, runID))
+}
+
+func keyPrefix(domainID string, runID string) string {
+	return domainID + "_" + runID + "_"
+}
+
+// payloadFields returns pointers to the user payloads of an event which are eligible for offloading
+func payloadFields(event *shared.HistoryEvent) []*[]byte {
+	switch event.GetEventType() {
+	case shared.EventTypeWorkflowExecutionStarted:
+		if attr := event.WorkflowExecutionStartedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input, &attr.ContinuedFailureDetails, &attr.LastCompletionResult}
+		}
+	case shared.EventTypeWorkflowExecutionCompleted:
+		if attr := event.WorkflowExecutionCompletedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Result}
+		}
+	case shared.EventTypeWorkflowExecutionFailed:
+		if attr := event.WorkflowExecutionFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeWorkflowExecutionContinuedAsNew:
+		if attr := event.WorkflowExecutionContinuedAsNewEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input, &attr.FailureDetails, &attr.LastCompletionResult}
+		}
+	case shared.EventTypeWorkflowExecutionSignaled:
+		if attr := event.WorkflowExecutionSignaledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case shared.EventTypeWorkflowExecutionTerminated:
+		if attr := event.WorkflowExecutionTerminatedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeWorkflowExecutionCanceled:
+		if attr := event.WorkflowExecutionCanceledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeActivityTaskScheduled:
+		if attr := event.ActivityTaskScheduledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case shared.EventTypeActivityTaskCompleted:
+		if attr := event.ActivityTaskCompletedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Result}
+		}
+	case shared.EventTypeActivityTaskFailed:
+		if attr := event.ActivityTaskFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeActivityTaskTimedOut:
+		if attr := event.ActivityTaskTimedOutEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeActivityTaskCanceled:
+		if attr := event.ActivityTaskCanceledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeMarkerRecorded:
+		if attr := event.MarkerRecordedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeSignalExternalWorkflowExecutionInitiated:
+		if attr := event.SignalExternalWorkflowExecutionInitiatedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case shared.EventTypeStartChildWorkflowExecutionInitiated:
+		if attr := event.StartChildWorkflowExecutionInitiatedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case shared.EventTypeChildWorkflowExecutionCompleted:
+		if attr := event.ChildWorkflowExecutionCompletedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Result}
+		}
+	case shared.EventTypeChildWorkflowExecutionFailed:
+		if attr := event.ChildWorkflowExecutionFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case shared.EventTypeChildWorkflowExecutionCanceled:
+		if attr := event.ChildWorkflowExecutionCanceledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	}
+	return nil
+}
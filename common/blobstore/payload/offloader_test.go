@@ -0,0 +1,187 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	testBucket    = "payload-bucket"
+	testDomain    = "test-domain"
+	testThreshold = 16
+)
+
+type OffloaderSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	storeDir        string
+	blobstoreClient blobstore.Client
+	offloader       Offloader
+	domainID        string
+	runID           string
+}
+
+func TestOffloaderSuite(t *testing.T) {
+	suite.Run(t, new(OffloaderSuite))
+}
+
+func (s *OffloaderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	dir, err := ioutil.TempDir("", "OffloaderSuite")
+	s.NoError(err)
+	s.storeDir = dir
+	s.blobstoreClient, err = filestore.NewClient(&filestore.Config{
+		StoreDirectory: dir,
+		DefaultBucket:  testBucket,
+	})
+	s.NoError(err)
+	s.offloader = NewOffloader(s.blobstoreClient, s.newConfig(true))
+	s.domainID = uuid.New()
+	s.runID = uuid.New()
+}
+
+func (s *OffloaderSuite) TearDownTest() {
+	os.RemoveAll(s.storeDir)
+}
+
+func (s *OffloaderSuite) TestOffloadAndHydrateEvents() {
+	small := []byte("small")
+	large := bytes.Repeat([]byte("x"), testThreshold+1)
+	events := []*shared.HistoryEvent{
+		s.newActivityScheduledEvent(1, small),
+		s.newActivityScheduledEvent(2, large),
+	}
+
+	s.NoError(s.offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+	s.Equal(small, events[0].ActivityTaskScheduledEventAttributes.Input)
+	s.True(IsReference(events[1].ActivityTaskScheduledEventAttributes.Input))
+
+	s.NoError(s.offloader.HydrateEvents(context.Background(), events))
+	s.Equal(small, events[0].ActivityTaskScheduledEventAttributes.Input)
+	s.Equal(large, events[1].ActivityTaskScheduledEventAttributes.Input)
+}
+
+func (s *OffloaderSuite) TestOffloadEvents_Disabled() {
+	large := bytes.Repeat([]byte("x"), testThreshold+1)
+	events := []*shared.HistoryEvent{s.newActivityScheduledEvent(1, large)}
+
+	offloader := NewOffloader(s.blobstoreClient, s.newConfig(false))
+	s.False(offloader.IsEnabled(testDomain))
+	s.NoError(offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+	s.Equal(large, events[0].ActivityTaskScheduledEventAttributes.Input)
+
+	offloader = NewOffloader(nil, s.newConfig(true))
+	s.False(offloader.IsEnabled(testDomain))
+	s.NoError(offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+	s.Equal(large, events[0].ActivityTaskScheduledEventAttributes.Input)
+}
+
+func (s *OffloaderSuite) TestHydrate_NotConfigured() {
+	large := bytes.Repeat([]byte("x"), testThreshold+1)
+	events := []*shared.HistoryEvent{s.newActivityScheduledEvent(1, large)}
+	s.NoError(s.offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+
+	_, err := NewOffloader(nil, nil).Hydrate(context.Background(), events[0].ActivityTaskScheduledEventAttributes.Input)
+	s.Equal(ErrOffloadNotConfigured, err)
+}
+
+func (s *OffloaderSuite) TestOffloadEvents_ReferenceOfOtherRun() {
+	large := bytes.Repeat([]byte("x"), testThreshold+1)
+	events := []*shared.HistoryEvent{s.newActivityScheduledEvent(1, large)}
+	s.NoError(s.offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+	reference := events[0].ActivityTaskScheduledEventAttributes.Input
+
+	// offloading again for the same run keeps the reference
+	s.NoError(s.offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+	s.Equal(reference, events[0].ActivityTaskScheduledEventAttributes.Input)
+
+	// another run gets its own copy of the payload
+	otherRunID := uuid.New()
+	s.NoError(s.offloader.OffloadEvents(context.Background(), s.domainID, testDomain, otherRunID, events))
+	otherReference := events[0].ActivityTaskScheduledEventAttributes.Input
+	s.True(IsReference(otherReference))
+	s.NotEqual(reference, otherReference)
+
+	s.NoError(s.offloader.DeleteWorkflowPayloads(context.Background(), s.domainID, s.runID))
+	payload, err := s.offloader.Hydrate(context.Background(), otherReference)
+	s.NoError(err)
+	s.Equal(large, payload)
+}
+
+func (s *OffloaderSuite) TestDeleteWorkflowPayloads() {
+	large := bytes.Repeat([]byte("x"), testThreshold+1)
+	events := []*shared.HistoryEvent{
+		s.newActivityScheduledEvent(1, large),
+		s.newActivityScheduledEvent(2, large),
+	}
+	s.NoError(s.offloader.OffloadEvents(context.Background(), s.domainID, testDomain, s.runID, events))
+
+	s.NoError(s.offloader.DeleteWorkflowPayloads(context.Background(), s.domainID, s.runID))
+	for _, event := range events {
+		_, err := s.offloader.Hydrate(context.Background(), event.ActivityTaskScheduledEventAttributes.Input)
+		s.Error(err)
+	}
+
+	// deleting payloads of a run without any is a no-op
+	s.NoError(s.offloader.DeleteWorkflowPayloads(context.Background(), s.domainID, uuid.New()))
+}
+
+func (s *OffloaderSuite) TestHydrate_NotReference() {
+	data := []byte("not a reference")
+	payload, err := s.offloader.Hydrate(context.Background(), data)
+	s.NoError(err)
+	s.Equal(data, payload)
+}
+
+func (s *OffloaderSuite) newConfig(enabled bool) *Config {
+	return &Config{
+		EnableOffload: dynamicconfig.GetBoolPropertyFnFilteredByDomain(enabled),
+		Threshold:     dynamicconfig.GetIntPropertyFilteredByDomain(testThreshold),
+		Bucket:        dynamicconfig.GetStringPropertyFn(testBucket),
+	}
+}
+
+func (s *OffloaderSuite) newActivityScheduledEvent(eventID int64, input []byte) *shared.HistoryEvent {
+	return &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		EventType: shared.EventTypeActivityTaskScheduled.Ptr(),
+		ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{
+			ActivityId: common.StringPtr("activity"),
+			Input:      input,
+		},
+	}
+}
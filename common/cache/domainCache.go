@@ -21,6 +21,7 @@
 package cache
 
 import (
+	"context"
 	"fmt"
 	"hash/fnv"
 	"sort"
@@ -48,6 +49,8 @@ const (
 	// DomainCacheRefreshInterval domain cache refresh interval
 	DomainCacheRefreshInterval = 10 * time.Second
 	domainCacheRefreshPageSize = 100
+	// domainCachePersistenceTimeout bounds the persistence calls made to load or refresh domains
+	domainCachePersistenceTimeout = 10 * time.Second
 
 	domainCacheInitialized int32 = 0
 	domainCacheStarted     int32 = 1
@@ -109,6 +112,7 @@ type (
 		failoverVersion             int64
 		isGlobalDomain              bool
 		failoverNotificationVersion int64
+		failoverDrainEndTime        int64
 		notificationVersion         int64
 		expiry                      time.Time
 	}
@@ -345,7 +349,9 @@ func (c *domainCache) refreshLoop() {
 func (c *domainCache) refreshDomains() error {
 	// first load the metadata record, then load domains
 	// this can guarantee that domains in the cache are not updated more than metadata record
-	metadata, err := c.metadataMgr.GetMetadata()
+	ctx, cancel := context.WithTimeout(context.Background(), domainCachePersistenceTimeout)
+	defer cancel()
+	metadata, err := c.metadataMgr.GetMetadata(ctx)
 	if err != nil {
 		return err
 	}
@@ -358,7 +364,7 @@ func (c *domainCache) refreshDomains() error {
 
 	for continuePage {
 		request.NextPageToken = token
-		response, err := c.metadataMgr.ListDomains(request)
+		response, err := c.metadataMgr.ListDomains(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -419,7 +425,9 @@ UpdateLoop:
 }
 
 func (c *domainCache) loadDomain(name string, id string) (*persistence.GetDomainResponse, error) {
-	resp, err := c.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: name, ID: id})
+	ctx, cancel := context.WithTimeout(context.Background(), domainCachePersistenceTimeout)
+	defer cancel()
+	resp, err := c.metadataMgr.GetDomain(ctx, &persistence.GetDomainRequest{Name: name, ID: id})
 	if err == nil {
 		if resp.TableVersion == persistence.DomainTableVersionV1 {
 			// if loaded from V1 table
@@ -472,6 +480,7 @@ func (c *domainCache) updateIDToDomainCache(cacheByID Cache, id string, record *
 	entry.failoverVersion = record.failoverVersion
 	entry.isGlobalDomain = record.isGlobalDomain
 	entry.failoverNotificationVersion = record.failoverNotificationVersion
+	entry.failoverDrainEndTime = record.failoverDrainEndTime
 	entry.notificationVersion = record.notificationVersion
 	entry.expiry = c.timeSource.Now().Add(domainCacheEntryTTL)
 
@@ -580,6 +589,7 @@ func (c *domainCache) buildEntryFromRecord(record *persistence.GetDomainResponse
 	newEntry.failoverVersion = record.FailoverVersion
 	newEntry.isGlobalDomain = record.IsGlobalDomain
 	newEntry.failoverNotificationVersion = record.FailoverNotificationVersion
+	newEntry.failoverDrainEndTime = record.FailoverDrainEndTime
 	newEntry.notificationVersion = record.NotificationVersion
 	return newEntry
 }
@@ -625,6 +635,7 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	result.failoverVersion = entry.failoverVersion
 	result.isGlobalDomain = entry.isGlobalDomain
 	result.failoverNotificationVersion = entry.failoverNotificationVersion
+	result.failoverDrainEndTime = entry.failoverDrainEndTime
 	result.notificationVersion = entry.notificationVersion
 	result.expiry = entry.expiry
 	return result
@@ -669,6 +680,16 @@ func (entry *DomainCacheEntry) GetFailoverNotificationVersion() int64 {
 	return entry.failoverNotificationVersion
 }
 
+// GetFailoverDrainEndTime return the time in unix nanos until which the domain drains for a graceful failover
+func (entry *DomainCacheEntry) GetFailoverDrainEndTime() int64 {
+	return entry.failoverDrainEndTime
+}
+
+// IsDraining return whether the domain is draining for a graceful failover, i.e. does not accept new workflows
+func (entry *DomainCacheEntry) IsDraining(now time.Time) bool {
+	return entry.IsDomainActive() && now.UnixNano() < entry.failoverDrainEndTime
+}
+
 // GetNotificationVersion return the global notification version of when domain changed
 func (entry *DomainCacheEntry) GetNotificationVersion() int64 {
 	return entry.notificationVersion
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package checksum
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+// GenerateCRC32 generates an IEEE crc32 checksum on the
+// given binary payload
+// GenerateCRC32 generates an IEEE crc32 checksum on the
+// serialized byte array of the given payload
+func GenerateCRC32(
+	payload []byte,
+	payloadVersion int,
+) Checksum {
+
+	crc := crc32.ChecksumIEEE(payload)
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, crc)
+	return Checksum{
+		Value:   value,
+		Version: payloadVersion,
+		Flavor:  FlavorIEEECRC32OverBinary,
+	}
+}
+
+// Verify verifies that the checksum generated from the
+// given payload matches the input checksum
+// Verify verifies that the checksum generated from the
+// given payload matches the expected checksum
+func Verify(
+	payload []byte,
+	csum Checksum,
+) error {
+
+	if csum.Flavor != FlavorIEEECRC32OverBinary {
+		return fmt.Errorf("unknown checksum flavor %v", csum.Flavor)
+	}
+
+	expected := GenerateCRC32(payload, csum.Version)
+	if !bytes.Equal(expected.Value, csum.Value) {
+		return ErrMismatch
+	}
+	return nil
+}
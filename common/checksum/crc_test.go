@@ -0,0 +1,41 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package checksum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCRC32OverBinary(t *testing.T) {
+	payload := []byte("cadence mutable state")
+	csum := GenerateCRC32(payload, 1)
+	assert.Equal(t, FlavorIEEECRC32OverBinary, csum.Flavor)
+	assert.Equal(t, 1, csum.Version)
+	assert.Equal(t, 4, len(csum.Value))
+	assert.False(t, csum.IsEmpty())
+	assert.NoError(t, Verify(payload, csum))
+
+	assert.Equal(t, ErrMismatch, Verify([]byte("cadence mutable state!"), csum))
+	assert.Error(t, Verify(payload, Checksum{Value: csum.Value}))
+	assert.True(t, Checksum{}.IsEmpty())
+}
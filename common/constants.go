@@ -39,6 +39,8 @@ const (
 	EmptyEventTaskID int64 = -1234
 	// TransientEventID is the id of the transient event
 	TransientEventID int64 = -124
+	// EmptyMessageID is the default read level of a replication task cursor that has not read anything yet
+	EmptyMessageID int64 = -1
 	// FirstBlobPageToken is the page token identifying the first blob for each history archival
 	FirstBlobPageToken = 1
 	// LastBlobNextPageToken is the next page token on the last blob for each history archival
@@ -58,11 +60,14 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW              = "thriftrw"
-	EncodingTypeGob                   = "gob"
-	EncodingTypeUnknown               = "unknow"
-	EncodingTypeEmpty                 = ""
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeThriftRW                    = "thriftrw"
+	EncodingTypeThriftRWSnappy              = "thriftrw-snappy"
+	EncodingTypeThriftRWZstd                = "thriftrw-zstd"
+	EncodingTypeEncrypted                   = "encrypted"
+	EncodingTypeGob                         = "gob"
+	EncodingTypeUnknown                     = "unknow"
+	EncodingTypeEmpty                       = ""
 )
 
 type (
@@ -83,6 +88,8 @@ const (
 const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
+	// VisibilityESDLQAppName is used to find the optional kafka topic of visibility messages ES rejected permanently
+	VisibilityESDLQAppName = "visibility-es-dlq"
 )
 
 const (
@@ -98,6 +105,18 @@ const (
 	DefaultAdminOperationToken = "CadenceTeamONLY"
 )
 
+const (
+	// DomainDataKeyForFailoverHistory is the reserved domain data key under which the
+	// failover history of a domain is kept, as a json encoded list of failover events
+	DomainDataKeyForFailoverHistory = "__cadence_failover_history__"
+)
+
+const (
+	// ReplicationStatusUnknownMessage is the message of the error returned by history when the replication
+	// backlog of a target cluster is not known, since the replication tasks are published to kafka
+	ReplicationStatusUnknownMessage = "Replication status is unknown when replication tasks are published to kafka."
+)
+
 const (
 	// MinLongPollTimeout is the minimum context timeout for long poll API, below which
 	// the request won't be processed
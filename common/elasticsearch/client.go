@@ -22,8 +22,10 @@ package elasticsearch
 
 import (
 	"context"
-	"github.com/olivere/elastic"
+	"net/url"
 	"time"
+
+	"github.com/olivere/elastic"
 )
 
 type (
@@ -37,6 +39,10 @@ type (
 		ScrollFirstPage(ctx context.Context, index, query string) (*elastic.SearchResult, ScrollService, error)
 		Count(ctx context.Context, index, query string) (int64, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (*elastic.BulkProcessor, error)
+		// GetMapping returns the type of each field in the index mapping, nested fields are keyed by their path, e.g. Attr.CustomIntField
+		GetMapping(ctx context.Context, index string) (map[string]string, error)
+		// PutMapping adds the field of the type to the index mapping, under the root field when root is not empty
+		PutMapping(ctx context.Context, index, root, key, valueType string) error
 	}
 
 	// ScrollService is a interface for elastic.ScrollService
@@ -80,8 +86,25 @@ var _ Client = (*elasticWrapper)(nil)
 
 // NewClient create a ES client
 func NewClient(config *Config) (Client, error) {
+	return newClient(config.URL)
+}
+
+// NewClusterClients creates a ES client for each of the additional clusters in config
+func NewClusterClients(config *Config) (map[string]Client, error) {
+	clients := make(map[string]Client, len(config.Clusters))
+	for name, cluster := range config.Clusters {
+		client, err := newClient(cluster.URL)
+		if err != nil {
+			return nil, err
+		}
+		clients[name] = client
+	}
+	return clients, nil
+}
+
+func newClient(url url.URL) (Client, error) {
 	client, err := elastic.NewClient(
-		elastic.SetURL(config.URL.String()),
+		elastic.SetURL(url.String()),
 		elastic.SetRetrier(elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))),
 		elastic.SetDecoder(&elastic.NumberDecoder{}), // critical to ensure decode of int64 won't lose precise
 	)
@@ -150,6 +173,74 @@ func (c *elasticWrapper) RunBulkProcessor(ctx context.Context, p *BulkProcessorP
 		Do(ctx)
 }
 
+func (c *elasticWrapper) GetMapping(ctx context.Context, index string) (map[string]string, error) {
+	result, err := c.client.GetMapping().Index(index).Type(docType).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return flattenMapping(result), nil
+}
+
+func (c *elasticWrapper) PutMapping(ctx context.Context, index, root, key, valueType string) error {
+	body := buildPutMappingBody(root, key, valueType)
+	_, err := c.client.PutMapping().Index(index).Type(docType).BodyJson(body).Do(ctx)
+	return err
+}
+
+// flattenMapping converts the get mapping result {index: {mappings: {_doc: {properties: ...}}}} to field types,
+// the index in result is the concrete index even if the request used an alias
+func flattenMapping(result map[string]interface{}) map[string]string {
+	fields := make(map[string]string)
+	for _, indexMapping := range result {
+		mappings, _ := getMapValue(indexMapping, "mappings")
+		docMapping, _ := getMapValue(mappings, docType)
+		properties, _ := getMapValue(docMapping, "properties")
+		flattenProperties(properties, "", fields)
+	}
+	return fields
+}
+
+func flattenProperties(properties map[string]interface{}, prefix string, fields map[string]string) {
+	for name, property := range properties {
+		if valueType, ok := getStringValue(property, "type"); ok {
+			fields[prefix+name] = valueType
+		}
+		if nested, ok := getMapValue(property, "properties"); ok {
+			flattenProperties(nested, prefix+name+".", fields)
+		}
+	}
+}
+
+func getMapValue(value interface{}, key string) (map[string]interface{}, bool) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	v, ok := m[key].(map[string]interface{})
+	return v, ok
+}
+
+func getStringValue(value interface{}, key string) (string, bool) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	v, ok := m[key].(string)
+	return v, ok
+}
+
+func buildPutMappingBody(root, key, valueType string) map[string]interface{} {
+	properties := map[string]interface{}{
+		key: map[string]interface{}{"type": valueType},
+	}
+	if len(root) != 0 {
+		properties = map[string]interface{}{
+			root: map[string]interface{}{"properties": properties},
+		}
+	}
+	return map[string]interface{}{"properties": properties}
+}
+
 func (s *scrollServiceImpl) Clear(ctx context.Context) error {
 	return s.scrollService.Clear(ctx)
 }
@@ -30,5 +30,29 @@ type (
 		Enable  bool              `yaml:enable`
 		URL     url.URL           `yaml:url`
 		Indices map[string]string `yaml:indices`
+		// DomainIndices are the dedicated visibility indices of domains on this cluster,
+		// a domain can only be routed to the visibility index or one of these indices
+		DomainIndices []string `yaml:domainIndices`
+		// Clusters are additional ElasticSearch clusters by name, the visibility records of
+		// a domain can be routed to one of them with dynamic config
+		Clusters map[string]ClusterConfig `yaml:clusters`
+	}
+
+	// ClusterConfig for connecting to an additional ElasticSearch cluster
+	ClusterConfig struct {
+		URL url.URL `yaml:url`
+		// Indices are the visibility indices on the cluster, a domain can only be routed to one of
+		// them, including the default visibility index name when the domain has no index configured
+		Indices []string `yaml:indices`
 	}
 )
+
+// GetClusterIndices returns the visibility indices configured for each cluster by cluster name,
+// besides the visibility index of the default cluster
+func (cfg *Config) GetClusterIndices() map[string][]string {
+	indices := map[string][]string{DefaultCluster: cfg.DomainIndices}
+	for name, cluster := range cfg.Clusters {
+		indices[name] = cluster.Indices
+	}
+	return indices
+}
@@ -39,6 +39,9 @@ const (
 	KafkaKey = "KafkaKey"
 )
 
+// docType is the only mapping type of the visibility index
+const docType = "_doc"
+
 // Supported field types
 var (
 	FieldTypeString = indexer.FieldTypeString
@@ -0,0 +1,143 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// Router routes the visibility records of a domain to the ElasticSearch cluster and index
+	// configured for the domain, domains without any configuration use the default cluster and index
+	Router interface {
+		// Route returns the cluster, client and index for the visibility records of the domain
+		Route(domain string) (Route, error)
+		// Clients returns the clients of all clusters by cluster name, including the default cluster
+		Clients() map[string]Client
+		// Indices returns the visibility indices of all clusters by cluster name, domains are only
+		// routed to these indices
+		Indices() map[string][]string
+	}
+
+	// Route is the ElasticSearch cluster and index of a domain,
+	// Cluster is DefaultCluster for the default cluster
+	Route struct {
+		Cluster string
+		Client  Client
+		Index   string
+	}
+
+	router struct {
+		defaultIndex string
+		clients      map[string]Client
+		indices      map[string][]string
+		clusterFn    dynamicconfig.StringPropertyFnWithDomainFilter
+		indexFn      dynamicconfig.StringPropertyFnWithDomainFilter
+	}
+)
+
+// DefaultCluster is the name of the ElasticSearch cluster in the top level config
+const DefaultCluster = ""
+
+var _ Router = (*router)(nil)
+
+// NewRouter creates a router, the cluster indices are the visibility indices of each cluster by cluster name
+// besides the default index of the default cluster. The cluster and index functions return the cluster name
+// and index of a domain, empty for the defaults, and are optional
+func NewRouter(
+	defaultClient Client,
+	defaultIndex string,
+	clusterClients map[string]Client,
+	clusterIndices map[string][]string,
+	clusterFn dynamicconfig.StringPropertyFnWithDomainFilter,
+	indexFn dynamicconfig.StringPropertyFnWithDomainFilter,
+) Router {
+
+	clients := map[string]Client{DefaultCluster: defaultClient}
+	for name, client := range clusterClients {
+		clients[name] = client
+	}
+	indices := map[string][]string{DefaultCluster: {defaultIndex}}
+	for name, clusterIndex := range clusterIndices {
+		for _, index := range clusterIndex {
+			if !containsIndex(indices[name], index) {
+				indices[name] = append(indices[name], index)
+			}
+		}
+	}
+	return &router{
+		defaultIndex: defaultIndex,
+		clients:      clients,
+		indices:      indices,
+		clusterFn:    clusterFn,
+		indexFn:      indexFn,
+	}
+}
+
+// NewStaticRouter creates a router that routes all domains to the given client and index
+func NewStaticRouter(client Client, index string) Router {
+	return NewRouter(client, index, nil, nil, nil, nil)
+}
+
+func (r *router) Route(domain string) (Route, error) {
+	route := Route{
+		Cluster: DefaultCluster,
+		Index:   r.defaultIndex,
+	}
+	if r.clusterFn != nil {
+		route.Cluster = r.clusterFn(domain)
+	}
+	if r.indexFn != nil {
+		if index := r.indexFn(domain); index != "" {
+			route.Index = index
+		}
+	}
+
+	client, ok := r.clients[route.Cluster]
+	if !ok {
+		return Route{}, fmt.Errorf("unknown ElasticSearch cluster %v for domain %v", route.Cluster, domain)
+	}
+	// an index unknown to the router would miss the search attributes added to the known indices
+	if !containsIndex(r.indices[route.Cluster], route.Index) {
+		return Route{}, fmt.Errorf("unknown ElasticSearch index %v on cluster %q for domain %v", route.Index, route.Cluster, domain)
+	}
+	route.Client = client
+	return route, nil
+}
+
+func (r *router) Clients() map[string]Client {
+	return r.clients
+}
+
+func (r *router) Indices() map[string][]string {
+	return r.indices
+}
+
+func containsIndex(indices []string, index string) bool {
+	for _, i := range indices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type routerSuite struct {
+	suite.Suite
+}
+
+func TestRouterSuite(t *testing.T) {
+	suite.Run(t, new(routerSuite))
+}
+
+func (s *routerSuite) TestStaticRouter() {
+	client := &elasticWrapper{}
+	router := NewStaticRouter(client, "test-index")
+
+	route, err := router.Route("some-domain")
+	s.NoError(err)
+	s.Equal(DefaultCluster, route.Cluster)
+	s.True(client == route.Client)
+	s.Equal("test-index", route.Index)
+	s.Len(router.Clients(), 1)
+	s.Equal(map[string][]string{DefaultCluster: {"test-index"}}, router.Indices())
+}
+
+func (s *routerSuite) TestRouteByDomain() {
+	defaultClient := &elasticWrapper{}
+	tenantClient := &elasticWrapper{}
+	clusterFn := func(domain string) string {
+		if domain == "big-tenant" {
+			return "tenant-cluster"
+		}
+		if domain == "misconfigured" {
+			return "unknown-cluster"
+		}
+		return DefaultCluster
+	}
+	indexFn := func(domain string) string {
+		if domain == "big-tenant" || domain == "own-index" || domain == "unknown-index" {
+			return "cadence-visibility-" + domain
+		}
+		return ""
+	}
+	clusterIndices := map[string][]string{
+		DefaultCluster:   {"cadence-visibility-own-index"},
+		"tenant-cluster": {"cadence-visibility-big-tenant"},
+	}
+	router := NewRouter(defaultClient, "test-index", map[string]Client{"tenant-cluster": tenantClient}, clusterIndices, clusterFn, indexFn)
+	s.Len(router.Clients(), 2)
+	s.Equal(map[string][]string{
+		DefaultCluster:   {"test-index", "cadence-visibility-own-index"},
+		"tenant-cluster": {"cadence-visibility-big-tenant"},
+	}, router.Indices())
+
+	route, err := router.Route("other-domain")
+	s.NoError(err)
+	s.Equal(DefaultCluster, route.Cluster)
+	s.True(defaultClient == route.Client)
+	s.Equal("test-index", route.Index)
+
+	route, err = router.Route("own-index")
+	s.NoError(err)
+	s.True(defaultClient == route.Client)
+	s.Equal("cadence-visibility-own-index", route.Index)
+
+	route, err = router.Route("big-tenant")
+	s.NoError(err)
+	s.Equal("tenant-cluster", route.Cluster)
+	s.True(tenantClient == route.Client)
+	s.Equal("cadence-visibility-big-tenant", route.Index)
+
+	_, err = router.Route("misconfigured")
+	s.Error(err)
+
+	_, err = router.Route("unknown-index")
+	s.Error(err)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// SearchAttributesClient is a dynamic config client which adds the search attributes registered
+	// in the visibility index mapping to the valid search attributes, the mapping is loaded once started
+	SearchAttributesClient interface {
+		dynamicconfig.Client
+		common.Daemon
+	}
+
+	searchAttributesClient struct {
+		dynamicconfig.Client
+		status     int32
+		esClient   Client
+		index      string
+		logger     log.Logger
+		attributes atomic.Value // map[string]interface{}
+		shutdownCh chan struct{}
+	}
+)
+
+const (
+	// SearchAttributesRefreshInterval is how often the registered search attributes are reloaded from the index mapping
+	SearchAttributesRefreshInterval = 30 * time.Second
+
+	searchAttributesLoadTimeout = 10 * time.Second
+)
+
+// mapping types of search attributes, the mapping of custom search attributes must use these types
+var searchAttributeESTypes = map[shared.IndexedValueType]string{
+	shared.IndexedValueTypeString:   "text",
+	shared.IndexedValueTypeKeyword:  "keyword",
+	shared.IndexedValueTypeInt:      "long",
+	shared.IndexedValueTypeDouble:   "double",
+	shared.IndexedValueTypeBool:     "boolean",
+	shared.IndexedValueTypeDatetime: "date",
+}
+
+var _ SearchAttributesClient = (*searchAttributesClient)(nil)
+
+// NewSearchAttributesClient wraps the dynamic config client so that the valid search attributes include the
+// custom search attributes in the mapping of the visibility index, the attributes configured in dynamic config
+// take precedence. Search attributes registered by the admin API are valid on every host without a restart.
+func NewSearchAttributesClient(
+	client dynamicconfig.Client,
+	esClient Client,
+	index string,
+	logger log.Logger,
+) SearchAttributesClient {
+
+	c := &searchAttributesClient{
+		Client:     client,
+		status:     common.DaemonStatusInitialized,
+		esClient:   esClient,
+		index:      index,
+		logger:     logger,
+		shutdownCh: make(chan struct{}),
+	}
+	c.attributes.Store(map[string]interface{}{})
+	return c
+}
+
+// Start loads the registered search attributes and keeps reloading them until stopped
+func (c *searchAttributesClient) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	c.refreshAndLog()
+	go c.refreshLoop()
+}
+
+// Stop stops reloading the registered search attributes
+func (c *searchAttributesClient) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.shutdownCh)
+}
+
+func (c *searchAttributesClient) refreshLoop() {
+	ticker := time.NewTicker(SearchAttributesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.refreshAndLog()
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+func (c *searchAttributesClient) refreshAndLog() {
+	if err := c.refresh(); err != nil {
+		c.logger.Warn("Failed to load search attributes from ElasticSearch", tag.Error(err))
+	}
+}
+
+func (c *searchAttributesClient) GetMapValue(
+	name dynamicconfig.Key,
+	filters map[dynamicconfig.Filter]interface{},
+	defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+
+	value, err := c.Client.GetMapValue(name, filters, defaultValue)
+	if name != dynamicconfig.ValidSearchAttributes {
+		return value, err
+	}
+
+	registered := c.attributes.Load().(map[string]interface{})
+	if len(registered) == 0 {
+		return value, err
+	}
+	merged := make(map[string]interface{}, len(registered)+len(value))
+	for k, v := range registered {
+		merged[k] = v
+	}
+	for k, v := range value {
+		merged[k] = v
+	}
+	return merged, err
+}
+
+func (c *searchAttributesClient) refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), searchAttributesLoadTimeout)
+	defer cancel()
+	mapping, err := c.esClient.GetMapping(ctx, c.index)
+	if err != nil {
+		return err
+	}
+	c.attributes.Store(getSearchAttributesFromMapping(mapping))
+	return nil
+}
+
+// getSearchAttributesFromMapping returns the custom search attributes in the index mapping,
+// fields of unsupported types are skipped
+func getSearchAttributesFromMapping(mapping map[string]string) map[string]interface{} {
+	attributes := make(map[string]interface{})
+	prefix := definition.Attr + "."
+	for field, esType := range mapping {
+		if !strings.HasPrefix(field, prefix) {
+			continue
+		}
+		if valueType, ok := GetSearchAttributeType(esType); ok {
+			attributes[strings.TrimPrefix(field, prefix)] = valueType
+		}
+	}
+	return attributes
+}
+
+// GetSearchAttributeESType returns the mapping type of the search attribute type
+func GetSearchAttributeESType(valueType shared.IndexedValueType) (string, bool) {
+	esType, ok := searchAttributeESTypes[valueType]
+	return esType, ok
+}
+
+// GetSearchAttributeType returns the search attribute type of the mapping type
+func GetSearchAttributeType(esType string) (shared.IndexedValueType, bool) {
+	for valueType, t := range searchAttributeESTypes {
+		if t == esType {
+			return valueType, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	searchAttributesSuite struct {
+		suite.Suite
+	}
+
+	mappingClient struct {
+		Client
+		mapping map[string]string
+	}
+)
+
+func TestSearchAttributesSuite(t *testing.T) {
+	suite.Run(t, new(searchAttributesSuite))
+}
+
+func (c *mappingClient) GetMapping(ctx context.Context, index string) (map[string]string, error) {
+	return c.mapping, nil
+}
+
+func (s *searchAttributesSuite) TestFlattenMapping() {
+	result := map[string]interface{}{
+		"test-index": map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"_doc": map[string]interface{}{
+					"properties": map[string]interface{}{
+						"WorkflowID": map[string]interface{}{"type": "keyword"},
+						"Attr": map[string]interface{}{
+							"properties": map[string]interface{}{
+								"CustomIntField": map[string]interface{}{"type": "long"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	s.Equal(map[string]string{
+		"WorkflowID":          "keyword",
+		"Attr.CustomIntField": "long",
+	}, flattenMapping(result))
+}
+
+func (s *searchAttributesSuite) TestBuildPutMappingBody() {
+	s.Equal(map[string]interface{}{
+		"properties": map[string]interface{}{
+			"Attr": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"testKey": map[string]interface{}{"type": "keyword"},
+				},
+			},
+		},
+	}, buildPutMappingBody("Attr", "testKey", "keyword"))
+
+	s.Equal(map[string]interface{}{
+		"properties": map[string]interface{}{
+			"testKey": map[string]interface{}{"type": "date"},
+		},
+	}, buildPutMappingBody("", "testKey", "date"))
+}
+
+func (s *searchAttributesSuite) TestSearchAttributeTypes() {
+	for _, valueType := range shared.IndexedValueType_Values() {
+		esType, ok := GetSearchAttributeESType(valueType)
+		s.True(ok)
+		t, ok := GetSearchAttributeType(esType)
+		s.True(ok)
+		s.Equal(valueType, t)
+	}
+	_, ok := GetSearchAttributeType("integer")
+	s.False(ok)
+}
+
+func (s *searchAttributesSuite) TestGetMapValue() {
+	client := &searchAttributesClient{
+		Client: dynamicconfig.NewNopClient(),
+		esClient: &mappingClient{mapping: map[string]string{
+			"WorkflowID":          "keyword",
+			"Attr.CustomIntField": "long",
+			"Attr.NewKeyword":     "keyword",
+			"Attr.Unsupported":    "integer",
+		}},
+		index:  "test-index",
+		logger: loggerimpl.NewNopLogger(),
+	}
+	client.attributes.Store(map[string]interface{}{})
+	s.NoError(client.refresh())
+
+	defaultValue := map[string]interface{}{
+		definition.CustomIntField: shared.IndexedValueTypeDouble,
+	}
+	value, _ := client.GetMapValue(dynamicconfig.ValidSearchAttributes, nil, defaultValue)
+	s.Equal(map[string]interface{}{
+		definition.CustomIntField: shared.IndexedValueTypeDouble,
+		"NewKeyword":              shared.IndexedValueTypeKeyword,
+	}, value)
+
+	value, _ = client.GetMapValue(dynamicconfig.PersistenceFaultInjectionDomains, nil, defaultValue)
+	s.Equal(defaultValue, value)
+}
+
+func (s *searchAttributesSuite) TestStartStop() {
+	client := NewSearchAttributesClient(dynamicconfig.NewNopClient(), &mappingClient{mapping: map[string]string{
+		"Attr.NewKeyword": "keyword",
+	}}, "test-index", loggerimpl.NewNopLogger())
+
+	value, _ := client.GetMapValue(dynamicconfig.ValidSearchAttributes, nil, nil)
+	s.Empty(value)
+
+	client.Start()
+	defer client.Stop()
+	value, _ = client.GetMapValue(dynamicconfig.ValidSearchAttributes, nil, nil)
+	s.Equal(map[string]interface{}{"NewKeyword": shared.IndexedValueTypeKeyword}, value)
+}
@@ -114,8 +114,10 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentFailover                 = component("failover")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
+	ComponentMembershipMonitor        = component("membership-monitor")
 )
 
 // Pre-defined values for TagSysLifecycle
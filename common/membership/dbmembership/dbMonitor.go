@@ -0,0 +1,245 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dbmembership
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// DefaultHeartbeatInterval is how often a host refreshes its record by default
+	DefaultHeartbeatInterval = config.DefaultDBMembershipHeartbeatInterval
+	// DefaultHeartbeatTTL is how long a host record stays live without a heartbeat by default
+	DefaultHeartbeatTTL = config.DefaultDBMembershipHeartbeatTTL
+
+	pruneInterval    = time.Minute
+	maxRecordsPruned = 100
+)
+
+type dbMonitor struct {
+	started  bool
+	stopped  bool
+	services []string
+	self     *persistence.UpsertClusterMembershipRequest
+	manager  persistence.ClusterMembershipManager
+	interval time.Duration
+	rings    map[string]*dbServiceResolver
+	logger   log.Logger
+	mutex    sync.Mutex
+
+	shutdownCh chan struct{}
+	shutdownWG sync.WaitGroup
+}
+
+var _ membership.Monitor = (*dbMonitor)(nil)
+
+// NewMonitor returns a membership monitor which heartbeats the record of this host into the
+// cluster membership table and resolves the hosts of every service from the live records
+func NewMonitor(
+	services []string,
+	hostID string,
+	rpcAddress string,
+	role string,
+	manager persistence.ClusterMembershipManager,
+	heartbeatInterval time.Duration,
+	heartbeatTTL time.Duration,
+	logger log.Logger,
+) membership.Monitor {
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = DefaultHeartbeatInterval
+	}
+	if heartbeatTTL <= 0 {
+		heartbeatTTL = DefaultHeartbeatTTL
+	}
+
+	logger = logger.WithTags(tag.ComponentMembershipMonitor, tag.Address(rpcAddress))
+	m := &dbMonitor{
+		services: services,
+		self: &persistence.UpsertClusterMembershipRequest{
+			HostID:       hostID,
+			RPCAddress:   rpcAddress,
+			Role:         role,
+			SessionStart: time.Now(),
+			RecordExpiry: heartbeatTTL,
+		},
+		manager:    manager,
+		interval:   heartbeatInterval,
+		rings:      make(map[string]*dbServiceResolver),
+		logger:     logger,
+		shutdownCh: make(chan struct{}),
+	}
+	for _, service := range services {
+		m.rings[service] = newDBServiceResolver(service, manager, heartbeatInterval, heartbeatTTL, logger)
+	}
+	return m
+}
+
+func (m *dbMonitor) Start() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.started {
+		return nil
+	}
+
+	// the first heartbeat registers this host so it is part of its own ring
+	if err := m.heartbeat(); err != nil {
+		return err
+	}
+
+	for service, ring := range m.rings {
+		if err := ring.Start(); err != nil {
+			m.logger.Error("Failed to initialize ring.", tag.Service(service))
+			// stopping a ring which has not started is a no-op, so every ring is stopped
+			for _, r := range m.rings {
+				r.Stop()
+			}
+			if err := m.deregister(); err != nil {
+				m.logger.Warn("Failed to delete membership record.", tag.Error(err))
+			}
+			return err
+		}
+	}
+
+	m.shutdownWG.Add(1)
+	go m.heartbeatWorker()
+
+	m.started = true
+	return nil
+}
+
+func (m *dbMonitor) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.stopped {
+		return
+	}
+
+	if m.started {
+		close(m.shutdownCh)
+		if success := common.AwaitWaitGroup(&m.shutdownWG, time.Minute); !success {
+			m.logger.Warn("membership monitor timed out on shutdown.")
+		}
+		// remove our own record so peers drop this host on their next refresh
+		// rather than waiting for the record to expire
+		if err := m.deregister(); err != nil {
+			m.logger.Warn("Failed to delete membership record.", tag.Error(err))
+		}
+	}
+	for _, ring := range m.rings {
+		ring.Stop()
+	}
+	m.manager.Close()
+	m.stopped = true
+}
+
+func (m *dbMonitor) WhoAmI() (*membership.HostInfo, error) {
+	labels := map[string]string{membership.RoleKey: m.self.Role}
+	return membership.NewHostInfo(m.self.RPCAddress, labels), nil
+}
+
+func (m *dbMonitor) GetResolver(service string) (membership.ServiceResolver, error) {
+	ring, found := m.rings[service]
+	if !found {
+		return nil, membership.ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (m *dbMonitor) Lookup(service string, key string) (*membership.HostInfo, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (m *dbMonitor) AddListener(service string, name string, notifyChannel chan<- *membership.ChangedEvent) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (m *dbMonitor) RemoveListener(service string, name string) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (m *dbMonitor) heartbeat() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+	return m.manager.UpsertClusterMembership(ctx, m.self)
+}
+
+func (m *dbMonitor) deregister() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+	return m.manager.DeleteClusterMembership(ctx, &persistence.DeleteClusterMembershipRequest{
+		HostID: m.self.HostID,
+	})
+}
+
+func (m *dbMonitor) prune() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+	return m.manager.PruneClusterMembership(ctx, &persistence.PruneClusterMembershipRequest{
+		MaxRecordsPruned: maxRecordsPruned,
+	})
+}
+
+func (m *dbMonitor) heartbeatWorker() {
+	defer m.shutdownWG.Done()
+
+	heartbeatTicker := time.NewTicker(m.interval)
+	defer heartbeatTicker.Stop()
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-heartbeatTicker.C:
+			if err := m.heartbeat(); err != nil {
+				m.logger.Warn("Failed to heartbeat membership record.", tag.Error(err))
+			}
+		case <-pruneTicker.C:
+			if err := m.prune(); err != nil {
+				m.logger.Warn("Failed to prune expired membership records.", tag.Error(err))
+			}
+		}
+	}
+}
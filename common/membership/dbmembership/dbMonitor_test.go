@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dbmembership
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/memory"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	dbMonitorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		logger   log.Logger
+		services []string
+		factory  *memory.Factory
+	}
+)
+
+var errGetClusterMembers = errors.New("get cluster members failed")
+
+type failingGetManager struct {
+	persistence.ClusterMembershipManager
+}
+
+func (m *failingGetManager) GetClusterMembers(ctx context.Context, request *persistence.GetClusterMembersRequest) (*persistence.GetClusterMembersResponse, error) {
+	return nil, errGetClusterMembers
+}
+
+const (
+	testHeartbeatInterval = 50 * time.Millisecond
+	testHeartbeatTTL      = 200 * time.Millisecond
+)
+
+func TestDBMonitorSuite(t *testing.T) {
+	suite.Run(t, new(dbMonitorSuite))
+}
+
+func (s *dbMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = loggerimpl.NewNopLogger()
+	s.services = []string{common.FrontendServiceName, common.HistoryServiceName}
+	s.factory = memory.NewFactory(config.Memory{DatabaseName: "dbmembership-" + uuid.New()}, "active", s.logger)
+}
+
+func (s *dbMonitorSuite) newMonitor(address string, role string) membership.Monitor {
+	manager, err := s.factory.NewClusterMembershipStore()
+	s.NoError(err)
+	return NewMonitor(s.services, uuid.New(), address, role, manager, testHeartbeatInterval, testHeartbeatTTL, s.logger)
+}
+
+func (s *dbMonitorSuite) TestMonitor() {
+	frontend := s.newMonitor("127.0.0.1:7933", common.FrontendServiceName)
+	s.NoError(frontend.Start())
+	defer frontend.Stop()
+
+	self, err := frontend.WhoAmI()
+	s.NoError(err)
+	s.Equal("127.0.0.1:7933", self.GetAddress())
+	role, ok := self.Label(membership.RoleKey)
+	s.True(ok)
+	s.Equal(common.FrontendServiceName, role)
+
+	host, err := frontend.Lookup(common.FrontendServiceName, "key")
+	s.NoError(err)
+	s.Equal("127.0.0.1:7933", host.GetAddress())
+
+	_, err = frontend.Lookup(common.HistoryServiceName, "key")
+	s.Equal(membership.ErrInsufficientHosts, err)
+
+	_, err = frontend.Lookup(common.MatchingServiceName, "key")
+	s.Equal(membership.ErrUnknownService, err)
+
+	listenCh := make(chan *membership.ChangedEvent, 10)
+	s.NoError(frontend.AddListener(common.HistoryServiceName, "test", listenCh))
+	s.Equal(membership.ErrListenerAlreadyExist, frontend.AddListener(common.HistoryServiceName, "test", listenCh))
+
+	history := s.newMonitor("127.0.0.1:7934", common.HistoryServiceName)
+	s.NoError(history.Start())
+
+	select {
+	case event := <-listenCh:
+		s.Len(event.HostsAdded, 1)
+		s.Equal("127.0.0.1:7934", event.HostsAdded[0].GetAddress())
+		s.Empty(event.HostsRemoved)
+	case <-time.After(10 * testHeartbeatInterval):
+		s.Fail("timed out waiting for the host added event")
+	}
+
+	host, err = frontend.Lookup(common.HistoryServiceName, "key")
+	s.NoError(err)
+	s.Equal("127.0.0.1:7934", host.GetAddress())
+
+	// the history host deletes its record on stop, so it leaves the ring on the
+	// next refresh without waiting for the record to expire
+	history.Stop()
+	store, err := s.factory.NewClusterMembershipStore()
+	s.NoError(err)
+	defer store.Close()
+	resp, err := store.GetClusterMembers(context.Background(), &persistence.GetClusterMembersRequest{Role: common.HistoryServiceName})
+	s.NoError(err)
+	s.Empty(resp.ActiveMembers)
+
+	select {
+	case event := <-listenCh:
+		s.Empty(event.HostsAdded)
+		s.Len(event.HostsRemoved, 1)
+		s.Equal("127.0.0.1:7934", event.HostsRemoved[0].GetAddress())
+	case <-time.After(3 * testHeartbeatInterval):
+		s.Fail("timed out waiting for the host removed event")
+	}
+
+	_, err = frontend.Lookup(common.HistoryServiceName, "key")
+	s.Equal(membership.ErrInsufficientHosts, err)
+	s.NoError(frontend.RemoveListener(common.HistoryServiceName, "test"))
+}
+
+func (s *dbMonitorSuite) TestStartFailed() {
+	manager, err := s.factory.NewClusterMembershipStore()
+	s.NoError(err)
+	monitor := NewMonitor(s.services, uuid.New(), "127.0.0.1:7933", common.FrontendServiceName,
+		&failingGetManager{ClusterMembershipManager: manager}, testHeartbeatInterval, testHeartbeatTTL, s.logger)
+	s.Equal(errGetClusterMembers, monitor.Start())
+
+	// the record written by the first heartbeat is deleted when a ring fails to start
+	store, err := s.factory.NewClusterMembershipStore()
+	s.NoError(err)
+	defer store.Close()
+	resp, err := store.GetClusterMembers(context.Background(), &persistence.GetClusterMembersRequest{})
+	s.NoError(err)
+	s.Empty(resp.ActiveMembers)
+}
+
+func (s *dbMonitorSuite) TestRingDistributesKeys() {
+	var monitors []membership.Monitor
+	addresses := []string{"127.0.0.1:7933", "127.0.0.1:7943", "127.0.0.1:7953"}
+	for _, address := range addresses {
+		monitor := s.newMonitor(address, common.FrontendServiceName)
+		s.NoError(monitor.Start())
+		defer monitor.Stop()
+		monitors = append(monitors, monitor)
+	}
+
+	// the last monitor started after the others registered, every monitor
+	// converges on the same owner for a key after one refresh
+	time.Sleep(3 * testHeartbeatInterval)
+	owners := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		key := uuid.New()
+		expected, err := monitors[0].Lookup(common.FrontendServiceName, key)
+		s.NoError(err)
+		for _, monitor := range monitors[1:] {
+			host, err := monitor.Lookup(common.FrontendServiceName, key)
+			s.NoError(err)
+			s.Equal(expected.GetAddress(), host.GetAddress())
+		}
+		owners[expected.GetAddress()] = struct{}{}
+	}
+	s.Len(owners, len(addresses))
+}
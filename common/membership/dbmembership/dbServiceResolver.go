@@ -0,0 +1,252 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dbmembership
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/ringpop-go/hashring"
+)
+
+const (
+	replicaPoints = 100
+)
+
+type dbServiceResolver struct {
+	service         string
+	isStarted       bool
+	isStopped       bool
+	manager         persistence.ClusterMembershipManager
+	refreshInterval time.Duration
+	heartbeatTTL    time.Duration
+	shutdownCh      chan struct{}
+	shutdownWG      sync.WaitGroup
+	logger          log.Logger
+
+	ringLock sync.RWMutex
+	ring     *hashring.HashRing
+	members  map[string]struct{}
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *membership.ChangedEvent
+}
+
+var _ membership.ServiceResolver = (*dbServiceResolver)(nil)
+
+func newDBServiceResolver(
+	service string,
+	manager persistence.ClusterMembershipManager,
+	refreshInterval time.Duration,
+	heartbeatTTL time.Duration,
+	logger log.Logger,
+) *dbServiceResolver {
+	return &dbServiceResolver{
+		service:         service,
+		manager:         manager,
+		refreshInterval: refreshInterval,
+		heartbeatTTL:    heartbeatTTL,
+		logger:          logger.WithTags(tag.ComponentServiceResolver, tag.Service(service)),
+		ring:            hashring.New(farm.Fingerprint32, replicaPoints),
+		members:         make(map[string]struct{}),
+		listeners:       make(map[string]chan<- *membership.ChangedEvent),
+		shutdownCh:      make(chan struct{}),
+	}
+}
+
+// Start loads the live hosts of the service and starts refreshing them
+func (r *dbServiceResolver) Start() error {
+	r.ringLock.Lock()
+	if r.isStarted {
+		r.ringLock.Unlock()
+		return nil
+	}
+	r.isStarted = true
+	r.ringLock.Unlock()
+
+	if err := r.refresh(); err != nil {
+		return err
+	}
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+	return nil
+}
+
+// Stop stops the resolver
+func (r *dbServiceResolver) Stop() error {
+	r.ringLock.Lock()
+	if r.isStopped {
+		r.ringLock.Unlock()
+		return nil
+	}
+	r.isStopped = true
+	isStarted := r.isStarted
+	r.ringLock.Unlock()
+
+	// the refresh worker takes the ring lock, so it is released while waiting for the worker
+	if isStarted {
+		close(r.shutdownCh)
+	}
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+
+	r.ringLock.Lock()
+	r.ring = hashring.New(farm.Fingerprint32, replicaPoints)
+	r.members = make(map[string]struct{})
+	r.ringLock.Unlock()
+
+	r.listenerLock.Lock()
+	r.listeners = make(map[string]chan<- *membership.ChangedEvent)
+	r.listenerLock.Unlock()
+	return nil
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *dbServiceResolver) Lookup(key string) (*membership.HostInfo, error) {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	addr, found := r.ring.Lookup(key)
+	if !found {
+		return nil, membership.ErrInsufficientHosts
+	}
+	return membership.NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *dbServiceResolver) AddListener(name string, notifyChannel chan<- *membership.ChangedEvent) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return membership.ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *dbServiceResolver) RemoveListener(name string) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if !ok {
+		return nil
+	}
+	delete(r.listeners, name)
+	return nil
+}
+
+// refresh reloads the live hosts from the membership table, rebuilds the ring
+// when they differ from the current members and notifies the listeners of the change
+func (r *dbServiceResolver) refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.refreshInterval)
+	defer cancel()
+	resp, err := r.manager.GetClusterMembers(ctx, &persistence.GetClusterMembersRequest{
+		Role:                r.service,
+		LastHeartbeatWithin: r.heartbeatTTL,
+	})
+	if err != nil {
+		r.logger.Warn("Error during membership refresh.", tag.Error(err))
+		return err
+	}
+
+	// a restarted host heartbeats under a new host id while its old record
+	// has not expired yet, so members are keyed by address
+	members := make(map[string]struct{}, len(resp.ActiveMembers))
+	for _, member := range resp.ActiveMembers {
+		members[member.RPCAddress] = struct{}{}
+	}
+
+	r.ringLock.Lock()
+	event := &membership.ChangedEvent{}
+	for addr := range members {
+		if _, ok := r.members[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, membership.NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.members {
+		if _, ok := members[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, membership.NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		r.ringLock.Unlock()
+		return nil
+	}
+
+	addrs := make([]string, 0, len(members))
+	ring := hashring.New(farm.Fingerprint32, replicaPoints)
+	for addr := range members {
+		addrs = append(addrs, addr)
+		ring.AddMembers(membership.NewHostInfo(addr, r.getLabelsMap()))
+	}
+	r.ring = ring
+	r.members = members
+	r.ringLock.Unlock()
+
+	sort.Strings(addrs)
+	r.logger.Info("Membership changed", tag.Addresses(addrs))
+	r.emitEvent(event)
+	return nil
+}
+
+func (r *dbServiceResolver) emitEvent(event *membership.ChangedEvent) {
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *dbServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(r.refreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-refreshTicker.C:
+			r.refresh()
+		}
+	}
+}
+
+func (r *dbServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[membership.RoleKey] = r.service
+	return labels
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dbmembership
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	tcg "github.com/uber/tchannel-go"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
+)
+
+// Factory implements the MembershipMonitorFactory interface for membership
+// backed by the cluster membership table of the default persistence store
+type Factory struct {
+	config      *config.Ringpop
+	manager     persistence.ClusterMembershipManager
+	logger      log.Logger
+	serviceName string
+}
+
+// NewFactory builds a membership monitor factory which heartbeats through the given manager
+func NewFactory(cfg *config.Ringpop, manager persistence.ClusterMembershipManager, logger log.Logger, serviceName string) (*Factory, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Factory{config: cfg, manager: manager, logger: logger, serviceName: serviceName}, nil
+}
+
+// Create is the implementation for MembershipMonitorFactory.Create
+func (factory *Factory) Create(dispatcher *yarpc.Dispatcher) (membership.Monitor, error) {
+	// use actual listen port (in case service is bound to :0 or 0.0.0.0:0)
+	ch, err := factory.getChannel(dispatcher)
+	if err != nil {
+		return nil, err
+	}
+
+	membershipMonitor := NewMonitor(
+		config.CadenceServices,
+		uuid.New(),
+		ch.PeerInfo().HostPort,
+		factory.serviceName,
+		factory.manager,
+		factory.config.HeartbeatInterval,
+		factory.config.HeartbeatTTL,
+		factory.logger,
+	)
+	if err = membershipMonitor.Start(); err != nil {
+		return nil, fmt.Errorf("membership monitor start failed: %v", err)
+	}
+	return membershipMonitor, nil
+}
+
+func (factory *Factory) getChannel(dispatcher *yarpc.Dispatcher) (*tcg.Channel, error) {
+	t, ok := dispatcher.Inbounds()[0].Transports()[0].(*tchannel.ChannelTransport)
+	if !ok {
+		return nil, errors.New("Unable to get tchannel transport out of the dispatcher")
+	}
+	ch, ok := t.Channel().(*tcg.Channel)
+	if !ok {
+		return nil, errors.New("Unable to get tchannel out of the dispatcher")
+	}
+	return ch, nil
+}
@@ -25,4 +25,6 @@ import "errors"
 var (
 	// ErrMessageSizeLimit indicate that message is rejected by server due to size limitation
 	ErrMessageSizeLimit = errors.New("message was too large, server rejected it to avoid allocation error")
+	// ErrApplicationNotConfigured indicate that no kafka topic is configured for the application
+	ErrApplicationNotConfigured = errors.New("no kafka topic is configured for the application")
 )
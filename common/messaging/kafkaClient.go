@@ -141,6 +141,9 @@ func (c *kafkaClient) newConsumerHelper(topic, dlq *uberKafka.Topic, consumerNam
 // NewProducer is used to create a Kafka producer
 func (c *kafkaClient) NewProducer(app string) (Producer, error) {
 	topics := c.config.getTopicsForApplication(app)
+	if topics.Topic == "" {
+		return nil, ErrApplicationNotConfigured
+	}
 	return c.newProducerHelper(topics.Topic)
 }
 
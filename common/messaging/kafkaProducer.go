@@ -161,6 +161,18 @@ func (p *kafkaProducer) getProducerMessage(message interface{}) (*sarama.Produce
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *indexer.DLQMessage:
+		dlqMsg := message.(*indexer.DLQMessage)
+		payload, err := p.serializeThrift(dlqMsg)
+		if err != nil {
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(dlqMsg.GetMessage().GetWorkflowID()),
+			Value: sarama.ByteEncoder(payload),
+		}
+		return msg, nil
 	default:
 		return nil, errors.New("unknown producer message type")
 	}
@@ -52,6 +52,7 @@ const (
 	Counter MetricType = iota
 	Timer
 	Gauge
+	Histogram
 )
 
 // Service names for all services that emit metrics.
@@ -106,6 +107,10 @@ const (
 	GcPauseMsTimer       = "memory_gc_pause_ms"
 )
 
+// replicationTaskIDLagBuckets are the buckets of the distance between the replication ack level
+// and the max task ID of a shard, from a single task ID up to about 10^9
+var replicationTaskIDLagBuckets = tally.MustMakeExponentialValueBuckets(1, 4, 16)
+
 // ServiceMetrics are types for common service base metrics
 var ServiceMetrics = map[MetricName]MetricType{
 	RestartCount: Counter,
@@ -160,6 +165,14 @@ const (
 	PersistenceRangeCompleteTransferTaskScope
 	// PersistenceCompleteReplicationTaskScope tracks CompleteReplicationTasks calls made by service to persistence layer
 	PersistenceCompleteReplicationTaskScope
+	// PersistencePutReplicationTaskToDLQScope tracks PutReplicationTaskToDLQ calls made by service to persistence layer
+	PersistencePutReplicationTaskToDLQScope
+	// PersistenceGetReplicationTasksFromDLQScope tracks GetReplicationTasksFromDLQ calls made by service to persistence layer
+	PersistenceGetReplicationTasksFromDLQScope
+	// PersistenceDeleteReplicationTaskFromDLQScope tracks DeleteReplicationTaskFromDLQ calls made by service to persistence layer
+	PersistenceDeleteReplicationTaskFromDLQScope
+	// PersistenceRangeDeleteReplicationTaskFromDLQScope tracks RangeDeleteReplicationTaskFromDLQ calls made by service to persistence layer
+	PersistenceRangeDeleteReplicationTaskFromDLQScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
 	PersistenceGetTimerIndexTasksScope
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
@@ -278,6 +291,16 @@ const (
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
 	HistoryClientSyncActivityScope
+	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientGetReplicationMessagesScope
+	// HistoryClientReadDLQMessagesScope tracks RPC calls to history service
+	HistoryClientReadDLQMessagesScope
+	// HistoryClientPurgeDLQMessagesScope tracks RPC calls to history service
+	HistoryClientPurgeDLQMessagesScope
+	// HistoryClientMergeDLQMessagesScope tracks RPC calls to history service
+	HistoryClientMergeDLQMessagesScope
+	// HistoryClientDescribeReplicationStatusScope tracks RPC calls to history service
+	HistoryClientDescribeReplicationStatusScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -362,6 +385,22 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientVerifyMutableStateScope tracks RPC calls to admin service
+	AdminClientVerifyMutableStateScope
+	// AdminClientGetReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientGetReplicationMessagesScope
+	// AdminClientReadDLQMessagesScope tracks RPC calls to admin service
+	AdminClientReadDLQMessagesScope
+	// AdminClientPurgeDLQMessagesScope tracks RPC calls to admin service
+	AdminClientPurgeDLQMessagesScope
+	// AdminClientMergeDLQMessagesScope tracks RPC calls to admin service
+	AdminClientMergeDLQMessagesScope
+	// AdminClientDescribeReplicationStatusScope tracks RPC calls to admin service
+	AdminClientDescribeReplicationStatusScope
+	// AdminClientFailoverDomainsScope tracks RPC calls to admin service
+	AdminClientFailoverDomainsScope
+	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
+	AdminClientAddSearchAttributeScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -463,6 +502,18 @@ const (
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetHistoryTreeScope
+	// PersistenceCompressScope tracks payload compression done by the persistence serializer
+	PersistenceCompressScope
+	// PersistenceDecompressScope tracks payload decompression done by the persistence serializer
+	PersistenceDecompressScope
+	// PersistenceUpsertClusterMembershipScope tracks UpsertClusterMembership calls made by service to persistence layer
+	PersistenceUpsertClusterMembershipScope
+	// PersistenceGetClusterMembersScope tracks GetClusterMembers calls made by service to persistence layer
+	PersistenceGetClusterMembersScope
+	// PersistencePruneClusterMembershipScope tracks PruneClusterMembership calls made by service to persistence layer
+	PersistencePruneClusterMembershipScope
+	// PersistenceDeleteClusterMembershipScope tracks DeleteClusterMembership calls made by service to persistence layer
+	PersistenceDeleteClusterMembershipScope
 
 	// BlobstoreClientUploadScope tracks Upload calls to blobstore
 	BlobstoreClientUploadScope
@@ -523,6 +574,22 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminVerifyMutableStateScope is the metric scope for admin.VerifyMutableStateScope
+	AdminVerifyMutableStateScope
+	// AdminGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
+	AdminGetReplicationMessagesScope
+	// AdminReadDLQMessagesScope is the metric scope for admin.ReadDLQMessages
+	AdminReadDLQMessagesScope
+	// AdminPurgeDLQMessagesScope is the metric scope for admin.PurgeDLQMessages
+	AdminPurgeDLQMessagesScope
+	// AdminMergeDLQMessagesScope is the metric scope for admin.MergeDLQMessages
+	AdminMergeDLQMessagesScope
+	// AdminDescribeReplicationStatusScope is the metric scope for admin.DescribeReplicationStatus
+	AdminDescribeReplicationStatusScope
+	// AdminFailoverDomainsScope is the metric scope for admin.FailoverDomains
+	AdminFailoverDomainsScope
+	// AdminAddSearchAttributeScope is the metric scope for admin.AddSearchAttribute
+	AdminAddSearchAttributeScope
 
 	NumAdminScopes
 )
@@ -653,6 +720,18 @@ const (
 	HistorySyncActivityScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
+	// HistoryVerifyMutableStateScope tracks VerifyMutableState API calls received by service
+	HistoryVerifyMutableStateScope
+	// HistoryGetReplicationMessagesScope tracks GetReplicationMessages API calls received by service
+	HistoryGetReplicationMessagesScope
+	// HistoryReadDLQMessagesScope tracks ReadDLQMessages API calls received by service
+	HistoryReadDLQMessagesScope
+	// HistoryPurgeDLQMessagesScope tracks PurgeDLQMessages API calls received by service
+	HistoryPurgeDLQMessagesScope
+	// HistoryMergeDLQMessagesScope tracks MergeDLQMessages API calls received by service
+	HistoryMergeDLQMessagesScope
+	// HistoryDescribeReplicationStatusScope tracks DescribeReplicationStatus API calls received by service
+	HistoryDescribeReplicationStatusScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -735,6 +814,10 @@ const (
 	ReplicatorTaskHistoryScope
 	// ReplicatorTaskSyncActivityScope is the scope used for sync activity by replicator queue processor
 	ReplicatorTaskSyncActivityScope
+	// ReplicationTaskFetcherScope is the scope used by the replication task fetcher pulling from remote clusters
+	ReplicationTaskFetcherScope
+	// ReplicationTaskProcessorScope is the scope used by the replication task processor applying pulled tasks
+	ReplicationTaskProcessorScope
 	// ReplicateHistoryEventsScope is the scope used by historyReplicator API for applying events
 	ReplicateHistoryEventsScope
 	// ShardInfoScope is the scope used when updating shard info
@@ -875,6 +958,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
 		PersistenceRangeCompleteTransferTaskScope:                {operation: "RangeCompleteTransferTask"},
 		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
+		PersistencePutReplicationTaskToDLQScope:                  {operation: "PutReplicationTaskToDLQ"},
+		PersistenceGetReplicationTasksFromDLQScope:               {operation: "GetReplicationTasksFromDLQ"},
+		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "DeleteReplicationTaskFromDLQ"},
+		PersistenceRangeDeleteReplicationTaskFromDLQScope:        {operation: "RangeDeleteReplicationTaskFromDLQ"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
 		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
@@ -916,6 +1003,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch"},
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
+		PersistenceCompressScope:                                 {operation: "PersistenceCompress"},
+		PersistenceDecompressScope:                               {operation: "PersistenceDecompress"},
+		PersistenceUpsertClusterMembershipScope:                  {operation: "UpsertClusterMembership"},
+		PersistenceGetClusterMembersScope:                        {operation: "GetClusterMembers"},
+		PersistencePruneClusterMembershipScope:                   {operation: "PruneClusterMembership"},
+		PersistenceDeleteClusterMembershipScope:                  {operation: "DeleteClusterMembership"},
 
 		BlobstoreClientUploadScope:       {operation: "BlobstoreClientUpload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDownloadScope:     {operation: "BlobstoreClientDownload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
@@ -951,6 +1044,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientReplicateRawEventsScope:                {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeReplicationStatusScope:         {operation: "HistoryClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -993,6 +1091,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientVerifyMutableStateScope:                  {operation: "AdminClientVerifyMutableState", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetReplicationMessagesScope:              {operation: "AdminClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReadDLQMessagesScope:                     {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeDLQMessagesScope:                    {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMergeDLQMessagesScope:                    {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeReplicationStatusScope:           {operation: "AdminClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientFailoverDomainsScope:                     {operation: "AdminClientFailoverDomains", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                   {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                    {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                  {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1060,6 +1166,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminVerifyMutableStateScope:             {operation: "VerifyMutableState"},
+		AdminGetReplicationMessagesScope:         {operation: "GetReplicationMessages"},
+		AdminReadDLQMessagesScope:                {operation: "ReadDLQMessages"},
+		AdminPurgeDLQMessagesScope:               {operation: "PurgeDLQMessages"},
+		AdminMergeDLQMessagesScope:               {operation: "MergeDLQMessages"},
+		AdminDescribeReplicationStatusScope:      {operation: "DescribeReplicationStatus"},
+		AdminFailoverDomainsScope:                {operation: "FailoverDomains"},
+		AdminAddSearchAttributeScope:             {operation: "AddSearchAttribute"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1125,6 +1239,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncShardStatusScope:                   {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                      {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:              {operation: "DescribeMutableState"},
+		HistoryVerifyMutableStateScope:                {operation: "VerifyMutableState"},
+		HistoryGetReplicationMessagesScope:            {operation: "GetReplicationMessages"},
+		HistoryReadDLQMessagesScope:                   {operation: "ReadDLQMessages"},
+		HistoryPurgeDLQMessagesScope:                  {operation: "PurgeDLQMessages"},
+		HistoryMergeDLQMessagesScope:                  {operation: "MergeDLQMessages"},
+		HistoryDescribeReplicationStatusScope:         {operation: "DescribeReplicationStatus"},
 		HistoryShardControllerScope:                   {operation: "ShardController"},
 		TransferQueueProcessorScope:                   {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:             {operation: "TransferActiveQueueProcessor"},
@@ -1166,6 +1286,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ReplicatorQueueProcessorScope:                 {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                    {operation: "ReplicatorTaskHistory"},
 		ReplicatorTaskSyncActivityScope:               {operation: "ReplicatorTaskSyncActivity"},
+		ReplicationTaskFetcherScope:                   {operation: "ReplicationTaskFetcher"},
+		ReplicationTaskProcessorScope:                 {operation: "ReplicationTaskProcessor"},
 		ReplicateHistoryEventsScope:                   {operation: "ReplicateHistoryEvents"},
 		ShardInfoScope:                                {operation: "ShardInfo"},
 		WorkflowContextScope:                          {operation: "WorkflowContext"},
@@ -1256,6 +1378,8 @@ const (
 	PersistenceErrExecutionAlreadyStartedCounter
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
+	PersistenceErrContextCanceledCounter
+	PersistenceErrContextDeadlineExceededCounter
 	PersistenceSampledCounter
 
 	CadenceClientRequests
@@ -1269,6 +1393,11 @@ const (
 	HistoryCount
 	EventBlobSize
 
+	CompressionLatency
+	CompressionRatio
+	UncompressedPayloadSize
+	CompressedPayloadSize
+
 	ArchivalConfigFailures
 
 	ElasticsearchRequests
@@ -1276,6 +1405,8 @@ const (
 	ElasticsearchLatency
 	ElasticsearchErrBadRequestCounter
 	ElasticsearchErrBusyCounter
+	ElasticsearchErrContextCanceledCounter
+	ElasticsearchErrContextDeadlineExceededCounter
 
 	SequentialTaskSubmitRequest
 	SequentialTaskSubmitRequestTaskQueueExist
@@ -1374,6 +1505,7 @@ const (
 	CacheMissCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
+	MutableStateChecksumMismatch
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -1404,6 +1536,12 @@ const (
 	WorkflowFailedCount
 	WorkflowTimeoutCount
 	WorkflowTerminateCount
+	ReplicationTasksFetched
+	ReplicationTasksApplied
+	ReplicationTasksFailed
+	ReplicationTaskFetcherErrors
+	ReplicationTaskLag
+	ReplicationTaskIDLag
 
 	NumHistoryMetrics
 )
@@ -1432,13 +1570,17 @@ const (
 	ReplicatorFailures
 	ReplicatorMessagesDropped
 	ReplicatorLatency
+	ReplicatorTaskLag
 	ESProcessorRequests
 	ESProcessorRetries
 	ESProcessorFailures
 	ESProcessorCorruptedData
 	ESProcessorProcessMsgLatency
+	ESProcessorDLQMessages
+	ESProcessorDLQFailures
 	IndexProcessorCorruptedData
 	IndexProcessorProcessMsgLatency
+	IndexProcessorDeletedDomainMessages
 	ArchiverNonRetryableErrorCount
 	ArchiverSkipUploadCount
 	ArchiverHistoryMutatedCount
@@ -1523,6 +1665,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrExecutionAlreadyStartedCounter:        {metricName: "persistence_errors_execution_already_started", metricType: Counter},
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
+		PersistenceErrContextCanceledCounter:                {metricName: "persistence_errors_context_canceled", metricType: Counter},
+		PersistenceErrContextDeadlineExceededCounter:        {metricName: "persistence_errors_context_deadline_exceeded", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
@@ -1532,12 +1676,18 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
+		CompressionLatency:                                  {metricName: "compression_latency", metricType: Timer},
+		CompressionRatio:                                    {metricName: "compression_ratio", metricType: Gauge},
+		UncompressedPayloadSize:                             {metricName: "uncompressed_payload_size", metricType: Timer},
+		CompressedPayloadSize:                               {metricName: "compressed_payload_size", metricType: Timer},
 		ArchivalConfigFailures:                              {metricName: "archivalconfig_failures", metricType: Counter},
 		ElasticsearchRequests:                               {metricName: "elasticsearch_requests", metricType: Counter},
 		ElasticsearchFailures:                               {metricName: "elasticsearch_errors", metricType: Counter},
 		ElasticsearchLatency:                                {metricName: "elasticsearch_latency", metricType: Timer},
 		ElasticsearchErrBadRequestCounter:                   {metricName: "elasticsearch_errors_bad_request", metricType: Counter},
 		ElasticsearchErrBusyCounter:                         {metricName: "elasticsearch_errors_busy", metricType: Counter},
+		ElasticsearchErrContextCanceledCounter:              {metricName: "elasticsearch_errors_context_canceled", metricType: Counter},
+		ElasticsearchErrContextDeadlineExceededCounter:      {metricName: "elasticsearch_errors_context_deadline_exceeded", metricType: Counter},
 		SequentialTaskSubmitRequest:                         {metricName: "sequentialtask_submit_request", metricType: Counter},
 		SequentialTaskSubmitRequestTaskQueueExist:           {metricName: "sequentialtask_submit_request_taskqueue_exist", metricType: Counter},
 		SequentialTaskSubmitRequestTaskQueueMissing:         {metricName: "sequentialtask_submit_request_taskqueue_missing", metricType: Counter},
@@ -1631,6 +1781,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheMissCounter:                             {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                     {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                       {metricName: "workflow_context_cleared", metricType: Counter},
+		MutableStateChecksumMismatch:                 {metricName: "mutable_state_checksum_mismatch", metricType: Counter},
 		MutableStateSize:                             {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                            {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                             {metricName: "activity_info_size", metricType: Timer},
@@ -1661,6 +1812,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowFailedCount:                          {metricName: "workflow_failed", metricType: Counter},
 		WorkflowTimeoutCount:                         {metricName: "workflow_timeout", metricType: Counter},
 		WorkflowTerminateCount:                       {metricName: "workflow_terminate", metricType: Counter},
+		ReplicationTasksFetched:                      {metricName: "replication_tasks_fetched", metricType: Counter},
+		ReplicationTasksApplied:                      {metricName: "replication_tasks_applied", metricType: Counter},
+		ReplicationTasksFailed:                       {metricName: "replication_tasks_failed", metricType: Counter},
+		ReplicationTaskFetcherErrors:                 {metricName: "replication_task_fetcher_errors", metricType: Counter},
+		ReplicationTaskLag:                           {metricName: "replication_task_lag", metricType: Timer},
+		ReplicationTaskIDLag:                         {metricName: "replication_task_id_lag", metricType: Histogram, buckets: replicationTaskIDLagBuckets},
 	},
 	Matching: {
 		PollSuccessCounter:            {metricName: "poll_success"},
@@ -1681,13 +1838,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicatorFailures:                                     {metricName: "replicator_errors"},
 		ReplicatorMessagesDropped:                              {metricName: "replicator_messages_dropped"},
 		ReplicatorLatency:                                      {metricName: "replicator_latency"},
+		ReplicatorTaskLag:                                      {metricName: "replication_task_lag", metricType: Timer},
 		ESProcessorRequests:                                    {metricName: "es_processor_requests"},
 		ESProcessorRetries:                                     {metricName: "es_processor_retries"},
 		ESProcessorFailures:                                    {metricName: "es_processor_errors"},
 		ESProcessorCorruptedData:                               {metricName: "es_processor_corrupted_data"},
 		ESProcessorProcessMsgLatency:                           {metricName: "es_processor_process_msg_latency", metricType: Timer},
+		ESProcessorDLQMessages:                                 {metricName: "es_processor_dlq_messages"},
+		ESProcessorDLQFailures:                                 {metricName: "es_processor_dlq_errors"},
 		IndexProcessorCorruptedData:                            {metricName: "index_processor_corrupted_data"},
 		IndexProcessorProcessMsgLatency:                        {metricName: "index_processor_process_msg_latency", metricType: Timer},
+		IndexProcessorDeletedDomainMessages:                    {metricName: "index_processor_deleted_domain_messages"},
 		ArchiverNonRetryableErrorCount:                         {metricName: "archiver_non_retryable_error"},
 		ArchiverSkipUploadCount:                                {metricName: "archiver_skip_upload"},
 		ArchiverHistoryMutatedCount:                            {metricName: "archiver_history_mutated"},
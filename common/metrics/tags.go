@@ -30,6 +30,7 @@ const (
 	instance      = "instance"
 	domain        = "domain"
 	targetCluster = "target_cluster"
+	encoding      = "encoding"
 
 	domainAllValue = "all"
 	unknownValue   = "_unknown_"
@@ -55,6 +56,10 @@ type (
 	targetClusterTag struct {
 		value string
 	}
+
+	encodingTag struct {
+		value string
+	}
 )
 
 // DomainTag returns a new domain tag. For timers, this also ensures that we
@@ -124,3 +129,21 @@ func (d targetClusterTag) Key() string {
 func (d targetClusterTag) Value() string {
 	return d.value
 }
+
+// EncodingTag returns a new encoding tag.
+func EncodingTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return encodingTag{value}
+}
+
+// Key returns the key of the encoding tag
+func (e encodingTag) Key() string {
+	return encoding
+}
+
+// Value returns the value of an encoding tag
+func (e encodingTag) Value() string {
+	return e.value
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/yarpc"
 )
@@ -35,6 +36,149 @@ type AdminClient struct {
 	mock.Mock
 }
 
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetReplicationMessagesRequest) *replicator.GetReplicationMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadDLQMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ReadDLQMessages(ctx context.Context, request *replicator.ReadDLQMessagesRequest, opts ...yarpc.CallOption) (*replicator.ReadDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.ReadDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.ReadDLQMessagesRequest) *replicator.ReadDLQMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReadDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.ReadDLQMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDLQMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) PurgeDLQMessages(ctx context.Context, request *replicator.PurgeDLQMessagesRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.PurgeDLQMessagesRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MergeDLQMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) MergeDLQMessages(ctx context.Context, request *replicator.MergeDLQMessagesRequest, opts ...yarpc.CallOption) (*replicator.MergeDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.MergeDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.MergeDLQMessagesRequest) *replicator.MergeDLQMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.MergeDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.MergeDLQMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReplicationStatus provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeReplicationStatus(ctx context.Context, request *replicator.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.DescribeReplicationStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.DescribeReplicationStatusRequest) *replicator.DescribeReplicationStatusResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.DescribeReplicationStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.DescribeReplicationStatusRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverDomains provides a mock function with given fields: ctx, request
+func (_m *AdminClient) FailoverDomains(ctx context.Context, request *admin.FailoverDomainsRequest, opts ...yarpc.CallOption) (*admin.FailoverDomainsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.FailoverDomainsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.FailoverDomainsRequest) *admin.FailoverDomainsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.FailoverDomainsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.FailoverDomainsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddSearchAttribute provides a mock function with given fields: ctx, request
+func (_m *AdminClient) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.AddSearchAttributeRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 var _ adminserviceclient.Interface = (*AdminClient)(nil)
 
 // DescribeHistoryHost provides a mock function with given fields: ctx, request
@@ -105,3 +249,26 @@ func (_m *AdminClient) GetWorkflowExecutionRawHistory(ctx context.Context, reque
 
 	return r0, r1
 }
+
+// VerifyMutableState provides a mock function with given fields: ctx, request
+func (_m *AdminClient) VerifyMutableState(ctx context.Context, request *admin.VerifyMutableStateRequest, opts ...yarpc.CallOption) (*admin.VerifyMutableStateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.VerifyMutableStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.VerifyMutableStateRequest) *admin.VerifyMutableStateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.VerifyMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.VerifyMutableStateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -20,6 +20,7 @@
 
 package mocks
 
+import "context"
 import "github.com/uber/cadence/common/persistence"
 import "github.com/stretchr/testify/mock"
 
@@ -56,13 +57,13 @@ func (_m *ExecutionManager) GetShardID() int {
 	return r0
 }
 
-// CreateWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
-	ret := _m.Called(request)
+// CreateWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.CreateWorkflowExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.CreateWorkflowExecutionRequest) *persistence.CreateWorkflowExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CreateWorkflowExecutionRequest) *persistence.CreateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.CreateWorkflowExecutionResponse)
@@ -70,8 +71,8 @@ func (_m *ExecutionManager) CreateWorkflowExecution(request *persistence.CreateW
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.CreateWorkflowExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.CreateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,13 +80,13 @@ func (_m *ExecutionManager) CreateWorkflowExecution(request *persistence.CreateW
 	return r0, r1
 }
 
-// GetWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) GetWorkflowExecution(request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
-	ret := _m.Called(request)
+// GetWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetWorkflowExecution(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetWorkflowExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetWorkflowExecutionRequest) *persistence.GetWorkflowExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetWorkflowExecutionRequest) *persistence.GetWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionResponse)
@@ -93,8 +94,8 @@ func (_m *ExecutionManager) GetWorkflowExecution(request *persistence.GetWorkflo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetWorkflowExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -102,13 +103,13 @@ func (_m *ExecutionManager) GetWorkflowExecution(request *persistence.GetWorkflo
 	return r0, r1
 }
 
-// UpdateWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
-	ret := _m.Called(request)
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.UpdateWorkflowExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.UpdateWorkflowExecutionRequest) *persistence.UpdateWorkflowExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.UpdateWorkflowExecutionRequest) *persistence.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.UpdateWorkflowExecutionResponse)
@@ -116,8 +117,8 @@ func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateW
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.UpdateWorkflowExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -125,13 +126,13 @@ func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateW
 	return r0, r1
 }
 
-// ResetMutableState provides a mock function with given fields: request
-func (_m *ExecutionManager) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
-	ret := _m.Called(request)
+// ResetMutableState provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.ResetMutableStateRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ResetMutableStateRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -139,13 +140,13 @@ func (_m *ExecutionManager) ResetMutableState(request *persistence.ResetMutableS
 	return r0
 }
 
-// ResetWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
-	ret := _m.Called(request)
+// ResetWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.ResetWorkflowExecutionRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ResetWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -153,13 +154,13 @@ func (_m *ExecutionManager) ResetWorkflowExecution(request *persistence.ResetWor
 	return r0
 }
 
-// DeleteWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) DeleteWorkflowExecution(request *persistence.DeleteWorkflowExecutionRequest) error {
-	ret := _m.Called(request)
+// DeleteWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.DeleteWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.DeleteWorkflowExecutionRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -167,13 +168,13 @@ func (_m *ExecutionManager) DeleteWorkflowExecution(request *persistence.DeleteW
 	return r0
 }
 
-// DeleteCurrentWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) DeleteCurrentWorkflowExecution(request *persistence.DeleteCurrentWorkflowExecutionRequest) error {
-	ret := _m.Called(request)
+// DeleteCurrentWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *persistence.DeleteCurrentWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.DeleteCurrentWorkflowExecutionRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteCurrentWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -181,13 +182,13 @@ func (_m *ExecutionManager) DeleteCurrentWorkflowExecution(request *persistence.
 	return r0
 }
 
-// GetCurrentExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
-	ret := _m.Called(request)
+// GetCurrentExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetCurrentExecution(ctx context.Context, request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetCurrentExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetCurrentExecutionRequest) *persistence.GetCurrentExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetCurrentExecutionRequest) *persistence.GetCurrentExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetCurrentExecutionResponse)
@@ -195,8 +196,8 @@ func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentE
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetCurrentExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetCurrentExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -204,13 +205,13 @@ func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentE
 	return r0, r1
 }
 
-// GetTransferTasks provides a mock function with given fields: request
-func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTasksRequest) (*persistence.GetTransferTasksResponse, error) {
-	ret := _m.Called(request)
+// GetTransferTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetTransferTasks(ctx context.Context, request *persistence.GetTransferTasksRequest) (*persistence.GetTransferTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetTransferTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetTransferTasksRequest) *persistence.GetTransferTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetTransferTasksRequest) *persistence.GetTransferTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetTransferTasksResponse)
@@ -218,8 +219,8 @@ func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTas
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetTransferTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetTransferTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -227,13 +228,13 @@ func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTas
 	return r0, r1
 }
 
-// CompleteTransferTask provides a mock function with given fields: request
-func (_m *ExecutionManager) CompleteTransferTask(request *persistence.CompleteTransferTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteTransferTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CompleteTransferTask(ctx context.Context, request *persistence.CompleteTransferTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteTransferTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteTransferTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -241,13 +242,13 @@ func (_m *ExecutionManager) CompleteTransferTask(request *persistence.CompleteTr
 	return r0
 }
 
-// RangeCompleteTransferTask provides a mock function with given fields: request
-func (_m *ExecutionManager) RangeCompleteTransferTask(request *persistence.RangeCompleteTransferTaskRequest) error {
-	ret := _m.Called(request)
+// RangeCompleteTransferTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeCompleteTransferTask(ctx context.Context, request *persistence.RangeCompleteTransferTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.RangeCompleteTransferTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeCompleteTransferTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -255,13 +256,13 @@ func (_m *ExecutionManager) RangeCompleteTransferTask(request *persistence.Range
 	return r0
 }
 
-// GetReplicationTasks provides a mock function with given fields: request
-func (_m *ExecutionManager) GetReplicationTasks(request *persistence.GetReplicationTasksRequest) (*persistence.GetReplicationTasksResponse, error) {
-	ret := _m.Called(request)
+// GetReplicationTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetReplicationTasks(ctx context.Context, request *persistence.GetReplicationTasksRequest) (*persistence.GetReplicationTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetReplicationTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetReplicationTasksRequest) *persistence.GetReplicationTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetReplicationTasksRequest) *persistence.GetReplicationTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetReplicationTasksResponse)
@@ -269,8 +270,8 @@ func (_m *ExecutionManager) GetReplicationTasks(request *persistence.GetReplicat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetReplicationTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetReplicationTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -278,13 +279,13 @@ func (_m *ExecutionManager) GetReplicationTasks(request *persistence.GetReplicat
 	return r0, r1
 }
 
-// CompleteReplicationTask provides a mock function with given fields: request
-func (_m *ExecutionManager) CompleteReplicationTask(request *persistence.CompleteReplicationTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteReplicationTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CompleteReplicationTask(ctx context.Context, request *persistence.CompleteReplicationTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteReplicationTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteReplicationTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -292,13 +293,78 @@ func (_m *ExecutionManager) CompleteReplicationTask(request *persistence.Complet
 	return r0
 }
 
-// GetTimerIndexTasks provides a mock function with given fields: request
-func (_m *ExecutionManager) GetTimerIndexTasks(request *persistence.GetTimerIndexTasksRequest) (*persistence.GetTimerIndexTasksResponse, error) {
-	ret := _m.Called(request)
+// PutReplicationTaskToDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *persistence.PutReplicationTaskToDLQRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.PutReplicationTaskToDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReplicationTasksFromDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (*persistence.GetReplicationTasksFromDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *persistence.GetReplicationTasksFromDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetReplicationTasksFromDLQRequest) *persistence.GetReplicationTasksFromDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetReplicationTasksFromDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetReplicationTasksFromDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReplicationTaskFromDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.DeleteReplicationTaskFromDLQRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteReplicationTaskFromDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RangeDeleteReplicationTaskFromDLQ provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.RangeDeleteReplicationTaskFromDLQRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeDeleteReplicationTaskFromDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTimerIndexTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetTimerIndexTasks(ctx context.Context, request *persistence.GetTimerIndexTasksRequest) (*persistence.GetTimerIndexTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetTimerIndexTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetTimerIndexTasksRequest) *persistence.GetTimerIndexTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetTimerIndexTasksRequest) *persistence.GetTimerIndexTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetTimerIndexTasksResponse)
@@ -306,8 +372,8 @@ func (_m *ExecutionManager) GetTimerIndexTasks(request *persistence.GetTimerInde
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetTimerIndexTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetTimerIndexTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -315,13 +381,13 @@ func (_m *ExecutionManager) GetTimerIndexTasks(request *persistence.GetTimerInde
 	return r0, r1
 }
 
-// CompleteTimerTask provides a mock function with given fields: request
-func (_m *ExecutionManager) CompleteTimerTask(request *persistence.CompleteTimerTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteTimerTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CompleteTimerTask(ctx context.Context, request *persistence.CompleteTimerTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteTimerTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteTimerTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -329,13 +395,13 @@ func (_m *ExecutionManager) CompleteTimerTask(request *persistence.CompleteTimer
 	return r0
 }
 
-// RangeCompleteTimerTask provides a mock function with given fields: request
-func (_m *ExecutionManager) RangeCompleteTimerTask(request *persistence.RangeCompleteTimerTaskRequest) error {
-	ret := _m.Called(request)
+// RangeCompleteTimerTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeCompleteTimerTask(ctx context.Context, request *persistence.RangeCompleteTimerTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.RangeCompleteTimerTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeCompleteTimerTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -36,6 +37,112 @@ type HistoryClient struct {
 	mock.Mock
 }
 
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetReplicationMessagesRequest) *replicator.GetReplicationMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadDLQMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ReadDLQMessages(ctx context.Context, request *replicator.ReadDLQMessagesRequest, opts ...yarpc.CallOption) (*replicator.ReadDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.ReadDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.ReadDLQMessagesRequest) *replicator.ReadDLQMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReadDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.ReadDLQMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDLQMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) PurgeDLQMessages(ctx context.Context, request *replicator.PurgeDLQMessagesRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.PurgeDLQMessagesRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MergeDLQMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) MergeDLQMessages(ctx context.Context, request *replicator.MergeDLQMessagesRequest, opts ...yarpc.CallOption) (*replicator.MergeDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.MergeDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.MergeDLQMessagesRequest) *replicator.MergeDLQMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.MergeDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.MergeDLQMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReplicationStatus provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeReplicationStatus(ctx context.Context, request *replicator.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.DescribeReplicationStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.DescribeReplicationStatusRequest) *replicator.DescribeReplicationStatusResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.DescribeReplicationStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.DescribeReplicationStatusRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ historyserviceclient.Interface = (*HistoryClient)(nil)
 
 // DescribeHistoryHost provides a mock function with given fields: ctx, getRequest
@@ -84,6 +191,29 @@ func (_m *HistoryClient) DescribeMutableState(ctx context.Context, request *hist
 	return r0, r1
 }
 
+// VerifyMutableState provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) VerifyMutableState(ctx context.Context, request *history.VerifyMutableStateRequest, opts ...yarpc.CallOption) (*history.VerifyMutableStateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.VerifyMutableStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.VerifyMutableStateRequest) *history.VerifyMutableStateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.VerifyMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.VerifyMutableStateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) GetMutableState(ctx context.Context, getRequest *history.GetMutableStateRequest, opts ...yarpc.CallOption) (*history.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, getRequest)
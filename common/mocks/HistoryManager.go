@@ -20,6 +20,7 @@
 
 package mocks
 
+import "context"
 import "github.com/uber/cadence/common/persistence"
 import "github.com/stretchr/testify/mock"
 
@@ -42,13 +43,13 @@ func (_m *HistoryManager) GetName() string {
 	return r0
 }
 
-// AppendHistoryEvents provides a mock function with given fields: request
-func (_m *HistoryManager) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (*persistence.AppendHistoryEventsResponse, error) {
-	ret := _m.Called(request)
+// AppendHistoryEvents provides a mock function with given fields: ctx, request
+func (_m *HistoryManager) AppendHistoryEvents(ctx context.Context, request *persistence.AppendHistoryEventsRequest) (*persistence.AppendHistoryEventsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.AppendHistoryEventsResponse
-	if rf, ok := ret.Get(0).(func(*persistence.AppendHistoryEventsRequest) *persistence.AppendHistoryEventsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.AppendHistoryEventsRequest) *persistence.AppendHistoryEventsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.AppendHistoryEventsResponse)
@@ -56,8 +57,8 @@ func (_m *HistoryManager) AppendHistoryEvents(request *persistence.AppendHistory
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.AppendHistoryEventsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.AppendHistoryEventsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -65,13 +66,13 @@ func (_m *HistoryManager) AppendHistoryEvents(request *persistence.AppendHistory
 	return r0, r1
 }
 
-// GetWorkflowExecutionHistory provides a mock function with given fields: request
-func (_m *HistoryManager) GetWorkflowExecutionHistory(request *persistence.GetWorkflowExecutionHistoryRequest) (*persistence.GetWorkflowExecutionHistoryResponse, error) {
-	ret := _m.Called(request)
+// GetWorkflowExecutionHistory provides a mock function with given fields: ctx, request
+func (_m *HistoryManager) GetWorkflowExecutionHistory(ctx context.Context, request *persistence.GetWorkflowExecutionHistoryRequest) (*persistence.GetWorkflowExecutionHistoryResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetWorkflowExecutionHistoryResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetWorkflowExecutionHistoryRequest) *persistence.GetWorkflowExecutionHistoryResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetWorkflowExecutionHistoryRequest) *persistence.GetWorkflowExecutionHistoryResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionHistoryResponse)
@@ -79,8 +80,8 @@ func (_m *HistoryManager) GetWorkflowExecutionHistory(request *persistence.GetWo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetWorkflowExecutionHistoryRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetWorkflowExecutionHistoryRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,13 +89,13 @@ func (_m *HistoryManager) GetWorkflowExecutionHistory(request *persistence.GetWo
 	return r0, r1
 }
 
-// GetWorkflowExecutionHistoryByBatch provides a mock function with given fields: request
-func (_m *HistoryManager) GetWorkflowExecutionHistoryByBatch(request *persistence.GetWorkflowExecutionHistoryRequest) (*persistence.GetWorkflowExecutionHistoryByBatchResponse, error) {
-	ret := _m.Called(request)
+// GetWorkflowExecutionHistoryByBatch provides a mock function with given fields: ctx, request
+func (_m *HistoryManager) GetWorkflowExecutionHistoryByBatch(ctx context.Context, request *persistence.GetWorkflowExecutionHistoryRequest) (*persistence.GetWorkflowExecutionHistoryByBatchResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetWorkflowExecutionHistoryByBatchResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetWorkflowExecutionHistoryRequest) *persistence.GetWorkflowExecutionHistoryByBatchResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetWorkflowExecutionHistoryRequest) *persistence.GetWorkflowExecutionHistoryByBatchResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionHistoryByBatchResponse)
@@ -102,8 +103,8 @@ func (_m *HistoryManager) GetWorkflowExecutionHistoryByBatch(request *persistenc
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetWorkflowExecutionHistoryRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetWorkflowExecutionHistoryRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,13 +112,13 @@ func (_m *HistoryManager) GetWorkflowExecutionHistoryByBatch(request *persistenc
 	return r0, r1
 }
 
-// DeleteWorkflowExecutionHistory provides a mock function with given fields: request
-func (_m *HistoryManager) DeleteWorkflowExecutionHistory(request *persistence.DeleteWorkflowExecutionHistoryRequest) error {
-	ret := _m.Called(request)
+// DeleteWorkflowExecutionHistory provides a mock function with given fields: ctx, request
+func (_m *HistoryManager) DeleteWorkflowExecutionHistory(ctx context.Context, request *persistence.DeleteWorkflowExecutionHistoryRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.DeleteWorkflowExecutionHistoryRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteWorkflowExecutionHistoryRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -20,6 +20,7 @@
 
 package mocks
 
+import "context"
 import mock "github.com/stretchr/testify/mock"
 import persistence "github.com/uber/cadence/common/persistence"
 
@@ -47,13 +48,13 @@ func (_m *ShardManager) Close() {
 	_m.Called()
 }
 
-// CreateShard provides a mock function with given fields: request
-func (_m *ShardManager) CreateShard(request *persistence.CreateShardRequest) error {
-	ret := _m.Called(request)
+// CreateShard provides a mock function with given fields: ctx, request
+func (_m *ShardManager) CreateShard(ctx context.Context, request *persistence.CreateShardRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CreateShardRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CreateShardRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -61,13 +62,13 @@ func (_m *ShardManager) CreateShard(request *persistence.CreateShardRequest) err
 	return r0
 }
 
-// GetShard provides a mock function with given fields: request
-func (_m *ShardManager) GetShard(request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
-	ret := _m.Called(request)
+// GetShard provides a mock function with given fields: ctx, request
+func (_m *ShardManager) GetShard(ctx context.Context, request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetShardResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetShardRequest) *persistence.GetShardResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetShardRequest) *persistence.GetShardResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetShardResponse)
@@ -75,8 +76,8 @@ func (_m *ShardManager) GetShard(request *persistence.GetShardRequest) (*persist
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetShardRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetShardRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -84,13 +85,13 @@ func (_m *ShardManager) GetShard(request *persistence.GetShardRequest) (*persist
 	return r0, r1
 }
 
-// UpdateShard provides a mock function with given fields: request
-func (_m *ShardManager) UpdateShard(request *persistence.UpdateShardRequest) error {
-	ret := _m.Called(request)
+// UpdateShard provides a mock function with given fields: ctx, request
+func (_m *ShardManager) UpdateShard(ctx context.Context, request *persistence.UpdateShardRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.UpdateShardRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.UpdateShardRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
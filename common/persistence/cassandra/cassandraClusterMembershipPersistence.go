@@ -0,0 +1,125 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// all host records live in a single partition, the membership table only holds
+	// one row per live host so it never grows large
+	constMembershipPartition = 0
+
+	// heartbeats and liveness are both measured with the coordinator clock, so that
+	// clock skew between cadence hosts does not drop live hosts from the ring
+	templateUpsertClusterMembership = `INSERT INTO cluster_membership (` +
+		`membership_partition, host_id, rpc_address, role, session_start, last_heartbeat) ` +
+		`VALUES (?, ?, ?, ?, ?, toTimestamp(now())) USING TTL ?`
+
+	templateGetClusterMembers = `SELECT host_id, rpc_address, role, session_start, last_heartbeat, TTL(rpc_address), toTimestamp(now()) ` +
+		`FROM cluster_membership ` +
+		`WHERE membership_partition = ?`
+
+	templateDeleteClusterMembership = `DELETE FROM cluster_membership ` +
+		`WHERE membership_partition = ? ` +
+		`AND host_id = ?`
+)
+
+type (
+	cassandraClusterMembershipPersistence struct {
+		cassandraStore
+	}
+)
+
+// newClusterMembershipPersistence is used to create an instance of ClusterMembershipManager implementation
+func newClusterMembershipPersistence(cfg config.Cassandra, logger log.Logger) (p.ClusterMembershipStore, error) {
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, 0, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &cassandraClusterMembershipPersistence{cassandraStore: store}, nil
+}
+
+// Close releases the resources held by this object
+func (m *cassandraClusterMembershipPersistence) Close() {
+	if m.session != nil {
+		m.session.Close()
+	}
+}
+
+func (m *cassandraClusterMembershipPersistence) UpsertClusterMembership(ctx context.Context, request *p.UpsertClusterMembershipRequest) error {
+	query := m.session.Query(templateUpsertClusterMembership,
+		constMembershipPartition,
+		request.HostID,
+		request.RPCAddress,
+		request.Role,
+		request.SessionStart,
+		int64(request.RecordExpiry.Seconds())).WithContext(ctx)
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("UpsertClusterMembership", err)
+	}
+	return nil
+}
+
+func (m *cassandraClusterMembershipPersistence) GetClusterMembers(ctx context.Context, request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
+	query := m.readQuery(templateGetClusterMembers, constMembershipPartition).WithContext(ctx)
+	iter := query.Iter()
+
+	var members []*p.ClusterMember
+	var member p.ClusterMember
+	var ttlSeconds int64
+	var now time.Time
+	for iter.Scan(&member.HostID, &member.RPCAddress, &member.Role, &member.SessionStart, &member.LastHeartbeat, &ttlSeconds, &now) {
+		member.RecordExpiry = now.Add(time.Duration(ttlSeconds) * time.Second)
+		if (request.Role == "" || member.Role == request.Role) &&
+			(request.LastHeartbeatWithin <= 0 || !member.LastHeartbeat.Before(now.Add(-request.LastHeartbeatWithin))) {
+			result := member
+			members = append(members, &result)
+		}
+		member = p.ClusterMember{}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("GetClusterMembers", err)
+	}
+	return &p.GetClusterMembersResponse{ActiveMembers: members}, nil
+}
+
+// PruneClusterMembership is a no-op since host records are expired by cassandra TTLs
+func (m *cassandraClusterMembershipPersistence) PruneClusterMembership(ctx context.Context, request *p.PruneClusterMembershipRequest) error {
+	return nil
+}
+
+func (m *cassandraClusterMembershipPersistence) DeleteClusterMembership(ctx context.Context, request *p.DeleteClusterMembershipRequest) error {
+	query := m.session.Query(templateDeleteClusterMembership,
+		constMembershipPartition,
+		request.HostID).WithContext(ctx)
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("DeleteClusterMembership", err)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gocql/gocql"
@@ -68,21 +69,12 @@ func NewHistoryPersistenceFromSession(session *gocql.Session, logger log.Logger)
 // newHistoryPersistence is used to create an instance of HistoryManager implementation
 func newHistoryPersistence(cfg config.Cassandra, logger log.Logger) (p.HistoryStore,
 	error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-	if cfg.MaxConns > 0 {
-		cluster.NumConns = cfg.MaxConns
-	}
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, cfg.MaxConns, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	return &cassandraHistoryPersistence{cassandraStore: cassandraStore{session: session, logger: logger}}, nil
+	return &cassandraHistoryPersistence{cassandraStore: store}, nil
 }
 
 // Close gracefully releases the resources held by this object
@@ -92,7 +84,7 @@ func (h *cassandraHistoryPersistence) Close() {
 	}
 }
 
-func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *p.InternalAppendHistoryEventsRequest) error {
+func (h *cassandraHistoryPersistence) AppendHistoryEvents(ctx context.Context, request *p.InternalAppendHistoryEventsRequest) error {
 	var query *gocql.Query
 
 	if request.Overwrite {
@@ -107,7 +99,7 @@ func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *p.InternalApp
 			*request.Execution.RunId,
 			request.FirstEventID,
 			request.RangeID,
-			request.TransactionID)
+			request.TransactionID).WithContext(ctx)
 	} else {
 		query = h.session.Query(templateAppendHistoryEvents,
 			request.DomainID,
@@ -118,7 +110,7 @@ func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *p.InternalApp
 			request.RangeID,
 			request.TransactionID,
 			request.Events.Data,
-			request.Events.Encoding)
+			request.Events.Encoding).WithContext(ctx)
 	}
 
 	previous := make(map[string]interface{})
@@ -147,15 +139,15 @@ func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *p.InternalApp
 	return nil
 }
 
-func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *p.InternalGetWorkflowExecutionHistoryRequest) (
+func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(ctx context.Context, request *p.InternalGetWorkflowExecutionHistoryRequest) (
 	*p.InternalGetWorkflowExecutionHistoryResponse, error) {
 	execution := request.Execution
-	query := h.session.Query(templateGetWorkflowExecutionHistory,
+	query := h.readQuery(templateGetWorkflowExecutionHistory,
 		request.DomainID,
 		*execution.WorkflowId,
 		*execution.RunId,
 		request.FirstEventID,
-		request.NextEventID)
+		request.NextEventID).WithContext(ctx)
 
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
@@ -204,13 +196,13 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *p.Int
 	return response, nil
 }
 
-func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(
+func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(ctx context.Context,
 	request *p.DeleteWorkflowExecutionHistoryRequest) error {
 	execution := request.Execution
 	query := h.session.Query(templateDeleteWorkflowExecutionHistory,
 		request.DomainID,
 		*execution.WorkflowId,
-		*execution.RunId)
+		*execution.RunId).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -70,21 +71,12 @@ func NewHistoryV2PersistenceFromSession(session *gocql.Session, logger log.Logge
 // newHistoryPersistence is used to create an instance of HistoryManager implementation
 func newHistoryV2Persistence(cfg config.Cassandra, logger log.Logger) (p.HistoryV2Store,
 	error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-	if cfg.MaxConns > 0 {
-		cluster.NumConns = cfg.MaxConns
-	}
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, cfg.MaxConns, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	return &cassandraHistoryV2Persistence{cassandraStore: cassandraStore{session: session, logger: logger}}, nil
+	return &cassandraHistoryV2Persistence{cassandraStore: store}, nil
 }
 
 func convertCommonErrors(operation string, err error) error {
@@ -106,7 +98,7 @@ func convertCommonErrors(operation string, err error) error {
 
 // AppendHistoryNodes upsert a batch of events as a single node to a history branch
 // Note that it's not allowed to append above the branch's ancestors' nodes, which means nodeID >= ForkNodeID
-func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(ctx context.Context, request *p.InternalAppendHistoryNodesRequest) error {
 	branchInfo := request.BranchInfo
 	beginNodeID := p.GetBeginNodeID(branchInfo)
 
@@ -127,7 +119,7 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(request *p.InternalAp
 		}
 
 		cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
-		batch := h.session.NewBatch(gocql.LoggedBatch)
+		batch := h.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 		batch.Query(v2templateInsertTree,
 			branchInfo.TreeID, branchInfo.BranchID, ancs, false, cqlNowTimestamp, request.Info)
 		batch.Query(v2templateUpsertData,
@@ -135,7 +127,7 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(request *p.InternalAp
 		err = h.session.ExecuteBatch(batch)
 	} else {
 		query := h.session.Query(v2templateUpsertData,
-			branchInfo.TreeID, branchInfo.BranchID, request.NodeID, request.TransactionID, request.Events.Data, request.Events.Encoding)
+			branchInfo.TreeID, branchInfo.BranchID, request.NodeID, request.TransactionID, request.Events.Data, request.Events.Encoding).WithContext(ctx)
 		err = query.Exec()
 	}
 
@@ -147,12 +139,12 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(request *p.InternalAp
 
 // ReadHistoryBranch returns history node data for a branch
 // NOTE: For branch that has ancestors, we need to query Cassandra multiple times, because it doesn't support OR/UNION operator
-func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(ctx context.Context, request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
 	treeID := request.TreeID
 	branchID := request.BranchID
 
-	query := h.session.Query(v2templateReadData,
-		treeID, branchID, request.MinNodeID, request.MaxNodeID)
+	query := h.readQuery(v2templateReadData,
+		treeID, branchID, request.MinNodeID, request.MaxNodeID).WithContext(ctx)
 
 	iter := query.PageSize(int(request.PageSize)).PageState(request.NextPageToken).Iter()
 	if iter == nil {
@@ -205,46 +197,49 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 // A valid forking nodeID can be an ancestor from the existing branch.
 // For example, we have branch B1 with three nodes(1[1,2], 3[3,4,5] and 6[6,7,8]. 1, 3 and 6 are nodeIDs (first eventID of the batch).
 // So B1 looks like this:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//        /
-//      6[6,7,8]
+//
+//	     1[1,2]
+//	     /
+//	   3[3,4,5]
+//	  /
+//	6[6,7,8]
 //
 // Assuming we have branch B2 which contains one ancestor B1 stopping at 6 (exclusive). So B2 inherit nodeID 1 and 3 from B1, and have its own nodeID 6 and 8.
 // Branch B2 looks like this:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//          \
-//           6[6,7]
-//           \
-//            8[8]
 //
-//Now we want to fork a new branch B3 from B2.
+//	  1[1,2]
+//	  /
+//	3[3,4,5]
+//	 \
+//	  6[6,7]
+//	  \
+//	   8[8]
+//
+// Now we want to fork a new branch B3 from B2.
 // The only valid forking nodeIDs are 3,6 or 8.
 // 1 is not valid because we can't fork from first node.
 // 2/4/5 is NOT valid either because they are inside a batch.
 //
 // Case #1: If we fork from nodeID 6, then B3 will have an ancestor B1 which stops at 6(exclusive).
 // As we append a batch of events[6,7,8,9] to B3, it will look like :
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//          \
-//         6[6,7,8,9]
+//
+//	  1[1,2]
+//	  /
+//	3[3,4,5]
+//	 \
+//	6[6,7,8,9]
 //
 // Case #2: If we fork from node 8, then B3 will have two ancestors: B1 stops at 6(exclusive) and ancestor B2 stops at 8(exclusive)
 // As we append a batch of events[8,9] to B3, it will look like:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//        /
-//      6[6,7]
-//       \
-//       8[8,9]
 //
-func (h *cassandraHistoryV2Persistence) ForkHistoryBranch(request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+//	     1[1,2]
+//	     /
+//	   3[3,4,5]
+//	  /
+//	6[6,7]
+//	 \
+//	 8[8,9]
+func (h *cassandraHistoryV2Persistence) ForkHistoryBranch(ctx context.Context, request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
 	forkB := request.ForkBranchInfo
 	treeID := *forkB.TreeID
 	newAncestors := make([]*workflow.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
@@ -292,7 +287,7 @@ func (h *cassandraHistoryV2Persistence) ForkHistoryBranch(request *p.InternalFor
 
 	// NOTE: To prevent leaking event data caused by forking, we introduce this in_progress flag.
 	query := h.session.Query(v2templateInsertTree,
-		treeID, request.NewBranchID, ancs, true, cqlNowTimestamp, request.Info)
+		treeID, request.NewBranchID, ancs, true, cqlNowTimestamp, request.Info).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -302,7 +297,7 @@ func (h *cassandraHistoryV2Persistence) ForkHistoryBranch(request *p.InternalFor
 }
 
 // UpdateHistoryBranch update a branch
-func (h *cassandraHistoryV2Persistence) CompleteForkBranch(request *p.InternalCompleteForkBranchRequest) error {
+func (h *cassandraHistoryV2Persistence) CompleteForkBranch(ctx context.Context, request *p.InternalCompleteForkBranchRequest) error {
 	branch := request.BranchInfo
 	treeID := *branch.TreeID
 	branchID := *branch.BranchID
@@ -310,13 +305,13 @@ func (h *cassandraHistoryV2Persistence) CompleteForkBranch(request *p.InternalCo
 	var query *gocql.Query
 	if request.Success {
 		query = h.session.Query(v2templateUpdateBranch,
-			false, treeID, branchID)
+			false, treeID, branchID).WithContext(ctx)
 		err := query.Exec()
 		if err != nil {
 			return convertCommonErrors("CompleteForkBranch", err)
 		}
 	} else {
-		batch := h.session.NewBatch(gocql.LoggedBatch)
+		batch := h.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 		batch.Query(v2templateDeleteBranch, treeID, branchID)
 		batch.Query(v2templateRangeDeleteData,
 			treeID, branchID, 1)
@@ -330,7 +325,7 @@ func (h *cassandraHistoryV2Persistence) CompleteForkBranch(request *p.InternalCo
 }
 
 // DeleteHistoryBranch removes a branch
-func (h *cassandraHistoryV2Persistence) DeleteHistoryBranch(request *p.InternalDeleteHistoryBranchRequest) error {
+func (h *cassandraHistoryV2Persistence) DeleteHistoryBranch(ctx context.Context, request *p.InternalDeleteHistoryBranchRequest) error {
 	branch := request.BranchInfo
 	treeID := *branch.TreeID
 	brsToDelete := branch.Ancestors
@@ -340,7 +335,7 @@ func (h *cassandraHistoryV2Persistence) DeleteHistoryBranch(request *p.InternalD
 		BeginNodeID: common.Int64Ptr(beginNodeID),
 	})
 
-	rsp, err := h.GetHistoryTree(&p.GetHistoryTreeRequest{
+	rsp, err := h.GetHistoryTree(ctx, &p.GetHistoryTreeRequest{
 		TreeID: treeID,
 	})
 	if err != nil {
@@ -356,7 +351,7 @@ func (h *cassandraHistoryV2Persistence) DeleteHistoryBranch(request *p.InternalD
 	// If there is no branch forking in progress we see here, it means that we are safe to calculate the deleting ranges based on the current result,
 	// because all the forking branches in the future would fail.
 
-	batch := h.session.NewBatch(gocql.LoggedBatch)
+	batch := h.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	batch.Query(v2templateDeleteBranch, treeID, branch.BranchID)
 
 	// validBRsMaxEndNode is to know each branch range that is being used, we want to know what is the max nodeID referred by other valid branch
@@ -399,10 +394,10 @@ func (h *cassandraHistoryV2Persistence) deleteBranchRangeNodes(batch *gocql.Batc
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (h *cassandraHistoryV2Persistence) GetHistoryTree(request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+func (h *cassandraHistoryV2Persistence) GetHistoryTree(ctx context.Context, request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
 	treeID := request.TreeID
 
-	query := h.session.Query(v2templateReadAllBranches, treeID)
+	query := h.readQuery(v2templateReadAllBranches, treeID).WithContext(ctx)
 
 	pagingToken := []byte{}
 	branches := make([]*workflow.HistoryBranch, 0)
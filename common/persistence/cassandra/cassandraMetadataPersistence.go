@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/uber/cadence/common"
@@ -107,20 +108,13 @@ type (
 // newMetadataPersistence is used to create an instance of HistoryManager implementation
 func newMetadataPersistence(cfg config.Cassandra, clusterName string, logger log.Logger) (p.MetadataStore,
 	error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, 0, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	return &cassandraMetadataPersistence{
-		cassandraStore:     cassandraStore{session: session, logger: logger},
+		cassandraStore:     store,
 		currentClusterName: clusterName,
 	}, nil
 }
@@ -136,8 +130,8 @@ func (m *cassandraMetadataPersistence) Close() {
 // 'Domains' table and then do a conditional insert into domains_by_name table.  If the conditional write fails we
 // delete the orphaned entry from domains table.  There is a chance delete entry could fail and we never delete the
 // orphaned entry from domains table.  We might need a background job to delete those orphaned record.
-func (m *cassandraMetadataPersistence) CreateDomain(request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
-	query := m.session.Query(templateCreateDomainQuery, request.Info.ID, request.Info.Name)
+func (m *cassandraMetadataPersistence) CreateDomain(ctx context.Context, request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
+	query := m.session.Query(templateCreateDomainQuery, request.Info.ID, request.Info.Name).WithContext(ctx)
 	applied, err := query.MapScanCAS(make(map[string]interface{}))
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
@@ -169,7 +163,7 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *p.InternalCreateDom
 		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
-	)
+	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err = query.MapScanCAS(previous)
@@ -182,7 +176,7 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *p.InternalCreateDom
 
 	if !applied {
 		// Domain already exist.  Delete orphan domain record before returning back to user
-		if errDelete := m.session.Query(templateDeleteDomainQuery, request.Info.ID).Exec(); errDelete != nil {
+		if errDelete := m.session.Query(templateDeleteDomainQuery, request.Info.ID).WithContext(ctx).Exec(); errDelete != nil {
 			m.logger.Warn("Unable to delete orphan domain record", tag.Error(errDelete))
 		}
 
@@ -201,7 +195,7 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *p.InternalCreateDom
 	return &p.CreateDomainResponse{ID: request.Info.ID}, nil
 }
 
-func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
+func (m *cassandraMetadataPersistence) GetDomain(ctx context.Context, request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
 	var query *gocql.Query
 	var err error
 	info := &p.DomainInfo{}
@@ -240,7 +234,7 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 
 	domainName := request.Name
 	if len(request.ID) > 0 {
-		query = m.session.Query(templateGetDomainQuery, request.ID)
+		query = m.readQuery(templateGetDomainQuery, request.ID).WithContext(ctx)
 		err = query.Scan(&domainName)
 		if err != nil {
 			return nil, handleError(request.Name, request.ID, err)
@@ -249,7 +243,7 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
-	query = m.session.Query(templateGetDomainByNameQuery, domainName)
+	query = m.readQuery(templateGetDomainByNameQuery, domainName).WithContext(ctx)
 	err = query.Scan(
 		&info.ID,
 		&info.Name,
@@ -295,7 +289,7 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 	}, nil
 }
 
-func (m *cassandraMetadataPersistence) UpdateDomain(request *p.InternalUpdateDomainRequest) error {
+func (m *cassandraMetadataPersistence) UpdateDomain(ctx context.Context, request *p.InternalUpdateDomainRequest) error {
 	var nextVersion int64 = 1
 	var currentVersion *int64
 	if request.NotificationVersion > 0 {
@@ -322,7 +316,7 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *p.InternalUpdateDom
 		nextVersion,
 		request.Info.Name,
 		currentVersion,
-	)
+	).WithContext(ctx)
 
 	applied, err := query.ScanCAS()
 	if !applied {
@@ -339,9 +333,9 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *p.InternalUpdateDom
 	return nil
 }
 
-func (m *cassandraMetadataPersistence) DeleteDomain(request *p.DeleteDomainRequest) error {
+func (m *cassandraMetadataPersistence) DeleteDomain(ctx context.Context, request *p.DeleteDomainRequest) error {
 	var name string
-	query := m.session.Query(templateGetDomainQuery, request.ID)
+	query := m.readQuery(templateGetDomainQuery, request.ID).WithContext(ctx)
 	err := query.Scan(&name)
 	if err != nil {
 		if err == gocql.ErrNotFound {
@@ -350,12 +344,12 @@ func (m *cassandraMetadataPersistence) DeleteDomain(request *p.DeleteDomainReque
 		return err
 	}
 
-	return m.deleteDomain(name, request.ID)
+	return m.deleteDomain(ctx, name, request.ID)
 }
 
-func (m *cassandraMetadataPersistence) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
+func (m *cassandraMetadataPersistence) DeleteDomainByName(ctx context.Context, request *p.DeleteDomainByNameRequest) error {
 	var ID string
-	query := m.session.Query(templateGetDomainByNameQuery, request.Name)
+	query := m.readQuery(templateGetDomainByNameQuery, request.Name).WithContext(ctx)
 	err := query.Scan(&ID, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		if err == gocql.ErrNotFound {
@@ -363,26 +357,26 @@ func (m *cassandraMetadataPersistence) DeleteDomainByName(request *p.DeleteDomai
 		}
 		return err
 	}
-	return m.deleteDomain(request.Name, ID)
+	return m.deleteDomain(ctx, request.Name, ID)
 }
 
-func (m *cassandraMetadataPersistence) ListDomains(request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
+func (m *cassandraMetadataPersistence) ListDomains(ctx context.Context, request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
 	panic("cassandraMetadataPersistence do not support list domain operation.")
 }
 
-func (m *cassandraMetadataPersistence) GetMetadata() (*p.GetMetadataResponse, error) {
+func (m *cassandraMetadataPersistence) GetMetadata(ctx context.Context) (*p.GetMetadataResponse, error) {
 	panic("cassandraMetadataPersistence do not support get metadata operation.")
 }
 
-func (m *cassandraMetadataPersistence) deleteDomain(name, ID string) error {
-	query := m.session.Query(templateDeleteDomainByNameQuery, name)
+func (m *cassandraMetadataPersistence) deleteDomain(ctx context.Context, name, ID string) error {
+	query := m.session.Query(templateDeleteDomainByNameQuery, name).WithContext(ctx)
 	if err := query.Exec(); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("DeleteDomainByName operation failed. Error %v", err),
 		}
 	}
 
-	query = m.session.Query(templateDeleteDomainQuery, ID)
+	query = m.session.Query(templateDeleteDomainQuery, ID).WithContext(ctx)
 	if err := query.Exec(); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("DeleteDomain operation failed. Error %v", err),
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"errors"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -65,7 +66,7 @@ func (m *metadataManagerProxy) GetName() string {
 	return cassandraPersistenceName
 }
 
-func (m *metadataManagerProxy) migrationDomain(resp *p.InternalGetDomainResponse) {
+func (m *metadataManagerProxy) migrationDomain(ctx context.Context, resp *p.InternalGetDomainResponse) {
 	if !resp.IsGlobalDomain {
 		badBinaries, err := m.serializer.DeserializeBadBinaries(resp.Config.BadBinaries)
 		if err != nil {
@@ -84,7 +85,7 @@ func (m *metadataManagerProxy) migrationDomain(resp *p.InternalGetDomainResponse
 			return
 		}
 
-		_, err = m.metadataMgrV2.CreateDomainInV2Table(&p.InternalCreateDomainRequest{
+		_, err = m.metadataMgrV2.CreateDomainInV2Table(ctx, &p.InternalCreateDomainRequest{
 			Info:              resp.Info,
 			Config:            resp.Config,
 			ReplicationConfig: resp.ReplicationConfig,
@@ -108,10 +109,10 @@ func (m *metadataManagerProxy) migrationDomain(resp *p.InternalGetDomainResponse
 	}
 }
 
-func (m *metadataManagerProxy) GetDomain(request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
+func (m *metadataManagerProxy) GetDomain(ctx context.Context, request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
 	// the reason this function does not call the v2 get domain is domain cache will
 	// use the list domain function to get all domain in the v2 table
-	resp, err := m.metadataMgrV2.GetDomain(request)
+	resp, err := m.metadataMgrV2.GetDomain(ctx, request)
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); !ok {
 			return nil, err
@@ -121,20 +122,20 @@ func (m *metadataManagerProxy) GetDomain(request *p.GetDomainRequest) (*p.Intern
 		return resp, nil
 	}
 
-	resp, err = m.metadataMgr.GetDomain(request)
+	resp, err = m.metadataMgr.GetDomain(ctx, request)
 	if err == nil {
 		resp.TableVersion = p.DomainTableVersionV1
-		m.migrationDomain(resp)
+		m.migrationDomain(ctx, resp)
 	}
 	return resp, err
 }
 
-func (m *metadataManagerProxy) ListDomains(request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
-	return m.metadataMgrV2.ListDomains(request)
+func (m *metadataManagerProxy) ListDomains(ctx context.Context, request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
+	return m.metadataMgrV2.ListDomains(ctx, request)
 }
 
-func (m *metadataManagerProxy) GetMetadata() (*p.GetMetadataResponse, error) {
-	return m.metadataMgrV2.GetMetadata()
+func (m *metadataManagerProxy) GetMetadata(ctx context.Context) (*p.GetMetadataResponse, error) {
+	return m.metadataMgrV2.GetMetadata(ctx)
 }
 
 func (m *metadataManagerProxy) Close() {
@@ -142,39 +143,39 @@ func (m *metadataManagerProxy) Close() {
 	m.metadataMgrV2.Close()
 }
 
-func (m *metadataManagerProxy) CreateDomain(request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
-	return m.metadataMgrV2.CreateDomain(request)
+func (m *metadataManagerProxy) CreateDomain(ctx context.Context, request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
+	return m.metadataMgrV2.CreateDomain(ctx, request)
 }
 
-func (m *metadataManagerProxy) UpdateDomain(request *p.InternalUpdateDomainRequest) error {
+func (m *metadataManagerProxy) UpdateDomain(ctx context.Context, request *p.InternalUpdateDomainRequest) error {
 	switch request.TableVersion {
 	case p.DomainTableVersionV1:
-		return m.metadataMgr.UpdateDomain(request)
+		return m.metadataMgr.UpdateDomain(ctx, request)
 	case p.DomainTableVersionV2:
-		return m.metadataMgrV2.UpdateDomain(request)
+		return m.metadataMgrV2.UpdateDomain(ctx, request)
 	default:
 		return errors.New("domain table version is not set")
 	}
 }
 
-func (m *metadataManagerProxy) DeleteDomain(request *p.DeleteDomainRequest) error {
-	err := m.metadataMgr.DeleteDomain(request)
+func (m *metadataManagerProxy) DeleteDomain(ctx context.Context, request *p.DeleteDomainRequest) error {
+	err := m.metadataMgr.DeleteDomain(ctx, request)
 	if err != nil {
 		m.logger.Warn("Error deleting domain from V1 table", tag.Error(err))
 	}
-	err = m.metadataMgrV2.DeleteDomain(request)
+	err = m.metadataMgrV2.DeleteDomain(ctx, request)
 	if err != nil {
 		m.logger.Warn("Error deleting domain from V2 table", tag.Error(err))
 	}
 	return nil
 }
 
-func (m *metadataManagerProxy) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
-	err := m.metadataMgr.DeleteDomainByName(request)
+func (m *metadataManagerProxy) DeleteDomainByName(ctx context.Context, request *p.DeleteDomainByNameRequest) error {
+	err := m.metadataMgr.DeleteDomainByName(ctx, request)
 	if err != nil {
 		m.logger.Warn("Error deleting domain by name from V1 table", tag.Error(err))
 	}
-	err = m.metadataMgrV2.DeleteDomainByName(request)
+	err = m.metadataMgrV2.DeleteDomainByName(ctx, request)
 	if err != nil {
 		m.logger.Warn("Error deleting domain by name from V2 table", tag.Error(err))
 	}
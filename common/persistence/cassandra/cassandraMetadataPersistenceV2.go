@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/uber/cadence/common"
@@ -49,6 +50,7 @@ const (
 		`config_version, ` +
 		`failover_version, ` +
 		`failover_notification_version, ` +
+		`failover_drain_end_time, ` +
 		`notification_version ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? ` +
@@ -61,6 +63,7 @@ const (
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`failover_notification_version = ? , ` +
+		`failover_drain_end_time = ? , ` +
 		`notification_version = ? ` +
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
@@ -88,6 +91,7 @@ const (
 		`config_version, ` +
 		`failover_version, ` +
 		`failover_notification_version, ` +
+		`failover_drain_end_time, ` +
 		`notification_version ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? `
@@ -102,20 +106,13 @@ type (
 
 // newMetadataPersistenceV2 is used to create an instance of HistoryManager implementation
 func newMetadataPersistenceV2(cfg config.Cassandra, currentClusterName string, logger log.Logger) (p.MetadataStore, error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, 0, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	return &cassandraMetadataPersistenceV2{
-		cassandraStore:     cassandraStore{session: session, logger: logger},
+		cassandraStore:     store,
 		currentClusterName: currentClusterName,
 	}, nil
 }
@@ -132,8 +129,8 @@ func (m *cassandraMetadataPersistenceV2) Close() {
 // 'Domains' table and then do a conditional insert into domains_by_name table.  If the conditional write fails we
 // delete the orphaned entry from domains table.  There is a chance delete entry could fail and we never delete the
 // orphaned entry from domains table.  We might need a background job to delete those orphaned record.
-func (m *cassandraMetadataPersistenceV2) CreateDomain(request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
-	query := m.session.Query(templateCreateDomainQuery, request.Info.ID, request.Info.Name)
+func (m *cassandraMetadataPersistenceV2) CreateDomain(ctx context.Context, request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
+	query := m.session.Query(templateCreateDomainQuery, request.Info.ID, request.Info.Name).WithContext(ctx)
 	applied, err := query.MapScanCAS(make(map[string]interface{}))
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
@@ -146,17 +143,17 @@ func (m *cassandraMetadataPersistenceV2) CreateDomain(request *p.InternalCreateD
 		}
 	}
 
-	return m.CreateDomainInV2Table(request)
+	return m.CreateDomainInV2Table(ctx, request)
 }
 
 // CreateDomainInV2Table is the temporary function used by domain v1 -> v2 migration
-func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
-	metadata, err := m.GetMetadata()
+func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(ctx context.Context, request *p.InternalCreateDomainRequest) (*p.CreateDomainResponse, error) {
+	metadata, err := m.GetMetadata(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch := m.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	batch.Query(templateCreateDomainByNameQueryWithinBatchV2,
 		constDomainPartition,
 		request.Info.Name,
@@ -198,7 +195,7 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 
 	if !applied {
 		// Domain already exist.  Delete orphan domain record before returning back to user
-		if errDelete := m.session.Query(templateDeleteDomainQuery, request.Info.ID).Exec(); errDelete != nil {
+		if errDelete := m.session.Query(templateDeleteDomainQuery, request.Info.ID).WithContext(ctx).Exec(); errDelete != nil {
 			m.logger.Warn("Unable to delete orphan domain record. Error", tag.Error(errDelete))
 		}
 
@@ -217,8 +214,8 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 	return &p.CreateDomainResponse{ID: request.Info.ID}, nil
 }
 
-func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateDomainRequest) error {
-	batch := m.session.NewBatch(gocql.LoggedBatch)
+func (m *cassandraMetadataPersistenceV2) UpdateDomain(ctx context.Context, request *p.InternalUpdateDomainRequest) error {
+	batch := m.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	batch.Query(templateUpdateDomainByNameQueryWithinBatchV2,
 		request.Info.ID,
 		request.Info.Name,
@@ -237,6 +234,7 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateD
 		request.ConfigVersion,
 		request.FailoverVersion,
 		request.FailoverNotificationVersion,
+		request.FailoverDrainEndTime,
 		request.NotificationVersion,
 		constDomainPartition,
 		request.Info.Name,
@@ -265,7 +263,7 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateD
 	return nil
 }
 
-func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
+func (m *cassandraMetadataPersistenceV2) GetDomain(ctx context.Context, request *p.GetDomainRequest) (*p.InternalGetDomainResponse, error) {
 	var query *gocql.Query
 	var err error
 	info := &p.DomainInfo{}
@@ -273,6 +271,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 	replicationConfig := &p.DomainReplicationConfig{}
 	var replicationClusters []map[string]interface{}
 	var failoverNotificationVersion int64
+	var failoverDrainEndTime int64
 	var notificationVersion int64
 	var failoverVersion int64
 	var configVersion int64
@@ -305,7 +304,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 
 	domainName := request.Name
 	if len(request.ID) > 0 {
-		query = m.session.Query(templateGetDomainQuery, request.ID)
+		query = m.readQuery(templateGetDomainQuery, request.ID).WithContext(ctx)
 		err = query.Scan(&domainName)
 		if err != nil {
 			return nil, handleError(request.Name, request.ID, err)
@@ -315,7 +314,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
 
-	query = m.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName)
+	query = m.readQuery(templateGetDomainByNameQueryV2, constDomainPartition, domainName).WithContext(ctx)
 	err = query.Scan(
 		&info.ID,
 		&info.Name,
@@ -335,6 +334,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		&configVersion,
 		&failoverVersion,
 		&failoverNotificationVersion,
+		&failoverDrainEndTime,
 		&notificationVersion,
 	)
 
@@ -358,15 +358,16 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		ConfigVersion:               configVersion,
 		FailoverVersion:             failoverVersion,
 		FailoverNotificationVersion: failoverNotificationVersion,
+		FailoverDrainEndTime:        failoverDrainEndTime,
 		NotificationVersion:         notificationVersion,
 		TableVersion:                p.DomainTableVersionV2,
 	}, nil
 }
 
-func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
+func (m *cassandraMetadataPersistenceV2) ListDomains(ctx context.Context, request *p.ListDomainsRequest) (*p.InternalListDomainsResponse, error) {
 	var query *gocql.Query
 
-	query = m.session.Query(templateListDomainQueryV2, constDomainPartition)
+	query = m.readQuery(templateListDomainQueryV2, constDomainPartition).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		return nil, &workflow.InternalServiceError{
@@ -392,7 +393,7 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 		&domain.Config.ArchivalBucket, &domain.Config.ArchivalStatus, &badBinariesData, &badBinariesDataEncoding,
 		&domain.ReplicationConfig.ActiveClusterName, &replicationClusters,
 		&domain.IsGlobalDomain, &domain.ConfigVersion, &domain.FailoverVersion,
-		&domain.FailoverNotificationVersion, &domain.NotificationVersion,
+		&domain.FailoverNotificationVersion, &domain.FailoverDrainEndTime, &domain.NotificationVersion,
 	) {
 		if name != domainMetadataRecordName {
 			// do not include the metadata record
@@ -427,9 +428,9 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 	return response, nil
 }
 
-func (m *cassandraMetadataPersistenceV2) DeleteDomain(request *p.DeleteDomainRequest) error {
+func (m *cassandraMetadataPersistenceV2) DeleteDomain(ctx context.Context, request *p.DeleteDomainRequest) error {
 	var name string
-	query := m.session.Query(templateGetDomainQuery, request.ID)
+	query := m.readQuery(templateGetDomainQuery, request.ID).WithContext(ctx)
 	err := query.Scan(&name)
 	if err != nil {
 		if err == gocql.ErrNotFound {
@@ -438,12 +439,12 @@ func (m *cassandraMetadataPersistenceV2) DeleteDomain(request *p.DeleteDomainReq
 		return err
 	}
 
-	return m.deleteDomain(name, request.ID)
+	return m.deleteDomain(ctx, name, request.ID)
 }
 
-func (m *cassandraMetadataPersistenceV2) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
+func (m *cassandraMetadataPersistenceV2) DeleteDomainByName(ctx context.Context, request *p.DeleteDomainByNameRequest) error {
 	var ID string
-	query := m.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, request.Name)
+	query := m.readQuery(templateGetDomainByNameQueryV2, constDomainPartition, request.Name).WithContext(ctx)
 	err := query.Scan(&ID, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		if err == gocql.ErrNotFound {
@@ -451,12 +452,12 @@ func (m *cassandraMetadataPersistenceV2) DeleteDomainByName(request *p.DeleteDom
 		}
 		return err
 	}
-	return m.deleteDomain(request.Name, ID)
+	return m.deleteDomain(ctx, request.Name, ID)
 }
 
-func (m *cassandraMetadataPersistenceV2) GetMetadata() (*p.GetMetadataResponse, error) {
+func (m *cassandraMetadataPersistenceV2) GetMetadata(ctx context.Context) (*p.GetMetadataResponse, error) {
 	var notificationVersion int64
-	query := m.session.Query(templateGetMetadataQueryV2, constDomainPartition, domainMetadataRecordName)
+	query := m.readQuery(templateGetMetadataQueryV2, constDomainPartition, domainMetadataRecordName).WithContext(ctx)
 	err := query.Scan(&notificationVersion)
 	if err != nil {
 		if err == gocql.ErrNotFound {
@@ -484,15 +485,15 @@ func (m *cassandraMetadataPersistenceV2) updateMetadataBatch(batch *gocql.Batch,
 	)
 }
 
-func (m *cassandraMetadataPersistenceV2) deleteDomain(name, ID string) error {
-	query := m.session.Query(templateDeleteDomainByNameQueryV2, constDomainPartition, name)
+func (m *cassandraMetadataPersistenceV2) deleteDomain(ctx context.Context, name, ID string) error {
+	query := m.session.Query(templateDeleteDomainByNameQueryV2, constDomainPartition, name).WithContext(ctx)
 	if err := query.Exec(); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("DeleteDomainByName operation failed. Error %v", err),
 		}
 	}
 
-	query = m.session.Query(templateDeleteDomainQuery, ID)
+	query = m.session.Query(templateDeleteDomainQuery, ID).WithContext(ctx)
 	if err := query.Exec(); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("DeleteDomain operation failed. Error %v", err),
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/gocql/gocql"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
@@ -63,6 +65,9 @@ const (
 	rowTypeReplicationDomainID   = "10000000-5000-f000-f000-000000000000"
 	rowTypeReplicationWorkflowID = "20000000-5000-f000-f000-000000000000"
 	rowTypeReplicationRunID      = "30000000-5000-f000-f000-000000000000"
+	// Row constants for Replication Task DLQ Row. Source cluster name will be used as WorkflowID.
+	rowTypeDLQDomainID = "10000000-6000-f000-f000-000000000000"
+	rowTypeDLQRunID    = "30000000-6000-f000-f000-000000000000"
 	// Special TaskId constants
 	rowTypeExecutionTaskID  = int64(-10)
 	rowTypeShardTaskID      = int64(-11)
@@ -82,6 +87,7 @@ const (
 	rowTypeTransferTask
 	rowTypeTimerTask
 	rowTypeReplicationTask
+	rowTypeDLQ
 )
 
 const (
@@ -107,7 +113,8 @@ const (
 		`timer_ack_level: ?, ` +
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
-		`domain_notification_version: ? ` +
+		`domain_notification_version: ?, ` +
+		`cluster_replication_level: ? ` +
 		`}`
 
 	templateWorkflowExecutionType = `{` +
@@ -177,6 +184,12 @@ const (
 		`last_replication_info: ?` +
 		`}`
 
+	templateChecksumType = `{` +
+		`version: ?, ` +
+		`flavor: ?, ` +
+		`value: ? ` +
+		`}`
+
 	templateTransferTaskType = `{` +
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
@@ -386,12 +399,12 @@ workflow_state = ? ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?, state: ?, close_status: ?}, {start_version: ?, last_write_version: ?}, ?, ?) IF NOT EXISTS USING TTL 0 `
 
 	templateCreateWorkflowExecutionQuery = `INSERT INTO executions (` +
-		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id) ` +
-		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?) `
+		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id, checksum) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?, ` + templateChecksumType + `) `
 
 	templateCreateWorkflowExecutionWithReplicationQuery = `INSERT INTO executions (` +
-		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, next_event_id, visibility_ts, task_id) ` +
-		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?) `
+		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, next_event_id, visibility_ts, task_id, checksum) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?, ` + templateChecksumType + `) `
 
 	templateCreateTransferTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, transfer, visibility_ts, task_id) ` +
@@ -416,7 +429,7 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map, checksum ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -450,7 +463,7 @@ workflow_state = ? ` +
 	templateUpdateWorkflowExecutionConditionSuffix = ` IF next_event_id = ?`
 
 	templateUpdateWorkflowExecutionQuery = `UPDATE executions ` +
-		`SET execution = ` + templateWorkflowExecutionType + `, next_event_id = ? ` +
+		`SET execution = ` + templateWorkflowExecutionType + `, next_event_id = ?, checksum = ` + templateChecksumType + ` ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -460,7 +473,7 @@ workflow_state = ? ` +
 		`and task_id = ? `
 
 	templateUpdateWorkflowExecutionWithReplicationQuery = `UPDATE executions ` +
-		`SET execution = ` + templateWorkflowExecutionType + `, replication_state = ` + templateReplicationStateType + `, next_event_id = ? ` +
+		`SET execution = ` + templateWorkflowExecutionType + `, replication_state = ` + templateReplicationStateType + `, next_event_id = ?, checksum = ` + templateChecksumType + ` ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -896,6 +909,8 @@ type (
 	cassandraStore struct {
 		session *gocql.Session
 		logger  log.Logger
+		// readConsistency overrides the session consistency for reads when set
+		readConsistency *gocql.Consistency
 	}
 
 	// Implements ExecutionManager, ShardManager and TaskManager
@@ -910,20 +925,13 @@ var _ p.ExecutionStore = (*cassandraPersistence)(nil)
 
 // newShardPersistence is used to create an instance of ShardManager implementation
 func newShardPersistence(cfg config.Cassandra, clusterName string, logger log.Logger) (p.ShardStore, error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, 0, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	return &cassandraPersistence{
-		cassandraStore:     cassandraStore{session: session, logger: logger},
+		cassandraStore:     store,
 		shardID:            -1,
 		currentClusterName: clusterName,
 	}, nil
@@ -937,17 +945,11 @@ func NewWorkflowExecutionPersistence(shardID int, session *gocql.Session,
 
 // newTaskPersistence is used to create an instance of TaskManager implementation
 func newTaskPersistence(cfg config.Cassandra, logger log.Logger) (p.TaskStore, error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, 0, logger)
 	if err != nil {
 		return nil, err
 	}
-	return &cassandraPersistence{cassandraStore: cassandraStore{session: session, logger: logger}, shardID: -1}, nil
+	return &cassandraPersistence{cassandraStore: store, shardID: -1}, nil
 }
 
 func (d *cassandraStore) GetName() string {
@@ -965,7 +967,7 @@ func (d *cassandraPersistence) GetShardID() int {
 	return d.shardID
 }
 
-func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error {
+func (d *cassandraPersistence) CreateShard(ctx context.Context, request *p.CreateShardRequest) error {
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 	shardInfo := request.ShardInfo
 	query := d.session.Query(templateCreateShardQuery,
@@ -987,7 +989,8 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
 		shardInfo.DomainNotificationVersion,
-		shardInfo.RangeID)
+		shardInfo.ClusterReplicationLevel,
+		shardInfo.RangeID).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -1013,16 +1016,16 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 	return nil
 }
 
-func (d *cassandraPersistence) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+func (d *cassandraPersistence) GetShard(ctx context.Context, request *p.GetShardRequest) (*p.GetShardResponse, error) {
 	shardID := request.ShardID
-	query := d.session.Query(templateGetShardQuery,
+	query := d.readQuery(templateGetShardQuery,
 		shardID,
 		rowTypeShard,
 		rowTypeShardDomainID,
 		rowTypeShardWorkflowID,
 		rowTypeShardRunID,
 		defaultVisibilityTimestamp,
-		rowTypeShardTaskID)
+		rowTypeShardTaskID).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -1046,7 +1049,7 @@ func (d *cassandraPersistence) GetShard(request *p.GetShardRequest) (*p.GetShard
 	return &p.GetShardResponse{ShardInfo: info}, nil
 }
 
-func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error {
+func (d *cassandraPersistence) UpdateShard(ctx context.Context, request *p.UpdateShardRequest) error {
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 	shardInfo := request.ShardInfo
 
@@ -1062,6 +1065,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
 		shardInfo.DomainNotificationVersion,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
 		rowTypeShard,
@@ -1070,7 +1074,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		rowTypeShardRunID,
 		defaultVisibilityTimestamp,
 		rowTypeShardTaskID,
-		request.PreviousRangeID)
+		request.PreviousRangeID).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -1101,10 +1105,10 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 	return nil
 }
 
-func (d *cassandraPersistence) CreateWorkflowExecution(request *p.InternalCreateWorkflowExecutionRequest) (
+func (d *cassandraPersistence) CreateWorkflowExecution(ctx context.Context, request *p.InternalCreateWorkflowExecutionRequest) (
 	*p.CreateWorkflowExecutionResponse, error) {
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 
 	if err := d.CreateWorkflowExecutionWithinBatch(request, batch, cqlNowTimestamp); err != nil {
 		return nil, err
@@ -1407,7 +1411,10 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Int
 			request.SearchAttributes,
 			request.NextEventID,
 			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID)
+			rowTypeExecutionTaskID,
+			request.Checksum.Version,
+			int(request.Checksum.Flavor),
+			request.Checksum.Value)
 	} else {
 		lastReplicationInfo := make(map[string]map[string]interface{})
 		for k, v := range request.ReplicationState.LastReplicationInfo {
@@ -1483,22 +1490,25 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Int
 			lastReplicationInfo,
 			request.NextEventID,
 			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID)
+			rowTypeExecutionTaskID,
+			request.Checksum.Version,
+			int(request.Checksum.Flavor),
+			request.Checksum.Value)
 	}
 	return nil
 }
 
-func (d *cassandraPersistence) GetWorkflowExecution(request *p.GetWorkflowExecutionRequest) (
+func (d *cassandraPersistence) GetWorkflowExecution(ctx context.Context, request *p.GetWorkflowExecutionRequest) (
 	*p.InternalGetWorkflowExecutionResponse, error) {
 	execution := request.Execution
-	query := d.session.Query(templateGetWorkflowExecutionQuery,
+	query := d.readQuery(templateGetWorkflowExecutionQuery,
 		d.shardID,
 		rowTypeExecution,
 		request.DomainID,
 		*execution.WorkflowId,
 		*execution.RunId,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -1588,6 +1598,10 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *p.GetWorkflowExecut
 	}
 	state.BufferedReplicationTasks = bufferedReplicationTasks
 
+	if cs, ok := result["checksum"].(map[string]interface{}); ok {
+		state.Checksum = createChecksum(cs)
+	}
+
 	return &p.InternalGetWorkflowExecutionResponse{State: state}, nil
 }
 
@@ -1601,7 +1615,7 @@ func batchQueryHelper(batch *gocql.Batch, stmt string, useCondition bool, condit
 }
 
 func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionInfo *p.InternalWorkflowExecutionInfo,
-	replicationState *p.ReplicationState, cqlNowTimestamp int64, useCondition bool, condition int64) {
+	replicationState *p.ReplicationState, csum checksum.Checksum, cqlNowTimestamp int64, useCondition bool, condition int64) {
 	if executionInfo.ParentDomainID == "" {
 		executionInfo.ParentDomainID = emptyDomainID
 	}
@@ -1670,6 +1684,9 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.NextEventID,
+			csum.Version,
+			int(csum.Flavor),
+			csum.Value,
 			d.shardID,
 			rowTypeExecution,
 			executionInfo.DomainID,
@@ -1746,6 +1763,9 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			replicationState.LastWriteEventID,
 			lastReplicationInfo,
 			executionInfo.NextEventID,
+			csum.Version,
+			int(csum.Flavor),
+			csum.Value,
 			d.shardID,
 			rowTypeExecution,
 			executionInfo.DomainID,
@@ -1756,8 +1776,8 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 	}
 }
 
-func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdateWorkflowExecutionRequest) error {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+func (d *cassandraPersistence) UpdateWorkflowExecution(ctx context.Context, request *p.InternalUpdateWorkflowExecutionRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 
 	// validate workflow state & close status
@@ -1770,7 +1790,7 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
 
-	d.updateMutableState(batch, executionInfo, replicationState, cqlNowTimestamp, true, request.Condition)
+	d.updateMutableState(batch, executionInfo, replicationState, request.Checksum, cqlNowTimestamp, true, request.Condition)
 
 	d.createTransferTasks(batch, request.TransferTasks, executionInfo.DomainID, executionInfo.WorkflowID,
 		executionInfo.RunID)
@@ -1890,8 +1910,8 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 	return nil
 }
 
-func (d *cassandraPersistence) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+func (d *cassandraPersistence) ResetWorkflowExecution(ctx context.Context, request *p.InternalResetWorkflowExecutionRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 
 	currExecutionInfo := request.CurrExecutionInfo
@@ -1969,7 +1989,7 @@ func (d *cassandraPersistence) ResetWorkflowExecution(request *p.InternalResetWo
 	}
 
 	if request.UpdateCurr {
-		d.updateMutableState(batch, currExecutionInfo, currReplicationState, cqlNowTimestamp, true, request.Condition)
+		d.updateMutableState(batch, currExecutionInfo, currReplicationState, checksum.Checksum{}, cqlNowTimestamp, true, request.Condition)
 		d.createTimerTasks(batch, request.CurrTimerTasks, currExecutionInfo.DomainID, currExecutionInfo.WorkflowID, currExecutionInfo.RunID, cqlNowTimestamp)
 		d.createTransferTasks(batch, request.CurrTransferTasks, currExecutionInfo.DomainID, currExecutionInfo.WorkflowID, currExecutionInfo.RunID)
 	} else {
@@ -1995,7 +2015,7 @@ func (d *cassandraPersistence) ResetWorkflowExecution(request *p.InternalResetWo
 	}
 
 	// we need to insert new mutableState, there is no condition to check. We use update without condition as insert
-	d.updateMutableState(batch, insertExecutionInfo, insertReplicationState, cqlNowTimestamp, false, 0)
+	d.updateMutableState(batch, insertExecutionInfo, insertReplicationState, checksum.Checksum{}, cqlNowTimestamp, false, 0)
 
 	if len(request.InsertActivityInfos) > 0 {
 		d.resetActivityInfos(batch, request.InsertActivityInfos, insertExecutionInfo.DomainID, insertExecutionInfo.WorkflowID,
@@ -2078,11 +2098,17 @@ func (d *cassandraPersistence) ResetWorkflowExecution(request *p.InternalResetWo
 	return nil
 }
 
-func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+func (d *cassandraPersistence) ResetMutableState(ctx context.Context, request *p.InternalResetMutableStateRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
+	startVersion := common.EmptyVersion
+	lastWriteVersion := common.EmptyVersion
+	if replicationState != nil {
+		startVersion = replicationState.StartVersion
+		lastWriteVersion = replicationState.LastWriteVersion
+	}
 
 	batch.Query(templateUpdateCurrentWorkflowExecutionForNewQuery,
 		executionInfo.RunID,
@@ -2090,9 +2116,9 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 		executionInfo.CreateRequestID,
 		executionInfo.State,
 		executionInfo.CloseStatus,
-		replicationState.StartVersion,
-		replicationState.LastWriteVersion,
-		replicationState.LastWriteVersion,
+		startVersion,
+		lastWriteVersion,
+		lastWriteVersion,
 		executionInfo.State,
 		d.shardID,
 		rowTypeExecution,
@@ -2106,7 +2132,7 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 		request.PrevState,
 	)
 
-	d.updateMutableState(batch, executionInfo, replicationState, cqlNowTimestamp, true, request.Condition)
+	d.updateMutableState(batch, executionInfo, replicationState, request.Checksum, cqlNowTimestamp, true, request.Condition)
 
 	if err := d.resetActivityInfos(batch, request.InsertActivityInfos, executionInfo.DomainID, executionInfo.WorkflowID,
 		executionInfo.RunID, true, request.Condition); err != nil {
@@ -2269,7 +2295,7 @@ GetFailureReasonLoop:
 	}
 }
 
-func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflowExecutionRequest) error {
+func (d *cassandraPersistence) DeleteWorkflowExecution(ctx context.Context, request *p.DeleteWorkflowExecutionRequest) error {
 	query := d.session.Query(templateDeleteWorkflowExecutionMutableStateQuery,
 		d.shardID,
 		rowTypeExecution,
@@ -2277,7 +2303,7 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflow
 		request.WorkflowID,
 		request.RunID,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2294,7 +2320,7 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflow
 	return nil
 }
 
-func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(ctx context.Context, request *p.DeleteCurrentWorkflowExecutionRequest) error {
 	query := d.session.Query(templateDeleteWorkflowExecutionCurrentRowQuery,
 		d.shardID,
 		rowTypeExecution,
@@ -2303,7 +2329,7 @@ func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteC
 		permanentRunID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
-		request.RunID)
+		request.RunID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2320,16 +2346,16 @@ func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteC
 	return nil
 }
 
-func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse,
+func (d *cassandraPersistence) GetCurrentExecution(ctx context.Context, request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse,
 	error) {
-	query := d.session.Query(templateGetCurrentExecutionQuery,
+	query := d.readQuery(templateGetCurrentExecutionQuery,
 		d.shardID,
 		rowTypeExecution,
 		request.DomainID,
 		request.WorkflowID,
 		permanentRunID,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -2361,10 +2387,10 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
-func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
+func (d *cassandraPersistence) GetTransferTasks(ctx context.Context, request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
-	query := d.session.Query(templateGetTransferTasksQuery,
+	query := d.readQuery(templateGetTransferTasksQuery,
 		d.shardID,
 		rowTypeTransferTask,
 		rowTypeTransferDomainID,
@@ -2373,7 +2399,7 @@ func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksReque
 		defaultVisibilityTimestamp,
 		request.ReadLevel,
 		request.MaxReadLevel,
-	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+	).WithContext(ctx).PageSize(request.BatchSize).PageState(request.NextPageToken)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -2404,11 +2430,11 @@ func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksReque
 	return response, nil
 }
 
-func (d *cassandraPersistence) GetReplicationTasks(request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse,
+func (d *cassandraPersistence) GetReplicationTasks(ctx context.Context, request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse,
 	error) {
 
 	// Reading replication tasks need to be quorum level consistent, otherwise we could loose task
-	query := d.session.Query(templateGetReplicationTasksQuery,
+	query := d.readQuery(templateGetReplicationTasksQuery,
 		d.shardID,
 		rowTypeReplicationTask,
 		rowTypeReplicationDomainID,
@@ -2417,12 +2443,16 @@ func (d *cassandraPersistence) GetReplicationTasks(request *p.GetReplicationTask
 		defaultVisibilityTimestamp,
 		request.ReadLevel,
 		request.MaxReadLevel,
-	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+	).WithContext(ctx).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	return d.populateGetReplicationTasksResponse(query, "GetReplicationTasks")
+}
 
+func (d *cassandraPersistence) populateGetReplicationTasksResponse(query *gocql.Query, operation string) (*p.GetReplicationTasksResponse, error) {
 	iter := query.Iter()
 	if iter == nil {
 		return nil, &workflow.InternalServiceError{
-			Message: "GetReplicationTasks operation failed.  Not able to create query iterator.",
+			Message: fmt.Sprintf("%v operation failed.  Not able to create query iterator.", operation),
 		}
 	}
 
@@ -2441,14 +2471,14 @@ func (d *cassandraPersistence) GetReplicationTasks(request *p.GetReplicationTask
 
 	if err := iter.Close(); err != nil {
 		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetReplicationTasks operation failed. Error: %v", err),
+			Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
 		}
 	}
 
 	return response, nil
 }
 
-func (d *cassandraPersistence) CompleteTransferTask(request *p.CompleteTransferTaskRequest) error {
+func (d *cassandraPersistence) CompleteTransferTask(ctx context.Context, request *p.CompleteTransferTaskRequest) error {
 	query := d.session.Query(templateCompleteTransferTaskQuery,
 		d.shardID,
 		rowTypeTransferTask,
@@ -2456,7 +2486,7 @@ func (d *cassandraPersistence) CompleteTransferTask(request *p.CompleteTransferT
 		rowTypeTransferWorkflowID,
 		rowTypeTransferRunID,
 		defaultVisibilityTimestamp,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2473,7 +2503,7 @@ func (d *cassandraPersistence) CompleteTransferTask(request *p.CompleteTransferT
 	return nil
 }
 
-func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
+func (d *cassandraPersistence) RangeCompleteTransferTask(ctx context.Context, request *p.RangeCompleteTransferTaskRequest) error {
 	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
 		d.shardID,
 		rowTypeTransferTask,
@@ -2483,7 +2513,7 @@ func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeComplet
 		defaultVisibilityTimestamp,
 		request.ExclusiveBeginTaskID,
 		request.InclusiveEndTaskID,
-	)
+	).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2500,7 +2530,7 @@ func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeComplet
 	return nil
 }
 
-func (d *cassandraPersistence) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
+func (d *cassandraPersistence) CompleteReplicationTask(ctx context.Context, request *p.CompleteReplicationTaskRequest) error {
 	query := d.session.Query(templateCompleteTransferTaskQuery,
 		d.shardID,
 		rowTypeReplicationTask,
@@ -2508,7 +2538,7 @@ func (d *cassandraPersistence) CompleteReplicationTask(request *p.CompleteReplic
 		rowTypeReplicationWorkflowID,
 		rowTypeReplicationRunID,
 		defaultVisibilityTimestamp,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2525,7 +2555,125 @@ func (d *cassandraPersistence) CompleteReplicationTask(request *p.CompleteReplic
 	return nil
 }
 
-func (d *cassandraPersistence) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
+func (d *cassandraPersistence) PutReplicationTaskToDLQ(ctx context.Context, request *p.PutReplicationTaskToDLQRequest) error {
+	task := request.TaskInfo
+	lastReplicationInfo := make(map[string]map[string]interface{})
+	for k, v := range task.LastReplicationInfo {
+		lastReplicationInfo[k] = createReplicationInfoMap(v)
+	}
+
+	// Use source cluster name as the workflow id for replication dlq
+	query := d.session.Query(templateCreateReplicationTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.TaskID,
+		task.TaskType,
+		task.FirstEventID,
+		task.NextEventID,
+		task.Version,
+		lastReplicationInfo,
+		task.ScheduledID,
+		task.EventStoreVersion,
+		task.BranchToken,
+		task.ResetWorkflow,
+		task.NewRunEventStoreVersion,
+		task.NewRunBranchToken,
+		defaultVisibilityTimestamp,
+		task.TaskID,
+	).WithContext(ctx)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetReplicationTasksFromDLQ(ctx context.Context, request *p.GetReplicationTasksFromDLQRequest) (*p.GetReplicationTasksFromDLQResponse,
+	error) {
+
+	query := d.readQuery(templateGetReplicationTasksQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ReadLevel,
+		request.MaxReadLevel,
+	).WithContext(ctx).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	return d.populateGetReplicationTasksResponse(query, "GetReplicationTasksFromDLQ")
+}
+
+func (d *cassandraPersistence) DeleteReplicationTaskFromDLQ(ctx context.Context, request *p.DeleteReplicationTaskFromDLQRequest) error {
+	query := d.session.Query(templateCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.TaskID,
+	).WithContext(ctx)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *p.RangeDeleteReplicationTaskFromDLQRequest) error {
+	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	).WithContext(ctx)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) CompleteTimerTask(ctx context.Context, request *p.CompleteTimerTaskRequest) error {
 	ts := p.UnixNanoToDBTimestamp(request.VisibilityTimestamp.UnixNano())
 	query := d.session.Query(templateCompleteTimerTaskQuery,
 		d.shardID,
@@ -2534,7 +2682,7 @@ func (d *cassandraPersistence) CompleteTimerTask(request *p.CompleteTimerTaskReq
 		rowTypeTimerWorkflowID,
 		rowTypeTimerRunID,
 		ts,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2551,7 +2699,7 @@ func (d *cassandraPersistence) CompleteTimerTask(request *p.CompleteTimerTaskReq
 	return nil
 }
 
-func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
+func (d *cassandraPersistence) RangeCompleteTimerTask(ctx context.Context, request *p.RangeCompleteTimerTaskRequest) error {
 	start := p.UnixNanoToDBTimestamp(request.InclusiveBeginTimestamp.UnixNano())
 	end := p.UnixNanoToDBTimestamp(request.ExclusiveEndTimestamp.UnixNano())
 	query := d.session.Query(templateRangeCompleteTimerTaskQuery,
@@ -2562,7 +2710,7 @@ func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTi
 		rowTypeTimerRunID,
 		start,
 		end,
-	)
+	).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2580,20 +2728,20 @@ func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTi
 }
 
 // From TaskManager interface
-func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
+func (d *cassandraPersistence) LeaseTaskList(ctx context.Context, request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
 	if len(request.TaskList) == 0 {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
 		}
 	}
 	now := time.Now()
-	query := d.session.Query(templateGetTaskList,
+	query := d.readQuery(templateGetTaskList,
 		request.DomainID,
 		request.TaskList,
 		request.TaskType,
 		rowTypeTaskList,
 		taskListTaskID,
-	)
+	).WithContext(ctx)
 	var rangeID, ackLevel int64
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
@@ -2612,7 +2760,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				0,
 				request.TaskListKind,
 				now,
-			)
+			).WithContext(ctx)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
 				Message: fmt.Sprintf("LeaseTaskList operation failed. TaskList: %v, TaskType: %v, Error: %v",
@@ -2650,7 +2798,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			rowTypeTaskList,
 			taskListTaskID,
 			rangeID,
-		)
+		).WithContext(ctx)
 	}
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -2684,7 +2832,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 }
 
 // From TaskManager interface
-func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
+func (d *cassandraPersistence) UpdateTaskList(ctx context.Context, request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
 	tli := request.TaskListInfo
 
 	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
@@ -2702,7 +2850,7 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 			tli.Kind,
 			time.Now(),
 			stickyTaskListTTL,
-		)
+		).WithContext(ctx)
 		err := query.Exec()
 		if err != nil {
 			if isThrottlingError(err) {
@@ -2731,7 +2879,7 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 		rowTypeTaskList,
 		taskListTaskID,
 		tli.RangeID,
-	)
+	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -2761,15 +2909,15 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 	return &p.UpdateTaskListResponse{}, nil
 }
 
-func (d *cassandraPersistence) ListTaskList(request *p.ListTaskListRequest) (*p.ListTaskListResponse, error) {
+func (d *cassandraPersistence) ListTaskList(ctx context.Context, request *p.ListTaskListRequest) (*p.ListTaskListResponse, error) {
 	return nil, &workflow.InternalServiceError{
 		Message: fmt.Sprintf("unsupported operation"),
 	}
 }
 
-func (d *cassandraPersistence) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+func (d *cassandraPersistence) DeleteTaskList(ctx context.Context, request *p.DeleteTaskListRequest) error {
 	query := d.session.Query(templateDeleteTaskListQuery,
-		request.DomainID, request.TaskListName, request.TaskListType, rowTypeTaskList, taskListTaskID, request.RangeID)
+		request.DomainID, request.TaskListName, request.TaskListType, rowTypeTaskList, taskListTaskID, request.RangeID).WithContext(ctx)
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
 	if err != nil {
@@ -2791,8 +2939,8 @@ func (d *cassandraPersistence) DeleteTaskList(request *p.DeleteTaskListRequest)
 }
 
 // From TaskManager interface
-func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+func (d *cassandraPersistence) CreateTasks(ctx context.Context, request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	domainID := request.TaskListInfo.DomainID
 	taskList := request.TaskListInfo.Name
 	taskListType := request.TaskListInfo.TaskType
@@ -2875,7 +3023,7 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 }
 
 // From TaskManager interface
-func (d *cassandraPersistence) GetTasks(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+func (d *cassandraPersistence) GetTasks(ctx context.Context, request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
 	if request.MaxReadLevel == nil {
 		return nil, &workflow.InternalServiceError{
 			Message: "getTasks: both readLevel and maxReadLevel MUST be specified for cassandra persistence",
@@ -2886,14 +3034,14 @@ func (d *cassandraPersistence) GetTasks(request *p.GetTasksRequest) (*p.GetTasks
 	}
 
 	// Reading tasklist tasks need to be quorum level consistent, otherwise we could loose task
-	query := d.session.Query(templateGetTasksQuery,
+	query := d.readQuery(templateGetTasksQuery,
 		request.DomainID,
 		request.TaskList,
 		request.TaskType,
 		rowTypeTask,
 		request.ReadLevel,
 		*request.MaxReadLevel,
-	).PageSize(request.BatchSize)
+	).WithContext(ctx).PageSize(request.BatchSize)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -2929,14 +3077,14 @@ PopulateTasks:
 }
 
 // From TaskManager interface
-func (d *cassandraPersistence) CompleteTask(request *p.CompleteTaskRequest) error {
+func (d *cassandraPersistence) CompleteTask(ctx context.Context, request *p.CompleteTaskRequest) error {
 	tli := request.TaskList
 	query := d.session.Query(templateCompleteTaskQuery,
 		tli.DomainID,
 		tli.Name,
 		tli.TaskType,
 		rowTypeTask,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2956,9 +3104,9 @@ func (d *cassandraPersistence) CompleteTask(request *p.CompleteTaskRequest) erro
 // CompleteTasksLessThan deletes all tasks less than or equal to the given task id. This API ignores the
 // Limit request parameter i.e. either all tasks leq the task_id will be deleted or an error will
 // be returned to the caller
-func (d *cassandraPersistence) CompleteTasksLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+func (d *cassandraPersistence) CompleteTasksLessThan(ctx context.Context, request *p.CompleteTasksLessThanRequest) (int, error) {
 	query := d.session.Query(templateCompleteTasksLessThanQuery,
-		request.DomainID, request.TaskListName, request.TaskType, rowTypeTask, request.TaskID)
+		request.DomainID, request.TaskListName, request.TaskType, rowTypeTask, request.TaskID).WithContext(ctx)
 	err := query.Exec()
 	if err != nil {
 		if isThrottlingError(err) {
@@ -2973,12 +3121,12 @@ func (d *cassandraPersistence) CompleteTasksLessThan(request *p.CompleteTasksLes
 	return p.UnknownNumRowsAffected, nil
 }
 
-func (d *cassandraPersistence) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse,
+func (d *cassandraPersistence) GetTimerIndexTasks(ctx context.Context, request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse,
 	error) {
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
 	minTimestamp := p.UnixNanoToDBTimestamp(request.MinTimestamp.UnixNano())
 	maxTimestamp := p.UnixNanoToDBTimestamp(request.MaxTimestamp.UnixNano())
-	query := d.session.Query(templateGetTimerTasksQuery,
+	query := d.readQuery(templateGetTimerTasksQuery,
 		d.shardID,
 		rowTypeTimerTask,
 		rowTypeTimerDomainID,
@@ -2986,7 +3134,7 @@ func (d *cassandraPersistence) GetTimerIndexTasks(request *p.GetTimerIndexTasksR
 		rowTypeTimerRunID,
 		minTimestamp,
 		maxTimestamp,
-	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+	).WithContext(ctx).PageSize(request.BatchSize).PageState(request.NextPageToken)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -3721,6 +3869,8 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			info.ClusterTimerAckLevel = v.(map[string]time.Time)
 		case "domain_notification_version":
 			info.DomainNotificationVersion = v.(int64)
+		case "cluster_replication_level":
+			info.ClusterReplicationLevel = v.(map[string]int64)
 		}
 	}
 
@@ -3734,6 +3884,9 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			currentCluster: info.TimerAckLevel,
 		}
 	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
 
 	return info
 }
@@ -3872,6 +4025,31 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 	return info
 }
 
+func createChecksum(result map[string]interface{}) checksum.Checksum {
+	csum := checksum.Checksum{}
+	if len(result) == 0 {
+		return csum
+	}
+
+	for k, v := range result {
+		switch k {
+		case "version":
+			if version, ok := v.(int); ok {
+				csum.Version = version
+			}
+		case "flavor":
+			if flavor, ok := v.(int); ok {
+				csum.Flavor = checksum.Flavor(flavor)
+			}
+		case "value":
+			if value, ok := v.([]byte); ok {
+				csum.Value = value
+			}
+		}
+	}
+	return csum
+}
+
 func createReplicationState(result map[string]interface{}) *p.ReplicationState {
 	if len(result) == 0 {
 		return nil
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -137,27 +138,18 @@ const (
 type (
 	cassandraVisibilityPersistence struct {
 		cassandraStore
-		lowConslevel gocql.Consistency
 	}
 )
 
 // newVisibilityPersistence is used to create an instance of VisibilityManager implementation
 func newVisibilityPersistence(cfg config.Cassandra, logger log.Logger) (p.VisibilityStore, error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.One, 0, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	return &cassandraVisibilityPersistence{
-		cassandraStore: cassandraStore{session: session, logger: logger},
-		lowConslevel:   gocql.One,
+		cassandraStore: store,
 	}, nil
 }
 
@@ -168,7 +160,7 @@ func (v *cassandraVisibilityPersistence) Close() {
 	}
 }
 
-func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
+func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest) error {
 	ttl := request.WorkflowTimeout + openExecutionTTLBuffer
 	var query *gocql.Query
@@ -184,7 +176,7 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 			request.WorkflowTypeName,
 			request.Memo.Data,
 			string(request.Memo.GetEncoding()),
-		)
+		).WithContext(ctx)
 	} else {
 		query = v.session.Query(templateCreateWorkflowExecutionStartedWithTTL,
 			request.DomainUUID,
@@ -197,7 +189,7 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 			request.Memo.Data,
 			string(request.Memo.GetEncoding()),
 			ttl,
-		)
+		).WithContext(ctx)
 	}
 	query = query.WithTimestamp(p.UnixNanoToDBTimestamp(request.StartTimestamp))
 	err := query.Exec()
@@ -215,9 +207,9 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 	return nil
 }
 
-func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
+func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionClosedRequest) error {
-	batch := v.session.NewBatch(gocql.LoggedBatch)
+	batch := v.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 
 	// First, remove execution from the open table
 	batch.Query(templateDeleteWorkflowExecutionStarted,
@@ -324,13 +316,13 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 	return nil
 }
 
-func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
+func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetOpenWorkflowExecutions,
+	query := v.readQuery(templateGetOpenWorkflowExecutions,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
-		p.UnixNanoToDBTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
+		p.UnixNanoToDBTimestamp(request.LatestStartTime)).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -364,13 +356,13 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutions,
+	query := v.readQuery(templateGetClosedWorkflowExecutions,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
-		p.UnixNanoToDBTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
+		p.UnixNanoToDBTimestamp(request.LatestStartTime)).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -404,14 +396,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetOpenWorkflowExecutionsByType,
+	query := v.readQuery(templateGetOpenWorkflowExecutionsByType,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.WorkflowTypeName).Consistency(v.lowConslevel)
+		request.WorkflowTypeName).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -445,14 +437,14 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsByType,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsByType,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.WorkflowTypeName).Consistency(v.lowConslevel)
+		request.WorkflowTypeName).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -486,14 +478,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetOpenWorkflowExecutionsByID,
+	query := v.readQuery(templateGetOpenWorkflowExecutionsByID,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.WorkflowID).Consistency(v.lowConslevel)
+		request.WorkflowID).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -527,14 +519,14 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsByID,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsByID,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.WorkflowID).Consistency(v.lowConslevel)
+		request.WorkflowID).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -568,14 +560,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowI
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(ctx context.Context,
 	request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsByStatus,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsByStatus,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.Status).Consistency(v.lowConslevel)
+		request.Status).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -609,14 +601,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
+func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(ctx context.Context,
 	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
-	query := v.session.Query(templateGetClosedWorkflowExecution,
+	query := v.readQuery(templateGetClosedWorkflowExecution,
 		request.DomainUUID,
 		domainPartition,
 		execution.GetWorkflowId(),
-		execution.GetRunId())
+		execution.GetRunId()).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -650,19 +642,19 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 }
 
 // DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
-func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(ctx context.Context, request *p.VisibilityDeleteWorkflowExecutionRequest) error {
 	return nil
 }
 
-func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
-func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
-func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(ctx context.Context, request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gocql/gocql"
@@ -66,28 +67,19 @@ const (
 type (
 	cassandraVisibilityPersistenceV2 struct {
 		cassandraStore
-		lowConslevel gocql.Consistency
-		persistence  p.VisibilityStore
+		persistence p.VisibilityStore
 	}
 )
 
 // NewVisibilityPersistenceV2 create a wrapper of cassandra visibilityPersistence, with all list closed executions using v2 table
 func NewVisibilityPersistenceV2(persistence p.VisibilityStore, cfg *config.Cassandra, logger log.Logger) (p.VisibilityStore, error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(*cfg, gocql.One, 0, logger)
 	if err != nil {
 		return nil, err
 	}
 
 	return &cassandraVisibilityPersistenceV2{
-		cassandraStore: cassandraStore{session: session, logger: logger},
-		lowConslevel:   gocql.One,
+		cassandraStore: store,
 		persistence:    persistence,
 	}, nil
 }
@@ -104,43 +96,43 @@ func (v *cassandraVisibilityPersistenceV2) GetName() string {
 	return v.persistence.GetName()
 }
 
-func (v *cassandraVisibilityPersistenceV2) RecordWorkflowExecutionStarted(
+func (v *cassandraVisibilityPersistenceV2) RecordWorkflowExecutionStarted(ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest) error {
-	return v.persistence.RecordWorkflowExecutionStarted(request)
+	return v.persistence.RecordWorkflowExecutionStarted(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) RecordWorkflowExecutionClosed(
+func (v *cassandraVisibilityPersistenceV2) RecordWorkflowExecutionClosed(ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionClosedRequest) error {
-	return v.persistence.RecordWorkflowExecutionClosed(request)
+	return v.persistence.RecordWorkflowExecutionClosed(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutions(
+func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return v.persistence.ListOpenWorkflowExecutions(request)
+	return v.persistence.ListOpenWorkflowExecutions(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutionsByType(
+func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutionsByType(ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return v.persistence.ListOpenWorkflowExecutionsByType(request)
+	return v.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutionsByWorkflowID(
+func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return v.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return v.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) GetClosedWorkflowExecution(
+func (v *cassandraVisibilityPersistenceV2) GetClosedWorkflowExecution(ctx context.Context,
 	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
-	return v.persistence.GetClosedWorkflowExecution(request)
+	return v.persistence.GetClosedWorkflowExecution(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutions(
+func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsV2,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsV2,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
-		p.UnixNanoToDBTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
+		p.UnixNanoToDBTimestamp(request.LatestStartTime)).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -174,14 +166,14 @@ func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutions(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByType(
+func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByType(ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsByTypeV2,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsByTypeV2,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.WorkflowTypeName).Consistency(v.lowConslevel)
+		request.WorkflowTypeName).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -215,14 +207,14 @@ func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByType(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByWorkflowID(
+func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsByIDV2,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsByIDV2,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.WorkflowID).Consistency(v.lowConslevel)
+		request.WorkflowID).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -256,14 +248,14 @@ func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByWorkflo
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByStatus(
+func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByStatus(ctx context.Context,
 	request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
-	query := v.session.Query(templateGetClosedWorkflowExecutionsByStatusV2,
+	query := v.readQuery(templateGetClosedWorkflowExecutionsByStatusV2,
 		request.DomainUUID,
 		domainPartition,
 		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
 		p.UnixNanoToDBTimestamp(request.LatestStartTime),
-		request.Status).Consistency(v.lowConslevel)
+		request.Status).WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -297,19 +289,19 @@ func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
-func (v *cassandraVisibilityPersistenceV2) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return v.persistence.ListWorkflowExecutions(request)
+func (v *cassandraVisibilityPersistenceV2) ListWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.persistence.ListWorkflowExecutions(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return v.persistence.ScanWorkflowExecutions(request)
+func (v *cassandraVisibilityPersistenceV2) ScanWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.persistence.ScanWorkflowExecutions(ctx, request)
 }
 
-func (v *cassandraVisibilityPersistenceV2) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return v.persistence.CountWorkflowExecutions(request)
+func (v *cassandraVisibilityPersistenceV2) CountWorkflowExecutions(ctx context.Context, request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+	return v.persistence.CountWorkflowExecutions(ctx, request)
 }
 
 // DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
-func (v *cassandraVisibilityPersistenceV2) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+func (v *cassandraVisibilityPersistenceV2) DeleteWorkflowExecution(ctx context.Context, request *p.VisibilityDeleteWorkflowExecutionRequest) error {
 	return nil
 }
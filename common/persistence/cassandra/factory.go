@@ -39,8 +39,7 @@ type (
 		execStoreFactory *executionStoreFactory
 	}
 	executionStoreFactory struct {
-		session *gocql.Session
-		logger  log.Logger
+		store cassandraStore
 	}
 )
 
@@ -103,6 +102,11 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return newVisibilityPersistence(f.cfg, f.logger)
 }
 
+// NewClusterMembershipStore returns a cluster membership store
+func (f *Factory) NewClusterMembershipStore() (p.ClusterMembershipStore, error) {
+	return newClusterMembershipPersistence(f.cfg, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
@@ -135,31 +139,18 @@ func (f *Factory) executionStoreFactory() (*executionStoreFactory, error) {
 
 // newExecutionStoreFactory is used to create an instance of ExecutionStoreFactory implementation
 func newExecutionStoreFactory(cfg config.Cassandra, logger log.Logger) (*executionStoreFactory, error) {
-	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
-	if cfg.MaxConns > 0 {
-		cluster.NumConns = cfg.MaxConns
-	}
-	session, err := cluster.CreateSession()
+	store, err := newCassandraStore(cfg, gocql.LocalQuorum, cfg.MaxConns, logger)
 	if err != nil {
 		return nil, err
 	}
-	return &executionStoreFactory{session: session, logger: logger}, nil
+	return &executionStoreFactory{store: store}, nil
 }
 
 func (f *executionStoreFactory) close() {
-	f.session.Close()
+	f.store.session.Close()
 }
 
 // new implements ExecutionStoreFactory interface
 func (f *executionStoreFactory) new(shardID int) (p.ExecutionStore, error) {
-	pmgr, err := NewWorkflowExecutionPersistence(shardID, f.session, f.logger)
-	if err != nil {
-		return nil, err
-	}
-	return pmgr, nil
+	return &cassandraPersistence{cassandraStore: f.store, shardID: shardID}, nil
 }
@@ -0,0 +1,141 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/service/config"
+)
+
+// newCassandraStore creates a cassandraStore for the given datastore config, reads use the configured
+// read consistency and fall back to defaultReadConsistency when none is configured,
+// the legacy consistency key of the datastore config is not used for either reads or writes
+func newCassandraStore(
+	cfg config.Cassandra,
+	defaultReadConsistency gocql.Consistency,
+	maxConns int,
+	logger log.Logger,
+) (cassandraStore, error) {
+	consistency, err := readConsistency(cfg, defaultReadConsistency)
+	if err != nil {
+		return cassandraStore{}, err
+	}
+	session, err := newSession(cfg, maxConns)
+	if err != nil {
+		return cassandraStore{}, err
+	}
+	return cassandraStore{session: session, logger: logger, readConsistency: &consistency}, nil
+}
+
+// newSession creates a session to the cassandra datastore described by cfg, maxConns
+// overrides the number of connections per host when it is greater than zero
+func newSession(cfg config.Cassandra, maxConns int) (*gocql.Session, error) {
+	cluster, err := newClusterConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if maxConns > 0 {
+		cluster.NumConns = maxConns
+	}
+	return cluster.CreateSession()
+}
+
+// newClusterConfig returns the gocql cluster config for the given datastore config, with the
+// configured write and serial consistency, host selection, TLS and retry policy applied
+func newClusterConfig(cfg config.Cassandra) (*gocql.ClusterConfig, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Timeout = defaultSessionTimeout
+
+	consistency, err := parseConsistency(gocql.LocalQuorum, cfg.WriteConsistency)
+	if err != nil {
+		return nil, err
+	}
+	cluster.Consistency = consistency
+	serialConsistency, err := parseSerialConsistency(cfg.SerialConsistency)
+	if err != nil {
+		return nil, err
+	}
+	cluster.SerialConsistency = serialConsistency
+
+	if cfg.Datacenter != "" && cfg.AllowRemoteDatacenter {
+		cluster.HostFilter = nil
+	}
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		cluster.SslOpts = &gocql.SslOptions{
+			CertPath:               cfg.TLS.CertFile,
+			KeyPath:                cfg.TLS.KeyFile,
+			CaPath:                 cfg.TLS.CaFile,
+			EnableHostVerification: cfg.TLS.EnableHostVerification,
+		}
+	}
+	if cfg.Retry != nil {
+		cluster.RetryPolicy = &gocql.ExponentialBackoffRetryPolicy{
+			NumRetries: cfg.Retry.NumRetries,
+			Min:        cfg.Retry.MinBackoff,
+			Max:        cfg.Retry.MaxBackoff,
+		}
+	}
+	return cluster, nil
+}
+
+// readConsistency returns the consistency level for reads from the given datastore,
+// falling back to defaultConsistency when none is configured
+func readConsistency(cfg config.Cassandra, defaultConsistency gocql.Consistency) (gocql.Consistency, error) {
+	return parseConsistency(defaultConsistency, cfg.ReadConsistency)
+}
+
+// parseConsistency parses the consistency level, returns defaultConsistency when value is empty
+func parseConsistency(defaultConsistency gocql.Consistency, value string) (gocql.Consistency, error) {
+	if value == "" {
+		return defaultConsistency, nil
+	}
+	consistency, err := gocql.ParseConsistencyWrapper(value)
+	if err != nil {
+		return defaultConsistency, fmt.Errorf("invalid cassandra consistency %v: %v", value, err)
+	}
+	return consistency, nil
+}
+
+func parseSerialConsistency(value string) (gocql.SerialConsistency, error) {
+	switch strings.ToUpper(value) {
+	case "", "LOCAL_SERIAL":
+		return gocql.LocalSerial, nil
+	case "SERIAL":
+		return gocql.Serial, nil
+	}
+	return gocql.LocalSerial, fmt.Errorf("invalid cassandra serial consistency %v", value)
+}
+
+// readQuery creates a query for a read from this store, using the configured read consistency
+// when the store has one and the session consistency otherwise
+func (d *cassandraStore) readQuery(stmt string, values ...interface{}) *gocql.Query {
+	query := d.session.Query(stmt, values...)
+	if d.readConsistency != nil {
+		query = query.Consistency(*d.readConsistency)
+	}
+	return query
+}
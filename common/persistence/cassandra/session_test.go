@@ -0,0 +1,106 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	sessionSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestSessionSuite(t *testing.T) {
+	suite.Run(t, new(sessionSuite))
+}
+
+func (s *sessionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sessionSuite) TestClusterConfigDefaults() {
+	cluster, err := newClusterConfig(config.Cassandra{Hosts: "127.0.0.1", Keyspace: "cadence"})
+	s.NoError(err)
+	s.Equal(gocql.LocalQuorum, cluster.Consistency)
+	s.Equal(gocql.LocalSerial, cluster.SerialConsistency)
+	s.Nil(cluster.HostFilter)
+	s.Nil(cluster.SslOpts)
+
+	consistency, err := readConsistency(config.Cassandra{}, gocql.One)
+	s.NoError(err)
+	s.Equal(gocql.One, consistency)
+}
+
+func (s *sessionSuite) TestClusterConfigOverrides() {
+	cfg := config.Cassandra{
+		Hosts:             "127.0.0.1",
+		Keyspace:          "cadence",
+		ReadConsistency:   "LOCAL_ONE",
+		WriteConsistency:  "QUORUM",
+		SerialConsistency: "SERIAL",
+		Datacenter:        "dc1",
+		TLS:               &config.CassandraTLS{Enabled: true, CaFile: "ca.pem"},
+		Retry:             &config.CassandraRetryPolicy{NumRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second},
+	}
+	cluster, err := newClusterConfig(cfg)
+	s.NoError(err)
+	s.Equal(gocql.Quorum, cluster.Consistency)
+	s.Equal(gocql.Serial, cluster.SerialConsistency)
+	s.NotNil(cluster.HostFilter)
+	s.Equal("ca.pem", cluster.SslOpts.CaPath)
+	s.Equal(&gocql.ExponentialBackoffRetryPolicy{NumRetries: 3, Min: time.Millisecond, Max: time.Second}, cluster.RetryPolicy)
+
+	consistency, err := readConsistency(cfg, gocql.LocalQuorum)
+	s.NoError(err)
+	s.Equal(gocql.LocalOne, consistency)
+
+	cfg.AllowRemoteDatacenter = true
+	cluster, err = newClusterConfig(cfg)
+	s.NoError(err)
+	s.Nil(cluster.HostFilter)
+}
+
+func (s *sessionSuite) TestClusterConfigIgnoresLegacyConsistency() {
+	cfg := config.Cassandra{Hosts: "127.0.0.1", Keyspace: "cadence", Consistency: "One"}
+	cluster, err := newClusterConfig(cfg)
+	s.NoError(err)
+	s.Equal(gocql.LocalQuorum, cluster.Consistency)
+
+	consistency, err := readConsistency(cfg, gocql.LocalQuorum)
+	s.NoError(err)
+	s.Equal(gocql.LocalQuorum, consistency)
+}
+
+func (s *sessionSuite) TestClusterConfigInvalidConsistency() {
+	_, err := newClusterConfig(config.Cassandra{Hosts: "127.0.0.1", WriteConsistency: "MOST"})
+	s.Error(err)
+	_, err = newClusterConfig(config.Cassandra{Hosts: "127.0.0.1", SerialConsistency: "QUORUM"})
+	s.Error(err)
+}
@@ -33,12 +33,12 @@ import (
 
 // NewESVisibilityManager create a visibility manager for ElasticSearch
 // In history, it only needs kafka producer for writing data;
-// In frontend, it only needs ES client and related config for reading data
-func NewESVisibilityManager(indexName string, esClient es.Client, config *config.VisibilityConfig,
-	producer messaging.Producer, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
+// In frontend, it only needs ES router and related config for reading data
+func NewESVisibilityManager(router es.Router, config *config.VisibilityConfig,
+	producer messaging.Producer, metricsClient metrics.Client, keyProvider p.KeyProvider, log log.Logger) p.VisibilityManager {
 
-	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, indexName, producer, config, log)
-	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log)
+	visibilityFromESStore := NewElasticSearchVisibilityStore(router, producer, config, log)
+	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log, keyProvider)
 
 	if config != nil {
 		// wrap with rate limiter
@@ -21,6 +21,8 @@
 package elasticsearch
 
 import (
+	"context"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -49,193 +51,203 @@ func (p *visibilityMetricsClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *visibilityMetricsClient) RecordWorkflowExecutionStarted(request *p.RecordWorkflowExecutionStartedRequest) error {
+func (p *visibilityMetricsClient) RecordWorkflowExecutionStarted(ctx context.Context, request *p.RecordWorkflowExecutionStartedRequest) error {
 	p.metricClient.IncCounter(metrics.ElasticsearchRecordWorkflowExecutionStartedScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchRecordWorkflowExecutionStartedScope, metrics.ElasticsearchLatency)
-	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	err := p.persistence.RecordWorkflowExecutionStarted(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchRecordWorkflowExecutionStartedScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchRecordWorkflowExecutionStartedScope, err)
 	}
 
 	return err
 }
 
-func (p *visibilityMetricsClient) RecordWorkflowExecutionClosed(request *p.RecordWorkflowExecutionClosedRequest) error {
+func (p *visibilityMetricsClient) RecordWorkflowExecutionClosed(ctx context.Context, request *p.RecordWorkflowExecutionClosedRequest) error {
 	p.metricClient.IncCounter(metrics.ElasticsearchRecordWorkflowExecutionClosedScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchRecordWorkflowExecutionClosedScope, metrics.ElasticsearchLatency)
-	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	err := p.persistence.RecordWorkflowExecutionClosed(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchRecordWorkflowExecutionClosedScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchRecordWorkflowExecutionClosedScope, err)
 	}
 
 	return err
 }
 
-func (p *visibilityMetricsClient) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListOpenWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListOpenWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	response, err := p.persistence.ListOpenWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListOpenWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListOpenWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListClosedWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	response, err := p.persistence.ListClosedWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListClosedWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListClosedWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListOpenWorkflowExecutionsByType(ctx context.Context, request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsByTypeScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListOpenWorkflowExecutionsByTypeScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListOpenWorkflowExecutionsByTypeScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListOpenWorkflowExecutionsByTypeScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByType(ctx context.Context, request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListClosedWorkflowExecutionsByTypeScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByStatusScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByStatusScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListClosedWorkflowExecutionsByStatusScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListClosedWorkflowExecutionsByStatusScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.GetClosedWorkflowExecutionResponse, error) {
+func (p *visibilityMetricsClient) GetClosedWorkflowExecution(ctx context.Context, request *p.GetClosedWorkflowExecutionRequest) (*p.GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchGetClosedWorkflowExecutionScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchGetClosedWorkflowExecutionScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	response, err := p.persistence.GetClosedWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchGetClosedWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchGetClosedWorkflowExecutionScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ListWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchListWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ListWorkflowExecutions(request)
+	response, err := p.persistence.ListWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchListWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchListWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.ListWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) ScanWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchScanWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchScanWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.ScanWorkflowExecutions(request)
+	response, err := p.persistence.ScanWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchScanWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchScanWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+func (p *visibilityMetricsClient) CountWorkflowExecutions(ctx context.Context, request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchCountWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := p.metricClient.StartTimer(metrics.ElasticsearchCountWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := p.persistence.CountWorkflowExecutions(request)
+	response, err := p.persistence.CountWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
-		p.updateErrorMetric(metrics.ElasticsearchCountWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.ElasticsearchCountWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityMetricsClient) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+func (p *visibilityMetricsClient) DeleteWorkflowExecution(ctx context.Context, request *p.VisibilityDeleteWorkflowExecutionRequest) error {
 	return nil // not applicable for elastic search, which relies on retention policies for deletion
 }
 
-func (p *visibilityMetricsClient) updateErrorMetric(scope int, err error) {
+func (p *visibilityMetricsClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	switch ctx.Err() {
+	case context.Canceled:
+		p.metricClient.IncCounter(scope, metrics.ElasticsearchErrContextCanceledCounter)
+		return
+	case context.DeadlineExceeded:
+		p.metricClient.IncCounter(scope, metrics.ElasticsearchErrContextDeadlineExceededCounter)
+		p.metricClient.IncCounter(scope, metrics.ElasticsearchFailures)
+		return
+	}
+
 	switch err.(type) {
 	case *workflow.BadRequestError:
 		p.metricClient.IncCounter(scope, metrics.ElasticsearchErrBadRequestCounter)
@@ -54,8 +54,7 @@ const (
 
 type (
 	esVisibilityStore struct {
-		esClient es.Client
-		index    string
+		router   es.Router
 		producer messaging.Producer
 		logger   log.Logger
 		config   *config.VisibilityConfig
@@ -92,11 +91,11 @@ var (
 	oneMilliSecondInNano = int64(1000)
 )
 
-// NewElasticSearchVisibilityStore create a visibility store connecting to ElasticSearch
-func NewElasticSearchVisibilityStore(esClient es.Client, index string, producer messaging.Producer, config *config.VisibilityConfig, logger log.Logger) p.VisibilityStore {
+// NewElasticSearchVisibilityStore create a visibility store connecting to ElasticSearch,
+// the router decides the cluster and index each domain is read from
+func NewElasticSearchVisibilityStore(router es.Router, producer messaging.Producer, config *config.VisibilityConfig, logger log.Logger) p.VisibilityStore {
 	return &esVisibilityStore{
-		esClient: esClient,
-		index:    index,
+		router:   router,
 		producer: producer,
 		logger:   logger.WithTags(tag.ComponentESVisibilityManager),
 		config:   config,
@@ -109,7 +108,7 @@ func (v *esVisibilityStore) GetName() string {
 	return esPersistenceName
 }
 
-func (v *esVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+func (v *esVisibilityStore) RecordWorkflowExecutionStarted(ctx context.Context, request *p.InternalRecordWorkflowExecutionStartedRequest) error {
 	v.checkProducer()
 	msg := getVisibilityMessageForOpenExecution(
 		request.DomainUUID,
@@ -126,7 +125,7 @@ func (v *esVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRe
 	return v.producer.Publish(msg)
 }
 
-func (v *esVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+func (v *esVisibilityStore) RecordWorkflowExecutionClosed(ctx context.Context, request *p.InternalRecordWorkflowExecutionClosedRequest) error {
 	v.checkProducer()
 	msg := getVisibilityMessageForCloseExecution(
 		request.DomainUUID,
@@ -146,7 +145,7 @@ func (v *esVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRec
 	return v.producer.Publish(msg)
 }
 
-func (v *esVisibilityStore) ListOpenWorkflowExecutions(
+func (v *esVisibilityStore) ListOpenWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	token, err := v.getNextPageToken(request.NextPageToken)
 	if err != nil {
@@ -154,7 +153,7 @@ func (v *esVisibilityStore) ListOpenWorkflowExecutions(
 	}
 
 	isOpen := true
-	searchResult, err := v.getSearchResult(request, token, nil, isOpen)
+	searchResult, err := v.getSearchResult(ctx, request, token, nil, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListOpenWorkflowExecutions failed. Error: %v", err),
@@ -164,7 +163,7 @@ func (v *esVisibilityStore) ListOpenWorkflowExecutions(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.StartTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) ListClosedWorkflowExecutions(
+func (v *esVisibilityStore) ListClosedWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -173,7 +172,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutions(
 	}
 
 	isOpen := false
-	searchResult, err := v.getSearchResult(request, token, nil, isOpen)
+	searchResult, err := v.getSearchResult(ctx, request, token, nil, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListClosedWorkflowExecutions failed. Error: %v", err),
@@ -183,7 +182,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutions(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.CloseTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) ListOpenWorkflowExecutionsByType(
+func (v *esVisibilityStore) ListOpenWorkflowExecutionsByType(ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -193,7 +192,7 @@ func (v *esVisibilityStore) ListOpenWorkflowExecutionsByType(
 
 	isOpen := true
 	matchQuery := elastic.NewMatchQuery(es.WorkflowType, request.WorkflowTypeName)
-	searchResult, err := v.getSearchResult(&request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
+	searchResult, err := v.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListOpenWorkflowExecutionsByType failed. Error: %v", err),
@@ -203,7 +202,7 @@ func (v *esVisibilityStore) ListOpenWorkflowExecutionsByType(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.StartTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) ListClosedWorkflowExecutionsByType(
+func (v *esVisibilityStore) ListClosedWorkflowExecutionsByType(ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -213,7 +212,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByType(
 
 	isOpen := false
 	matchQuery := elastic.NewMatchQuery(es.WorkflowType, request.WorkflowTypeName)
-	searchResult, err := v.getSearchResult(&request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
+	searchResult, err := v.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByType failed. Error: %v", err),
@@ -223,7 +222,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByType(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.CloseTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+func (v *esVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -233,7 +232,7 @@ func (v *esVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 
 	isOpen := true
 	matchQuery := elastic.NewMatchQuery(es.WorkflowID, request.WorkflowID)
-	searchResult, err := v.getSearchResult(&request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
+	searchResult, err := v.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListOpenWorkflowExecutionsByWorkflowID failed. Error: %v", err),
@@ -243,7 +242,7 @@ func (v *esVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.StartTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+func (v *esVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -253,7 +252,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 
 	isOpen := false
 	matchQuery := elastic.NewMatchQuery(es.WorkflowID, request.WorkflowID)
-	searchResult, err := v.getSearchResult(&request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
+	searchResult, err := v.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByWorkflowID failed. Error: %v", err),
@@ -263,7 +262,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.CloseTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) ListClosedWorkflowExecutionsByStatus(
+func (v *esVisibilityStore) ListClosedWorkflowExecutionsByStatus(ctx context.Context,
 	request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -273,7 +272,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 
 	isOpen := false
 	matchQuery := elastic.NewMatchQuery(es.CloseStatus, int32(request.Status))
-	searchResult, err := v.getSearchResult(&request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
+	searchResult, err := v.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, matchQuery, isOpen)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByStatus failed. Error: %v", err),
@@ -283,7 +282,7 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, definition.CloseTime, request.PageSize)
 }
 
-func (v *esVisibilityStore) GetClosedWorkflowExecution(
+func (v *esVisibilityStore) GetClosedWorkflowExecution(ctx context.Context,
 	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 
 	matchDomainQuery := elastic.NewMatchQuery(es.DomainID, request.DomainUUID)
@@ -296,12 +295,17 @@ func (v *esVisibilityStore) GetClosedWorkflowExecution(
 		boolQuery = boolQuery.Must(matchRunIDQuery)
 	}
 
-	ctx := context.Background()
+	route, err := v.router.Route(request.Domain)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetClosedWorkflowExecution failed. Error: %v", err),
+		}
+	}
 	params := &es.SearchParameters{
-		Index: v.index,
+		Index: route.Index,
 		Query: boolQuery,
 	}
-	searchResult, err := v.esClient.Search(ctx, params)
+	searchResult, err := route.Client.Search(ctx, params)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("GetClosedWorkflowExecution failed. Error: %v", err),
@@ -318,7 +322,7 @@ func (v *esVisibilityStore) GetClosedWorkflowExecution(
 	return response, nil
 }
 
-func (v *esVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+func (v *esVisibilityStore) DeleteWorkflowExecution(ctx context.Context, request *p.VisibilityDeleteWorkflowExecutionRequest) error {
 	v.checkProducer()
 	msg := getVisibilityMessageForDeletion(
 		request.DomainID,
@@ -329,7 +333,7 @@ func (v *esVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteW
 	return v.producer.Publish(msg)
 }
 
-func (v *esVisibilityStore) ListWorkflowExecutions(
+func (v *esVisibilityStore) ListWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	checkPageSize(request)
@@ -344,8 +348,13 @@ func (v *esVisibilityStore) ListWorkflowExecutions(
 		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 	}
 
-	ctx := context.Background()
-	searchResult, err := v.esClient.SearchWithDSL(ctx, v.index, queryDSL)
+	route, err := v.router.Route(request.Domain)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListWorkflowExecutions failed. Error: %v", err),
+		}
+	}
+	searchResult, err := route.Client.SearchWithDSL(ctx, route.Index, queryDSL)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ListWorkflowExecutions failed. Error: %v", err),
@@ -355,7 +364,7 @@ func (v *esVisibilityStore) ListWorkflowExecutions(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, sortField, request.PageSize)
 }
 
-func (v *esVisibilityStore) ScanWorkflowExecutions(
+func (v *esVisibilityStore) ScanWorkflowExecutions(ctx context.Context,
 	request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
 
 	checkPageSize(request)
@@ -365,7 +374,13 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 		return nil, err
 	}
 
-	ctx := context.Background()
+	route, err := v.router.Route(request.Domain)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ScanWorkflowExecutions failed. Error: %v", err),
+		}
+	}
+
 	var searchResult *elastic.SearchResult
 	var scrollService es.ScrollService
 	if len(token.ScrollID) == 0 { // first call
@@ -373,15 +388,15 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 		if err != nil {
 			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 		}
-		searchResult, scrollService, err = v.esClient.ScrollFirstPage(ctx, v.index, queryDSL)
+		searchResult, scrollService, err = route.Client.ScrollFirstPage(ctx, route.Index, queryDSL)
 	} else {
-		searchResult, scrollService, err = v.esClient.Scroll(ctx, token.ScrollID)
+		searchResult, scrollService, err = route.Client.Scroll(ctx, token.ScrollID)
 	}
 
 	isLastPage := false
 	if err == io.EOF { // no more result
 		isLastPage = true
-		scrollService.Clear(context.Background())
+		scrollService.Clear(ctx)
 	} else if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ScanWorkflowExecutions failed. Error: %v", err),
@@ -391,7 +406,7 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 	return v.getScanWorkflowExecutionsResponse(searchResult.Hits, token, request.PageSize, searchResult.ScrollId, isLastPage)
 }
 
-func (v *esVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (
+func (v *esVisibilityStore) CountWorkflowExecutions(ctx context.Context, request *p.CountWorkflowExecutionsRequest) (
 	*p.CountWorkflowExecutionsResponse, error) {
 
 	queryDSL, err := getESQueryDSLForCount(request)
@@ -399,8 +414,13 @@ func (v *esVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExec
 		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 	}
 
-	ctx := context.Background()
-	count, err := v.esClient.Count(ctx, v.index, queryDSL)
+	route, err := v.router.Route(request.Domain)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err),
+		}
+	}
+	count, err := route.Client.Count(ctx, route.Index, queryDSL)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err),
@@ -676,7 +696,7 @@ func (v *esVisibilityStore) getNextPageToken(token []byte) (*esVisibilityPageTok
 	return result, nil
 }
 
-func (v *esVisibilityStore) getSearchResult(request *p.ListWorkflowExecutionsRequest, token *esVisibilityPageToken,
+func (v *esVisibilityStore) getSearchResult(ctx context.Context, request *p.ListWorkflowExecutionsRequest, token *esVisibilityPageToken,
 	matchQuery *elastic.MatchQuery, isOpen bool) (*elastic.SearchResult, error) {
 
 	matchDomainQuery := elastic.NewMatchQuery(es.DomainID, request.DomainUUID)
@@ -712,9 +732,12 @@ func (v *esVisibilityStore) getSearchResult(request *p.ListWorkflowExecutionsReq
 		boolQuery = boolQuery.Must(existClosedStatusQuery)
 	}
 
-	ctx := context.Background()
+	route, err := v.router.Route(request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	params := &es.SearchParameters{
-		Index:    v.index,
+		Index:    route.Index,
 		Query:    boolQuery,
 		From:     token.From,
 		PageSize: request.PageSize,
@@ -730,7 +753,7 @@ func (v *esVisibilityStore) getSearchResult(request *p.ListWorkflowExecutionsReq
 		params.SearchAfter = []interface{}{token.SortValue, token.TieBreaker}
 	}
 
-	return v.esClient.Search(ctx, params)
+	return route.Client.Search(ctx, params)
 }
 
 func (v *esVisibilityStore) getScanWorkflowExecutionsResponse(searchHits *elastic.SearchHits,
@@ -911,6 +934,34 @@ func getVisibilityMessageForCloseExecution(domainID string, wid, rid string, wor
 	return msg
 }
 
+// GetVisibilityMessageFromRecord converts a record read from another visibility store to the message
+// indexed into ElasticSearch, records don't carry the task ID so the version of the message is given by the caller
+func GetVisibilityMessageFromRecord(domainID string, record *p.VisibilityWorkflowExecutionInfo, version int64) (*indexer.Message, error) {
+	var memo []byte
+	var encoding common.EncodingType
+	if record.Memo != nil {
+		memo = record.Memo.Data
+		encoding = record.Memo.GetEncoding()
+	}
+
+	searchAttributes := make(map[string][]byte, len(record.SearchAttributes))
+	for k, v := range record.SearchAttributes {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		searchAttributes[k] = data
+	}
+
+	if record.Status == nil {
+		return getVisibilityMessageForOpenExecution(domainID, record.WorkflowID, record.RunID, record.TypeName,
+			record.StartTime.UnixNano(), record.ExecutionTime.UnixNano(), version, memo, encoding, searchAttributes), nil
+	}
+	return getVisibilityMessageForCloseExecution(domainID, record.WorkflowID, record.RunID, record.TypeName,
+		record.StartTime.UnixNano(), record.ExecutionTime.UnixNano(), record.CloseTime.UnixNano(), *record.Status,
+		record.HistoryLength, version, memo, encoding, searchAttributes), nil
+}
+
 func getVisibilityMessageForDeletion(domainID, workflowID, runID string, docVersion int64) *indexer.Message {
 	msgType := indexer.MessageTypeDelete
 	msg := &indexer.Message{
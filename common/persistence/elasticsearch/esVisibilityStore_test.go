@@ -21,6 +21,7 @@
 package elasticsearch
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/olivere/elastic"
 	"github.com/stretchr/testify/mock"
@@ -105,7 +107,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 	}
 
 	s.mockProducer = &mocks.KafkaProducer{}
-	mgr := NewElasticSearchVisibilityStore(s.mockESClient, testIndex, s.mockProducer, config, loggerimpl.NewNopLogger())
+	mgr := NewElasticSearchVisibilityStore(es.NewStaticRouter(s.mockESClient, testIndex), s.mockProducer, config, loggerimpl.NewNopLogger())
 	s.visibilityStore = mgr.(*esVisibilityStore)
 }
 
@@ -139,7 +141,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionStarted() {
 		s.Equal(string(common.EncodingTypeThriftRW), fields[es.Encoding].GetStringData())
 		return true
 	})).Return(nil).Once()
-	err := s.visibilityStore.RecordWorkflowExecutionStarted(request)
+	err := s.visibilityStore.RecordWorkflowExecutionStarted(context.Background(), request)
 	s.NoError(err)
 }
 
@@ -156,7 +158,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionStarted_EmptyRequest() {
 		s.False(ok)
 		return true
 	})).Return(nil).Once()
-	err := s.visibilityStore.RecordWorkflowExecutionStarted(request)
+	err := s.visibilityStore.RecordWorkflowExecutionStarted(context.Background(), request)
 	s.NoError(err)
 }
 
@@ -191,7 +193,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed() {
 		s.Equal(request.HistoryLength, fields[es.HistoryLength].GetIntData())
 		return true
 	})).Return(nil).Once()
-	err := s.visibilityStore.RecordWorkflowExecutionClosed(request)
+	err := s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request)
 	s.NoError(err)
 }
 
@@ -208,7 +210,7 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed_EmptyRequest() {
 		s.False(ok)
 		return true
 	})).Return(nil).Once()
-	err := s.visibilityStore.RecordWorkflowExecutionClosed(request)
+	err := s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request)
 	s.NoError(err)
 }
 
@@ -218,11 +220,11 @@ func (s *ESVisibilitySuite) TestListOpenWorkflowExecutions() {
 		s.True(strings.Contains(fmt.Sprintf("%v", source), filterOpen))
 		return true
 	})).Return(testSearchResult, nil).Once()
-	_, err := s.visibilityStore.ListOpenWorkflowExecutions(testRequest)
+	_, err := s.visibilityStore.ListOpenWorkflowExecutions(context.Background(), testRequest)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListOpenWorkflowExecutions(testRequest)
+	_, err = s.visibilityStore.ListOpenWorkflowExecutions(context.Background(), testRequest)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -235,11 +237,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutions() {
 		s.True(strings.Contains(fmt.Sprintf("%v", source), filterClose))
 		return true
 	})).Return(testSearchResult, nil).Once()
-	_, err := s.visibilityStore.ListClosedWorkflowExecutions(testRequest)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutions(context.Background(), testRequest)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListClosedWorkflowExecutions(testRequest)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutions(context.Background(), testRequest)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -258,11 +260,11 @@ func (s *ESVisibilitySuite) TestListOpenWorkflowExecutionsByType() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowTypeName:              testWorkflowType,
 	}
-	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByType(request)
+	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByType(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByType(request)
+	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByType(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -281,11 +283,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByType() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowTypeName:              testWorkflowType,
 	}
-	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByType(request)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByType(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByType(request)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByType(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -304,11 +306,11 @@ func (s *ESVisibilitySuite) TestListOpenWorkflowExecutionsByWorkflowID() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowID:                    testWorkflowID,
 	}
-	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(request)
+	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(request)
+	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -327,11 +329,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByWorkflowID() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowID:                    testWorkflowID,
 	}
-	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(request)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(request)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -350,11 +352,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByStatus() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		Status:                        workflow.WorkflowExecutionCloseStatus(testCloseStatus),
 	}
-	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByStatus(request)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByStatus(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByStatus(request)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByStatus(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -376,11 +378,11 @@ func (s *ESVisibilitySuite) TestGetClosedWorkflowExecution() {
 			RunId:      common.StringPtr(testRunID),
 		},
 	}
-	_, err := s.visibilityStore.GetClosedWorkflowExecution(request)
+	_, err := s.visibilityStore.GetClosedWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("Search", mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.GetClosedWorkflowExecution(request)
+	_, err = s.visibilityStore.GetClosedWorkflowExecution(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -401,7 +403,7 @@ func (s *ESVisibilitySuite) TestGetClosedWorkflowExecution_NoRunID() {
 			WorkflowId: common.StringPtr(testWorkflowID),
 		},
 	}
-	_, err := s.visibilityStore.GetClosedWorkflowExecution(request)
+	_, err := s.visibilityStore.GetClosedWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
 
@@ -447,7 +449,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 		Sorter:   []elastic.Sorter{elastic.NewFieldSort(es.StartTime).Desc(), tieBreakerSorter},
 	}
 	s.mockESClient.On("Search", mock.Anything, params).Return(nil, nil).Once()
-	s.visibilityStore.getSearchResult(request, token, nil, isOpen)
+	s.visibilityStore.getSearchResult(context.Background(), request, token, nil, isOpen)
 
 	// test request latestTime overflow
 	request.LatestStartTime = math.MaxInt64
@@ -461,7 +463,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 		Sorter:   []elastic.Sorter{elastic.NewFieldSort(es.StartTime).Desc(), tieBreakerSorter},
 	}
 	s.mockESClient.On("Search", mock.Anything, param1).Return(nil, nil).Once()
-	s.visibilityStore.getSearchResult(request, token, nil, isOpen)
+	s.visibilityStore.getSearchResult(context.Background(), request, token, nil, isOpen)
 	request.LatestStartTime = testLatestTime // revert
 
 	// test for closed
@@ -471,14 +473,14 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 	params.Query = boolQuery
 	params.Sorter = []elastic.Sorter{elastic.NewFieldSort(es.CloseTime).Desc(), tieBreakerSorter}
 	s.mockESClient.On("Search", mock.Anything, params).Return(nil, nil).Once()
-	s.visibilityStore.getSearchResult(request, token, nil, isOpen)
+	s.visibilityStore.getSearchResult(context.Background(), request, token, nil, isOpen)
 
 	// test for additional matchQuery
 	matchQuery := elastic.NewMatchQuery(es.CloseStatus, int32(0))
 	boolQuery = elastic.NewBoolQuery().Must(matchDomainQuery).Filter(rangeQuery).Must(matchQuery).Must(existClosedStatusQuery)
 	params.Query = boolQuery
 	s.mockESClient.On("Search", mock.Anything, params).Return(nil, nil).Once()
-	s.visibilityStore.getSearchResult(request, token, matchQuery, isOpen)
+	s.visibilityStore.getSearchResult(context.Background(), request, token, matchQuery, isOpen)
 
 	// test for search after
 	runID := "runID"
@@ -489,7 +491,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 	params.From = 0
 	params.SearchAfter = []interface{}{token.SortValue, token.TieBreaker}
 	s.mockESClient.On("Search", mock.Anything, params).Return(nil, nil).Once()
-	s.visibilityStore.getSearchResult(request, token, matchQuery, isOpen)
+	s.visibilityStore.getSearchResult(context.Background(), request, token, matchQuery, isOpen)
 }
 
 func (s *ESVisibilitySuite) TestGetListWorkflowExecutionsResponse() {
@@ -841,18 +843,18 @@ func (s *ESVisibilitySuite) TestListWorkflowExecutions() {
 		PageSize:   10,
 		Query:      `CloseStatus = 5`,
 	}
-	_, err := s.visibilityStore.ListWorkflowExecutions(request)
+	_, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.On("SearchWithDSL", mock.Anything, mock.Anything, mock.Anything).Return(nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ListWorkflowExecutions(request)
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
 	s.True(strings.Contains(err.Error(), "ListWorkflowExecutions failed"))
 
 	request.Query = `invalid query`
-	_, err = s.visibilityStore.ListWorkflowExecutions(request)
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok = err.(*workflow.BadRequestError)
 	s.True(ok)
@@ -872,12 +874,12 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutions() {
 		PageSize:   10,
 		Query:      `CloseStatus = 5`,
 	}
-	_, err := s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err := s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// test bad request
 	request.Query = `invalid query`
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.BadRequestError)
 	s.True(ok)
@@ -891,20 +893,20 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutions() {
 	tokenBytes, err := s.visibilityStore.serializePageToken(token)
 	s.NoError(err)
 	request.NextPageToken = tokenBytes
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// test last page
 	mockScroll := &esMocks.ScrollService{}
 	s.mockESClient.On("Scroll", mock.Anything, scrollID).Return(testSearchResult, mockScroll, io.EOF).Once()
 	mockScroll.On("Clear", mock.Anything).Return(nil).Once()
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 	mockScroll.AssertExpectations(s.T())
 
 	// test internal error
 	s.mockESClient.On("Scroll", mock.Anything, scrollID).Return(nil, nil, errTestESSearch).Once()
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok = err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -922,14 +924,14 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 		Domain:     testDomain,
 		Query:      `CloseStatus = 5`,
 	}
-	resp, err := s.visibilityStore.CountWorkflowExecutions(request)
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 	s.Equal(int64(1), resp.Count)
 
 	// test internal error
 	s.mockESClient.On("Count", mock.Anything, testIndex, mock.Anything).Return(int64(0), errTestESSearch).Once()
 
-	_, err = s.visibilityStore.CountWorkflowExecutions(request)
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*workflow.InternalServiceError)
 	s.True(ok)
@@ -937,7 +939,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 
 	// test bad request
 	request.Query = `invalid query`
-	_, err = s.visibilityStore.CountWorkflowExecutions(request)
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok = err.(*workflow.BadRequestError)
 	s.True(ok)
@@ -1104,3 +1106,46 @@ func (s *ESVisibilitySuite) TestCleanDSL() {
 	expected = `{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"2b8344db-0ed6-47a4-92fd-bdeb6ead93e3"}}},{"bool":{"must":[{"range":{"Attr.CustomIntField":{"from":"1","to":"5"}}},{"range":{"Attr.CustomDoubleField":{"from":"1.0","to":"2.0"}}},{"range":{"StartTime":{"gt":"0"}}}]}}]}},"from":0,"size":10,"sort":[{"StartTime":"desc"},{"RunID":"desc"}]}`
 	s.Equal(expected, res)
 }
+
+func (s *ESVisibilitySuite) TestGetVisibilityMessageFromRecord() {
+	startTime := time.Unix(0, 1547596872371000000)
+	record := &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:       testWorkflowID,
+		RunID:            testRunID,
+		TypeName:         testWorkflowType,
+		StartTime:        startTime,
+		ExecutionTime:    startTime,
+		Memo:             p.NewDataBlob([]byte("test memo"), common.EncodingTypeThriftRW),
+		SearchAttributes: map[string]interface{}{"CustomIntField": 1},
+	}
+
+	// test for open
+	msg, err := GetVisibilityMessageFromRecord(testDomainID, record, 0)
+	s.NoError(err)
+	s.Equal(indexer.MessageTypeIndex, msg.GetMessageType())
+	s.Equal(testDomainID, msg.GetDomainID())
+	s.Equal(testWorkflowID, msg.GetWorkflowID())
+	s.Equal(testRunID, msg.GetRunID())
+	s.Equal(int64(0), msg.GetVersion())
+	s.Equal(testWorkflowType, msg.Fields[es.WorkflowType].GetStringData())
+	s.Equal(startTime.UnixNano(), msg.Fields[es.StartTime].GetIntData())
+	s.Equal([]byte("test memo"), msg.Fields[es.Memo].GetBinaryData())
+	s.Equal(string(common.EncodingTypeThriftRW), msg.Fields[es.Encoding].GetStringData())
+	s.Equal([]byte("1"), msg.Fields["CustomIntField"].GetBinaryData())
+	_, ok := msg.Fields[es.CloseStatus]
+	s.False(ok)
+
+	// test for close
+	closeStatus := workflow.WorkflowExecutionCloseStatusFailed
+	record.CloseTime = startTime.Add(time.Minute)
+	record.Status = &closeStatus
+	record.HistoryLength = 29
+	record.Memo = nil
+	msg, err = GetVisibilityMessageFromRecord(testDomainID, record, 0)
+	s.NoError(err)
+	s.Equal(record.CloseTime.UnixNano(), msg.Fields[es.CloseTime].GetIntData())
+	s.Equal(int64(closeStatus), msg.Fields[es.CloseStatus].GetIntData())
+	s.Equal(int64(29), msg.Fields[es.HistoryLength].GetIntData())
+	_, ok = msg.Fields[es.Memo]
+	s.False(ok)
+}
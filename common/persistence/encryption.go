@@ -0,0 +1,178 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/uber/cadence/common"
+)
+
+// Layout of an encrypted blob envelope:
+//
+//	| version (1) | keyID length (1) | keyID | inner encoding length (1) | inner encoding | nonce | ciphertext |
+//
+// Everything before the nonce is the envelope metadata, it is authenticated as
+// additional data so that neither the key ID nor the inner encoding can be tampered with.
+const (
+	encryptionEnvelopeVersion byte = 1
+	maxEnvelopeFieldLength         = 255
+)
+
+type (
+	// KeyProvider vends the keys used to encrypt and decrypt blobs at rest.
+	// Rotating keys means adding a new key and making it the active one,
+	// old keys must be kept around as long as data encrypted with them exists
+	KeyProvider interface {
+		// ActiveKey returns the ID of the key and the key used to encrypt new blobs
+		ActiveKey() (string, []byte, error)
+		// GetKey returns the key with the given ID
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	// EncryptionKeyNotFoundError is returned when a blob is encrypted with a key
+	// unknown to the key provider
+	EncryptionKeyNotFoundError struct {
+		keyID string
+	}
+)
+
+var errMalformedEnvelope = errors.New("malformed encryption envelope")
+
+// NewEncryptionKeyNotFoundError returns a new instance of EncryptionKeyNotFoundError
+func NewEncryptionKeyNotFoundError(keyID string) error {
+	return &EncryptionKeyNotFoundError{keyID: keyID}
+}
+
+func (e *EncryptionKeyNotFoundError) Error() string {
+	return fmt.Sprintf("encryption key %v not found", e.keyID)
+}
+
+// EncryptBlob encrypts the blob with the active key of the key provider using AES-GCM,
+// the returned blob has the encrypted encoding and carries the key ID and the original
+// encoding in its envelope
+func EncryptBlob(blob *DataBlob, keyProvider KeyProvider) (*DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	if blob.GetEncoding() == common.EncodingTypeEncrypted {
+		return nil, errors.New("blob is already encrypted")
+	}
+	keyID, key, err := keyProvider.ActiveKey()
+	if err != nil {
+		return nil, err
+	}
+	innerEncoding := string(blob.Encoding)
+	if len(keyID) == 0 || len(keyID) > maxEnvelopeFieldLength || len(innerEncoding) > maxEnvelopeFieldLength {
+		return nil, fmt.Errorf("invalid encryption key ID %q or encoding %q", keyID, innerEncoding)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, 3+len(keyID)+len(innerEncoding))
+	header = append(header, encryptionEnvelopeVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+	header = append(header, byte(len(innerEncoding)))
+	header = append(header, innerEncoding...)
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, len(header)+len(nonce)+len(blob.Data)+aead.Overhead())
+	data = append(data, header...)
+	data = append(data, nonce...)
+	data = aead.Seal(data, nonce, blob.Data, header)
+	return NewDataBlob(data, common.EncodingTypeEncrypted), nil
+}
+
+// DecryptBlob decrypts an encrypted blob and returns the blob with its original encoding
+func DecryptBlob(blob *DataBlob, keyProvider KeyProvider) (*DataBlob, error) {
+	keyID, innerEncoding, headerLen, err := parseEnvelope(blob)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(blob.Data) < headerLen+aead.NonceSize() {
+		return nil, errMalformedEnvelope
+	}
+	header := blob.Data[:headerLen]
+	nonce := blob.Data[headerLen : headerLen+aead.NonceSize()]
+	data, err := aead.Open(nil, nonce, blob.Data[headerLen+aead.NonceSize():], header)
+	if err != nil {
+		return nil, err
+	}
+	return NewDataBlob(data, common.EncodingType(innerEncoding)), nil
+}
+
+// GetEncryptionKeyID returns the ID of the key an encrypted blob was encrypted with
+func GetEncryptionKeyID(blob *DataBlob) (string, error) {
+	keyID, _, _, err := parseEnvelope(blob)
+	return keyID, err
+}
+
+func parseEnvelope(blob *DataBlob) (keyID string, innerEncoding string, headerLen int, err error) {
+	if blob == nil || blob.GetEncoding() != common.EncodingTypeEncrypted {
+		return "", "", 0, errors.New("blob is not encrypted")
+	}
+	data := blob.Data
+	if len(data) < 2 || data[0] != encryptionEnvelopeVersion {
+		return "", "", 0, errMalformedEnvelope
+	}
+	offset := 2
+	keyIDLen := int(data[1])
+	if len(data) < offset+keyIDLen+1 {
+		return "", "", 0, errMalformedEnvelope
+	}
+	keyID = string(data[offset : offset+keyIDLen])
+	offset += keyIDLen
+	encodingLen := int(data[offset])
+	offset++
+	if len(data) < offset+encodingLen {
+		return "", "", 0, errMalformedEnvelope
+	}
+	innerEncoding = string(data[offset : offset+encodingLen])
+	offset += encodingLen
+	return keyID, innerEncoding, offset, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	encryptionSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestEncryptionSuite(t *testing.T) {
+	s := new(encryptionSuite)
+	suite.Run(t, s)
+}
+
+func (s *encryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *encryptionSuite) newKeyProvider(activeKey string, keyIDs ...string) KeyProvider {
+	kr := &keyring{ActiveKey: activeKey, Keys: map[string]string{}}
+	for i, keyID := range keyIDs {
+		key := make([]byte, 32)
+		key[0] = byte(i + 1)
+		kr.Keys[keyID] = base64.StdEncoding.EncodeToString(key)
+	}
+	keyProvider, err := newKeyProviderFromKeyring(kr)
+	s.NoError(err)
+	return keyProvider
+}
+
+func (s *encryptionSuite) TestEncryptDecrypt() {
+	keyProvider := s.newKeyProvider("key-1", "key-1")
+	blob := NewDataBlob([]byte("some history"), common.EncodingTypeThriftRW)
+
+	encrypted, err := EncryptBlob(blob, keyProvider)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeEncrypted, encrypted.GetEncoding())
+	s.NotContains(string(encrypted.Data), "some history")
+
+	keyID, err := GetEncryptionKeyID(encrypted)
+	s.NoError(err)
+	s.Equal("key-1", keyID)
+
+	decrypted, err := DecryptBlob(encrypted, keyProvider)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	_, err = EncryptBlob(encrypted, keyProvider)
+	s.Error(err)
+}
+
+func (s *encryptionSuite) TestKeyRotation() {
+	blob := NewDataBlob([]byte("some history"), common.EncodingTypeThriftRW)
+	encrypted, err := EncryptBlob(blob, s.newKeyProvider("key-1", "key-1"))
+	s.NoError(err)
+
+	rotated := s.newKeyProvider("key-2", "key-1", "key-2")
+	decrypted, err := DecryptBlob(encrypted, rotated)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	reencrypted, err := EncryptBlob(decrypted, rotated)
+	s.NoError(err)
+	keyID, err := GetEncryptionKeyID(reencrypted)
+	s.NoError(err)
+	s.Equal("key-2", keyID)
+
+	_, err = DecryptBlob(encrypted, s.newKeyProvider("key-2", "key-2"))
+	s.IsType(&EncryptionKeyNotFoundError{}, err)
+}
+
+func (s *encryptionSuite) TestTamperedEnvelope() {
+	keyProvider := s.newKeyProvider("key-1", "key-1")
+	encrypted, err := EncryptBlob(NewDataBlob([]byte("some history"), common.EncodingTypeThriftRW), keyProvider)
+	s.NoError(err)
+
+	tampered := NewDataBlob(append([]byte{}, encrypted.Data...), encrypted.Encoding)
+	tampered.Data[len(tampered.Data)-1] ^= 0xff
+	_, err = DecryptBlob(tampered, keyProvider)
+	s.Error(err)
+
+	_, err = DecryptBlob(NewDataBlob(encrypted.Data[:3], encrypted.Encoding), keyProvider)
+	s.Error(err)
+}
+
+func (s *encryptionSuite) TestInvalidKeyring() {
+	_, err := newKeyProviderFromKeyring(&keyring{ActiveKey: "key-1", Keys: map[string]string{"key-1": "not base64"}})
+	s.Error(err)
+
+	_, err = newKeyProviderFromKeyring(&keyring{ActiveKey: "key-1", Keys: map[string]string{
+		"key-1": base64.StdEncoding.EncodeToString([]byte("too short")),
+	}})
+	s.Error(err)
+
+	_, err = newKeyProviderFromKeyring(&keyring{ActiveKey: "key-2", Keys: map[string]string{
+		"key-1": base64.StdEncoding.EncodeToString(make([]byte, 16)),
+	}})
+	s.Error(err)
+}
+
+func (s *encryptionSuite) TestEncryptingSerializer() {
+	keyProvider := s.newKeyProvider("key-1", "key-1")
+	serializer := NewEncryptingPayloadSerializer(nil, keyProvider)
+	event := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(1),
+		EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionStarted),
+	}
+
+	for _, encodingType := range []common.EncodingType{
+		common.EncodingTypeThriftRW,
+		common.EncodingTypeThriftRWSnappy,
+		common.EncodingTypeJSON,
+	} {
+		blob, err := serializer.SerializeBatchEvents([]*workflow.HistoryEvent{event}, encodingType)
+		s.NoError(err)
+		s.Equal(common.EncodingTypeEncrypted, blob.GetEncoding())
+
+		events, err := serializer.DeserializeBatchEvents(blob)
+		s.NoError(err)
+		s.Len(events, 1)
+		s.True(event.Equals(events[0]))
+
+		_, err = NewPayloadSerializer().DeserializeBatchEvents(blob)
+		s.Error(err)
+	}
+
+	// data written before encryption was turned on stays readable
+	plaintext, err := NewPayloadSerializer().SerializeEvent(event, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	event1, err := serializer.DeserializeEvent(plaintext)
+	s.NoError(err)
+	s.True(event.Equals(event1))
+}
@@ -21,9 +21,12 @@
 package persistence
 
 import (
+	"context"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 )
 
 type (
@@ -39,9 +42,10 @@ type (
 var _ ExecutionManager = (*executionManagerImpl)(nil)
 
 // NewExecutionManagerImpl returns new ExecutionManager
-func NewExecutionManagerImpl(persistence ExecutionStore, logger log.Logger) ExecutionManager {
+func NewExecutionManagerImpl(persistence ExecutionStore, logger log.Logger, metricsClient metrics.Client,
+	keyProvider KeyProvider) ExecutionManager {
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    NewEncryptingPayloadSerializer(metricsClient, keyProvider),
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -56,9 +60,9 @@ func (m *executionManagerImpl) GetShardID() int {
 	return m.persistence.GetShardID()
 }
 
-//The below three APIs are related to serialization/deserialization
-func (m *executionManagerImpl) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
-	response, err := m.persistence.GetWorkflowExecution(request)
+// The below three APIs are related to serialization/deserialization
+func (m *executionManagerImpl) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	response, err := m.persistence.GetWorkflowExecution(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +73,7 @@ func (m *executionManagerImpl) GetWorkflowExecution(request *GetWorkflowExecutio
 			SignalInfos:        response.State.SignalInfos,
 			SignalRequestedIDs: response.State.SignalRequestedIDs,
 			ReplicationState:   response.State.ReplicationState,
+			Checksum:           response.State.Checksum,
 		},
 	}
 
@@ -298,7 +303,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(infos map[int64]*Interna
 	return newInfos, nil
 }
 
-func (m *executionManagerImpl) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+func (m *executionManagerImpl) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
 	executionInfo, err := m.SerializeExecutionInfo(request.ExecutionInfo, request.Encoding)
 	if err != nil {
 		return nil, err
@@ -354,9 +359,10 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(request *UpdateWorkflowEx
 		DeleteSignalRequestedID:       request.DeleteSignalRequestedID,
 		ClearBufferedEvents:           request.ClearBufferedEvents,
 		DeleteBufferedReplicationTask: request.DeleteBufferedReplicationTask,
+		Checksum:                      request.Checksum,
 	}
 	msuss := m.statsComputer.computeMutableStateUpdateStats(newRequest)
-	err1 := m.persistence.UpdateWorkflowExecution(newRequest)
+	err1 := m.persistence.UpdateWorkflowExecution(ctx, newRequest)
 	return &UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: msuss}, err1
 }
 
@@ -543,7 +549,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 	}, nil
 }
 
-func (m *executionManagerImpl) ResetMutableState(request *ResetMutableStateRequest) error {
+func (m *executionManagerImpl) ResetMutableState(ctx context.Context, request *ResetMutableStateRequest) error {
 	executionInfo, err := m.SerializeExecutionInfo(request.ExecutionInfo, request.Encoding)
 	if err != nil {
 		return err
@@ -578,11 +584,13 @@ func (m *executionManagerImpl) ResetMutableState(request *ResetMutableStateReque
 		InsertReplicationTasks: request.InsertReplicationTasks,
 		InsertTransferTasks:    request.InsertTransferTasks,
 		InsertTimerTasks:       request.InsertTimerTasks,
+
+		Checksum: request.Checksum,
 	}
-	return m.persistence.ResetMutableState(newRequest)
+	return m.persistence.ResetMutableState(ctx, newRequest)
 }
 
-func (m *executionManagerImpl) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+func (m *executionManagerImpl) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest) error {
 
 	currExecution, err := m.SerializeExecutionInfo(request.CurrExecutionInfo, request.Encoding)
 	if err != nil {
@@ -631,15 +639,15 @@ func (m *executionManagerImpl) ResetWorkflowExecution(request *ResetWorkflowExec
 		InsertSignalInfos:         request.InsertSignalInfos,
 		InsertSignalRequestedIDs:  request.InsertSignalRequestedIDs,
 	}
-	return m.persistence.ResetWorkflowExecution(newRequest)
+	return m.persistence.ResetWorkflowExecution(ctx, newRequest)
 }
 
-func (m *executionManagerImpl) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+func (m *executionManagerImpl) CreateWorkflowExecution(ctx context.Context, request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	intReq, err := m.SerializeCreateWorkflowExecutionRequest(request)
 	if err != nil {
 		return nil, err
 	}
-	return m.persistence.CreateWorkflowExecution(intReq)
+	return m.persistence.CreateWorkflowExecution(ctx, intReq)
 }
 
 func (m *executionManagerImpl) SerializeCreateWorkflowExecutionRequest(request *CreateWorkflowExecutionRequest) (*InternalCreateWorkflowExecutionRequest, error) {
@@ -696,49 +704,66 @@ func (m *executionManagerImpl) SerializeCreateWorkflowExecutionRequest(request *
 		CronSchedule:                request.CronSchedule,
 		ExpirationSeconds:           request.ExpirationSeconds,
 		SearchAttributes:            request.SearchAttributes,
+		Checksum:                    request.Checksum,
 	}, nil
 }
 
-func (m *executionManagerImpl) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
-	return m.persistence.DeleteWorkflowExecution(request)
+func (m *executionManagerImpl) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) error {
+	return m.persistence.DeleteWorkflowExecution(ctx, request)
 }
 
-func (m *executionManagerImpl) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
-	return m.persistence.DeleteCurrentWorkflowExecution(request)
+func (m *executionManagerImpl) DeleteCurrentWorkflowExecution(ctx context.Context, request *DeleteCurrentWorkflowExecutionRequest) error {
+	return m.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 }
 
-func (m *executionManagerImpl) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
-	return m.persistence.GetCurrentExecution(request)
+func (m *executionManagerImpl) GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	return m.persistence.GetCurrentExecution(ctx, request)
 }
 
 // Transfer task related methods
-func (m *executionManagerImpl) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
-	return m.persistence.GetTransferTasks(request)
+func (m *executionManagerImpl) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	return m.persistence.GetTransferTasks(ctx, request)
 }
-func (m *executionManagerImpl) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
-	return m.persistence.CompleteTransferTask(request)
+func (m *executionManagerImpl) CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error {
+	return m.persistence.CompleteTransferTask(ctx, request)
 }
-func (m *executionManagerImpl) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
-	return m.persistence.RangeCompleteTransferTask(request)
+func (m *executionManagerImpl) RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error {
+	return m.persistence.RangeCompleteTransferTask(ctx, request)
 }
 
 // Replication task related methods
-func (m *executionManagerImpl) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
-	return m.persistence.GetReplicationTasks(request)
+func (m *executionManagerImpl) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	return m.persistence.GetReplicationTasks(ctx, request)
+}
+func (m *executionManagerImpl) CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error {
+	return m.persistence.CompleteReplicationTask(ctx, request)
+}
+
+func (m *executionManagerImpl) PutReplicationTaskToDLQ(ctx context.Context, request *PutReplicationTaskToDLQRequest) error {
+	return m.persistence.PutReplicationTaskToDLQ(ctx, request)
 }
-func (m *executionManagerImpl) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
-	return m.persistence.CompleteReplicationTask(request)
+
+func (m *executionManagerImpl) GetReplicationTasksFromDLQ(ctx context.Context, request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	return m.persistence.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (m *executionManagerImpl) DeleteReplicationTaskFromDLQ(ctx context.Context, request *DeleteReplicationTaskFromDLQRequest) error {
+	return m.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (m *executionManagerImpl) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	return m.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
 
 // Timer related methods.
-func (m *executionManagerImpl) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
-	return m.persistence.GetTimerIndexTasks(request)
+func (m *executionManagerImpl) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	return m.persistence.GetTimerIndexTasks(ctx, request)
 }
-func (m *executionManagerImpl) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
-	return m.persistence.CompleteTimerTask(request)
+func (m *executionManagerImpl) CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error {
+	return m.persistence.CompleteTimerTask(ctx, request)
 }
-func (m *executionManagerImpl) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
-	return m.persistence.RangeCompleteTimerTask(request)
+func (m *executionManagerImpl) RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error {
+	return m.persistence.RangeCompleteTimerTask(ctx, request)
 }
 
 func (m *executionManagerImpl) Close() {
@@ -21,12 +21,14 @@
 package persistence
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -50,10 +52,11 @@ type (
 
 var _ HistoryManager = (*historyManagerImpl)(nil)
 
-//NewHistoryManagerImpl returns new HistoryManager
-func NewHistoryManagerImpl(persistence HistoryStore, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
+// NewHistoryManagerImpl returns new HistoryManager
+func NewHistoryManagerImpl(persistence HistoryStore, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn,
+	metricsClient metrics.Client, keyProvider KeyProvider) HistoryManager {
 	return &historyManagerImpl{
-		serializer:           NewPayloadSerializer(),
+		serializer:           NewEncryptingPayloadSerializer(metricsClient, keyProvider),
 		persistence:          persistence,
 		logger:               logger,
 		transactionSizeLimit: transactionSizeLimit,
@@ -64,7 +67,7 @@ func (m *historyManagerImpl) GetName() string {
 	return m.persistence.GetName()
 }
 
-func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+func (m *historyManagerImpl) AppendHistoryEvents(ctx context.Context, request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
 	if len(request.Events) == 0 {
 		return nil, fmt.Errorf("events to be appended cannot be empty")
 	}
@@ -81,7 +84,7 @@ func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsReq
 		}
 	}
 	resp := &AppendHistoryEventsResponse{Size: len(eventsData.Data)}
-	return resp, m.persistence.AppendHistoryEvents(
+	return resp, m.persistence.AppendHistoryEvents(ctx,
 		&InternalAppendHistoryEventsRequest{
 			DomainID:          request.DomainID,
 			Execution:         request.Execution,
@@ -95,10 +98,10 @@ func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsReq
 }
 
 // GetWorkflowExecutionHistoryByBatch retrieves the paginated list of history events for given execution
-func (m *historyManagerImpl) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+func (m *historyManagerImpl) GetWorkflowExecutionHistoryByBatch(ctx context.Context, request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
 	resp := &GetWorkflowExecutionHistoryByBatchResponse{}
 	var err error
-	resp.History, _, resp.NextPageToken, resp.LastFirstEventID, resp.Size, err = m.getWorkflowExecutionHistory(request, true)
+	resp.History, _, resp.NextPageToken, resp.LastFirstEventID, resp.Size, err = m.getWorkflowExecutionHistory(ctx, request, true)
 	if err != nil {
 		return nil, err
 	}
@@ -106,10 +109,10 @@ func (m *historyManagerImpl) GetWorkflowExecutionHistoryByBatch(request *GetWork
 }
 
 // GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
-func (m *historyManagerImpl) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+func (m *historyManagerImpl) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
 	resp := &GetWorkflowExecutionHistoryResponse{}
 	var err error
-	_, resp.History, resp.NextPageToken, resp.LastFirstEventID, resp.Size, err = m.getWorkflowExecutionHistory(request, false)
+	_, resp.History, resp.NextPageToken, resp.LastFirstEventID, resp.Size, err = m.getWorkflowExecutionHistory(ctx, request, false)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +120,7 @@ func (m *historyManagerImpl) GetWorkflowExecutionHistory(request *GetWorkflowExe
 }
 
 // GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
-func (m *historyManagerImpl) getWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest, byBatch bool) ([]*workflow.History, *workflow.History, []byte, int64, int, error) {
+func (m *historyManagerImpl) getWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest, byBatch bool) ([]*workflow.History, *workflow.History, []byte, int64, int, error) {
 	defaultLastEventID := request.FirstEventID - 1
 	token, err := m.deserializeToken(request, defaultLastEventID)
 	if err != nil {
@@ -135,7 +138,7 @@ func (m *historyManagerImpl) getWorkflowExecutionHistory(request *GetWorkflowExe
 		NextEventID:  request.NextEventID,
 		PageSize:     request.PageSize,
 	}
-	response, err := m.persistence.GetWorkflowExecutionHistory(newRequest)
+	response, err := m.persistence.GetWorkflowExecutionHistory(ctx, newRequest)
 	if err != nil {
 		return nil, nil, nil, 0, 0, err
 	}
@@ -234,8 +237,8 @@ func (m *historyManagerImpl) serializeToken(token *historyToken, nextEventID int
 	return data, nil
 }
 
-func (m *historyManagerImpl) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
-	return m.persistence.DeleteWorkflowExecutionHistory(request)
+func (m *historyManagerImpl) DeleteWorkflowExecutionHistory(ctx context.Context, request *DeleteWorkflowExecutionHistoryRequest) error {
+	return m.persistence.DeleteWorkflowExecutionHistory(ctx, request)
 }
 
 func (m *historyManagerImpl) Close() {
@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pborman/uuid"
@@ -29,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -47,10 +49,11 @@ type (
 
 var _ HistoryV2Manager = (*historyV2ManagerImpl)(nil)
 
-//NewHistoryV2ManagerImpl returns new HistoryManager
-func NewHistoryV2ManagerImpl(persistence HistoryV2Store, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryV2Manager {
+// NewHistoryV2ManagerImpl returns new HistoryManager
+func NewHistoryV2ManagerImpl(persistence HistoryV2Store, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn,
+	metricsClient metrics.Client, keyProvider KeyProvider) HistoryV2Manager {
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     NewEncryptingPayloadSerializer(metricsClient, keyProvider),
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
@@ -64,7 +67,7 @@ func (m *historyV2ManagerImpl) GetName() string {
 }
 
 // ForkHistoryBranch forks a new branch from a old branch
-func (m *historyV2ManagerImpl) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+func (m *historyV2ManagerImpl) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	if request.ForkNodeID <= 1 {
 		return nil, &InvalidPersistenceRequestError{
 			Msg: fmt.Sprintf("ForkNodeID must be > 1"),
@@ -90,7 +93,7 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(request *ForkHistoryBranchReque
 		ShardID:        shardID,
 	}
 
-	resp, err := m.persistence.ForkHistoryBranch(req)
+	resp, err := m.persistence.ForkHistoryBranch(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +109,7 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(request *ForkHistoryBranchReque
 }
 
 // DeleteHistoryBranch removes a branch
-func (m *historyV2ManagerImpl) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+func (m *historyV2ManagerImpl) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
 	var branch workflow.HistoryBranch
 	err := m.thriftEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -125,11 +128,11 @@ func (m *historyV2ManagerImpl) DeleteHistoryBranch(request *DeleteHistoryBranchR
 		ShardID:    shardID,
 	}
 
-	return m.persistence.DeleteHistoryBranch(req)
+	return m.persistence.DeleteHistoryBranch(ctx, req)
 }
 
 // CompleteForkBranch complete the forking process
-func (m *historyV2ManagerImpl) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+func (m *historyV2ManagerImpl) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
 	var branch workflow.HistoryBranch
 	err := m.thriftEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -149,11 +152,11 @@ func (m *historyV2ManagerImpl) CompleteForkBranch(request *CompleteForkBranchReq
 		ShardID:    shardID,
 	}
 
-	return m.persistence.CompleteForkBranch(req)
+	return m.persistence.CompleteForkBranch(ctx, req)
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (m *historyV2ManagerImpl) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+func (m *historyV2ManagerImpl) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	if len(request.TreeID) == 0 {
 		var branch workflow.HistoryBranch
 		err := m.thriftEncoder.Decode(request.BranchToken, &branch)
@@ -162,11 +165,11 @@ func (m *historyV2ManagerImpl) GetHistoryTree(request *GetHistoryTreeRequest) (*
 		}
 		request.TreeID = branch.GetTreeID()
 	}
-	return m.persistence.GetHistoryTree(request)
+	return m.persistence.GetHistoryTree(ctx, request)
 }
 
 // AppendHistoryNodes add(or override) a node to a history branch
-func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+func (m *historyV2ManagerImpl) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	var branch workflow.HistoryBranch
 	err := m.thriftEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -226,7 +229,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 		ShardID:       shardID,
 	}
 
-	err = m.persistence.AppendHistoryNodes(req)
+	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
 		Size: size,
@@ -235,10 +238,10 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 
 // ReadHistoryBranchByBatch returns history node data for a branch by batch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
-func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
 	resp := &ReadHistoryBranchByBatchResponse{}
 	var err error
-	_, resp.History, resp.NextPageToken, resp.Size, resp.LastFirstEventID, err = m.readHistoryBranch(true, request)
+	_, resp.History, resp.NextPageToken, resp.Size, resp.LastFirstEventID, err = m.readHistoryBranch(ctx, true, request)
 	if err != nil {
 		return nil, err
 	}
@@ -247,17 +250,17 @@ func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(request *ReadHistoryBran
 
 // ReadHistoryBranch returns history node data for a branch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
-func (m *historyV2ManagerImpl) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+func (m *historyV2ManagerImpl) ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
 	resp := &ReadHistoryBranchResponse{}
 	var err error
-	resp.HistoryEvents, _, resp.NextPageToken, resp.Size, resp.LastFirstEventID, err = m.readHistoryBranch(false, request)
+	resp.HistoryEvents, _, resp.NextPageToken, resp.Size, resp.LastFirstEventID, err = m.readHistoryBranch(ctx, false, request)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHistoryBranchRequest) ([]*workflow.HistoryEvent, []*workflow.History, []byte, int, int64, error) {
+func (m *historyV2ManagerImpl) readHistoryBranch(ctx context.Context, byBatch bool, request *ReadHistoryBranchRequest) ([]*workflow.HistoryEvent, []*workflow.History, []byte, int, int64, error) {
 	var branch workflow.HistoryBranch
 	err := m.thriftEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -336,7 +339,7 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 		ShardID:       shardID,
 	}
 
-	resp, err := m.persistence.ReadHistoryBranch(req)
+	resp, err := m.persistence.ReadHistoryBranch(ctx, req)
 	if err != nil {
 		return nil, nil, nil, 0, 0, err
 	}
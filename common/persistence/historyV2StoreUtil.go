@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -31,7 +32,6 @@ import (
 )
 
 /*
-
 DeleteWorkflowExecutionHistoryV2 is used to delete workflow execution history from historyV2.
 Histories in historyV2 are represented as a tree rather than a linear list of history events.
 The tree based history structure introduces complexities for deletions. The following concepts
@@ -62,10 +62,9 @@ that zombie history segments can remain under some rare failure cases. Consider
 3. History section of parent branch is deleted. But section of parent which is common to child is not deleted.
 4. Our assumption of child branch fork being successful is actually wrong and the child never successfully forked.
 Under this rare case the section of parent history which was assumed to be common to child will be a zombie history section.
-
 */
-func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken []byte, shardID *int, logger log.Logger) error {
-	err := historyV2Mgr.DeleteHistoryBranch(&DeleteHistoryBranchRequest{
+func DeleteWorkflowExecutionHistoryV2(ctx context.Context, historyV2Mgr HistoryV2Manager, branchToken []byte, shardID *int, logger log.Logger) error {
+	err := historyV2Mgr.DeleteHistoryBranch(ctx, &DeleteHistoryBranchRequest{
 		BranchToken: branchToken,
 		ShardID:     shardID,
 	})
@@ -80,7 +79,7 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 
 	// we believe this is very rare case to see: DeleteHistoryBranch returns ConditionFailedError means there are some incomplete branches
 
-	resp, err := historyV2Mgr.GetHistoryTree(&GetHistoryTreeRequest{
+	resp, err := historyV2Mgr.GetHistoryTree(ctx, &GetHistoryTreeRequest{
 		BranchToken: branchToken,
 		ShardID:     shardID,
 	})
@@ -101,7 +100,7 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 				if err != nil {
 					return err
 				}
-				err = historyV2Mgr.CompleteForkBranch(&CompleteForkBranchRequest{
+				err = historyV2Mgr.CompleteForkBranch(ctx, &CompleteForkBranchRequest{
 					// actually we don't know it is success or fail. but use true for safety
 					// the worst case is we may leak some data that will never deleted
 					Success:     true,
@@ -119,7 +118,7 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 			}
 		}
 	}
-	err = historyV2Mgr.DeleteHistoryBranch(&DeleteHistoryBranchRequest{
+	err = historyV2Mgr.DeleteHistoryBranch(ctx, &DeleteHistoryBranchRequest{
 		BranchToken: branchToken,
 		ShardID:     shardID,
 	})
@@ -129,11 +128,11 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 // ReadFullPageV2Events reads a full page of history events from HistoryV2Manager. Due to storage format of V2 History
 // it is not guaranteed that pageSize amount of data is returned. Function returns the list of history events, the size
 // of data read, the next page token, and an error if present. Next page token will be empty when there are no more events to read.
-func ReadFullPageV2Events(historyV2Mgr HistoryV2Manager, req *ReadHistoryBranchRequest) ([]*shared.HistoryEvent, int, []byte, error) {
+func ReadFullPageV2Events(ctx context.Context, historyV2Mgr HistoryV2Manager, req *ReadHistoryBranchRequest) ([]*shared.HistoryEvent, int, []byte, error) {
 	historyEvents := []*shared.HistoryEvent{}
 	size := int(0)
 	for {
-		response, err := historyV2Mgr.ReadHistoryBranch(req)
+		response, err := historyV2Mgr.ReadHistoryBranch(ctx, req)
 		if err != nil {
 			return nil, 0, nil, err
 		}
@@ -0,0 +1,115 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/uber/cadence/common/service/config"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// keyring is the on-disk format of the file key provider:
+	//
+	//	activeKey: key-2
+	//	keys:
+	//	  key-1: <base64 encoded AES key>
+	//	  key-2: <base64 encoded AES key>
+	keyring struct {
+		ActiveKey string            `yaml:"activeKey"`
+		Keys      map[string]string `yaml:"keys"`
+	}
+
+	fileKeyProvider struct {
+		activeKeyID string
+		keys        map[string][]byte
+	}
+)
+
+var _ KeyProvider = (*fileKeyProvider)(nil)
+
+// NewKeyProvider returns the key provider described by the given encryption config,
+// or nil when encryption is not configured
+func NewKeyProvider(cfg *config.Encryption) (KeyProvider, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	switch cfg.KeyProvider {
+	case "", config.KeyProviderFile:
+		return NewFileKeyProvider(cfg.KeyringFile)
+	default:
+		return nil, fmt.Errorf("unknown encryption key provider %v", cfg.KeyProvider)
+	}
+}
+
+// NewFileKeyProvider returns a key provider backed by a local keyring file
+func NewFileKeyProvider(keyringFile string) (KeyProvider, error) {
+	content, err := ioutil.ReadFile(keyringFile)
+	if err != nil {
+		return nil, err
+	}
+	var kr keyring
+	if err := yaml.Unmarshal(content, &kr); err != nil {
+		return nil, err
+	}
+	return newKeyProviderFromKeyring(&kr)
+}
+
+func newKeyProviderFromKeyring(kr *keyring) (*fileKeyProvider, error) {
+	keys := make(map[string][]byte, len(kr.Keys))
+	for keyID, encoded := range kr.Keys {
+		if len(keyID) == 0 || len(keyID) > maxEnvelopeFieldLength {
+			return nil, fmt.Errorf("keyring: invalid key ID %q", keyID)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("keyring: key %v is not base64 encoded: %v", keyID, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("keyring: key %v must be 16, 24 or 32 bytes long", keyID)
+		}
+		keys[keyID] = key
+	}
+	if _, ok := keys[kr.ActiveKey]; !ok {
+		return nil, fmt.Errorf("keyring: active key %q not found", kr.ActiveKey)
+	}
+	return &fileKeyProvider{
+		activeKeyID: kr.ActiveKey,
+		keys:        keys,
+	}, nil
+}
+
+func (p *fileKeyProvider) ActiveKey() (string, []byte, error) {
+	return p.activeKeyID, p.keys[p.activeKeyID], nil
+}
+
+func (p *fileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, NewEncryptionKeyNotFoundError(keyID)
+	}
+	return key, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryClusterMembershipStore struct {
+	memoryStore
+}
+
+// newClusterMembershipPersistence creates an instance of ClusterMembershipManager
+func newClusterMembershipPersistence(db *database, logger log.Logger) p.ClusterMembershipStore {
+	return &memoryClusterMembershipStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryClusterMembershipStore) UpsertClusterMembership(ctx context.Context, request *p.UpsertClusterMembershipRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	now := time.Now()
+	m.db.clusterMembership[request.HostID] = &p.ClusterMember{
+		HostID:        request.HostID,
+		RPCAddress:    request.RPCAddress,
+		Role:          request.Role,
+		SessionStart:  request.SessionStart,
+		LastHeartbeat: now,
+		RecordExpiry:  now.Add(request.RecordExpiry),
+	}
+	return nil
+}
+
+func (m *memoryClusterMembershipStore) GetClusterMembers(ctx context.Context, request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	now := time.Now()
+	var members []*p.ClusterMember
+	for _, member := range m.db.clusterMembership {
+		if !member.RecordExpiry.After(now) {
+			continue
+		}
+		if request.Role != "" && member.Role != request.Role {
+			continue
+		}
+		if request.LastHeartbeatWithin > 0 && member.LastHeartbeat.Before(now.Add(-request.LastHeartbeatWithin)) {
+			continue
+		}
+		result := *member
+		members = append(members, &result)
+	}
+	return &p.GetClusterMembersResponse{ActiveMembers: members}, nil
+}
+
+func (m *memoryClusterMembershipStore) PruneClusterMembership(ctx context.Context, request *p.PruneClusterMembershipRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	now := time.Now()
+	pruned := 0
+	for hostID, member := range m.db.clusterMembership {
+		if request.MaxRecordsPruned > 0 && pruned >= request.MaxRecordsPruned {
+			break
+		}
+		if !member.RecordExpiry.After(now) {
+			delete(m.db.clusterMembership, hostID)
+			pruned++
+		}
+	}
+	return nil
+}
+
+func (m *memoryClusterMembershipStore) DeleteClusterMembership(ctx context.Context, request *p.DeleteClusterMembershipRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.clusterMembership, request.HostID)
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/checksum"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// database holds all the tables of an in-memory datastore. A single
+	// mutex guards every table, which makes each store operation atomic
+	database struct {
+		sync.Mutex
+
+		shards map[int]*p.ShardInfo
+
+		executions        map[executionKey]*executionRecord
+		currentExecutions map[currentExecutionKey]*currentExecutionRecord
+		transferTasks     map[int]map[int64]*p.TransferTaskInfo
+		timerTasks        map[int]map[timerTaskKey]*p.TimerTaskInfo
+		replicationTasks  map[int]map[int64]*p.ReplicationTaskInfo
+		replicationDLQ    map[replicationDLQKey]map[int64]*p.ReplicationTaskInfo
+
+		historyEvents map[historyKey]map[int64]*historyEventsRecord
+		historyTrees  map[string]map[string]*historyTreeRecord
+		historyNodes  map[string]map[string]map[historyNodeKey]*p.DataBlob
+
+		taskLists map[taskListKey]*p.TaskListInfo
+		tasks     map[taskListKey]map[int64]*p.TaskInfo
+
+		domains             map[string]*p.InternalGetDomainResponse
+		domainNames         map[string]string
+		notificationVersion int64
+
+		visibility map[visibilityKey]*visibilityRecord
+
+		clusterMembership map[string]*p.ClusterMember
+	}
+
+	executionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	currentExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+	}
+
+	executionRecord struct {
+		executionInfo            *p.InternalWorkflowExecutionInfo
+		replicationState         *p.ReplicationState
+		activityInfos            map[int64]*p.InternalActivityInfo
+		timerInfos               map[string]*p.TimerInfo
+		childExecutionInfos      map[int64]*p.InternalChildExecutionInfo
+		requestCancelInfos       map[int64]*p.RequestCancelInfo
+		signalInfos              map[int64]*p.SignalInfo
+		signalRequestedIDs       map[string]struct{}
+		bufferedEvents           []*p.DataBlob
+		bufferedReplicationTasks map[int64]*p.InternalBufferedReplicationTask
+		checksum                 checksum.Checksum
+	}
+
+	currentExecutionRecord struct {
+		runID            string
+		createRequestID  string
+		state            int
+		closeStatus      int
+		startVersion     int64
+		lastWriteVersion int64
+	}
+
+	timerTaskKey struct {
+		visibilityTimestamp int64
+		taskID              int64
+	}
+
+	replicationDLQKey struct {
+		sourceClusterName string
+		shardID           int
+	}
+
+	historyKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	historyEventsRecord struct {
+		rangeID       int64
+		transactionID int64
+		batchVersion  int64
+		events        *p.DataBlob
+	}
+
+	historyTreeRecord struct {
+		ancestors   []*shared.HistoryBranchRange
+		info        string
+		createdTime time.Time
+		inProgress  bool
+	}
+
+	historyNodeKey struct {
+		nodeID        int64
+		transactionID int64
+	}
+
+	taskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
+	visibilityKey struct {
+		domainID string
+		runID    string
+	}
+
+	visibilityRecord struct {
+		domainID         string
+		workflowID       string
+		runID            string
+		workflowTypeName string
+		startTime        time.Time
+		executionTime    time.Time
+		memo             *p.DataBlob
+		closed           bool
+		closeTime        time.Time
+		closeStatus      shared.WorkflowExecutionCloseStatus
+		historyLength    int64
+	}
+)
+
+func newDatabase() *database {
+	return &database{
+		shards:            make(map[int]*p.ShardInfo),
+		executions:        make(map[executionKey]*executionRecord),
+		currentExecutions: make(map[currentExecutionKey]*currentExecutionRecord),
+		transferTasks:     make(map[int]map[int64]*p.TransferTaskInfo),
+		timerTasks:        make(map[int]map[timerTaskKey]*p.TimerTaskInfo),
+		replicationTasks:  make(map[int]map[int64]*p.ReplicationTaskInfo),
+		replicationDLQ:    make(map[replicationDLQKey]map[int64]*p.ReplicationTaskInfo),
+		historyEvents:     make(map[historyKey]map[int64]*historyEventsRecord),
+		historyTrees:      make(map[string]map[string]*historyTreeRecord),
+		historyNodes:      make(map[string]map[string]map[historyNodeKey]*p.DataBlob),
+		taskLists:         make(map[taskListKey]*p.TaskListInfo),
+		tasks:             make(map[taskListKey]map[int64]*p.TaskInfo),
+		domains:           make(map[string]*p.InternalGetDomainResponse),
+		domainNames:       make(map[string]string),
+		visibility:        make(map[visibilityKey]*visibilityRecord),
+		clusterMembership: make(map[string]*p.ClusterMember),
+	}
+}
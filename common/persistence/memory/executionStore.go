@@ -0,0 +1,1003 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryExecutionStore struct {
+		memoryStore
+		shardID int
+	}
+
+	timerTaskPageToken struct {
+		TaskID    int64
+		Timestamp time.Time
+	}
+)
+
+var _ p.ExecutionStore = (*memoryExecutionStore)(nil)
+
+// newExecutionPersistence creates an instance of ExecutionStore
+func newExecutionPersistence(db *database, shardID int, logger log.Logger) p.ExecutionStore {
+	return &memoryExecutionStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		shardID: shardID,
+	}
+}
+
+func (m *memoryExecutionStore) GetShardID() int {
+	return m.shardID
+}
+
+func (m *memoryExecutionStore) CreateWorkflowExecution(ctx context.Context, request *p.InternalCreateWorkflowExecutionRequest) (*p.CreateWorkflowExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkShardRangeID(m.shardID, request.RangeID, "lock"); err != nil {
+		return nil, err
+	}
+
+	// validate workflow state & close status
+	if err := p.ValidateCreateWorkflowStateCloseStatus(
+		request.State,
+		request.CloseStatus); err != nil {
+		return nil, err
+	}
+	switch request.CreateWorkflowMode {
+	case p.CreateWorkflowModeContinueAsNew:
+		// cannot create workflow with continue as new mode
+		return nil, &workflow.InternalServiceError{
+			Message: "CreateWorkflowExecution operation failed. Invalid CreateWorkflowModeContinueAsNew is used",
+		}
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	if row, ok := m.db.currentExecutions[m.currentExecutionKey(request.DomainID, workflowID)]; ok {
+		switch request.CreateWorkflowMode {
+		case p.CreateWorkflowModeBrandNew:
+			lastWriteVersion := common.EmptyVersion
+			if request.ReplicationState != nil {
+				lastWriteVersion = row.lastWriteVersion
+			}
+			return nil, &p.WorkflowExecutionAlreadyStartedError{
+				Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID),
+				StartRequestID:   row.createRequestID,
+				RunID:            row.runID,
+				State:            row.state,
+				CloseStatus:      row.closeStatus,
+				LastWriteVersion: lastWriteVersion,
+			}
+		case p.CreateWorkflowModeWorkflowIDReuse:
+			if request.PreviousLastWriteVersion != row.lastWriteVersion {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
+						workflowID, row.lastWriteVersion, request.PreviousLastWriteVersion),
+				}
+			}
+			if row.state != p.WorkflowStateCompleted {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"State: %v, Expected: %v",
+						workflowID, row.state, p.WorkflowStateCompleted),
+				}
+			}
+			if row.runID != request.PreviousRunID {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"RunID: %v, PreviousRunID: %v",
+						workflowID, row.runID, request.PreviousRunID),
+				}
+			}
+		default:
+			return nil, fmt.Errorf("Unknown workflow creation mode: %v", request.CreateWorkflowMode)
+		}
+	}
+
+	runID := request.Execution.GetRunId()
+	if _, ok := m.db.executions[m.executionKey(request.DomainID, workflowID, runID)]; ok {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Execution already exists. WorkflowId: %v, RunId: %v", workflowID, runID),
+		}
+	}
+
+	if err := m.validateReplicationTasks(request.ReplicationTasks); err != nil {
+		return nil, err
+	}
+
+	startVersion, lastWriteVersion := getStartAndLastWriteVersion(request.ReplicationState)
+	m.updateCurrentExecution(request.DomainID, workflowID, runID, request.RequestID, request.State,
+		request.CloseStatus, startVersion, lastWriteVersion)
+	m.createExecutionFromRequest(request, time.Now())
+	m.createTransferTasks(request.TransferTasks, request.DomainID, workflowID, runID)
+	m.createReplicationTasks(request.ReplicationTasks, request.DomainID, workflowID, runID)
+	m.createTimerTasks(request.TimerTasks, request.DomainID, workflowID, runID)
+	return &p.CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *memoryExecutionStore) GetWorkflowExecution(ctx context.Context, request *p.GetWorkflowExecutionRequest) (*p.InternalGetWorkflowExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	record, ok := m.db.executions[m.executionKey(request.DomainID, request.Execution.GetWorkflowId(), request.Execution.GetRunId())]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				request.Execution.GetWorkflowId(),
+				request.Execution.GetRunId()),
+		}
+	}
+	return &p.InternalGetWorkflowExecutionResponse{State: record.toMutableState()}, nil
+}
+
+func (m *memoryExecutionStore) UpdateWorkflowExecution(ctx context.Context, request *p.InternalUpdateWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkShardRangeID(m.shardID, request.RangeID, "lock"); err != nil {
+		return err
+	}
+
+	// validate workflow state & close status
+	if err := p.ValidateUpdateWorkflowStateCloseStatus(
+		request.ExecutionInfo.State,
+		request.ExecutionInfo.CloseStatus); err != nil {
+		return err
+	}
+
+	executionInfo := request.ExecutionInfo
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+
+	record, err := m.checkNextEventID(domainID, workflowID, runID, request.Condition)
+	if err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+			}
+		}
+	}
+
+	newRequest := request.ContinueAsNew
+	if newRequest != nil {
+		// validate workflow state & close status
+		if err := p.ValidateCreateWorkflowStateCloseStatus(
+			newRequest.State,
+			newRequest.CloseStatus); err != nil {
+			return err
+		}
+		if newRequest.DomainID != domainID {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution. Cannot continue as new to another domain"),
+			}
+		}
+		if err := m.assertCurrentRunID(domainID, workflowID, runID); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution. Failed to continue as new current execution. Error: %v", err),
+			}
+		}
+	} else {
+		if err := m.assertCurrentRunID(domainID, workflowID, runID); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to update current execution. Error: %v", err),
+			}
+		}
+	}
+	if err := m.validateReplicationTasks(request.ReplicationTasks); err != nil {
+		return err
+	}
+
+	m.createTransferTasks(request.TransferTasks, domainID, workflowID, runID)
+	m.createReplicationTasks(request.ReplicationTasks, domainID, workflowID, runID)
+	m.createTimerTasks(request.TimerTasks, domainID, workflowID, runID)
+
+	record.executionInfo = copyExecutionInfo(executionInfo)
+	record.replicationState = copyReplicationState(request.ReplicationState)
+	record.checksum = copyChecksum(request.Checksum)
+	record.upsertActivityInfos(request.UpsertActivityInfos, request.DeleteActivityInfos)
+	record.upsertTimerInfos(request.UpserTimerInfos, request.DeleteTimerInfos)
+	record.upsertChildExecutionInfos(request.UpsertChildExecutionInfos, request.DeleteChildExecutionInfo)
+	record.upsertRequestCancelInfos(request.UpsertRequestCancelInfos, request.DeleteRequestCancelInfo)
+	record.upsertSignalInfos(request.UpsertSignalInfos, request.DeleteSignalInfo)
+	record.updateBufferedEvents(request.NewBufferedEvents, request.ClearBufferedEvents)
+	record.updateBufferedReplicationTasks(request.NewBufferedReplicationTask, request.DeleteBufferedReplicationTask)
+	record.upsertSignalRequestedIDs(request.UpsertSignalRequestedIDs, request.DeleteSignalRequestedID)
+
+	if newRequest != nil {
+		newRunID := newRequest.Execution.GetRunId()
+		startVersion, lastWriteVersion := getStartAndLastWriteVersion(newRequest.ReplicationState)
+		m.updateCurrentExecution(domainID, workflowID, newRunID, newRequest.RequestID, newRequest.State,
+			newRequest.CloseStatus, startVersion, lastWriteVersion)
+		m.createExecutionFromRequest(newRequest, time.Now())
+		m.createTransferTasks(newRequest.TransferTasks, domainID, newRequest.Execution.GetWorkflowId(), newRunID)
+		m.createTimerTasks(newRequest.TimerTasks, domainID, newRequest.Execution.GetWorkflowId(), newRunID)
+	} else {
+		// this is only to update the current record
+		startVersion, lastWriteVersion := getStartAndLastWriteVersion(request.ReplicationState)
+		m.updateCurrentExecution(domainID, workflowID, runID, executionInfo.CreateRequestID, executionInfo.State,
+			executionInfo.CloseStatus, startVersion, lastWriteVersion)
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) ResetWorkflowExecution(ctx context.Context, request *p.InternalResetWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkShardRangeID(m.shardID, request.RangeID, "lock"); err != nil {
+		return err
+	}
+
+	currExecutionInfo := request.CurrExecutionInfo
+	domainID := currExecutionInfo.DomainID
+	workflowID := currExecutionInfo.WorkflowID
+	currRunID := currExecutionInfo.RunID
+	insertExecutionInfo := request.InsertExecutionInfo
+	newRunID := insertExecutionInfo.RunID
+
+	if _, ok := m.db.currentExecutions[m.currentExecutionKey(domainID, insertExecutionInfo.WorkflowID)]; !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed at updateCurrentExecution. Error: current execution does not exist"),
+		}
+	}
+
+	// the base run must not have been deleted after forking
+	if request.BaseRunID != currRunID {
+		if _, ok := m.db.executions[m.executionKey(domainID, workflowID, request.BaseRunID)]; !ok {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Base run %v does not exist", request.BaseRunID),
+			}
+		}
+	}
+
+	currRecord, err := m.checkNextEventID(domainID, workflowID, currRunID, request.Condition)
+	if err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+			}
+		}
+	}
+
+	if _, ok := m.db.executions[m.executionKey(domainID, workflowID, newRunID)]; ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create executions row. Erorr: execution %v already exists", newRunID),
+		}
+	}
+	if err := m.validateReplicationTasks(request.CurrReplicationTasks); err != nil {
+		return err
+	}
+	if err := m.validateReplicationTasks(request.InsertReplicationTasks); err != nil {
+		return err
+	}
+
+	// 1. update current execution
+	startVersion, lastWriteVersion := getStartAndLastWriteVersion(request.InsertReplicationState)
+	m.updateCurrentExecution(domainID, insertExecutionInfo.WorkflowID, newRunID, insertExecutionInfo.CreateRequestID,
+		insertExecutionInfo.State, insertExecutionInfo.CloseStatus, startVersion, lastWriteVersion)
+
+	// 2. update current run
+	if request.UpdateCurr {
+		m.createTransferTasks(request.CurrTransferTasks, domainID, workflowID, currRunID)
+		m.createTimerTasks(request.CurrTimerTasks, domainID, workflowID, currRunID)
+		currRecord.executionInfo = copyExecutionInfo(currExecutionInfo)
+		currRecord.replicationState = copyReplicationState(request.CurrReplicationState)
+		currRecord.checksum = checksum.Checksum{}
+	}
+	m.createReplicationTasks(request.CurrReplicationTasks, domainID, workflowID, currRunID)
+
+	// 3. insert records for new run
+	m.createReplicationTasks(request.InsertReplicationTasks, domainID, workflowID, newRunID)
+	record := newExecutionRecord()
+	record.executionInfo = copyExecutionInfo(insertExecutionInfo)
+	record.replicationState = copyReplicationState(request.InsertReplicationState)
+	record.upsertActivityInfos(request.InsertActivityInfos, nil)
+	record.upsertTimerInfos(request.InsertTimerInfos, nil)
+	record.upsertChildExecutionInfos(request.InsertChildExecutionInfos, nil)
+	record.upsertRequestCancelInfos(request.InsertRequestCancelInfos, nil)
+	record.upsertSignalInfos(request.InsertSignalInfos, nil)
+	record.upsertSignalRequestedIDs(request.InsertSignalRequestedIDs, "")
+	m.db.executions[m.executionKey(domainID, workflowID, newRunID)] = record
+
+	m.createTimerTasks(request.InsertTimerTasks, domainID, workflowID, newRunID)
+	m.createTransferTasks(request.InsertTransferTasks, domainID, workflowID, newRunID)
+	return nil
+}
+
+func (m *memoryExecutionStore) ResetMutableState(ctx context.Context, request *p.InternalResetMutableStateRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkShardRangeID(m.shardID, request.RangeID, "lock"); err != nil {
+		return err
+	}
+
+	info := request.ExecutionInfo
+	domainID := info.DomainID
+	workflowID := info.WorkflowID
+	runID := info.RunID
+
+	row, ok := m.db.currentExecutions[m.currentExecutionKey(domainID, workflowID)]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Unable to load current record."),
+		}
+	}
+	if row.runID != request.PrevRunID {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"Update current record failed failed. Current run ID was %v, expected %v",
+			row.runID,
+			request.PrevRunID,
+		)}
+	}
+	if row.lastWriteVersion != request.PrevLastWriteVersion {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"Update current record failed failed. Current last write version was %v, expected %v",
+			row.lastWriteVersion,
+			request.PrevLastWriteVersion,
+		)}
+	}
+	if row.state != request.PrevState {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"Update current record failed failed. Current state %v, expected %v",
+			row.state,
+			request.PrevState,
+		)}
+	}
+
+	record, err := m.checkNextEventID(domainID, workflowID, runID, request.Condition)
+	if err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetMutableState operation failed. Failed to lock executions row. Error: %v", err),
+			}
+		}
+	}
+
+	startVersion, lastWriteVersion := getStartAndLastWriteVersion(request.ReplicationState)
+	m.updateCurrentExecution(domainID, workflowID, runID, info.CreateRequestID, info.State, info.CloseStatus,
+		startVersion, lastWriteVersion)
+
+	newRecord := newExecutionRecord()
+	newRecord.executionInfo = copyExecutionInfo(info)
+	newRecord.replicationState = copyReplicationState(request.ReplicationState)
+	newRecord.checksum = copyChecksum(request.Checksum)
+	newRecord.upsertActivityInfos(request.InsertActivityInfos, nil)
+	newRecord.upsertTimerInfos(request.InsertTimerInfos, nil)
+	newRecord.upsertChildExecutionInfos(request.InsertChildExecutionInfos, nil)
+	newRecord.upsertRequestCancelInfos(request.InsertRequestCancelInfos, nil)
+	newRecord.upsertSignalInfos(request.InsertSignalInfos, nil)
+	newRecord.upsertSignalRequestedIDs(request.InsertSignalRequestedIDs, "")
+	*record = *newRecord
+	return nil
+}
+
+func (m *memoryExecutionStore) DeleteWorkflowExecution(ctx context.Context, request *p.DeleteWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.executions, m.executionKey(request.DomainID, request.WorkflowID, request.RunID))
+	return nil
+}
+
+// its possible for a new run of the same workflow to have started after the run we are deleting
+// here was finished. In that case, the current execution record will have the same workflowID but
+// a different runID. The following code will delete the current record if and only if the runID is
+// same as the one we are trying to delete here
+func (m *memoryExecutionStore) DeleteCurrentWorkflowExecution(ctx context.Context, request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := m.currentExecutionKey(request.DomainID, request.WorkflowID)
+	if row, ok := m.db.currentExecutions[key]; ok && row.runID == request.RunID {
+		delete(m.db.currentExecutions, key)
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetCurrentExecution(ctx context.Context, request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	row, ok := m.db.currentExecutions[m.currentExecutionKey(request.DomainID, request.WorkflowID)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found. WorkflowId: %v", request.WorkflowID),
+		}
+	}
+	return row.toResponse(), nil
+}
+
+func (m *memoryExecutionStore) GetTransferTasks(ctx context.Context, request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	resp := &p.GetTransferTasksResponse{Tasks: []*p.TransferTaskInfo{}}
+	for taskID, task := range m.db.transferTasks[m.shardID] {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			info := *task
+			resp.Tasks = append(resp.Tasks, &info)
+		}
+	}
+	sort.Slice(resp.Tasks, func(i, j int) bool {
+		return resp.Tasks[i].TaskID < resp.Tasks[j].TaskID
+	})
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteTransferTask(ctx context.Context, request *p.CompleteTransferTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.transferTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTransferTask(ctx context.Context, request *p.RangeCompleteTransferTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.transferTasks[m.shardID]
+	for taskID := range tasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasks(ctx context.Context, request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
+	var readLevel int64
+	var err error
+	if len(request.NextPageToken) > 0 {
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetReplicationTasks operation failed. Error: %v", err),
+			}
+		}
+	} else {
+		readLevel = request.ReadLevel
+	}
+	maxReadLevelInclusive := collection.MaxInt64(
+		readLevel+int64(request.BatchSize), request.MaxReadLevel)
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var tasks []*p.ReplicationTaskInfo
+	for taskID, task := range m.db.replicationTasks[m.shardID] {
+		if taskID > readLevel && taskID <= maxReadLevelInclusive {
+			tasks = append(tasks, copyReplicationTaskInfo(task))
+		}
+	}
+	if len(tasks) == 0 {
+		return &p.GetReplicationTasksResponse{}, nil
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].TaskID < tasks[j].TaskID
+	})
+	if len(tasks) > request.BatchSize {
+		tasks = tasks[:request.BatchSize]
+	}
+
+	var nextPageToken []byte
+	lastTaskID := tasks[len(tasks)-1].TaskID
+	if lastTaskID < request.MaxReadLevel {
+		nextPageToken = serializePageToken(lastTaskID)
+	}
+	return &p.GetReplicationTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *memoryExecutionStore) CompleteReplicationTask(ctx context.Context, request *p.CompleteReplicationTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.replicationTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) PutReplicationTaskToDLQ(ctx context.Context, request *p.PutReplicationTaskToDLQRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := replicationDLQKey{sourceClusterName: request.SourceClusterName, shardID: m.shardID}
+	tasks, ok := m.db.replicationDLQ[key]
+	if !ok {
+		tasks = make(map[int64]*p.ReplicationTaskInfo)
+		m.db.replicationDLQ[key] = tasks
+	}
+	tasks[request.TaskInfo.TaskID] = copyReplicationTaskInfo(request.TaskInfo)
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasksFromDLQ(ctx context.Context, request *p.GetReplicationTasksFromDLQRequest) (*p.GetReplicationTasksFromDLQResponse, error) {
+	var readLevel int64
+	var err error
+	if len(request.NextPageToken) > 0 {
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetReplicationTasksFromDLQ operation failed. Error: %v", err),
+			}
+		}
+	} else {
+		readLevel = request.ReadLevel
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := replicationDLQKey{sourceClusterName: request.SourceClusterName, shardID: m.shardID}
+	var tasks []*p.ReplicationTaskInfo
+	for taskID, task := range m.db.replicationDLQ[key] {
+		if taskID > readLevel && taskID <= request.MaxReadLevel {
+			tasks = append(tasks, copyReplicationTaskInfo(task))
+		}
+	}
+	if len(tasks) == 0 {
+		return &p.GetReplicationTasksFromDLQResponse{}, nil
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].TaskID < tasks[j].TaskID
+	})
+
+	var nextPageToken []byte
+	if len(tasks) > request.BatchSize {
+		tasks = tasks[:request.BatchSize]
+		nextPageToken = serializePageToken(tasks[len(tasks)-1].TaskID)
+	}
+	return &p.GetReplicationTasksFromDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *memoryExecutionStore) DeleteReplicationTaskFromDLQ(ctx context.Context, request *p.DeleteReplicationTaskFromDLQRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := replicationDLQKey{sourceClusterName: request.SourceClusterName, shardID: m.shardID}
+	delete(m.db.replicationDLQ[key], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *p.RangeDeleteReplicationTaskFromDLQRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := replicationDLQKey{sourceClusterName: request.SourceClusterName, shardID: m.shardID}
+	tasks := m.db.replicationDLQ[key]
+	for taskID := range tasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (t *timerTaskPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *timerTaskPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
+}
+
+func (m *memoryExecutionStore) GetTimerIndexTasks(ctx context.Context, request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse, error) {
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
+	if len(request.NextPageToken) > 0 {
+		if err := pageToken.deserialize(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing timerTaskPageToken: %v", err),
+			}
+		}
+	}
+
+	minTimestamp := pageToken.Timestamp.UnixNano()
+	maxTimestamp := request.MaxTimestamp.UnixNano()
+
+	m.db.Lock()
+	resp := &p.GetTimerIndexTasksResponse{Timers: []*p.TimerTaskInfo{}}
+	for key, task := range m.db.timerTasks[m.shardID] {
+		if key.visibilityTimestamp >= maxTimestamp {
+			continue
+		}
+		if key.visibilityTimestamp > minTimestamp ||
+			(key.visibilityTimestamp == minTimestamp && key.taskID >= pageToken.TaskID) {
+			info := *task
+			resp.Timers = append(resp.Timers, &info)
+		}
+	}
+	m.db.Unlock()
+
+	sort.Slice(resp.Timers, func(i, j int) bool {
+		ti, tj := resp.Timers[i], resp.Timers[j]
+		if !ti.VisibilityTimestamp.Equal(tj.VisibilityTimestamp) {
+			return ti.VisibilityTimestamp.Before(tj.VisibilityTimestamp)
+		}
+		return ti.TaskID < tj.TaskID
+	})
+
+	if len(resp.Timers) > request.BatchSize {
+		pageToken = &timerTaskPageToken{
+			TaskID:    resp.Timers[request.BatchSize].TaskID,
+			Timestamp: resp.Timers[request.BatchSize].VisibilityTimestamp,
+		}
+		resp.Timers = resp.Timers[:request.BatchSize]
+		nextToken, err := pageToken.serialize()
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetTimerTasks: error serializing page token: %v", err),
+			}
+		}
+		resp.NextPageToken = nextToken
+	}
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteTimerTask(ctx context.Context, request *p.CompleteTimerTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.timerTasks[m.shardID], timerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTimerTask(ctx context.Context, request *p.RangeCompleteTimerTaskRequest) error {
+	start := request.InclusiveBeginTimestamp.UnixNano()
+	end := request.ExclusiveEndTimestamp.UnixNano()
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.timerTasks[m.shardID]
+	for key := range tasks {
+		if key.visibilityTimestamp >= start && key.visibilityTimestamp < end {
+			delete(tasks, key)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) executionKey(domainID string, workflowID string, runID string) executionKey {
+	return executionKey{shardID: m.shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+}
+
+func (m *memoryExecutionStore) currentExecutionKey(domainID string, workflowID string) currentExecutionKey {
+	return currentExecutionKey{shardID: m.shardID, domainID: domainID, workflowID: workflowID}
+}
+
+// checkNextEventID returns the execution record if its next event ID matches the condition
+func (m *memoryExecutionStore) checkNextEventID(domainID string, workflowID string, runID string, condition int64) (*executionRecord, error) {
+	record, ok := m.db.executions[m.executionKey(domainID, workflowID, runID)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Failed to lock executions row with (shard, domain, workflow, run) = (%v,%v,%v,%v) which does not exist.", m.shardID, domainID, workflowID, runID),
+		}
+	}
+	if nextEventID := record.executionInfo.NextEventID; nextEventID != condition {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("next_event_id was %v when it should have been %v.", nextEventID, condition),
+		}
+	}
+	return record, nil
+}
+
+func (m *memoryExecutionStore) assertCurrentRunID(domainID string, workflowID string, runID string) error {
+	row, ok := m.db.currentExecutions[m.currentExecutionKey(domainID, workflowID)]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unable to load current record. WorkflowId: %v", workflowID),
+		}
+	}
+	if row.runID != runID {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"Update current record failed failed. Current run ID was %v, expected %v",
+			row.runID,
+			runID,
+		)}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) updateCurrentExecution(domainID string, workflowID string, runID string,
+	createRequestID string, state int, closeStatus int, startVersion int64, lastWriteVersion int64) {
+	m.db.currentExecutions[m.currentExecutionKey(domainID, workflowID)] = &currentExecutionRecord{
+		runID:            runID,
+		createRequestID:  createRequestID,
+		state:            state,
+		closeStatus:      closeStatus,
+		startVersion:     startVersion,
+		lastWriteVersion: lastWriteVersion,
+	}
+}
+
+func (m *memoryExecutionStore) createExecutionFromRequest(request *p.InternalCreateWorkflowExecutionRequest, nowTimestamp time.Time) {
+	info := &p.InternalWorkflowExecutionInfo{
+		DomainID:                   request.DomainID,
+		WorkflowID:                 request.Execution.GetWorkflowId(),
+		RunID:                      request.Execution.GetRunId(),
+		NextEventID:                request.NextEventID,
+		TaskList:                   request.TaskList,
+		WorkflowTypeName:           request.WorkflowTypeName,
+		WorkflowTimeout:            request.WorkflowTimeout,
+		DecisionTimeoutValue:       request.DecisionTimeoutValue,
+		ExecutionContext:           request.ExecutionContext,
+		State:                      request.State,
+		CloseStatus:                request.CloseStatus,
+		LastFirstEventID:           common.FirstEventID,
+		LastEventTaskID:            request.LastEventTaskID,
+		LastProcessedEvent:         request.LastProcessedEvent,
+		StartTimestamp:             nowTimestamp,
+		LastUpdatedTimestamp:       nowTimestamp,
+		CreateRequestID:            request.RequestID,
+		SignalCount:                request.SignalCount,
+		HistorySize:                request.HistorySize,
+		DecisionVersion:            request.DecisionVersion,
+		DecisionScheduleID:         request.DecisionScheduleID,
+		DecisionStartedID:          request.DecisionStartedID,
+		DecisionTimeout:            request.DecisionStartToCloseTimeout,
+		DecisionAttempt:            0,
+		DecisionStartedTimestamp:   0,
+		DecisionScheduledTimestamp: 0,
+		CompletionEventBatchID:     common.EmptyEventID,
+		AutoResetPoints:            request.PreviousAutoResetPoints,
+		Attempt:                    request.Attempt,
+		HasRetryPolicy:             request.HasRetryPolicy,
+		InitialInterval:            request.InitialInterval,
+		BackoffCoefficient:         request.BackoffCoefficient,
+		MaximumInterval:            request.MaximumInterval,
+		ExpirationTime:             request.ExpirationTime,
+		MaximumAttempts:            request.MaximumAttempts,
+		NonRetriableErrors:         request.NonRetriableErrors,
+		CronSchedule:               request.CronSchedule,
+		ExpirationSeconds:          request.ExpirationSeconds,
+		SearchAttributes:           request.SearchAttributes,
+	}
+	if request.ParentDomainID != "" {
+		info.ParentDomainID = request.ParentDomainID
+		info.ParentWorkflowID = request.ParentExecution.GetWorkflowId()
+		info.ParentRunID = request.ParentExecution.GetRunId()
+		info.InitiatedID = request.InitiatedID
+	}
+	if request.EventStoreVersion == p.EventStoreVersionV2 {
+		info.EventStoreVersion = p.EventStoreVersionV2
+		info.BranchToken = request.BranchToken
+	}
+
+	record := newExecutionRecord()
+	record.executionInfo = copyExecutionInfo(info)
+	record.replicationState = copyReplicationState(request.ReplicationState)
+	record.checksum = copyChecksum(request.Checksum)
+	m.db.executions[m.executionKey(info.DomainID, info.WorkflowID, info.RunID)] = record
+}
+
+func (m *memoryExecutionStore) createTransferTasks(transferTasks []p.Task, domainID string, workflowID string, runID string) {
+	if len(transferTasks) == 0 {
+		return
+	}
+	tasks, ok := m.db.transferTasks[m.shardID]
+	if !ok {
+		tasks = make(map[int64]*p.TransferTaskInfo)
+		m.db.transferTasks[m.shardID] = tasks
+	}
+
+	for _, task := range transferTasks {
+		info := &p.TransferTaskInfo{
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            runID,
+			TaskID:           task.GetTaskID(),
+			TargetDomainID:   domainID,
+			TargetWorkflowID: p.TransferTaskTransferTargetWorkflowID,
+			ScheduleID:       0,
+		}
+
+		switch t := task.(type) {
+		case *p.ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+
+		case *p.DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+			info.RecordVisibility = t.RecordVisibility
+
+		case *p.CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+
+		case *p.SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+
+		case *p.StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+		}
+
+		info.TaskType = task.GetType()
+		info.Version = task.GetVersion()
+		info.VisibilityTimestamp = task.GetVisibilityTimestamp()
+		tasks[info.TaskID] = info
+	}
+}
+
+func (m *memoryExecutionStore) validateReplicationTasks(replicationTasks []p.Task) error {
+	for _, task := range replicationTasks {
+		switch task.(type) {
+		case *p.HistoryReplicationTask, *p.SyncActivityTask:
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Unknown replication task: %v", task),
+			}
+		}
+	}
+	return nil
+}
+
+// createReplicationTasks expects the tasks to have been checked by validateReplicationTasks
+func (m *memoryExecutionStore) createReplicationTasks(replicationTasks []p.Task, domainID string, workflowID string, runID string) {
+	if len(replicationTasks) == 0 {
+		return
+	}
+	tasks, ok := m.db.replicationTasks[m.shardID]
+	if !ok {
+		tasks = make(map[int64]*p.ReplicationTaskInfo)
+		m.db.replicationTasks[m.shardID] = tasks
+	}
+
+	for _, task := range replicationTasks {
+		info := &p.ReplicationTaskInfo{
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskID:       task.GetTaskID(),
+			TaskType:     task.GetType(),
+			FirstEventID: common.EmptyEventID,
+			NextEventID:  common.EmptyEventID,
+			Version:      task.GetVersion(),
+			ScheduledID:  common.EmptyEventID,
+		}
+
+		switch t := task.(type) {
+		case *p.HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.EventStoreVersion = t.EventStoreVersion
+			info.NewRunEventStoreVersion = t.NewRunEventStoreVersion
+			info.BranchToken = copyBytes(t.BranchToken)
+			info.NewRunBranchToken = copyBytes(t.NewRunBranchToken)
+			info.ResetWorkflow = t.ResetWorkflow
+			info.LastReplicationInfo = copyReplicationInfo(t.LastReplicationInfo)
+			if info.LastReplicationInfo == nil {
+				info.LastReplicationInfo = map[string]*p.ReplicationInfo{}
+			}
+		case *p.SyncActivityTask:
+			info.ScheduledID = t.ScheduledID
+		}
+		tasks[info.TaskID] = info
+	}
+}
+
+func (m *memoryExecutionStore) createTimerTasks(timerTasks []p.Task, domainID string, workflowID string, runID string) {
+	if len(timerTasks) == 0 {
+		return
+	}
+	tasks, ok := m.db.timerTasks[m.shardID]
+	if !ok {
+		tasks = make(map[timerTaskKey]*p.TimerTaskInfo)
+		m.db.timerTasks[m.shardID] = tasks
+	}
+
+	for _, task := range timerTasks {
+		info := &p.TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *p.DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+		case *p.ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+		case *p.UserTimerTask:
+			info.EventID = t.EventID
+		case *p.ActivityRetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+		case *p.WorkflowBackoffTimerTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+		}
+
+		tasks[timerTaskKey{
+			visibilityTimestamp: info.VisibilityTimestamp.UnixNano(),
+			taskID:              info.TaskID,
+		}] = info
+	}
+}
+
+func copyReplicationTaskInfo(task *p.ReplicationTaskInfo) *p.ReplicationTaskInfo {
+	result := *task
+	result.BranchToken = copyBytes(task.BranchToken)
+	result.NewRunBranchToken = copyBytes(task.NewRunBranchToken)
+	result.LastReplicationInfo = copyReplicationInfo(task.LastReplicationInfo)
+	return &result
+}
+
+func copyChecksum(c checksum.Checksum) checksum.Checksum {
+	c.Value = copyBytes(c.Value)
+	return c
+}
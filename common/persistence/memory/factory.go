@@ -0,0 +1,131 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Factory vends store objects backed by an in-process, in-memory database
+	Factory struct {
+		cfg         config.Memory
+		db          *database
+		clusterName string
+		logger      log.Logger
+	}
+)
+
+var (
+	databasesLock sync.Mutex
+	databases     = make(map[string]*database)
+)
+
+// NewFactory returns an instance of a factory object which can be used to create
+// datastores backed by memory. Factories configured with the same database name
+// share the same underlying data for the lifetime of the process
+func NewFactory(cfg config.Memory, clusterName string, logger log.Logger) *Factory {
+	return &Factory{
+		cfg:         cfg,
+		db:          getDatabase(cfg.DatabaseName),
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return newTaskPersistence(f.db, f.logger), nil
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return newShardPersistence(f.db, f.clusterName, f.logger), nil
+}
+
+// NewHistoryStore returns a new history store
+func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
+	return newHistoryPersistence(f.db, f.logger), nil
+}
+
+// NewHistoryV2Store returns a new history store
+func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
+	return newHistoryV2Persistence(f.db, f.logger), nil
+}
+
+// NewMetadataStore returns a new metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return newMetadataPersistence(f.db, f.clusterName, f.logger), nil
+}
+
+// NewMetadataStoreV1 returns the default metadatastore
+func (f *Factory) NewMetadataStoreV1() (p.MetadataStore, error) {
+	return f.NewMetadataStore()
+}
+
+// NewMetadataStoreV2 returns the default metadatastore
+func (f *Factory) NewMetadataStoreV2() (p.MetadataStore, error) {
+	return f.NewMetadataStore()
+}
+
+// NewExecutionStore returns an ExecutionStore for a given shardID
+func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
+	return newExecutionPersistence(f.db, shardID, f.logger), nil
+}
+
+// NewVisibilityStore returns a visibility store
+func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
+	return newVisibilityPersistence(f.db, f.logger), nil
+}
+
+// NewClusterMembershipStore returns a cluster membership store
+func (f *Factory) NewClusterMembershipStore() (p.ClusterMembershipStore, error) {
+	return newClusterMembershipPersistence(f.db, f.logger), nil
+}
+
+// Close closes the factory. The underlying database is kept around so that
+// other factories using the same database name still see the data
+func (f *Factory) Close() {
+}
+
+// getDatabase returns the database registered under the given name,
+// creating it if this is the first time the name is used
+func getDatabase(name string) *database {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+	db, ok := databases[name]
+	if !ok {
+		db = newDatabase()
+		databases[name] = db
+	}
+	return db
+}
+
+// DropDatabase discards all the data stored under the given database name
+func DropDatabase(name string) {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+	delete(databases, name)
+}
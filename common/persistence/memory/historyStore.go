@@ -0,0 +1,167 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryHistoryStore struct {
+	memoryStore
+}
+
+// newHistoryPersistence creates an instance of HistoryManager
+func newHistoryPersistence(db *database, logger log.Logger) p.HistoryStore {
+	return &memoryHistoryStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryHistoryStore) AppendHistoryEvents(ctx context.Context, request *p.InternalAppendHistoryEventsRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := newHistoryKey(request.DomainID, request.Execution)
+	batches, ok := m.db.historyEvents[key]
+	if !ok {
+		batches = make(map[int64]*historyEventsRecord)
+		m.db.historyEvents[key] = batches
+	}
+
+	existing, exists := batches[request.FirstEventID]
+	if request.Overwrite {
+		if !exists {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("AppendHistoryEvents: event with first event ID %v does not exist", request.FirstEventID),
+			}
+		}
+		if existing.rangeID > request.RangeID {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("expected rangedID <=%v, got %v", request.RangeID, existing.rangeID),
+			}
+		}
+		if existing.transactionID >= request.TransactionID {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("expected txID < %v, got %v", request.TransactionID, existing.transactionID),
+			}
+		}
+	} else if exists {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("AppendHistoryEvents: event already exist: first event ID %v", request.FirstEventID),
+		}
+	}
+
+	batches[request.FirstEventID] = &historyEventsRecord{
+		rangeID:       request.RangeID,
+		transactionID: request.TransactionID,
+		batchVersion:  request.EventBatchVersion,
+		events:        copyDataBlob(request.Events),
+	}
+	return nil
+}
+
+func (m *memoryHistoryStore) GetWorkflowExecutionHistory(ctx context.Context, request *p.InternalGetWorkflowExecutionHistoryRequest) (
+	*p.InternalGetWorkflowExecutionHistoryResponse, error) {
+
+	offset := request.FirstEventID - 1
+	if request.NextPageToken != nil && len(request.NextPageToken) > 0 {
+		var newOffset int64
+		var err error
+		if newOffset, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
+		}
+		offset = newOffset
+	}
+
+	m.db.Lock()
+	var firstEventIDs []int64
+	batches := m.db.historyEvents[newHistoryKey(request.DomainID, request.Execution)]
+	for firstEventID := range batches {
+		if firstEventID >= offset+1 && firstEventID < request.NextEventID {
+			firstEventIDs = append(firstEventIDs, firstEventID)
+		}
+	}
+	sort.Slice(firstEventIDs, func(i, j int) bool { return firstEventIDs[i] < firstEventIDs[j] })
+	if len(firstEventIDs) > request.PageSize {
+		firstEventIDs = firstEventIDs[:request.PageSize]
+	}
+	rows := make([]*historyEventsRecord, len(firstEventIDs))
+	for i, firstEventID := range firstEventIDs {
+		rows[i] = batches[firstEventID]
+	}
+	m.db.Unlock()
+
+	if len(rows) == 0 {
+		return &p.InternalGetWorkflowExecutionHistoryResponse{}, nil
+	}
+
+	history := make([]*p.DataBlob, 0)
+	lastEventBatchVersion := request.LastEventBatchVersion
+
+	for i, v := range rows {
+		eventBatchVersion := common.EmptyVersion
+		if v.batchVersion > 0 {
+			eventBatchVersion = v.batchVersion
+		}
+		if eventBatchVersion >= lastEventBatchVersion {
+			history = append(history, copyDataBlob(v.events))
+			lastEventBatchVersion = eventBatchVersion
+		}
+		offset = firstEventIDs[i]
+	}
+
+	var nextPageToken []byte
+	if len(rows) >= request.PageSize {
+		nextPageToken = serializePageToken(offset)
+	}
+	return &p.InternalGetWorkflowExecutionHistoryResponse{
+		History:               history,
+		LastEventBatchVersion: lastEventBatchVersion,
+		NextPageToken:         nextPageToken,
+	}, nil
+}
+
+func (m *memoryHistoryStore) DeleteWorkflowExecutionHistory(ctx context.Context, request *p.DeleteWorkflowExecutionHistoryRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.historyEvents, newHistoryKey(request.DomainID, request.Execution))
+	return nil
+}
+
+func newHistoryKey(domainID string, execution workflow.WorkflowExecution) historyKey {
+	return historyKey{
+		domainID:   domainID,
+		workflowID: execution.GetWorkflowId(),
+		runID:      execution.GetRunId(),
+	}
+}
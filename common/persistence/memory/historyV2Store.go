@@ -0,0 +1,364 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryHistoryV2Store struct {
+	memoryStore
+}
+
+// newHistoryV2Persistence creates an instance of HistoryManager
+func newHistoryV2Persistence(db *database, logger log.Logger) p.HistoryV2Store {
+	return &memoryHistoryV2Store{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (m *memoryHistoryV2Store) AppendHistoryNodes(ctx context.Context, request *p.InternalAppendHistoryNodesRequest) error {
+	branchInfo := request.BranchInfo
+	beginNodeID := p.GetBeginNodeID(branchInfo)
+
+	if request.NodeID < beginNodeID {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("cannot append to ancestors' nodes"),
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	treeID := branchInfo.GetTreeID()
+	branchID := branchInfo.GetBranchID()
+	nodeKey := historyNodeKey{nodeID: request.NodeID, transactionID: request.TransactionID}
+	nodes := m.db.historyNodes[treeID][branchID]
+	if _, ok := nodes[nodeKey]; ok {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: node ID %v, transaction ID %v", request.NodeID, request.TransactionID),
+		}
+	}
+
+	if request.IsNewBranch {
+		if _, ok := m.db.historyTrees[treeID][branchID]; ok {
+			return &shared.InternalServiceError{
+				Message: fmt.Sprintf("AppendHistoryNodes: branch %v already exists", branchID),
+			}
+		}
+		m.getOrCreateBranches(treeID)[branchID] = &historyTreeRecord{
+			ancestors:   copyAncestors(branchInfo.Ancestors),
+			info:        request.Info,
+			createdTime: time.Now(),
+			inProgress:  false,
+		}
+	}
+
+	if nodes == nil {
+		nodes = make(map[historyNodeKey]*p.DataBlob)
+		m.getOrCreateNodes(treeID)[branchID] = nodes
+	}
+	nodes[nodeKey] = copyDataBlob(request.Events)
+	return nil
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (m *memoryHistoryV2Store) ReadHistoryBranch(ctx context.Context, request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	minNodeID := request.MinNodeID
+
+	if request.NextPageToken != nil && len(request.NextPageToken) > 0 {
+		var lastNodeID int64
+		var err error
+		if lastNodeID, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
+		}
+		minNodeID = lastNodeID + 1
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// only the node with the largest transaction ID is visible for each node ID
+	latest := make(map[int64]historyNodeKey)
+	for key := range m.db.historyNodes[request.TreeID][request.BranchID] {
+		if key.nodeID < minNodeID || key.nodeID >= request.MaxNodeID {
+			continue
+		}
+		if curr, ok := latest[key.nodeID]; !ok || curr.transactionID < key.transactionID {
+			latest[key.nodeID] = key
+		}
+	}
+	if len(latest) == 0 {
+		return &p.InternalReadHistoryBranchResponse{}, nil
+	}
+
+	keys := make([]historyNodeKey, 0, len(latest))
+	for _, key := range latest {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].nodeID < keys[j].nodeID })
+	if len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+	}
+
+	history := make([]*p.DataBlob, 0, len(keys))
+	for _, key := range keys {
+		history = append(history, copyDataBlob(m.db.historyNodes[request.TreeID][request.BranchID][key]))
+	}
+
+	var pagingToken []byte
+	if len(keys) >= request.PageSize {
+		pagingToken = serializePageToken(keys[len(keys)-1].nodeID)
+	}
+	return &p.InternalReadHistoryBranchResponse{
+		History:       history,
+		NextPageToken: pagingToken,
+	}, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch
+// Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
+// The new branch inherits the ancestors of the forking branch up to the forking nodeID (exclusive).
+func (m *memoryHistoryV2Store) ForkHistoryBranch(ctx context.Context, request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+	forkB := request.ForkBranchInfo
+	treeID := forkB.GetTreeID()
+	newAncestors := make([]*shared.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
+
+	beginNodeID := p.GetBeginNodeID(forkB)
+	if beginNodeID >= request.ForkNodeID {
+		// this is the case that new branch's ancestors doesn't include the forking branch
+		for _, br := range forkB.Ancestors {
+			if br.GetEndNodeID() >= request.ForkNodeID {
+				newAncestors = append(newAncestors, &shared.HistoryBranchRange{
+					BranchID:    common.StringPtr(br.GetBranchID()),
+					BeginNodeID: common.Int64Ptr(br.GetBeginNodeID()),
+					EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+				})
+				break
+			}
+			newAncestors = append(newAncestors, copyAncestor(br))
+		}
+	} else {
+		// this is the case the new branch will inherit all ancestors from forking branch
+		newAncestors = append(newAncestors, copyAncestors(forkB.Ancestors)...)
+		newAncestors = append(newAncestors, &shared.HistoryBranchRange{
+			BranchID:    common.StringPtr(forkB.GetBranchID()),
+			BeginNodeID: common.Int64Ptr(beginNodeID),
+			EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+		})
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	branches := m.getOrCreateBranches(treeID)
+	if _, ok := branches[request.NewBranchID]; ok {
+		return nil, &shared.InternalServiceError{
+			Message: fmt.Sprintf("ForkHistoryBranch: branch %v already exists", request.NewBranchID),
+		}
+	}
+	branches[request.NewBranchID] = &historyTreeRecord{
+		ancestors:   copyAncestors(newAncestors),
+		info:        request.Info,
+		createdTime: time.Now(),
+		inProgress:  true,
+	}
+
+	return &p.InternalForkHistoryBranchResponse{
+		NewBranchInfo: shared.HistoryBranch{
+			TreeID:    common.StringPtr(treeID),
+			BranchID:  common.StringPtr(request.NewBranchID),
+			Ancestors: newAncestors,
+		}}, nil
+}
+
+// DeleteHistoryBranch removes a branch
+func (m *memoryHistoryV2Store) DeleteHistoryBranch(ctx context.Context, request *p.InternalDeleteHistoryBranchRequest) error {
+	branch := request.BranchInfo
+	treeID := branch.GetTreeID()
+	brsToDelete := append([]*shared.HistoryBranchRange{}, branch.Ancestors...)
+	beginNodeID := p.GetBeginNodeID(branch)
+	brsToDelete = append(brsToDelete, &shared.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: common.Int64Ptr(beginNodeID),
+	})
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	branches := m.db.historyTrees[treeID]
+	// We won't delete the branch if there is any branch forking in progress. We will return error.
+	for _, b := range branches {
+		if b.inProgress {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("There are branches in progress of forking"),
+			}
+		}
+	}
+
+	// validBRsMaxEndNode is to for each branch range that is being used, we want to know what is the max nodeID referred by other valid branch
+	validBRsMaxEndNode := map[string]int64{}
+	for _, b := range branches {
+		for _, br := range b.ancestors {
+			curr, ok := validBRsMaxEndNode[br.GetBranchID()]
+			if !ok || curr < br.GetEndNodeID() {
+				validBRsMaxEndNode[br.GetBranchID()] = br.GetEndNodeID()
+			}
+		}
+	}
+
+	delete(branches, branch.GetBranchID())
+
+	// for each branch range to delete, we iterate from bottom to up, and delete up to the point according to validBRsEndNode
+	for i := len(brsToDelete) - 1; i >= 0; i-- {
+		br := brsToDelete[i]
+		maxReferredEndNodeID, ok := validBRsMaxEndNode[br.GetBranchID()]
+		if ok {
+			// we can only delete from the maxEndNode and stop here
+			m.deleteNodes(treeID, br.GetBranchID(), maxReferredEndNodeID)
+			break
+		}
+		// No any branch is using this range, we can delete all of it
+		m.deleteNodes(treeID, br.GetBranchID(), br.GetBeginNodeID())
+	}
+	return nil
+}
+
+// CompleteForkBranch update a branch
+func (m *memoryHistoryV2Store) CompleteForkBranch(ctx context.Context, request *p.InternalCompleteForkBranchRequest) error {
+	branch := request.BranchInfo
+	treeID := branch.GetTreeID()
+	branchID := branch.GetBranchID()
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	record, ok := m.db.historyTrees[treeID][branchID]
+	if !ok {
+		return fmt.Errorf("expected 1 row to be affected for tree table, got 0")
+	}
+	if request.Success {
+		record.inProgress = false
+		return nil
+	}
+	// request.Success == false
+	m.deleteNodes(treeID, branchID, 1)
+	delete(m.db.historyTrees[treeID], branchID)
+	return nil
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (m *memoryHistoryV2Store) GetHistoryTree(ctx context.Context, request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	records := m.db.historyTrees[request.TreeID]
+	if len(records) == 0 {
+		return &p.GetHistoryTreeResponse{}, nil
+	}
+
+	branches := make([]*shared.HistoryBranch, 0, len(records))
+	forkingBranches := make([]p.ForkingInProgressBranch, 0)
+	for branchID, record := range records {
+		if record.inProgress {
+			forkingBranches = append(forkingBranches, p.ForkingInProgressBranch{
+				BranchID: branchID,
+				ForkTime: record.createdTime,
+				Info:     record.info,
+			})
+		}
+		branches = append(branches, &shared.HistoryBranch{
+			TreeID:    common.StringPtr(request.TreeID),
+			BranchID:  common.StringPtr(branchID),
+			Ancestors: copyAncestors(record.ancestors),
+		})
+	}
+	return &p.GetHistoryTreeResponse{
+		Branches:                  branches,
+		ForkingInProgressBranches: forkingBranches,
+	}, nil
+}
+
+func (m *memoryHistoryV2Store) getOrCreateBranches(treeID string) map[string]*historyTreeRecord {
+	branches, ok := m.db.historyTrees[treeID]
+	if !ok {
+		branches = make(map[string]*historyTreeRecord)
+		m.db.historyTrees[treeID] = branches
+	}
+	return branches
+}
+
+func (m *memoryHistoryV2Store) getOrCreateNodes(treeID string) map[string]map[historyNodeKey]*p.DataBlob {
+	nodes, ok := m.db.historyNodes[treeID]
+	if !ok {
+		nodes = make(map[string]map[historyNodeKey]*p.DataBlob)
+		m.db.historyNodes[treeID] = nodes
+	}
+	return nodes
+}
+
+// deleteNodes removes all the nodes of a branch starting from minNodeID
+func (m *memoryHistoryV2Store) deleteNodes(treeID string, branchID string, minNodeID int64) {
+	nodes := m.db.historyNodes[treeID][branchID]
+	for key := range nodes {
+		if key.nodeID >= minNodeID {
+			delete(nodes, key)
+		}
+	}
+}
+
+func copyAncestors(ancestors []*shared.HistoryBranchRange) []*shared.HistoryBranchRange {
+	if ancestors == nil {
+		return nil
+	}
+	result := make([]*shared.HistoryBranchRange, len(ancestors))
+	for i, br := range ancestors {
+		result[i] = copyAncestor(br)
+	}
+	return result
+}
+
+func copyAncestor(br *shared.HistoryBranchRange) *shared.HistoryBranchRange {
+	result := &shared.HistoryBranchRange{}
+	if br.BranchID != nil {
+		result.BranchID = common.StringPtr(*br.BranchID)
+	}
+	if br.BeginNodeID != nil {
+		result.BeginNodeID = common.Int64Ptr(*br.BeginNodeID)
+	}
+	if br.EndNodeID != nil {
+		result.EndNodeID = common.Int64Ptr(*br.EndNodeID)
+	}
+	return result
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type memoryMetadataStore struct {
+	memoryStore
+	activeClusterName string
+}
+
+// newMetadataPersistence creates an instance of memoryMetadataStore
+func newMetadataPersistence(db *database, currentClusterName string, logger log.Logger) persistence.MetadataStore {
+	return &memoryMetadataStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		activeClusterName: currentClusterName,
+	}
+}
+
+func (m *memoryMetadataStore) CreateDomain(ctx context.Context, request *persistence.InternalCreateDomainRequest) (*persistence.CreateDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	_, idExists := m.db.domains[request.Info.ID]
+	_, nameExists := m.db.domainNames[request.Info.Name]
+	if idExists || nameExists {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("name: %v", request.Info.Name),
+		}
+	}
+
+	m.db.domains[request.Info.ID] = copyDomain(&persistence.InternalGetDomainResponse{
+		Info:                        request.Info,
+		Config:                      request.Config,
+		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              request.IsGlobalDomain,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		NotificationVersion:         m.db.notificationVersion,
+		FailoverNotificationVersion: persistence.InitialFailoverNotificationVersion,
+	})
+	m.db.domainNames[request.Info.Name] = request.Info.ID
+	m.db.notificationVersion++
+	return &persistence.CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+func (m *memoryMetadataStore) GetDomain(ctx context.Context, request *persistence.GetDomainRequest) (*persistence.InternalGetDomainResponse, error) {
+	id := request.ID
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	case request.Name == "" && request.ID == "":
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if request.Name != "" {
+		id = m.db.domainNames[request.Name]
+	}
+	domain, ok := m.db.domains[id]
+	if !ok {
+		identity := request.Name
+		if len(request.ID) > 0 {
+			identity = request.ID
+		}
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", identity),
+		}
+	}
+	return m.toGetDomainResponse(domain), nil
+}
+
+func (m *memoryMetadataStore) UpdateDomain(ctx context.Context, request *persistence.InternalUpdateDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	domain, ok := m.db.domains[request.Info.ID]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed. Domain %v does not exist.", request.Info.ID),
+		}
+	}
+	if m.db.notificationVersion != request.NotificationVersion {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to update domain metadata. Notification version was %v, expected %v",
+				m.db.notificationVersion, request.NotificationVersion),
+		}
+	}
+
+	delete(m.db.domainNames, domain.Info.Name)
+	m.db.domains[request.Info.ID] = copyDomain(&persistence.InternalGetDomainResponse{
+		Info:                        request.Info,
+		Config:                      request.Config,
+		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              domain.IsGlobalDomain,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		NotificationVersion:         request.NotificationVersion,
+		FailoverNotificationVersion: request.FailoverNotificationVersion,
+		FailoverDrainEndTime:        request.FailoverDrainEndTime,
+	})
+	m.db.domainNames[request.Info.Name] = request.Info.ID
+	m.db.notificationVersion++
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteDomain(ctx context.Context, request *persistence.DeleteDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if domain, ok := m.db.domains[request.ID]; ok {
+		delete(m.db.domainNames, domain.Info.Name)
+		delete(m.db.domains, request.ID)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteDomainByName(ctx context.Context, request *persistence.DeleteDomainByNameRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if id, ok := m.db.domainNames[request.Name]; ok {
+		delete(m.db.domains, id)
+		delete(m.db.domainNames, request.Name)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) GetMetadata(ctx context.Context) (*persistence.GetMetadataResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	return &persistence.GetMetadataResponse{NotificationVersion: m.db.notificationVersion}, nil
+}
+
+func (m *memoryMetadataStore) ListDomains(ctx context.Context, request *persistence.ListDomainsRequest) (*persistence.InternalListDomainsResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	pageToken := string(request.NextPageToken)
+	var ids []string
+	for id := range m.db.domains {
+		if id > pageToken {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > request.PageSize {
+		ids = ids[:request.PageSize]
+	}
+
+	var domains []*persistence.InternalGetDomainResponse
+	for _, id := range ids {
+		domains = append(domains, m.toGetDomainResponse(m.db.domains[id]))
+	}
+
+	resp := &persistence.InternalListDomainsResponse{Domains: domains}
+	if len(ids) >= request.PageSize {
+		resp.NextPageToken = []byte(ids[len(ids)-1])
+	}
+	return resp, nil
+}
+
+func (m *memoryMetadataStore) toGetDomainResponse(domain *persistence.InternalGetDomainResponse) *persistence.InternalGetDomainResponse {
+	resp := copyDomain(domain)
+	resp.TableVersion = persistence.DomainTableVersionV2
+	resp.ReplicationConfig.ActiveClusterName = persistence.GetOrUseDefaultActiveCluster(m.activeClusterName, resp.ReplicationConfig.ActiveClusterName)
+	resp.ReplicationConfig.Clusters = persistence.GetOrUseDefaultClusters(m.activeClusterName, resp.ReplicationConfig.Clusters)
+	return resp
+}
+
+func copyDomain(domain *persistence.InternalGetDomainResponse) *persistence.InternalGetDomainResponse {
+	result := *domain
+
+	info := *domain.Info
+	if domain.Info.Data != nil {
+		info.Data = make(map[string]string, len(domain.Info.Data))
+		for k, v := range domain.Info.Data {
+			info.Data[k] = v
+		}
+	}
+	result.Info = &info
+
+	config := *domain.Config
+	config.BadBinaries = copyDataBlob(domain.Config.BadBinaries)
+	result.Config = &config
+
+	replicationConfig := &persistence.DomainReplicationConfig{}
+	if domain.ReplicationConfig != nil {
+		replicationConfig.ActiveClusterName = domain.ReplicationConfig.ActiveClusterName
+		replicationConfig.Clusters = make([]*persistence.ClusterReplicationConfig, len(domain.ReplicationConfig.Clusters))
+		for i, c := range domain.ReplicationConfig.Clusters {
+			replicationConfig.Clusters[i] = &persistence.ClusterReplicationConfig{ClusterName: c.ClusterName}
+		}
+	}
+	result.ReplicationConfig = replicationConfig
+	return &result
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type memoryShardStore struct {
+	memoryStore
+	currentClusterName string
+}
+
+// newShardPersistence creates an instance of ShardManager
+func newShardPersistence(db *database, currentClusterName string, logger log.Logger) p.ShardStore {
+	return &memoryShardStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		currentClusterName: currentClusterName,
+	}
+}
+
+func (m *memoryShardStore) CreateShard(ctx context.Context, request *p.CreateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.shards[request.ShardInfo.ShardID]; ok {
+		return &p.ShardAlreadyExistError{
+			Msg: fmt.Sprintf("CreateShard operaiton failed. Shard with ID %v already exists.", request.ShardInfo.ShardID),
+		}
+	}
+	m.db.shards[request.ShardInfo.ShardID] = copyShardInfo(request.ShardInfo)
+	return nil
+}
+
+func (m *memoryShardStore) GetShard(ctx context.Context, request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardInfo, ok := m.db.shards[request.ShardID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("GetShard operation failed. Shard with ID %v not found.", request.ShardID),
+		}
+	}
+
+	result := copyShardInfo(shardInfo)
+	if len(result.ClusterTransferAckLevel) == 0 {
+		result.ClusterTransferAckLevel = map[string]int64{
+			m.currentClusterName: result.TransferAckLevel,
+		}
+	}
+	if len(result.ClusterTimerAckLevel) == 0 {
+		result.ClusterTimerAckLevel = map[string]time.Time{
+			m.currentClusterName: result.TimerAckLevel,
+		}
+	}
+	if result.ClusterReplicationLevel == nil {
+		result.ClusterReplicationLevel = make(map[string]int64)
+	}
+	return &p.GetShardResponse{ShardInfo: result}, nil
+}
+
+func (m *memoryShardStore) UpdateShard(ctx context.Context, request *p.UpdateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkShardRangeID(request.ShardInfo.ShardID, request.PreviousRangeID, "update"); err != nil {
+		return err
+	}
+	m.db.shards[request.ShardInfo.ShardID] = copyShardInfo(request.ShardInfo)
+	return nil
+}
+
+// checkShardRangeID verifies the caller still owns the shard, must be called with the database lock held
+func (db *database) checkShardRangeID(shardID int, rangeID int64, operation string) error {
+	shardInfo, ok := db.shards[shardID]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to %v shard with ID %v that does not exist.", operation, shardID),
+		}
+	}
+	if shardInfo.RangeID != rangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to %v shard. Previous range ID: %v; new range ID: %v", operation, rangeID, shardInfo.RangeID),
+		}
+	}
+	return nil
+}
+
+func copyShardInfo(info *p.ShardInfo) *p.ShardInfo {
+	result := *info
+	if info.ClusterTransferAckLevel != nil {
+		result.ClusterTransferAckLevel = make(map[string]int64, len(info.ClusterTransferAckLevel))
+		for k, v := range info.ClusterTransferAckLevel {
+			result.ClusterTransferAckLevel[k] = v
+		}
+	}
+	if info.ClusterTimerAckLevel != nil {
+		result.ClusterTimerAckLevel = make(map[string]time.Time, len(info.ClusterTimerAckLevel))
+		for k, v := range info.ClusterTimerAckLevel {
+			result.ClusterTimerAckLevel[k] = v
+		}
+	}
+	if info.ClusterReplicationLevel != nil {
+		result.ClusterReplicationLevel = make(map[string]int64, len(info.ClusterReplicationLevel))
+		for k, v := range info.ClusterReplicationLevel {
+			result.ClusterReplicationLevel[k] = v
+		}
+	}
+	if info.TransferFailoverLevels != nil {
+		result.TransferFailoverLevels = make(map[string]p.TransferFailoverLevel, len(info.TransferFailoverLevels))
+		for k, v := range info.TransferFailoverLevels {
+			v.DomainIDs = copyStringSet(v.DomainIDs)
+			result.TransferFailoverLevels[k] = v
+		}
+	}
+	if info.TimerFailoverLevels != nil {
+		result.TimerFailoverLevels = make(map[string]p.TimerFailoverLevel, len(info.TimerFailoverLevels))
+		for k, v := range info.TimerFailoverLevels {
+			v.DomainIDs = copyStringSet(v.DomainIDs)
+			result.TimerFailoverLevels[k] = v
+		}
+	}
+	return &result
+}
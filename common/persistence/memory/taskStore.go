@@ -0,0 +1,312 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryTaskStore struct {
+		memoryStore
+	}
+
+	taskListPageToken struct {
+		DomainID string
+		Name     string
+		TaskType int
+	}
+)
+
+// newTaskPersistence creates a new instance of TaskManager
+func newTaskPersistence(db *database, logger log.Logger) persistence.TaskStore {
+	return &memoryTaskStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryTaskStore) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tlInfo, ok := m.db.taskLists[key]
+	if !ok {
+		tlInfo = &persistence.TaskListInfo{
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			Kind:     request.TaskListKind,
+		}
+		m.db.taskLists[key] = tlInfo
+	}
+
+	if request.RangeID > 0 && request.RangeID != tlInfo.RangeID {
+		return nil, &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("leaseTaskList:renew failed:taskList:%v, taskListType:%v, haveRangeID:%v, gotRangeID:%v",
+				request.TaskList, request.TaskType, request.RangeID, tlInfo.RangeID),
+		}
+	}
+
+	now := time.Now()
+	tlInfo.RangeID++
+	tlInfo.LastUpdated = now
+	return &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
+		DomainID:    request.DomainID,
+		Name:        request.TaskList,
+		TaskType:    request.TaskType,
+		RangeID:     tlInfo.RangeID,
+		AckLevel:    tlInfo.AckLevel,
+		Kind:        request.TaskListKind,
+		LastUpdated: now,
+	}}, nil
+}
+
+func (m *memoryTaskStore) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	info := request.TaskListInfo
+	key := taskListKey{domainID: info.DomainID, name: info.Name, taskType: info.TaskType}
+	updated := &persistence.TaskListInfo{
+		DomainID:    info.DomainID,
+		Name:        info.Name,
+		TaskType:    info.TaskType,
+		RangeID:     info.RangeID,
+		AckLevel:    info.AckLevel,
+		Kind:        info.Kind,
+		LastUpdated: time.Now(),
+	}
+	if info.Kind == persistence.TaskListKindSticky {
+		// sticky task lists are created on demand and expire if not used
+		updated.Expiry = stickyTaskListTTL()
+		m.db.taskLists[key] = updated
+		return &persistence.UpdateTaskListResponse{}, nil
+	}
+
+	if err := m.checkTaskListRangeID(key, info.RangeID); err != nil {
+		return nil, err
+	}
+	m.db.taskLists[key] = updated
+	return &persistence.UpdateTaskListResponse{}, nil
+}
+
+func (m *memoryTaskStore) ListTaskList(ctx context.Context, request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
+	var pageToken *taskListPageToken
+	if request.PageToken != nil {
+		pageToken = &taskListPageToken{}
+		if err := json.Unmarshal(request.PageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+
+	m.db.Lock()
+	keys := make([]taskListKey, 0, len(m.db.taskLists))
+	for key := range m.db.taskLists {
+		if pageToken == nil || compareTaskListKeys(key, taskListKey{
+			domainID: pageToken.DomainID,
+			name:     pageToken.Name,
+			taskType: pageToken.TaskType,
+		}) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return compareTaskListKeys(keys[i], keys[j]) < 0 })
+
+	var nextPageToken []byte
+	if len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+		lastKey := keys[len(keys)-1]
+		var err error
+		nextPageToken, err = json.Marshal(&taskListPageToken{
+			DomainID: lastKey.domainID,
+			Name:     lastKey.name,
+			TaskType: lastKey.taskType,
+		})
+		if err != nil {
+			m.db.Unlock()
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+
+	resp := &persistence.ListTaskListResponse{
+		Items:         make([]persistence.TaskListInfo, len(keys)),
+		NextPageToken: nextPageToken,
+	}
+	for i, key := range keys {
+		resp.Items[i] = *m.db.taskLists[key]
+	}
+	m.db.Unlock()
+
+	return resp, nil
+}
+
+func (m *memoryTaskStore) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskListType}
+	tlInfo, ok := m.db.taskLists[key]
+	if !ok || tlInfo.RangeID != request.RangeID {
+		return &workflow.InternalServiceError{Message: fmt.Sprintf("delete failed: 0 rows affected instead of 1")}
+	}
+	delete(m.db.taskLists, key)
+	return nil
+}
+
+func (m *memoryTaskStore) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{
+		domainID: request.TaskListInfo.DomainID,
+		name:     request.TaskListInfo.Name,
+		taskType: request.TaskListInfo.TaskType,
+	}
+	if err := m.checkTaskListRangeID(key, request.TaskListInfo.RangeID); err != nil {
+		return nil, err
+	}
+
+	tasks, ok := m.db.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*persistence.TaskInfo)
+		m.db.tasks[key] = tasks
+	}
+	now := time.Now()
+	for _, v := range request.Tasks {
+		var expiryTime time.Time
+		if v.Data.ScheduleToStartTimeout > 0 {
+			expiryTime = now.Add(time.Second * time.Duration(v.Data.ScheduleToStartTimeout))
+		}
+		tasks[v.TaskID] = &persistence.TaskInfo{
+			DomainID:    v.Data.DomainID,
+			WorkflowID:  v.Data.WorkflowID,
+			RunID:       v.Data.RunID,
+			TaskID:      v.TaskID,
+			ScheduleID:  v.Data.ScheduleID,
+			Expiry:      expiryTime,
+			CreatedTime: now,
+		}
+	}
+	return &persistence.CreateTasksResponse{}, nil
+}
+
+func (m *memoryTaskStore) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	var tasks []*persistence.TaskInfo
+	for taskID, task := range m.db.tasks[key] {
+		if taskID <= request.ReadLevel {
+			continue
+		}
+		if request.MaxReadLevel != nil && taskID > *request.MaxReadLevel {
+			continue
+		}
+		info := *task
+		info.DomainID = request.DomainID
+		tasks = append(tasks, &info)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	if len(tasks) > request.BatchSize {
+		tasks = tasks[:request.BatchSize]
+	}
+	if tasks == nil {
+		tasks = []*persistence.TaskInfo{}
+	}
+	return &persistence.GetTasksResponse{Tasks: tasks}, nil
+}
+
+func (m *memoryTaskStore) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	taskList := request.TaskList
+	key := taskListKey{domainID: taskList.DomainID, name: taskList.Name, taskType: taskList.TaskType}
+	delete(m.db.tasks[key], request.TaskID)
+	return nil
+}
+
+func (m *memoryTaskStore) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskType}
+	tasks := m.db.tasks[key]
+	var taskIDs []int64
+	for taskID := range tasks {
+		if taskID <= request.TaskID {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	if len(taskIDs) > request.Limit {
+		taskIDs = taskIDs[:request.Limit]
+	}
+	for _, taskID := range taskIDs {
+		delete(tasks, taskID)
+	}
+	return len(taskIDs), nil
+}
+
+// checkTaskListRangeID verifies the caller still owns the task list, must be called with the database lock held
+func (m *memoryTaskStore) checkTaskListRangeID(key taskListKey, oldRangeID int64) error {
+	tlInfo, ok := m.db.taskLists[key]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to lock task list. Error: task list %v of type %v does not exist", key.name, key.taskType),
+		}
+	}
+	if tlInfo.RangeID != oldRangeID {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("Task list range ID was %v when it was should have been %v", tlInfo.RangeID, oldRangeID),
+		}
+	}
+	return nil
+}
+
+func compareTaskListKeys(a taskListKey, b taskListKey) int {
+	switch {
+	case a.domainID != b.domainID:
+		return strings.Compare(a.domainID, b.domainID)
+	case a.name != b.name:
+		return strings.Compare(a.name, b.name)
+	default:
+		return a.taskType - b.taskType
+	}
+}
+
+func stickyTaskListTTL() time.Time {
+	return time.Now().Add(24 * time.Hour)
+}
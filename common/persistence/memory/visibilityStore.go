@@ -0,0 +1,256 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryVisibilityStore struct {
+		memoryStore
+	}
+
+	visibilityPageToken struct {
+		Time  time.Time
+		RunID string
+	}
+)
+
+// newVisibilityPersistence creates an instance of VisibilityStore
+func newVisibilityPersistence(db *database, logger log.Logger) p.VisibilityStore {
+	return &memoryVisibilityStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (s *memoryVisibilityStore) RecordWorkflowExecutionStarted(ctx context.Context, request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	key := visibilityKey{domainID: request.DomainUUID, runID: request.RunID}
+	if _, ok := s.db.visibility[key]; ok {
+		// the record may already be closed, never overwrite it
+		return nil
+	}
+	s.db.visibility[key] = &visibilityRecord{
+		domainID:         request.DomainUUID,
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        time.Unix(0, request.StartTimestamp),
+		executionTime:    time.Unix(0, request.ExecutionTimestamp),
+		memo:             copyDataBlob(request.Memo),
+	}
+	return nil
+}
+
+func (s *memoryVisibilityStore) RecordWorkflowExecutionClosed(ctx context.Context, request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	s.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: request.RunID}] = &visibilityRecord{
+		domainID:         request.DomainUUID,
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        time.Unix(0, request.StartTimestamp),
+		executionTime:    time.Unix(0, request.ExecutionTimestamp),
+		memo:             copyDataBlob(request.Memo),
+		closed:           true,
+		closeTime:        time.Unix(0, request.CloseTimestamp),
+		closeStatus:      request.Status,
+		historyLength:    request.HistoryLength,
+	}
+	return nil
+}
+
+func (s *memoryVisibilityStore) ListOpenWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(request, false, func(*visibilityRecord) bool {
+		return true
+	})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(request, true, func(*visibilityRecord) bool {
+		return true
+	})
+}
+
+func (s *memoryVisibilityStore) ListOpenWorkflowExecutionsByType(ctx context.Context, request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, false, func(record *visibilityRecord) bool {
+		return record.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutionsByType(ctx context.Context, request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true, func(record *visibilityRecord) bool {
+		return record.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (s *memoryVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, false, func(record *visibilityRecord) bool {
+		return record.workflowID == request.WorkflowID
+	})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true, func(record *visibilityRecord) bool {
+		return record.workflowID == request.WorkflowID
+	})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true, func(record *visibilityRecord) bool {
+		return record.closeStatus == request.Status
+	})
+}
+
+func (s *memoryVisibilityStore) GetClosedWorkflowExecution(ctx context.Context, request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	execution := request.Execution
+	record, ok := s.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: execution.GetRunId()}]
+	if !ok || !record.closed {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &p.InternalGetClosedWorkflowExecutionResponse{Execution: record.toInfo()}, nil
+}
+
+func (s *memoryVisibilityStore) DeleteWorkflowExecution(ctx context.Context, request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	delete(s.db.visibility, visibilityKey{domainID: request.DomainID, runID: request.RunID})
+	return nil
+}
+
+func (s *memoryVisibilityStore) ListWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (s *memoryVisibilityStore) ScanWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (s *memoryVisibilityStore) CountWorkflowExecutions(ctx context.Context, request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+// listWorkflowExecutions returns the records ordered by start time (newest first) and run ID
+func (s *memoryVisibilityStore) listWorkflowExecutions(request *p.ListWorkflowExecutionsRequest, closed bool,
+	filter func(record *visibilityRecord) bool) (*p.InternalListWorkflowExecutionsResponse, error) {
+	readLevel := &visibilityPageToken{Time: time.Unix(0, request.LatestStartTime), RunID: ""}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, err
+		}
+	}
+	minStartTime := time.Unix(0, request.EarliestStartTime)
+
+	s.db.Lock()
+	var records []*visibilityRecord
+	for _, record := range s.db.visibility {
+		if record.domainID != request.DomainUUID || record.closed != closed || !filter(record) {
+			continue
+		}
+		if record.startTime.Before(minStartTime) || record.startTime.After(readLevel.Time) {
+			continue
+		}
+		// RunID condition is needed for correct pagination
+		if record.runID > readLevel.RunID || record.startTime.Before(readLevel.Time) {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].startTime.Equal(records[j].startTime) {
+			return records[i].startTime.After(records[j].startTime)
+		}
+		return records[i].runID < records[j].runID
+	})
+	if len(records) > request.PageSize {
+		records = records[:request.PageSize]
+	}
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(records))
+	for i, record := range records {
+		infos[i] = record.toInfo()
+	}
+	s.db.Unlock()
+
+	if len(records) == 0 {
+		return &p.InternalListWorkflowExecutionsResponse{}, nil
+	}
+
+	var nextPageToken []byte
+	lastRecord := records[len(records)-1]
+	if lastRecord.startTime.After(minStartTime) {
+		var err error
+		nextPageToken, err = json.Marshal(&visibilityPageToken{
+			Time:  lastRecord.startTime,
+			RunID: lastRecord.runID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (r *visibilityRecord) toInfo() *p.VisibilityWorkflowExecutionInfo {
+	executionTime := r.executionTime
+	if executionTime.UnixNano() == 0 {
+		executionTime = r.startTime
+	}
+	info := &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:    r.workflowID,
+		RunID:         r.runID,
+		TypeName:      r.workflowTypeName,
+		StartTime:     r.startTime,
+		ExecutionTime: executionTime,
+		Memo:          copyDataBlob(r.memo),
+	}
+	if r.closed {
+		status := r.closeStatus
+		info.Status = &status
+		info.CloseTime = r.closeTime
+		info.HistoryLength = r.historyLength
+	}
+	return info
+}
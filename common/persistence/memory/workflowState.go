@@ -0,0 +1,250 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// The copy helpers below make sure that callers never share memory with the
+// records held by the database, so that mutating a request or a response
+// after the call cannot change what is stored
+
+func newExecutionRecord() *executionRecord {
+	return &executionRecord{
+		activityInfos:            make(map[int64]*p.InternalActivityInfo),
+		timerInfos:               make(map[string]*p.TimerInfo),
+		childExecutionInfos:      make(map[int64]*p.InternalChildExecutionInfo),
+		requestCancelInfos:       make(map[int64]*p.RequestCancelInfo),
+		signalInfos:              make(map[int64]*p.SignalInfo),
+		signalRequestedIDs:       make(map[string]struct{}),
+		bufferedReplicationTasks: make(map[int64]*p.InternalBufferedReplicationTask),
+	}
+}
+
+// toMutableState returns a copy of the record as a mutable state
+func (r *executionRecord) toMutableState() *p.InternalWorkflowMutableState {
+	state := &p.InternalWorkflowMutableState{
+		ExecutionInfo:            copyExecutionInfo(r.executionInfo),
+		ReplicationState:         copyReplicationState(r.replicationState),
+		ActivitInfos:             make(map[int64]*p.InternalActivityInfo, len(r.activityInfos)),
+		TimerInfos:               make(map[string]*p.TimerInfo, len(r.timerInfos)),
+		ChildExecutionInfos:      make(map[int64]*p.InternalChildExecutionInfo, len(r.childExecutionInfos)),
+		RequestCancelInfos:       make(map[int64]*p.RequestCancelInfo, len(r.requestCancelInfos)),
+		SignalInfos:              make(map[int64]*p.SignalInfo, len(r.signalInfos)),
+		SignalRequestedIDs:       copyStringSet(r.signalRequestedIDs),
+		BufferedEvents:           make([]*p.DataBlob, 0, len(r.bufferedEvents)),
+		BufferedReplicationTasks: make(map[int64]*p.InternalBufferedReplicationTask, len(r.bufferedReplicationTasks)),
+		Checksum:                 r.checksum,
+	}
+	state.Checksum.Value = copyBytes(r.checksum.Value)
+	for k, v := range r.activityInfos {
+		state.ActivitInfos[k] = copyActivityInfo(v)
+	}
+	for k, v := range r.timerInfos {
+		state.TimerInfos[k] = copyTimerInfo(v)
+	}
+	for k, v := range r.childExecutionInfos {
+		state.ChildExecutionInfos[k] = copyChildExecutionInfo(v)
+	}
+	for k, v := range r.requestCancelInfos {
+		state.RequestCancelInfos[k] = copyRequestCancelInfo(v)
+	}
+	for k, v := range r.signalInfos {
+		state.SignalInfos[k] = copySignalInfo(v)
+	}
+	for _, v := range r.bufferedEvents {
+		state.BufferedEvents = append(state.BufferedEvents, copyDataBlob(v))
+	}
+	for k, v := range r.bufferedReplicationTasks {
+		state.BufferedReplicationTasks[k] = copyBufferedReplicationTask(v)
+	}
+	return state
+}
+
+func (r *executionRecord) upsertActivityInfos(activityInfos []*p.InternalActivityInfo, deleteInfos []int64) {
+	for _, v := range activityInfos {
+		r.activityInfos[v.ScheduleID] = copyActivityInfo(v)
+	}
+	for _, v := range deleteInfos {
+		delete(r.activityInfos, v)
+	}
+}
+
+func (r *executionRecord) upsertTimerInfos(timerInfos []*p.TimerInfo, deleteInfos []string) {
+	for _, v := range timerInfos {
+		r.timerInfos[v.TimerID] = copyTimerInfo(v)
+	}
+	for _, v := range deleteInfos {
+		delete(r.timerInfos, v)
+	}
+}
+
+func (r *executionRecord) upsertChildExecutionInfos(childExecutionInfos []*p.InternalChildExecutionInfo, deleteInfo *int64) {
+	for _, v := range childExecutionInfos {
+		r.childExecutionInfos[v.InitiatedID] = copyChildExecutionInfo(v)
+	}
+	if deleteInfo != nil {
+		delete(r.childExecutionInfos, *deleteInfo)
+	}
+}
+
+func (r *executionRecord) upsertRequestCancelInfos(requestCancelInfos []*p.RequestCancelInfo, deleteInfo *int64) {
+	for _, v := range requestCancelInfos {
+		r.requestCancelInfos[v.InitiatedID] = copyRequestCancelInfo(v)
+	}
+	if deleteInfo != nil {
+		delete(r.requestCancelInfos, *deleteInfo)
+	}
+}
+
+func (r *executionRecord) upsertSignalInfos(signalInfos []*p.SignalInfo, deleteInfo *int64) {
+	for _, v := range signalInfos {
+		r.signalInfos[v.InitiatedID] = copySignalInfo(v)
+	}
+	if deleteInfo != nil {
+		delete(r.signalInfos, *deleteInfo)
+	}
+}
+
+func (r *executionRecord) upsertSignalRequestedIDs(signalRequestedIDs []string, deleteID string) {
+	for _, v := range signalRequestedIDs {
+		r.signalRequestedIDs[v] = struct{}{}
+	}
+	if deleteID != "" {
+		delete(r.signalRequestedIDs, deleteID)
+	}
+}
+
+func (r *executionRecord) updateBufferedEvents(batch *p.DataBlob, clear bool) {
+	if clear {
+		r.bufferedEvents = nil
+		return
+	}
+	if batch == nil {
+		return
+	}
+	r.bufferedEvents = append(r.bufferedEvents, copyDataBlob(batch))
+}
+
+func (r *executionRecord) updateBufferedReplicationTasks(newTask *p.InternalBufferedReplicationTask, deleteTask *int64) {
+	if newTask != nil {
+		r.bufferedReplicationTasks[newTask.FirstEventID] = copyBufferedReplicationTask(newTask)
+	}
+	if deleteTask != nil {
+		delete(r.bufferedReplicationTasks, *deleteTask)
+	}
+}
+
+func (r *currentExecutionRecord) toResponse() *p.GetCurrentExecutionResponse {
+	return &p.GetCurrentExecutionResponse{
+		StartRequestID:   r.createRequestID,
+		RunID:            r.runID,
+		State:            r.state,
+		CloseStatus:      r.closeStatus,
+		LastWriteVersion: r.lastWriteVersion,
+	}
+}
+
+// getStartAndLastWriteVersion returns the versions recorded in the current execution record
+func getStartAndLastWriteVersion(replicationState *p.ReplicationState) (int64, int64) {
+	if replicationState == nil {
+		return common.EmptyVersion, common.EmptyVersion
+	}
+	return replicationState.StartVersion, replicationState.LastWriteVersion
+}
+
+func copyExecutionInfo(info *p.InternalWorkflowExecutionInfo) *p.InternalWorkflowExecutionInfo {
+	result := *info
+	result.CompletionEvent = copyDataBlob(info.CompletionEvent)
+	result.AutoResetPoints = copyDataBlob(info.AutoResetPoints)
+	result.ExecutionContext = copyBytes(info.ExecutionContext)
+	result.BranchToken = copyBytes(info.BranchToken)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	if info.SearchAttributes != nil {
+		result.SearchAttributes = make(map[string][]byte, len(info.SearchAttributes))
+		for k, v := range info.SearchAttributes {
+			result.SearchAttributes[k] = copyBytes(v)
+		}
+	}
+	return &result
+}
+
+func copyReplicationState(state *p.ReplicationState) *p.ReplicationState {
+	if state == nil {
+		return nil
+	}
+	result := *state
+	result.LastReplicationInfo = copyReplicationInfo(state.LastReplicationInfo)
+	return &result
+}
+
+func copyReplicationInfo(info map[string]*p.ReplicationInfo) map[string]*p.ReplicationInfo {
+	if info == nil {
+		return nil
+	}
+	result := make(map[string]*p.ReplicationInfo, len(info))
+	for k, v := range info {
+		result[k] = &p.ReplicationInfo{Version: v.Version, LastEventID: v.LastEventID}
+	}
+	return result
+}
+
+func copyActivityInfo(info *p.InternalActivityInfo) *p.InternalActivityInfo {
+	result := *info
+	result.ScheduledEvent = copyDataBlob(info.ScheduledEvent)
+	result.StartedEvent = copyDataBlob(info.StartedEvent)
+	result.Details = copyBytes(info.Details)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	return &result
+}
+
+func copyTimerInfo(info *p.TimerInfo) *p.TimerInfo {
+	result := *info
+	return &result
+}
+
+func copyChildExecutionInfo(info *p.InternalChildExecutionInfo) *p.InternalChildExecutionInfo {
+	result := *info
+	result.InitiatedEvent = copyDataBlob(info.InitiatedEvent)
+	result.StartedEvent = copyDataBlob(info.StartedEvent)
+	return &result
+}
+
+func copyRequestCancelInfo(info *p.RequestCancelInfo) *p.RequestCancelInfo {
+	result := *info
+	return &result
+}
+
+func copySignalInfo(info *p.SignalInfo) *p.SignalInfo {
+	result := *info
+	result.Input = copyBytes(info.Input)
+	result.Control = copyBytes(info.Control)
+	return &result
+}
+
+func copyBufferedReplicationTask(task *p.InternalBufferedReplicationTask) *p.InternalBufferedReplicationTask {
+	result := *task
+	result.History = copyDataBlob(task.History)
+	result.NewRunHistory = copyDataBlob(task.NewRunHistory)
+	return &result
+}
@@ -23,11 +23,14 @@ package persistence
 import (
 	"sync"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/tokenbucket"
@@ -55,6 +58,8 @@ type (
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
 		NewVisibilityManager() (p.VisibilityManager, error)
+		// NewClusterMembershipManager returns a new cluster membership manager
+		NewClusterMembershipManager() (p.ClusterMembershipManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -79,6 +84,8 @@ type (
 		NewExecutionStore(shardID int) (p.ExecutionStore, error)
 		// NewVisibilityStore returns a new visibility store
 		NewVisibilityStore() (p.VisibilityStore, error)
+		// NewClusterMembershipStore returns a new cluster membership store
+		NewClusterMembershipStore() (p.ClusterMembershipStore, error)
 	}
 	// Datastore represents a datastore
 	Datastore struct {
@@ -89,6 +96,8 @@ type (
 		sync.RWMutex
 		config        *config.Persistence
 		metricsClient metrics.Client
+		tracer        opentracing.Tracer
+		keyProvider   p.KeyProvider
 		logger        log.Logger
 		datastores    map[storeType]Datastore
 	}
@@ -106,6 +115,7 @@ const (
 	storeTypeMetadata
 	storeTypeExecution
 	storeTypeVisibility
+	storeTypeClusterMembership
 )
 
 const (
@@ -118,7 +128,7 @@ const (
 )
 
 var storeTypes = []storeType{
-	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility}
+	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility, storeTypeClusterMembership}
 
 // New returns an implementation of factory that vends persistence objects based on
 // specified configuration. This factory takes as input a config.Persistence object
@@ -126,17 +136,25 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics and record the spans
+// of the tracer automatically
 func New(
 	cfg *config.Persistence,
 	clusterName string,
 	metricsClient metrics.Client,
+	tracer opentracing.Tracer,
 	logger log.Logger) Factory {
 	factory := &factoryImpl{
 		config:        cfg,
 		metricsClient: metricsClient,
+		tracer:        tracer,
 		logger:        logger,
 	}
+	keyProvider, err := p.NewKeyProvider(cfg.Encryption)
+	if err != nil {
+		logger.Fatal("unable to create encryption key provider", tag.Error(err))
+	}
+	factory.keyProvider = keyProvider
 	limiters := buildRatelimiters(cfg)
 	factory.init(clusterName, limiters)
 	return factory
@@ -149,11 +167,14 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.config.FaultInjection != nil {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 	return result, nil
 }
@@ -165,11 +186,14 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.config.FaultInjection != nil {
+		result = p.NewShardPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 	return result, nil
 
@@ -182,12 +206,15 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryManagerImpl(store, f.logger, f.config.TransactionSizeLimit, f.metricsClient, f.keyProvider)
+	if f.config.FaultInjection != nil {
+		result = p.NewHistoryPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 	return result, nil
 }
@@ -199,12 +226,15 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit, f.metricsClient, f.keyProvider)
+	if f.config.FaultInjection != nil {
+		result = p.NewHistoryV2PersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewHistoryV2PersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewHistoryV2PersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 	return result, nil
 }
@@ -228,11 +258,14 @@ func (f *factoryImpl) NewMetadataManager(version MetadataVersion) (p.MetadataMan
 	}
 
 	result := p.NewMetadataManagerImpl(store, f.logger)
+	if f.config.FaultInjection != nil {
+		result = p.NewMetadataPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 	return result, nil
 }
@@ -244,12 +277,15 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.logger, f.metricsClient, f.keyProvider)
+	if f.config.FaultInjection != nil {
+		result = p.NewWorkflowExecutionPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 	return result, nil
 }
@@ -266,7 +302,10 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 		store, err = cassandra.NewVisibilityPersistenceV2(store, f.getCassandraConfig(), f.logger)
 	}
 
-	result := p.NewVisibilityManagerImpl(store, f.logger)
+	result := p.NewVisibilityManagerImpl(store, f.logger, f.keyProvider)
+	if f.config.FaultInjection != nil {
+		result = p.NewVisibilityPersistenceFaultInjectionClient(result, f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -274,12 +313,26 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 		result = p.NewVisibilitySamplingClient(result, visConfig, f.metricsClient, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewVisibilityPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewVisibilityPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
 	}
 
 	return result, nil
 }
 
+// NewClusterMembershipManager returns a new cluster membership manager. Membership heartbeats
+// are neither rate limited nor fault injected, a dropped heartbeat evicts the host from the ring
+func (f *factoryImpl) NewClusterMembershipManager() (p.ClusterMembershipManager, error) {
+	ds := f.datastores[storeTypeClusterMembership]
+	result, err := ds.factory.NewClusterMembershipStore()
+	if err != nil {
+		return nil, err
+	}
+	if f.metricsClient != nil {
+		result = p.NewClusterMembershipPersistenceMetricsClient(result, f.metricsClient, f.logger, f.tracer)
+	}
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
@@ -288,7 +341,7 @@ func (f *factoryImpl) Close() {
 
 func (f *factoryImpl) isCassandra() bool {
 	cfg := f.config
-	return cfg.DataStores[cfg.VisibilityStore].SQL == nil
+	return cfg.DataStores[cfg.VisibilityStore].Cassandra != nil
 }
 
 func (f *factoryImpl) getCassandraConfig() *config.Cassandra {
@@ -304,9 +357,11 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]tokenbucket.T
 	case defaultCfg.Cassandra != nil:
 		defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, clusterName, f.logger)
 	case defaultCfg.SQL != nil:
-		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.logger)
+		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, f.config.NumHistoryShards, clusterName, f.logger)
+	case defaultCfg.Memory != nil:
+		defaultDataStore.factory = memory.NewFactory(*defaultCfg.Memory, clusterName, f.logger)
 	default:
-		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
+		f.logger.Fatal("invalid config: one of cassandra, sql or memory params must be specified")
 	}
 
 	for _, st := range storeTypes {
@@ -318,12 +373,14 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]tokenbucket.T
 	visibilityCfg := f.config.DataStores[f.config.VisibilityStore]
 	visibilityDataStore := Datastore{ratelimit: limiters[f.config.VisibilityStore]}
 	switch {
-	case defaultCfg.Cassandra != nil:
+	case visibilityCfg.Cassandra != nil:
 		visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, clusterName, f.logger)
 	case visibilityCfg.SQL != nil:
-		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.logger)
+		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, f.config.NumHistoryShards, clusterName, f.logger)
+	case visibilityCfg.Memory != nil:
+		visibilityDataStore.factory = memory.NewFactory(*visibilityCfg.Memory, clusterName, f.logger)
 	default:
-		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
+		f.logger.Fatal("invalid config: one of cassandra, sql or memory params must be specified")
 	}
 
 	f.datastores[storeTypeVisibility] = visibilityDataStore
@@ -339,6 +396,9 @@ func buildRatelimiters(cfg *config.Persistence) map[string]tokenbucket.TokenBuck
 		if ds.SQL != nil {
 			qps = ds.SQL.MaxQPS
 		}
+		if ds.Memory != nil {
+			qps = ds.Memory.MaxQPS
+		}
 		if qps > 0 {
 			result[dsName] = tokenbucket.New(qps, clock.NewRealTimeSource())
 		}
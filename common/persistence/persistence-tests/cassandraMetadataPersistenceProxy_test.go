@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
@@ -100,13 +101,13 @@ func (s *metadataPersistenceProxySuite) TestDomainV1V2Migration() {
 	clusterActive := "some random active cluster name"
 	clusters := []*p.ClusterReplicationConfig{{ClusterName: clusterActive}}
 
-	resp, err := s.MetadataProxy.GetDomain(&p.GetDomainRequest{
+	resp, err := s.MetadataProxy.GetDomain(context.Background(), &p.GetDomainRequest{
 		Name: name,
 	})
 	s.Nil(resp)
 	s.NotNil(err)
 	s.IsType(&gen.EntityNotExistsError{}, err)
-	resp, err = s.MetadataProxy.GetDomain(&p.GetDomainRequest{
+	resp, err = s.MetadataProxy.GetDomain(context.Background(), &p.GetDomainRequest{
 		ID: id,
 	})
 	s.Nil(resp)
@@ -114,7 +115,7 @@ func (s *metadataPersistenceProxySuite) TestDomainV1V2Migration() {
 	s.IsType(&gen.EntityNotExistsError{}, err)
 
 	// create the domain using v1 metadata manager
-	createResp, err1 := s.MetadataManager.CreateDomain(&p.CreateDomainRequest{
+	createResp, err1 := s.MetadataManager.CreateDomain(context.Background(), &p.CreateDomainRequest{
 		Info: &p.DomainInfo{
 			ID:          id,
 			Name:        name,
@@ -143,7 +144,7 @@ func (s *metadataPersistenceProxySuite) TestDomainV1V2Migration() {
 	s.Equal(id, createResp.ID)
 
 	// use proxy to get the domain
-	resp, err = s.MetadataProxy.GetDomain(&p.GetDomainRequest{
+	resp, err = s.MetadataProxy.GetDomain(context.Background(), &p.GetDomainRequest{
 		Name: name,
 	})
 	s.Nil(err)
@@ -172,14 +173,14 @@ func (s *metadataPersistenceProxySuite) TestDomainV1V2Migration() {
 	resp.TableVersion = p.DomainTableVersionV2
 
 	// use proxy to get the domain
-	respV2, err := s.MetadataManagerV2.GetDomain(&p.GetDomainRequest{
+	respV2, err := s.MetadataManagerV2.GetDomain(context.Background(), &p.GetDomainRequest{
 		Name: name,
 	})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(resp, respV2)
 
-	respV2, err = s.MetadataManagerV2.GetDomain(&p.GetDomainRequest{
+	respV2, err = s.MetadataManagerV2.GetDomain(context.Background(), &p.GetDomainRequest{
 		ID: id,
 	})
 	s.Nil(err)
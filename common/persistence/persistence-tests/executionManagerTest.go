@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -36,6 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/cluster"
 	p "github.com/uber/cadence/common/persistence"
 )
@@ -108,11 +110,11 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionStateCloseStatus() {
 	req.State = p.WorkflowStateCreated
 	for _, invalidCloseStatus := range invalidCloseStatuses {
 		req.CloseStatus = invalidCloseStatus
-		_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+		_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 		s.IsType(&gen.InternalServiceError{}, err)
 	}
 	req.CloseStatus = p.WorkflowCloseStatusNone
-	_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 	info, err := s.GetWorkflowExecutionInfo(domainID, workflowExecutionStatusCreated)
 	s.Nil(err)
@@ -127,11 +129,11 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionStateCloseStatus() {
 	req.State = p.WorkflowStateRunning
 	for _, invalidCloseStatus := range invalidCloseStatuses {
 		req.CloseStatus = invalidCloseStatus
-		_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+		_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 		s.IsType(&gen.InternalServiceError{}, err)
 	}
 	req.CloseStatus = p.WorkflowCloseStatusNone
-	_, err = s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 	info, err = s.GetWorkflowExecutionInfo(domainID, workflowExecutionStatusRunning)
 	s.Nil(err)
@@ -146,11 +148,11 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionStateCloseStatus() {
 	req.State = p.WorkflowStateCompleted
 	for _, invalidCloseStatus := range invalidCloseStatuses {
 		req.CloseStatus = invalidCloseStatus
-		_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+		_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 		s.IsType(&gen.InternalServiceError{}, err)
 	}
 	req.CloseStatus = p.WorkflowCloseStatusNone
-	_, err = s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.IsType(&gen.InternalServiceError{}, err)
 }
 
@@ -192,7 +194,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflowExecutionStateCloseStatus() {
 
 	req.State = p.WorkflowStateCreated
 	req.CloseStatus = p.WorkflowCloseStatusNone
-	_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 	info, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
 	s.Nil(err)
@@ -202,7 +204,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflowExecutionStateCloseStatus() {
 	updatedInfo := copyWorkflowExecutionInfo(info.ExecutionInfo)
 	updatedInfo.State = p.WorkflowStateRunning
 	updatedInfo.CloseStatus = p.WorkflowCloseStatusNone
-	_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
 		ExecutionInfo: updatedInfo,
 		Condition:     nextEventID,
 		RangeID:       s.ShardInfo.RangeID,
@@ -217,7 +219,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflowExecutionStateCloseStatus() {
 	updatedInfo.State = p.WorkflowStateRunning
 	for _, closeStatus := range closeStatuses {
 		updatedInfo.CloseStatus = closeStatus
-		_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+		_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
 			ExecutionInfo: updatedInfo,
 			Condition:     nextEventID,
 			RangeID:       s.ShardInfo.RangeID,
@@ -228,7 +230,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflowExecutionStateCloseStatus() {
 	updatedInfo = copyWorkflowExecutionInfo(info.ExecutionInfo)
 	updatedInfo.State = p.WorkflowStateCompleted
 	updatedInfo.CloseStatus = p.WorkflowCloseStatusNone
-	_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
 		ExecutionInfo: updatedInfo,
 		Condition:     nextEventID,
 		RangeID:       s.ShardInfo.RangeID,
@@ -237,7 +239,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflowExecutionStateCloseStatus() {
 
 	for _, closeStatus := range closeStatuses {
 		updatedInfo.CloseStatus = closeStatus
-		_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+		_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
 			ExecutionInfo: updatedInfo,
 			Condition:     nextEventID,
 			RangeID:       s.ShardInfo.RangeID,
@@ -280,9 +282,9 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionBrandNew() {
 		CreateWorkflowMode:   p.CreateWorkflowModeBrandNew,
 	}
 
-	_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.Nil(err)
-	_, err = s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.NotNil(err)
 	alreadyStartedErr, ok := err.(*p.WorkflowExecutionAlreadyStartedError)
 	s.True(ok, "err is not WorkflowExecutionAlreadyStartedError")
@@ -292,6 +294,61 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionBrandNew() {
 	s.Equal(p.WorkflowStateRunning, alreadyStartedErr.State)
 }
 
+// TestWorkflowExecutionChecksum test
+func (s *ExecutionManagerSuite) TestWorkflowExecutionChecksum() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("workflow-execution-checksum-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	nextEventID := int64(3)
+	createChecksum := checksum.GenerateCRC32([]byte("create"), 1)
+
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
+		RequestID:            uuid.New(),
+		DomainID:             domainID,
+		Execution:            workflowExecution,
+		TaskList:             "some random tasklist",
+		WorkflowTypeName:     "some random workflow type",
+		WorkflowTimeout:      10,
+		DecisionTimeoutValue: 14,
+		State:                p.WorkflowStateRunning,
+		CloseStatus:          p.WorkflowCloseStatusNone,
+		NextEventID:          nextEventID,
+		LastProcessedEvent:   0,
+		RangeID:              s.ShardInfo.RangeID,
+		CreateWorkflowMode:   p.CreateWorkflowModeBrandNew,
+		Checksum:             createChecksum,
+	})
+	s.NoError(err)
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err)
+	s.Equal(createChecksum, state.Checksum)
+
+	updateChecksum := checksum.GenerateCRC32([]byte("update"), 1)
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
+		ExecutionInfo: copyWorkflowExecutionInfo(state.ExecutionInfo),
+		Condition:     nextEventID,
+		RangeID:       s.ShardInfo.RangeID,
+		Checksum:      updateChecksum,
+	})
+	s.NoError(err)
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err)
+	s.Equal(updateChecksum, state.Checksum)
+
+	// an update without checksum clears the previously stored checksum
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
+		ExecutionInfo: copyWorkflowExecutionInfo(state.ExecutionInfo),
+		Condition:     nextEventID,
+		RangeID:       s.ShardInfo.RangeID,
+	})
+	s.NoError(err)
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err)
+	s.True(state.Checksum.IsEmpty())
+}
+
 // TestCreateWorkflowExecutionRunIDReuseWithReplication test
 func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplication() {
 	domainID := uuid.New()
@@ -326,7 +383,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	}
 
 	// try to create a workflow while the current workflow is still running
-	_, err := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -375,7 +432,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 		LastWriteVersion: version,
 		LastWriteEventID: updatedInfo.NextEventID - 1,
 	}
-	_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
 		ExecutionInfo:       updatedInfo,
 		TransferTasks:       nil,
 		TimerTasks:          nil,
@@ -395,7 +452,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	s.Equal(testResetPoints.String(), state.ExecutionInfo.AutoResetPoints.String())
 
 	// try to create a workflow while the current workflow is complete but run ID is wrong
-	_, err = s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -417,7 +474,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	s.IsType(&p.CurrentWorkflowConditionFailedError{}, err, err.Error())
 
 	// try to create a workflow while the current workflow is complete but version is wrong
-	_, err = s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -439,7 +496,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	s.IsType(&p.CurrentWorkflowConditionFailedError{}, err, err.Error())
 
 	// try to create a workflow while the current workflow is complete with run ID & version correct
-	_, err = s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -500,7 +557,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithoutRepl
 	}
 	// this create should work since we are relying the business logic in history engine
 	// to check whether the existing running workflow has finished
-	_, err3 := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err3 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -601,7 +658,7 @@ func (s *ExecutionManagerSuite) TestPersistenceStartWorkflow() {
 	s.Equal(common.EmptyVersion, startedErr.LastWriteVersion, startedErr.Msg)
 	s.Empty(task1, "Expected empty task identifier.")
 
-	response, err2 := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	response, err2 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:            uuid.New(),
 		DomainID:             domainID,
 		Execution:            workflowExecution,
@@ -664,7 +721,7 @@ func (s *ExecutionManagerSuite) TestPersistenceStartWorkflowWithReplicationState
 	s.Equal(common.EmptyVersion, startedErr.LastWriteVersion, startedErr.Msg)
 	s.Empty(task1, "Expected empty task identifier.")
 
-	response, err2 := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	response, err2 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:            uuid.New(),
 		DomainID:             domainID,
 		Execution:            workflowExecution,
@@ -770,7 +827,7 @@ func (s *ExecutionManagerSuite) TestGetWorkflow() {
 		SearchAttributes:        testSearchAttr,
 	}
 
-	createResp, err := s.ExecutionManager.CreateWorkflowExecution(createReq)
+	createResp, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), createReq)
 	s.NoError(err)
 	s.NotNil(createResp, "Expected non empty task identifier.")
 
@@ -1252,7 +1309,7 @@ func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	s.NoError(err0)
 	s.NotNil(task0, "Expected non empty task identifier.")
 
-	response, err := s.ExecutionManager.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+	response, err := s.ExecutionManager.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: workflowExecution.GetWorkflowId(),
 	})
@@ -1652,6 +1709,95 @@ func (s *ExecutionManagerSuite) TestReplicationTasks() {
 	}
 }
 
+// TestReplicationDLQ test
+func (s *ExecutionManagerSuite) TestReplicationDLQ() {
+	sourceCluster := "test"
+	otherCluster := "other"
+	taskInfo1 := &p.ReplicationTaskInfo{
+		DomainID:     uuid.New(),
+		WorkflowID:   uuid.New(),
+		RunID:        uuid.New(),
+		TaskID:       1,
+		TaskType:     p.ReplicationTaskTypeHistory,
+		FirstEventID: 1,
+		NextEventID:  5,
+		Version:      10,
+		LastReplicationInfo: map[string]*p.ReplicationInfo{
+			"dc1": {
+				Version:     int64(3),
+				LastEventID: int64(1),
+			},
+		},
+		ScheduledID: common.EmptyEventID,
+	}
+	taskInfo2 := &p.ReplicationTaskInfo{
+		DomainID:            uuid.New(),
+		WorkflowID:          uuid.New(),
+		RunID:               uuid.New(),
+		TaskID:              2,
+		TaskType:            p.ReplicationTaskTypeSyncActivity,
+		FirstEventID:        common.EmptyEventID,
+		NextEventID:         common.EmptyEventID,
+		Version:             20,
+		LastReplicationInfo: map[string]*p.ReplicationInfo{},
+		ScheduledID:         99,
+	}
+	taskInfo3 := &p.ReplicationTaskInfo{
+		DomainID:            uuid.New(),
+		WorkflowID:          uuid.New(),
+		RunID:               uuid.New(),
+		TaskID:              3,
+		TaskType:            p.ReplicationTaskTypeSyncActivity,
+		FirstEventID:        common.EmptyEventID,
+		NextEventID:         common.EmptyEventID,
+		Version:             30,
+		LastReplicationInfo: map[string]*p.ReplicationInfo{},
+		ScheduledID:         100,
+	}
+	s.NoError(s.PutReplicationTaskToDLQ(sourceCluster, taskInfo1))
+	s.NoError(s.PutReplicationTaskToDLQ(sourceCluster, taskInfo2))
+	s.NoError(s.PutReplicationTaskToDLQ(sourceCluster, taskInfo3))
+	s.NoError(s.PutReplicationTaskToDLQ(otherCluster, taskInfo1))
+
+	resp, err := s.GetReplicationTasksFromDLQ(sourceCluster, 0, 3, 2, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 2)
+	s.NotEmpty(resp.NextPageToken)
+	s.Equal(taskInfo1.TaskID, resp.Tasks[0].TaskID)
+	s.Equal(taskInfo1.DomainID, resp.Tasks[0].DomainID)
+	s.Equal(taskInfo1.WorkflowID, resp.Tasks[0].WorkflowID)
+	s.Equal(taskInfo1.RunID, resp.Tasks[0].RunID)
+	s.Equal(taskInfo1.TaskType, resp.Tasks[0].TaskType)
+	s.Equal(taskInfo1.FirstEventID, resp.Tasks[0].FirstEventID)
+	s.Equal(taskInfo1.NextEventID, resp.Tasks[0].NextEventID)
+	s.Equal(taskInfo1.Version, resp.Tasks[0].Version)
+	s.Equal(taskInfo2.TaskID, resp.Tasks[1].TaskID)
+	s.Equal(taskInfo2.ScheduledID, resp.Tasks[1].ScheduledID)
+
+	resp, err = s.GetReplicationTasksFromDLQ(sourceCluster, 0, 3, 2, resp.NextPageToken)
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(taskInfo3.TaskID, resp.Tasks[0].TaskID)
+
+	s.NoError(s.DeleteReplicationTaskFromDLQ(sourceCluster, taskInfo2.TaskID))
+	resp, err = s.GetReplicationTasksFromDLQ(sourceCluster, 0, 3, 10, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 2)
+	s.Equal(taskInfo1.TaskID, resp.Tasks[0].TaskID)
+	s.Equal(taskInfo3.TaskID, resp.Tasks[1].TaskID)
+
+	s.NoError(s.RangeDeleteReplicationTaskFromDLQ(sourceCluster, 0, 3))
+	resp, err = s.GetReplicationTasksFromDLQ(sourceCluster, 0, 3, 10, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 0)
+
+	// tasks from other source clusters are not affected
+	resp, err = s.GetReplicationTasksFromDLQ(otherCluster, 0, 3, 10, nil)
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(taskInfo1.TaskID, resp.Tasks[0].TaskID)
+}
+
 // TestTransferTasksComplete test
 func (s *ExecutionManagerSuite) TestTransferTasksComplete() {
 	domainID := "8bfb47be-5b57-4d55-9109-5fb35e20b1d7"
@@ -3744,7 +3890,7 @@ func (s *ExecutionManagerSuite) TestCreateGetShardBackfill() {
 	createRequest := &p.CreateShardRequest{
 		ShardInfo: shardInfo,
 	}
-	s.Nil(s.ShardMgr.CreateShard(createRequest))
+	s.Nil(s.ShardMgr.CreateShard(context.Background(), createRequest))
 
 	shardInfo.ClusterTransferAckLevel = map[string]int64{
 		s.ClusterMetadata.GetCurrentClusterName(): currentClusterTransferAck,
@@ -3752,7 +3898,8 @@ func (s *ExecutionManagerSuite) TestCreateGetShardBackfill() {
 	shardInfo.ClusterTimerAckLevel = map[string]time.Time{
 		s.ClusterMetadata.GetCurrentClusterName(): currentClusterTimerAck,
 	}
-	resp, err := s.ShardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
+	shardInfo.ClusterReplicationLevel = map[string]int64{}
+	resp, err := s.ShardMgr.GetShard(context.Background(), &p.GetShardRequest{ShardID: shardID})
 	s.NoError(err)
 	s.True(timeComparator(shardInfo.UpdatedAt, resp.ShardInfo.UpdatedAt, TimePrecision))
 	s.True(timeComparator(shardInfo.ClusterTimerAckLevel[cluster.TestCurrentClusterName], resp.ShardInfo.ClusterTimerAckLevel[cluster.TestCurrentClusterName], TimePrecision))
@@ -3776,6 +3923,7 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 	currentClusterTimerAck := timestampConvertor(time.Now().Add(-10 * time.Second))
 	alternativeClusterTimerAck := timestampConvertor(time.Now().Add(-20 * time.Second))
 	domainNotificationVersion := int64(8192)
+	alternativeClusterReplicationLevel := int64(25)
 	shardInfo := &p.ShardInfo{
 		ShardID:             shardID,
 		Owner:               "some random owner",
@@ -3794,12 +3942,15 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
 		DomainNotificationVersion: domainNotificationVersion,
+		ClusterReplicationLevel: map[string]int64{
+			cluster.TestAlternativeClusterName: alternativeClusterReplicationLevel,
+		},
 	}
 	createRequest := &p.CreateShardRequest{
 		ShardInfo: shardInfo,
 	}
-	s.Nil(s.ShardMgr.CreateShard(createRequest))
-	resp, err := s.ShardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
+	s.Nil(s.ShardMgr.CreateShard(context.Background(), createRequest))
+	resp, err := s.ShardMgr.GetShard(context.Background(), &p.GetShardRequest{ShardID: shardID})
 	s.NoError(err)
 	s.True(timeComparator(shardInfo.UpdatedAt, resp.ShardInfo.UpdatedAt, TimePrecision))
 	s.True(timeComparator(shardInfo.ClusterTimerAckLevel[cluster.TestCurrentClusterName], resp.ShardInfo.ClusterTimerAckLevel[cluster.TestCurrentClusterName], TimePrecision))
@@ -3817,6 +3968,7 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 	currentClusterTimerAck = timestampConvertor(time.Now().Add(-100 * time.Second))
 	alternativeClusterTimerAck = timestampConvertor(time.Now().Add(-200 * time.Second))
 	domainNotificationVersion = int64(16384)
+	alternativeClusterReplicationLevel = int64(250)
 	shardInfo = &p.ShardInfo{
 		ShardID:             shardID,
 		Owner:               "some random owner",
@@ -3835,14 +3987,17 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
 		DomainNotificationVersion: domainNotificationVersion,
+		ClusterReplicationLevel: map[string]int64{
+			cluster.TestAlternativeClusterName: alternativeClusterReplicationLevel,
+		},
 	}
 	updateRequest := &p.UpdateShardRequest{
 		ShardInfo:       shardInfo,
 		PreviousRangeID: rangeID,
 	}
-	s.Nil(s.ShardMgr.UpdateShard(updateRequest))
+	s.Nil(s.ShardMgr.UpdateShard(context.Background(), updateRequest))
 
-	resp, err = s.ShardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
+	resp, err = s.ShardMgr.GetShard(context.Background(), &p.GetShardRequest{ShardID: shardID})
 	s.NoError(err)
 	s.True(timeComparator(shardInfo.UpdatedAt, resp.ShardInfo.UpdatedAt, TimePrecision))
 	s.True(timeComparator(shardInfo.ClusterTimerAckLevel[cluster.TestCurrentClusterName], resp.ShardInfo.ClusterTimerAckLevel[cluster.TestCurrentClusterName], TimePrecision))
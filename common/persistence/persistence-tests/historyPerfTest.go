@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"os"
@@ -113,7 +114,9 @@ readV1-batch size: 1000 , time eslapsed: 9.965 milliseconds
 readv2-batch size: 1000 , time eslapsed: 5.758 milliseconds
 time="2018-10-21T19:33:02-07:00" level=info msg="dropped namespace" keyspace=test_wofofrfwrw
 --- PASS: TestCassandraHistoryPerformance (10.07s)
-    --- PASS: TestCassandraHistoryPerformance/TestPerf (8.13s)
+
+	--- PASS: TestCassandraHistoryPerformance/TestPerf (8.13s)
+
 PASS
 */
 func (s *HistoryPerfSuite) TestPerf() {
@@ -235,7 +238,7 @@ func (s *HistoryPerfSuite) newHistoryBranch(treeID string) ([]byte, error) {
 
 // persistence helper
 func (s *HistoryPerfSuite) readv2(branch []byte, minID, maxID int64, pageSize int, token []byte) ([]*workflow.HistoryEvent, []byte, error) {
-	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), &p.ReadHistoryBranchRequest{
 		BranchToken:   branch,
 		MinEventID:    minID,
 		MaxEventID:    maxID,
@@ -258,7 +261,7 @@ func (s *HistoryPerfSuite) appendV2(br []byte, events []*workflow.HistoryEvent,
 	var resp *p.AppendHistoryNodesResponse
 	var err error
 
-	resp, err = s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+	resp, err = s.HistoryV2Mgr.AppendHistoryNodes(context.Background(), &p.AppendHistoryNodesRequest{
 		BranchToken:   br,
 		Events:        events,
 		TransactionID: txnID,
@@ -275,7 +278,7 @@ func (s *HistoryPerfSuite) appendV2(br []byte, events []*workflow.HistoryEvent,
 func (s *HistoryPerfSuite) appendV1(domainID string, workflowExecution workflow.WorkflowExecution,
 	firstEventID, eventBatchVersion int64, rangeID, txID int64, eventsBatch *workflow.History, overwrite bool) error {
 
-	_, err := s.HistoryMgr.AppendHistoryEvents(&p.AppendHistoryEventsRequest{
+	_, err := s.HistoryMgr.AppendHistoryEvents(context.Background(), &p.AppendHistoryEventsRequest{
 		DomainID:          domainID,
 		Execution:         workflowExecution,
 		FirstEventID:      firstEventID,
@@ -293,7 +296,7 @@ func (s *HistoryPerfSuite) appendV1(domainID string, workflowExecution workflow.
 func (s *HistoryPerfSuite) readV1(domainID string, workflowExecution workflow.WorkflowExecution,
 	firstEventID, nextEventID int64, pageSize int, token []byte) (*workflow.History, []byte, error) {
 
-	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(&p.GetWorkflowExecutionHistoryRequest{
+	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(context.Background(), &p.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     workflowExecution,
 		FirstEventID:  firstEventID,
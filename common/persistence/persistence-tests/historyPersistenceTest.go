@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -286,7 +287,7 @@ func (s *HistoryPersistenceSuite) TestAppendAndGetByBatch() {
 
 		nextEventID := events[len(events)-1].GetEventId()
 
-		resp, err1 := s.HistoryMgr.GetWorkflowExecutionHistoryByBatch(&p.GetWorkflowExecutionHistoryRequest{
+		resp, err1 := s.HistoryMgr.GetWorkflowExecutionHistoryByBatch(context.Background(), &p.GetWorkflowExecutionHistoryRequest{
 			DomainID:      domainID,
 			Execution:     workflowExecution,
 			FirstEventID:  1,
@@ -385,7 +386,7 @@ func (s *HistoryPersistenceSuite) TestOverwriteAndShadowingHistoryEvents() {
 func (s *HistoryPersistenceSuite) AppendHistoryEvents(domainID string, workflowExecution gen.WorkflowExecution,
 	firstEventID, eventBatchVersion int64, rangeID, txID int64, eventsBatch *gen.History, overwrite bool) error {
 
-	_, err := s.HistoryMgr.AppendHistoryEvents(&p.AppendHistoryEventsRequest{
+	_, err := s.HistoryMgr.AppendHistoryEvents(context.Background(), &p.AppendHistoryEventsRequest{
 		DomainID:          domainID,
 		Execution:         workflowExecution,
 		FirstEventID:      firstEventID,
@@ -403,7 +404,7 @@ func (s *HistoryPersistenceSuite) AppendHistoryEvents(domainID string, workflowE
 func (s *HistoryPersistenceSuite) GetWorkflowExecutionHistory(domainID string, workflowExecution gen.WorkflowExecution,
 	firstEventID, nextEventID int64, pageSize int, token []byte) (*gen.History, []byte, error) {
 
-	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(&p.GetWorkflowExecutionHistoryRequest{
+	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(context.Background(), &p.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     workflowExecution,
 		FirstEventID:  firstEventID,
@@ -423,7 +424,7 @@ func (s *HistoryPersistenceSuite) GetWorkflowExecutionHistory(domainID string, w
 func (s *HistoryPersistenceSuite) DeleteWorkflowExecutionHistory(domainID string,
 	workflowExecution gen.WorkflowExecution) error {
 
-	return s.HistoryMgr.DeleteWorkflowExecutionHistory(&p.DeleteWorkflowExecutionHistoryRequest{
+	return s.HistoryMgr.DeleteWorkflowExecutionHistory(context.Background(), &p.DeleteWorkflowExecutionHistoryRequest{
 		DomainID:  domainID,
 		Execution: workflowExecution,
 	})
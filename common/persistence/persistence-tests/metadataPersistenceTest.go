@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -816,7 +817,7 @@ func (m *MetadataPersistenceSuite) TestDeleteDomain() {
 // CreateDomain helper
 func (m *MetadataPersistenceSuite) CreateDomain(info *p.DomainInfo, config *p.DomainConfig,
 	replicationConfig *p.DomainReplicationConfig, isGlobaldomain bool, configVersion int64, failoverVersion int64) (*p.CreateDomainResponse, error) {
-	return m.MetadataManager.CreateDomain(&p.CreateDomainRequest{
+	return m.MetadataManager.CreateDomain(context.Background(), &p.CreateDomainRequest{
 		Info:              info,
 		Config:            config,
 		ReplicationConfig: replicationConfig,
@@ -828,7 +829,7 @@ func (m *MetadataPersistenceSuite) CreateDomain(info *p.DomainInfo, config *p.Do
 
 // GetDomain helper
 func (m *MetadataPersistenceSuite) GetDomain(id, name string) (*p.GetDomainResponse, error) {
-	return m.MetadataManager.GetDomain(&p.GetDomainRequest{
+	return m.MetadataManager.GetDomain(context.Background(), &p.GetDomainRequest{
 		ID:   id,
 		Name: name,
 	})
@@ -837,7 +838,7 @@ func (m *MetadataPersistenceSuite) GetDomain(id, name string) (*p.GetDomainRespo
 // UpdateDomain helper
 func (m *MetadataPersistenceSuite) UpdateDomain(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig,
 	configVersion int64, failoverVersion int64, dbVersion int64) error {
-	return m.MetadataManager.UpdateDomain(&p.UpdateDomainRequest{
+	return m.MetadataManager.UpdateDomain(context.Background(), &p.UpdateDomainRequest{
 		Info:                info,
 		Config:              config,
 		ReplicationConfig:   replicationConfig,
@@ -850,7 +851,7 @@ func (m *MetadataPersistenceSuite) UpdateDomain(info *p.DomainInfo, config *p.Do
 // DeleteDomain helper
 func (m *MetadataPersistenceSuite) DeleteDomain(id, name string) error {
 	if len(id) > 0 {
-		return m.MetadataManager.DeleteDomain(&p.DeleteDomainRequest{ID: id})
+		return m.MetadataManager.DeleteDomain(context.Background(), &p.DeleteDomainRequest{ID: id})
 	}
-	return m.MetadataManager.DeleteDomainByName(&p.DeleteDomainByNameRequest{Name: name})
+	return m.MetadataManager.DeleteDomainByName(context.Background(), &p.DeleteDomainByNameRequest{Name: name})
 }
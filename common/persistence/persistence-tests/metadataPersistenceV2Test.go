@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -468,7 +469,7 @@ func (m *MetadataPersistenceSuiteV2) TestConcurrentUpdateDomain() {
 	resp2, err2 := m.GetDomain(id, "")
 	m.NoError(err2)
 	m.Equal(badBinaries, resp2.Config.BadBinaries)
-	metadata, err := m.MetadataManagerV2.GetMetadata()
+	metadata, err := m.MetadataManagerV2.GetMetadata(context.Background())
 	m.NoError(err)
 	notificationVersion := metadata.NotificationVersion
 
@@ -609,7 +610,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 
 	resp2, err2 := m.GetDomain(id, "")
 	m.NoError(err2)
-	metadata, err := m.MetadataManagerV2.GetMetadata()
+	metadata, err := m.MetadataManagerV2.GetMetadata(context.Background())
 	m.NoError(err)
 	notificationVersion := metadata.NotificationVersion
 
@@ -720,6 +721,27 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(updateFailoverVersion, resp5.FailoverVersion)
 	m.Equal(updateFailoverNotificationVersion, resp5.FailoverNotificationVersion)
 	m.Equal(notificationVersion, resp5.NotificationVersion)
+	m.Equal(int64(0), resp5.FailoverDrainEndTime)
+
+	metadata, err = m.MetadataManagerV2.GetMetadata(context.Background())
+	m.NoError(err)
+	failoverDrainEndTime := int64(1234567)
+	err6 := m.MetadataManagerV2.UpdateDomain(context.Background(), &p.UpdateDomainRequest{
+		Info:                        resp5.Info,
+		Config:                      resp5.Config,
+		ReplicationConfig:           resp5.ReplicationConfig,
+		ConfigVersion:               resp5.ConfigVersion,
+		FailoverVersion:             resp5.FailoverVersion,
+		FailoverNotificationVersion: resp5.FailoverNotificationVersion,
+		FailoverDrainEndTime:        failoverDrainEndTime,
+		NotificationVersion:         metadata.NotificationVersion,
+	})
+	m.NoError(err6)
+
+	resp6, err6 := m.GetDomain("", name)
+	m.NoError(err6)
+	m.Equal(failoverDrainEndTime, resp6.FailoverDrainEndTime)
+	m.Equal(updateFailoverVersion, resp6.FailoverVersion)
 }
 
 // TestDeleteDomain test
@@ -968,7 +990,7 @@ ListLoop:
 // CreateDomain helper method
 func (m *MetadataPersistenceSuiteV2) CreateDomain(info *p.DomainInfo, config *p.DomainConfig,
 	replicationConfig *p.DomainReplicationConfig, isGlobaldomain bool, configVersion int64, failoverVersion int64) (*p.CreateDomainResponse, error) {
-	return m.MetadataManagerV2.CreateDomain(&p.CreateDomainRequest{
+	return m.MetadataManagerV2.CreateDomain(context.Background(), &p.CreateDomainRequest{
 		Info:              info,
 		Config:            config,
 		ReplicationConfig: replicationConfig,
@@ -980,7 +1002,7 @@ func (m *MetadataPersistenceSuiteV2) CreateDomain(info *p.DomainInfo, config *p.
 
 // GetDomain helper method
 func (m *MetadataPersistenceSuiteV2) GetDomain(id, name string) (*p.GetDomainResponse, error) {
-	return m.MetadataManagerV2.GetDomain(&p.GetDomainRequest{
+	return m.MetadataManagerV2.GetDomain(context.Background(), &p.GetDomainRequest{
 		ID:   id,
 		Name: name,
 	})
@@ -989,7 +1011,7 @@ func (m *MetadataPersistenceSuiteV2) GetDomain(id, name string) (*p.GetDomainRes
 // UpdateDomain helper method
 func (m *MetadataPersistenceSuiteV2) UpdateDomain(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig,
 	configVersion int64, failoverVersion int64, failoverNotificationVersion int64, notificationVersion int64) error {
-	return m.MetadataManagerV2.UpdateDomain(&p.UpdateDomainRequest{
+	return m.MetadataManagerV2.UpdateDomain(context.Background(), &p.UpdateDomainRequest{
 		Info:                        info,
 		Config:                      config,
 		ReplicationConfig:           replicationConfig,
@@ -1003,14 +1025,14 @@ func (m *MetadataPersistenceSuiteV2) UpdateDomain(info *p.DomainInfo, config *p.
 // DeleteDomain helper method
 func (m *MetadataPersistenceSuiteV2) DeleteDomain(id, name string) error {
 	if len(id) > 0 {
-		return m.MetadataManagerV2.DeleteDomain(&p.DeleteDomainRequest{ID: id})
+		return m.MetadataManagerV2.DeleteDomain(context.Background(), &p.DeleteDomainRequest{ID: id})
 	}
-	return m.MetadataManagerV2.DeleteDomainByName(&p.DeleteDomainByNameRequest{Name: name})
+	return m.MetadataManagerV2.DeleteDomainByName(context.Background(), &p.DeleteDomainByNameRequest{Name: name})
 }
 
 // ListDomains helper method
 func (m *MetadataPersistenceSuiteV2) ListDomains(pageSize int, pageToken []byte) (*p.ListDomainsResponse, error) {
-	return m.MetadataManagerV2.ListDomains(&p.ListDomainsRequest{
+	return m.MetadataManagerV2.ListDomains(context.Background(), &p.ListDomainsRequest{
 		PageSize:      pageSize,
 		NextPageToken: pageToken,
 	})
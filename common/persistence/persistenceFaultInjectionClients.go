@@ -0,0 +1,886 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// FaultInjectionErrorTypeTimeout injects a TimeoutError
+	FaultInjectionErrorTypeTimeout = "timeout"
+	// FaultInjectionErrorTypeConditionFailed injects a ConditionFailedError
+	FaultInjectionErrorTypeConditionFailed = "conditionFailed"
+	// FaultInjectionErrorTypeShardOwnershipLost injects a ShardOwnershipLostError
+	FaultInjectionErrorTypeShardOwnershipLost = "shardOwnershipLost"
+	// FaultInjectionErrorTypeServiceBusy injects a ServiceBusyError
+	FaultInjectionErrorTypeServiceBusy = "serviceBusy"
+)
+
+var faultInjectionErrorTypes = []string{
+	FaultInjectionErrorTypeTimeout,
+	FaultInjectionErrorTypeConditionFailed,
+	FaultInjectionErrorTypeShardOwnershipLost,
+	FaultInjectionErrorTypeServiceBusy,
+}
+
+type (
+	// faultInjector decides, for every persistence call, whether to delay it and whether to fail
+	// it before it reaches the underlying persistence
+	faultInjector struct {
+		config  *config.FaultInjectionConfig
+		shardID int
+		logger  log.Logger
+	}
+
+	shardFaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence ShardManager
+		logger      log.Logger
+	}
+
+	workflowExecutionFaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence ExecutionManager
+		logger      log.Logger
+	}
+
+	taskFaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence TaskManager
+		logger      log.Logger
+	}
+
+	historyFaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence HistoryManager
+		logger      log.Logger
+	}
+
+	historyV2FaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence HistoryV2Manager
+		logger      log.Logger
+	}
+
+	metadataFaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence MetadataManager
+		logger      log.Logger
+	}
+
+	visibilityFaultInjectionPersistenceClient struct {
+		injector    *faultInjector
+		persistence VisibilityManager
+		logger      log.Logger
+	}
+)
+
+var _ ShardManager = (*shardFaultInjectionPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionFaultInjectionPersistenceClient)(nil)
+var _ TaskManager = (*taskFaultInjectionPersistenceClient)(nil)
+var _ HistoryManager = (*historyFaultInjectionPersistenceClient)(nil)
+var _ HistoryV2Manager = (*historyV2FaultInjectionPersistenceClient)(nil)
+var _ MetadataManager = (*metadataFaultInjectionPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityFaultInjectionPersistenceClient)(nil)
+
+// NewShardPersistenceFaultInjectionClient creates a client to manage shards
+func NewShardPersistenceFaultInjectionClient(persistence ShardManager, config *config.FaultInjectionConfig, logger log.Logger) ShardManager {
+	return &shardFaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, 0, logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceFaultInjectionClient creates a client to manage executions
+func NewWorkflowExecutionPersistenceFaultInjectionClient(persistence ExecutionManager, config *config.FaultInjectionConfig, logger log.Logger) ExecutionManager {
+	return &workflowExecutionFaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, persistence.GetShardID(), logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewTaskPersistenceFaultInjectionClient creates a client to manage tasks
+func NewTaskPersistenceFaultInjectionClient(persistence TaskManager, config *config.FaultInjectionConfig, logger log.Logger) TaskManager {
+	return &taskFaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, 0, logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewHistoryPersistenceFaultInjectionClient creates a HistoryManager client to manage workflow execution history
+func NewHistoryPersistenceFaultInjectionClient(persistence HistoryManager, config *config.FaultInjectionConfig, logger log.Logger) HistoryManager {
+	return &historyFaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, 0, logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewHistoryV2PersistenceFaultInjectionClient creates a HistoryV2Manager client to manage workflow execution history
+func NewHistoryV2PersistenceFaultInjectionClient(persistence HistoryV2Manager, config *config.FaultInjectionConfig, logger log.Logger) HistoryV2Manager {
+	return &historyV2FaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, 0, logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewMetadataPersistenceFaultInjectionClient creates a MetadataManager client to manage metadata
+func NewMetadataPersistenceFaultInjectionClient(persistence MetadataManager, config *config.FaultInjectionConfig, logger log.Logger) MetadataManager {
+	return &metadataFaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, 0, logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewVisibilityPersistenceFaultInjectionClient creates a client to manage visibility
+func NewVisibilityPersistenceFaultInjectionClient(persistence VisibilityManager, config *config.FaultInjectionConfig, logger log.Logger) VisibilityManager {
+	return &visibilityFaultInjectionPersistenceClient{
+		injector:    newFaultInjector(config, 0, logger),
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+func newFaultInjector(config *config.FaultInjectionConfig, shardID int, logger log.Logger) *faultInjector {
+	return &faultInjector{
+		config:  config,
+		shardID: shardID,
+		logger:  logger,
+	}
+}
+
+// inject adds the configured latency to the call and returns the error the call should fail
+// with, or nil if the call should go through to persistence
+func (f *faultInjector) inject(operation string, domainID string) error {
+	if !f.config.Enabled() || !f.isDomainEligible(domainID) {
+		return nil
+	}
+
+	if maxLatency := f.config.MaxLatency(); maxLatency > 0 {
+		time.Sleep(time.Duration(rand.Int63n(int64(maxLatency))))
+	}
+
+	if rand.Float64() >= f.errorRate(operation) {
+		return nil
+	}
+	err := f.newError(operation)
+	f.logger.Debug("Injecting persistence error.", tag.Name(operation), tag.Error(err))
+	return err
+}
+
+func (f *faultInjector) isDomainEligible(domainID string) bool {
+	domains := f.config.Domains()
+	if len(domains) == 0 {
+		return true
+	}
+	_, ok := domains[domainID]
+	return ok
+}
+
+func (f *faultInjector) errorRate(operation string) float64 {
+	if rate, ok := f.config.MethodErrorRates()[operation]; ok {
+		if value, ok := rate.(float64); ok {
+			return value
+		}
+		if value, ok := rate.(int); ok {
+			return float64(value)
+		}
+	}
+	return f.config.ErrorRate()
+}
+
+// newError picks the kind of error to inject according to the configured weights, all kinds are
+// equally likely when no weight is configured
+func (f *faultInjector) newError(operation string) error {
+	weights := make([]float64, len(faultInjectionErrorTypes))
+	total := 0.0
+	configured := f.config.ErrorTypes()
+	for i, errorType := range faultInjectionErrorTypes {
+		weight := 1.0
+		if len(configured) > 0 {
+			weight = 0
+			switch value := configured[errorType].(type) {
+			case float64:
+				weight = value
+			case int:
+				weight = float64(value)
+			}
+		}
+		weights[i] = weight
+		total += weight
+	}
+
+	errorType := FaultInjectionErrorTypeServiceBusy
+	if total > 0 {
+		target := rand.Float64() * total
+		for i, weight := range weights {
+			if target < weight {
+				errorType = faultInjectionErrorTypes[i]
+				break
+			}
+			target -= weight
+		}
+	}
+
+	msg := "Injected persistence error for " + operation + "."
+	switch errorType {
+	case FaultInjectionErrorTypeTimeout:
+		return &TimeoutError{Msg: msg}
+	case FaultInjectionErrorTypeConditionFailed:
+		return &ConditionFailedError{Msg: msg}
+	case FaultInjectionErrorTypeShardOwnershipLost:
+		return &ShardOwnershipLostError{ShardID: f.shardID, Msg: msg}
+	default:
+		return &workflow.ServiceBusyError{Message: msg}
+	}
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardFaultInjectionPersistenceClient) CreateShard(ctx context.Context, request *CreateShardRequest) error {
+	if err := p.injector.inject("CreateShard", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.CreateShard(ctx, request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetShard(ctx context.Context, request *GetShardRequest) (*GetShardResponse, error) {
+	if err := p.injector.inject("GetShard", ""); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetShard(ctx, request)
+	return response, err
+}
+
+func (p *shardFaultInjectionPersistenceClient) UpdateShard(ctx context.Context, request *UpdateShardRequest) error {
+	if err := p.injector.inject("UpdateShard", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateShard(ctx, request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CreateWorkflowExecution(ctx context.Context, request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if err := p.injector.inject("CreateWorkflowExecution", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if err := p.injector.inject("GetWorkflowExecution", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if err := p.injector.inject("UpdateWorkflowExecution", request.ExecutionInfo.DomainID); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.persistence.UpdateWorkflowExecution(ctx, request)
+	return resp, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ResetMutableState(ctx context.Context, request *ResetMutableStateRequest) error {
+	if err := p.injector.inject("ResetMutableState", request.ExecutionInfo.DomainID); err != nil {
+		return err
+	}
+
+	err := p.persistence.ResetMutableState(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest) error {
+	if err := p.injector.inject("ResetWorkflowExecution", request.InsertExecutionInfo.DomainID); err != nil {
+		return err
+	}
+
+	err := p.persistence.ResetWorkflowExecution(ctx, request)
+	return err
+}
+
+// CompleteForkBranch complete forking process
+
+func (p *historyV2FaultInjectionPersistenceClient) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
+	if err := p.injector.inject("CompleteForkBranch", ""); err != nil {
+		return err
+	}
+	err := p.persistence.CompleteForkBranch(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) error {
+	if err := p.injector.inject("DeleteWorkflowExecution", request.DomainID); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteCurrentWorkflowExecution(ctx context.Context, request *DeleteCurrentWorkflowExecutionRequest) error {
+	if err := p.injector.inject("DeleteCurrentWorkflowExecution", request.DomainID); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if err := p.injector.inject("GetCurrentExecution", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if err := p.injector.inject("GetTransferTasks", ""); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	if err := p.injector.inject("GetReplicationTasks", ""); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error {
+	if err := p.injector.inject("CompleteTransferTask", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTransferTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error {
+	if err := p.injector.inject("RangeCompleteTransferTask", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTransferTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error {
+	if err := p.injector.inject("CompleteReplicationTask", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteReplicationTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) PutReplicationTaskToDLQ(ctx context.Context, request *PutReplicationTaskToDLQRequest) error {
+	if err := p.injector.inject("PutReplicationTaskToDLQ", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.PutReplicationTaskToDLQ(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasksFromDLQ(ctx context.Context, request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	if err := p.injector.inject("GetReplicationTasksFromDLQ", ""); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteReplicationTaskFromDLQ(ctx context.Context, request *DeleteReplicationTaskFromDLQRequest) error {
+	if err := p.injector.inject("DeleteReplicationTaskFromDLQ", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	if err := p.injector.inject("RangeDeleteReplicationTaskFromDLQ", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if err := p.injector.inject("GetTimerIndexTasks", ""); err != nil {
+		return nil, err
+	}
+
+	resonse, err := p.persistence.GetTimerIndexTasks(ctx, request)
+	return resonse, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error {
+	if err := p.injector.inject("CompleteTimerTask", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTimerTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error {
+	if err := p.injector.inject("RangeCompleteTimerTask", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTimerTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskFaultInjectionPersistenceClient) CreateTasks(ctx context.Context, request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	if err := p.injector.inject("CreateTasks", request.TaskListInfo.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateTasks(ctx, request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTasks(ctx context.Context, request *GetTasksRequest) (*GetTasksResponse, error) {
+	if err := p.injector.inject("GetTasks", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTasks(ctx, request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTask(ctx context.Context, request *CompleteTaskRequest) error {
+	if err := p.injector.inject("CompleteTask", request.TaskList.DomainID); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTask(ctx, request)
+	return err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
+	if err := p.injector.inject("CompleteTasksLessThan", request.DomainID); err != nil {
+		return 0, err
+	}
+	return p.persistence.CompleteTasksLessThan(ctx, request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if err := p.injector.inject("LeaseTaskList", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.LeaseTaskList(ctx, request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	if err := p.injector.inject("UpdateTaskList", request.TaskListInfo.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.UpdateTaskList(ctx, request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	if err := p.injector.inject("ListTaskList", ""); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListTaskList(ctx, request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error {
+	if err := p.injector.inject("DeleteTaskList", request.DomainID); err != nil {
+		return err
+	}
+	return p.persistence.DeleteTaskList(ctx, request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyFaultInjectionPersistenceClient) AppendHistoryEvents(ctx context.Context, request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+	if err := p.injector.inject("AppendHistoryEvents", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.persistence.AppendHistoryEvents(ctx, request)
+	return resp, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	if err := p.injector.inject("GetWorkflowExecutionHistory", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecutionHistory(ctx, request)
+	return response, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetWorkflowExecutionHistoryByBatch(ctx context.Context, request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	if err := p.injector.inject("GetWorkflowExecutionHistoryByBatch", request.DomainID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(ctx, request)
+	return response, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) DeleteWorkflowExecutionHistory(ctx context.Context, request *DeleteWorkflowExecutionHistoryRequest) error {
+	if err := p.injector.inject("DeleteWorkflowExecutionHistory", request.DomainID); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteWorkflowExecutionHistory(ctx, request)
+	return err
+}
+
+func (p *historyFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateDomain(ctx context.Context, request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	if err := p.injector.inject("CreateDomain", request.Info.ID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateDomain(ctx, request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetDomain(ctx context.Context, request *GetDomainRequest) (*GetDomainResponse, error) {
+	if err := p.injector.inject("GetDomain", request.ID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetDomain(ctx, request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateDomain(ctx context.Context, request *UpdateDomainRequest) error {
+	if err := p.injector.inject("UpdateDomain", request.Info.ID); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateDomain(ctx, request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomain(ctx context.Context, request *DeleteDomainRequest) error {
+	if err := p.injector.inject("DeleteDomain", request.ID); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteDomain(ctx, request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomainByName(ctx context.Context, request *DeleteDomainByNameRequest) error {
+	if err := p.injector.inject("DeleteDomainByName", ""); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteDomainByName(ctx, request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListDomains(ctx context.Context, request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	if err := p.injector.inject("ListDomains", ""); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListDomains(ctx, request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetMetadata(ctx context.Context) (*GetMetadataResponse, error) {
+	if err := p.injector.inject("GetMetadata", ""); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetMetadata(ctx)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStarted(ctx context.Context, request *RecordWorkflowExecutionStartedRequest) error {
+	if err := p.injector.inject("RecordWorkflowExecutionStarted", request.DomainUUID); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionStarted(ctx, request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosed(ctx context.Context, request *RecordWorkflowExecutionClosedRequest) error {
+	if err := p.injector.inject("RecordWorkflowExecutionClosed", request.DomainUUID); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionClosed(ctx, request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListOpenWorkflowExecutions", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListClosedWorkflowExecutions", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutions(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListOpenWorkflowExecutionsByType", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListClosedWorkflowExecutionsByType", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListOpenWorkflowExecutionsByWorkflowID", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListClosedWorkflowExecutionsByWorkflowID", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListClosedWorkflowExecutionsByStatus", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	if err := p.injector.inject("GetClosedWorkflowExecution", request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetClosedWorkflowExecution(ctx, request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) DeleteWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error {
+	if err := p.injector.inject("DeleteWorkflowExecution", request.DomainID); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecution(ctx, request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ListWorkflowExecutions", request.DomainUUID); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListWorkflowExecutions(ctx, request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("ScanWorkflowExecutions", request.DomainUUID); err != nil {
+		return nil, err
+	}
+	return p.persistence.ScanWorkflowExecutions(ctx, request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	if err := p.injector.inject("CountWorkflowExecutions", request.DomainUUID); err != nil {
+		return nil, err
+	}
+	return p.persistence.CountWorkflowExecutions(ctx, request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+
+func (p *historyV2FaultInjectionPersistenceClient) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	if err := p.injector.inject("AppendHistoryNodes", ""); err != nil {
+		return nil, err
+	}
+	return p.persistence.AppendHistoryNodes(ctx, request)
+}
+
+// ReadHistoryBranch returns history node data for a branch
+
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	if err := p.injector.inject("ReadHistoryBranch", ""); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	if err := p.injector.inject("ReadHistoryBranchByBatch", ""); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+
+func (p *historyV2FaultInjectionPersistenceClient) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	if err := p.injector.inject("ForkHistoryBranch", ""); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+
+func (p *historyV2FaultInjectionPersistenceClient) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
+	if err := p.injector.inject("DeleteHistoryBranch", ""); err != nil {
+		return err
+	}
+	err := p.persistence.DeleteHistoryBranch(ctx, request)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+
+func (p *historyV2FaultInjectionPersistenceClient) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	if err := p.injector.inject("GetHistoryTree", ""); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetHistoryTree(ctx, request)
+	return response, err
+}
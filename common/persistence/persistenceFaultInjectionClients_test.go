@@ -0,0 +1,99 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	faultInjectorSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestFaultInjectorSuite(t *testing.T) {
+	s := new(faultInjectorSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectorSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *faultInjectorSuite) newInjector(enabled bool, errorRate float64, methodErrorRates,
+	errorTypes, domains map[string]interface{}) *faultInjector {
+	logger, err := loggerimpl.NewDevelopment()
+	s.NoError(err)
+	return newFaultInjector(&config.FaultInjectionConfig{
+		Enabled:          dynamicconfig.GetBoolPropertyFn(enabled),
+		ErrorRate:        dynamicconfig.GetFloatPropertyFn(errorRate),
+		MethodErrorRates: dynamicconfig.GetMapPropertyFn(methodErrorRates),
+		ErrorTypes:       dynamicconfig.GetMapPropertyFn(errorTypes),
+		MaxLatency:       dynamicconfig.GetDurationPropertyFn(0),
+		Domains:          dynamicconfig.GetMapPropertyFn(domains),
+	}, 1, logger)
+}
+
+func (s *faultInjectorSuite) TestDisabled() {
+	injector := s.newInjector(false, 1, nil, nil, nil)
+	s.NoError(injector.inject("GetShard", ""))
+}
+
+func (s *faultInjectorSuite) TestErrorRate() {
+	injector := s.newInjector(true, 1, map[string]interface{}{"GetShard": 0.0}, nil, nil)
+	s.NoError(injector.inject("GetShard", ""))
+	s.Error(injector.inject("UpdateShard", ""))
+}
+
+func (s *faultInjectorSuite) TestErrorTypes() {
+	injector := s.newInjector(true, 1, nil, map[string]interface{}{FaultInjectionErrorTypeTimeout: 1}, nil)
+	s.IsType(&TimeoutError{}, injector.inject("GetShard", ""))
+
+	injector = s.newInjector(true, 1, nil, map[string]interface{}{FaultInjectionErrorTypeConditionFailed: 1.0}, nil)
+	s.IsType(&ConditionFailedError{}, injector.inject("GetShard", ""))
+
+	injector = s.newInjector(true, 1, nil, map[string]interface{}{FaultInjectionErrorTypeShardOwnershipLost: 1}, nil)
+	err := injector.inject("GetShard", "")
+	s.IsType(&ShardOwnershipLostError{}, err)
+	s.Equal(1, err.(*ShardOwnershipLostError).ShardID)
+
+	injector = s.newInjector(true, 1, nil, map[string]interface{}{FaultInjectionErrorTypeServiceBusy: 1}, nil)
+	s.IsType(&workflow.ServiceBusyError{}, injector.inject("GetShard", ""))
+}
+
+func (s *faultInjectorSuite) TestDomains() {
+	injector := s.newInjector(true, 1, nil, nil, map[string]interface{}{"domain-id": true})
+	s.Error(injector.inject("GetWorkflowExecution", "domain-id"))
+	s.NoError(injector.inject("GetWorkflowExecution", "other-domain-id"))
+	s.NoError(injector.inject("GetShard", ""))
+}
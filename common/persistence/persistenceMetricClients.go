@@ -21,10 +21,14 @@
 package persistence
 
 import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 )
 
 type (
@@ -32,42 +36,56 @@ type (
 		metricClient metrics.Client
 		persistence  ShardManager
 		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 
 	workflowExecutionPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  ExecutionManager
 		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 
 	taskPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  TaskManager
 		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 
 	historyPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  HistoryManager
 		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 
 	historyV2PersistenceClient struct {
 		metricClient metrics.Client
 		persistence  HistoryV2Manager
 		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 
 	metadataPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  MetadataManager
 		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 
 	visibilityPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  VisibilityManager
 		logger       log.Logger
+		tracer       opentracing.Tracer
+	}
+
+	clusterMembershipPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  ClusterMembershipManager
+		logger       log.Logger
+		tracer       opentracing.Tracer
 	}
 )
 
@@ -78,118 +96,152 @@ var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2PersistenceClient)(nil)
 var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
+var _ ClusterMembershipManager = (*clusterMembershipPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
-func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger log.Logger) ShardManager {
+func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) ShardManager {
 	return &shardPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
 // NewWorkflowExecutionPersistenceMetricsClient creates a client to manage executions
-func NewWorkflowExecutionPersistenceMetricsClient(persistence ExecutionManager, metricClient metrics.Client, logger log.Logger) ExecutionManager {
+func NewWorkflowExecutionPersistenceMetricsClient(persistence ExecutionManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) ExecutionManager {
 	return &workflowExecutionPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
 // NewTaskPersistenceMetricsClient creates a client to manage tasks
-func NewTaskPersistenceMetricsClient(persistence TaskManager, metricClient metrics.Client, logger log.Logger) TaskManager {
+func NewTaskPersistenceMetricsClient(persistence TaskManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) TaskManager {
 	return &taskPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
 // NewHistoryPersistenceMetricsClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryPersistenceMetricsClient(persistence HistoryManager, metricClient metrics.Client, logger log.Logger) HistoryManager {
+func NewHistoryPersistenceMetricsClient(persistence HistoryManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) HistoryManager {
 	return &historyPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
 // NewHistoryV2PersistenceMetricsClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryV2PersistenceMetricsClient(persistence HistoryV2Manager, metricClient metrics.Client, logger log.Logger) HistoryV2Manager {
+func NewHistoryV2PersistenceMetricsClient(persistence HistoryV2Manager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) HistoryV2Manager {
 	return &historyV2PersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
 // NewMetadataPersistenceMetricsClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricClient metrics.Client, logger log.Logger) MetadataManager {
+func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) MetadataManager {
 	return &metadataPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
 // NewVisibilityPersistenceMetricsClient creates a client to manage visibility
-func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metricClient metrics.Client, logger log.Logger) VisibilityManager {
+func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) VisibilityManager {
 	return &visibilityPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
 		logger:       logger,
+		tracer:       tracer,
 	}
 }
 
+// NewClusterMembershipPersistenceMetricsClient creates a client to manage the cluster membership
+func NewClusterMembershipPersistenceMetricsClient(persistence ClusterMembershipManager, metricClient metrics.Client, logger log.Logger, tracer opentracing.Tracer) ClusterMembershipManager {
+	return &clusterMembershipPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+		tracer:       tracer,
+	}
+}
+
+// startSpan starts the span of a persistence operation as a child of the span carried by the context
+func startSpan(ctx context.Context, tracer opentracing.Tracer, operation string) (opentracing.Span, context.Context) {
+	return tracing.StartSpan(ctx, tracer, "persistence."+operation)
+}
+
 func (p *shardPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *shardPersistenceClient) CreateShard(request *CreateShardRequest) error {
+func (p *shardPersistenceClient) CreateShard(ctx context.Context, request *CreateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCreateShardScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CreateShard")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateShardScope, metrics.PersistenceLatency)
-	err := p.persistence.CreateShard(request)
+	err := p.persistence.CreateShard(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCreateShardScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCreateShardScope, err)
 	}
 
 	return err
 }
 
 func (p *shardPersistenceClient) GetShard(
+	ctx context.Context,
 	request *GetShardRequest) (*GetShardResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetShard")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetShardScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetShard(request)
+	response, err := p.persistence.GetShard(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetShardScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetShardScope, err)
 	}
 
 	return response, err
 }
 
-func (p *shardPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+func (p *shardPersistenceClient) UpdateShard(ctx context.Context, request *UpdateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateShardScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "UpdateShard")
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateShardScope, metrics.PersistenceLatency)
-	err := p.persistence.UpdateShard(request)
+	err := p.persistence.UpdateShard(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceUpdateShardScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceUpdateShardScope, err)
 	}
 
 	return err
 }
 
-func (p *shardPersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *shardPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *ShardAlreadyExistError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardExistsCounter)
@@ -218,231 +270,331 @@ func (p *workflowExecutionPersistenceClient) GetShardID() int {
 	return p.persistence.GetShardID()
 }
 
-func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(ctx context.Context, request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CreateWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CreateWorkflowExecution(request)
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCreateWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCreateWorkflowExecutionScope, err)
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetWorkflowExecution(request)
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetWorkflowExecutionScope, err)
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "UpdateWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
-	resp, err := p.persistence.UpdateWorkflowExecution(request)
+	resp, err := p.persistence.UpdateWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceUpdateWorkflowExecutionScope, err)
 	}
 
 	return resp, err
 }
 
-func (p *workflowExecutionPersistenceClient) ResetMutableState(request *ResetMutableStateRequest) error {
+func (p *workflowExecutionPersistenceClient) ResetMutableState(ctx context.Context, request *ResetMutableStateRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceResetMutableStateScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ResetMutableState")
 	sw := p.metricClient.StartTimer(metrics.PersistenceResetMutableStateScope, metrics.PersistenceLatency)
-	err := p.persistence.ResetMutableState(request)
+	err := p.persistence.ResetMutableState(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceResetMutableStateScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceResetMutableStateScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceResetWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ResetWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceResetWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.ResetWorkflowExecution(request)
+	err := p.persistence.ResetWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceResetWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceResetWorkflowExecutionScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "DeleteWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteWorkflowExecution(request)
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteWorkflowExecutionScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(ctx context.Context, request *DeleteCurrentWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "DeleteCurrentWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	err := p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetCurrentExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetCurrentExecution(request)
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetCurrentExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetCurrentExecutionScope, err)
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetTransferTasks")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetTransferTasks(request)
+	response, err := p.persistence.GetTransferTasks(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetTransferTasksScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetTransferTasksScope, err)
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetReplicationTasks")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetReplicationTasks(request)
+	response, err := p.persistence.GetReplicationTasks(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetReplicationTasksScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetReplicationTasksScope, err)
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CompleteTransferTask")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteTransferTask(request)
+	err := p.persistence.CompleteTransferTask(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCompleteTransferTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCompleteTransferTaskScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "RangeCompleteTransferTask")
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.RangeCompleteTransferTask(request)
+	err := p.persistence.RangeCompleteTransferTask(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceRangeCompleteTransferTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceRangeCompleteTransferTaskScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CompleteReplicationTask")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteReplicationTask(request)
+	err := p.persistence.CompleteReplicationTask(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCompleteReplicationTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCompleteReplicationTaskScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+func (p *workflowExecutionPersistenceClient) PutReplicationTaskToDLQ(ctx context.Context, request *PutReplicationTaskToDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceRequests)
+
+	span, ctx := startSpan(ctx, p.tracer, "PutReplicationTaskToDLQ")
+	sw := p.metricClient.StartTimer(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.PutReplicationTaskToDLQ(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistencePutReplicationTaskToDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetReplicationTasksFromDLQ(ctx context.Context, request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceRequests)
+
+	span, ctx := startSpan(ctx, p.tracer, "GetReplicationTasksFromDLQ")
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) DeleteReplicationTaskFromDLQ(ctx context.Context, request *DeleteReplicationTaskFromDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
+
+	span, ctx := startSpan(ctx, p.tracer, "DeleteReplicationTaskFromDLQ")
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteReplicationTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
+
+	span, ctx := startSpan(ctx, p.tracer, "RangeDeleteReplicationTaskFromDLQ")
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetTimerIndexTasks")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceLatency)
-	resonse, err := p.persistence.GetTimerIndexTasks(request)
+	resonse, err := p.persistence.GetTimerIndexTasks(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetTimerIndexTasksScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetTimerIndexTasksScope, err)
 	}
 
 	return resonse, err
 }
 
-func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CompleteTimerTask")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteTimerTask(request)
+	err := p.persistence.CompleteTimerTask(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCompleteTimerTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCompleteTimerTaskScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "RangeCompleteTimerTask")
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.RangeCompleteTimerTask(request)
+	err := p.persistence.RangeCompleteTimerTask(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceRangeCompleteTimerTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceRangeCompleteTimerTaskScope, err)
 	}
 
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *workflowExecutionPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *WorkflowExecutionAlreadyStartedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrExecutionAlreadyStartedCounter)
@@ -475,110 +627,130 @@ func (p *taskPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+func (p *taskPersistenceClient) CreateTasks(ctx context.Context, request *CreateTasksRequest) (*CreateTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CreateTasks")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateTaskScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CreateTasks(request)
+	response, err := p.persistence.CreateTasks(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCreateTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCreateTaskScope, err)
 	}
 
 	return response, err
 }
 
-func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+func (p *taskPersistenceClient) GetTasks(ctx context.Context, request *GetTasksRequest) (*GetTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTasksScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetTasks")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTasksScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetTasks(request)
+	response, err := p.persistence.GetTasks(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetTasksScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetTasksScope, err)
 	}
 
 	return response, err
 }
 
-func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+func (p *taskPersistenceClient) CompleteTask(ctx context.Context, request *CompleteTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTaskScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CompleteTask")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteTask(request)
+	err := p.persistence.CompleteTask(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCompleteTaskScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCompleteTaskScope, err)
 	}
 
 	return err
 }
 
-func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+func (p *taskPersistenceClient) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "CompleteTasksLessThan")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceLatency)
-	result, err := p.persistence.CompleteTasksLessThan(request)
+	result, err := p.persistence.CompleteTasksLessThan(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCompleteTasksLessThanScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCompleteTasksLessThanScope, err)
 	}
 	return result, err
 }
 
-func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+func (p *taskPersistenceClient) LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "LeaseTaskList")
 	sw := p.metricClient.StartTimer(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceLatency)
-	response, err := p.persistence.LeaseTaskList(request)
+	response, err := p.persistence.LeaseTaskList(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceLeaseTaskListScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceLeaseTaskListScope, err)
 	}
 
 	return response, err
 }
 
-func (p *taskPersistenceClient) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
+func (p *taskPersistenceClient) ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListTaskListScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "ListTaskList")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListTaskListScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListTaskList(request)
+	response, err := p.persistence.ListTaskList(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListTaskListScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListTaskListScope, err)
 	}
 	return response, err
 }
 
-func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+func (p *taskPersistenceClient) DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "DeleteTaskList")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteTaskList(request)
+	err := p.persistence.DeleteTaskList(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteTaskListScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteTaskListScope, err)
 	}
 	return err
 }
 
-func (p *taskPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+func (p *taskPersistenceClient) UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "UpdateTaskList")
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceLatency)
-	response, err := p.persistence.UpdateTaskList(request)
+	response, err := p.persistence.UpdateTaskList(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceUpdateTaskListScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceUpdateTaskListScope, err)
 	}
 
 	return response, err
 }
 
-func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *taskPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
@@ -603,66 +775,78 @@ func (p *historyPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *historyPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+func (p *historyPersistenceClient) AppendHistoryEvents(ctx context.Context, request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryEventsScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "AppendHistoryEvents")
 	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryEventsScope, metrics.PersistenceLatency)
-	resp, err := p.persistence.AppendHistoryEvents(request)
+	resp, err := p.persistence.AppendHistoryEvents(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceAppendHistoryEventsScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceAppendHistoryEventsScope, err)
 	}
 
 	return resp, err
 }
 
-func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
+func (p *historyPersistenceClient) GetWorkflowExecutionHistory(ctx context.Context,
 	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetWorkflowExecutionHistory")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetWorkflowExecutionHistory(request)
+	response, err := p.persistence.GetWorkflowExecutionHistory(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
 	}
 
 	return response, err
 }
 
-func (p *historyPersistenceClient) GetWorkflowExecutionHistoryByBatch(
+func (p *historyPersistenceClient) GetWorkflowExecutionHistoryByBatch(ctx context.Context,
 	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetWorkflowExecutionHistoryByBatch")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(request)
+	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
 	}
 
 	return response, err
 }
 
-func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
+func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(ctx context.Context,
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "DeleteWorkflowExecutionHistory")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteWorkflowExecutionHistory(request)
+	err := p.persistence.DeleteWorkflowExecutionHistory(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteWorkflowExecutionHistoryScope, err)
 	}
 
 	return err
 }
 
-func (p *historyPersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *historyPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *workflow.EntityNotExistsError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
@@ -689,99 +873,113 @@ func (p *metadataPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *metadataPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+func (p *metadataPersistenceClient) CreateDomain(ctx context.Context, request *CreateDomainRequest) (*CreateDomainResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateDomainScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CreateDomain")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateDomainScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CreateDomain(request)
+	response, err := p.persistence.CreateDomain(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCreateDomainScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCreateDomainScope, err)
 	}
 
 	return response, err
 }
 
-func (p *metadataPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+func (p *metadataPersistenceClient) GetDomain(ctx context.Context, request *GetDomainRequest) (*GetDomainResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetDomainScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetDomain")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetDomainScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetDomain(request)
+	response, err := p.persistence.GetDomain(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetDomainScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetDomainScope, err)
 	}
 
 	return response, err
 }
 
-func (p *metadataPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
+func (p *metadataPersistenceClient) UpdateDomain(ctx context.Context, request *UpdateDomainRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateDomainScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "UpdateDomain")
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateDomainScope, metrics.PersistenceLatency)
-	err := p.persistence.UpdateDomain(request)
+	err := p.persistence.UpdateDomain(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceUpdateDomainScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceUpdateDomainScope, err)
 	}
 
 	return err
 }
 
-func (p *metadataPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
+func (p *metadataPersistenceClient) DeleteDomain(ctx context.Context, request *DeleteDomainRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteDomainScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "DeleteDomain")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteDomainScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteDomain(request)
+	err := p.persistence.DeleteDomain(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteDomainScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteDomainScope, err)
 	}
 
 	return err
 }
 
-func (p *metadataPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+func (p *metadataPersistenceClient) DeleteDomainByName(ctx context.Context, request *DeleteDomainByNameRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteDomainByNameScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "DeleteDomainByName")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteDomainByNameScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteDomainByName(request)
+	err := p.persistence.DeleteDomainByName(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteDomainByNameScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteDomainByNameScope, err)
 	}
 
 	return err
 }
 
-func (p *metadataPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+func (p *metadataPersistenceClient) ListDomains(ctx context.Context, request *ListDomainsRequest) (*ListDomainsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListDomainScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListDomains")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListDomainScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListDomains(request)
+	response, err := p.persistence.ListDomains(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListDomainScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListDomainScope, err)
 	}
 
 	return response, err
 }
 
-func (p *metadataPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+func (p *metadataPersistenceClient) GetMetadata(ctx context.Context) (*GetMetadataResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetMetadataScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetMetadata")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetMetadataScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetMetadata()
+	response, err := p.persistence.GetMetadata(ctx)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetMetadataScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetMetadataScope, err)
 	}
 
 	return response, err
@@ -791,7 +989,11 @@ func (p *metadataPersistenceClient) Close() {
 	p.persistence.Close()
 }
 
-func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *metadataPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *workflow.DomainAlreadyExistsError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrDomainAlreadyExistsCounter)
@@ -813,203 +1015,235 @@ func (p *visibilityPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *visibilityPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+func (p *visibilityPersistenceClient) RecordWorkflowExecutionStarted(ctx context.Context, request *RecordWorkflowExecutionStartedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "RecordWorkflowExecutionStarted")
 	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceLatency)
-	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	err := p.persistence.RecordWorkflowExecutionStarted(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceRecordWorkflowExecutionStartedScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceRecordWorkflowExecutionStartedScope, err)
 	}
 
 	return err
 }
 
-func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(ctx context.Context, request *RecordWorkflowExecutionClosedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "RecordWorkflowExecutionClosed")
 	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceLatency)
-	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	err := p.persistence.RecordWorkflowExecutionClosed(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceRecordWorkflowExecutionClosedScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceRecordWorkflowExecutionClosedScope, err)
 	}
 
 	return err
 }
 
-func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListOpenWorkflowExecutions")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	response, err := p.persistence.ListOpenWorkflowExecutions(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListOpenWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListClosedWorkflowExecutions")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	response, err := p.persistence.ListClosedWorkflowExecutions(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListClosedWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListOpenWorkflowExecutionsByType")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListClosedWorkflowExecutionsByType")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListOpenWorkflowExecutionsByWorkflowID")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListClosedWorkflowExecutionsByWorkflowID")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListClosedWorkflowExecutionsByStatus")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "GetClosedWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	response, err := p.persistence.GetClosedWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetClosedWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetClosedWorkflowExecutionScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+func (p *visibilityPersistenceClient) DeleteWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "DeleteWorkflowExecution")
 	sw := p.metricClient.StartTimer(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteWorkflowExecution(request)
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, err)
 	}
 
 	return err
 }
 
-func (p *visibilityPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ListWorkflowExecutions")
 	sw := p.metricClient.StartTimer(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListWorkflowExecutions(request)
+	response, err := p.persistence.ListWorkflowExecutions(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceListWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceListWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "ScanWorkflowExecutions")
 	sw := p.metricClient.StartTimer(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ScanWorkflowExecutions(request)
+	response, err := p.persistence.ScanWorkflowExecutions(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceScanWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceScanWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span, ctx := startSpan(ctx, p.tracer, "CountWorkflowExecutions")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CountWorkflowExecutions(request)
+	response, err := p.persistence.CountWorkflowExecutions(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCountWorkflowExecutionsScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCountWorkflowExecutionsScope, err)
 	}
 
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *visibilityPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
@@ -1041,90 +1275,108 @@ func (p *historyV2PersistenceClient) Close() {
 }
 
 // AppendHistoryNodes add(or override) a node to a history branch
-func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+func (p *historyV2PersistenceClient) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "AppendHistoryNodes")
 	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceLatency)
-	resp, err := p.persistence.AppendHistoryNodes(request)
+	resp, err := p.persistence.AppendHistoryNodes(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceAppendHistoryNodesScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceAppendHistoryNodesScope, err)
 	}
 	return resp, err
 }
 
 // ReadHistoryBranch returns history node data for a branch
-func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+func (p *historyV2PersistenceClient) ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "ReadHistoryBranch")
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ReadHistoryBranch(request)
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceReadHistoryBranchScope, err)
 	}
 	return response, err
 }
 
 // ReadHistoryBranchByBatch returns history node data for a branch ByBatch
-func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "ReadHistoryBranchByBatch")
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceReadHistoryBranchScope, err)
 	}
 	return response, err
 }
 
 // ForkHistoryBranch forks a new branch from a old branch
-func (p *historyV2PersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+func (p *historyV2PersistenceClient) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "ForkHistoryBranch")
 	sw := p.metricClient.StartTimer(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ForkHistoryBranch(request)
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceForkHistoryBranchScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceForkHistoryBranchScope, err)
 	}
 	return response, err
 }
 
 // DeleteHistoryBranch removes a branch
-func (p *historyV2PersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+func (p *historyV2PersistenceClient) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "DeleteHistoryBranch")
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteHistoryBranch(request)
+	err := p.persistence.DeleteHistoryBranch(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceDeleteHistoryBranchScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteHistoryBranchScope, err)
 	}
 	return err
 }
 
 // CompleteForkBranch complete forking process
-func (p *historyV2PersistenceClient) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+func (p *historyV2PersistenceClient) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteForkBranchScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "CompleteForkBranch")
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteForkBranchScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteForkBranch(request)
+	err := p.persistence.CompleteForkBranch(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceCompleteForkBranchScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceCompleteForkBranchScope, err)
 	}
 	return err
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (p *historyV2PersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+func (p *historyV2PersistenceClient) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "GetHistoryTree")
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetHistoryTree(request)
+	response, err := p.persistence.GetHistoryTree(ctx, request)
 	sw.Stop()
+	tracing.FinishSpan(span, &err)
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceGetHistoryTreeScope, err)
+		p.updateErrorMetric(ctx, metrics.PersistenceGetHistoryTreeScope, err)
 	}
 	return response, err
 }
 
-func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
+func (p *historyV2PersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
 	switch err.(type) {
 	case *workflow.EntityNotExistsError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
@@ -1142,3 +1394,97 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	}
 }
+
+func (p *clusterMembershipPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMembershipPersistenceClient) UpsertClusterMembership(ctx context.Context, request *UpsertClusterMembershipRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "UpsertClusterMembership")
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceLatency)
+	err := p.persistence.UpsertClusterMembership(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistenceUpsertClusterMembershipScope, err)
+	}
+	return err
+}
+
+func (p *clusterMembershipPersistenceClient) GetClusterMembers(ctx context.Context, request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "GetClusterMembers")
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetClusterMembers(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistenceGetClusterMembersScope, err)
+	}
+	return response, err
+}
+
+func (p *clusterMembershipPersistenceClient) PruneClusterMembership(ctx context.Context, request *PruneClusterMembershipRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "PruneClusterMembership")
+	sw := p.metricClient.StartTimer(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceLatency)
+	err := p.persistence.PruneClusterMembership(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistencePruneClusterMembershipScope, err)
+	}
+	return err
+}
+
+func (p *clusterMembershipPersistenceClient) DeleteClusterMembership(ctx context.Context, request *DeleteClusterMembershipRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteClusterMembershipScope, metrics.PersistenceRequests)
+	span, ctx := startSpan(ctx, p.tracer, "DeleteClusterMembership")
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteClusterMembershipScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteClusterMembership(ctx, request)
+	sw.Stop()
+	tracing.FinishSpan(span, &err)
+	if err != nil {
+		p.updateErrorMetric(ctx, metrics.PersistenceDeleteClusterMembershipScope, err)
+	}
+	return err
+}
+
+func (p *clusterMembershipPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *clusterMembershipPersistenceClient) updateErrorMetric(ctx context.Context, scope int, err error) {
+	if updateContextErrorMetric(ctx, p.metricClient, scope) {
+		return
+	}
+
+	switch err.(type) {
+	case *TimeoutError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(scope))
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+// updateContextErrorMetric counts a failed request whose context was canceled or
+// timed out, and reports whether the context was the cause of the failure
+func updateContextErrorMetric(ctx context.Context, metricClient metrics.Client, scope int) bool {
+	switch ctx.Err() {
+	case context.Canceled:
+		metricClient.IncCounter(scope, metrics.PersistenceErrContextCanceledCounter)
+	case context.DeadlineExceeded:
+		metricClient.IncCounter(scope, metrics.PersistenceErrContextDeadlineExceededCounter)
+		metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		return false
+	}
+	return true
+}
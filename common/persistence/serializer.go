@@ -23,10 +23,14 @@ package persistence
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/metrics"
 )
 
 type (
@@ -71,13 +75,30 @@ type (
 
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+		metricsClient   metrics.Client
+		keyProvider     KeyProvider
 	}
 )
 
 // NewPayloadSerializer returns a PayloadSerializer
 func NewPayloadSerializer() PayloadSerializer {
+	return NewPayloadSerializerWithMetrics(nil)
+}
+
+// NewPayloadSerializerWithMetrics returns a PayloadSerializer which emits compression metrics,
+// metricsClient can be nil
+func NewPayloadSerializerWithMetrics(metricsClient metrics.Client) PayloadSerializer {
+	return NewEncryptingPayloadSerializer(metricsClient, nil)
+}
+
+// NewEncryptingPayloadSerializer returns a PayloadSerializer which encrypts every serialized blob
+// with the active key of keyProvider, blobs are left in plaintext when keyProvider is nil.
+// Encrypted blobs can be deserialized as long as keyProvider still knows their key
+func NewEncryptingPayloadSerializer(metricsClient metrics.Client, keyProvider KeyProvider) PayloadSerializer {
 	return &serializerImpl{
 		thriftrwEncoder: codec.NewThriftRWEncoder(),
+		metricsClient:   metricsClient,
+		keyProvider:     keyProvider,
 	}
 }
 
@@ -165,6 +186,11 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 	switch encodingType {
 	case common.EncodingTypeThriftRW:
 		data, err = t.thriftrwEncode(input)
+	case common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd:
+		data, err = t.thriftrwEncode(input)
+		if err == nil {
+			data, err = t.compress(data, encodingType)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		encodingType = common.EncodingTypeJSON
 		data, err = json.Marshal(input)
@@ -175,7 +201,14 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 	if err != nil {
 		return nil, NewCadenceSerializationError(err.Error())
 	}
-	return NewDataBlob(data, encodingType), nil
+	blob := NewDataBlob(data, encodingType)
+	if t.keyProvider != nil {
+		blob, err = EncryptBlob(blob, t.keyProvider)
+		if err != nil {
+			return nil, NewCadenceSerializationError(err.Error())
+		}
+	}
+	return blob, nil
 }
 
 func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
@@ -205,8 +238,23 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	var err error
 
 	switch data.GetEncoding() {
+	case common.EncodingTypeEncrypted:
+		if t.keyProvider == nil {
+			return NewCadenceDeserializationError("blob is encrypted but no encryption key provider is configured")
+		}
+		var decrypted *DataBlob
+		decrypted, err = DecryptBlob(data, t.keyProvider)
+		if err == nil {
+			return t.deserialize(decrypted, target)
+		}
 	case common.EncodingTypeThriftRW:
 		err = t.thriftrwDecode(data.Data, target)
+	case common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd:
+		var decompressed []byte
+		decompressed, err = t.decompress(data.Data, data.GetEncoding())
+		if err == nil {
+			err = t.thriftrwDecode(decompressed, target)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		err = json.Unmarshal(data.Data, target)
 	default:
@@ -248,6 +296,57 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 	}
 }
 
+func (t *serializerImpl) compress(data []byte, encodingType common.EncodingType) ([]byte, error) {
+	startTime := time.Now()
+	var compressed []byte
+	var err error
+	switch encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		compressed = snappy.Encode(nil, data)
+	case common.EncodingTypeThriftRWZstd:
+		compressed, err = zstd.Compress(nil, data)
+	default:
+		return nil, NewUnknownEncodingTypeError(encodingType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if t.metricsClient != nil {
+		scope := t.metricsClient.Scope(metrics.PersistenceCompressScope, metrics.EncodingTag(string(encodingType)))
+		scope.RecordTimer(metrics.CompressionLatency, time.Since(startTime))
+		scope.RecordTimer(metrics.UncompressedPayloadSize, time.Duration(len(data)))
+		scope.RecordTimer(metrics.CompressedPayloadSize, time.Duration(len(compressed)))
+		if len(compressed) > 0 {
+			scope.UpdateGauge(metrics.CompressionRatio, float64(len(data))/float64(len(compressed)))
+		}
+	}
+	return compressed, nil
+}
+
+func (t *serializerImpl) decompress(data []byte, encodingType common.EncodingType) ([]byte, error) {
+	startTime := time.Now()
+	var decompressed []byte
+	var err error
+	switch encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		decompressed, err = snappy.Decode(nil, data)
+	case common.EncodingTypeThriftRWZstd:
+		decompressed, err = zstd.Decompress(nil, data)
+	default:
+		return nil, NewUnknownEncodingTypeError(encodingType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if t.metricsClient != nil {
+		scope := t.metricsClient.Scope(metrics.PersistenceDecompressScope, metrics.EncodingTag(string(encodingType)))
+		scope.RecordTimer(metrics.CompressionLatency, time.Since(startTime))
+	}
+	return decompressed, nil
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType common.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
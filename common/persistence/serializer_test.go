@@ -338,3 +338,47 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestSerializer_CompressedEncodings() {
+	serializer := NewPayloadSerializer()
+
+	event0 := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(999),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+		ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+			Result:           []byte("result-1-event-1"),
+			ScheduledEventId: common.Int64Ptr(4),
+			StartedEventId:   common.Int64Ptr(5),
+			Identity:         common.StringPtr("event-1"),
+		},
+	}
+	history0 := &workflow.History{Events: []*workflow.HistoryEvent{event0, event0}}
+
+	for _, encodingType := range []common.EncodingType{
+		common.EncodingTypeThriftRWSnappy,
+		common.EncodingTypeThriftRWZstd,
+	} {
+		dEvent, err := serializer.SerializeEvent(event0, encodingType)
+		s.Nil(err)
+		s.Equal(encodingType, dEvent.Encoding)
+
+		event1, err := serializer.DeserializeEvent(dEvent)
+		s.Nil(err)
+		s.True(event0.Equals(event1))
+
+		dEvents, err := serializer.SerializeBatchEvents(history0.Events, encodingType)
+		s.Nil(err)
+		s.Equal(encodingType, dEvents.Encoding)
+
+		events, err := serializer.DeserializeBatchEvents(dEvents)
+		s.Nil(err)
+		history1 := &workflow.History{Events: events}
+		s.True(history0.Equals(history1))
+
+		// a corrupted compressed payload must surface as a deserialization error
+		corrupted := NewDataBlob([]byte("not-compressed"), encodingType)
+		_, err = serializer.DeserializeEvent(corrupted)
+		s.NotNil(err)
+	}
+}
@@ -22,11 +22,11 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/gob"
 	"fmt"
 
-	"github.com/go-sql-driver/mysql"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
@@ -49,8 +49,8 @@ func (m *sqlStore) Close() {
 	}
 }
 
-func (m *sqlStore) txExecute(operation string, f func(tx sqldb.Tx) error) error {
-	tx, err := m.db.BeginTx()
+func (m *sqlStore) txExecute(ctx context.Context, operation string, f func(tx sqldb.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("%s failed. Failed to start transaction. Error: %v", operation, err),
@@ -81,15 +81,6 @@ func (m *sqlStore) txExecute(operation string, f func(tx sqldb.Tx) error) error
 	return nil
 }
 
-// ErrDupEntry MySQL Error 1062 indicates a duplicate primary key i.e. the row already exists,
-// so we don't do the insert and return a ConditionalUpdate error.
-const ErrDupEntry = 1062
-
-func isDupEntry(err error) bool {
-	sqlErr, ok := err.(*mysql.MySQLError)
-	return ok && sqlErr.Number == ErrDupEntry
-}
-
 func gobSerialize(x interface{}) ([]byte, error) {
 	b := bytes.Buffer{}
 	e := gob.NewEncoder(&b)
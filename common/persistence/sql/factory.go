@@ -33,8 +33,12 @@ import (
 type (
 	// Factory vends store objects backed by MySQL
 	Factory struct {
-		cfg         config.SQL
-		dbConn      dbConn
+		cfg              config.SQL
+		numHistoryShards int
+		dbConn           dbConn
+		// shardConns are the databases history shards and task lists are
+		// spread across, it only holds dbConn when the store is not sharded
+		shardConns  []*dbConn
 		clusterName string
 		logger      log.Logger
 	}
@@ -52,13 +56,30 @@ type (
 
 // NewFactory returns an instance of a factory object which can be used to create
 // datastores backed by any kind of SQL store
-func NewFactory(cfg config.SQL, clusterName string, logger log.Logger) *Factory {
-	return &Factory{
-		cfg:         cfg,
-		clusterName: clusterName,
-		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg),
+func NewFactory(cfg config.SQL, numHistoryShards int, clusterName string, logger log.Logger) *Factory {
+	f := &Factory{
+		cfg:              cfg,
+		numHistoryShards: numHistoryShards,
+		clusterName:      clusterName,
+		logger:           logger,
+		dbConn:           newRefCountedDBConn(&cfg),
 	}
+	f.shardConns = []*dbConn{&f.dbConn}
+	if len(cfg.ShardConnectAddrs) > 0 {
+		f.shardConns = make([]*dbConn, len(cfg.ShardConnectAddrs))
+		for i, addr := range cfg.ShardConnectAddrs {
+			if addr == cfg.ConnectAddr {
+				f.shardConns[i] = &f.dbConn
+				continue
+			}
+			shardCfg := cfg
+			shardCfg.ConnectAddr = addr
+			shardCfg.ShardConnectAddrs = nil
+			conn := newRefCountedDBConn(&shardCfg)
+			f.shardConns[i] = &conn
+		}
+	}
+	return f
 }
 
 // NewTaskStore returns a new task store
@@ -67,16 +88,35 @@ func (f *Factory) NewTaskStore() (p.TaskStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newTaskPersistence(conn, f.cfg.NumShards, f.logger)
+	shardDBs := make([]sqldb.Interface, len(f.shardConns))
+	for i, c := range f.shardConns {
+		if shardDBs[i], err = c.get(); err != nil {
+			return nil, err
+		}
+	}
+	return newTaskPersistence(conn, shardDBs, f.cfg.NumShards, f.logger)
 }
 
 // NewShardStore returns a new shard store
 func (f *Factory) NewShardStore() (p.ShardStore, error) {
-	conn, err := f.dbConn.get()
-	if err != nil {
-		return nil, err
+	if !f.isSharded() {
+		conn, err := f.dbConn.get()
+		if err != nil {
+			return nil, err
+		}
+		return newShardPersistence(conn, f.clusterName, f.logger)
 	}
-	return newShardPersistence(conn, f.clusterName, f.logger)
+	stores := make([]p.ShardStore, len(f.shardConns))
+	for i, c := range f.shardConns {
+		conn, err := c.get()
+		if err != nil {
+			return nil, err
+		}
+		if stores[i], err = newShardPersistence(conn, f.clusterName, f.logger); err != nil {
+			return nil, err
+		}
+	}
+	return newShardedShardStore(stores, f.shardDBIndex), nil
 }
 
 // NewHistoryStore returns a new history store
@@ -90,11 +130,24 @@ func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
 
 // NewHistoryV2Store returns a new history store
 func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
-	conn, err := f.dbConn.get()
-	if err != nil {
-		return nil, err
+	if !f.isSharded() {
+		conn, err := f.dbConn.get()
+		if err != nil {
+			return nil, err
+		}
+		return newHistoryV2Persistence(conn, f.logger)
 	}
-	return newHistoryV2Persistence(conn, f.logger)
+	stores := make([]p.HistoryV2Store, len(f.shardConns))
+	for i, c := range f.shardConns {
+		conn, err := c.get()
+		if err != nil {
+			return nil, err
+		}
+		if stores[i], err = newHistoryV2Persistence(conn, f.logger); err != nil {
+			return nil, err
+		}
+	}
+	return newShardedHistoryV2Store(stores, f.shardDBIndex), nil
 }
 
 // NewMetadataStore returns a new metadata store
@@ -118,13 +171,22 @@ func (f *Factory) NewMetadataStoreV2() (p.MetadataStore, error) {
 
 // NewExecutionStore returns an ExecutionStore for a given shardID
 func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
-	conn, err := f.dbConn.get()
+	conn, err := f.shardConns[f.shardDBIndex(shardID)].get()
 	if err != nil {
 		return nil, err
 	}
 	return NewSQLExecutionStore(conn, f.logger, shardID)
 }
 
+// NewClusterMembershipStore returns a cluster membership store
+func (f *Factory) NewClusterMembershipStore() (p.ClusterMembershipStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return newClusterMembershipPersistence(conn, f.logger)
+}
+
 // NewVisibilityStore returns a visibility store
 func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return NewSQLVisibilityStore(f.cfg, f.logger)
@@ -133,6 +195,29 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
+	for _, c := range f.shardConns {
+		if c != &f.dbConn {
+			c.forceClose()
+		}
+	}
+}
+
+func (f *Factory) isSharded() bool {
+	return len(f.cfg.ShardConnectAddrs) > 0
+}
+
+// shardDBIndex returns the index in shardConns of the
+// database that holds the given history shard
+func (f *Factory) shardDBIndex(shardID int) int {
+	n := len(f.shardConns)
+	if f.cfg.ShardMapping == config.SQLShardMappingRange && f.numHistoryShards > 0 {
+		idx := shardID * n / f.numHistoryShards
+		if idx >= n {
+			idx = n - 1
+		}
+		return idx
+	}
+	return shardID % n
 }
 
 // newRefCountedDBConn returns a  logical mysql connection that
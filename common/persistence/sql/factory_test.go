@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	factorySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestFactorySuite(t *testing.T) {
+	suite.Run(t, new(factorySuite))
+}
+
+func (s *factorySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *factorySuite) TestShardDBIndex() {
+	cfg := config.SQL{
+		ConnectAddr:       "db0:3306",
+		ShardConnectAddrs: []string{"db0:3306", "db1:3306", "db2:3306"},
+	}
+	f := NewFactory(cfg, 8, "active", loggerimpl.NewNopLogger())
+	s.True(f.isSharded())
+	s.Equal(3, len(f.shardConns))
+	s.True(f.shardConns[0] == &f.dbConn)
+	s.Equal("db1:3306", f.shardConns[1].cfg.ConnectAddr)
+	for shardID, want := range []int{0, 1, 2, 0, 1, 2, 0, 1} {
+		s.Equal(want, f.shardDBIndex(shardID))
+	}
+
+	cfg.ShardMapping = config.SQLShardMappingRange
+	f = NewFactory(cfg, 8, "active", loggerimpl.NewNopLogger())
+	for shardID, want := range []int{0, 0, 0, 1, 1, 1, 2, 2} {
+		s.Equal(want, f.shardDBIndex(shardID))
+	}
+}
+
+func (s *factorySuite) TestNotSharded() {
+	f := NewFactory(config.SQL{ConnectAddr: "db0:3306"}, 8, "active", loggerimpl.NewNopLogger())
+	s.False(f.isSharded())
+	s.Equal(1, len(f.shardConns))
+	for shardID := 0; shardID < 8; shardID++ {
+		s.Equal(0, f.shardDBIndex(shardID))
+	}
+}
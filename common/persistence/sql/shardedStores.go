@@ -0,0 +1,122 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// shardedShardStore routes every request to the
+	// shard store of the database that owns the shard
+	shardedShardStore struct {
+		stores  []p.ShardStore
+		dbIndex func(shardID int) int
+	}
+
+	// shardedHistoryV2Store routes every request to the
+	// history store of the database that owns the shard
+	shardedHistoryV2Store struct {
+		stores  []p.HistoryV2Store
+		dbIndex func(shardID int) int
+	}
+)
+
+var _ p.ShardStore = (*shardedShardStore)(nil)
+var _ p.HistoryV2Store = (*shardedHistoryV2Store)(nil)
+
+func newShardedShardStore(stores []p.ShardStore, dbIndex func(shardID int) int) p.ShardStore {
+	return &shardedShardStore{stores: stores, dbIndex: dbIndex}
+}
+
+func (s *shardedShardStore) store(shardID int) p.ShardStore {
+	return s.stores[s.dbIndex(shardID)]
+}
+
+func (s *shardedShardStore) GetName() string {
+	return s.stores[0].GetName()
+}
+
+func (s *shardedShardStore) Close() {
+	for _, store := range s.stores {
+		store.Close()
+	}
+}
+
+func (s *shardedShardStore) CreateShard(ctx context.Context, request *p.CreateShardRequest) error {
+	return s.store(request.ShardInfo.ShardID).CreateShard(ctx, request)
+}
+
+func (s *shardedShardStore) GetShard(ctx context.Context, request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	return s.store(request.ShardID).GetShard(ctx, request)
+}
+
+func (s *shardedShardStore) UpdateShard(ctx context.Context, request *p.UpdateShardRequest) error {
+	return s.store(request.ShardInfo.ShardID).UpdateShard(ctx, request)
+}
+
+func newShardedHistoryV2Store(stores []p.HistoryV2Store, dbIndex func(shardID int) int) p.HistoryV2Store {
+	return &shardedHistoryV2Store{stores: stores, dbIndex: dbIndex}
+}
+
+func (s *shardedHistoryV2Store) store(shardID int) p.HistoryV2Store {
+	return s.stores[s.dbIndex(shardID)]
+}
+
+func (s *shardedHistoryV2Store) GetName() string {
+	return s.stores[0].GetName()
+}
+
+func (s *shardedHistoryV2Store) Close() {
+	for _, store := range s.stores {
+		store.Close()
+	}
+}
+
+func (s *shardedHistoryV2Store) AppendHistoryNodes(ctx context.Context, request *p.InternalAppendHistoryNodesRequest) error {
+	return s.store(request.ShardID).AppendHistoryNodes(ctx, request)
+}
+
+func (s *shardedHistoryV2Store) ReadHistoryBranch(ctx context.Context, request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	return s.store(request.ShardID).ReadHistoryBranch(ctx, request)
+}
+
+func (s *shardedHistoryV2Store) ForkHistoryBranch(ctx context.Context, request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+	return s.store(request.ShardID).ForkHistoryBranch(ctx, request)
+}
+
+func (s *shardedHistoryV2Store) DeleteHistoryBranch(ctx context.Context, request *p.InternalDeleteHistoryBranchRequest) error {
+	return s.store(request.ShardID).DeleteHistoryBranch(ctx, request)
+}
+
+func (s *shardedHistoryV2Store) CompleteForkBranch(ctx context.Context, request *p.InternalCompleteForkBranchRequest) error {
+	return s.store(request.ShardID).CompleteForkBranch(ctx, request)
+}
+
+func (s *shardedHistoryV2Store) GetHistoryTree(ctx context.Context, request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+	if request.ShardID == nil {
+		return nil, &workflow.InternalServiceError{Message: "GetHistoryTree operation failed. ShardID is not set"}
+	}
+	return s.store(*request.ShardID).GetHistoryTree(ctx, request)
+}
@@ -22,6 +22,7 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -31,6 +32,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
@@ -45,9 +47,9 @@ type sqlExecutionManager struct {
 var _ p.ExecutionStore = (*sqlExecutionManager)(nil)
 
 // txExecuteShardLocked executes f under transaction and with read lock on shard row
-func (m *sqlExecutionManager) txExecuteShardLocked(operation string, rangeID int64, f func(tx sqldb.Tx) error) error {
-	return m.txExecute(operation, func(tx sqldb.Tx) error {
-		if err := readLockShard(tx, m.shardID, rangeID); err != nil {
+func (m *sqlExecutionManager) txExecuteShardLocked(ctx context.Context, operation string, rangeID int64, f func(tx sqldb.Tx) error) error {
+	return m.txExecute(ctx, operation, func(tx sqldb.Tx) error {
+		if err := readLockShard(ctx, tx, m.shardID, rangeID); err != nil {
 			return err
 		}
 		err := f(tx)
@@ -62,15 +64,15 @@ func (m *sqlExecutionManager) GetShardID() int {
 	return m.shardID
 }
 
-func (m *sqlExecutionManager) CreateWorkflowExecution(request *p.InternalCreateWorkflowExecutionRequest) (response *p.CreateWorkflowExecutionResponse, err error) {
-	err = m.txExecuteShardLocked("CreateWorkflowExecution", request.RangeID, func(tx sqldb.Tx) error {
-		response, err = m.createWorkflowExecutionTx(tx, request)
+func (m *sqlExecutionManager) CreateWorkflowExecution(ctx context.Context, request *p.InternalCreateWorkflowExecutionRequest) (response *p.CreateWorkflowExecutionResponse, err error) {
+	err = m.txExecuteShardLocked(ctx, "CreateWorkflowExecution", request.RangeID, func(tx sqldb.Tx) error {
+		response, err = m.createWorkflowExecutionTx(ctx, tx, request)
 		return err
 	})
 	return
 }
 
-func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.InternalCreateWorkflowExecutionRequest) (*p.CreateWorkflowExecutionResponse, error) {
+func (m *sqlExecutionManager) createWorkflowExecutionTx(ctx context.Context, tx sqldb.Tx, request *p.InternalCreateWorkflowExecutionRequest) (*p.CreateWorkflowExecutionResponse, error) {
 	// validate workflow state & close status
 	if err := p.ValidateCreateWorkflowStateCloseStatus(
 		request.State,
@@ -89,7 +91,7 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 	var row *sqldb.CurrentExecutionsRow
 	workflowID := *request.Execution.WorkflowId
 	domainID := sqldb.MustParseUUID(request.DomainID)
-	if row, err = lockCurrentExecutionIfExists(tx, m.shardID, domainID, workflowID); err != nil {
+	if row, err = lockCurrentExecutionIfExists(ctx, tx, m.shardID, domainID, workflowID); err != nil {
 		return nil, err
 	}
 
@@ -139,22 +141,22 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 	}
 
 	runID := sqldb.MustParseUUID(*request.Execution.RunId)
-	if err := createOrUpdateCurrentExecution(tx, request, m.shardID, domainID, runID,
+	if err := createOrUpdateCurrentExecution(ctx, tx, request, m.shardID, domainID, runID,
 		request.State, request.CloseStatus); err != nil {
 		return nil, err
 	}
 
-	if err := createExecutionFromRequest(tx, request, m.shardID, domainID, runID, time.Now()); err != nil {
+	if err := createExecutionFromRequest(ctx, tx, request, m.shardID, domainID, runID, time.Now()); err != nil {
 		return nil, err
 	}
 
-	if err := createTransferTasks(tx, request.TransferTasks, m.shardID, domainID, workflowID, runID); err != nil {
+	if err := createTransferTasks(ctx, tx, request.TransferTasks, m.shardID, domainID, workflowID, runID); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
 		}
 	}
 
-	if err := createReplicationTasks(tx,
+	if err := createReplicationTasks(ctx, tx,
 		request.ReplicationTasks,
 		m.shardID,
 		domainID,
@@ -165,7 +167,7 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 		}
 	}
 
-	if err := createTimerTasks(tx,
+	if err := createTimerTasks(ctx, tx,
 		request.TimerTasks,
 		m.shardID,
 		domainID,
@@ -178,11 +180,11 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 	return &p.CreateWorkflowExecutionResponse{}, nil
 }
 
-func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecutionRequest) (*p.InternalGetWorkflowExecutionResponse, error) {
+func (m *sqlExecutionManager) GetWorkflowExecution(ctx context.Context, request *p.GetWorkflowExecutionRequest) (*p.InternalGetWorkflowExecutionResponse, error) {
 	domainID := sqldb.MustParseUUID(request.DomainID)
 	runID := sqldb.MustParseUUID(*request.Execution.RunId)
 	wfID := *request.Execution.WorkflowId
-	execution, err := m.db.SelectFromExecutions(&sqldb.ExecutionsFilter{
+	execution, err := m.db.SelectFromExecutions(ctx, &sqldb.ExecutionsFilter{
 		ShardID: m.shardID, DomainID: domainID, WorkflowID: wfID, RunID: runID})
 
 	if err != nil {
@@ -274,6 +276,14 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		}
 	}
 
+	if info.Checksum != nil {
+		state.Checksum = checksum.Checksum{
+			Version: int(info.GetChecksumVersion()),
+			Flavor:  checksum.Flavor(info.GetChecksumFlavor()),
+			Value:   info.Checksum,
+		}
+	}
+
 	if info.GetCancelRequested() {
 		state.ExecutionInfo.CancelRequested = true
 		state.ExecutionInfo.CancelRequestID = info.GetCancelRequestID()
@@ -295,7 +305,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.ActivitInfos, err = getActivityInfoMap(m.db,
+		state.ActivitInfos, err = getActivityInfoMap(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -309,7 +319,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.TimerInfos, err = getTimerInfoMap(m.db,
+		state.TimerInfos, err = getTimerInfoMap(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -323,7 +333,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.ChildExecutionInfos, err = getChildExecutionInfoMap(m.db,
+		state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -337,7 +347,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.RequestCancelInfos, err = getRequestCancelInfoMap(m.db,
+		state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -351,7 +361,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.SignalInfos, err = getSignalInfoMap(m.db,
+		state.SignalInfos, err = getSignalInfoMap(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -365,7 +375,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.BufferedEvents, err = getBufferedEvents(m.db,
+		state.BufferedEvents, err = getBufferedEvents(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -379,7 +389,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.BufferedReplicationTasks, err = getBufferedReplicationTasks(m.db,
+		state.BufferedReplicationTasks, err = getBufferedReplicationTasks(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -393,7 +403,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 
 	{
 		var err error
-		state.SignalRequestedIDs, err = getSignalsRequested(m.db,
+		state.SignalRequestedIDs, err = getSignalsRequested(ctx, m.db,
 			m.shardID,
 			domainID,
 			wfID,
@@ -408,9 +418,9 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 	return &p.InternalGetWorkflowExecutionResponse{State: &state}, nil
 }
 
-func getBufferedEvents(
+func getBufferedEvents(ctx context.Context,
 	db sqldb.Interface, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) ([]*p.DataBlob, error) {
-	rows, err := db.SelectFromBufferedEvents(&sqldb.BufferedEventsFilter{
+	rows, err := db.SelectFromBufferedEvents(ctx, &sqldb.BufferedEventsFilter{
 		ShardID:    shardID,
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -428,13 +438,13 @@ func getBufferedEvents(
 	return result, nil
 }
 
-func (m *sqlExecutionManager) UpdateWorkflowExecution(request *p.InternalUpdateWorkflowExecutionRequest) error {
-	return m.txExecuteShardLocked("UpdateWorkflowExecution", request.RangeID, func(tx sqldb.Tx) error {
-		return m.updateWorkflowExecutionTx(tx, request)
+func (m *sqlExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *p.InternalUpdateWorkflowExecutionRequest) error {
+	return m.txExecuteShardLocked(ctx, "UpdateWorkflowExecution", request.RangeID, func(tx sqldb.Tx) error {
+		return m.updateWorkflowExecutionTx(ctx, tx, request)
 	})
 }
 
-func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.InternalUpdateWorkflowExecutionRequest) error {
+func (m *sqlExecutionManager) updateWorkflowExecutionTx(ctx context.Context, tx sqldb.Tx, request *p.InternalUpdateWorkflowExecutionRequest) error {
 	// validate workflow state & close status
 	if err := p.ValidateUpdateWorkflowStateCloseStatus(
 		request.ExecutionInfo.State,
@@ -447,26 +457,26 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 	workflowID := executionInfo.WorkflowID
 	runID := sqldb.MustParseUUID(executionInfo.RunID)
 	shardID := m.shardID
-	if err := createTransferTasks(tx, request.TransferTasks, shardID, domainID, workflowID, runID); err != nil {
+	if err := createTransferTasks(ctx, tx, request.TransferTasks, shardID, domainID, workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
 		}
 	}
 
-	if err := createReplicationTasks(tx, request.ReplicationTasks, shardID, domainID, workflowID, runID); err != nil {
+	if err := createReplicationTasks(ctx, tx, request.ReplicationTasks, shardID, domainID, workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to create replication tasks. Error: %v", err),
 		}
 	}
 
-	if err := createTimerTasks(tx, request.TimerTasks, shardID, domainID, workflowID, runID); err != nil {
+	if err := createTimerTasks(ctx, tx, request.TimerTasks, shardID, domainID, workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to create timer tasks. Error: %v", err),
 		}
 	}
 
 	// TODO Remove me if UPDATE holds the lock to the end of a transaction
-	if err := lockAndCheckNextEventID(tx, shardID, domainID, workflowID, runID, request.Condition); err != nil {
+	if err := lockAndCheckNextEventID(ctx, tx, shardID, domainID, workflowID, runID, request.Condition); err != nil {
 		switch err.(type) {
 		case *p.ConditionFailedError:
 			return err
@@ -477,55 +487,55 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 		}
 	}
 
-	if err := updateExecution(tx, executionInfo, request.ReplicationState, shardID); err != nil {
+	if err := updateExecution(ctx, tx, executionInfo, request.ReplicationState, request.Checksum, shardID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to update executions row. Erorr: %v", err),
 		}
 	}
 
-	if err := updateActivityInfos(tx, request.UpsertActivityInfos, request.DeleteActivityInfos, shardID, domainID,
+	if err := updateActivityInfos(ctx, tx, request.UpsertActivityInfos, request.DeleteActivityInfos, shardID, domainID,
 		workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
-	if err := updateTimerInfos(tx, request.UpserTimerInfos, request.DeleteTimerInfos, shardID, domainID,
+	if err := updateTimerInfos(ctx, tx, request.UpserTimerInfos, request.DeleteTimerInfos, shardID, domainID,
 		workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
-	if err := updateChildExecutionInfos(tx, request.UpsertChildExecutionInfos, request.DeleteChildExecutionInfo,
+	if err := updateChildExecutionInfos(ctx, tx, request.UpsertChildExecutionInfos, request.DeleteChildExecutionInfo,
 		shardID, domainID, workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
-	if err := updateRequestCancelInfos(tx, request.UpsertRequestCancelInfos, request.DeleteRequestCancelInfo,
+	if err := updateRequestCancelInfos(ctx, tx, request.UpsertRequestCancelInfos, request.DeleteRequestCancelInfo,
 		shardID, domainID, workflowID, runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
-	if err := updateSignalInfos(tx, request.UpsertSignalInfos, request.DeleteSignalInfo, shardID, domainID, workflowID,
+	if err := updateSignalInfos(ctx, tx, request.UpsertSignalInfos, request.DeleteSignalInfo, shardID, domainID, workflowID,
 		runID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
-	if err := updateBufferedEvents(tx, request.NewBufferedEvents, request.ClearBufferedEvents, shardID, domainID,
+	if err := updateBufferedEvents(ctx, tx, request.NewBufferedEvents, request.ClearBufferedEvents, shardID, domainID,
 		workflowID, runID, request.Condition, request.RangeID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
-	if err := updateBufferedReplicationTasks(tx,
+	if err := updateBufferedReplicationTasks(ctx, tx,
 		request.NewBufferedReplicationTask,
 		request.DeleteBufferedReplicationTask,
 		shardID,
@@ -537,7 +547,7 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 		}
 	}
 
-	if err := updateSignalsRequested(tx,
+	if err := updateSignalsRequested(ctx, tx,
 		request.UpsertSignalRequestedIDs,
 		request.DeleteSignalRequestedID,
 		shardID,
@@ -572,7 +582,7 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 			lastWriteVersion = request.ContinueAsNew.ReplicationState.LastWriteVersion
 		}
 
-		if err := assertRunIDAndUpdateCurrentExecution(tx,
+		if err := assertRunIDAndUpdateCurrentExecution(ctx, tx,
 			shardID,
 			domainID,
 			workflowID,
@@ -588,11 +598,11 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 			}
 		}
 
-		if err := createExecutionFromRequest(tx, request.ContinueAsNew, shardID, newDomainID, newRunID, time.Now()); err != nil {
+		if err := createExecutionFromRequest(ctx, tx, request.ContinueAsNew, shardID, newDomainID, newRunID, time.Now()); err != nil {
 			return err
 		}
 
-		if err := createTransferTasks(tx,
+		if err := createTransferTasks(ctx, tx,
 			request.ContinueAsNew.TransferTasks,
 			shardID,
 			newDomainID,
@@ -601,7 +611,7 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 			return err
 		}
 
-		if err := createTimerTasks(tx,
+		if err := createTimerTasks(ctx, tx,
 			request.ContinueAsNew.TimerTasks,
 			shardID,
 			newDomainID,
@@ -618,7 +628,7 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 			lastWriteVersion = request.ReplicationState.LastWriteVersion
 		}
 		// this is only to update the current record
-		if err := assertRunIDAndUpdateCurrentExecution(tx,
+		if err := assertRunIDAndUpdateCurrentExecution(ctx, tx,
 			shardID,
 			domainID,
 			workflowID,
@@ -637,10 +647,10 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(tx sqldb.Tx, request *p.
 	return nil
 }
 
-func updateBufferedEvents(tx sqldb.Tx, batch *p.DataBlob, clear bool, shardID int, domainID sqldb.UUID,
+func updateBufferedEvents(ctx context.Context, tx sqldb.Tx, batch *p.DataBlob, clear bool, shardID int, domainID sqldb.UUID,
 	workflowID string, runID sqldb.UUID, condition int64, rangeID int64) error {
 	if clear {
-		if _, err := tx.DeleteFromBufferedEvents(&sqldb.BufferedEventsFilter{
+		if _, err := tx.DeleteFromBufferedEvents(ctx, &sqldb.BufferedEventsFilter{
 			ShardID:    shardID,
 			DomainID:   domainID,
 			WorkflowID: workflowID,
@@ -664,7 +674,7 @@ func updateBufferedEvents(tx sqldb.Tx, batch *p.DataBlob, clear bool, shardID in
 		DataEncoding: string(batch.Encoding),
 	}
 
-	if _, err := tx.InsertIntoBufferedEvents([]sqldb.BufferedEventsRow{row}); err != nil {
+	if _, err := tx.InsertIntoBufferedEvents(ctx, []sqldb.BufferedEventsRow{row}); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("updateBufferedEvents operation failed. Error: %v", err),
 		}
@@ -672,8 +682,8 @@ func updateBufferedEvents(tx sqldb.Tx, batch *p.DataBlob, clear bool, shardID in
 	return nil
 }
 
-func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
-	return m.txExecuteShardLocked("ResetWorkflowExecution", request.RangeID, func(tx sqldb.Tx) error {
+func (m *sqlExecutionManager) ResetWorkflowExecution(ctx context.Context, request *p.InternalResetWorkflowExecutionRequest) error {
+	return m.txExecuteShardLocked(ctx, "ResetWorkflowExecution", request.RangeID, func(tx sqldb.Tx) error {
 		currExecutionInfo := request.CurrExecutionInfo
 		currReplicationState := request.CurrReplicationState
 		domainID := sqldb.MustParseUUID(currExecutionInfo.DomainID)
@@ -693,7 +703,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		// 1. update current execution
-		if err := updateCurrentExecution(tx,
+		if err := updateCurrentExecution(ctx, tx,
 			shardID,
 			domainID,
 			insertExecutionInfo.WorkflowID,
@@ -713,7 +723,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		// It is only needed when base run is not current run. Because we will obtain a lock on current run anyway.
 		if request.BaseRunID != currExecutionInfo.RunID {
 			filter := &sqldb.ExecutionsFilter{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID, RunID: sqldb.MustParseUUID(request.BaseRunID)}
-			_, err := tx.ReadLockExecutions(filter)
+			_, err := tx.ReadLockExecutions(ctx, filter)
 			if err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed at ReadLockExecutions. Error: %v", err),
@@ -723,18 +733,18 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 
 		// 3. update or lock current run
 		if request.UpdateCurr {
-			if err := createTransferTasks(tx, request.CurrTransferTasks, shardID, domainID, workflowID, currRunID); err != nil {
+			if err := createTransferTasks(ctx, tx, request.CurrTransferTasks, shardID, domainID, workflowID, currRunID); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
 				}
 			}
-			if err := createTimerTasks(tx, request.CurrTimerTasks, shardID, domainID, workflowID, currRunID); err != nil {
+			if err := createTimerTasks(ctx, tx, request.CurrTimerTasks, shardID, domainID, workflowID, currRunID); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create timer tasks. Error: %v", err),
 				}
 			}
 
-			if err := lockAndCheckNextEventID(tx, shardID, domainID, workflowID, currRunID, request.Condition); err != nil {
+			if err := lockAndCheckNextEventID(ctx, tx, shardID, domainID, workflowID, currRunID, request.Condition); err != nil {
 				switch err.(type) {
 				case *p.ConditionFailedError:
 					return err
@@ -745,7 +755,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 				}
 			}
 
-			if err := updateExecution(tx, currExecutionInfo, currReplicationState, shardID); err != nil {
+			if err := updateExecution(ctx, tx, currExecutionInfo, currReplicationState, checksum.Checksum{}, shardID); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to update executions row. Erorr: %v", err),
 				}
@@ -754,7 +764,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 			// even the current run is not running, we need to lock the current run:
 			// 1). in case it is changed by conflict resolution
 			// 2). in case delete history timer kicks in if the base is current
-			if err := lockAndCheckNextEventID(tx, shardID, domainID, workflowID, currRunID, request.Condition); err != nil {
+			if err := lockAndCheckNextEventID(ctx, tx, shardID, domainID, workflowID, currRunID, request.Condition); err != nil {
 				switch err.(type) {
 				case *p.ConditionFailedError:
 					return err
@@ -766,26 +776,26 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 			}
 		}
 
-		if err := createReplicationTasks(tx, request.CurrReplicationTasks, shardID, domainID, workflowID, currRunID); err != nil {
+		if err := createReplicationTasks(ctx, tx, request.CurrReplicationTasks, shardID, domainID, workflowID, currRunID); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create replication tasks. Error: %v", err),
 			}
 		}
 
 		// 4. insert records for new run
-		if err := createReplicationTasks(tx, request.InsertReplicationTasks, shardID, domainID, workflowID, newRunID); err != nil {
+		if err := createReplicationTasks(ctx, tx, request.InsertReplicationTasks, shardID, domainID, workflowID, newRunID); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create replication tasks. Error: %v", err),
 			}
 		}
-		if err := createExecution(tx, insertExecutionInfo, insertReplicationState, shardID); err != nil {
+		if err := createExecution(ctx, tx, insertExecutionInfo, insertReplicationState, checksum.Checksum{}, shardID); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create executions row. Erorr: %v", err),
 			}
 		}
 
 		if len(request.InsertActivityInfos) > 0 {
-			if err := updateActivityInfos(tx,
+			if err := updateActivityInfos(ctx, tx,
 				request.InsertActivityInfos,
 				nil,
 				m.shardID,
@@ -799,7 +809,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertTimerInfos) > 0 {
-			if err := updateTimerInfos(tx,
+			if err := updateTimerInfos(ctx, tx,
 				request.InsertTimerInfos,
 				nil,
 				m.shardID,
@@ -813,7 +823,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertRequestCancelInfos) > 0 {
-			if err := updateRequestCancelInfos(tx,
+			if err := updateRequestCancelInfos(ctx, tx,
 				request.InsertRequestCancelInfos,
 				nil,
 				m.shardID,
@@ -827,7 +837,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertChildExecutionInfos) > 0 {
-			if err := updateChildExecutionInfos(tx,
+			if err := updateChildExecutionInfos(ctx, tx,
 				request.InsertChildExecutionInfos,
 				nil,
 				m.shardID,
@@ -841,7 +851,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertSignalInfos) > 0 {
-			if err := updateSignalInfos(tx,
+			if err := updateSignalInfos(ctx, tx,
 				request.InsertSignalInfos,
 				nil,
 				m.shardID,
@@ -855,7 +865,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertSignalRequestedIDs) > 0 {
-			if err := updateSignalsRequested(tx,
+			if err := updateSignalsRequested(ctx, tx,
 				request.InsertSignalRequestedIDs,
 				"",
 				m.shardID,
@@ -869,7 +879,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertTimerTasks) > 0 {
-			if err := createTimerTasks(tx, request.InsertTimerTasks, shardID, domainID, workflowID, newRunID); err != nil {
+			if err := createTimerTasks(ctx, tx, request.InsertTimerTasks, shardID, domainID, workflowID, newRunID); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create timer tasks. Error: %v", err),
 				}
@@ -877,7 +887,7 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 		}
 
 		if len(request.InsertTransferTasks) > 0 {
-			if err := createTransferTasks(tx, request.InsertTransferTasks, shardID, domainID, workflowID, newRunID); err != nil {
+			if err := createTransferTasks(ctx, tx, request.InsertTransferTasks, shardID, domainID, workflowID, newRunID); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
 				}
@@ -888,13 +898,13 @@ func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWor
 	})
 }
 
-func (m *sqlExecutionManager) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
-	return m.txExecuteShardLocked("ResetMutableState", request.RangeID, func(tx sqldb.Tx) error {
-		return m.resetMutableStateTx(tx, request)
+func (m *sqlExecutionManager) ResetMutableState(ctx context.Context, request *p.InternalResetMutableStateRequest) error {
+	return m.txExecuteShardLocked(ctx, "ResetMutableState", request.RangeID, func(tx sqldb.Tx) error {
+		return m.resetMutableStateTx(ctx, tx, request)
 	})
 }
 
-func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.InternalResetMutableStateRequest) error {
+func (m *sqlExecutionManager) resetMutableStateTx(ctx context.Context, tx sqldb.Tx, request *p.InternalResetMutableStateRequest) error {
 	info := request.ExecutionInfo
 	replicationState := request.ReplicationState
 	domainID := sqldb.MustParseUUID(info.DomainID)
@@ -902,8 +912,14 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 	prevRunID := sqldb.MustParseUUID(request.PrevRunID)
 	prevLastWriteVersion := request.PrevLastWriteVersion
 	prevState := request.PrevState
+	startVersion := common.EmptyVersion
+	lastWriteVersion := common.EmptyVersion
+	if replicationState != nil {
+		startVersion = replicationState.StartVersion
+		lastWriteVersion = replicationState.LastWriteVersion
+	}
 
-	if err := assertAndUpdateCurrentExecution(tx,
+	if err := assertAndUpdateCurrentExecution(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -914,8 +930,8 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		info.CreateRequestID,
 		info.State,
 		info.CloseStatus,
-		replicationState.StartVersion,
-		replicationState.LastWriteVersion); err != nil {
+		startVersion,
+		lastWriteVersion); err != nil {
 		return &workflow.InternalServiceError{Message: fmt.Sprintf(
 			"ResetMutableState. Failed to comare and swap the current record. Error: %v",
 			err,
@@ -923,7 +939,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 	}
 
 	// TODO Is there a way to modify the various map tables without fear of other people adding rows after we delete, without locking the executions row?
-	if err := lockAndCheckNextEventID(tx,
+	if err := lockAndCheckNextEventID(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -939,13 +955,13 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateExecution(tx, info, request.ReplicationState, m.shardID); err != nil {
+	if err := updateExecution(ctx, tx, info, request.ReplicationState, request.Checksum, m.shardID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to update executions row. Erorr: %v", err),
 		}
 	}
 
-	if err := deleteActivityInfoMap(tx,
+	if err := deleteActivityInfoMap(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -955,7 +971,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateActivityInfos(tx,
+	if err := updateActivityInfos(ctx, tx,
 		request.InsertActivityInfos,
 		nil,
 		m.shardID,
@@ -967,7 +983,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := deleteTimerInfoMap(tx,
+	if err := deleteTimerInfoMap(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -977,7 +993,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateTimerInfos(tx,
+	if err := updateTimerInfos(ctx, tx,
 		request.InsertTimerInfos,
 		nil,
 		m.shardID,
@@ -989,7 +1005,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := deleteChildExecutionInfoMap(tx,
+	if err := deleteChildExecutionInfoMap(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -999,7 +1015,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateChildExecutionInfos(tx,
+	if err := updateChildExecutionInfos(ctx, tx,
 		request.InsertChildExecutionInfos,
 		nil,
 		m.shardID,
@@ -1011,7 +1027,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := deleteRequestCancelInfoMap(tx,
+	if err := deleteRequestCancelInfoMap(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -1021,7 +1037,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateRequestCancelInfos(tx,
+	if err := updateRequestCancelInfos(ctx, tx,
 		request.InsertRequestCancelInfos,
 		nil,
 		m.shardID,
@@ -1033,7 +1049,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := deleteSignalInfoMap(tx,
+	if err := deleteSignalInfoMap(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -1043,7 +1059,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := deleteBufferedReplicationTasksMap(tx,
+	if err := deleteBufferedReplicationTasksMap(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -1053,7 +1069,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateBufferedEvents(tx, nil,
+	if err := updateBufferedEvents(ctx, tx, nil,
 		true,
 		m.shardID,
 		domainID,
@@ -1064,7 +1080,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateSignalInfos(tx,
+	if err := updateSignalInfos(ctx, tx,
 		request.InsertSignalInfos,
 		nil,
 		m.shardID,
@@ -1076,7 +1092,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := deleteSignalsRequestedSet(tx,
+	if err := deleteSignalsRequestedSet(ctx, tx,
 		m.shardID,
 		domainID,
 		info.WorkflowID,
@@ -1086,7 +1102,7 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		}
 	}
 
-	if err := updateSignalsRequested(tx,
+	if err := updateSignalsRequested(ctx, tx,
 		request.InsertSignalRequestedIDs,
 		"",
 		m.shardID,
@@ -1100,10 +1116,10 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 	return nil
 }
 
-func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowExecutionRequest) error {
+func (m *sqlExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *p.DeleteWorkflowExecutionRequest) error {
 	domainID := sqldb.MustParseUUID(request.DomainID)
 	runID := sqldb.MustParseUUID(request.RunID)
-	_, err := m.db.DeleteFromExecutions(&sqldb.ExecutionsFilter{
+	_, err := m.db.DeleteFromExecutions(ctx, &sqldb.ExecutionsFilter{
 		ShardID:    m.shardID,
 		DomainID:   domainID,
 		WorkflowID: request.WorkflowID,
@@ -1116,10 +1132,10 @@ func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowE
 // here was finished. In that case, current_executions table will have the same workflowID but different
 // runID. The following code will delete the row from current_executions if and only if the runID is
 // same as the one we are trying to delete here
-func (m *sqlExecutionManager) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+func (m *sqlExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *p.DeleteCurrentWorkflowExecutionRequest) error {
 	domainID := sqldb.MustParseUUID(request.DomainID)
 	runID := sqldb.MustParseUUID(request.RunID)
-	_, err := m.db.DeleteFromCurrentExecutions(&sqldb.CurrentExecutionsFilter{
+	_, err := m.db.DeleteFromCurrentExecutions(ctx, &sqldb.CurrentExecutionsFilter{
 		ShardID:    int64(m.shardID),
 		DomainID:   domainID,
 		WorkflowID: request.WorkflowID,
@@ -1128,8 +1144,8 @@ func (m *sqlExecutionManager) DeleteCurrentWorkflowExecution(request *p.DeleteCu
 	return err
 }
 
-func (m *sqlExecutionManager) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
-	row, err := m.db.SelectFromCurrentExecutions(&sqldb.CurrentExecutionsFilter{
+func (m *sqlExecutionManager) GetCurrentExecution(ctx context.Context, request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
+	row, err := m.db.SelectFromCurrentExecutions(ctx, &sqldb.CurrentExecutionsFilter{
 		ShardID:    int64(m.shardID),
 		DomainID:   sqldb.MustParseUUID(request.DomainID),
 		WorkflowID: request.WorkflowID,
@@ -1151,8 +1167,8 @@ func (m *sqlExecutionManager) GetCurrentExecution(request *p.GetCurrentExecution
 	}, nil
 }
 
-func (m *sqlExecutionManager) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
-	rows, err := m.db.SelectFromTransferTasks(&sqldb.TransferTasksFilter{
+func (m *sqlExecutionManager) GetTransferTasks(ctx context.Context, request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
+	rows, err := m.db.SelectFromTransferTasks(ctx, &sqldb.TransferTasksFilter{
 		ShardID: m.shardID, MinTaskID: &request.ReadLevel, MaxTaskID: &request.MaxReadLevel})
 	if err != nil {
 		if err != sql.ErrNoRows {
@@ -1186,8 +1202,8 @@ func (m *sqlExecutionManager) GetTransferTasks(request *p.GetTransferTasksReques
 	return resp, nil
 }
 
-func (m *sqlExecutionManager) CompleteTransferTask(request *p.CompleteTransferTaskRequest) error {
-	if _, err := m.db.DeleteFromTransferTasks(&sqldb.TransferTasksFilter{
+func (m *sqlExecutionManager) CompleteTransferTask(ctx context.Context, request *p.CompleteTransferTaskRequest) error {
+	if _, err := m.db.DeleteFromTransferTasks(ctx, &sqldb.TransferTasksFilter{
 		ShardID: m.shardID,
 		TaskID:  &request.TaskID,
 	}); err != nil {
@@ -1198,8 +1214,8 @@ func (m *sqlExecutionManager) CompleteTransferTask(request *p.CompleteTransferTa
 	return nil
 }
 
-func (m *sqlExecutionManager) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
-	if _, err := m.db.DeleteFromTransferTasks(&sqldb.TransferTasksFilter{
+func (m *sqlExecutionManager) RangeCompleteTransferTask(ctx context.Context, request *p.RangeCompleteTransferTaskRequest) error {
+	if _, err := m.db.DeleteFromTransferTasks(ctx, &sqldb.TransferTasksFilter{
 		ShardID:   m.shardID,
 		MinTaskID: &request.ExclusiveBeginTaskID,
 		MaxTaskID: &request.InclusiveEndTaskID}); err != nil {
@@ -1210,7 +1226,7 @@ func (m *sqlExecutionManager) RangeCompleteTransferTask(request *p.RangeComplete
 	return nil
 }
 
-func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
+func (m *sqlExecutionManager) GetReplicationTasks(ctx context.Context, request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
 	var readLevel int64
 	var maxReadLevelInclusive int64
 	var err error
@@ -1225,7 +1241,7 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 	maxReadLevelInclusive = collection.MaxInt64(
 		readLevel+int64(request.BatchSize), request.MaxReadLevel)
 
-	rows, err := m.db.SelectFromReplicationTasks(&sqldb.ReplicationTasksFilter{
+	rows, err := m.db.SelectFromReplicationTasks(ctx, &sqldb.ReplicationTasksFilter{
 		ShardID:   m.shardID,
 		MinTaskID: &readLevel,
 		MaxTaskID: &maxReadLevelInclusive,
@@ -1238,6 +1254,10 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 			}
 		}
 	}
+	return m.populateGetReplicationTasksResponse(rows, request.MaxReadLevel)
+}
+
+func (m *sqlExecutionManager) populateGetReplicationTasksResponse(rows []sqldb.ReplicationTasksRow, maxReadLevel int64) (*p.GetReplicationTasksResponse, error) {
 	if len(rows) == 0 {
 		return &p.GetReplicationTasksResponse{}, nil
 	}
@@ -1277,7 +1297,7 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 	}
 	var nextPageToken []byte
 	lastTaskID := rows[len(rows)-1].TaskID
-	if lastTaskID < request.MaxReadLevel {
+	if lastTaskID < maxReadLevel {
 		nextPageToken = serializePageToken(lastTaskID)
 	}
 	return &p.GetReplicationTasksResponse{
@@ -1286,8 +1306,8 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 	}, nil
 }
 
-func (m *sqlExecutionManager) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
-	if _, err := m.db.DeleteFromReplicationTasks(&sqldb.ReplicationTasksFilter{
+func (m *sqlExecutionManager) CompleteReplicationTask(ctx context.Context, request *p.CompleteReplicationTaskRequest) error {
+	if _, err := m.db.DeleteFromReplicationTasks(ctx, &sqldb.ReplicationTasksFilter{
 		ShardID: m.shardID,
 		TaskID:  &request.TaskID,
 	}); err != nil {
@@ -1298,6 +1318,114 @@ func (m *sqlExecutionManager) CompleteReplicationTask(request *p.CompleteReplica
 	return nil
 }
 
+func (m *sqlExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *p.PutReplicationTaskToDLQRequest) error {
+	replicationTask := request.TaskInfo
+	lastReplicationInfo := make(map[string]*sqlblobs.ReplicationInfo, len(replicationTask.LastReplicationInfo))
+	for k, v := range replicationTask.LastReplicationInfo {
+		lastReplicationInfo[k] = &sqlblobs.ReplicationInfo{Version: common.Int64Ptr(v.Version), LastEventID: common.Int64Ptr(v.LastEventID)}
+	}
+
+	blob, err := replicationTaskInfoToBlob(&sqlblobs.ReplicationTaskInfo{
+		DomainID:                sqldb.MustParseUUID(replicationTask.DomainID),
+		WorkflowID:              &replicationTask.WorkflowID,
+		RunID:                   sqldb.MustParseUUID(replicationTask.RunID),
+		TaskType:                common.Int16Ptr(int16(replicationTask.TaskType)),
+		FirstEventID:            &replicationTask.FirstEventID,
+		NextEventID:             &replicationTask.NextEventID,
+		Version:                 &replicationTask.Version,
+		LastReplicationInfo:     lastReplicationInfo,
+		ScheduledID:             &replicationTask.ScheduledID,
+		EventStoreVersion:       &replicationTask.EventStoreVersion,
+		NewRunEventStoreVersion: &replicationTask.NewRunEventStoreVersion,
+		BranchToken:             replicationTask.BranchToken,
+		NewRunBranchToken:       replicationTask.NewRunBranchToken,
+		ResetWorkflow:           &replicationTask.ResetWorkflow,
+	})
+	if err != nil {
+		return err
+	}
+
+	row := &sqldb.ReplicationTasksDLQRow{
+		SourceClusterName: request.SourceClusterName,
+		ShardID:           m.shardID,
+		TaskID:            replicationTask.TaskID,
+		Data:              blob.Data,
+		DataEncoding:      string(blob.Encoding),
+	}
+
+	// tasks are immutable, so it is fine to overwrite a task which is already in the DLQ
+	if _, err := m.db.ReplaceIntoReplicationTasksDLQ(ctx, row); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (m *sqlExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *p.GetReplicationTasksFromDLQRequest) (*p.GetReplicationTasksFromDLQResponse, error) {
+	var readLevel int64
+	var err error
+	if len(request.NextPageToken) > 0 {
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		readLevel = request.ReadLevel
+	}
+
+	// task ids in the DLQ are sparse, so the page is bounded by the batch size only
+	rows, err := m.db.SelectFromReplicationTasksDLQ(ctx, &sqldb.ReplicationTasksDLQFilter{
+		ReplicationTasksFilter: sqldb.ReplicationTasksFilter{
+			ShardID:   m.shardID,
+			MinTaskID: &readLevel,
+			MaxTaskID: &request.MaxReadLevel,
+			PageSize:  &request.BatchSize,
+		},
+		SourceClusterName: request.SourceClusterName,
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetReplicationTasksFromDLQ operation failed. Select failed: %v", err),
+			}
+		}
+	}
+	return m.populateGetReplicationTasksResponse(rows, request.MaxReadLevel)
+}
+
+func (m *sqlExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *p.DeleteReplicationTaskFromDLQRequest) error {
+	if _, err := m.db.DeleteFromReplicationTasksDLQ(ctx, &sqldb.ReplicationTasksDLQFilter{
+		ReplicationTasksFilter: sqldb.ReplicationTasksFilter{
+			ShardID: m.shardID,
+			TaskID:  &request.TaskID,
+		},
+		SourceClusterName: request.SourceClusterName,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *p.RangeDeleteReplicationTaskFromDLQRequest) error {
+	if _, err := m.db.DeleteFromReplicationTasksDLQ(ctx, &sqldb.ReplicationTasksDLQFilter{
+		ReplicationTasksFilter: sqldb.ReplicationTasksFilter{
+			ShardID:   m.shardID,
+			MinTaskID: &request.ExclusiveBeginTaskID,
+			MaxTaskID: &request.InclusiveEndTaskID,
+		},
+		SourceClusterName: request.SourceClusterName,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 type timerTaskPageToken struct {
 	TaskID    int64
 	Timestamp time.Time
@@ -1311,7 +1439,7 @@ func (t *timerTaskPageToken) deserialize(payload []byte) error {
 	return json.Unmarshal(payload, t)
 }
 
-func (m *sqlExecutionManager) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse, error) {
+func (m *sqlExecutionManager) GetTimerIndexTasks(ctx context.Context, request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse, error) {
 	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
 	if len(request.NextPageToken) > 0 {
 		if err := pageToken.deserialize(request.NextPageToken); err != nil {
@@ -1321,7 +1449,7 @@ func (m *sqlExecutionManager) GetTimerIndexTasks(request *p.GetTimerIndexTasksRe
 		}
 	}
 
-	rows, err := m.db.SelectFromTimerTasks(&sqldb.TimerTasksFilter{
+	rows, err := m.db.SelectFromTimerTasks(ctx, &sqldb.TimerTasksFilter{
 		ShardID:                m.shardID,
 		MinVisibilityTimestamp: &pageToken.Timestamp,
 		TaskID:                 pageToken.TaskID,
@@ -1373,8 +1501,8 @@ func (m *sqlExecutionManager) GetTimerIndexTasks(request *p.GetTimerIndexTasksRe
 	return resp, nil
 }
 
-func (m *sqlExecutionManager) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
-	if _, err := m.db.DeleteFromTimerTasks(&sqldb.TimerTasksFilter{
+func (m *sqlExecutionManager) CompleteTimerTask(ctx context.Context, request *p.CompleteTimerTaskRequest) error {
+	if _, err := m.db.DeleteFromTimerTasks(ctx, &sqldb.TimerTasksFilter{
 		ShardID:             m.shardID,
 		VisibilityTimestamp: &request.VisibilityTimestamp,
 		TaskID:              request.TaskID,
@@ -1386,10 +1514,10 @@ func (m *sqlExecutionManager) CompleteTimerTask(request *p.CompleteTimerTaskRequ
 	return nil
 }
 
-func (m *sqlExecutionManager) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
+func (m *sqlExecutionManager) RangeCompleteTimerTask(ctx context.Context, request *p.RangeCompleteTimerTaskRequest) error {
 	start := request.InclusiveBeginTimestamp
 	end := request.ExclusiveEndTimestamp
-	if _, err := m.db.DeleteFromTimerTasks(&sqldb.TimerTasksFilter{
+	if _, err := m.db.DeleteFromTimerTasks(ctx, &sqldb.TimerTasksFilter{
 		ShardID:                m.shardID,
 		MinVisibilityTimestamp: &start,
 		MaxVisibilityTimestamp: &end,
@@ -1414,8 +1542,8 @@ func NewSQLExecutionStore(db sqldb.Interface, logger log.Logger, shardID int) (p
 
 // lockCurrentExecutionIfExists returns current execution or nil if none is found for the workflowID
 // locking it in the DB
-func lockCurrentExecutionIfExists(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string) (*sqldb.CurrentExecutionsRow, error) {
-	rows, err := tx.LockCurrentExecutionsJoinExecutions(&sqldb.CurrentExecutionsFilter{
+func lockCurrentExecutionIfExists(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string) (*sqldb.CurrentExecutionsRow, error) {
+	rows, err := tx.LockCurrentExecutionsJoinExecutions(ctx, &sqldb.CurrentExecutionsFilter{
 		ShardID: int64(shardID), DomainID: domainID, WorkflowID: workflowID})
 	if err != nil {
 		if err != sql.ErrNoRows {
@@ -1436,7 +1564,7 @@ func lockCurrentExecutionIfExists(tx sqldb.Tx, shardID int, domainID sqldb.UUID,
 	return &rows[0], nil
 }
 
-func createExecutionFromRequest(
+func createExecutionFromRequest(ctx context.Context,
 	tx sqldb.Tx,
 	request *p.InternalCreateWorkflowExecutionRequest,
 	shardID int, domainID sqldb.UUID, runID sqldb.UUID, nowTimestamp time.Time) error {
@@ -1505,6 +1633,11 @@ func createExecutionFromRequest(
 		info.EventStoreVersion = common.Int32Ptr(int32(p.EventStoreVersionV2))
 		info.EventBranchToken = request.BranchToken
 	}
+	if !request.Checksum.IsEmpty() {
+		info.ChecksumVersion = common.Int32Ptr(int32(request.Checksum.Version))
+		info.ChecksumFlavor = common.Int32Ptr(int32(request.Checksum.Flavor))
+		info.Checksum = request.Checksum.Value
+	}
 
 	blob, err := workflowExecutionInfoToBlob(info)
 	if err != nil {
@@ -1520,7 +1653,7 @@ func createExecutionFromRequest(
 		Data:             blob.Data,
 		DataEncoding:     string(blob.Encoding),
 	}
-	_, err = tx.InsertIntoExecutions(row)
+	_, err = tx.InsertIntoExecutions(ctx, row)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to insert into executions table. Error: %v", err),
@@ -1529,7 +1662,7 @@ func createExecutionFromRequest(
 	return nil
 }
 
-func createOrUpdateCurrentExecution(
+func createOrUpdateCurrentExecution(ctx context.Context,
 	tx sqldb.Tx, request *p.InternalCreateWorkflowExecutionRequest, shardID int, domainID sqldb.UUID, runID sqldb.UUID,
 	state int, closeStatus int) error {
 	row := sqldb.CurrentExecutionsRow{
@@ -1551,7 +1684,7 @@ func createOrUpdateCurrentExecution(
 
 	switch request.CreateWorkflowMode {
 	case p.CreateWorkflowModeContinueAsNew:
-		if err := updateCurrentExecution(tx,
+		if err := updateCurrentExecution(ctx, tx,
 			shardID,
 			domainID,
 			*request.Execution.WorkflowId,
@@ -1566,7 +1699,7 @@ func createOrUpdateCurrentExecution(
 			}
 		}
 	case p.CreateWorkflowModeWorkflowIDReuse:
-		if err := updateCurrentExecution(tx,
+		if err := updateCurrentExecution(ctx, tx,
 			shardID,
 			domainID,
 			*request.Execution.WorkflowId,
@@ -1581,7 +1714,7 @@ func createOrUpdateCurrentExecution(
 			}
 		}
 	case p.CreateWorkflowModeBrandNew:
-		if _, err := tx.InsertIntoCurrentExecutions(&row); err != nil {
+		if _, err := tx.InsertIntoCurrentExecutions(ctx, &row); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to insert into current_executions table. Error: %v", err),
 			}
@@ -1593,8 +1726,8 @@ func createOrUpdateCurrentExecution(
 	return nil
 }
 
-func lockAndCheckNextEventID(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID, condition int64) error {
-	nextEventID, err := lockNextEventID(tx, shardID, domainID, workflowID, runID)
+func lockAndCheckNextEventID(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID, condition int64) error {
+	nextEventID, err := lockNextEventID(ctx, tx, shardID, domainID, workflowID, runID)
 	if err != nil {
 		return err
 	}
@@ -1606,8 +1739,8 @@ func lockAndCheckNextEventID(tx sqldb.Tx, shardID int, domainID sqldb.UUID, work
 	return nil
 }
 
-func lockNextEventID(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) (*int64, error) {
-	nextEventID, err := tx.WriteLockExecutions(&sqldb.ExecutionsFilter{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID, RunID: runID})
+func lockNextEventID(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) (*int64, error) {
+	nextEventID, err := tx.WriteLockExecutions(ctx, &sqldb.ExecutionsFilter{ShardID: shardID, DomainID: domainID, WorkflowID: workflowID, RunID: runID})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &workflow.EntityNotExistsError{
@@ -1622,7 +1755,7 @@ func lockNextEventID(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID s
 	return &result, nil
 }
 
-func createTransferTasks(tx sqldb.Tx, transferTasks []p.Task, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+func createTransferTasks(ctx context.Context, tx sqldb.Tx, transferTasks []p.Task, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
 	if len(transferTasks) == 0 {
 		return nil
 	}
@@ -1697,7 +1830,7 @@ func createTransferTasks(tx sqldb.Tx, transferTasks []p.Task, shardID int, domai
 		transferTasksRows[i].DataEncoding = string(blob.Encoding)
 	}
 
-	result, err := tx.InsertIntoTransferTasks(transferTasksRows)
+	result, err := tx.InsertIntoTransferTasks(ctx, transferTasksRows)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to create transfer tasks. Error: %v", err),
@@ -1720,7 +1853,7 @@ func createTransferTasks(tx sqldb.Tx, transferTasks []p.Task, shardID int, domai
 	return nil
 }
 
-func createReplicationTasks(
+func createReplicationTasks(ctx context.Context,
 	tx sqldb.Tx, replicationTasks []p.Task, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
 	if len(replicationTasks) == 0 {
 		return nil
@@ -1795,7 +1928,7 @@ func createReplicationTasks(
 		replicationTasksRows[i].DataEncoding = string(blob.Encoding)
 	}
 
-	result, err := tx.InsertIntoReplicationTasks(replicationTasksRows)
+	result, err := tx.InsertIntoReplicationTasks(ctx, replicationTasksRows)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to create replication tasks. Error: %v", err),
@@ -1818,7 +1951,7 @@ func createReplicationTasks(
 	return nil
 }
 
-func createTimerTasks(
+func createTimerTasks(ctx context.Context,
 	tx sqldb.Tx, timerTasks []p.Task, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
 	if len(timerTasks) > 0 {
 		timerTasksRows := make([]sqldb.TimerTasksRow, len(timerTasks))
@@ -1862,7 +1995,7 @@ func createTimerTasks(
 			timerTasksRows[i].DataEncoding = string(blob.Encoding)
 		}
 
-		result, err := tx.InsertIntoTimerTasks(timerTasksRows)
+		result, err := tx.InsertIntoTimerTasks(ctx, timerTasksRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to create timer tasks. Error: %v", err),
@@ -1885,7 +2018,7 @@ func createTimerTasks(
 	return nil
 }
 
-func assertRunIDAndUpdateCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, newRunID sqldb.UUID, previousRunID sqldb.UUID,
+func assertRunIDAndUpdateCurrentExecution(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, newRunID sqldb.UUID, previousRunID sqldb.UUID,
 	createRequestID string, state int, closeStatus int, startVersion int64, lastWriteVersion int64) error {
 
 	assertFn := func(currentRow *sqldb.CurrentExecutionsRow) error {
@@ -1898,14 +2031,14 @@ func assertRunIDAndUpdateCurrentExecution(tx sqldb.Tx, shardID int, domainID sql
 		}
 		return nil
 	}
-	if err := assertCurrentExecution(tx, shardID, domainID, workflowID, assertFn); err != nil {
+	if err := assertCurrentExecution(ctx, tx, shardID, domainID, workflowID, assertFn); err != nil {
 		return err
 	}
 
-	return updateCurrentExecution(tx, shardID, domainID, workflowID, newRunID, createRequestID, state, closeStatus, startVersion, lastWriteVersion)
+	return updateCurrentExecution(ctx, tx, shardID, domainID, workflowID, newRunID, createRequestID, state, closeStatus, startVersion, lastWriteVersion)
 }
 
-func assertAndUpdateCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, newRunID sqldb.UUID,
+func assertAndUpdateCurrentExecution(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, newRunID sqldb.UUID,
 	previousRunID sqldb.UUID, previousLastWriteVersion int64, previousState int,
 	createRequestID string, state int, closeStatus int, startVersion int64, lastWriteVersion int64) error {
 
@@ -1933,17 +2066,17 @@ func assertAndUpdateCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UU
 		}
 		return nil
 	}
-	if err := assertCurrentExecution(tx, shardID, domainID, workflowID, assertFn); err != nil {
+	if err := assertCurrentExecution(ctx, tx, shardID, domainID, workflowID, assertFn); err != nil {
 		return err
 	}
 
-	return updateCurrentExecution(tx, shardID, domainID, workflowID, newRunID, createRequestID, state, closeStatus, startVersion, lastWriteVersion)
+	return updateCurrentExecution(ctx, tx, shardID, domainID, workflowID, newRunID, createRequestID, state, closeStatus, startVersion, lastWriteVersion)
 }
 
-func assertCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string,
+func assertCurrentExecution(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string,
 	assertFn func(currentRow *sqldb.CurrentExecutionsRow) error) error {
 
-	currentRow, err := tx.LockCurrentExecutions(&sqldb.CurrentExecutionsFilter{
+	currentRow, err := tx.LockCurrentExecutions(ctx, &sqldb.CurrentExecutionsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -1956,9 +2089,9 @@ func assertCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workf
 	return assertFn(currentRow)
 }
 
-func updateCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID,
+func updateCurrentExecution(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID,
 	createRequestID string, state int, closeStatus int, startVersion int64, lastWriteVersion int64) error {
-	result, err := tx.UpdateCurrentExecutions(&sqldb.CurrentExecutionsRow{
+	result, err := tx.UpdateCurrentExecutions(ctx, &sqldb.CurrentExecutionsRow{
 		ShardID:          int64(shardID),
 		DomainID:         domainID,
 		WorkflowID:       workflowID,
@@ -1990,6 +2123,7 @@ func updateCurrentExecution(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workf
 
 func buildExecutionRow(executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
+	csum checksum.Checksum,
 	shardID int) (row *sqldb.ExecutionsRow, err error) {
 	lastWriteVersion := common.EmptyVersion
 	info := &sqlblobs.WorkflowExecutionInfo{
@@ -2068,6 +2202,12 @@ func buildExecutionRow(executionInfo *p.InternalWorkflowExecutionInfo,
 		info.CancelRequestID = &executionInfo.CancelRequestID
 	}
 
+	if !csum.IsEmpty() {
+		info.ChecksumVersion = common.Int32Ptr(int32(csum.Version))
+		info.ChecksumFlavor = common.Int32Ptr(int32(csum.Flavor))
+		info.Checksum = csum.Value
+	}
+
 	blob, err := workflowExecutionInfoToBlob(info)
 	if err != nil {
 		return nil, err
@@ -2085,15 +2225,16 @@ func buildExecutionRow(executionInfo *p.InternalWorkflowExecutionInfo,
 	}, nil
 }
 
-func updateExecution(tx sqldb.Tx,
+func updateExecution(ctx context.Context, tx sqldb.Tx,
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
+	csum checksum.Checksum,
 	shardID int) error {
-	row, err := buildExecutionRow(executionInfo, replicationState, shardID)
+	row, err := buildExecutionRow(executionInfo, replicationState, csum, shardID)
 	if err != nil {
 		return err
 	}
-	result, err := tx.UpdateExecutions(row)
+	result, err := tx.UpdateExecutions(ctx, row)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to update executions row. Erorr: %v", err),
@@ -2114,15 +2255,16 @@ func updateExecution(tx sqldb.Tx,
 	return nil
 }
 
-func createExecution(tx sqldb.Tx,
+func createExecution(ctx context.Context, tx sqldb.Tx,
 	executionInfo *p.InternalWorkflowExecutionInfo,
 	replicationState *p.ReplicationState,
+	csum checksum.Checksum,
 	shardID int) error {
-	row, err := buildExecutionRow(executionInfo, replicationState, shardID)
+	row, err := buildExecutionRow(executionInfo, replicationState, csum, shardID)
 	if err != nil {
 		return err
 	}
-	result, err := tx.InsertIntoExecutions(row)
+	result, err := tx.InsertIntoExecutions(ctx, row)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to insert executions row. Erorr: %v", err),
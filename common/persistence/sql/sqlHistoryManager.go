@@ -21,10 +21,10 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
-	"github.com/go-sql-driver/mysql"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
@@ -47,7 +47,7 @@ func newHistoryPersistence(db sqldb.Interface, logger log.Logger) (p.HistoryStor
 	}, nil
 }
 
-func (m *sqlHistoryManager) AppendHistoryEvents(request *p.InternalAppendHistoryEventsRequest) error {
+func (m *sqlHistoryManager) AppendHistoryEvents(ctx context.Context, request *p.InternalAppendHistoryEventsRequest) error {
 	row := &sqldb.EventsRow{
 		DomainID:     sqldb.MustParseUUID(request.DomainID),
 		WorkflowID:   *request.Execution.WorkflowId,
@@ -60,11 +60,11 @@ func (m *sqlHistoryManager) AppendHistoryEvents(request *p.InternalAppendHistory
 		DataEncoding: string(request.Events.Encoding),
 	}
 	if request.Overwrite {
-		return m.overWriteHistoryEvents(request, row)
+		return m.overWriteHistoryEvents(ctx, request, row)
 	}
-	_, err := m.db.InsertIntoEvents(row)
+	_, err := m.db.InsertIntoEvents(ctx, row)
 	if err != nil {
-		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+		if m.db.IsDupEntryError(err) {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryEvents: event already exist: %v", err)}
 		}
 		return &workflow.InternalServiceError{Message: fmt.Sprintf("AppendHistoryEvents: %v", err)}
@@ -72,7 +72,7 @@ func (m *sqlHistoryManager) AppendHistoryEvents(request *p.InternalAppendHistory
 	return nil
 }
 
-func (m *sqlHistoryManager) GetWorkflowExecutionHistory(request *p.InternalGetWorkflowExecutionHistoryRequest) (
+func (m *sqlHistoryManager) GetWorkflowExecutionHistory(ctx context.Context, request *p.InternalGetWorkflowExecutionHistoryRequest) (
 	*p.InternalGetWorkflowExecutionHistoryResponse, error) {
 
 	offset := request.FirstEventID - 1
@@ -86,7 +86,7 @@ func (m *sqlHistoryManager) GetWorkflowExecutionHistory(request *p.InternalGetWo
 		offset = newOffset
 	}
 
-	rows, err := m.db.SelectFromEvents(&sqldb.EventsFilter{
+	rows, err := m.db.SelectFromEvents(ctx, &sqldb.EventsFilter{
 		DomainID:     sqldb.MustParseUUID(request.DomainID),
 		WorkflowID:   *request.Execution.WorkflowId,
 		RunID:        sqldb.MustParseUUID(*request.Execution.RunId),
@@ -135,8 +135,8 @@ func (m *sqlHistoryManager) GetWorkflowExecutionHistory(request *p.InternalGetWo
 	}, nil
 }
 
-func (m *sqlHistoryManager) DeleteWorkflowExecutionHistory(request *p.DeleteWorkflowExecutionHistoryRequest) error {
-	_, err := m.db.DeleteFromEvents(&sqldb.EventsFilter{
+func (m *sqlHistoryManager) DeleteWorkflowExecutionHistory(ctx context.Context, request *p.DeleteWorkflowExecutionHistoryRequest) error {
+	_, err := m.db.DeleteFromEvents(ctx, &sqldb.EventsFilter{
 		DomainID:   sqldb.MustParseUUID(request.DomainID),
 		WorkflowID: *request.Execution.WorkflowId,
 		RunID:      sqldb.MustParseUUID(*request.Execution.RunId),
@@ -149,12 +149,12 @@ func (m *sqlHistoryManager) DeleteWorkflowExecutionHistory(request *p.DeleteWork
 	return nil
 }
 
-func (m *sqlHistoryManager) overWriteHistoryEvents(request *p.InternalAppendHistoryEventsRequest, row *sqldb.EventsRow) error {
-	return m.txExecute("AppendHistoryEvents", func(tx sqldb.Tx) error {
-		if err := lockEventForUpdate(tx, request, row); err != nil {
+func (m *sqlHistoryManager) overWriteHistoryEvents(ctx context.Context, request *p.InternalAppendHistoryEventsRequest, row *sqldb.EventsRow) error {
+	return m.txExecute(ctx, "AppendHistoryEvents", func(tx sqldb.Tx) error {
+		if err := lockEventForUpdate(ctx, tx, request, row); err != nil {
 			return err
 		}
-		result, err := tx.UpdateEvents(row)
+		result, err := tx.UpdateEvents(ctx, row)
 		if err != nil {
 			return err
 		}
@@ -169,8 +169,8 @@ func (m *sqlHistoryManager) overWriteHistoryEvents(request *p.InternalAppendHist
 	})
 }
 
-func lockEventForUpdate(tx sqldb.Tx, req *p.InternalAppendHistoryEventsRequest, row *sqldb.EventsRow) error {
-	row, err := tx.LockEvents(&sqldb.EventsFilter{
+func lockEventForUpdate(ctx context.Context, tx sqldb.Tx, req *p.InternalAppendHistoryEventsRequest, row *sqldb.EventsRow) error {
+	row, err := tx.LockEvents(ctx, &sqldb.EventsFilter{
 		DomainID:     row.DomainID,
 		WorkflowID:   *req.Execution.WorkflowId,
 		RunID:        row.RunID,
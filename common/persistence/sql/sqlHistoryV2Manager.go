@@ -21,12 +21,12 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
@@ -68,7 +68,7 @@ func (m *sqlHistoryV2Manager) deserializeAncestors(jsonStr []byte) ([]*shared.Hi
 }
 
 // AppendHistoryNodes add(or override) a node to a history branch
-func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+func (m *sqlHistoryV2Manager) AppendHistoryNodes(ctx context.Context, request *p.InternalAppendHistoryNodesRequest) error {
 	branchInfo := request.BranchInfo
 	beginNodeID := p.GetBeginNodeID(branchInfo)
 
@@ -114,8 +114,8 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 			DataEncoding: string(blob.Encoding),
 		}
 
-		return m.txExecute("AppendHistoryNodes", func(tx sqldb.Tx) error {
-			result, err := tx.InsertIntoHistoryNode(nodeRow)
+		return m.txExecute(ctx, "AppendHistoryNodes", func(tx sqldb.Tx) error {
+			result, err := tx.InsertIntoHistoryNode(ctx, nodeRow)
 			if err != nil {
 				return err
 			}
@@ -126,7 +126,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 			if rowsAffected != 1 {
 				return fmt.Errorf("expected 1 row to be affected for node table, got %v", rowsAffected)
 			}
-			result, err = tx.InsertIntoHistoryTree(treeRow)
+			result, err = tx.InsertIntoHistoryTree(ctx, treeRow)
 			if err != nil {
 				return err
 			}
@@ -141,9 +141,9 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 		})
 	}
 
-	_, err := m.db.InsertIntoHistoryNode(nodeRow)
+	_, err := m.db.InsertIntoHistoryNode(ctx, nodeRow)
 	if err != nil {
-		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+		if m.db.IsDupEntryError(err) {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: %v", err)}
 		}
 		return &shared.InternalServiceError{Message: fmt.Sprintf("AppendHistoryEvents: %v", err)}
@@ -152,7 +152,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 }
 
 // ReadHistoryBranch returns history node data for a branch
-func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+func (m *sqlHistoryV2Manager) ReadHistoryBranch(ctx context.Context, request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
 	minNodeID := request.MinNodeID
 
 	if request.NextPageToken != nil && len(request.NextPageToken) > 0 {
@@ -174,7 +174,7 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 		ShardID:   request.ShardID,
 	}
 
-	rows, err := m.db.SelectFromHistoryNode(filter)
+	rows, err := m.db.SelectFromHistoryNode(ctx, filter)
 	if err == sql.ErrNoRows || (err == nil && len(rows) == 0) {
 		return &p.InternalReadHistoryBranchResponse{}, nil
 	}
@@ -227,46 +227,49 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 // A valid forking nodeID can be an ancestor from the existing branch.
 // For example, we have branch B1 with three nodes(1[1,2], 3[3,4,5] and 6[6,7,8]. 1, 3 and 6 are nodeIDs (first eventID of the batch).
 // So B1 looks like this:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//        /
-//      6[6,7,8]
+//
+//	     1[1,2]
+//	     /
+//	   3[3,4,5]
+//	  /
+//	6[6,7,8]
 //
 // Assuming we have branch B2 which contains one ancestor B1 stopping at 6 (exclusive). So B2 inherit nodeID 1 and 3 from B1, and have its own nodeID 6 and 8.
 // Branch B2 looks like this:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//          \
-//           6[6,7]
-//           \
-//            8[8]
 //
-//Now we want to fork a new branch B3 from B2.
+//	  1[1,2]
+//	  /
+//	3[3,4,5]
+//	 \
+//	  6[6,7]
+//	  \
+//	   8[8]
+//
+// Now we want to fork a new branch B3 from B2.
 // The only valid forking nodeIDs are 3,6 or 8.
 // 1 is not valid because we can't fork from first node.
 // 2/4/5 is NOT valid either because they are inside a batch.
 //
 // Case #1: If we fork from nodeID 6, then B3 will have an ancestor B1 which stops at 6(exclusive).
 // As we append a batch of events[6,7,8,9] to B3, it will look like :
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//          \
-//         6[6,7,8,9]
+//
+//	  1[1,2]
+//	  /
+//	3[3,4,5]
+//	 \
+//	6[6,7,8,9]
 //
 // Case #2: If we fork from node 8, then B3 will have two ancestors: B1 stops at 6(exclusive) and ancestor B2 stops at 8(exclusive)
 // As we append a batch of events[8,9] to B3, it will look like:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//        /
-//      6[6,7]
-//       \
-//       8[8,9]
 //
-func (m *sqlHistoryV2Manager) ForkHistoryBranch(request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+//	     1[1,2]
+//	     /
+//	   3[3,4,5]
+//	  /
+//	6[6,7]
+//	 \
+//	 8[8,9]
+func (m *sqlHistoryV2Manager) ForkHistoryBranch(ctx context.Context, request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
 	forkB := request.ForkBranchInfo
 	treeID := *forkB.TreeID
 	newAncestors := make([]*shared.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
@@ -322,7 +325,7 @@ func (m *sqlHistoryV2Manager) ForkHistoryBranch(request *p.InternalForkHistoryBr
 		Data:         blob.Data,
 		DataEncoding: string(blob.Encoding),
 	}
-	result, err := m.db.InsertIntoHistoryTree(row)
+	result, err := m.db.InsertIntoHistoryTree(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -337,7 +340,7 @@ func (m *sqlHistoryV2Manager) ForkHistoryBranch(request *p.InternalForkHistoryBr
 }
 
 // DeleteHistoryBranch removes a branch
-func (m *sqlHistoryV2Manager) DeleteHistoryBranch(request *p.InternalDeleteHistoryBranchRequest) error {
+func (m *sqlHistoryV2Manager) DeleteHistoryBranch(ctx context.Context, request *p.InternalDeleteHistoryBranchRequest) error {
 	branch := request.BranchInfo
 	treeID := *branch.TreeID
 	brsToDelete := branch.Ancestors
@@ -347,7 +350,7 @@ func (m *sqlHistoryV2Manager) DeleteHistoryBranch(request *p.InternalDeleteHisto
 		BeginNodeID: common.Int64Ptr(beginNodeID),
 	})
 
-	rsp, err := m.GetHistoryTree(&p.GetHistoryTreeRequest{
+	rsp, err := m.GetHistoryTree(ctx, &p.GetHistoryTreeRequest{
 		TreeID:  treeID,
 		ShardID: common.IntPtr(request.ShardID),
 	})
@@ -375,14 +378,14 @@ func (m *sqlHistoryV2Manager) DeleteHistoryBranch(request *p.InternalDeleteHisto
 		}
 	}
 
-	return m.txExecute("DeleteHistoryBranch", func(tx sqldb.Tx) error {
+	return m.txExecute(ctx, "DeleteHistoryBranch", func(tx sqldb.Tx) error {
 		branchID := sqldb.MustParseUUID(*branch.BranchID)
 		treeFilter := &sqldb.HistoryTreeFilter{
 			TreeID:   sqldb.MustParseUUID(treeID),
 			BranchID: &branchID,
 			ShardID:  request.ShardID,
 		}
-		_, err = tx.DeleteFromHistoryTree(treeFilter)
+		_, err = tx.DeleteFromHistoryTree(ctx, treeFilter)
 		if err != nil {
 			return err
 		}
@@ -406,7 +409,7 @@ func (m *sqlHistoryV2Manager) DeleteHistoryBranch(request *p.InternalDeleteHisto
 				// No any branch is using this range, we can delete all of it
 				nodeFilter.MinNodeID = br.BeginNodeID
 			}
-			_, err := tx.DeleteFromHistoryNode(nodeFilter)
+			_, err := tx.DeleteFromHistoryNode(ctx, nodeFilter)
 			if err != nil {
 				return err
 			}
@@ -419,7 +422,7 @@ func (m *sqlHistoryV2Manager) DeleteHistoryBranch(request *p.InternalDeleteHisto
 }
 
 // UpdateHistoryBranch update a branch
-func (m *sqlHistoryV2Manager) CompleteForkBranch(request *p.InternalCompleteForkBranchRequest) error {
+func (m *sqlHistoryV2Manager) CompleteForkBranch(ctx context.Context, request *p.InternalCompleteForkBranchRequest) error {
 	branch := request.BranchInfo
 	treeID := sqldb.MustParseUUID(*branch.TreeID)
 	branchID := sqldb.MustParseUUID(*branch.BranchID)
@@ -431,7 +434,7 @@ func (m *sqlHistoryV2Manager) CompleteForkBranch(request *p.InternalCompleteFork
 			InProgress: false,
 			ShardID:    request.ShardID,
 		}
-		result, err := m.db.UpdateHistoryTree(row)
+		result, err := m.db.UpdateHistoryTree(ctx, row)
 		if err != nil {
 			return err
 		}
@@ -456,13 +459,13 @@ func (m *sqlHistoryV2Manager) CompleteForkBranch(request *p.InternalCompleteFork
 		ShardID:   request.ShardID,
 		MinNodeID: common.Int64Ptr(1),
 	}
-	return m.txExecute("CompleteForkBranch", func(tx sqldb.Tx) error {
-		_, err := tx.DeleteFromHistoryNode(nodeFilter)
+	return m.txExecute(ctx, "CompleteForkBranch", func(tx sqldb.Tx) error {
+		_, err := tx.DeleteFromHistoryNode(ctx, nodeFilter)
 		if err != nil {
 			return err
 		}
 		// Note: we don't check result for DeleteFromHistoryNode because there can be deleting zero nodes.
-		result, err := tx.DeleteFromHistoryTree(treeFilter)
+		result, err := tx.DeleteFromHistoryTree(ctx, treeFilter)
 		if err != nil {
 			return err
 		}
@@ -478,7 +481,7 @@ func (m *sqlHistoryV2Manager) CompleteForkBranch(request *p.InternalCompleteFork
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (m *sqlHistoryV2Manager) GetHistoryTree(request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+func (m *sqlHistoryV2Manager) GetHistoryTree(ctx context.Context, request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
 	treeID := sqldb.MustParseUUID(request.TreeID)
 	branches := make([]*shared.HistoryBranch, 0)
 	forkingBranches := make([]p.ForkingInProgressBranch, 0)
@@ -487,7 +490,7 @@ func (m *sqlHistoryV2Manager) GetHistoryTree(request *p.GetHistoryTreeRequest) (
 		TreeID:  treeID,
 		ShardID: *request.ShardID,
 	}
-	rows, err := m.db.SelectFromHistoryTree(treeFilter)
+	rows, err := m.db.SelectFromHistoryTree(ctx, treeFilter)
 	if err == sql.ErrNoRows || (err == nil && len(rows) == 0) {
 		return &p.GetHistoryTreeResponse{}, nil
 	}
@@ -21,10 +21,10 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
-	"github.com/go-sql-driver/mysql"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
@@ -50,8 +50,8 @@ func newMetadataPersistenceV2(db sqldb.Interface, currentClusterName string,
 	}, nil
 }
 
-func updateMetadata(tx sqldb.Tx, oldNotificationVersion int64) error {
-	result, err := tx.UpdateDomainMetadata(&sqldb.DomainMetadataRow{NotificationVersion: oldNotificationVersion})
+func updateMetadata(ctx context.Context, tx sqldb.Tx, oldNotificationVersion int64) error {
+	result, err := tx.UpdateDomainMetadata(ctx, &sqldb.DomainMetadataRow{NotificationVersion: oldNotificationVersion})
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to update domain metadata. Error: %v", err),
@@ -72,8 +72,8 @@ func updateMetadata(tx sqldb.Tx, oldNotificationVersion int64) error {
 	return nil
 }
 
-func lockMetadata(tx sqldb.Tx) error {
-	err := tx.LockDomainMetadata()
+func lockMetadata(ctx context.Context, tx sqldb.Tx) error {
+	err := tx.LockDomainMetadata(ctx)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to lock domain metadata. Error: %v", err),
@@ -82,8 +82,8 @@ func lockMetadata(tx sqldb.Tx) error {
 	return nil
 }
 
-func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateDomainRequest) (*persistence.CreateDomainResponse, error) {
-	metadata, err := m.GetMetadata()
+func (m *sqlMetadataManagerV2) CreateDomain(ctx context.Context, request *persistence.InternalCreateDomainRequest) (*persistence.CreateDomainResponse, error) {
+	metadata, err := m.GetMetadata(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -124,25 +124,25 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 	}
 
 	var resp *persistence.CreateDomainResponse
-	err = m.txExecute("CreateDomain", func(tx sqldb.Tx) error {
-		if _, err1 := tx.InsertIntoDomain(&sqldb.DomainRow{
+	err = m.txExecute(ctx, "CreateDomain", func(tx sqldb.Tx) error {
+		if _, err1 := tx.InsertIntoDomain(ctx, &sqldb.DomainRow{
 			Name:         request.Info.Name,
 			ID:           sqldb.MustParseUUID(request.Info.ID),
 			Data:         blob.Data,
 			DataEncoding: string(blob.Encoding),
 			IsGlobal:     request.IsGlobalDomain,
 		}); err1 != nil {
-			if sqlErr, ok := err1.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+			if tx.IsDupEntryError(err1) {
 				return &workflow.DomainAlreadyExistsError{
 					Message: fmt.Sprintf("name: %v", request.Info.Name),
 				}
 			}
 			return err1
 		}
-		if err1 := lockMetadata(tx); err1 != nil {
+		if err1 := lockMetadata(ctx, tx); err1 != nil {
 			return err1
 		}
-		if err1 := updateMetadata(tx, metadata.NotificationVersion); err1 != nil {
+		if err1 := updateMetadata(ctx, tx, metadata.NotificationVersion); err1 != nil {
 			return err1
 		}
 		resp = &persistence.CreateDomainResponse{ID: request.Info.ID}
@@ -151,7 +151,7 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 	return resp, err
 }
 
-func (m *sqlMetadataManagerV2) GetDomain(request *persistence.GetDomainRequest) (*persistence.InternalGetDomainResponse, error) {
+func (m *sqlMetadataManagerV2) GetDomain(ctx context.Context, request *persistence.GetDomainRequest) (*persistence.InternalGetDomainResponse, error) {
 	filter := &sqldb.DomainFilter{}
 	switch {
 	case request.Name != "" && request.ID != "":
@@ -168,7 +168,7 @@ func (m *sqlMetadataManagerV2) GetDomain(request *persistence.GetDomainRequest)
 		}
 	}
 
-	rows, err := m.db.SelectFromDomain(filter)
+	rows, err := m.db.SelectFromDomain(ctx, filter)
 	if err != nil {
 		switch err {
 		case sql.ErrNoRows:
@@ -238,10 +238,11 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(row *sqldb.DomainRow
 		ConfigVersion:               domainInfo.GetConfigVersion(),
 		NotificationVersion:         domainInfo.GetNotificationVersion(),
 		FailoverNotificationVersion: domainInfo.GetFailoverNotificationVersion(),
+		FailoverDrainEndTime:        domainInfo.GetFailoverDrainEndTime(),
 	}, nil
 }
 
-func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateDomainRequest) error {
+func (m *sqlMetadataManagerV2) UpdateDomain(ctx context.Context, request *persistence.InternalUpdateDomainRequest) error {
 	clusters := make([]string, len(request.ReplicationConfig.Clusters))
 	for i := range clusters {
 		clusters[i] = request.ReplicationConfig.Clusters[i].ClusterName
@@ -268,6 +269,7 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		FailoverVersion:             common.Int64Ptr(request.FailoverVersion),
 		NotificationVersion:         common.Int64Ptr(request.NotificationVersion),
 		FailoverNotificationVersion: common.Int64Ptr(request.FailoverNotificationVersion),
+		FailoverDrainEndTime:        common.Int64Ptr(request.FailoverDrainEndTime),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
 	}
@@ -277,8 +279,8 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		return err
 	}
 
-	return m.txExecute("UpdateDomain", func(tx sqldb.Tx) error {
-		result, err := tx.UpdateDomain(&sqldb.DomainRow{
+	return m.txExecute(ctx, "UpdateDomain", func(tx sqldb.Tx) error {
+		result, err := tx.UpdateDomain(ctx, &sqldb.DomainRow{
 			Name:         request.Info.Name,
 			ID:           sqldb.MustParseUUID(request.Info.ID),
 			Data:         blob.Data,
@@ -294,29 +296,29 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		if noRowsAffected != 1 {
 			return fmt.Errorf("%v rows updated instead of one", noRowsAffected)
 		}
-		if err := lockMetadata(tx); err != nil {
+		if err := lockMetadata(ctx, tx); err != nil {
 			return err
 		}
-		return updateMetadata(tx, request.NotificationVersion)
+		return updateMetadata(ctx, tx, request.NotificationVersion)
 	})
 }
 
-func (m *sqlMetadataManagerV2) DeleteDomain(request *persistence.DeleteDomainRequest) error {
-	return m.txExecute("DeleteDomain", func(tx sqldb.Tx) error {
-		_, err := tx.DeleteFromDomain(&sqldb.DomainFilter{ID: sqldb.UUIDPtr(sqldb.MustParseUUID(request.ID))})
+func (m *sqlMetadataManagerV2) DeleteDomain(ctx context.Context, request *persistence.DeleteDomainRequest) error {
+	return m.txExecute(ctx, "DeleteDomain", func(tx sqldb.Tx) error {
+		_, err := tx.DeleteFromDomain(ctx, &sqldb.DomainFilter{ID: sqldb.UUIDPtr(sqldb.MustParseUUID(request.ID))})
 		return err
 	})
 }
 
-func (m *sqlMetadataManagerV2) DeleteDomainByName(request *persistence.DeleteDomainByNameRequest) error {
-	return m.txExecute("DeleteDomainByName", func(tx sqldb.Tx) error {
-		_, err := tx.DeleteFromDomain(&sqldb.DomainFilter{Name: &request.Name})
+func (m *sqlMetadataManagerV2) DeleteDomainByName(ctx context.Context, request *persistence.DeleteDomainByNameRequest) error {
+	return m.txExecute(ctx, "DeleteDomainByName", func(tx sqldb.Tx) error {
+		_, err := tx.DeleteFromDomain(ctx, &sqldb.DomainFilter{Name: &request.Name})
 		return err
 	})
 }
 
-func (m *sqlMetadataManagerV2) GetMetadata() (*persistence.GetMetadataResponse, error) {
-	row, err := m.db.SelectFromDomainMetadata()
+func (m *sqlMetadataManagerV2) GetMetadata(ctx context.Context) (*persistence.GetMetadataResponse, error) {
+	row, err := m.db.SelectFromDomainMetadata(ctx)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("GetMetadata operation failed. Error: %v", err),
@@ -325,13 +327,13 @@ func (m *sqlMetadataManagerV2) GetMetadata() (*persistence.GetMetadataResponse,
 	return &persistence.GetMetadataResponse{NotificationVersion: row.NotificationVersion}, nil
 }
 
-func (m *sqlMetadataManagerV2) ListDomains(request *persistence.ListDomainsRequest) (*persistence.InternalListDomainsResponse, error) {
+func (m *sqlMetadataManagerV2) ListDomains(ctx context.Context, request *persistence.ListDomainsRequest) (*persistence.InternalListDomainsResponse, error) {
 	var pageToken *sqldb.UUID
 	if request.NextPageToken != nil {
 		token := sqldb.UUID(request.NextPageToken)
 		pageToken = &token
 	}
-	rows, err := m.db.SelectFromDomain(&sqldb.DomainFilter{
+	rows, err := m.db.SelectFromDomain(ctx, &sqldb.DomainFilter{
 		GreaterThanID: pageToken,
 		PageSize:      &request.PageSize,
 	})
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
@@ -49,8 +50,8 @@ func newShardPersistence(db sqldb.Interface, currentClusterName string, log log.
 	}, nil
 }
 
-func (m *sqlShardManager) CreateShard(request *persistence.CreateShardRequest) error {
-	if _, err := m.GetShard(&persistence.GetShardRequest{
+func (m *sqlShardManager) CreateShard(ctx context.Context, request *persistence.CreateShardRequest) error {
+	if _, err := m.GetShard(ctx, &persistence.GetShardRequest{
 		ShardID: request.ShardInfo.ShardID,
 	}); err == nil {
 		return &persistence.ShardAlreadyExistError{
@@ -65,7 +66,7 @@ func (m *sqlShardManager) CreateShard(request *persistence.CreateShardRequest) e
 		}
 	}
 
-	if _, err := m.db.InsertIntoShards(row); err != nil {
+	if _, err := m.db.InsertIntoShards(ctx, row); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateShard operation failed. Failed to insert into shards table. Error: %v", err),
 		}
@@ -74,8 +75,8 @@ func (m *sqlShardManager) CreateShard(request *persistence.CreateShardRequest) e
 	return nil
 }
 
-func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
-	row, err := m.db.SelectFromShards(&sqldb.ShardsFilter{ShardID: int64(request.ShardID)})
+func (m *sqlShardManager) GetShard(ctx context.Context, request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
+	row, err := m.db.SelectFromShards(ctx, &sqldb.ShardsFilter{ShardID: int64(request.ShardID)})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &workflow.EntityNotExistsError{
@@ -109,6 +110,10 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		}
 	}
 
+	if shardInfo.ClusterReplicationLevel == nil {
+		shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+
 	resp := &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{
 		ShardID:                   int(row.ShardID),
 		RangeID:                   row.RangeID,
@@ -121,23 +126,24 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		ClusterTransferAckLevel:   shardInfo.ClusterTransferAckLevel,
 		ClusterTimerAckLevel:      timerAckLevel,
 		DomainNotificationVersion: shardInfo.GetDomainNotificationVersion(),
+		ClusterReplicationLevel:   shardInfo.ClusterReplicationLevel,
 	}}
 
 	return resp, nil
 }
 
-func (m *sqlShardManager) UpdateShard(request *persistence.UpdateShardRequest) error {
+func (m *sqlShardManager) UpdateShard(ctx context.Context, request *persistence.UpdateShardRequest) error {
 	row, err := shardInfoToShardsRow(*request.ShardInfo)
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("UpdateShard operation failed. Error: %v", err),
 		}
 	}
-	return m.txExecute("UpdateShard", func(tx sqldb.Tx) error {
-		if err := lockShard(tx, request.ShardInfo.ShardID, request.PreviousRangeID); err != nil {
+	return m.txExecute(ctx, "UpdateShard", func(tx sqldb.Tx) error {
+		if err := lockShard(ctx, tx, request.ShardInfo.ShardID, request.PreviousRangeID); err != nil {
 			return err
 		}
-		result, err := tx.UpdateShards(row)
+		result, err := tx.UpdateShards(ctx, row)
 		if err != nil {
 			return err
 		}
@@ -153,8 +159,8 @@ func (m *sqlShardManager) UpdateShard(request *persistence.UpdateShardRequest) e
 }
 
 // initiated by the owning shard
-func lockShard(tx sqldb.Tx, shardID int, oldRangeID int64) error {
-	rangeID, err := tx.WriteLockShards(&sqldb.ShardsFilter{ShardID: int64(shardID)})
+func lockShard(ctx context.Context, tx sqldb.Tx, shardID int, oldRangeID int64) error {
+	rangeID, err := tx.WriteLockShards(ctx, &sqldb.ShardsFilter{ShardID: int64(shardID)})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &workflow.InternalServiceError{
@@ -177,8 +183,8 @@ func lockShard(tx sqldb.Tx, shardID int, oldRangeID int64) error {
 }
 
 // initiated by the owning shard
-func readLockShard(tx sqldb.Tx, shardID int, oldRangeID int64) error {
-	rangeID, err := tx.ReadLockShards(&sqldb.ShardsFilter{ShardID: int64(shardID)})
+func readLockShard(ctx context.Context, tx sqldb.Tx, shardID int, oldRangeID int64) error {
+	rangeID, err := tx.ReadLockShards(ctx, &sqldb.ShardsFilter{ShardID: int64(shardID)})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &workflow.InternalServiceError{
@@ -215,6 +221,7 @@ func shardInfoToShardsRow(s persistence.ShardInfo) (*sqldb.ShardsRow, error) {
 		ClusterTimerAckLevel:      timerAckLevels,
 		DomainNotificationVersion: common.Int64Ptr(s.DomainNotificationVersion),
 		Owner:                     &s.Owner,
+		ClusterReplicationLevel:   s.ClusterReplicationLevel,
 	}
 
 	blob, err := shardInfoToBlob(shardInfo)
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"math"
@@ -37,7 +38,10 @@ import (
 
 type sqlTaskManager struct {
 	sqlStore
-	nShards int
+	// shardStores are the stores task lists are spread across, task lists are hashed
+	// over them separately from the storage shard, which is hashed over nShards
+	shardStores []sqlStore
+	nShards     int
 }
 
 var (
@@ -45,22 +49,35 @@ var (
 )
 
 // newTaskPersistence creates a new instance of TaskManager
-func newTaskPersistence(db sqldb.Interface, nShards int, log log.Logger) (persistence.TaskManager, error) {
+func newTaskPersistence(db sqldb.Interface, shardDBs []sqldb.Interface, nShards int, log log.Logger) (persistence.TaskManager, error) {
+	shardStores := make([]sqlStore, len(shardDBs))
+	for i, shardDB := range shardDBs {
+		shardStores[i] = sqlStore{db: shardDB, logger: log}
+	}
 	return &sqlTaskManager{
 		sqlStore: sqlStore{
 			db:     db,
 			logger: log,
 		},
-		nShards: nShards,
+		shardStores: shardStores,
+		nShards:     nShards,
 	}, nil
 }
 
-func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+func (m *sqlTaskManager) Close() {
+	m.sqlStore.Close()
+	for i := range m.shardStores {
+		m.shardStores[i].Close()
+	}
+}
+
+func (m *sqlTaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
 	var rangeID int64
 	var ackLevel int64
 	shardID := m.shardID(request.DomainID, request.TaskList)
+	store := m.taskListStore(request.DomainID, request.TaskList)
 	domainID := sqldb.MustParseUUID(request.DomainID)
-	rows, err := m.db.SelectFromTaskLists(&sqldb.TaskListsFilter{
+	rows, err := store.db.SelectFromTaskLists(ctx, &sqldb.TaskListsFilter{
 		ShardID:  shardID,
 		DomainID: &domainID,
 		Name:     &request.TaskList,
@@ -86,7 +103,7 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 				DataEncoding: string(blob.Encoding),
 			}
 			rows = []sqldb.TaskListsRow{row}
-			if _, err := m.db.InsertIntoTaskLists(&row); err != nil {
+			if _, err := store.db.InsertIntoTaskLists(ctx, &row); err != nil {
 				return nil, &workflow.InternalServiceError{
 					Message: fmt.Sprintf("LeaseTaskList operation failed. Failed to make task list %v of type %v. Error: %v", request.TaskList, request.TaskType, err),
 				}
@@ -112,13 +129,13 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	}
 
 	var resp *persistence.LeaseTaskListResponse
-	err = m.txExecute("LeaseTaskList", func(tx sqldb.Tx) error {
+	err = store.txExecute(ctx, "LeaseTaskList", func(tx sqldb.Tx) error {
 		rangeID = row.RangeID
 		ackLevel = tlInfo.GetAckLevel()
 		// We need to separately check the condition and do the
 		// update because we want to throw different error codes.
 		// Since we need to do things separately (in a transaction), we need to take a lock.
-		err1 := lockTaskList(tx, shardID, domainID, request.TaskList, request.TaskType, rangeID)
+		err1 := lockTaskList(ctx, tx, shardID, domainID, request.TaskList, request.TaskType, rangeID)
 		if err1 != nil {
 			return err1
 		}
@@ -128,7 +145,7 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 		if err1 != nil {
 			return err1
 		}
-		result, err1 := tx.UpdateTaskLists(&sqldb.TaskListsRow{
+		result, err1 := tx.UpdateTaskLists(ctx, &sqldb.TaskListsRow{
 			ShardID:      shardID,
 			DomainID:     row.DomainID,
 			RangeID:      row.RangeID + 1,
@@ -161,8 +178,9 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	return resp, err
 }
 
-func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+func (m *sqlTaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
 	shardID := m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name)
+	store := m.taskListStore(request.TaskListInfo.DomainID, request.TaskListInfo.Name)
 	domainID := sqldb.MustParseUUID(request.TaskListInfo.DomainID)
 	tlInfo := &sqlblobs.TaskListInfo{
 		AckLevel:         common.Int64Ptr(request.TaskListInfo.AckLevel),
@@ -176,7 +194,7 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 		if err != nil {
 			return nil, err
 		}
-		if _, err := m.db.ReplaceIntoTaskLists(&sqldb.TaskListsRow{
+		if _, err := store.db.ReplaceIntoTaskLists(ctx, &sqldb.TaskListsRow{
 			ShardID:      shardID,
 			DomainID:     domainID,
 			RangeID:      request.TaskListInfo.RangeID,
@@ -195,13 +213,13 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 	if err != nil {
 		return nil, err
 	}
-	err = m.txExecute("UpdateTaskList", func(tx sqldb.Tx) error {
-		err1 := lockTaskList(
+	err = store.txExecute(ctx, "UpdateTaskList", func(tx sqldb.Tx) error {
+		err1 := lockTaskList(ctx,
 			tx, shardID, domainID, request.TaskListInfo.Name, request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
 		if err1 != nil {
 			return err1
 		}
-		result, err1 := tx.UpdateTaskLists(&sqldb.TaskListsRow{
+		result, err1 := tx.UpdateTaskLists(ctx, &sqldb.TaskListsRow{
 			ShardID:      shardID,
 			DomainID:     domainID,
 			RangeID:      request.TaskListInfo.RangeID,
@@ -227,13 +245,14 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 }
 
 type taskListPageToken struct {
+	DBIndex  int
 	ShardID  int
 	DomainID string
 	Name     string
 	TaskType int64
 }
 
-func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
+func (m *sqlTaskManager) ListTaskList(ctx context.Context, request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
 	pageToken := taskListPageToken{TaskType: math.MinInt16, DomainID: minUUID}
 	if request.PageToken != nil {
 		if err := gobDeserialize(request.PageToken, &pageToken); err != nil {
@@ -243,8 +262,9 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 	var err error
 	var rows []sqldb.TaskListsRow
 	domainID := sqldb.MustParseUUID(pageToken.DomainID)
-	for pageToken.ShardID < m.nShards {
-		rows, err = m.db.SelectFromTaskLists(&sqldb.TaskListsFilter{
+	// every storage shard is listed on every database, databases first
+	for pageToken.DBIndex < len(m.shardStores) {
+		rows, err = m.shardStores[pageToken.DBIndex].db.SelectFromTaskLists(ctx, &sqldb.TaskListsFilter{
 			ShardID:             pageToken.ShardID,
 			DomainIDGreaterThan: &domainID,
 			NameGreaterThan:     &pageToken.Name,
@@ -257,7 +277,7 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 		if len(rows) > 0 {
 			break
 		}
-		pageToken = taskListPageToken{ShardID: pageToken.ShardID + 1, TaskType: math.MinInt16, DomainID: minUUID}
+		pageToken = m.nextTaskListPage(pageToken)
 	}
 
 	var nextPageToken []byte
@@ -265,13 +285,16 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 	case len(rows) >= request.PageSize:
 		lastRow := &rows[request.PageSize-1]
 		nextPageToken, err = gobSerialize(&taskListPageToken{
+			DBIndex:  pageToken.DBIndex,
 			ShardID:  pageToken.ShardID,
 			DomainID: lastRow.DomainID.String(),
 			Name:     lastRow.Name,
 			TaskType: lastRow.TaskType,
 		})
-	case pageToken.ShardID+1 < m.nShards:
-		nextPageToken, err = gobSerialize(&taskListPageToken{ShardID: pageToken.ShardID + 1, TaskType: math.MinInt16})
+	case pageToken.DBIndex < len(m.shardStores):
+		if next := m.nextTaskListPage(pageToken); next.DBIndex < len(m.shardStores) {
+			nextPageToken, err = gobSerialize(&next)
+		}
 	}
 
 	if err != nil {
@@ -301,10 +324,21 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 	return resp, nil
 }
 
-func (m *sqlTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+// nextTaskListPage returns the token of the first page of the storage shard following the one of the token
+func (m *sqlTaskManager) nextTaskListPage(pageToken taskListPageToken) taskListPageToken {
+	next := taskListPageToken{DBIndex: pageToken.DBIndex, ShardID: pageToken.ShardID + 1, TaskType: math.MinInt16, DomainID: minUUID}
+	if next.ShardID >= m.nShards {
+		next.DBIndex++
+		next.ShardID = 0
+	}
+	return next
+}
+
+func (m *sqlTaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) error {
+	shardID := m.shardID(request.DomainID, request.TaskListName)
 	domainID := sqldb.MustParseUUID(request.DomainID)
-	result, err := m.db.DeleteFromTaskLists(&sqldb.TaskListsFilter{
-		ShardID:  m.shardID(request.DomainID, request.TaskListName),
+	result, err := m.taskListStore(request.DomainID, request.TaskListName).db.DeleteFromTaskLists(ctx, &sqldb.TaskListsFilter{
+		ShardID:  shardID,
 		DomainID: &domainID,
 		Name:     &request.TaskListName,
 		TaskType: common.Int64Ptr(int64(request.TaskListType)),
@@ -323,7 +357,7 @@ func (m *sqlTaskManager) DeleteTaskList(request *persistence.DeleteTaskListReque
 	return nil
 }
 
-func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+func (m *sqlTaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	tasksRows := make([]sqldb.TasksRow, len(request.Tasks))
 	for i, v := range request.Tasks {
 		var expiryTime time.Time
@@ -349,14 +383,15 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			DataEncoding: string(blob.Encoding),
 		}
 	}
+	shardID := m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name)
 	var resp *persistence.CreateTasksResponse
-	err := m.txExecute("CreateTasks", func(tx sqldb.Tx) error {
-		if _, err1 := tx.InsertIntoTasks(tasksRows); err1 != nil {
+	err := m.taskListStore(request.TaskListInfo.DomainID, request.TaskListInfo.Name).txExecute(ctx, "CreateTasks", func(tx sqldb.Tx) error {
+		if _, err1 := tx.InsertIntoTasks(ctx, tasksRows); err1 != nil {
 			return err1
 		}
 		// Lock task list before committing.
-		err1 := lockTaskList(tx,
-			m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name),
+		err1 := lockTaskList(ctx, tx,
+			shardID,
 			sqldb.MustParseUUID(request.TaskListInfo.DomainID),
 			request.TaskListInfo.Name,
 			request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
@@ -369,8 +404,8 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 	return resp, err
 }
 
-func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
-	rows, err := m.db.SelectFromTasks(&sqldb.TasksFilter{
+func (m *sqlTaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	rows, err := m.taskListStore(request.DomainID, request.TaskList).db.SelectFromTasks(ctx, &sqldb.TasksFilter{
 		DomainID:     sqldb.MustParseUUID(request.DomainID),
 		TaskListName: request.TaskList,
 		TaskType:     int64(request.TaskType),
@@ -404,10 +439,10 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 	return &persistence.GetTasksResponse{Tasks: tasks}, nil
 }
 
-func (m *sqlTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
+func (m *sqlTaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) error {
 	taskID := request.TaskID
 	taskList := request.TaskList
-	_, err := m.db.DeleteFromTasks(&sqldb.TasksFilter{
+	_, err := m.taskListStore(taskList.DomainID, taskList.Name).db.DeleteFromTasks(ctx, &sqldb.TasksFilter{
 		DomainID:     sqldb.MustParseUUID(taskList.DomainID),
 		TaskListName: taskList.Name,
 		TaskType:     int64(taskList.TaskType),
@@ -418,8 +453,8 @@ func (m *sqlTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	return nil
 }
 
-func (m *sqlTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
-	result, err := m.db.DeleteFromTasks(&sqldb.TasksFilter{
+func (m *sqlTaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	result, err := m.taskListStore(request.DomainID, request.TaskListName).db.DeleteFromTasks(ctx, &sqldb.TasksFilter{
 		DomainID:             sqldb.MustParseUUID(request.DomainID),
 		TaskListName:         request.TaskListName,
 		TaskType:             int64(request.TaskType),
@@ -443,8 +478,20 @@ func (m *sqlTaskManager) shardID(domainID string, name string) int {
 	return int(id)
 }
 
-func lockTaskList(tx sqldb.Tx, shardID int, domainID sqldb.UUID, name string, taskListType int, oldRangeID int64) error {
-	rangeID, err := tx.LockTaskLists(&sqldb.TaskListsFilter{
+// dbIndex returns the index of the database that holds the task list, it does not depend on
+// nShards so that task lists are spread across all databases even with a single storage shard
+func (m *sqlTaskManager) dbIndex(domainID string, name string) int {
+	id := farm.Hash32([]byte(domainID+"_"+name)) % uint32(len(m.shardStores))
+	return int(id)
+}
+
+// taskListStore returns the store of the database that holds the task list
+func (m *sqlTaskManager) taskListStore(domainID string, name string) *sqlStore {
+	return &m.shardStores[m.dbIndex(domainID, name)]
+}
+
+func lockTaskList(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, name string, taskListType int, oldRangeID int64) error {
+	rangeID, err := tx.LockTaskLists(ctx, &sqldb.TaskListsFilter{
 		ShardID: shardID, DomainID: &domainID, Name: &name, TaskType: common.Int64Ptr(int64(taskListType))})
 	if err != nil {
 		return &workflow.InternalServiceError{
@@ -0,0 +1,61 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+func TestTaskListRouting(t *testing.T) {
+	// two databases with the default single storage shard
+	tm, err := newTaskPersistence(nil, []sqldb.Interface{nil, nil}, 1, loggerimpl.NewNopLogger())
+	require.NoError(t, err)
+	m := tm.(*sqlTaskManager)
+
+	counts := make([]int, len(m.shardStores))
+	domainID := uuid.New()
+	for i := 0; i < 100; i++ {
+		name := uuid.New()
+		require.Equal(t, 0, m.shardID(domainID, name))
+		dbIndex := m.dbIndex(domainID, name)
+		require.True(t, m.taskListStore(domainID, name) == &m.shardStores[dbIndex])
+		counts[dbIndex]++
+	}
+	require.NotZero(t, counts[0])
+	require.NotZero(t, counts[1])
+}
+
+func TestNextTaskListPage(t *testing.T) {
+	tm, err := newTaskPersistence(nil, []sqldb.Interface{nil, nil}, 2, loggerimpl.NewNopLogger())
+	require.NoError(t, err)
+	m := tm.(*sqlTaskManager)
+
+	var pages [][2]int
+	for token := (taskListPageToken{}); token.DBIndex < len(m.shardStores); token = m.nextTaskListPage(token) {
+		pages = append(pages, [2]int{token.DBIndex, token.ShardID})
+	}
+	require.Equal(t, [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}}, pages)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	testSQLiteSchemaDir      = "schema/sqlite"
+	testSQLiteDataSourceName = "file:%s?_busy_timeout=10000&_journal_mode=WAL"
+)
+
+// SQLiteTestCluster allows executing sqlite operations in testing, the
+// database is a file in a temporary directory removed on tear down
+type SQLiteTestCluster struct {
+	dbName    string
+	schemaDir string
+	dir       string
+	db        *sqlx.DB
+	cfg       config.SQL
+}
+
+// NewSQLiteTestCluster returns a new sqlite test cluster
+func NewSQLiteTestCluster(dbName string, schemaDir string) *SQLiteTestCluster {
+	if schemaDir == "" {
+		schemaDir = testSQLiteSchemaDir
+	}
+	return &SQLiteTestCluster{
+		dbName:    dbName,
+		schemaDir: schemaDir,
+		cfg: config.SQL{
+			DriverName:      sqlite.DriverName,
+			DatabaseName:    dbName + ".db",
+			ConnectProtocol: "file",
+			NumShards:       4,
+		},
+	}
+}
+
+// DatabaseName from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) DatabaseName() string {
+	return s.dbName
+}
+
+// SetupTestDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) SetupTestDatabase() {
+	s.CreateDatabase()
+	s.CreateSession()
+
+	schemaDir := s.schemaDir + "/"
+	if !strings.HasPrefix(schemaDir, "/") && !strings.HasPrefix(schemaDir, "../") {
+		cadencePackageDir, err := getCadencePackageDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+		schemaDir = cadencePackageDir + schemaDir
+	}
+	s.LoadSchema([]string{"schema.sql"}, schemaDir)
+	s.LoadVisibilitySchema([]string{"schema.sql"}, schemaDir)
+}
+
+// Config returns the persistence config for connecting to this test cluster
+func (s *SQLiteTestCluster) Config() config.Persistence {
+	cfg := s.cfg
+	return config.Persistence{
+		DefaultStore:    "test",
+		VisibilityStore: "test",
+		DataStores: map[string]config.DataStore{
+			"test": {SQL: &cfg},
+		},
+		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+	}
+}
+
+// TearDownTestDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) TearDownTestDatabase() {
+	s.db.Close()
+	s.DropDatabase()
+}
+
+// CreateSession from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) CreateSession() {
+	var err error
+	s.db, err = sqlx.Connect(sqlite.DriverName, fmt.Sprintf(testSQLiteDataSourceName, filepath.Join(s.dir, s.cfg.DatabaseName)))
+	if err != nil {
+		log.Fatal(`CreateSession`, err)
+	}
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	s.db.MapperFunc(strcase.ToSnake)
+}
+
+// CreateDatabase creates the directory of the database file, sqlite
+// creates the file itself on the first connection
+func (s *SQLiteTestCluster) CreateDatabase() {
+	dir, err := ioutil.TempDir("", "cadence-sqlite-"+s.dbName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.dir = dir
+	s.cfg.ConnectAddr = dir
+	log.WithField(`database-name`, s.dbName).Debug(`created database`)
+}
+
+// DropDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) DropDatabase() {
+	if err := os.RemoveAll(s.dir); err != nil {
+		log.Fatal(err)
+	}
+	log.WithField(`database-name`, s.dbName).Info(`dropped database`)
+}
+
+// LoadSchema from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) LoadSchema(fileNames []string, schemaDir string) {
+	workflowSchemaDir := schemaDir + "/cadence"
+	err := loadDatabaseSchema(workflowSchemaDir, fileNames, s.db, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// LoadVisibilitySchema from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) LoadVisibilitySchema(fileNames []string, schemaDir string) {
+	workflowSchemaDir := schemaDir + "/visibility"
+	err := loadDatabaseSchema(workflowSchemaDir, fileNames, s.db, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -21,10 +21,17 @@
 package mysql
 
 import (
+	"context"
+
+	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
+// ErrDupEntry MySQL Error 1062 indicates a duplicate primary key i.e. the row already exists,
+// so we don't do the insert and return a ConditionalUpdate error.
+const ErrDupEntry = 1062
+
 // DB represents a logical connection to mysql database
 type DB struct {
 	db        *sqlx.DB
@@ -49,8 +56,8 @@ func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
 }
 
 // BeginTx starts a new transaction and returns a reference to the Tx object
-func (mdb *DB) BeginTx() (sqldb.Tx, error) {
-	xtx, err := mdb.db.Beginx()
+func (mdb *DB) BeginTx(ctx context.Context) (sqldb.Tx, error) {
+	xtx, err := mdb.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -76,3 +83,9 @@ func (mdb *DB) Close() error {
 func (mdb *DB) DriverName() string {
 	return mdb.db.DriverName()
 }
+
+// IsDupEntryError verifies if the error is a duplicate entry error
+func (mdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(*mysql.MySQLError)
+	return ok && sqlErr.Number == ErrDupEntry
+}
@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 
@@ -57,35 +58,35 @@ const (
 var errMissingArgs = errors.New("missing one or more args for API")
 
 // InsertIntoDomain inserts a single row into domains table
-func (mdb *DB) InsertIntoDomain(row *sqldb.DomainRow) (sql.Result, error) {
-	return mdb.conn.Exec(createDomainQry, row.ID, row.Name, row.IsGlobal, row.Data, row.DataEncoding)
+func (mdb *DB) InsertIntoDomain(ctx context.Context, row *sqldb.DomainRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, createDomainQry, row.ID, row.Name, row.IsGlobal, row.Data, row.DataEncoding)
 }
 
 // UpdateDomain updates a single row in domains table
-func (mdb *DB) UpdateDomain(row *sqldb.DomainRow) (sql.Result, error) {
-	return mdb.conn.Exec(updateDomainQry, row.Name, row.Data, row.DataEncoding, row.ID)
+func (mdb *DB) UpdateDomain(ctx context.Context, row *sqldb.DomainRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, updateDomainQry, row.Name, row.Data, row.DataEncoding, row.ID)
 }
 
 // SelectFromDomain reads one or more rows from domains table
-func (mdb *DB) SelectFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+func (mdb *DB) SelectFromDomain(ctx context.Context, filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
 	switch {
 	case filter.ID != nil || filter.Name != nil:
-		return mdb.selectFromDomain(filter)
+		return mdb.selectFromDomain(ctx, filter)
 	case filter.PageSize != nil && *filter.PageSize > 0:
-		return mdb.selectAllFromDomain(filter)
+		return mdb.selectAllFromDomain(ctx, filter)
 	default:
 		return nil, errMissingArgs
 	}
 }
 
-func (mdb *DB) selectFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+func (mdb *DB) selectFromDomain(ctx context.Context, filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
 	var err error
 	var row sqldb.DomainRow
 	switch {
 	case filter.ID != nil:
-		err = mdb.conn.Get(&row, getDomainByIDQry, shardID, *filter.ID)
+		err = mdb.conn.GetContext(ctx, &row, getDomainByIDQry, shardID, *filter.ID)
 	case filter.Name != nil:
-		err = mdb.conn.Get(&row, getDomainByNameQry, shardID, *filter.Name)
+		err = mdb.conn.GetContext(ctx, &row, getDomainByNameQry, shardID, *filter.Name)
 	}
 	if err != nil {
 		return nil, err
@@ -93,46 +94,46 @@ func (mdb *DB) selectFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow,
 	return []sqldb.DomainRow{row}, err
 }
 
-func (mdb *DB) selectAllFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+func (mdb *DB) selectAllFromDomain(ctx context.Context, filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
 	var err error
 	var rows []sqldb.DomainRow
 	switch {
 	case filter.GreaterThanID != nil:
-		err = mdb.conn.Select(&rows, listDomainsRangeQry, shardID, *filter.GreaterThanID, *filter.PageSize)
+		err = mdb.conn.SelectContext(ctx, &rows, listDomainsRangeQry, shardID, *filter.GreaterThanID, *filter.PageSize)
 	default:
-		err = mdb.conn.Select(&rows, listDomainsQry, shardID, filter.PageSize)
+		err = mdb.conn.SelectContext(ctx, &rows, listDomainsQry, shardID, filter.PageSize)
 	}
 	return rows, err
 }
 
 // DeleteFromDomain deletes a single row in domains table
-func (mdb *DB) DeleteFromDomain(filter *sqldb.DomainFilter) (sql.Result, error) {
+func (mdb *DB) DeleteFromDomain(ctx context.Context, filter *sqldb.DomainFilter) (sql.Result, error) {
 	var err error
 	var result sql.Result
 	switch {
 	case filter.ID != nil:
-		result, err = mdb.conn.Exec(deleteDomainByIDQry, shardID, filter.ID)
+		result, err = mdb.conn.ExecContext(ctx, deleteDomainByIDQry, shardID, filter.ID)
 	default:
-		result, err = mdb.conn.Exec(deleteDomainByNameQry, shardID, filter.Name)
+		result, err = mdb.conn.ExecContext(ctx, deleteDomainByNameQry, shardID, filter.Name)
 	}
 	return result, err
 }
 
 // LockDomainMetadata acquires a write lock on a single row in domain_metadata table
-func (mdb *DB) LockDomainMetadata() error {
+func (mdb *DB) LockDomainMetadata(ctx context.Context) error {
 	var row sqldb.DomainMetadataRow
-	err := mdb.conn.Get(&row.NotificationVersion, lockDomainMetadataQry)
+	err := mdb.conn.GetContext(ctx, &row.NotificationVersion, lockDomainMetadataQry)
 	return err
 }
 
 // SelectFromDomainMetadata reads a single row in domain_metadata table
-func (mdb *DB) SelectFromDomainMetadata() (*sqldb.DomainMetadataRow, error) {
+func (mdb *DB) SelectFromDomainMetadata(ctx context.Context) (*sqldb.DomainMetadataRow, error) {
 	var row sqldb.DomainMetadataRow
-	err := mdb.conn.Get(&row.NotificationVersion, getDomainMetadataQry)
+	err := mdb.conn.GetContext(ctx, &row.NotificationVersion, getDomainMetadataQry)
 	return &row, err
 }
 
 // UpdateDomainMetadata updates a single row in domain_metadata table
-func (mdb *DB) UpdateDomainMetadata(row *sqldb.DomainMetadataRow) (sql.Result, error) {
-	return mdb.conn.Exec(updateDomainMetadataQry, row.NotificationVersion+1, row.NotificationVersion)
+func (mdb *DB) UpdateDomainMetadata(ctx context.Context, row *sqldb.DomainMetadataRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, updateDomainMetadataQry, row.NotificationVersion+1, row.NotificationVersion)
 }
@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
@@ -48,31 +49,31 @@ const (
 )
 
 // InsertIntoEvents inserts a row into events table
-func (mdb *DB) InsertIntoEvents(row *sqldb.EventsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(addEventsQry, row)
+func (mdb *DB) InsertIntoEvents(ctx context.Context, row *sqldb.EventsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, addEventsQry, row)
 }
 
 // UpdateEvents updates a row in events table
-func (mdb *DB) UpdateEvents(row *sqldb.EventsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(updateEventsQry, row)
+func (mdb *DB) UpdateEvents(ctx context.Context, row *sqldb.EventsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, updateEventsQry, row)
 }
 
 // SelectFromEvents reads one or more rows from events table
-func (mdb *DB) SelectFromEvents(filter *sqldb.EventsFilter) ([]sqldb.EventsRow, error) {
+func (mdb *DB) SelectFromEvents(ctx context.Context, filter *sqldb.EventsFilter) ([]sqldb.EventsRow, error) {
 	var rows []sqldb.EventsRow
-	err := mdb.conn.Select(&rows, getEventsQry,
+	err := mdb.conn.SelectContext(ctx, &rows, getEventsQry,
 		filter.DomainID, filter.WorkflowID, filter.RunID, *filter.FirstEventID, *filter.NextEventID, *filter.PageSize)
 	return rows, err
 }
 
 // DeleteFromEvents deletes one or more rows from events table
-func (mdb *DB) DeleteFromEvents(filter *sqldb.EventsFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteEventsQry, filter.DomainID, filter.WorkflowID, filter.RunID)
+func (mdb *DB) DeleteFromEvents(ctx context.Context, filter *sqldb.EventsFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteEventsQry, filter.DomainID, filter.WorkflowID, filter.RunID)
 }
 
 // LockEvents acquires a write lock on a single row in events table
-func (mdb *DB) LockEvents(filter *sqldb.EventsFilter) (*sqldb.EventsRow, error) {
+func (mdb *DB) LockEvents(ctx context.Context, filter *sqldb.EventsFilter) (*sqldb.EventsRow, error) {
 	var row sqldb.EventsRow
-	err := mdb.conn.Get(&row, lockEventQry, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.FirstEventID)
+	err := mdb.conn.GetContext(ctx, &row, lockEventQry, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.FirstEventID)
 	return &row, err
 }
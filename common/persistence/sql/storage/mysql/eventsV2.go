@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
@@ -52,16 +53,16 @@ const (
 // For history_node table:
 
 // InsertIntoHistoryNode inserts a row into history_node table
-func (mdb *DB) InsertIntoHistoryNode(row *sqldb.HistoryNodeRow) (sql.Result, error) {
+func (mdb *DB) InsertIntoHistoryNode(ctx context.Context, row *sqldb.HistoryNodeRow) (sql.Result, error) {
 	// NOTE: MySQL 5.6 doesn't support clustering order, to workaround, we let txn_id multiple by -1
 	*row.TxnID *= -1
-	return mdb.conn.NamedExec(addHistoryNodesQry, row)
+	return mdb.conn.NamedExecContext(ctx, addHistoryNodesQry, row)
 }
 
 // SelectFromHistoryNode reads one or more rows from history_node table
-func (mdb *DB) SelectFromHistoryNode(filter *sqldb.HistoryNodeFilter) ([]sqldb.HistoryNodeRow, error) {
+func (mdb *DB) SelectFromHistoryNode(ctx context.Context, filter *sqldb.HistoryNodeFilter) ([]sqldb.HistoryNodeRow, error) {
 	var rows []sqldb.HistoryNodeRow
-	err := mdb.conn.Select(&rows, getHistoryNodesQry,
+	err := mdb.conn.SelectContext(ctx, &rows, getHistoryNodesQry,
 		filter.ShardID, filter.TreeID, filter.BranchID, *filter.MinNodeID, *filter.MaxNodeID, *filter.PageSize)
 	// NOTE: since we let txn_id multiple by -1 when inserting, we have to revert it back here
 	for _, row := range rows {
@@ -71,30 +72,30 @@ func (mdb *DB) SelectFromHistoryNode(filter *sqldb.HistoryNodeFilter) ([]sqldb.H
 }
 
 // DeleteFromHistoryNode deletes one or more rows from history_node table
-func (mdb *DB) DeleteFromHistoryNode(filter *sqldb.HistoryNodeFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteHistoryNodesQry, filter.ShardID, filter.TreeID, filter.BranchID, *filter.MinNodeID)
+func (mdb *DB) DeleteFromHistoryNode(ctx context.Context, filter *sqldb.HistoryNodeFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteHistoryNodesQry, filter.ShardID, filter.TreeID, filter.BranchID, *filter.MinNodeID)
 }
 
 // For history_tree table:
 
 // InsertIntoHistoryTree inserts a row into history_tree table
-func (mdb *DB) InsertIntoHistoryTree(row *sqldb.HistoryTreeRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(addHistoryTreeQry, row)
+func (mdb *DB) InsertIntoHistoryTree(ctx context.Context, row *sqldb.HistoryTreeRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, addHistoryTreeQry, row)
 }
 
 // SelectFromHistoryTree reads one or more rows from history_tree table
-func (mdb *DB) SelectFromHistoryTree(filter *sqldb.HistoryTreeFilter) ([]sqldb.HistoryTreeRow, error) {
+func (mdb *DB) SelectFromHistoryTree(ctx context.Context, filter *sqldb.HistoryTreeFilter) ([]sqldb.HistoryTreeRow, error) {
 	var rows []sqldb.HistoryTreeRow
-	err := mdb.conn.Select(&rows, getHistoryTreeQry, filter.ShardID, filter.TreeID)
+	err := mdb.conn.SelectContext(ctx, &rows, getHistoryTreeQry, filter.ShardID, filter.TreeID)
 	return rows, err
 }
 
 // UpdateHistoryTree updates a row in history_tree table
-func (mdb *DB) UpdateHistoryTree(row *sqldb.HistoryTreeRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(updateHistoryTreeQry, row)
+func (mdb *DB) UpdateHistoryTree(ctx context.Context, row *sqldb.HistoryTreeRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, updateHistoryTreeQry, row)
 }
 
 // DeleteFromHistoryTree deletes one or more rows from history_tree table
-func (mdb *DB) DeleteFromHistoryTree(filter *sqldb.HistoryTreeFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteHistoryTreeQry, filter.ShardID, filter.TreeID, *filter.BranchID)
+func (mdb *DB) DeleteFromHistoryTree(ctx context.Context, filter *sqldb.HistoryTreeFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteHistoryTreeQry, filter.ShardID, filter.TreeID, *filter.BranchID)
 }
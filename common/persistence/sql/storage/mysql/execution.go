@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
@@ -111,6 +112,20 @@ ORDER BY task_id LIMIT ?`
 
 	deleteReplicationTaskQry = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id = ?`
 
+	replaceReplicationTaskDLQQry = `REPLACE INTO replication_tasks_dlq (source_cluster_name, shard_id, task_id, data, data_encoding) 
+  VALUES(:source_cluster_name, :shard_id, :task_id, :data, :data_encoding)`
+
+	getReplicationTasksDLQQry = `SELECT task_id, data, data_encoding FROM replication_tasks_dlq WHERE 
+source_cluster_name = ? AND
+shard_id = ? AND
+task_id > ? AND
+task_id <= ?
+ORDER BY task_id LIMIT ?`
+
+	deleteReplicationTaskFromDLQQry = `DELETE FROM replication_tasks_dlq WHERE source_cluster_name = ? AND shard_id = ? AND task_id = ?`
+
+	rangeDeleteReplicationTaskFromDLQQry = `DELETE FROM replication_tasks_dlq WHERE source_cluster_name = ? AND shard_id = ? AND task_id > ? AND task_id <= ?`
+
 	bufferedEventsColumns    = `shard_id, domain_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQury = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -121,19 +136,19 @@ shard_id=? AND domain_id=? AND workflow_id=? AND run_id=?`
 )
 
 // InsertIntoExecutions inserts a row into executions table
-func (mdb *DB) InsertIntoExecutions(row *sqldb.ExecutionsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createExecutionQry, row)
+func (mdb *DB) InsertIntoExecutions(ctx context.Context, row *sqldb.ExecutionsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createExecutionQry, row)
 }
 
 // UpdateExecutions updates a single row in executions table
-func (mdb *DB) UpdateExecutions(row *sqldb.ExecutionsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(updateExecutionQry, row)
+func (mdb *DB) UpdateExecutions(ctx context.Context, row *sqldb.ExecutionsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, updateExecutionQry, row)
 }
 
 // SelectFromExecutions reads a single row from executions table
-func (mdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.ExecutionsRow, error) {
+func (mdb *DB) SelectFromExecutions(ctx context.Context, filter *sqldb.ExecutionsFilter) (*sqldb.ExecutionsRow, error) {
 	var row sqldb.ExecutionsRow
-	err := mdb.conn.Get(&row, getExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	err := mdb.conn.GetContext(ctx, &row, getExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 	if err != nil {
 		return nil, err
 	}
@@ -141,70 +156,70 @@ func (mdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.Exec
 }
 
 // DeleteFromExecutions deletes a single row from executions table
-func (mdb *DB) DeleteFromExecutions(filter *sqldb.ExecutionsFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+func (mdb *DB) DeleteFromExecutions(ctx context.Context, filter *sqldb.ExecutionsFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 }
 
 // ReadLockExecutions acquires a write lock on a single row in executions table
-func (mdb *DB) ReadLockExecutions(filter *sqldb.ExecutionsFilter) (int, error) {
+func (mdb *DB) ReadLockExecutions(ctx context.Context, filter *sqldb.ExecutionsFilter) (int, error) {
 	var nextEventID int
-	err := mdb.conn.Get(&nextEventID, readLockExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	err := mdb.conn.GetContext(ctx, &nextEventID, readLockExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 	return nextEventID, err
 }
 
 // WriteLockExecutions acquires a write lock on a single row in executions table
-func (mdb *DB) WriteLockExecutions(filter *sqldb.ExecutionsFilter) (int, error) {
+func (mdb *DB) WriteLockExecutions(ctx context.Context, filter *sqldb.ExecutionsFilter) (int, error) {
 	var nextEventID int
-	err := mdb.conn.Get(&nextEventID, writeLockExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	err := mdb.conn.GetContext(ctx, &nextEventID, writeLockExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 	return nextEventID, err
 }
 
 // InsertIntoCurrentExecutions inserts a single row into current_executions table
-func (mdb *DB) InsertIntoCurrentExecutions(row *sqldb.CurrentExecutionsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createCurrentExecutionQry, row)
+func (mdb *DB) InsertIntoCurrentExecutions(ctx context.Context, row *sqldb.CurrentExecutionsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createCurrentExecutionQry, row)
 }
 
 // UpdateCurrentExecutions updates a single row in current_executions table
-func (mdb *DB) UpdateCurrentExecutions(row *sqldb.CurrentExecutionsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(updateCurrentExecutionsQry, row)
+func (mdb *DB) UpdateCurrentExecutions(ctx context.Context, row *sqldb.CurrentExecutionsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, updateCurrentExecutionsQry, row)
 }
 
 // SelectFromCurrentExecutions reads one or more rows from current_executions table
-func (mdb *DB) SelectFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (*sqldb.CurrentExecutionsRow, error) {
+func (mdb *DB) SelectFromCurrentExecutions(ctx context.Context, filter *sqldb.CurrentExecutionsFilter) (*sqldb.CurrentExecutionsRow, error) {
 	var row sqldb.CurrentExecutionsRow
-	err := mdb.conn.Get(&row, getCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
+	err := mdb.conn.GetContext(ctx, &row, getCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
 	return &row, err
 }
 
 // DeleteFromCurrentExecutions deletes a single row in current_executions table
-func (mdb *DB) DeleteFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+func (mdb *DB) DeleteFromCurrentExecutions(ctx context.Context, filter *sqldb.CurrentExecutionsFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 }
 
 // LockCurrentExecutions acquires a write lock on a single row in current_executions table
-func (mdb *DB) LockCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (*sqldb.CurrentExecutionsRow, error) {
+func (mdb *DB) LockCurrentExecutions(ctx context.Context, filter *sqldb.CurrentExecutionsFilter) (*sqldb.CurrentExecutionsRow, error) {
 	var row sqldb.CurrentExecutionsRow
-	err := mdb.conn.Get(&row, lockCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
+	err := mdb.conn.GetContext(ctx, &row, lockCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
 	return &row, err
 }
 
 // LockCurrentExecutionsJoinExecutions joins a row in current_executions with executions table and acquires a
 // write lock on the result
-func (mdb *DB) LockCurrentExecutionsJoinExecutions(filter *sqldb.CurrentExecutionsFilter) ([]sqldb.CurrentExecutionsRow, error) {
+func (mdb *DB) LockCurrentExecutionsJoinExecutions(ctx context.Context, filter *sqldb.CurrentExecutionsFilter) ([]sqldb.CurrentExecutionsRow, error) {
 	var rows []sqldb.CurrentExecutionsRow
-	err := mdb.conn.Select(&rows, lockCurrentExecutionJoinExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
+	err := mdb.conn.SelectContext(ctx, &rows, lockCurrentExecutionJoinExecutionsQry, filter.ShardID, filter.DomainID, filter.WorkflowID)
 	return rows, err
 }
 
 // InsertIntoTransferTasks inserts one or more rows into transfer_tasks table
-func (mdb *DB) InsertIntoTransferTasks(rows []sqldb.TransferTasksRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createTransferTasksQry, rows)
+func (mdb *DB) InsertIntoTransferTasks(ctx context.Context, rows []sqldb.TransferTasksRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createTransferTasksQry, rows)
 }
 
 // SelectFromTransferTasks reads one or more rows from transfer_tasks table
-func (mdb *DB) SelectFromTransferTasks(filter *sqldb.TransferTasksFilter) ([]sqldb.TransferTasksRow, error) {
+func (mdb *DB) SelectFromTransferTasks(ctx context.Context, filter *sqldb.TransferTasksFilter) ([]sqldb.TransferTasksRow, error) {
 	var rows []sqldb.TransferTasksRow
-	err := mdb.conn.Select(&rows, getTransferTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
+	err := mdb.conn.SelectContext(ctx, &rows, getTransferTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
 	if err != nil {
 		return nil, err
 	}
@@ -212,27 +227,27 @@ func (mdb *DB) SelectFromTransferTasks(filter *sqldb.TransferTasksFilter) ([]sql
 }
 
 // DeleteFromTransferTasks deletes one or more rows from transfer_tasks table
-func (mdb *DB) DeleteFromTransferTasks(filter *sqldb.TransferTasksFilter) (sql.Result, error) {
+func (mdb *DB) DeleteFromTransferTasks(ctx context.Context, filter *sqldb.TransferTasksFilter) (sql.Result, error) {
 	if filter.MinTaskID != nil {
-		return mdb.conn.Exec(rangeDeleteTransferTaskQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
+		return mdb.conn.ExecContext(ctx, rangeDeleteTransferTaskQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
 	}
-	return mdb.conn.Exec(deleteTransferTaskQry, filter.ShardID, *filter.TaskID)
+	return mdb.conn.ExecContext(ctx, deleteTransferTaskQry, filter.ShardID, *filter.TaskID)
 }
 
 // InsertIntoTimerTasks inserts one or more rows into timer_tasks table
-func (mdb *DB) InsertIntoTimerTasks(rows []sqldb.TimerTasksRow) (sql.Result, error) {
+func (mdb *DB) InsertIntoTimerTasks(ctx context.Context, rows []sqldb.TimerTasksRow) (sql.Result, error) {
 	for i := range rows {
 		rows[i].VisibilityTimestamp = mdb.converter.ToMySQLDateTime(rows[i].VisibilityTimestamp)
 	}
-	return mdb.conn.NamedExec(createTimerTasksQry, rows)
+	return mdb.conn.NamedExecContext(ctx, createTimerTasksQry, rows)
 }
 
 // SelectFromTimerTasks reads one or more rows from timer_tasks table
-func (mdb *DB) SelectFromTimerTasks(filter *sqldb.TimerTasksFilter) ([]sqldb.TimerTasksRow, error) {
+func (mdb *DB) SelectFromTimerTasks(ctx context.Context, filter *sqldb.TimerTasksFilter) ([]sqldb.TimerTasksRow, error) {
 	var rows []sqldb.TimerTasksRow
 	*filter.MinVisibilityTimestamp = mdb.converter.ToMySQLDateTime(*filter.MinVisibilityTimestamp)
 	*filter.MaxVisibilityTimestamp = mdb.converter.ToMySQLDateTime(*filter.MaxVisibilityTimestamp)
-	err := mdb.conn.Select(&rows, getTimerTasksQry, filter.ShardID, *filter.MinVisibilityTimestamp,
+	err := mdb.conn.SelectContext(ctx, &rows, getTimerTasksQry, filter.ShardID, *filter.MinVisibilityTimestamp,
 		filter.TaskID, *filter.MinVisibilityTimestamp, *filter.MaxVisibilityTimestamp, *filter.PageSize)
 	if err != nil {
 		return nil, err
@@ -244,25 +259,25 @@ func (mdb *DB) SelectFromTimerTasks(filter *sqldb.TimerTasksFilter) ([]sqldb.Tim
 }
 
 // DeleteFromTimerTasks deletes one or more rows from timer_tasks table
-func (mdb *DB) DeleteFromTimerTasks(filter *sqldb.TimerTasksFilter) (sql.Result, error) {
+func (mdb *DB) DeleteFromTimerTasks(ctx context.Context, filter *sqldb.TimerTasksFilter) (sql.Result, error) {
 	if filter.MinVisibilityTimestamp != nil {
 		*filter.MinVisibilityTimestamp = mdb.converter.ToMySQLDateTime(*filter.MinVisibilityTimestamp)
 		*filter.MaxVisibilityTimestamp = mdb.converter.ToMySQLDateTime(*filter.MaxVisibilityTimestamp)
-		return mdb.conn.Exec(rangeDeleteTimerTaskQry, filter.ShardID, *filter.MinVisibilityTimestamp, *filter.MaxVisibilityTimestamp)
+		return mdb.conn.ExecContext(ctx, rangeDeleteTimerTaskQry, filter.ShardID, *filter.MinVisibilityTimestamp, *filter.MaxVisibilityTimestamp)
 	}
 	*filter.VisibilityTimestamp = mdb.converter.ToMySQLDateTime(*filter.VisibilityTimestamp)
-	return mdb.conn.Exec(deleteTimerTaskQry, filter.ShardID, *filter.VisibilityTimestamp, filter.TaskID)
+	return mdb.conn.ExecContext(ctx, deleteTimerTaskQry, filter.ShardID, *filter.VisibilityTimestamp, filter.TaskID)
 }
 
 // InsertIntoBufferedEvents inserts one or more rows into buffered_events table
-func (mdb *DB) InsertIntoBufferedEvents(rows []sqldb.BufferedEventsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createBufferedEventsQury, rows)
+func (mdb *DB) InsertIntoBufferedEvents(ctx context.Context, rows []sqldb.BufferedEventsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createBufferedEventsQury, rows)
 }
 
 // SelectFromBufferedEvents reads one or more rows from buffered_events table
-func (mdb *DB) SelectFromBufferedEvents(filter *sqldb.BufferedEventsFilter) ([]sqldb.BufferedEventsRow, error) {
+func (mdb *DB) SelectFromBufferedEvents(ctx context.Context, filter *sqldb.BufferedEventsFilter) ([]sqldb.BufferedEventsRow, error) {
 	var rows []sqldb.BufferedEventsRow
-	err := mdb.conn.Select(&rows, getBufferedEventsQury, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	err := mdb.conn.SelectContext(ctx, &rows, getBufferedEventsQury, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 	for i := 0; i < len(rows); i++ {
 		rows[i].DomainID = filter.DomainID
 		rows[i].WorkflowID = filter.WorkflowID
@@ -273,23 +288,50 @@ func (mdb *DB) SelectFromBufferedEvents(filter *sqldb.BufferedEventsFilter) ([]s
 }
 
 // DeleteFromBufferedEvents deletes one or more rows from buffered_events table
-func (mdb *DB) DeleteFromBufferedEvents(filter *sqldb.BufferedEventsFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteBufferedEventsQury, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+func (mdb *DB) DeleteFromBufferedEvents(ctx context.Context, filter *sqldb.BufferedEventsFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteBufferedEventsQury, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 }
 
 // InsertIntoReplicationTasks inserts one or more rows into replication_tasks table
-func (mdb *DB) InsertIntoReplicationTasks(rows []sqldb.ReplicationTasksRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createReplicationTasksQry, rows)
+func (mdb *DB) InsertIntoReplicationTasks(ctx context.Context, rows []sqldb.ReplicationTasksRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createReplicationTasksQry, rows)
 }
 
 // SelectFromReplicationTasks reads one or more rows from replication_tasks table
-func (mdb *DB) SelectFromReplicationTasks(filter *sqldb.ReplicationTasksFilter) ([]sqldb.ReplicationTasksRow, error) {
+func (mdb *DB) SelectFromReplicationTasks(ctx context.Context, filter *sqldb.ReplicationTasksFilter) ([]sqldb.ReplicationTasksRow, error) {
 	var rows []sqldb.ReplicationTasksRow
-	err := mdb.conn.Select(&rows, getReplicationTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
+	err := mdb.conn.SelectContext(ctx, &rows, getReplicationTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
 	return rows, err
 }
 
 // DeleteFromReplicationTasks deletes one or more rows from replication_tasks table
-func (mdb *DB) DeleteFromReplicationTasks(filter *sqldb.ReplicationTasksFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteReplicationTaskQry, filter.ShardID, *filter.TaskID)
+func (mdb *DB) DeleteFromReplicationTasks(ctx context.Context, filter *sqldb.ReplicationTasksFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteReplicationTaskQry, filter.ShardID, *filter.TaskID)
+}
+
+// ReplaceIntoReplicationTasksDLQ replaces a row in replication_tasks_dlq table
+func (mdb *DB) ReplaceIntoReplicationTasksDLQ(ctx context.Context, row *sqldb.ReplicationTasksDLQRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, replaceReplicationTaskDLQQry, row)
+}
+
+// SelectFromReplicationTasksDLQ reads one or more rows from replication_tasks_dlq table
+func (mdb *DB) SelectFromReplicationTasksDLQ(ctx context.Context, filter *sqldb.ReplicationTasksDLQFilter) ([]sqldb.ReplicationTasksRow, error) {
+	var rows []sqldb.ReplicationTasksRow
+	err := mdb.conn.SelectContext(
+		ctx,
+		&rows, getReplicationTasksDLQQry,
+		filter.SourceClusterName,
+		filter.ShardID,
+		*filter.MinTaskID,
+		*filter.MaxTaskID,
+		*filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromReplicationTasksDLQ deletes one or more rows from replication_tasks_dlq table
+func (mdb *DB) DeleteFromReplicationTasksDLQ(ctx context.Context, filter *sqldb.ReplicationTasksDLQFilter) (sql.Result, error) {
+	if filter.MinTaskID != nil {
+		return mdb.conn.ExecContext(ctx, rangeDeleteReplicationTaskFromDLQQry, filter.SourceClusterName, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
+	}
+	return mdb.conn.ExecContext(ctx, deleteReplicationTaskFromDLQQry, filter.SourceClusterName, filter.ShardID, *filter.TaskID)
 }
@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// the heartbeat and expiry of host records are taken from the database clock, in UTC like
+// every other datetime written by cadence, so that clock skew between hosts does not matter
+const (
+	replaceIntoClusterMembershipQry = `REPLACE INTO cluster_membership (` +
+		`host_id, rpc_address, role, session_start, last_heartbeat, record_expiry) ` +
+		`VALUES (?, ?, ?, ?, UTC_TIMESTAMP(6), DATE_ADD(UTC_TIMESTAMP(6), INTERVAL ? MICROSECOND))`
+
+	getClusterMembersQry = `SELECT host_id, rpc_address, role, session_start, last_heartbeat, record_expiry ` +
+		`FROM cluster_membership WHERE record_expiry > UTC_TIMESTAMP(6)`
+
+	clusterMembersLastHeartbeatWithinClause = ` AND last_heartbeat > DATE_SUB(UTC_TIMESTAMP(6), INTERVAL ? MICROSECOND)`
+
+	clusterMembersRoleClause = ` AND role = ?`
+
+	deleteClusterMembershipQry = `DELETE FROM cluster_membership WHERE host_id = ?`
+
+	pruneClusterMembershipQry = `DELETE FROM cluster_membership WHERE record_expiry < UTC_TIMESTAMP(6) LIMIT ?`
+)
+
+// ReplaceIntoClusterMembership replaces a host record in cluster_membership table
+func (mdb *DB) ReplaceIntoClusterMembership(ctx context.Context, row *sqldb.ClusterMembershipRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, replaceIntoClusterMembershipQry,
+		row.HostID,
+		row.RPCAddress,
+		row.Role,
+		mdb.converter.ToMySQLDateTime(row.SessionStart),
+		row.RecordTTL.Nanoseconds()/int64(time.Microsecond))
+}
+
+// SelectFromClusterMembership reads one or more rows from cluster_membership table
+func (mdb *DB) SelectFromClusterMembership(ctx context.Context, filter *sqldb.ClusterMembershipFilter) ([]sqldb.ClusterMembershipRow, error) {
+	query := getClusterMembersQry
+	var args []interface{}
+	if filter.LastHeartbeatWithin > 0 {
+		query += clusterMembersLastHeartbeatWithinClause
+		args = append(args, filter.LastHeartbeatWithin.Nanoseconds()/int64(time.Microsecond))
+	}
+	if filter.Role != "" {
+		query += clusterMembersRoleClause
+		args = append(args, filter.Role)
+	}
+	var rows []sqldb.ClusterMembershipRow
+	if err := mdb.conn.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].SessionStart = mdb.converter.FromMySQLDateTime(rows[i].SessionStart)
+		rows[i].LastHeartbeat = mdb.converter.FromMySQLDateTime(rows[i].LastHeartbeat)
+		rows[i].RecordExpiry = mdb.converter.FromMySQLDateTime(rows[i].RecordExpiry)
+	}
+	return rows, nil
+}
+
+// DeleteFromClusterMembership deletes the row of a host or expired rows from cluster_membership table
+func (mdb *DB) DeleteFromClusterMembership(ctx context.Context, filter *sqldb.ClusterMembershipFilter) (sql.Result, error) {
+	if filter.HostID != "" {
+		return mdb.conn.ExecContext(ctx, deleteClusterMembershipQry, filter.HostID)
+	}
+	return mdb.conn.ExecContext(ctx, pruneClusterMembershipQry, filter.Limit)
+}
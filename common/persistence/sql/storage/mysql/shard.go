@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
@@ -43,19 +44,19 @@ const (
 )
 
 // InsertIntoShards inserts one or more rows into shards table
-func (mdb *DB) InsertIntoShards(row *sqldb.ShardsRow) (sql.Result, error) {
-	return mdb.conn.Exec(createShardQry, row.ShardID, row.RangeID, row.Data, row.DataEncoding)
+func (mdb *DB) InsertIntoShards(ctx context.Context, row *sqldb.ShardsRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, createShardQry, row.ShardID, row.RangeID, row.Data, row.DataEncoding)
 }
 
 // UpdateShards updates one or more rows into shards table
-func (mdb *DB) UpdateShards(row *sqldb.ShardsRow) (sql.Result, error) {
-	return mdb.conn.Exec(updateShardQry, row.RangeID, row.Data, row.DataEncoding, row.ShardID)
+func (mdb *DB) UpdateShards(ctx context.Context, row *sqldb.ShardsRow) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, updateShardQry, row.RangeID, row.Data, row.DataEncoding, row.ShardID)
 }
 
 // SelectFromShards reads one or more rows from shards table
-func (mdb *DB) SelectFromShards(filter *sqldb.ShardsFilter) (*sqldb.ShardsRow, error) {
+func (mdb *DB) SelectFromShards(ctx context.Context, filter *sqldb.ShardsFilter) (*sqldb.ShardsRow, error) {
 	var row sqldb.ShardsRow
-	err := mdb.conn.Get(&row, getShardQry, filter.ShardID)
+	err := mdb.conn.GetContext(ctx, &row, getShardQry, filter.ShardID)
 	if err != nil {
 		return nil, err
 	}
@@ -63,15 +64,15 @@ func (mdb *DB) SelectFromShards(filter *sqldb.ShardsFilter) (*sqldb.ShardsRow, e
 }
 
 // ReadLockShards acquires a read lock on a single row in shards table
-func (mdb *DB) ReadLockShards(filter *sqldb.ShardsFilter) (int, error) {
+func (mdb *DB) ReadLockShards(ctx context.Context, filter *sqldb.ShardsFilter) (int, error) {
 	var rangeID int
-	err := mdb.conn.Get(&rangeID, readLockShardQry, filter.ShardID)
+	err := mdb.conn.GetContext(ctx, &rangeID, readLockShardQry, filter.ShardID)
 	return rangeID, err
 }
 
 // WriteLockShards acquires a write lock on a single row in shards table
-func (mdb *DB) WriteLockShards(filter *sqldb.ShardsFilter) (int, error) {
+func (mdb *DB) WriteLockShards(ctx context.Context, filter *sqldb.ShardsFilter) (int, error) {
 	var rangeID int
-	err := mdb.conn.Get(&rangeID, lockShardQry, filter.ShardID)
+	err := mdb.conn.GetContext(ctx, &rangeID, lockShardQry, filter.ShardID)
 	return rangeID, err
 }
@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 
 	"fmt"
@@ -83,20 +84,20 @@ task_type = :task_type
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
-func (mdb *DB) InsertIntoTasks(rows []sqldb.TasksRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createTaskQry, rows)
+func (mdb *DB) InsertIntoTasks(ctx context.Context, rows []sqldb.TasksRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createTaskQry, rows)
 }
 
 // SelectFromTasks reads one or more rows from tasks table
-func (mdb *DB) SelectFromTasks(filter *sqldb.TasksFilter) ([]sqldb.TasksRow, error) {
+func (mdb *DB) SelectFromTasks(ctx context.Context, filter *sqldb.TasksFilter) ([]sqldb.TasksRow, error) {
 	var err error
 	var rows []sqldb.TasksRow
 	switch {
 	case filter.MaxTaskID != nil:
-		err = mdb.conn.Select(&rows, getTaskMinMaxQry, filter.DomainID,
+		err = mdb.conn.SelectContext(ctx, &rows, getTaskMinMaxQry, filter.DomainID,
 			filter.TaskListName, filter.TaskType, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
 	default:
-		err = mdb.conn.Select(&rows, getTaskMinQry, filter.DomainID,
+		err = mdb.conn.SelectContext(ctx, &rows, getTaskMinQry, filter.DomainID,
 			filter.TaskListName, filter.TaskType, *filter.MinTaskID, *filter.PageSize)
 	}
 	if err != nil {
@@ -106,58 +107,58 @@ func (mdb *DB) SelectFromTasks(filter *sqldb.TasksFilter) ([]sqldb.TasksRow, err
 }
 
 // DeleteFromTasks deletes one or more rows from tasks table
-func (mdb *DB) DeleteFromTasks(filter *sqldb.TasksFilter) (sql.Result, error) {
+func (mdb *DB) DeleteFromTasks(ctx context.Context, filter *sqldb.TasksFilter) (sql.Result, error) {
 	if filter.TaskIDLessThanEquals != nil {
 		if filter.Limit == nil || *filter.Limit == 0 {
 			return nil, fmt.Errorf("missing limit parameter")
 		}
-		return mdb.conn.Exec(rangeDeleteTaskQry,
+		return mdb.conn.ExecContext(ctx, rangeDeleteTaskQry,
 			filter.DomainID, filter.TaskListName, filter.TaskType, *filter.TaskIDLessThanEquals, *filter.Limit)
 	}
-	return mdb.conn.Exec(deleteTaskQry, filter.DomainID, filter.TaskListName, filter.TaskType, *filter.TaskID)
+	return mdb.conn.ExecContext(ctx, deleteTaskQry, filter.DomainID, filter.TaskListName, filter.TaskType, *filter.TaskID)
 }
 
 // InsertIntoTaskLists inserts one or more rows into task_lists table
-func (mdb *DB) InsertIntoTaskLists(row *sqldb.TaskListsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(createTaskListQry, row)
+func (mdb *DB) InsertIntoTaskLists(ctx context.Context, row *sqldb.TaskListsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, createTaskListQry, row)
 }
 
 // ReplaceIntoTaskLists replaces one or more rows in task_lists table
-func (mdb *DB) ReplaceIntoTaskLists(row *sqldb.TaskListsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(replaceTaskListQry, row)
+func (mdb *DB) ReplaceIntoTaskLists(ctx context.Context, row *sqldb.TaskListsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, replaceTaskListQry, row)
 }
 
 // UpdateTaskLists updates a row in task_lists table
-func (mdb *DB) UpdateTaskLists(row *sqldb.TaskListsRow) (sql.Result, error) {
-	return mdb.conn.NamedExec(updateTaskListQry, row)
+func (mdb *DB) UpdateTaskLists(ctx context.Context, row *sqldb.TaskListsRow) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx, updateTaskListQry, row)
 }
 
 // SelectFromTaskLists reads one or more rows from task_lists table
-func (mdb *DB) SelectFromTaskLists(filter *sqldb.TaskListsFilter) ([]sqldb.TaskListsRow, error) {
+func (mdb *DB) SelectFromTaskLists(ctx context.Context, filter *sqldb.TaskListsFilter) ([]sqldb.TaskListsRow, error) {
 	switch {
 	case filter.DomainID != nil && filter.Name != nil && filter.TaskType != nil:
-		return mdb.selectFromTaskLists(filter)
+		return mdb.selectFromTaskLists(ctx, filter)
 	case filter.DomainIDGreaterThan != nil && filter.NameGreaterThan != nil && filter.TaskTypeGreaterThan != nil && filter.PageSize != nil:
-		return mdb.rangeSelectFromTaskLists(filter)
+		return mdb.rangeSelectFromTaskLists(ctx, filter)
 	default:
 		return nil, fmt.Errorf("invalid set of query filter params")
 	}
 }
 
-func (mdb *DB) selectFromTaskLists(filter *sqldb.TaskListsFilter) ([]sqldb.TaskListsRow, error) {
+func (mdb *DB) selectFromTaskLists(ctx context.Context, filter *sqldb.TaskListsFilter) ([]sqldb.TaskListsRow, error) {
 	var err error
 	var row sqldb.TaskListsRow
-	err = mdb.conn.Get(&row, getTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType)
+	err = mdb.conn.GetContext(ctx, &row, getTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType)
 	if err != nil {
 		return nil, err
 	}
 	return []sqldb.TaskListsRow{row}, err
 }
 
-func (mdb *DB) rangeSelectFromTaskLists(filter *sqldb.TaskListsFilter) ([]sqldb.TaskListsRow, error) {
+func (mdb *DB) rangeSelectFromTaskLists(ctx context.Context, filter *sqldb.TaskListsFilter) ([]sqldb.TaskListsRow, error) {
 	var err error
 	var rows []sqldb.TaskListsRow
-	err = mdb.conn.Select(&rows, listTaskListQry,
+	err = mdb.conn.SelectContext(ctx, &rows, listTaskListQry,
 		filter.ShardID, *filter.DomainIDGreaterThan, *filter.NameGreaterThan, *filter.TaskTypeGreaterThan, *filter.PageSize)
 	if err != nil {
 		return nil, err
@@ -169,13 +170,13 @@ func (mdb *DB) rangeSelectFromTaskLists(filter *sqldb.TaskListsFilter) ([]sqldb.
 }
 
 // DeleteFromTaskLists deletes a row from task_lists table
-func (mdb *DB) DeleteFromTaskLists(filter *sqldb.TaskListsFilter) (sql.Result, error) {
-	return mdb.conn.Exec(deleteTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType, *filter.RangeID)
+func (mdb *DB) DeleteFromTaskLists(ctx context.Context, filter *sqldb.TaskListsFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, deleteTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType, *filter.RangeID)
 }
 
 // LockTaskLists locks a row in task_lists table
-func (mdb *DB) LockTaskLists(filter *sqldb.TaskListsFilter) (int64, error) {
+func (mdb *DB) LockTaskLists(ctx context.Context, filter *sqldb.TaskListsFilter) (int64, error) {
 	var rangeID int64
-	err := mdb.conn.Get(&rangeID, lockTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType)
+	err := mdb.conn.GetContext(ctx, &rangeID, lockTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType)
 	return rangeID, err
 }
@@ -21,6 +21,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -77,9 +78,9 @@ var errCloseParams = errors.New("missing one of {closeStatus, closeTime, history
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
-func (mdb *DB) InsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqldb.VisibilityRow) (sql.Result, error) {
 	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
-	return mdb.conn.Exec(templateCreateWorkflowExecutionStarted,
+	return mdb.conn.ExecContext(ctx, templateCreateWorkflowExecutionStarted,
 		row.DomainID,
 		row.WorkflowID,
 		row.RunID,
@@ -91,12 +92,12 @@ func (mdb *DB) InsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
-func (mdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+func (mdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqldb.VisibilityRow) (sql.Result, error) {
 	switch {
 	case row.CloseStatus != nil && row.CloseTime != nil && row.HistoryLength != nil:
 		row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
 		closeTime := mdb.converter.ToMySQLDateTime(*row.CloseTime)
-		return mdb.conn.Exec(templateCreateWorkflowExecutionClosed,
+		return mdb.conn.ExecContext(ctx, templateCreateWorkflowExecutionClosed,
 			row.DomainID,
 			row.WorkflowID,
 			row.RunID,
@@ -114,12 +115,12 @@ func (mdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, erro
 }
 
 // DeleteFromVisibility deletes a row from visibility table if it exist
-func (mdb *DB) DeleteFromVisibility(filter *sqldb.VisibilityFilter) (sql.Result, error) {
-	return mdb.conn.Exec(templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
+func (mdb *DB) DeleteFromVisibility(ctx context.Context, filter *sqldb.VisibilityFilter) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx, templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
 // SelectFromVisibility reads one or more rows from visibility table
-func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.VisibilityRow, error) {
+func (mdb *DB) SelectFromVisibility(ctx context.Context, filter *sqldb.VisibilityFilter) ([]sqldb.VisibilityRow, error) {
 	var err error
 	var rows []sqldb.VisibilityRow
 	if filter.MinStartTime != nil {
@@ -131,7 +132,7 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 	switch {
 	case filter.MinStartTime == nil && filter.RunID != nil && filter.Closed:
 		var row sqldb.VisibilityRow
-		err = mdb.conn.Get(&row, templateGetClosedWorkflowExecution, filter.DomainID, *filter.RunID)
+		err = mdb.conn.GetContext(ctx, &row, templateGetClosedWorkflowExecution, filter.DomainID, *filter.RunID)
 		if err == nil {
 			rows = append(rows, row)
 		}
@@ -140,7 +141,7 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutionsByID
 		}
-		err = mdb.conn.Select(&rows,
+		err = mdb.conn.SelectContext(ctx, &rows,
 			qry,
 			*filter.WorkflowID,
 			filter.DomainID,
@@ -154,7 +155,7 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutionsByType
 		}
-		err = mdb.conn.Select(&rows,
+		err = mdb.conn.SelectContext(ctx, &rows,
 			qry,
 			*filter.WorkflowTypeName,
 			filter.DomainID,
@@ -164,7 +165,7 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 			*filter.MaxStartTime,
 			*filter.PageSize)
 	case filter.MinStartTime != nil && filter.CloseStatus != nil:
-		err = mdb.conn.Select(&rows,
+		err = mdb.conn.SelectContext(ctx, &rows,
 			templateGetClosedWorkflowExecutionsByStatus,
 			*filter.CloseStatus,
 			filter.DomainID,
@@ -178,7 +179,7 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutions
 		}
-		err = mdb.conn.Select(&rows,
+		err = mdb.conn.SelectContext(ctx, &rows,
 			qry,
 			filter.DomainID,
 			mdb.converter.ToMySQLDateTime(*filter.MinStartTime),
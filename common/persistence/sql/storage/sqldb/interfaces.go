@@ -21,6 +21,7 @@
 package sqldb
 
 import (
+	"context"
 	"database/sql"
 	"time"
 )
@@ -212,6 +213,22 @@ type (
 		PageSize  *int
 	}
 
+	// ReplicationTasksDLQRow represents a row in replication_tasks_dlq table
+	ReplicationTasksDLQRow struct {
+		SourceClusterName string
+		ShardID           int
+		TaskID            int64
+		Data              []byte
+		DataEncoding      string
+	}
+
+	// ReplicationTasksDLQFilter contains the column names within replication_tasks_dlq table that
+	// can be used to filter results through a WHERE clause
+	ReplicationTasksDLQFilter struct {
+		ReplicationTasksFilter
+		SourceClusterName string
+	}
+
 	// TimerTasksRow represents a row in timer_tasks table
 	TimerTasksRow struct {
 		ShardID             int
@@ -481,31 +498,53 @@ type (
 		PageSize         *int
 	}
 
+	// ClusterMembershipRow represents a row in cluster_membership table, LastHeartbeat and
+	// RecordExpiry are set from the database clock on writes, RecordExpiry being RecordTTL later
+	ClusterMembershipRow struct {
+		HostID        string
+		RPCAddress    string
+		Role          string
+		SessionStart  time.Time
+		LastHeartbeat time.Time
+		RecordExpiry  time.Time
+		RecordTTL     time.Duration
+	}
+
+	// ClusterMembershipFilter contains the column names within cluster_membership table that
+	// can be used to filter results through a WHERE clause, expiry and heartbeats are
+	// compared with the database clock so that hosts with skewed clocks agree on liveness
+	ClusterMembershipFilter struct {
+		HostID              string
+		Role                string
+		LastHeartbeatWithin time.Duration
+		Limit               int
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
-		InsertIntoDomain(rows *DomainRow) (sql.Result, error)
-		UpdateDomain(row *DomainRow) (sql.Result, error)
+		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
+		UpdateDomain(ctx context.Context, row *DomainRow) (sql.Result, error)
 		// SelectFromDomain returns domains that match filter criteria. Either ID or
 		// Name can be specified to filter results. If both are not specified, all rows
 		// will be returned
-		SelectFromDomain(filter *DomainFilter) ([]DomainRow, error)
+		SelectFromDomain(ctx context.Context, filter *DomainFilter) ([]DomainRow, error)
 		// DeleteDomain deletes a single row. One of ID or Name MUST be specified
-		DeleteFromDomain(filter *DomainFilter) (sql.Result, error)
+		DeleteFromDomain(ctx context.Context, filter *DomainFilter) (sql.Result, error)
 
-		LockDomainMetadata() error
-		UpdateDomainMetadata(row *DomainMetadataRow) (sql.Result, error)
-		SelectFromDomainMetadata() (*DomainMetadataRow, error)
+		LockDomainMetadata(ctx context.Context) error
+		UpdateDomainMetadata(ctx context.Context, row *DomainMetadataRow) (sql.Result, error)
+		SelectFromDomainMetadata(ctx context.Context) (*DomainMetadataRow, error)
 
-		InsertIntoShards(rows *ShardsRow) (sql.Result, error)
-		UpdateShards(row *ShardsRow) (sql.Result, error)
-		SelectFromShards(filter *ShardsFilter) (*ShardsRow, error)
-		ReadLockShards(filter *ShardsFilter) (int, error)
-		WriteLockShards(filter *ShardsFilter) (int, error)
+		InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error)
+		UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error)
+		SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error)
+		ReadLockShards(ctx context.Context, filter *ShardsFilter) (int, error)
+		WriteLockShards(ctx context.Context, filter *ShardsFilter) (int, error)
 
-		InsertIntoTasks(rows []TasksRow) (sql.Result, error)
+		InsertIntoTasks(ctx context.Context, rows []TasksRow) (sql.Result, error)
 		// SelectFromTasks retrieves one or more rows from the tasks table
 		// Required filter params - {domainID, tasklistName, taskType, minTaskID, maxTaskID, pageSize}
-		SelectFromTasks(filter *TasksFilter) ([]TasksRow, error)
+		SelectFromTasks(ctx context.Context, filter *TasksFilter) ([]TasksRow, error)
 		// DeleteFromTasks deletes a row from tasks table
 		// Required filter params:
 		//  to delete single row
@@ -513,163 +552,173 @@ type (
 		//  to delete multiple rows
 		//    - {domainID, tasklistName, taskType, taskIDLessThanEquals, limit }
 		//    - this will delete upto limit number of tasks less than or equal to the given task id
-		DeleteFromTasks(filter *TasksFilter) (sql.Result, error)
+		DeleteFromTasks(ctx context.Context, filter *TasksFilter) (sql.Result, error)
 
-		InsertIntoTaskLists(row *TaskListsRow) (sql.Result, error)
-		ReplaceIntoTaskLists(row *TaskListsRow) (sql.Result, error)
-		UpdateTaskLists(row *TaskListsRow) (sql.Result, error)
+		InsertIntoTaskLists(ctx context.Context, row *TaskListsRow) (sql.Result, error)
+		ReplaceIntoTaskLists(ctx context.Context, row *TaskListsRow) (sql.Result, error)
+		UpdateTaskLists(ctx context.Context, row *TaskListsRow) (sql.Result, error)
 		// SelectFromTaskLists returns one or more rows from task_lists table
 		// Required Filter params:
 		//  to read a single row: {shardID, domainID, name, taskType}
 		//  to range read multiple rows: {shardID, domainIDGreaterThan, nameGreaterThan, taskTypeGreaterThan, pageSize}
-		SelectFromTaskLists(filter *TaskListsFilter) ([]TaskListsRow, error)
-		DeleteFromTaskLists(filter *TaskListsFilter) (sql.Result, error)
-		LockTaskLists(filter *TaskListsFilter) (int64, error)
+		SelectFromTaskLists(ctx context.Context, filter *TaskListsFilter) ([]TaskListsRow, error)
+		DeleteFromTaskLists(ctx context.Context, filter *TaskListsFilter) (sql.Result, error)
+		LockTaskLists(ctx context.Context, filter *TaskListsFilter) (int64, error)
 
 		// eventsV1: will be deprecated in favor of eventsV2
-		InsertIntoEvents(row *EventsRow) (sql.Result, error)
-		UpdateEvents(rows *EventsRow) (sql.Result, error)
-		SelectFromEvents(filter *EventsFilter) ([]EventsRow, error)
-		DeleteFromEvents(filter *EventsFilter) (sql.Result, error)
-		LockEvents(filter *EventsFilter) (*EventsRow, error)
+		InsertIntoEvents(ctx context.Context, row *EventsRow) (sql.Result, error)
+		UpdateEvents(ctx context.Context, rows *EventsRow) (sql.Result, error)
+		SelectFromEvents(ctx context.Context, filter *EventsFilter) ([]EventsRow, error)
+		DeleteFromEvents(ctx context.Context, filter *EventsFilter) (sql.Result, error)
+		LockEvents(ctx context.Context, filter *EventsFilter) (*EventsRow, error)
 
 		// eventsV2
-		InsertIntoHistoryNode(row *HistoryNodeRow) (sql.Result, error)
-		SelectFromHistoryNode(filter *HistoryNodeFilter) ([]HistoryNodeRow, error)
-		DeleteFromHistoryNode(filter *HistoryNodeFilter) (sql.Result, error)
-		InsertIntoHistoryTree(row *HistoryTreeRow) (sql.Result, error)
-		SelectFromHistoryTree(filter *HistoryTreeFilter) ([]HistoryTreeRow, error)
-		UpdateHistoryTree(row *HistoryTreeRow) (sql.Result, error)
-		DeleteFromHistoryTree(filter *HistoryTreeFilter) (sql.Result, error)
-
-		InsertIntoExecutions(row *ExecutionsRow) (sql.Result, error)
-		UpdateExecutions(row *ExecutionsRow) (sql.Result, error)
-		SelectFromExecutions(filter *ExecutionsFilter) (*ExecutionsRow, error)
-		DeleteFromExecutions(filter *ExecutionsFilter) (sql.Result, error)
-		ReadLockExecutions(filter *ExecutionsFilter) (int, error)
-		WriteLockExecutions(filter *ExecutionsFilter) (int, error)
-
-		LockCurrentExecutionsJoinExecutions(filter *CurrentExecutionsFilter) ([]CurrentExecutionsRow, error)
-
-		InsertIntoCurrentExecutions(row *CurrentExecutionsRow) (sql.Result, error)
-		UpdateCurrentExecutions(row *CurrentExecutionsRow) (sql.Result, error)
+		InsertIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error)
+		SelectFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) ([]HistoryNodeRow, error)
+		DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) (sql.Result, error)
+		InsertIntoHistoryTree(ctx context.Context, row *HistoryTreeRow) (sql.Result, error)
+		SelectFromHistoryTree(ctx context.Context, filter *HistoryTreeFilter) ([]HistoryTreeRow, error)
+		UpdateHistoryTree(ctx context.Context, row *HistoryTreeRow) (sql.Result, error)
+		DeleteFromHistoryTree(ctx context.Context, filter *HistoryTreeFilter) (sql.Result, error)
+
+		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
+		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
+		SelectFromExecutions(ctx context.Context, filter *ExecutionsFilter) (*ExecutionsRow, error)
+		DeleteFromExecutions(ctx context.Context, filter *ExecutionsFilter) (sql.Result, error)
+		ReadLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error)
+		WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error)
+
+		LockCurrentExecutionsJoinExecutions(ctx context.Context, filter *CurrentExecutionsFilter) ([]CurrentExecutionsRow, error)
+
+		InsertIntoCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error)
+		UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error)
 		// SelectFromCurrentExecutions returns one or more rows from current_executions table
 		// Required params - {shardID, domainID, workflowID}
-		SelectFromCurrentExecutions(filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
+		SelectFromCurrentExecutions(ctx context.Context, filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
 		// DeleteFromCurrentExecutions deletes a single row that matches the filter criteria
 		// If a row exist, that row will be deleted and this method will return success
 		// If there is no row matching the filter criteria, this method will still return success
 		// Callers can check the output of Result.RowsAffected() to see if a row was deleted or not
 		// Required params - {shardID, domainID, workflowID, runID}
-		DeleteFromCurrentExecutions(filter *CurrentExecutionsFilter) (sql.Result, error)
-		LockCurrentExecutions(filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
+		DeleteFromCurrentExecutions(ctx context.Context, filter *CurrentExecutionsFilter) (sql.Result, error)
+		LockCurrentExecutions(ctx context.Context, filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
 
-		InsertIntoTransferTasks(rows []TransferTasksRow) (sql.Result, error)
+		InsertIntoTransferTasks(ctx context.Context, rows []TransferTasksRow) (sql.Result, error)
 		// SelectFromTransferTasks returns rows that match filter criteria from transfer_tasks table.
 		// Required filter params - {shardID, minTaskID, maxTaskID}
-		SelectFromTransferTasks(filter *TransferTasksFilter) ([]TransferTasksRow, error)
+		SelectFromTransferTasks(ctx context.Context, filter *TransferTasksFilter) ([]TransferTasksRow, error)
 		// DeleteFromTransferTasks deletes one or more rows from transfer_tasks table.
 		// Filter params - shardID is required. If TaskID is not nil, a single row is deleted.
 		// When MinTaskID and MaxTaskID are not-nil, a range of rows are deleted.
-		DeleteFromTransferTasks(filter *TransferTasksFilter) (sql.Result, error)
+		DeleteFromTransferTasks(ctx context.Context, filter *TransferTasksFilter) (sql.Result, error)
 
-		InsertIntoTimerTasks(rows []TimerTasksRow) (sql.Result, error)
+		InsertIntoTimerTasks(ctx context.Context, rows []TimerTasksRow) (sql.Result, error)
 		// SelectFromTimerTasks returns one or more rows from timer_tasks table
 		// Required filter Params - {shardID, taskID, minVisibilityTimestamp, maxVisibilityTimestamp, pageSize}
-		SelectFromTimerTasks(filter *TimerTasksFilter) ([]TimerTasksRow, error)
+		SelectFromTimerTasks(ctx context.Context, filter *TimerTasksFilter) ([]TimerTasksRow, error)
 		// DeleteFromTimerTasks deletes one or more rows from timer_tasks table
 		// Required filter Params:
 		//  - to delete one row - {shardID, visibilityTimestamp, taskID}
 		//  - to delete multiple rows - {shardID, minVisibilityTimestamp, maxVisibilityTimestamp}
-		DeleteFromTimerTasks(filter *TimerTasksFilter) (sql.Result, error)
+		DeleteFromTimerTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error)
 
-		InsertIntoBufferedEvents(rows []BufferedEventsRow) (sql.Result, error)
-		SelectFromBufferedEvents(filter *BufferedEventsFilter) ([]BufferedEventsRow, error)
-		DeleteFromBufferedEvents(filter *BufferedEventsFilter) (sql.Result, error)
+		InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error)
+		SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error)
+		DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error)
 
-		InsertIntoReplicationTasks(rows []ReplicationTasksRow) (sql.Result, error)
+		InsertIntoReplicationTasks(ctx context.Context, rows []ReplicationTasksRow) (sql.Result, error)
 		// SelectFromReplicationTasks returns one or more rows from replication_tasks table
 		// Required filter params - {shardID, minTaskID, maxTaskID, pageSize}
-		SelectFromReplicationTasks(filter *ReplicationTasksFilter) ([]ReplicationTasksRow, error)
+		SelectFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) ([]ReplicationTasksRow, error)
 		// DeleteFromReplicationTasks deletes a row from replication_tasks table
 		// Required filter params - {shardID, taskID}
-		DeleteFromReplicationTasks(filter *ReplicationTasksFilter) (sql.Result, error)
+		DeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error)
 
-		ReplaceIntoActivityInfoMaps(rows []ActivityInfoMapsRow) (sql.Result, error)
+		ReplaceIntoReplicationTasksDLQ(ctx context.Context, row *ReplicationTasksDLQRow) (sql.Result, error)
+		// SelectFromReplicationTasksDLQ returns one or more rows from replication_tasks_dlq table
+		// Required filter params - {sourceClusterName, shardID, minTaskID, maxTaskID, pageSize}
+		SelectFromReplicationTasksDLQ(ctx context.Context, filter *ReplicationTasksDLQFilter) ([]ReplicationTasksRow, error)
+		// DeleteFromReplicationTasksDLQ deletes one or more rows from replication_tasks_dlq table
+		// Required filter params:
+		//  - to delete one row - {sourceClusterName, shardID, taskID}
+		//  - to delete multiple rows - {sourceClusterName, shardID, minTaskID, maxTaskID}
+		DeleteFromReplicationTasksDLQ(ctx context.Context, filter *ReplicationTasksDLQFilter) (sql.Result, error)
+
+		ReplaceIntoActivityInfoMaps(ctx context.Context, rows []ActivityInfoMapsRow) (sql.Result, error)
 		// SelectFromActivityInfoMaps returns one or more rows from activity_info_maps
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromActivityInfoMaps(filter *ActivityInfoMapsFilter) ([]ActivityInfoMapsRow, error)
+		SelectFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) ([]ActivityInfoMapsRow, error)
 		// DeleteFromActivityInfoMaps deletes a row from activity_info_maps table
 		// Required filter params
 		// - single row delete - {shardID, domainID, workflowID, runID, scheduleID}
 		// - range delete - {shardID, domainID, workflowID, runID}
-		DeleteFromActivityInfoMaps(filter *ActivityInfoMapsFilter) (sql.Result, error)
+		DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error)
 
-		ReplaceIntoTimerInfoMaps(rows []TimerInfoMapsRow) (sql.Result, error)
+		ReplaceIntoTimerInfoMaps(ctx context.Context, rows []TimerInfoMapsRow) (sql.Result, error)
 		// SelectFromTimerInfoMaps returns one or more rows form timer_info_maps table
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromTimerInfoMaps(filter *TimerInfoMapsFilter) ([]TimerInfoMapsRow, error)
+		SelectFromTimerInfoMaps(ctx context.Context, filter *TimerInfoMapsFilter) ([]TimerInfoMapsRow, error)
 		// DeleteFromTimerInfoMaps deletes one or more rows from timer_info_maps
 		// Required filter params
 		// - single row - {shardID, domainID, workflowID, runID, timerID}
 		// - multiple rows - {shardID, domainID, workflowID, runID}
-		DeleteFromTimerInfoMaps(filter *TimerInfoMapsFilter) (sql.Result, error)
+		DeleteFromTimerInfoMaps(ctx context.Context, filter *TimerInfoMapsFilter) (sql.Result, error)
 
-		ReplaceIntoChildExecutionInfoMaps(rows []ChildExecutionInfoMapsRow) (sql.Result, error)
+		ReplaceIntoChildExecutionInfoMaps(ctx context.Context, rows []ChildExecutionInfoMapsRow) (sql.Result, error)
 		// SelectFromChildExecutionInfoMaps returns one or more rows form child_execution_info_maps table
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromChildExecutionInfoMaps(filter *ChildExecutionInfoMapsFilter) ([]ChildExecutionInfoMapsRow, error)
+		SelectFromChildExecutionInfoMaps(ctx context.Context, filter *ChildExecutionInfoMapsFilter) ([]ChildExecutionInfoMapsRow, error)
 		// DeleteFromChildExecutionInfoMaps deletes one or more rows from child_execution_info_maps
 		// Required filter params
 		// - single row - {shardID, domainID, workflowID, runID, initiatedID}
 		// - multiple rows - {shardID, domainID, workflowID, runID}
-		DeleteFromChildExecutionInfoMaps(filter *ChildExecutionInfoMapsFilter) (sql.Result, error)
+		DeleteFromChildExecutionInfoMaps(ctx context.Context, filter *ChildExecutionInfoMapsFilter) (sql.Result, error)
 
-		ReplaceIntoRequestCancelInfoMaps(rows []RequestCancelInfoMapsRow) (sql.Result, error)
+		ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error)
 		// SelectFromRequestCancelInfoMaps returns one or more rows form request_cancel_info_maps table
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromRequestCancelInfoMaps(filter *RequestCancelInfoMapsFilter) ([]RequestCancelInfoMapsRow, error)
+		SelectFromRequestCancelInfoMaps(ctx context.Context, filter *RequestCancelInfoMapsFilter) ([]RequestCancelInfoMapsRow, error)
 		// DeleteFromRequestCancelInfoMaps deletes one or more rows from request_cancel_info_maps
 		// Required filter params
 		// - single row - {shardID, domainID, workflowID, runID, initiatedID}
 		// - multiple rows - {shardID, domainID, workflowID, runID}
-		DeleteFromRequestCancelInfoMaps(filter *RequestCancelInfoMapsFilter) (sql.Result, error)
+		DeleteFromRequestCancelInfoMaps(ctx context.Context, filter *RequestCancelInfoMapsFilter) (sql.Result, error)
 
-		ReplaceIntoSignalInfoMaps(rows []SignalInfoMapsRow) (sql.Result, error)
+		ReplaceIntoSignalInfoMaps(ctx context.Context, rows []SignalInfoMapsRow) (sql.Result, error)
 		// SelectFromSignalInfoMaps returns one or more rows form signal_info_maps table
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromSignalInfoMaps(filter *SignalInfoMapsFilter) ([]SignalInfoMapsRow, error)
+		SelectFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) ([]SignalInfoMapsRow, error)
 		// DeleteFromSignalInfoMaps deletes one or more rows from signal_info_maps table
 		// Required filter params
 		// - single row - {shardID, domainID, workflowID, runID, initiatedID}
 		// - multiple rows - {shardID, domainID, workflowID, runID}
-		DeleteFromSignalInfoMaps(filter *SignalInfoMapsFilter) (sql.Result, error)
+		DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error)
 
-		ReplaceIntoBufferedReplicationTasks(rows *BufferedReplicationTaskMapsRow) (sql.Result, error)
+		ReplaceIntoBufferedReplicationTasks(ctx context.Context, rows *BufferedReplicationTaskMapsRow) (sql.Result, error)
 		// SelectFromSignalInfoMaps returns one or more rows form buffered_replication_tasks table
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromBufferedReplicationTasks(filter *BufferedReplicationTaskMapsFilter) ([]BufferedReplicationTaskMapsRow, error)
+		SelectFromBufferedReplicationTasks(ctx context.Context, filter *BufferedReplicationTaskMapsFilter) ([]BufferedReplicationTaskMapsRow, error)
 		// DeleteFromBufferedReplicationTasks deletes one or more rows from buffered_replication_tasks
 		// Required filter params
 		// - single row - {shardID, domainID, workflowID, runID, firstEventID}
 		// - multiple rows - {shardID, domainID, workflowID, runID}
-		DeleteFromBufferedReplicationTasks(filter *BufferedReplicationTaskMapsFilter) (sql.Result, error)
+		DeleteFromBufferedReplicationTasks(ctx context.Context, filter *BufferedReplicationTaskMapsFilter) (sql.Result, error)
 
-		InsertIntoSignalsRequestedSets(rows []SignalsRequestedSetsRow) (sql.Result, error)
+		InsertIntoSignalsRequestedSets(ctx context.Context, rows []SignalsRequestedSetsRow) (sql.Result, error)
 		// SelectFromSignalInfoMaps returns one or more rows form singals_requested_sets table
 		// Required filter params - {shardID, domainID, workflowID, runID}
-		SelectFromSignalsRequestedSets(filter *SignalsRequestedSetsFilter) ([]SignalsRequestedSetsRow, error)
+		SelectFromSignalsRequestedSets(ctx context.Context, filter *SignalsRequestedSetsFilter) ([]SignalsRequestedSetsRow, error)
 		// DeleteFromSignalsRequestedSets deletes one or more rows from signals_requested_sets
 		// Required filter params
 		// - single row - {shardID, domainID, workflowID, runID, signalID}
 		// - multiple rows - {shardID, domainID, workflowID, runID}
-		DeleteFromSignalsRequestedSets(filter *SignalsRequestedSetsFilter) (sql.Result, error)
+		DeleteFromSignalsRequestedSets(ctx context.Context, filter *SignalsRequestedSetsFilter) (sql.Result, error)
 
 		// InsertIntoVisibility inserts a row into visibility table. If a row already exist,
 		// no changes will be made by this API
-		InsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
-		ReplaceIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {domainID, runID, closed=true}
@@ -678,8 +727,17 @@ type (
 		//     - domainID, minStartTime, maxStartTime, runID and pageSize where some or all of these may come from previous page token
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
-		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
-		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
+		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+
+		// ReplaceIntoClusterMembership writes a host record, replacing the previous record of the host if it exist
+		ReplaceIntoClusterMembership(ctx context.Context, row *ClusterMembershipRow) (sql.Result, error)
+		// SelectFromClusterMembership returns the unexpired host records that match the filter
+		// Optional filter params - {role, lastHeartbeatWithin}
+		SelectFromClusterMembership(ctx context.Context, filter *ClusterMembershipFilter) ([]ClusterMembershipRow, error)
+		// DeleteFromClusterMembership deletes the record of a host, or expired host records
+		// Required filter params - {hostID} or {limit}
+		DeleteFromClusterMembership(ctx context.Context, filter *ClusterMembershipFilter) (sql.Result, error)
 	}
 
 	// Tx defines the API for a SQL transaction
@@ -687,21 +745,23 @@ type (
 		tableCRUD
 		Commit() error
 		Rollback() error
+		IsDupEntryError(err error) bool
 	}
 
 	// Interface defines the API for a SQL database
 	Interface interface {
 		tableCRUD
-		BeginTx() (Tx, error)
+		BeginTx(ctx context.Context) (Tx, error)
 		DriverName() string
+		IsDupEntryError(err error) bool
 		Close() error
 	}
 
 	// Conn defines the API for a single database connection
 	Conn interface {
-		Exec(query string, args ...interface{}) (sql.Result, error)
-		NamedExec(query string, arg interface{}) (sql.Result, error)
-		Get(dest interface{}, query string, args ...interface{}) error
-		Select(dest interface{}, query string, args ...interface{}) error
+		ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+		NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+		GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+		SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 	}
 )
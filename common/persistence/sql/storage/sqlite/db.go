@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// DriverName refers to the name of the sqlite driver
+const DriverName = "sqlite3"
+
+// DB represents a logical connection to sqlite database
+type DB struct {
+	db        *sqlx.DB
+	tx        *sqlx.Tx
+	conn      sqldb.Conn
+	converter DataConverter
+}
+
+var _ sqldb.Tx = (*DB)(nil)
+var _ sqldb.Interface = (*DB)(nil)
+
+// NewDB returns an instance of DB, which is a logical
+// connection to the underlying sqlite database
+func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
+	sdb := &DB{db: xdb, tx: tx}
+	sdb.conn = xdb
+	if tx != nil {
+		sdb.conn = tx
+	}
+	sdb.converter = &converter{}
+	return sdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (sdb *DB) BeginTx(ctx context.Context) (sqldb.Tx, error) {
+	xtx, err := sdb.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewDB(sdb.db, xtx), nil
+}
+
+// Commit commits a previously started transaction
+func (sdb *DB) Commit() error {
+	return sdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (sdb *DB) Rollback() error {
+	return sdb.tx.Rollback()
+}
+
+// Close closes the connection to the sqlite db
+func (sdb *DB) Close() error {
+	return sdb.db.Close()
+}
+
+// DriverName returns the name of the sqlite driver
+func (sdb *DB) DriverName() string {
+	return sdb.db.DriverName()
+}
+
+// IsDupEntryError verifies if the error is a duplicate entry error
+func (sdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	if !ok || sqlErr.Code != sqlite3.ErrConstraint {
+		return false
+	}
+	return sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey || sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
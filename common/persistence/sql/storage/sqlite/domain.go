@@ -0,0 +1,139 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	shardID = 54321
+
+	createDomainQry = `INSERT INTO 
+ domains (id, name, is_global, data, data_encoding)
+ VALUES(?, ?, ?, ?, ?)`
+
+	updateDomainQry = `UPDATE domains 
+ SET name = ?, data = ?, data_encoding = ?
+ WHERE shard_id=54321 AND id = ?`
+
+	getDomainPart = `SELECT id, name, is_global, data, data_encoding FROM domains`
+
+	getDomainByIDQry   = getDomainPart + ` WHERE shard_id=? AND id = ?`
+	getDomainByNameQry = getDomainPart + ` WHERE shard_id=? AND name = ?`
+
+	listDomainsQry      = getDomainPart + ` WHERE shard_id=? ORDER BY id LIMIT ?`
+	listDomainsRangeQry = getDomainPart + ` WHERE shard_id=? AND id > ? ORDER BY id LIMIT ?`
+
+	deleteDomainByIDQry   = `DELETE FROM domains WHERE shard_id=? AND id = ?`
+	deleteDomainByNameQry = `DELETE FROM domains WHERE shard_id=? AND name = ?`
+
+	getDomainMetadataQry    = `SELECT notification_version FROM domain_metadata`
+	lockDomainMetadataQry   = `SELECT notification_version FROM domain_metadata`
+	updateDomainMetadataQry = `UPDATE domain_metadata SET notification_version = ? WHERE notification_version = ?`
+)
+
+var errMissingArgs = errors.New("missing one or more args for API")
+
+// InsertIntoDomain inserts a single row into domains table
+func (sdb *DB) InsertIntoDomain(ctx context.Context, row *sqldb.DomainRow) (sql.Result, error) {
+	return sdb.conn.ExecContext(ctx, createDomainQry, row.ID, row.Name, row.IsGlobal, row.Data, row.DataEncoding)
+}
+
+// UpdateDomain updates a single row in domains table
+func (sdb *DB) UpdateDomain(ctx context.Context, row *sqldb.DomainRow) (sql.Result, error) {
+	return sdb.conn.ExecContext(ctx, updateDomainQry, row.Name, row.Data, row.DataEncoding, row.ID)
+}
+
+// SelectFromDomain reads one or more rows from domains table
+func (sdb *DB) SelectFromDomain(ctx context.Context, filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+	switch {
+	case filter.ID != nil || filter.Name != nil:
+		return sdb.selectFromDomain(ctx, filter)
+	case filter.PageSize != nil && *filter.PageSize > 0:
+		return sdb.selectAllFromDomain(ctx, filter)
+	default:
+		return nil, errMissingArgs
+	}
+}
+
+func (sdb *DB) selectFromDomain(ctx context.Context, filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+	var err error
+	var row sqldb.DomainRow
+	switch {
+	case filter.ID != nil:
+		err = sdb.conn.GetContext(ctx, &row, getDomainByIDQry, shardID, *filter.ID)
+	case filter.Name != nil:
+		err = sdb.conn.GetContext(ctx, &row, getDomainByNameQry, shardID, *filter.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []sqldb.DomainRow{row}, err
+}
+
+func (sdb *DB) selectAllFromDomain(ctx context.Context, filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+	var err error
+	var rows []sqldb.DomainRow
+	switch {
+	case filter.GreaterThanID != nil:
+		err = sdb.conn.SelectContext(ctx, &rows, listDomainsRangeQry, shardID, *filter.GreaterThanID, *filter.PageSize)
+	default:
+		err = sdb.conn.SelectContext(ctx, &rows, listDomainsQry, shardID, filter.PageSize)
+	}
+	return rows, err
+}
+
+// DeleteFromDomain deletes a single row in domains table
+func (sdb *DB) DeleteFromDomain(ctx context.Context, filter *sqldb.DomainFilter) (sql.Result, error) {
+	var err error
+	var result sql.Result
+	switch {
+	case filter.ID != nil:
+		result, err = sdb.conn.ExecContext(ctx, deleteDomainByIDQry, shardID, filter.ID)
+	default:
+		result, err = sdb.conn.ExecContext(ctx, deleteDomainByNameQry, shardID, filter.Name)
+	}
+	return result, err
+}
+
+// LockDomainMetadata acquires a write lock on a single row in domain_metadata table
+func (sdb *DB) LockDomainMetadata(ctx context.Context) error {
+	var row sqldb.DomainMetadataRow
+	err := sdb.conn.GetContext(ctx, &row.NotificationVersion, lockDomainMetadataQry)
+	return err
+}
+
+// SelectFromDomainMetadata reads a single row in domain_metadata table
+func (sdb *DB) SelectFromDomainMetadata(ctx context.Context) (*sqldb.DomainMetadataRow, error) {
+	var row sqldb.DomainMetadataRow
+	err := sdb.conn.GetContext(ctx, &row.NotificationVersion, getDomainMetadataQry)
+	return &row, err
+}
+
+// UpdateDomainMetadata updates a single row in domain_metadata table
+func (sdb *DB) UpdateDomainMetadata(ctx context.Context, row *sqldb.DomainMetadataRow) (sql.Result, error) {
+	return sdb.conn.ExecContext(ctx, updateDomainMetadataQry, row.NotificationVersion+1, row.NotificationVersion)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// the heartbeat and expiry of host records are taken from the database clock, sqlite compares
+// datetimes as text so every one of them is written and compared in the same UTC format
+const (
+	sqliteNow = `strftime('%Y-%m-%d %H:%M:%f', 'now')`
+
+	sqliteNowWithOffset = `strftime('%Y-%m-%d %H:%M:%f', 'now', ?)`
+
+	replaceIntoClusterMembershipQry = `REPLACE INTO cluster_membership (` +
+		`host_id, rpc_address, role, session_start, last_heartbeat, record_expiry) ` +
+		`VALUES (?, ?, ?, ?, ` + sqliteNow + `, ` + sqliteNowWithOffset + `)`
+
+	getClusterMembersQry = `SELECT host_id, rpc_address, role, session_start, last_heartbeat, record_expiry ` +
+		`FROM cluster_membership WHERE record_expiry > ` + sqliteNow
+
+	clusterMembersLastHeartbeatWithinClause = ` AND last_heartbeat > ` + sqliteNowWithOffset
+
+	clusterMembersRoleClause = ` AND role = ?`
+
+	deleteClusterMembershipQry = `DELETE FROM cluster_membership WHERE host_id = ?`
+
+	pruneClusterMembershipQry = `DELETE FROM cluster_membership WHERE host_id IN (` +
+		`SELECT host_id FROM cluster_membership WHERE record_expiry < ` + sqliteNow + ` LIMIT ?)`
+)
+
+// ReplaceIntoClusterMembership replaces a host record in cluster_membership table
+func (sdb *DB) ReplaceIntoClusterMembership(ctx context.Context, row *sqldb.ClusterMembershipRow) (sql.Result, error) {
+	return sdb.conn.ExecContext(ctx, replaceIntoClusterMembershipQry,
+		row.HostID,
+		row.RPCAddress,
+		row.Role,
+		sdb.converter.ToSQLiteDateTime(row.SessionStart),
+		toSQLiteTimeModifier(row.RecordTTL))
+}
+
+// SelectFromClusterMembership reads one or more rows from cluster_membership table
+func (sdb *DB) SelectFromClusterMembership(ctx context.Context, filter *sqldb.ClusterMembershipFilter) ([]sqldb.ClusterMembershipRow, error) {
+	query := getClusterMembersQry
+	var args []interface{}
+	if filter.LastHeartbeatWithin > 0 {
+		query += clusterMembersLastHeartbeatWithinClause
+		args = append(args, toSQLiteTimeModifier(-filter.LastHeartbeatWithin))
+	}
+	if filter.Role != "" {
+		query += clusterMembersRoleClause
+		args = append(args, filter.Role)
+	}
+	var rows []sqldb.ClusterMembershipRow
+	if err := sdb.conn.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].SessionStart = sdb.converter.FromSQLiteDateTime(rows[i].SessionStart)
+		rows[i].LastHeartbeat = sdb.converter.FromSQLiteDateTime(rows[i].LastHeartbeat)
+		rows[i].RecordExpiry = sdb.converter.FromSQLiteDateTime(rows[i].RecordExpiry)
+	}
+	return rows, nil
+}
+
+// DeleteFromClusterMembership deletes the row of a host or expired rows from cluster_membership table
+func (sdb *DB) DeleteFromClusterMembership(ctx context.Context, filter *sqldb.ClusterMembershipFilter) (sql.Result, error) {
+	if filter.HostID != "" {
+		return sdb.conn.ExecContext(ctx, deleteClusterMembershipQry, filter.HostID)
+	}
+	return sdb.conn.ExecContext(ctx, pruneClusterMembershipQry, filter.Limit)
+}
+
+// toSQLiteTimeModifier returns the sqlite date function modifier shifting a time by d
+func toSQLiteTimeModifier(d time.Duration) string {
+	return fmt.Sprintf("%+.3f seconds", d.Seconds())
+}
@@ -24,12 +24,14 @@ import (
 	"bytes"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/mysql"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -38,6 +40,12 @@ const (
 	isolationLevelAttrName       = "transaction_isolation"
 	isolationLevelAttrNameLegacy = "tx_isolation"
 	defaultIsolationLevel        = "'READ-COMMITTED'"
+
+	sqliteDSNFmt      = "file:%s"
+	sqliteMemoryProto = "memory"
+	sqliteBusyTimeout = "10000"
+	sqliteJournalMode = "WAL"
+	sqliteTxLockMode  = "immediate"
 )
 
 var dsnAttrOverrides = map[string]string{
@@ -51,7 +59,25 @@ var dsnAttrOverrides = map[string]string{
 // SQL database and the object can be used to perform CRUD operations on
 // the tables in the database
 func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
-	db, err := sqlx.Connect(cfg.DriverName, buildDSN(cfg))
+	db, err := NewSQLXDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DriverName == sqlite.DriverName {
+		return sqlite.NewDB(db, nil), nil
+	}
+	return mysql.NewDB(db, nil), nil
+}
+
+// NewSQLXDB connects to the SQL database of the config and returns the raw
+// connection pool, for tools which need to run queries sqldb.Interface
+// doesn't offer
+func NewSQLXDB(cfg *config.SQL) (*sqlx.DB, error) {
+	dsn := buildDSN(cfg)
+	if cfg.DriverName == sqlite.DriverName {
+		dsn = buildSQLiteDSN(cfg)
+	}
+	db, err := sqlx.Connect(cfg.DriverName, dsn)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +92,32 @@ func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
 	}
 	// Maps struct names in CamelCase to snake without need for db struct tags.
 	db.MapperFunc(strcase.ToSnake)
-	return mysql.NewDB(db, nil), nil
+	return db, nil
+}
+
+// buildSQLiteDSN returns the data source name for a sqlite database. The
+// database file is databaseName under the connectAddr directory, unless the
+// connectProtocol is memory, in which case the database lives in a shared
+// in-memory cache for as long as at least one connection to it is open
+func buildSQLiteDSN(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		attrs.Set(k, v)
+	}
+	// writers wait on each other instead of failing with SQLITE_BUSY, and every
+	// transaction takes the write lock upfront to avoid lock upgrade deadlocks
+	attrs.Set("_busy_timeout", sqliteBusyTimeout)
+	attrs.Set("_txlock", sqliteTxLockMode)
+
+	path := filepath.Join(cfg.ConnectAddr, cfg.DatabaseName)
+	if cfg.ConnectProtocol == sqliteMemoryProto {
+		path = cfg.DatabaseName
+		attrs.Set("mode", "memory")
+		attrs.Set("cache", "shared")
+	} else {
+		attrs.Set("_journal_mode", sqliteJournalMode)
+	}
+	return fmt.Sprintf(sqliteDSNFmt, path) + "?" + attrs.Encode()
 }
 
 func buildDSN(cfg *config.SQL) string {
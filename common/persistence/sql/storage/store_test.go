@@ -122,6 +122,54 @@ func (s *StoreTestSuite) TestBuildDSN() {
 	}
 }
 
+func (s *StoreTestSuite) TestBuildSQLiteDSN() {
+	testCases := []struct {
+		in         config.SQL
+		outURLPath string
+		outAttrs   url.Values
+	}{
+		{
+			in: config.SQL{
+				ConnectProtocol: "file",
+				ConnectAddr:     "/tmp/cadence",
+				DatabaseName:    "cadence.db",
+			},
+			outURLPath: "file:/tmp/cadence/cadence.db?",
+			outAttrs: url.Values{
+				"_busy_timeout": []string{"10000"},
+				"_txlock":       []string{"immediate"},
+				"_journal_mode": []string{"WAL"},
+			},
+		},
+		{
+			in: config.SQL{
+				ConnectProtocol:   "memory",
+				ConnectAddr:       "localhost",
+				DatabaseName:      "cadence",
+				ConnectAttributes: map[string]string{"_sync": "OFF"},
+			},
+			outURLPath: "file:cadence?",
+			outAttrs: url.Values{
+				"_busy_timeout": []string{"10000"},
+				"_txlock":       []string{"immediate"},
+				"_sync":         []string{"OFF"},
+				"mode":          []string{"memory"},
+				"cache":         []string{"shared"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		out := buildSQLiteDSN(&tc.in)
+		s.True(strings.HasPrefix(out, tc.outURLPath), "invalid url path")
+		tokens := strings.Split(out, "?")
+		s.Equal(2, len(tokens), "invalid url")
+		qry, err := url.ParseQuery(tokens[1])
+		s.NoError(err)
+		s.Equal(tc.outAttrs, qry, "invalid dsn url params")
+	}
+}
+
 func buildExpectedURLParams(attrs map[string]string, isolationKey string, isolationValue string) url.Values {
 	result := make(map[string][]string, len(dsnAttrOverrides)+len(attrs)+1)
 	for k, v := range attrs {
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
@@ -32,7 +33,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
-func updateActivityInfos(tx sqldb.Tx,
+func updateActivityInfos(ctx context.Context, tx sqldb.Tx,
 	activityInfos []*persistence.InternalActivityInfo,
 	deleteInfos []int64,
 	shardID int,
@@ -94,7 +95,7 @@ func updateActivityInfos(tx sqldb.Tx,
 			}
 		}
 
-		if _, err := tx.ReplaceIntoActivityInfoMaps(rows); err != nil {
+		if _, err := tx.ReplaceIntoActivityInfoMaps(ctx, rows); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update activity info. Failed to execute update query. Error: %v", err),
 			}
@@ -103,7 +104,7 @@ func updateActivityInfos(tx sqldb.Tx,
 
 	if len(deleteInfos) > 0 {
 		for _, v := range deleteInfos {
-			result, err := tx.DeleteFromActivityInfoMaps(&sqldb.ActivityInfoMapsFilter{
+			result, err := tx.DeleteFromActivityInfoMaps(ctx, &sqldb.ActivityInfoMapsFilter{
 				ShardID:    int64(shardID),
 				DomainID:   domainID,
 				WorkflowID: workflowID,
@@ -132,12 +133,12 @@ func updateActivityInfos(tx sqldb.Tx,
 	return nil
 }
 
-func getActivityInfoMap(db sqldb.Interface,
+func getActivityInfoMap(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[int64]*persistence.InternalActivityInfo, error) {
-	rows, err := db.SelectFromActivityInfoMaps(&sqldb.ActivityInfoMapsFilter{
+	rows, err := db.SelectFromActivityInfoMaps(ctx, &sqldb.ActivityInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -195,8 +196,8 @@ func getActivityInfoMap(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteActivityInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromActivityInfoMaps(&sqldb.ActivityInfoMapsFilter{
+func deleteActivityInfoMap(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromActivityInfoMaps(ctx, &sqldb.ActivityInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -209,7 +210,7 @@ func deleteActivityInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workfl
 	return nil
 }
 
-func updateTimerInfos(tx sqldb.Tx,
+func updateTimerInfos(ctx context.Context, tx sqldb.Tx,
 	timerInfos []*persistence.TimerInfo,
 	deleteInfos []string,
 	shardID int,
@@ -238,7 +239,7 @@ func updateTimerInfos(tx sqldb.Tx,
 				DataEncoding: string(blob.Encoding),
 			}
 		}
-		if _, err := tx.ReplaceIntoTimerInfoMaps(rows); err != nil {
+		if _, err := tx.ReplaceIntoTimerInfoMaps(ctx, rows); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update timer info. Failed to execute update query. Error: %v", err),
 			}
@@ -246,7 +247,7 @@ func updateTimerInfos(tx sqldb.Tx,
 	}
 	if len(deleteInfos) > 0 {
 		for _, v := range deleteInfos {
-			result, err := tx.DeleteFromTimerInfoMaps(&sqldb.TimerInfoMapsFilter{
+			result, err := tx.DeleteFromTimerInfoMaps(ctx, &sqldb.TimerInfoMapsFilter{
 				ShardID:    int64(shardID),
 				DomainID:   domainID,
 				WorkflowID: workflowID,
@@ -274,12 +275,12 @@ func updateTimerInfos(tx sqldb.Tx,
 	return nil
 }
 
-func getTimerInfoMap(db sqldb.Interface,
+func getTimerInfoMap(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[string]*persistence.TimerInfo, error) {
-	rows, err := db.SelectFromTimerInfoMaps(&sqldb.TimerInfoMapsFilter{
+	rows, err := db.SelectFromTimerInfoMaps(ctx, &sqldb.TimerInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -308,8 +309,8 @@ func getTimerInfoMap(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteTimerInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromTimerInfoMaps(&sqldb.TimerInfoMapsFilter{
+func deleteTimerInfoMap(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromTimerInfoMaps(ctx, &sqldb.TimerInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -322,7 +323,7 @@ func deleteTimerInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowI
 	return nil
 }
 
-func updateChildExecutionInfos(tx sqldb.Tx,
+func updateChildExecutionInfos(ctx context.Context, tx sqldb.Tx,
 	childExecutionInfos []*persistence.InternalChildExecutionInfo,
 	deleteInfos *int64,
 	shardID int,
@@ -363,14 +364,14 @@ func updateChildExecutionInfos(tx sqldb.Tx,
 				DataEncoding: string(blob.Encoding),
 			}
 		}
-		if _, err := tx.ReplaceIntoChildExecutionInfoMaps(rows); err != nil {
+		if _, err := tx.ReplaceIntoChildExecutionInfoMaps(ctx, rows); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update child execution info. Failed to execute update query. Error: %v", err),
 			}
 		}
 	}
 	if deleteInfos != nil {
-		if _, err := tx.DeleteFromChildExecutionInfoMaps(&sqldb.ChildExecutionInfoMapsFilter{
+		if _, err := tx.DeleteFromChildExecutionInfoMaps(ctx, &sqldb.ChildExecutionInfoMapsFilter{
 			ShardID:     int64(shardID),
 			DomainID:    domainID,
 			WorkflowID:  workflowID,
@@ -386,12 +387,12 @@ func updateChildExecutionInfos(tx sqldb.Tx,
 	return nil
 }
 
-func getChildExecutionInfoMap(db sqldb.Interface,
+func getChildExecutionInfoMap(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[int64]*persistence.InternalChildExecutionInfo, error) {
-	rows, err := db.SelectFromChildExecutionInfoMaps(&sqldb.ChildExecutionInfoMapsFilter{
+	rows, err := db.SelectFromChildExecutionInfoMaps(ctx, &sqldb.ChildExecutionInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -432,8 +433,8 @@ func getChildExecutionInfoMap(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteChildExecutionInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromChildExecutionInfoMaps(&sqldb.ChildExecutionInfoMapsFilter{
+func deleteChildExecutionInfoMap(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromChildExecutionInfoMaps(ctx, &sqldb.ChildExecutionInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -446,7 +447,7 @@ func deleteChildExecutionInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID,
 	return nil
 }
 
-func updateRequestCancelInfos(tx sqldb.Tx,
+func updateRequestCancelInfos(ctx context.Context, tx sqldb.Tx,
 	requestCancelInfos []*persistence.RequestCancelInfo,
 	deleteInfo *int64,
 	shardID int,
@@ -474,7 +475,7 @@ func updateRequestCancelInfos(tx sqldb.Tx,
 			}
 		}
 
-		if _, err := tx.ReplaceIntoRequestCancelInfoMaps(rows); err != nil {
+		if _, err := tx.ReplaceIntoRequestCancelInfoMaps(ctx, rows); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update request cancel info. Failed to execute update query. Error: %v", err),
 			}
@@ -483,7 +484,7 @@ func updateRequestCancelInfos(tx sqldb.Tx,
 	if deleteInfo == nil {
 		return nil
 	}
-	result, err := tx.DeleteFromRequestCancelInfoMaps(&sqldb.RequestCancelInfoMapsFilter{
+	result, err := tx.DeleteFromRequestCancelInfoMaps(ctx, &sqldb.RequestCancelInfoMapsFilter{
 		ShardID:     int64(shardID),
 		DomainID:    domainID,
 		WorkflowID:  workflowID,
@@ -509,12 +510,12 @@ func updateRequestCancelInfos(tx sqldb.Tx,
 	return nil
 }
 
-func getRequestCancelInfoMap(db sqldb.Interface,
+func getRequestCancelInfoMap(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[int64]*persistence.RequestCancelInfo, error) {
-	rows, err := db.SelectFromRequestCancelInfoMaps(&sqldb.RequestCancelInfoMapsFilter{
+	rows, err := db.SelectFromRequestCancelInfoMaps(ctx, &sqldb.RequestCancelInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -542,8 +543,8 @@ func getRequestCancelInfoMap(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteRequestCancelInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromRequestCancelInfoMaps(&sqldb.RequestCancelInfoMapsFilter{
+func deleteRequestCancelInfoMap(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromRequestCancelInfoMaps(ctx, &sqldb.RequestCancelInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -556,7 +557,7 @@ func deleteRequestCancelInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, w
 	return nil
 }
 
-func updateSignalInfos(tx sqldb.Tx,
+func updateSignalInfos(ctx context.Context, tx sqldb.Tx,
 	signalInfos []*persistence.SignalInfo,
 	deleteInfo *int64,
 	shardID int,
@@ -587,7 +588,7 @@ func updateSignalInfos(tx sqldb.Tx,
 			}
 		}
 
-		if _, err := tx.ReplaceIntoSignalInfoMaps(rows); err != nil {
+		if _, err := tx.ReplaceIntoSignalInfoMaps(ctx, rows); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update signal info. Failed to execute update query. Error: %v", err),
 			}
@@ -596,7 +597,7 @@ func updateSignalInfos(tx sqldb.Tx,
 	if deleteInfo == nil {
 		return nil
 	}
-	result, err := tx.DeleteFromSignalInfoMaps(&sqldb.SignalInfoMapsFilter{
+	result, err := tx.DeleteFromSignalInfoMaps(ctx, &sqldb.SignalInfoMapsFilter{
 		ShardID:     int64(shardID),
 		DomainID:    domainID,
 		WorkflowID:  workflowID,
@@ -622,12 +623,12 @@ func updateSignalInfos(tx sqldb.Tx,
 	return nil
 }
 
-func getSignalInfoMap(db sqldb.Interface,
+func getSignalInfoMap(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[int64]*persistence.SignalInfo, error) {
-	rows, err := db.SelectFromSignalInfoMaps(&sqldb.SignalInfoMapsFilter{
+	rows, err := db.SelectFromSignalInfoMaps(ctx, &sqldb.SignalInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -658,8 +659,8 @@ func getSignalInfoMap(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteSignalInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromSignalInfoMaps(&sqldb.SignalInfoMapsFilter{
+func deleteSignalInfoMap(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromSignalInfoMaps(ctx, &sqldb.SignalInfoMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -672,7 +673,7 @@ func deleteSignalInfoMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflow
 	return nil
 }
 
-func updateBufferedReplicationTasks(tx sqldb.Tx,
+func updateBufferedReplicationTasks(ctx context.Context, tx sqldb.Tx,
 	newBufferedReplicationTask *persistence.InternalBufferedReplicationTask,
 	deleteInfo *int64,
 	shardID int,
@@ -701,7 +702,7 @@ func updateBufferedReplicationTasks(tx sqldb.Tx,
 			row.NewRunHistory = &newRunHistoryData
 			row.NewRunHistoryEncoding = newRunHistoryEncoding
 		}
-		if _, err := tx.ReplaceIntoBufferedReplicationTasks(row); err != nil {
+		if _, err := tx.ReplaceIntoBufferedReplicationTasks(ctx, row); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update buffered replication tasks. Failed to execute update query. Error: %v", err),
 			}
@@ -710,7 +711,7 @@ func updateBufferedReplicationTasks(tx sqldb.Tx,
 	if deleteInfo == nil {
 		return nil
 	}
-	result, err := tx.DeleteFromBufferedReplicationTasks(&sqldb.BufferedReplicationTaskMapsFilter{
+	result, err := tx.DeleteFromBufferedReplicationTasks(ctx, &sqldb.BufferedReplicationTaskMapsFilter{
 		ShardID:      int64(shardID),
 		DomainID:     domainID,
 		WorkflowID:   workflowID,
@@ -736,12 +737,12 @@ func updateBufferedReplicationTasks(tx sqldb.Tx,
 	return nil
 }
 
-func getBufferedReplicationTasks(db sqldb.Interface,
+func getBufferedReplicationTasks(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[int64]*persistence.InternalBufferedReplicationTask, error) {
-	rows, err := db.SelectFromBufferedReplicationTasks(&sqldb.BufferedReplicationTaskMapsFilter{
+	rows, err := db.SelectFromBufferedReplicationTasks(ctx, &sqldb.BufferedReplicationTaskMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -775,8 +776,8 @@ func getBufferedReplicationTasks(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteBufferedReplicationTasksMap(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromBufferedReplicationTasks(&sqldb.BufferedReplicationTaskMapsFilter{
+func deleteBufferedReplicationTasksMap(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromBufferedReplicationTasks(ctx, &sqldb.BufferedReplicationTaskMapsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -28,7 +29,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
-func updateSignalsRequested(tx sqldb.Tx,
+func updateSignalsRequested(ctx context.Context, tx sqldb.Tx,
 	signalRequestedIDs []string,
 	deleteSignalRequestID string,
 	shardID int,
@@ -44,7 +45,7 @@ func updateSignalsRequested(tx sqldb.Tx,
 				SignalID:   v,
 			}
 		}
-		if _, err := tx.InsertIntoSignalsRequestedSets(rows); err != nil {
+		if _, err := tx.InsertIntoSignalsRequestedSets(ctx, rows); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update signals requested. Failed to execute update query. Error: %v", err),
 			}
@@ -52,7 +53,7 @@ func updateSignalsRequested(tx sqldb.Tx,
 	}
 
 	if deleteSignalRequestID != "" {
-		if _, err := tx.DeleteFromSignalsRequestedSets(&sqldb.SignalsRequestedSetsFilter{
+		if _, err := tx.DeleteFromSignalsRequestedSets(ctx, &sqldb.SignalsRequestedSetsFilter{
 			ShardID:    int64(shardID),
 			DomainID:   domainID,
 			WorkflowID: workflowID,
@@ -68,12 +69,12 @@ func updateSignalsRequested(tx sqldb.Tx,
 	return nil
 }
 
-func getSignalsRequested(db sqldb.Interface,
+func getSignalsRequested(ctx context.Context, db sqldb.Interface,
 	shardID int,
 	domainID sqldb.UUID,
 	workflowID string,
 	runID sqldb.UUID) (map[string]struct{}, error) {
-	rows, err := db.SelectFromSignalsRequestedSets(&sqldb.SignalsRequestedSetsFilter{
+	rows, err := db.SelectFromSignalsRequestedSets(ctx, &sqldb.SignalsRequestedSetsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
@@ -91,8 +92,8 @@ func getSignalsRequested(db sqldb.Interface,
 	return ret, nil
 }
 
-func deleteSignalsRequestedSet(tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
-	if _, err := tx.DeleteFromSignalsRequestedSets(&sqldb.SignalsRequestedSetsFilter{
+func deleteSignalsRequestedSet(ctx context.Context, tx sqldb.Tx, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
+	if _, err := tx.DeleteFromSignalsRequestedSets(ctx, &sqldb.SignalsRequestedSetsFilter{
 		ShardID:    int64(shardID),
 		DomainID:   domainID,
 		WorkflowID: workflowID,
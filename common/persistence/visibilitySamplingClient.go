@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"sync"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -95,13 +96,13 @@ func (m *domainToBucketMap) getRateLimiter(domain string, numOfPriority, qps int
 	return rateLimiter
 }
 
-func (p *visibilitySamplingClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+func (p *visibilitySamplingClient) RecordWorkflowExecutionStarted(ctx context.Context, request *RecordWorkflowExecutionStartedRequest) error {
 	domain := request.Domain
 	domainID := request.DomainUUID
 
 	rateLimiter := p.rateLimitersForOpen.getRateLimiter(domain, numOfPriorityForOpen, p.config.VisibilityOpenMaxQPS(domain))
 	if ok, _ := rateLimiter.GetToken(0, 1); ok {
-		return p.persistence.RecordWorkflowExecutionStarted(request)
+		return p.persistence.RecordWorkflowExecutionStarted(ctx, request)
 	}
 
 	p.logger.Info("Request for open workflow is sampled",
@@ -115,14 +116,14 @@ func (p *visibilitySamplingClient) RecordWorkflowExecutionStarted(request *Recor
 	return nil
 }
 
-func (p *visibilitySamplingClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+func (p *visibilitySamplingClient) RecordWorkflowExecutionClosed(ctx context.Context, request *RecordWorkflowExecutionClosedRequest) error {
 	domain := request.Domain
 	domainID := request.DomainUUID
 	priority := getRequestPriority(request)
 
 	rateLimiter := p.rateLimitersForClosed.getRateLimiter(domain, numOfPriorityForClosed, p.config.VisibilityClosedMaxQPS(domain))
 	if ok, _ := rateLimiter.GetToken(priority, 1); ok {
-		return p.persistence.RecordWorkflowExecutionClosed(request)
+		return p.persistence.RecordWorkflowExecutionClosed(ctx, request)
 	}
 
 	p.logger.Info("Request for closed workflow is sampled",
@@ -136,7 +137,7 @@ func (p *visibilitySamplingClient) RecordWorkflowExecutionClosed(request *Record
 	return nil
 }
 
-func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -144,10 +145,10 @@ func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(request *ListWorkf
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListOpenWorkflowExecutions(request)
+	return p.persistence.ListOpenWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -155,10 +156,10 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutions(request *ListWor
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutions(request)
+	return p.persistence.ListClosedWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -166,10 +167,10 @@ func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByType(request *Lis
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListOpenWorkflowExecutionsByType(request)
+	return p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -177,10 +178,10 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByType(request *L
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutionsByType(request)
+	return p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -188,10 +189,10 @@ func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByWorkflowID(reques
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -199,10 +200,10 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByWorkflowID(requ
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	return p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
 	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
@@ -210,27 +211,27 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(request
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	return p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
 }
 
-func (p *visibilitySamplingClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
-	return p.persistence.GetClosedWorkflowExecution(request)
+func (p *visibilitySamplingClient) GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	return p.persistence.GetClosedWorkflowExecution(ctx, request)
 }
 
-func (p *visibilitySamplingClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
-	return p.persistence.DeleteWorkflowExecution(request)
+func (p *visibilitySamplingClient) DeleteWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error {
+	return p.persistence.DeleteWorkflowExecution(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
-	return p.persistence.ListWorkflowExecutions(request)
+func (p *visibilitySamplingClient) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	return p.persistence.ListWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
-	return p.persistence.ScanWorkflowExecutions(request)
+func (p *visibilitySamplingClient) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	return p.persistence.ScanWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
-	return p.persistence.CountWorkflowExecutions(request)
+func (p *visibilitySamplingClient) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return p.persistence.CountWorkflowExecutions(ctx, request)
 }
 
 func (p *visibilitySamplingClient) Close() {
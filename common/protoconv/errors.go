@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package protoconv converts between the thrift types of the WorkflowService
+// and their protobuf mirror served over gRPC. The conversion of the types is
+// generated by cmd/tools/thrift2proto, this file maps the service errors.
+package protoconv
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+// errorCodes maps each thrift error of the WorkflowService to the yarpc error code
+// it is carried with over gRPC, the name of the thrift type is set as the name of the
+// yarpc error and its message holds the json encoded thrift error, so that the client
+// can rebuild the error the handler returned
+var errorCodes = map[reflect.Type]yarpcerrors.Code{
+	reflect.TypeOf(shared.BadRequestError{}):                      yarpcerrors.CodeInvalidArgument,
+	reflect.TypeOf(shared.InternalServiceError{}):                 yarpcerrors.CodeInternal,
+	reflect.TypeOf(shared.DomainAlreadyExistsError{}):             yarpcerrors.CodeAlreadyExists,
+	reflect.TypeOf(shared.WorkflowExecutionAlreadyStartedError{}): yarpcerrors.CodeAlreadyExists,
+	reflect.TypeOf(shared.EntityNotExistsError{}):                 yarpcerrors.CodeNotFound,
+	reflect.TypeOf(shared.ServiceBusyError{}):                     yarpcerrors.CodeResourceExhausted,
+	reflect.TypeOf(shared.CancellationAlreadyRequestedError{}):    yarpcerrors.CodeAlreadyExists,
+	reflect.TypeOf(shared.QueryFailedError{}):                     yarpcerrors.CodeFailedPrecondition,
+	reflect.TypeOf(shared.DomainNotActiveError{}):                 yarpcerrors.CodeFailedPrecondition,
+	reflect.TypeOf(shared.LimitExceededError{}):                   yarpcerrors.CodeResourceExhausted,
+	reflect.TypeOf(shared.AccessDeniedError{}):                    yarpcerrors.CodePermissionDenied,
+	reflect.TypeOf(shared.RetryTaskError{}):                       yarpcerrors.CodeFailedPrecondition,
+	reflect.TypeOf(shared.ClientVersionNotSupportedError{}):       yarpcerrors.CodeFailedPrecondition,
+}
+
+// errorTypes indexes the thrift errors by the name carried on the yarpc error
+var errorTypes = func() map[string]reflect.Type {
+	types := make(map[string]reflect.Type, len(errorCodes))
+	for errType := range errorCodes {
+		types[errType.Name()] = errType
+	}
+	return types
+}()
+
+// ToProtoError converts a thrift error returned by the WorkflowService handler to
+// the yarpc error sent over gRPC, other errors are returned unchanged
+func ToProtoError(err error) error {
+	if err == nil {
+		return nil
+	}
+	errType := reflect.TypeOf(err)
+	if errType.Kind() != reflect.Ptr {
+		return err
+	}
+	code, ok := errorCodes[errType.Elem()]
+	if !ok {
+		return err
+	}
+	name := errType.Elem().Name()
+	payload, jsonErr := json.Marshal(err)
+	if jsonErr != nil {
+		return yarpcerrors.Newf(code, "%v", err.Error()).WithName(name)
+	}
+	return yarpcerrors.Newf(code, "%s", payload).WithName(name)
+}
+
+// FromProtoError converts a yarpc error received over gRPC back to the thrift error
+// the WorkflowService handler returned, other errors are returned unchanged
+func FromProtoError(err error) error {
+	if !yarpcerrors.IsStatus(err) {
+		return err
+	}
+	status := yarpcerrors.FromError(err)
+	errType, ok := errorTypes[status.Name()]
+	if !ok {
+		return err
+	}
+	thriftErr := reflect.New(errType).Interface()
+	if jsonErr := json.Unmarshal([]byte(status.Message()), thriftErr); jsonErr != nil {
+		return err
+	}
+	return thriftErr.(error)
+}
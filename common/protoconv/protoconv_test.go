@@ -0,0 +1,194 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protoconv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	protoconvSuite struct {
+		suite.Suite
+	}
+)
+
+func TestProtoconvSuite(t *testing.T) {
+	suite.Run(t, new(protoconvSuite))
+}
+
+func (s *protoconvSuite) TestHistoryEventRoundTrip() {
+	event := &workflow.HistoryEvent{
+		EventId:   int64Ptr(1),
+		Timestamp: int64Ptr(1000),
+		EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+		Version:   int64Ptr(2),
+		TaskId:    int64Ptr(3),
+		WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+			WorkflowType: &workflow.WorkflowType{Name: stringPtr("some-workflow")},
+			TaskList: &workflow.TaskList{
+				Name: stringPtr("some-task-list"),
+				Kind: workflow.TaskListKindSticky.Ptr(),
+			},
+			Input:                               []byte("some-input"),
+			ExecutionStartToCloseTimeoutSeconds: int32Ptr(60),
+			Memo:                                &workflow.Memo{Fields: map[string][]byte{"memo": []byte("value")}},
+			Header:                              &workflow.Header{Fields: map[string][]byte{"header": []byte("value")}},
+		},
+	}
+
+	data, err := ToProtoHistoryEvent(event).Marshal()
+	s.NoError(err)
+	decoded := &apiv1.HistoryEvent{}
+	s.NoError(decoded.Unmarshal(data))
+	s.True(event.Equals(FromProtoHistoryEvent(decoded)))
+}
+
+func (s *protoconvSuite) TestPollForDecisionTaskResponseRoundTrip() {
+	response := &workflow.PollForDecisionTaskResponse{
+		TaskToken:         []byte("task-token"),
+		WorkflowExecution: &workflow.WorkflowExecution{WorkflowId: stringPtr("wid"), RunId: stringPtr("rid")},
+		StartedEventId:    int64Ptr(5),
+		History: &workflow.History{
+			Events: []*workflow.HistoryEvent{
+				{EventId: int64Ptr(1), EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr()},
+				{EventId: int64Ptr(2), EventType: workflow.EventTypeDecisionTaskScheduled.Ptr()},
+			},
+		},
+		Query: &workflow.WorkflowQuery{QueryType: stringPtr("query"), QueryArgs: []byte("args")},
+	}
+
+	data, err := ToProtoPollForDecisionTaskResponse(response).Marshal()
+	s.NoError(err)
+	decoded := &apiv1.PollForDecisionTaskResponse{}
+	s.NoError(decoded.Unmarshal(data))
+	s.True(response.Equals(FromProtoPollForDecisionTaskResponse(decoded)))
+}
+
+func (s *protoconvSuite) TestDescribeDomainResponseRoundTrip() {
+	response := &workflow.DescribeDomainResponse{
+		DomainInfo: &workflow.DomainInfo{
+			Name:   stringPtr("domain"),
+			Status: workflow.DomainStatusRegistered.Ptr(),
+			Data:   map[string]string{"key": "value"},
+		},
+		Configuration: &workflow.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: int32Ptr(7),
+			EmitMetric:                             boolPtr(true),
+			BadBinaries: &workflow.BadBinaries{
+				Binaries: map[string]*workflow.BadBinaryInfo{
+					"checksum": {Reason: stringPtr("reason"), CreatedTimeNano: int64Ptr(100)},
+				},
+			},
+		},
+		FailoverVersion: int64Ptr(10),
+		IsGlobalDomain:  boolPtr(false),
+	}
+	s.True(response.Equals(FromProtoDescribeDomainResponse(ToProtoDescribeDomainResponse(response))))
+}
+
+func (s *protoconvSuite) TestGetSearchAttributesResponseRoundTrip() {
+	response := &workflow.GetSearchAttributesResponse{
+		Keys: map[string]workflow.IndexedValueType{
+			"CustomKeywordField": workflow.IndexedValueTypeKeyword,
+			"CustomIntField":     workflow.IndexedValueTypeInt,
+		},
+	}
+	s.True(response.Equals(FromProtoGetSearchAttributesResponse(ToProtoGetSearchAttributesResponse(response))))
+}
+
+func (s *protoconvSuite) TestEnumValues() {
+	for _, eventType := range workflow.EventType_Values() {
+		_, ok := apiv1.EventType_name[int32(eventType)]
+		s.True(ok, "%v has no protobuf counterpart", eventType)
+		s.Equal(eventType, *fromProtoEventType(toProtoEventType(&eventType)))
+	}
+}
+
+func (s *protoconvSuite) TestNil() {
+	s.Nil(ToProtoHistoryEvent(nil))
+	s.Nil(FromProtoHistoryEvent(nil))
+	s.Nil(ToProtoError(nil))
+}
+
+func (s *protoconvSuite) TestErrorRoundTrip() {
+	testCases := []struct {
+		err  error
+		code yarpcerrors.Code
+	}{
+		{&workflow.BadRequestError{Message: "bad request"}, yarpcerrors.CodeInvalidArgument},
+		{&workflow.EntityNotExistsError{Message: "not found"}, yarpcerrors.CodeNotFound},
+		{&workflow.ServiceBusyError{Message: "busy"}, yarpcerrors.CodeResourceExhausted},
+		{
+			&workflow.DomainNotActiveError{
+				Message:        "not active",
+				DomainName:     "domain",
+				CurrentCluster: "cluster-a",
+				ActiveCluster:  "cluster-b",
+			},
+			yarpcerrors.CodeFailedPrecondition,
+		},
+		{
+			&workflow.WorkflowExecutionAlreadyStartedError{
+				Message: stringPtr("already started"),
+				RunId:   stringPtr("rid"),
+			},
+			yarpcerrors.CodeAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		protoErr := ToProtoError(tc.err)
+		s.True(yarpcerrors.IsStatus(protoErr))
+		s.Equal(tc.code, yarpcerrors.FromError(protoErr).Code())
+		s.Equal(tc.err, FromProtoError(protoErr))
+	}
+}
+
+func (s *protoconvSuite) TestErrorPassThrough() {
+	err := errors.New("some random error")
+	s.Equal(err, ToProtoError(err))
+	s.Equal(err, FromProtoError(err))
+
+	statusErr := yarpcerrors.UnavailableErrorf("unavailable")
+	s.Equal(statusErr, FromProtoError(statusErr))
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
@@ -64,6 +64,8 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// Tracing is the config for exporting the traces of the services
+		Tracing Tracing `yaml:"tracing"`
 	}
 
 	// Service contains the service specific config items
@@ -96,6 +98,12 @@ type (
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// HTTPPort is the port on which the frontend serves its HTTP/JSON gateway,
+		// the gateway is disabled when it is not set
+		HTTPPort int `yaml:"httpPort"`
+		// GRPCPort is the port on which the service accepts gRPC calls alongside
+		// TChannel, the gRPC inbound is disabled when it is not set
+		GRPCPort int `yaml:"grpcPort"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -112,6 +120,13 @@ type (
 		MaxJoinDuration time.Duration `yaml:"maxJoinDuration"`
 		// Custom discovery provider, cannot be specified through yaml
 		DiscoveryProvider discovery.DiscoverProvider `yaml:"-"`
+		// Membership is a enum that selects how hosts discover each other, either through
+		// ringpop gossip (the default) or through host records in the default persistence store
+		Membership MembershipMode `yaml:"membership"`
+		// HeartbeatInterval is how often a host refreshes its record when membership is db
+		HeartbeatInterval time.Duration `yaml:"heartbeatInterval"`
+		// HeartbeatTTL is how long a host record stays live without a heartbeat when membership is db
+		HeartbeatTTL time.Duration `yaml:"heartbeatTTL"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -132,6 +147,11 @@ type (
 		VisibilityConfig *VisibilityConfig
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn
+		// FaultInjection is config for injecting faults into persistence calls
+		FaultInjection *FaultInjectionConfig
+		// Encryption is the config for encrypting history and memo blobs at rest,
+		// blobs are stored in plaintext when it is not set
+		Encryption *Encryption `yaml:"encryption"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -140,6 +160,8 @@ type (
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
 		SQL *SQL `yaml:"sql"`
+		// Memory contains the config for an in-memory datastore
+		Memory *Memory `yaml:"memory"`
 	}
 
 	// VisibilityConfig is config for visibility sampling
@@ -162,6 +184,30 @@ type (
 		ValidSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
+	// FaultInjectionConfig is config for injecting faults into persistence calls
+	FaultInjectionConfig struct {
+		// Enabled turns fault injection on
+		Enabled dynamicconfig.BoolPropertyFn
+		// ErrorRate is the probability of failing a persistence call
+		ErrorRate dynamicconfig.FloatPropertyFn
+		// MethodErrorRates overrides ErrorRate for individual persistence methods
+		MethodErrorRates dynamicconfig.MapPropertyFn
+		// ErrorTypes is the relative weight of each kind of injected error
+		ErrorTypes dynamicconfig.MapPropertyFn
+		// MaxLatency is the upper bound of the latency added to persistence calls
+		MaxLatency dynamicconfig.DurationPropertyFn
+		// Domains limits fault injection to calls on the given domain IDs, all calls are eligible when empty
+		Domains dynamicconfig.MapPropertyFn
+	}
+
+	// Encryption is the configuration for encrypting persisted blobs
+	Encryption struct {
+		// KeyProvider is the name of the key provider, only "file" is supported today
+		KeyProvider string `yaml:"keyProvider"`
+		// KeyringFile is the path of the keyring read by the file key provider
+		KeyringFile string `yaml:"keyringFile"`
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -176,12 +222,51 @@ type (
 		Keyspace string `yaml:"keyspace" validate:"nonzero"`
 		// Consistency is the default cassandra consistency level
 		Consistency string `yaml:"consistency"`
-		// Datacenter is the data center filter arg for cassandra
+		// ReadConsistency is the consistency level for reads from this datastore,
+		// defaults to LOCAL_QUORUM, or ONE for visibility
+		ReadConsistency string `yaml:"readConsistency"`
+		// WriteConsistency is the consistency level for writes to this datastore, defaults to LOCAL_QUORUM
+		WriteConsistency string `yaml:"writeConsistency"`
+		// SerialConsistency is the consistency level for the paxos phase of conditional updates,
+		// must be one of SERIAL or LOCAL_SERIAL
+		SerialConsistency string `yaml:"serialConsistency"`
+		// Datacenter is the local data center, hosts in it are preferred by the host selection policy
 		Datacenter string `yaml:"datacenter"`
+		// AllowRemoteDatacenter allows falling back to hosts outside of Datacenter when no local
+		// host is available, otherwise only hosts in Datacenter are connected to
+		AllowRemoteDatacenter bool `yaml:"allowRemoteDatacenter"`
 		// MaxQPS is the max request rate to this datastore
 		MaxQPS int `yaml:"maxQPS"`
 		// MaxConns is the max number of connections to this datastore for a single keyspace
 		MaxConns int `yaml:"maxConns"`
+		// TLS is the config for connecting to cassandra over TLS
+		TLS *CassandraTLS `yaml:"tls"`
+		// Retry is the retry policy for failed queries, queries are not retried when it is not set
+		Retry *CassandraRetryPolicy `yaml:"retry"`
+	}
+
+	// CassandraTLS is the TLS configuration for a cassandra datastore
+	CassandraTLS struct {
+		// Enabled turns on TLS for connections to cassandra
+		Enabled bool `yaml:"enabled"`
+		// CertFile is the path to the client certificate
+		CertFile string `yaml:"certFile"`
+		// KeyFile is the path to the client private key
+		KeyFile string `yaml:"keyFile"`
+		// CaFile is the path to the CA bundle used to verify the cassandra hosts
+		CaFile string `yaml:"caFile"`
+		// EnableHostVerification verifies that the host name matches the server certificate
+		EnableHostVerification bool `yaml:"enableHostVerification"`
+	}
+
+	// CassandraRetryPolicy is the retry policy for queries against a cassandra datastore
+	CassandraRetryPolicy struct {
+		// NumRetries is the max number of times a failed query is retried
+		NumRetries int `yaml:"numRetries"`
+		// MinBackoff is the backoff before the first retry
+		MinBackoff time.Duration `yaml:"minBackoff"`
+		// MaxBackoff is the upper bound on the exponential backoff between retries
+		MaxBackoff time.Duration `yaml:"maxBackoff"`
 	}
 
 	// SQL is the configuration for connecting to a SQL backed datastore
@@ -194,9 +279,11 @@ type (
 		DriverName string `yaml:"driverName" validate:"nonzero"`
 		// DatabaseName is the name of SQL database to connect to
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
-		// ConnectAddr is the remote addr of the database
+		// ConnectAddr is the remote addr of the database, for sqlite it is the
+		// directory that holds the database file
 		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
-		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
+		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix,
+		// and file or memory for sqlite
 		ConnectProtocol string `yaml:"connectProtocol" validate:"nonzero"`
 		// ConnectAttributes is a set of key-value attributes to be sent as part of connect data_source_name url
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
@@ -211,6 +298,23 @@ type (
 		// NumShards is the number of storage shards to use for tables
 		// in a sharded sql database. The default value for this param is 1
 		NumShards int `yaml:"nShards"`
+		// ShardConnectAddrs is the list of addresses of the databases that history shards
+		// and task lists are spread across. When empty, everything is stored in the database
+		// at ConnectAddr, otherwise ConnectAddr only holds the domain metadata and v1 history
+		ShardConnectAddrs []string `yaml:"shardConnectAddrs"`
+		// ShardMapping is how history shards are mapped to ShardConnectAddrs, one
+		// of modulo or range. The default value for this param is modulo
+		ShardMapping string `yaml:"shardMapping"`
+	}
+
+	// Memory is the configuration for an in-memory datastore. Data lives only as
+	// long as the process does, so it is intended for tests and local development
+	Memory struct {
+		// DatabaseName identifies the in-memory database, stores created with
+		// the same name within a process share the same data
+		DatabaseName string `yaml:"databaseName"`
+		// MaxQPS the max request rate on this datastore
+		MaxQPS int `yaml:"maxQPS"`
 	}
 
 	// Replicator describes the configuration of replicator
@@ -299,6 +403,42 @@ type (
 		Tags map[string]string `yaml:"tags"`
 	}
 
+	// Tracing contains the config items for the tracing subsystem,
+	// spans are only recorded when one of the exporters is configured
+	Tracing struct {
+		// Jaeger is the configuration for exporting spans to jaeger
+		Jaeger *JaegerTracing `yaml:"jaeger"`
+		// Zipkin is the configuration for exporting spans to zipkin
+		Zipkin *ZipkinTracing `yaml:"zipkin"`
+		// File is the configuration for writing spans to a local file
+		File *FileTracing `yaml:"file"`
+		// SampleRate is the fraction of the traces which are recorded, DefaultTracingSampleRate
+		// is used when it is not set and no trace is recorded when it is 0
+		SampleRate *float64 `yaml:"sampleRate"`
+	}
+
+	// JaegerTracing contains the config items for the jaeger exporter
+	JaegerTracing struct {
+		// AgentHostPort is the host and port of the jaeger agent, spans are sent over UDP
+		AgentHostPort string `yaml:"agentHostPort"`
+		// CollectorEndpoint is the url of the jaeger collector, spans are sent
+		// over HTTP. It takes precedence over the agent when both are set
+		CollectorEndpoint string `yaml:"collectorEndpoint"`
+	}
+
+	// ZipkinTracing contains the config items for the zipkin exporter
+	ZipkinTracing struct {
+		// Endpoint is the url of the zipkin span collector,
+		// for example http://localhost:9411/api/v1/spans
+		Endpoint string `yaml:"endpoint" validate:"nonzero"`
+	}
+
+	// FileTracing contains the config items for the local file exporter
+	FileTracing struct {
+		// Path is the file the spans are appended to, one json encoded span per line
+		Path string `yaml:"path" validate:"nonzero"`
+	}
+
 	// Statsd contains the config items for statsd metrics reporter
 	Statsd struct {
 		// The host and port of the statsd server
@@ -336,6 +476,9 @@ type (
 
 	// BootstrapMode is an enum type for ringpop bootstrap mode
 	BootstrapMode int
+
+	// MembershipMode is an enum type for the membership implementation
+	MembershipMode int
 )
 
 // Validate validates this config
@@ -20,13 +20,26 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
 
 const (
 	// StoreTypeSQL refers to sql based storage as persistence store
 	StoreTypeSQL = "sql"
 	// StoreTypeCassandra refers to cassandra as persistence store
 	StoreTypeCassandra = "cassandra"
+	// StoreTypeMemory refers to an in-memory persistence store
+	StoreTypeMemory = "memory"
+	// KeyProviderFile refers to the local file based encryption keyring
+	KeyProviderFile = "file"
+	// SQLShardMappingModulo maps history shard n to the sql shard database n % numShardDBs
+	SQLShardMappingModulo = "modulo"
+	// SQLShardMappingRange maps contiguous ranges of history shards to each sql shard database
+	SQLShardMappingRange = "range"
 )
 
 // SetMaxQPS sets the MaxQPS value for the given datastore
@@ -35,17 +48,37 @@ func (c *Persistence) SetMaxQPS(key string, qps int) {
 	if !ok {
 		return
 	}
-	if ds.Cassandra != nil {
+	switch {
+	case ds.Cassandra != nil:
 		ds.Cassandra.MaxQPS = qps
-		return
+	case ds.SQL != nil:
+		ds.SQL.MaxQPS = qps
+	case ds.Memory != nil:
+		ds.Memory.MaxQPS = qps
+	}
+}
+
+// NewFaultInjectionConfig returns the persistence fault injection config, enabled through the given
+// service specific key and tuned through the shared system keys
+func NewFaultInjectionConfig(dc *dynamicconfig.Collection, enabledKey dynamicconfig.Key) *FaultInjectionConfig {
+	return &FaultInjectionConfig{
+		Enabled:          dc.GetBoolProperty(enabledKey, false),
+		ErrorRate:        dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionErrorRate, 0),
+		MethodErrorRates: dc.GetMapProperty(dynamicconfig.PersistenceFaultInjectionMethodErrorRates, nil),
+		ErrorTypes:       dc.GetMapProperty(dynamicconfig.PersistenceFaultInjectionErrorTypes, nil),
+		MaxLatency:       dc.GetDurationProperty(dynamicconfig.PersistenceFaultInjectionMaxLatency, 0),
+		Domains:          dc.GetMapProperty(dynamicconfig.PersistenceFaultInjectionDomains, nil),
 	}
-	ds.SQL.MaxQPS = qps
 }
 
 // DefaultStoreType returns the storeType for the default persistence store
 func (c *Persistence) DefaultStoreType() string {
-	if c.DataStores[c.DefaultStore].SQL != nil {
+	ds := c.DataStores[c.DefaultStore]
+	switch {
+	case ds.SQL != nil:
 		return StoreTypeSQL
+	case ds.Memory != nil:
+		return StoreTypeMemory
 	}
 	return StoreTypeCassandra
 }
@@ -58,15 +91,82 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence config: missing config for datastore %v", st)
 		}
-		if ds.SQL == nil && ds.Cassandra == nil {
-			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra or sql stores", st)
+		numStores := 0
+		if ds.Cassandra != nil {
+			numStores++
+		}
+		if ds.SQL != nil {
+			numStores++
 		}
-		if ds.SQL != nil && ds.Cassandra != nil {
-			return fmt.Errorf("persistence config: datastore %v: only one of SQL or cassandra can be specified", st)
+		if ds.Memory != nil {
+			numStores++
+		}
+		if numStores == 0 {
+			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra, sql or memory stores", st)
+		}
+		if numStores > 1 {
+			return fmt.Errorf("persistence config: datastore %v: only one of cassandra, sql or memory can be specified", st)
+		}
+		if ds.Cassandra != nil {
+			if err := ds.Cassandra.validate(); err != nil {
+				return fmt.Errorf("persistence config: datastore %v: %v", st, err)
+			}
 		}
 		if ds.SQL != nil && ds.SQL.NumShards == 0 {
 			ds.SQL.NumShards = 1
 		}
+		if ds.SQL != nil {
+			switch ds.SQL.ShardMapping {
+			case "", SQLShardMappingModulo, SQLShardMappingRange:
+			default:
+				return fmt.Errorf("persistence config: datastore %v: unknown sql shard mapping %v", st, ds.SQL.ShardMapping)
+			}
+		}
+	}
+	if c.Encryption != nil {
+		if c.Encryption.KeyProvider != "" && c.Encryption.KeyProvider != KeyProviderFile {
+			return fmt.Errorf("persistence config: unknown encryption key provider %v", c.Encryption.KeyProvider)
+		}
+		if c.Encryption.KeyringFile == "" {
+			return fmt.Errorf("persistence config: encryption keyringFile must be provided")
+		}
+	}
+	return nil
+}
+
+func (c *Cassandra) validate() error {
+	consistencies := map[string]string{
+		"readConsistency":  c.ReadConsistency,
+		"writeConsistency": c.WriteConsistency,
+	}
+	for name, value := range consistencies {
+		if value == "" {
+			continue
+		}
+		switch strings.ToUpper(value) {
+		case "ANY", "ONE", "TWO", "THREE", "QUORUM", "ALL", "LOCAL_QUORUM", "EACH_QUORUM", "LOCAL_ONE":
+		default:
+			return fmt.Errorf("unknown cassandra %v %v", name, value)
+		}
+	}
+	switch strings.ToUpper(c.SerialConsistency) {
+	case "", "SERIAL", "LOCAL_SERIAL":
+	default:
+		return fmt.Errorf("unknown cassandra serialConsistency %v", c.SerialConsistency)
+	}
+	if c.AllowRemoteDatacenter && c.Datacenter == "" {
+		return fmt.Errorf("cassandra allowRemoteDatacenter requires datacenter to be set")
+	}
+	if c.TLS != nil && c.TLS.Enabled && c.TLS.CaFile == "" {
+		return fmt.Errorf("cassandra tls requires caFile to be set")
+	}
+	if c.Retry != nil {
+		if c.Retry.NumRetries < 0 {
+			return fmt.Errorf("cassandra retry numRetries must not be negative")
+		}
+		if c.Retry.MaxBackoff > 0 && c.Retry.MaxBackoff < c.Retry.MinBackoff {
+			return fmt.Errorf("cassandra retry maxBackoff must not be less than minBackoff")
+		}
 	}
 	return nil
 }
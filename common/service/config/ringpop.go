@@ -57,10 +57,25 @@ const (
 	BootstrapModeDNS
 )
 
+const (
+	// MembershipModeRingpop represents membership through ringpop gossip
+	MembershipModeRingpop MembershipMode = iota
+	// MembershipModeDB represents membership through host records heartbeated
+	// into the default persistence store
+	MembershipModeDB
+)
+
 const (
 	defaultMaxJoinDuration = 10 * time.Second
 )
 
+const (
+	// DefaultDBMembershipHeartbeatInterval is how often a host refreshes its record by default when membership is db
+	DefaultDBMembershipHeartbeatInterval = 5 * time.Second
+	// DefaultDBMembershipHeartbeatTTL is how long a host record stays live without a heartbeat by default when membership is db
+	DefaultDBMembershipHeartbeatTTL = 20 * time.Second
+)
+
 // CadenceServices indicate the list of cadence services
 var CadenceServices = []string{
 	common.FrontendServiceName,
@@ -86,9 +101,59 @@ func (rpConfig *Ringpop) validate() error {
 	if len(rpConfig.Name) == 0 {
 		return fmt.Errorf("ringpop config missing `name` param")
 	}
+	if rpConfig.Membership == MembershipModeDB {
+		return validateDBMembership(rpConfig)
+	}
 	return validateBootstrapMode(rpConfig)
 }
 
+// Validate validates the ringpop config
+func (rpConfig *Ringpop) Validate() error {
+	return rpConfig.validate()
+}
+
+// UnmarshalYAML is called by the yaml package to convert
+// the config YAML into a MembershipMode.
+func (m *MembershipMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	var err error
+	*m, err = parseMembershipMode(s)
+	return err
+}
+
+// parseMembershipMode reads a string value and returns a membership mode.
+func parseMembershipMode(s string) (MembershipMode, error) {
+	switch strings.ToLower(s) {
+	case "", "ringpop":
+		return MembershipModeRingpop, nil
+	case "db":
+		return MembershipModeDB, nil
+	}
+	return MembershipModeRingpop, fmt.Errorf("invalid membership mode: %v", s)
+}
+
+func validateDBMembership(rpConfig *Ringpop) error {
+	if rpConfig.HeartbeatInterval < 0 || rpConfig.HeartbeatTTL < 0 {
+		return fmt.Errorf("ringpop config with negative heartbeat interval or ttl")
+	}
+	// the defaults apply to unset values, so the check is made on the values the monitor uses
+	heartbeatInterval := rpConfig.HeartbeatInterval
+	if heartbeatInterval == 0 {
+		heartbeatInterval = DefaultDBMembershipHeartbeatInterval
+	}
+	heartbeatTTL := rpConfig.HeartbeatTTL
+	if heartbeatTTL == 0 {
+		heartbeatTTL = DefaultDBMembershipHeartbeatTTL
+	}
+	if heartbeatTTL <= heartbeatInterval {
+		return fmt.Errorf("ringpop config heartbeatTTL %v must be greater than heartbeatInterval %v", heartbeatTTL, heartbeatInterval)
+	}
+	return nil
+}
+
 // UnmarshalYAML is called by the yaml package to convert
 // the config YAML into a BootstrapMode.
 func (m *BootstrapMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -90,6 +90,33 @@ func (s *RingpopSuite) TestCustomMode() {
 	s.NotNil(f)
 }
 
+func (s *RingpopSuite) TestDBMembershipMode() {
+	var cfg Ringpop
+	err := yaml.Unmarshal([]byte(getDBMembershipConfig()), &cfg)
+	s.Nil(err)
+	s.Equal("test", cfg.Name)
+	s.Equal(MembershipModeDB, cfg.Membership)
+	s.Equal(BootstrapModeNone, cfg.BootstrapMode)
+	s.Equal(time.Second, cfg.HeartbeatInterval)
+	s.Equal(time.Second*10, cfg.HeartbeatTTL)
+	s.Nil(cfg.validate())
+
+	cfg.HeartbeatTTL = time.Second
+	s.NotNil(cfg.validate())
+
+	// an unset ttl gets the default, which is below the interval
+	cfg.HeartbeatInterval = time.Second * 30
+	cfg.HeartbeatTTL = 0
+	s.NotNil(cfg.validate())
+
+	cfg.HeartbeatInterval = 0
+	s.Nil(cfg.validate())
+
+	err = yaml.Unmarshal([]byte(`name: "test"
+membership: "gossip"`), &cfg)
+	s.NotNil(err)
+}
+
 type mockResolver struct {
 	Hosts map[string][]string
 }
@@ -189,6 +216,13 @@ bootstrapHosts: ["127.0.0.1:1111"]
 maxJoinDuration: 30s`
 }
 
+func getDBMembershipConfig() string {
+	return `name: "test"
+membership: "db"
+heartbeatInterval: 1s
+heartbeatTTL: 10s`
+}
+
 func getCustomConfig() string {
 	return `name: "test"
 bootstrapMode: "custom"
@@ -24,28 +24,36 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
+// grpcMaxMessageSize is the max size of the messages received and sent over
+// gRPC, it is raised from the default 4MB to allow for large history pages
+const grpcMaxMessageSize = 64 * 1024 * 1024
+
 // RPCFactory is an implementation of service.RPCFactory interface
 type RPCFactory struct {
 	config      *RPC
 	serviceName string
 	ch          *tchannel.ChannelTransport
 	logger      log.Logger
+	tracer      opentracing.Tracer
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
-func (cfg *RPC) NewFactory(sName string, logger log.Logger) *RPCFactory {
-	return newRPCFactory(cfg, sName, logger)
+// conforming to the underlying configuration,
+// the transports propagate the spans of the tracer
+func (cfg *RPC) NewFactory(sName string, logger log.Logger, tracer opentracing.Tracer) *RPCFactory {
+	return newRPCFactory(cfg, sName, logger, tracer)
 }
 
-func newRPCFactory(cfg *RPC, sName string, logger log.Logger) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger}
+func newRPCFactory(cfg *RPC, sName string, logger log.Logger, tracer opentracing.Tracer) *RPCFactory {
+	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, tracer: tracer}
 	return factory
 }
 
@@ -56,14 +64,31 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.Port)
 	d.ch, err = tchannel.NewChannelTransport(
 		tchannel.ServiceName(d.serviceName),
-		tchannel.ListenAddr(hostAddress))
+		tchannel.ListenAddr(hostAddress),
+		tchannel.Tracer(d.tracer))
 	if err != nil {
 		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
 	d.logger.Info("Created RPC dispatcher and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	// the tchannel inbound must stay first, membership is bootstrapped from it
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort != 0 {
+		grpcAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+		listener, err := net.Listen("tcp", grpcAddress)
+		if err != nil {
+			d.logger.Fatal("Failed to listen on gRPC address", tag.Address(grpcAddress), tag.Error(err))
+		}
+		transport := grpc.NewTransport(
+			grpc.ServerMaxRecvMsgSize(grpcMaxMessageSize),
+			grpc.ServerMaxSendMsgSize(grpcMaxMessageSize),
+			grpc.Tracer(d.tracer),
+		)
+		inbounds = append(inbounds, transport.NewInbound(listener))
+		d.logger.Info("Created gRPC inbound and listening", tag.Service(d.serviceName), tag.Address(grpcAddress))
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
 	})
 }
 
@@ -84,6 +109,15 @@ func (d *RPCFactory) CreateDispatcherForOutbound(
 	return dispatcher
 }
 
+// GetHTTPGatewayAddress returns the address on which the frontend HTTP/JSON gateway
+// listens, it is empty when the gateway is disabled
+func (d *RPCFactory) GetHTTPGatewayAddress() string {
+	if d.config.HTTPPort == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v", d.getListenIP(), d.config.HTTPPort)
+}
+
 func (d *RPCFactory) getListenIP() net.IP {
 	if d.config.BindOnLocalHost && len(d.config.BindOnIP) > 0 {
 		d.logger.Fatal("ListenIP failed, bindOnLocalHost and bindOnIP are mutually exclusive")
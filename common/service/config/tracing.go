@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/tracing"
+	"github.com/uber/jaeger-client-go"
+	"github.com/uber/jaeger-client-go/transport"
+	"github.com/uber/jaeger-client-go/transport/zipkin"
+)
+
+// DefaultTracingSampleRate is the fraction of the traces which are recorded when the sample rate is not configured
+const DefaultTracingSampleRate = 0.001
+
+type (
+	// jaegerLogger adapts the cadence logger to the logger of the jaeger client
+	jaegerLogger struct {
+		logger log.Logger
+	}
+
+	noopCloser struct{}
+)
+
+// NewTracer builds the tracer of the service from this tracing configuration,
+// the closer flushes the spans which are not exported yet. A tracer which does
+// not record spans is returned when no exporter is configured
+//
+// If the underlying configuration is valid for multiple exporters, only one of
+// them will be used. Currently, jaeger is preferred over zipkin, which is
+// preferred over the local file
+func (c *Tracing) NewTracer(serviceName string, logger log.Logger) (opentracing.Tracer, io.Closer, error) {
+	if c.Jaeger == nil && c.Zipkin == nil && c.File == nil {
+		return opentracing.NoopTracer{}, noopCloser{}, nil
+	}
+
+	sampler, err := c.newSampler()
+	if err != nil {
+		return nil, nil, err
+	}
+	reporter, err := c.newReporter(serviceName, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	options := []jaeger.TracerOption{jaeger.TracerOptions.Logger(&jaegerLogger{logger: logger})}
+	if c.Jaeger == nil && c.Zipkin != nil {
+		// zipkin expects the client and the server of a call to share the span
+		options = append(options, jaeger.TracerOptions.ZipkinSharedRPCSpan(true))
+	}
+	tracer, closer := jaeger.NewTracer(serviceName, sampler, reporter, options...)
+	return tracer, closer, nil
+}
+
+func (c *Tracing) newSampler() (jaeger.Sampler, error) {
+	sampleRate := DefaultTracingSampleRate
+	if c.SampleRate != nil {
+		sampleRate = *c.SampleRate
+	}
+	switch sampleRate {
+	case 0:
+		return jaeger.NewConstSampler(false), nil
+	case 1:
+		return jaeger.NewConstSampler(true), nil
+	default:
+		return jaeger.NewProbabilisticSampler(sampleRate)
+	}
+}
+
+func (c *Tracing) newReporter(serviceName string, logger log.Logger) (jaeger.Reporter, error) {
+	reporterLogger := jaeger.ReporterOptions.Logger(&jaegerLogger{logger: logger})
+	switch {
+	case c.Jaeger != nil:
+		if len(c.Jaeger.CollectorEndpoint) != 0 {
+			return jaeger.NewRemoteReporter(transport.NewHTTPTransport(c.Jaeger.CollectorEndpoint), reporterLogger), nil
+		}
+		if len(c.Jaeger.AgentHostPort) == 0 {
+			return nil, fmt.Errorf("jaeger tracing requires either agentHostPort or collectorEndpoint")
+		}
+		sender, err := jaeger.NewUDPTransport(c.Jaeger.AgentHostPort, 0)
+		if err != nil {
+			return nil, err
+		}
+		return jaeger.NewRemoteReporter(sender, reporterLogger), nil
+	case c.Zipkin != nil:
+		sender, err := zipkin.NewHTTPTransport(c.Zipkin.Endpoint, zipkin.HTTPLogger(&jaegerLogger{logger: logger}))
+		if err != nil {
+			return nil, err
+		}
+		return jaeger.NewRemoteReporter(sender, reporterLogger), nil
+	default:
+		return tracing.NewFileReporter(c.File.Path, serviceName)
+	}
+}
+
+// Error implements jaeger.Logger
+func (l *jaegerLogger) Error(msg string) {
+	l.logger.Error(msg)
+}
+
+// Infof implements jaeger.Logger
+func (l *jaegerLogger) Infof(msg string, args ...interface{}) {
+	l.logger.Info(fmt.Sprintf(msg, args...))
+}
+
+func (noopCloser) Close() error {
+	return nil
+}
@@ -50,65 +50,81 @@ var keys = map[Key]string{
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
 
 	// system settings
-	EnableGlobalDomain:                  "system.enableGlobalDomain",
-	EnableNewKafkaClient:                "system.enableNewKafkaClient",
-	EnableVisibilitySampling:            "system.enableVisibilitySampling",
-	EnableReadFromClosedExecutionV2:     "system.enableReadFromClosedExecutionV2",
-	EnableVisibilityToKafka:             "system.enableVisibilityToKafka",
-	EnableReadVisibilityFromES:          "system.enableReadVisibilityFromES",
-	ArchivalStatus:                      "system.archivalStatus",
-	EnableReadFromArchival:              "system.enableReadFromArchival",
-	EnableDomainNotActiveAutoForwarding: "system.enableDomainNotActiveAutoForwarding",
-	TransactionSizeLimit:                "system.transactionSizeLimit",
-	MinRetentionDays:                    "system.minRetentionDays",
-	EnableBatcher:                       "worker.enableBatcher",
+	EnableGlobalDomain:                        "system.enableGlobalDomain",
+	EnableNewKafkaClient:                      "system.enableNewKafkaClient",
+	EnableVisibilitySampling:                  "system.enableVisibilitySampling",
+	EnableReadFromClosedExecutionV2:           "system.enableReadFromClosedExecutionV2",
+	EnableVisibilityToKafka:                   "system.enableVisibilityToKafka",
+	EnableReadVisibilityFromES:                "system.enableReadVisibilityFromES",
+	ESVisibilityCluster:                       "system.esVisibilityCluster",
+	ESVisibilityIndex:                         "system.esVisibilityIndex",
+	ArchivalStatus:                            "system.archivalStatus",
+	EnableReadFromArchival:                    "system.enableReadFromArchival",
+	EnableDomainNotActiveAutoForwarding:       "system.enableDomainNotActiveAutoForwarding",
+	TransactionSizeLimit:                      "system.transactionSizeLimit",
+	MinRetentionDays:                          "system.minRetentionDays",
+	PersistenceFaultInjectionErrorRate:        "system.persistenceFaultInjectionErrorRate",
+	PersistenceFaultInjectionMethodErrorRates: "system.persistenceFaultInjectionMethodErrorRates",
+	PersistenceFaultInjectionErrorTypes:       "system.persistenceFaultInjectionErrorTypes",
+	PersistenceFaultInjectionMaxLatency:       "system.persistenceFaultInjectionMaxLatency",
+	PersistenceFaultInjectionDomains:          "system.persistenceFaultInjectionDomains",
+	PayloadOffloadBucket:                      "system.payloadOffloadBucket",
+	EnableBatcher:                             "worker.enableBatcher",
 
 	// size limit
-	BlobSizeLimitError:     "limit.blobSize.error",
-	BlobSizeLimitWarn:      "limit.blobSize.warn",
-	HistorySizeLimitError:  "limit.historySize.error",
-	HistorySizeLimitWarn:   "limit.historySize.warn",
-	HistoryCountLimitError: "limit.historyCount.error",
-	HistoryCountLimitWarn:  "limit.historyCount.warn",
-	MaxIDLengthLimit:       "limit.maxIDLength",
+	BlobSizeLimitError:             "limit.blobSize.error",
+	BlobSizeLimitWarn:              "limit.blobSize.warn",
+	HistorySizeLimitError:          "limit.historySize.error",
+	HistorySizeLimitWarn:           "limit.historySize.warn",
+	HistoryCountLimitError:         "limit.historyCount.error",
+	HistoryCountLimitWarn:          "limit.historyCount.warn",
+	MaxIDLengthLimit:               "limit.maxIDLength",
+	PayloadOffloadThreshold:        "limit.payloadOffloadThreshold",
+	OffloadedPayloadSizeLimitError: "limit.offloadedPayloadSize.error",
 
 	// frontend settings
-	FrontendPersistenceMaxQPS:         "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:     "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:      "frontend.visibilityListMaxQPS",
-	FrontendESVisibilityListMaxQPS:    "frontend.esVisibilityListMaxQPS",
-	FrontendMaxBadBinaries:            "frontend.maxBadBinaries",
-	FrontendESIndexMaxResultWindow:    "frontend.esIndexMaxResultWindow",
-	FrontendHistoryMaxPageSize:        "frontend.historyMaxPageSize",
-	FrontendRPS:                       "frontend.rps",
-	FrontendHistoryMgrNumConns:        "frontend.historyMgrNumConns",
-	MaxDecisionStartToCloseTimeout:    "frontend.maxDecisionStartToCloseTimeout",
-	DisableListVisibilityByFilter:     "frontend.disableListVisibilityByFilter",
-	FrontendThrottledLogRPS:           "frontend.throttledLogRPS",
-	EnableClientVersionCheck:          "frontend.enableClientVersionCheck",
-	ValidSearchAttributes:             "frontend.validSearchAttributes",
-	SearchAttributesNumberOfKeysLimit: "frontend.searchAttributesNumberOfKeysLimit",
-	SearchAttributesSizeOfValueLimit:  "frontend.searchAttributesSizeOfValueLimit",
-	SearchAttributesTotalSizeLimit:    "frontend.searchAttributesTotalSizeLimit",
+	FrontendPersistenceMaxQPS:                "frontend.persistenceMaxQPS",
+	FrontendPersistenceFaultInjectionEnabled: "frontend.persistenceFaultInjectionEnabled",
+	FrontendEnablePayloadOffload:             "frontend.enablePayloadOffload",
+	FrontendVisibilityMaxPageSize:            "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:             "frontend.visibilityListMaxQPS",
+	FrontendESVisibilityListMaxQPS:           "frontend.esVisibilityListMaxQPS",
+	FrontendMaxBadBinaries:                   "frontend.maxBadBinaries",
+	FrontendFailoverHistoryMaxSize:           "frontend.failoverHistoryMaxSize",
+	FrontendESIndexMaxResultWindow:           "frontend.esIndexMaxResultWindow",
+	FrontendHistoryMaxPageSize:               "frontend.historyMaxPageSize",
+	FrontendRPS:                              "frontend.rps",
+	FrontendHistoryMgrNumConns:               "frontend.historyMgrNumConns",
+	MaxDecisionStartToCloseTimeout:           "frontend.maxDecisionStartToCloseTimeout",
+	DisableListVisibilityByFilter:            "frontend.disableListVisibilityByFilter",
+	FrontendThrottledLogRPS:                  "frontend.throttledLogRPS",
+	EnableClientVersionCheck:                 "frontend.enableClientVersionCheck",
+	ValidSearchAttributes:                    "frontend.validSearchAttributes",
+	SearchAttributesNumberOfKeysLimit:        "frontend.searchAttributesNumberOfKeysLimit",
+	SearchAttributesSizeOfValueLimit:         "frontend.searchAttributesSizeOfValueLimit",
+	SearchAttributesTotalSizeLimit:           "frontend.searchAttributesTotalSizeLimit",
 
 	// matching settings
-	MatchingRPS:                             "matching.rps",
-	MatchingPersistenceMaxQPS:               "matching.persistenceMaxQPS",
-	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
-	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
-	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
-	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
-	MatchingUpdateAckInterval:               "matching.updateAckInterval",
-	MatchingIdleTasklistCheckInterval:       "matching.idleTasklistCheckInterval",
-	MaxTasklistIdleTime:                     "matching.maxTasklistIdleTime",
-	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
-	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
-	MatchingMaxTaskDeleteBatchSize:          "matching.maxTaskDeleteBatchSize",
-	MatchingThrottledLogRPS:                 "matching.throttledLogRPS",
+	MatchingRPS:                              "matching.rps",
+	MatchingPersistenceMaxQPS:                "matching.persistenceMaxQPS",
+	MatchingPersistenceFaultInjectionEnabled: "matching.persistenceFaultInjectionEnabled",
+	MatchingMinTaskThrottlingBurstSize:       "matching.minTaskThrottlingBurstSize",
+	MatchingGetTasksBatchSize:                "matching.getTasksBatchSize",
+	MatchingLongPollExpirationInterval:       "matching.longPollExpirationInterval",
+	MatchingEnableSyncMatch:                  "matching.enableSyncMatch",
+	MatchingUpdateAckInterval:                "matching.updateAckInterval",
+	MatchingIdleTasklistCheckInterval:        "matching.idleTasklistCheckInterval",
+	MaxTasklistIdleTime:                      "matching.maxTasklistIdleTime",
+	MatchingOutstandingTaskAppendsThreshold:  "matching.outstandingTaskAppendsThreshold",
+	MatchingMaxTaskBatchSize:                 "matching.maxTaskBatchSize",
+	MatchingMaxTaskDeleteBatchSize:           "matching.maxTaskDeleteBatchSize",
+	MatchingThrottledLogRPS:                  "matching.throttledLogRPS",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
 	HistoryPersistenceMaxQPS:                              "history.persistenceMaxQPS",
+	HistoryPersistenceFaultInjectionEnabled:               "history.persistenceFaultInjectionEnabled",
+	HistoryEnablePayloadOffload:                           "history.enablePayloadOffload",
 	HistoryVisibilityOpenMaxQPS:                           "history.historyVisibilityOpenMaxQPS",
 	HistoryVisibilityClosedMaxQPS:                         "history.historyVisibilityClosedMaxQPS",
 	HistoryLongPollExpirationInterval:                     "history.longPollExpirationInterval",
@@ -161,6 +177,10 @@ var keys = map[Key]string{
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   "history.replicatorProcessorMaxPollIntervalJitterCoefficient",
 	ReplicatorProcessorUpdateAckInterval:                  "history.replicatorProcessorUpdateAckInterval",
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: "history.replicatorProcessorUpdateAckIntervalJitterCoefficient",
+	EnableReplicationPull:                                 "history.enableReplicationPull",
+	ReplicationTaskFetcherPollInterval:                    "history.replicationTaskFetcherPollInterval",
+	ReplicationTaskProcessorMaxRetryCount:                 "history.replicationTaskProcessorMaxRetryCount",
+	ReplicationStatusScanLimit:                            "history.replicationStatusScanLimit",
 	ExecutionMgrNumConns:                                  "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
@@ -175,8 +195,12 @@ var keys = map[Key]string{
 	ArchiveRequestRPS:                                     "history.archiveRequestRPS",
 	EmitShardDiffLog:                                      "history.emitShardDiffLog",
 	HistoryThrottledLogRPS:                                "history.throttledLogRPS",
+	MutableStateChecksumGenProbability:                    "history.mutableStateChecksumGenProbability",
+	MutableStateChecksumVerifyProbability:                 "history.mutableStateChecksumVerifyProbability",
+	MutableStateChecksumVerifyMode:                        "history.mutableStateChecksumVerifyMode",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
+	WorkerPersistenceFaultInjectionEnabled:          "worker.persistenceFaultInjectionEnabled",
 	WorkerReplicatorMetaTaskConcurrency:             "worker.replicatorMetaTaskConcurrency",
 	WorkerReplicatorTaskConcurrency:                 "worker.replicatorTaskConcurrency",
 	WorkerReplicatorMessageConcurrency:              "worker.replicatorMessageConcurrency",
@@ -232,6 +256,12 @@ const (
 	EmitShardDiffLog
 	// EnableReadVisibilityFromES is key for enable read from elastic search
 	EnableReadVisibilityFromES
+	// ESVisibilityCluster is the additional ElasticSearch cluster the visibility records of a domain are routed to,
+	// the default cluster is used when empty
+	ESVisibilityCluster
+	// ESVisibilityIndex is the ElasticSearch index the visibility records of a domain are routed to,
+	// the default visibility index is used when empty
+	ESVisibilityIndex
 	// DisableListVisibilityByFilter is config to disable list open/close workflow using filter
 	DisableListVisibilityByFilter
 	// ArchivalStatus is key for the status of archival
@@ -245,6 +275,18 @@ const (
 	TransactionSizeLimit
 	// MinRetentionDays is the minimal allowed retention days for domain
 	MinRetentionDays
+	// PersistenceFaultInjectionErrorRate is the probability of failing a persistence call with an injected error
+	PersistenceFaultInjectionErrorRate
+	// PersistenceFaultInjectionMethodErrorRates overrides the injected error rate per persistence method
+	PersistenceFaultInjectionMethodErrorRates
+	// PersistenceFaultInjectionErrorTypes is the relative weight of each kind of injected error
+	PersistenceFaultInjectionErrorTypes
+	// PersistenceFaultInjectionMaxLatency is the upper bound of the latency added to persistence calls
+	PersistenceFaultInjectionMaxLatency
+	// PersistenceFaultInjectionDomains limits fault injection to the given domain IDs
+	PersistenceFaultInjectionDomains
+	// PayloadOffloadBucket is the blobstore bucket oversized payloads are offloaded to
+	PayloadOffloadBucket
 
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
@@ -262,11 +304,19 @@ const (
 	// MaxIDLengthLimit is the length limit for various IDs, including: Domain, TaskList, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit
+	// PayloadOffloadThreshold is the per domain payload size above which payloads are offloaded to the blobstore, 0 disables offloading
+	PayloadOffloadThreshold
+	// OffloadedPayloadSizeLimitError is the per domain size limit of payloads which are offloaded to the blobstore
+	OffloadedPayloadSizeLimitError
 
 	// key for frontend
 
 	// FrontendPersistenceMaxQPS is the max qps frontend host can query DB
 	FrontendPersistenceMaxQPS
+	// FrontendPersistenceFaultInjectionEnabled enables persistence fault injection in frontend
+	FrontendPersistenceFaultInjectionEnabled
+	// FrontendEnablePayloadOffload lets frontend accept payloads up to OffloadedPayloadSizeLimitError for a domain
+	FrontendEnablePayloadOffload
 	// FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page
 	FrontendVisibilityMaxPageSize
 	// FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows
@@ -289,6 +339,8 @@ const (
 	EnableClientVersionCheck
 	// FrontendMaxBadBinaries is the max number of bad binaries in domain config
 	FrontendMaxBadBinaries
+	// FrontendFailoverHistoryMaxSize is the max number of failover events kept in the failover history of a domain
+	FrontendFailoverHistoryMaxSize
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes
 	// SearchAttributesNumberOfKeysLimit is the limit of number of keys
@@ -304,6 +356,8 @@ const (
 	MatchingRPS
 	// MatchingPersistenceMaxQPS is the max qps matching host can query DB
 	MatchingPersistenceMaxQPS
+	// MatchingPersistenceFaultInjectionEnabled enables persistence fault injection in matching
+	MatchingPersistenceFaultInjectionEnabled
 	// MatchingMinTaskThrottlingBurstSize is the minimum burst size for task list throttling
 	MatchingMinTaskThrottlingBurstSize
 	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer
@@ -333,6 +387,10 @@ const (
 	HistoryRPS
 	// HistoryPersistenceMaxQPS is the max qps history host can query DB
 	HistoryPersistenceMaxQPS
+	// HistoryPersistenceFaultInjectionEnabled enables persistence fault injection in history
+	HistoryPersistenceFaultInjectionEnabled
+	// HistoryEnablePayloadOffload enables offloading payloads above PayloadOffloadThreshold to the blobstore for a domain
+	HistoryEnablePayloadOffload
 	// HistoryVisibilityOpenMaxQPS is max qps one history host can write visibility open_executions
 	HistoryVisibilityOpenMaxQPS
 	// HistoryVisibilityClosedMaxQPS is max qps one history host can write visibility closed_executions
@@ -435,6 +493,14 @@ const (
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
+	// EnableReplicationPull is whether remote clusters pull history replication tasks from this cluster instead of consuming them from kafka
+	EnableReplicationPull
+	// ReplicationTaskFetcherPollInterval is the interval between polls of a shard for replication tasks of a remote cluster
+	ReplicationTaskFetcherPollInterval
+	// ReplicationTaskProcessorMaxRetryCount is the max number of attempts to apply a pulled replication task before skipping it
+	ReplicationTaskProcessorMaxRetryCount
+	// ReplicationStatusScanLimit is the max number of pending replication tasks of a shard counted when describing the replication status
+	ReplicationStatusScanLimit
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	ExecutionMgrNumConns
 	// HistoryMgrNumConns is persistence connections number for HistoryManager
@@ -447,7 +513,7 @@ const (
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events: thriftrw, thriftrw-snappy or thriftrw-zstd
 	DefaultEventEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
@@ -465,11 +531,19 @@ const (
 	EnableEventsV2
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	HistoryThrottledLogRPS
+	// MutableStateChecksumGenProbability is the percentage [0-100] of mutable state updates which persist a checksum
+	MutableStateChecksumGenProbability
+	// MutableStateChecksumVerifyProbability is the percentage [0-100] of mutable state loads which verify the checksum
+	MutableStateChecksumVerifyProbability
+	// MutableStateChecksumVerifyMode is the action taken on checksum mismatch, one of metric, log or fail
+	MutableStateChecksumVerifyMode
 
 	// key for worker
 
 	// WorkerPersistenceMaxQPS is the max qps worker host can query DB
 	WorkerPersistenceMaxQPS
+	// WorkerPersistenceFaultInjectionEnabled enables persistence fault injection in worker
+	WorkerPersistenceFaultInjectionEnabled
 	// WorkerReplicatorMetaTaskConcurrency is the number of coroutine handling metadata related tasks
 	WorkerReplicatorMetaTaskConcurrency
 	// WorkerReplicatorTaskConcurrency is the number of coroutine handling non metadata related tasks
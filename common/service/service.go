@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common/clock"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-go/tally"
 
 	"github.com/uber/cadence/client"
@@ -72,12 +73,17 @@ type (
 		MetricsClient       metrics.Client
 		MessagingClient     messaging.Client
 		ESClient            es.Client
+		ESRouter            es.Router
 		ESConfig            *es.Config
 		DynamicConfig       dynamicconfig.Client
 		DispatcherProvider  client.DispatcherProvider
 		BlobstoreClient     blobstore.Client
 		DCRedirectionPolicy config.DCRedirectionPolicy
 		PublicClient        workflowserviceclient.Interface
+		// HTTPGatewayAddress is the address of the frontend HTTP/JSON gateway, empty disables the gateway
+		HTTPGatewayAddress string
+		// Tracer records the spans of the service, spans are not recorded when it is not set
+		Tracer opentracing.Tracer
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -111,6 +117,7 @@ type (
 		messagingClient        messaging.Client
 		dynamicCollection      *dynamicconfig.Collection
 		dispatcherProvider     client.DispatcherProvider
+		tracer                 opentracing.Tracer
 	}
 )
 
@@ -135,6 +142,10 @@ func New(params *BootstrapParams) Service {
 		messagingClient:       params.MessagingClient,
 		dispatcherProvider:    params.DispatcherProvider,
 		dynamicCollection:     dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+		tracer:                params.Tracer,
+	}
+	if sVice.tracer == nil {
+		sVice.tracer = opentracing.NoopTracer{}
 	}
 
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.GetLogger(), params.InstanceID)
@@ -199,7 +210,7 @@ func (h *serviceImpl) Start() {
 	h.hostInfo = hostInfo
 
 	h.clientBean, err = client.NewClientBean(
-		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.numberOfHistoryShards),
+		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.tracer, h.numberOfHistoryShards),
 		h.dispatcherProvider,
 		h.clusterMetadata,
 	)
@@ -272,6 +283,11 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetTracer returns the tracer recording the spans of the service
+func (h *serviceImpl) GetTracer() opentracing.Tracer {
+	return h.tracer
+}
+
 // GetMetricsServiceIdx returns the metrics name
 func GetMetricsServiceIdx(serviceName string, logger log.Logger) metrics.ServiceIdx {
 	switch serviceName {
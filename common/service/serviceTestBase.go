@@ -21,6 +21,7 @@
 package service
 
 import (
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -141,3 +142,8 @@ func (s *serviceTestBase) GetClusterMetadata() cluster.Metadata {
 func (s *serviceTestBase) GetMessagingClient() messaging.Client {
 	return s.messagingClient
 }
+
+// GetTracer returns a tracer which does not record spans
+func (s *serviceTestBase) GetTracer() opentracing.Tracer {
+	return opentracing.NoopTracer{}
+}
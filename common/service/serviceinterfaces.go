@@ -21,6 +21,7 @@
 package service
 
 import (
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -64,5 +65,8 @@ type (
 
 		// GetMessagingClient returns the messaging client against Kafka
 		GetMessagingClient() messaging.Client
+
+		// GetTracer returns the tracer recording the spans of the service
+		GetTracer() opentracing.Tracer
 	}
 )
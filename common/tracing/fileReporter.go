@@ -0,0 +1,148 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber/jaeger-client-go"
+)
+
+const (
+	// fileReporterQueueSize is the number of finished spans buffered by the fileReporter,
+	// spans reported while the queue is full are dropped
+	fileReporterQueueSize = 1000
+)
+
+type (
+	// fileReporter is a jaeger reporter which appends the finished spans
+	// to a local file, one json encoded span per line. Like the remote reporter
+	// of jaeger, spans are queued and written by a background goroutine so that
+	// finishing a span never blocks on the file
+	fileReporter struct {
+		serviceName string
+		file        *os.File
+		encoder     *json.Encoder
+		queue       chan *spanRecord
+		closeOnce   sync.Once
+		shutdownCh  chan struct{}
+		doneCh      chan struct{}
+	}
+
+	// spanRecord is the json representation of a span written by the fileReporter
+	spanRecord struct {
+		TraceID        string                 `json:"traceId"`
+		SpanID         string                 `json:"spanId"`
+		ParentID       string                 `json:"parentId,omitempty"`
+		ServiceName    string                 `json:"serviceName"`
+		OperationName  string                 `json:"operationName"`
+		StartTime      time.Time              `json:"startTime"`
+		DurationMicros int64                  `json:"durationMicros"`
+		Tags           map[string]interface{} `json:"tags,omitempty"`
+	}
+)
+
+var _ jaeger.Reporter = (*fileReporter)(nil)
+
+// NewFileReporter creates a jaeger reporter which appends the spans finished
+// by the tracer of the given service to the file at the given path
+func NewFileReporter(path string, serviceName string) (jaeger.Reporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	reporter := &fileReporter{
+		serviceName: serviceName,
+		file:        file,
+		encoder:     json.NewEncoder(file),
+		queue:       make(chan *spanRecord, fileReporterQueueSize),
+		shutdownCh:  make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+	go reporter.processQueue()
+	return reporter, nil
+}
+
+// Report implements jaeger.Reporter
+func (r *fileReporter) Report(span *jaeger.Span) {
+	spanContext := span.SpanContext()
+	record := &spanRecord{
+		TraceID:        spanContext.TraceID().String(),
+		SpanID:         spanContext.SpanID().String(),
+		ServiceName:    r.serviceName,
+		OperationName:  span.OperationName(),
+		StartTime:      span.StartTime(),
+		DurationMicros: int64(span.Duration() / time.Microsecond),
+		Tags:           span.Tags(),
+	}
+	if parentID := spanContext.ParentID(); parentID != 0 {
+		record.ParentID = parentID.String()
+	}
+
+	// the spans are dropped once the reporter is closed or when the queue is full,
+	// in the same way as the remote reporters drop them
+	select {
+	case <-r.shutdownCh:
+		return
+	default:
+	}
+	select {
+	case r.queue <- record:
+	default:
+	}
+}
+
+// Close implements jaeger.Reporter, it writes the queued spans before closing the file
+func (r *fileReporter) Close() {
+	r.closeOnce.Do(func() {
+		close(r.shutdownCh)
+	})
+	<-r.doneCh
+}
+
+func (r *fileReporter) processQueue() {
+	defer close(r.doneCh)
+	defer r.file.Close()
+
+	for {
+		select {
+		case record := <-r.queue:
+			r.write(record)
+		case <-r.shutdownCh:
+			for {
+				select {
+				case record := <-r.queue:
+					r.write(record)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *fileReporter) write(record *spanRecord) {
+	// spans which fail to be written are dropped
+	r.encoder.Encode(record)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package tracing records the OpenTracing spans of the cadence services. Spans are started by the
+// handlers, the RPC clients and the persistence clients as children of the span carried by the
+// context, the yarpc transports propagate it through the RPC headers and the workflow header carries
+// it to the workers and to the child workflows.
+package tracing
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+// headerCarrier adapts the fields of a workflow header to the opentracing TextMap carrier
+type headerCarrier map[string][]byte
+
+var _ opentracing.TextMapWriter = headerCarrier(nil)
+var _ opentracing.TextMapReader = headerCarrier(nil)
+
+// StartSpan starts a span as a child of the span carried by the context, or as the root of
+// a new trace when the context carries none, and returns it along with a context carrying it
+func StartSpan(ctx context.Context, tracer opentracing.Tracer, operationName string) (opentracing.Span, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if tracer == nil {
+		tracer = opentracing.NoopTracer{}
+	}
+	return opentracing.StartSpanFromContextWithTracer(ctx, tracer, operationName)
+}
+
+// StartSpanFromHeader starts a span continuing the trace written into the workflow header,
+// the span carried by the context takes precedence over the one of the header
+func StartSpanFromHeader(
+	ctx context.Context,
+	tracer opentracing.Tracer,
+	operationName string,
+	header *shared.Header,
+) (opentracing.Span, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if tracer == nil {
+		tracer = opentracing.NoopTracer{}
+	}
+	if opentracing.SpanFromContext(ctx) == nil {
+		if parent := ExtractHeader(tracer, header); parent != nil {
+			span := tracer.StartSpan(operationName, opentracing.FollowsFrom(parent))
+			return span, opentracing.ContextWithSpan(ctx, span)
+		}
+	}
+	return StartSpan(ctx, tracer, operationName)
+}
+
+// FinishSpan finishes the span, it is tagged as failed when the
+// error returned by the traced operation is not nil
+func FinishSpan(span opentracing.Span, err *error) {
+	if err != nil && *err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(*err))
+	}
+	span.Finish()
+}
+
+// InjectHeader writes the context of the span into the fields of the workflow header, so
+// that the trace is continued by the workers and the workflows started with the header.
+// The header is returned unchanged when the tracer does not record spans
+func InjectHeader(span opentracing.Span, header *shared.Header) *shared.Header {
+	carrier := make(headerCarrier)
+	if err := span.Tracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil || len(carrier) == 0 {
+		return header
+	}
+	result := &shared.Header{Fields: make(map[string][]byte)}
+	if header != nil {
+		for key, value := range header.Fields {
+			result.Fields[key] = value
+		}
+	}
+	for key, value := range carrier {
+		result.Fields[key] = value
+	}
+	return result
+}
+
+// ExtractHeader reads the span context written into the workflow header,
+// it returns nil when the header does not carry one
+func ExtractHeader(tracer opentracing.Tracer, header *shared.Header) opentracing.SpanContext {
+	if header == nil || len(header.Fields) == 0 {
+		return nil
+	}
+	spanContext, err := tracer.Extract(opentracing.TextMap, headerCarrier(header.Fields))
+	if err != nil {
+		return nil
+	}
+	return spanContext
+}
+
+// Set implements opentracing.TextMapWriter
+func (c headerCarrier) Set(key, value string) {
+	c[key] = []byte(value)
+}
+
+// ForeachKey implements opentracing.TextMapReader
+func (c headerCarrier) ForeachKey(handler func(key, value string) error) error {
+	for key, value := range c {
+		if err := handler(key, string(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
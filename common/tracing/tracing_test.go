@@ -0,0 +1,199 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/jaeger-client-go"
+)
+
+type (
+	tracingSuite struct {
+		suite.Suite
+		tracer *mocktracer.MockTracer
+	}
+)
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(tracingSuite))
+}
+
+func (s *tracingSuite) SetupTest() {
+	s.tracer = mocktracer.New()
+}
+
+func (s *tracingSuite) TestStartSpan_ChildOfContext() {
+	parent, ctx := StartSpan(nil, s.tracer, "parent")
+	child, childCtx := StartSpan(ctx, s.tracer, "child")
+	s.Equal(child, opentracing.SpanFromContext(childCtx))
+	FinishSpan(child, nil)
+	FinishSpan(parent, nil)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 2)
+	s.Equal("child", spans[0].OperationName)
+	s.Equal("parent", spans[1].OperationName)
+	s.Equal(spans[1].SpanContext.TraceID, spans[0].SpanContext.TraceID)
+	s.Equal(spans[1].SpanContext.SpanID, spans[0].ParentID)
+	s.Equal(0, spans[1].ParentID)
+}
+
+func (s *tracingSuite) TestStartSpan_NilTracer() {
+	span, ctx := StartSpan(context.Background(), nil, "noop")
+	s.NotNil(span)
+	s.NotNil(ctx)
+	FinishSpan(span, nil)
+}
+
+func (s *tracingSuite) TestFinishSpan_Error() {
+	span, _ := StartSpan(nil, s.tracer, "failed")
+	err := errors.New("some error")
+	FinishSpan(span, &err)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	s.Equal(true, spans[0].Tag("error"))
+	s.Len(spans[0].Logs(), 1)
+}
+
+func (s *tracingSuite) TestFinishSpan_NoError() {
+	span, _ := StartSpan(nil, s.tracer, "succeeded")
+	var err error
+	FinishSpan(span, &err)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	s.Nil(spans[0].Tag("error"))
+	s.Empty(spans[0].Logs())
+}
+
+func (s *tracingSuite) TestHeaderRoundTrip() {
+	header := &shared.Header{Fields: map[string][]byte{"key": []byte("value")}}
+	span, _ := StartSpan(nil, s.tracer, "start")
+	injected := InjectHeader(span, header)
+	FinishSpan(span, nil)
+
+	s.Len(header.Fields, 1, "the header of the caller is not modified")
+	s.Equal([]byte("value"), injected.Fields["key"])
+	s.True(len(injected.Fields) > 1)
+
+	spanContext := ExtractHeader(s.tracer, injected)
+	s.NotNil(spanContext)
+	s.Equal(span.Context().(mocktracer.MockSpanContext).SpanID, spanContext.(mocktracer.MockSpanContext).SpanID)
+
+	child, _ := StartSpanFromHeader(nil, s.tracer, "child", injected)
+	FinishSpan(child, nil)
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 2)
+	s.Equal(spans[0].SpanContext.TraceID, spans[1].SpanContext.TraceID)
+	s.Equal(spans[0].SpanContext.SpanID, spans[1].ParentID)
+}
+
+func (s *tracingSuite) TestStartSpanFromHeader_ContextTakesPrecedence() {
+	headerSpan, _ := StartSpan(nil, s.tracer, "header")
+	header := InjectHeader(headerSpan, nil)
+	FinishSpan(headerSpan, nil)
+	parent, ctx := StartSpan(nil, s.tracer, "parent")
+
+	child, _ := StartSpanFromHeader(ctx, s.tracer, "child", header)
+	FinishSpan(child, nil)
+	FinishSpan(parent, nil)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 3)
+	s.Equal(spans[2].SpanContext.SpanID, spans[1].ParentID)
+}
+
+func (s *tracingSuite) TestInjectHeader_NoopTracer() {
+	header := &shared.Header{Fields: map[string][]byte{"key": []byte("value")}}
+	span, _ := StartSpan(nil, opentracing.NoopTracer{}, "noop")
+	s.Equal(header, InjectHeader(span, header))
+	s.Nil(InjectHeader(span, nil))
+	s.Nil(ExtractHeader(s.tracer, nil))
+	s.Nil(ExtractHeader(s.tracer, header))
+}
+
+func (s *tracingSuite) TestFileReporter() {
+	dir, err := ioutil.TempDir("", "tracing")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "traces.json")
+
+	reporter, err := NewFileReporter(path, "cadence-frontend")
+	s.NoError(err)
+	tracer, closer := jaeger.NewTracer("cadence-frontend", jaeger.NewConstSampler(true), reporter)
+	parent, ctx := StartSpan(nil, tracer, "frontend.StartWorkflowExecution")
+	child, _ := StartSpan(ctx, tracer, "history-client.StartWorkflowExecution")
+	FinishSpan(child, nil)
+	FinishSpan(parent, nil)
+	s.NoError(closer.Close())
+
+	file, err := os.Open(path)
+	s.NoError(err)
+	defer file.Close()
+	var records []spanRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record spanRecord
+		s.NoError(json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	s.NoError(scanner.Err())
+
+	s.Len(records, 2)
+	s.Equal("history-client.StartWorkflowExecution", records[0].OperationName)
+	s.Equal("frontend.StartWorkflowExecution", records[1].OperationName)
+	s.Equal("cadence-frontend", records[0].ServiceName)
+	s.Equal(records[1].TraceID, records[0].TraceID)
+	s.Equal(records[1].SpanID, records[0].ParentID)
+	s.Empty(records[1].ParentID)
+}
+
+func (s *tracingSuite) TestFileReporter_ReportAfterClose() {
+	dir, err := ioutil.TempDir("", "tracing")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "traces.json")
+
+	reporter, err := NewFileReporter(path, "cadence-frontend")
+	s.NoError(err)
+	tracer, closer := jaeger.NewTracer("cadence-frontend", jaeger.NewConstSampler(true), reporter)
+	s.NoError(closer.Close())
+	span, _ := StartSpan(nil, tracer, "frontend.StartWorkflowExecution")
+	FinishSpan(span, nil)
+	reporter.Close()
+
+	content, err := ioutil.ReadFile(path)
+	s.NoError(err)
+	s.Empty(content)
+}
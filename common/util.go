@@ -446,3 +446,75 @@ func IsJustOrderByClause(clause string) bool {
 	whereClause = strings.ToLower(whereClause)
 	return strings.HasPrefix(whereClause, "order by")
 }
+
+// ConvertIndexedValueTypeToThriftType converts the type of a valid search attribute read from dynamic config
+// to IndexedValueType, the types in dynamic config file are decoded as numbers
+func ConvertIndexedValueTypeToThriftType(fieldType interface{}) (workflow.IndexedValueType, bool) {
+	var valueType workflow.IndexedValueType
+	switch t := fieldType.(type) {
+	case workflow.IndexedValueType:
+		valueType = t
+	case int:
+		valueType = workflow.IndexedValueType(t)
+	case float64:
+		valueType = workflow.IndexedValueType(t)
+	default:
+		return valueType, false
+	}
+	for _, v := range workflow.IndexedValueType_Values() {
+		if v == valueType {
+			return valueType, true
+		}
+	}
+	return valueType, false
+}
+
+// DeserializeSearchAttributeValue decodes the JSON value of a search attribute as its registered type,
+// an array is decoded element by element as ES indexes every element of it
+func DeserializeSearchAttributeValue(data []byte, valueType workflow.IndexedValueType) (interface{}, error) {
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, 0, len(elements))
+		for _, element := range elements {
+			value, err := DeserializeSearchAttributeValue(element, valueType)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+
+	switch valueType {
+	case workflow.IndexedValueTypeString, workflow.IndexedValueTypeKeyword:
+		var value string
+		err := json.Unmarshal(data, &value)
+		return value, err
+	case workflow.IndexedValueTypeInt:
+		var value int64
+		err := json.Unmarshal(data, &value)
+		return value, err
+	case workflow.IndexedValueTypeDouble:
+		var value float64
+		err := json.Unmarshal(data, &value)
+		return value, err
+	case workflow.IndexedValueTypeBool:
+		var value bool
+		err := json.Unmarshal(data, &value)
+		return value, err
+	case workflow.IndexedValueTypeDatetime:
+		// ES date field takes both the formatted time and epoch millis
+		var value time.Time
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+		var millis int64
+		err := json.Unmarshal(data, &millis)
+		return millis, err
+	default:
+		return nil, fmt.Errorf("unknown search attribute type %v", valueType)
+	}
+}
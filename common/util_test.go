@@ -0,0 +1,82 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+func TestConvertIndexedValueTypeToThriftType(t *testing.T) {
+	for _, fieldType := range []interface{}{workflow.IndexedValueTypeInt, int(2), float64(2)} {
+		valueType, ok := ConvertIndexedValueTypeToThriftType(fieldType)
+		assert.True(t, ok)
+		assert.Equal(t, workflow.IndexedValueTypeInt, valueType)
+	}
+
+	_, ok := ConvertIndexedValueTypeToThriftType(100)
+	assert.False(t, ok)
+	_, ok = ConvertIndexedValueTypeToThriftType("Int")
+	assert.False(t, ok)
+}
+
+func TestDeserializeSearchAttributeValue(t *testing.T) {
+	timeVal, _ := json.Marshal(time.Unix(1547596872, 0).UTC())
+
+	value, err := DeserializeSearchAttributeValue([]byte(`"keyword"`), workflow.IndexedValueTypeKeyword)
+	assert.NoError(t, err)
+	assert.Equal(t, "keyword", value)
+	value, err = DeserializeSearchAttributeValue([]byte(`123`), workflow.IndexedValueTypeInt)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123), value)
+	value, err = DeserializeSearchAttributeValue([]byte(`1.5`), workflow.IndexedValueTypeDouble)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, value)
+	value, err = DeserializeSearchAttributeValue([]byte(`true`), workflow.IndexedValueTypeBool)
+	assert.NoError(t, err)
+	assert.Equal(t, true, value)
+	value, err = DeserializeSearchAttributeValue(timeVal, workflow.IndexedValueTypeDatetime)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1547596872, 0).UTC(), value)
+	value, err = DeserializeSearchAttributeValue([]byte(`1547596872000`), workflow.IndexedValueTypeDatetime)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1547596872000), value)
+	value, err = DeserializeSearchAttributeValue([]byte(`[1, 2]`), workflow.IndexedValueTypeInt)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, value)
+
+	_, err = DeserializeSearchAttributeValue([]byte(`"123"`), workflow.IndexedValueTypeInt)
+	assert.Error(t, err)
+	_, err = DeserializeSearchAttributeValue([]byte(`1.5`), workflow.IndexedValueTypeInt)
+	assert.Error(t, err)
+	_, err = DeserializeSearchAttributeValue([]byte(`123`), workflow.IndexedValueTypeString)
+	assert.Error(t, err)
+	_, err = DeserializeSearchAttributeValue([]byte(`"true"`), workflow.IndexedValueTypeBool)
+	assert.Error(t, err)
+	_, err = DeserializeSearchAttributeValue([]byte(`"not a time"`), workflow.IndexedValueTypeDatetime)
+	assert.Error(t, err)
+	_, err = DeserializeSearchAttributeValue([]byte(`[1, "2"]`), workflow.IndexedValueTypeInt)
+	assert.Error(t, err)
+}
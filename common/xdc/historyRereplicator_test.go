@@ -85,7 +85,7 @@ func (s *historyRereplicatorSuite) SetupTest() {
 	s.domainID = uuid.New()
 	s.domainName = "some random domain name"
 	s.targetClusterName = "some random target cluster name"
-	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, mock.Anything).Return(
 		&persistence.GetDomainResponse{
 			Info:   &persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
 			Config: &persistence.DomainConfig{Retention: 1},
@@ -0,0 +1,84 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// ToDLQReplicationTaskInfo converts the replication task of a workflow to the task info stored in the replication DLQ,
+// only tasks carrying the source task ID can be put into the DLQ, since it is the key of the DLQ
+func ToDLQReplicationTaskInfo(task *replicator.ReplicationTask) (*persistence.ReplicationTaskInfo, bool) {
+	if task.SourceTaskId == nil {
+		return nil, false
+	}
+
+	taskInfo := &persistence.ReplicationTaskInfo{
+		TaskID:       task.GetSourceTaskId(),
+		FirstEventID: common.EmptyEventID,
+		NextEventID:  common.EmptyEventID,
+		ScheduledID:  common.EmptyEventID,
+	}
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		attr := task.HistoryTaskAttributes
+		taskInfo.TaskType = persistence.ReplicationTaskTypeHistory
+		taskInfo.DomainID = attr.GetDomainId()
+		taskInfo.WorkflowID = attr.GetWorkflowId()
+		taskInfo.RunID = attr.GetRunId()
+		taskInfo.FirstEventID = attr.GetFirstEventId()
+		taskInfo.NextEventID = attr.GetNextEventId()
+		taskInfo.Version = attr.GetVersion()
+		taskInfo.EventStoreVersion = attr.GetEventStoreVersion()
+		taskInfo.NewRunEventStoreVersion = attr.GetNewRunEventStoreVersion()
+		taskInfo.ResetWorkflow = attr.GetResetWorkflow()
+		taskInfo.LastReplicationInfo = make(map[string]*persistence.ReplicationInfo, len(attr.ReplicationInfo))
+		for k, v := range attr.ReplicationInfo {
+			taskInfo.LastReplicationInfo[k] = &persistence.ReplicationInfo{
+				Version:     v.GetVersion(),
+				LastEventID: v.GetLastEventId(),
+			}
+		}
+	case replicator.ReplicationTaskTypeHistoryMetadata:
+		attr := task.HistoryMetadataTaskAttributes
+		taskInfo.TaskType = persistence.ReplicationTaskTypeHistory
+		taskInfo.DomainID = attr.GetDomainId()
+		taskInfo.WorkflowID = attr.GetWorkflowId()
+		taskInfo.RunID = attr.GetRunId()
+		taskInfo.FirstEventID = attr.GetFirstEventId()
+		taskInfo.NextEventID = attr.GetNextEventId()
+		taskInfo.LastReplicationInfo = map[string]*persistence.ReplicationInfo{}
+	case replicator.ReplicationTaskTypeSyncActivity:
+		attr := task.SyncActicvityTaskAttributes
+		taskInfo.TaskType = persistence.ReplicationTaskTypeSyncActivity
+		taskInfo.DomainID = attr.GetDomainId()
+		taskInfo.WorkflowID = attr.GetWorkflowId()
+		taskInfo.RunID = attr.GetRunId()
+		taskInfo.Version = attr.GetVersion()
+		taskInfo.ScheduledID = attr.GetScheduledId()
+		taskInfo.LastReplicationInfo = map[string]*persistence.ReplicationInfo{}
+	default:
+		return nil, false
+	}
+	return taskInfo, true
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	replicationDLQSuite struct {
+		suite.Suite
+	}
+)
+
+func TestReplicationDLQSuite(t *testing.T) {
+	s := new(replicationDLQSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationDLQSuite) TestToDLQReplicationTaskInfo_History() {
+	task := &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		SourceTaskId: common.Int64Ptr(1234),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			DomainId:     common.StringPtr("some random domain ID"),
+			WorkflowId:   common.StringPtr("some random workflow ID"),
+			RunId:        common.StringPtr("some random run ID"),
+			FirstEventId: common.Int64Ptr(11),
+			NextEventId:  common.Int64Ptr(15),
+			Version:      common.Int64Ptr(144),
+		},
+	}
+
+	taskInfo, ok := ToDLQReplicationTaskInfo(task)
+	s.True(ok)
+	s.Equal(int64(1234), taskInfo.TaskID)
+	s.Equal(persistence.ReplicationTaskTypeHistory, taskInfo.TaskType)
+	s.Equal("some random domain ID", taskInfo.DomainID)
+	s.Equal("some random workflow ID", taskInfo.WorkflowID)
+	s.Equal("some random run ID", taskInfo.RunID)
+	s.Equal(int64(11), taskInfo.FirstEventID)
+	s.Equal(int64(15), taskInfo.NextEventID)
+	s.Equal(int64(144), taskInfo.Version)
+	s.Equal(common.EmptyEventID, taskInfo.ScheduledID)
+}
+
+func (s *replicationDLQSuite) TestToDLQReplicationTaskInfo_HistoryMetadata() {
+	task := &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistoryMetadata),
+		SourceTaskId: common.Int64Ptr(1234),
+		HistoryMetadataTaskAttributes: &replicator.HistoryMetadataTaskAttributes{
+			DomainId:     common.StringPtr("some random domain ID"),
+			WorkflowId:   common.StringPtr("some random workflow ID"),
+			RunId:        common.StringPtr("some random run ID"),
+			FirstEventId: common.Int64Ptr(11),
+			NextEventId:  common.Int64Ptr(15),
+		},
+	}
+
+	taskInfo, ok := ToDLQReplicationTaskInfo(task)
+	s.True(ok)
+	s.Equal(int64(1234), taskInfo.TaskID)
+	s.Equal(persistence.ReplicationTaskTypeHistory, taskInfo.TaskType)
+	s.Equal("some random workflow ID", taskInfo.WorkflowID)
+	s.Equal(int64(11), taskInfo.FirstEventID)
+	s.Equal(int64(15), taskInfo.NextEventID)
+	s.Equal(common.EmptyEventID, taskInfo.ScheduledID)
+}
+
+func (s *replicationDLQSuite) TestToDLQReplicationTaskInfo_SyncActivity() {
+	task := &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SourceTaskId: common.Int64Ptr(1234),
+		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
+			DomainId:    common.StringPtr("some random domain ID"),
+			WorkflowId:  common.StringPtr("some random workflow ID"),
+			RunId:       common.StringPtr("some random run ID"),
+			Version:     common.Int64Ptr(144),
+			ScheduledId: common.Int64Ptr(21),
+		},
+	}
+
+	taskInfo, ok := ToDLQReplicationTaskInfo(task)
+	s.True(ok)
+	s.Equal(persistence.ReplicationTaskTypeSyncActivity, taskInfo.TaskType)
+	s.Equal(int64(21), taskInfo.ScheduledID)
+	s.Equal(common.EmptyEventID, taskInfo.FirstEventID)
+	s.Equal(common.EmptyEventID, taskInfo.NextEventID)
+}
+
+func (s *replicationDLQSuite) TestToDLQReplicationTaskInfo_NoSourceTaskID() {
+	task := &replicator.ReplicationTask{
+		TaskType:              replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{},
+	}
+
+	_, ok := ToDLQReplicationTaskInfo(task)
+	s.False(ok)
+}
+
+func (s *replicationDLQSuite) TestToDLQReplicationTaskInfo_DomainTask() {
+	task := &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeDomain),
+		SourceTaskId: common.Int64Ptr(1234),
+	}
+
+	_, ok := ToDLQReplicationTaskInfo(task)
+	s.False(ok)
+}
@@ -69,6 +69,13 @@ setup_es_template() {
     server=`echo $ES_SEEDS | awk -F ',' '{print $1}'`
     URL="http://$server:$ES_PORT/_template/cadence-visibility-template"
     curl -X PUT $URL -H 'Content-Type: application/json' --data-binary "@$SCHEMA_FILE"
+
+    # ES_DOMAIN_INDICES is a comma separated list of dedicated domain indices
+    for index in `echo $ES_DOMAIN_INDICES | tr ',' ' '`; do
+        URL="http://$server:$ES_PORT/_template/$index-template"
+        sed "s/\"cadence-visibility-\*\"/\"$index\"/" $SCHEMA_FILE | \
+            curl -X PUT $URL -H 'Content-Type: application/json' --data-binary @-
+    done
 }
 
 setup_schema() {
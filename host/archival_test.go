@@ -22,6 +22,7 @@ package host
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"strconv"
@@ -136,7 +137,7 @@ func (s *integrationSuite) isHistoryDeleted(domainID string, execution *workflow
 			PageSize:     1,
 		}
 		for i := 0; i < retryLimit; i++ {
-			_, err := s.testCluster.testBase.HistoryMgr.GetWorkflowExecutionHistory(request)
+			_, err := s.testCluster.testBase.HistoryMgr.GetWorkflowExecutionHistory(context.Background(), request)
 			if _, ok := err.(*workflow.EntityNotExistsError); ok {
 				return true
 			}
@@ -151,7 +152,7 @@ func (s *integrationSuite) isHistoryDeleted(domainID string, execution *workflow
 		ShardID: common.IntPtr(shardID),
 	}
 	for i := 0; i < retryLimit; i++ {
-		resp, err := s.testCluster.testBase.HistoryV2Mgr.GetHistoryTree(request)
+		resp, err := s.testCluster.testBase.HistoryV2Mgr.GetHistoryTree(context.Background(), request)
 		s.Nil(err)
 		if len(resp.Branches) == 0 && len(resp.ForkingInProgressBranches) == 0 {
 			return true
@@ -168,7 +169,7 @@ func (s *integrationSuite) isMutableStateDeleted(domainID string, execution *wor
 	}
 
 	for i := 0; i < retryLimit; i++ {
-		_, err := s.testCluster.testBase.ExecutionManager.GetWorkflowExecution(request)
+		_, err := s.testCluster.testBase.ExecutionManager.GetWorkflowExecution(context.Background(), request)
 		if _, ok := err.(*workflow.EntityNotExistsError); ok {
 			return true
 		}
@@ -192,7 +193,7 @@ func (s *integrationSuite) isMultiBlobHistory(domain, domainID string, execution
 		}
 		resp := &persistence.GetWorkflowExecutionHistoryResponse{}
 		for historySize == 0 || len(resp.NextPageToken) != 0 {
-			resp, err = s.testCluster.testBase.HistoryMgr.GetWorkflowExecutionHistory(req)
+			resp, err = s.testCluster.testBase.HistoryMgr.GetWorkflowExecutionHistory(context.Background(), req)
 			s.Nil(err)
 			historySize += resp.Size
 			req.NextPageToken = resp.NextPageToken
@@ -225,7 +226,7 @@ func (s *integrationSuite) isMultiBlobHistory(domain, domainID string, execution
 	}
 	var nextPageToken []byte
 	for historySize == 0 || len(nextPageToken) != 0 {
-		_, pageSize, nextPageToken, err = persistence.ReadFullPageV2Events(s.testCluster.testBase.HistoryV2Mgr, req)
+		_, pageSize, nextPageToken, err = persistence.ReadFullPageV2Events(context.Background(), s.testCluster.testBase.HistoryV2Mgr, req)
 		s.Nil(err)
 		historySize += pageSize
 		req.NextPageToken = nextPageToken
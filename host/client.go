@@ -23,8 +23,10 @@ package host
 import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
 )
 
 // AdminClient is the interface exposed by admin service client
@@ -46,3 +48,8 @@ func NewAdminClient(d *yarpc.Dispatcher) AdminClient {
 func NewFrontendClient(d *yarpc.Dispatcher) FrontendClient {
 	return workflowserviceclient.New(d.ClientConfig(common.FrontendServiceName))
 }
+
+// NewFrontendGRPCClient creates a client to cadence frontend which calls it over gRPC
+func NewFrontendGRPCClient(clientConfig transport.ClientConfig) FrontendClient {
+	return frontend.NewGRPCClient(clientConfig)
+}
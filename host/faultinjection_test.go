@@ -0,0 +1,303 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"flag"
+	"strconv"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	faultInjectionMaxAttempts   = 20
+	faultInjectionMaxIterations = 100
+)
+
+type faultInjectionIntegrationSuite struct {
+	// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+	// not merely log an error
+	*require.Assertions
+	IntegrationBase
+}
+
+// This cluster injects persistence errors and latency while a test is running
+func (s *faultInjectionIntegrationSuite) SetupSuite() {
+	s.setupSuite("testdata/integration_faultinjection_cluster.yaml")
+}
+
+func (s *faultInjectionIntegrationSuite) TearDownSuite() {
+	s.tearDownSuite()
+}
+
+func (s *faultInjectionIntegrationSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	if s.testCluster == nil {
+		s.T().Skip("fault injection requires a test cluster")
+	}
+	s.testCluster.SetFaultInjectionEnabled(true)
+}
+
+func (s *faultInjectionIntegrationSuite) TearDownTest() {
+	if s.testCluster != nil {
+		s.testCluster.SetFaultInjectionEnabled(false)
+	}
+}
+
+func TestFaultInjectionIntegrationSuite(t *testing.T) {
+	flag.Parse()
+	suite.Run(t, new(faultInjectionIntegrationSuite))
+}
+
+func (s *faultInjectionIntegrationSuite) TestActivityWorkflowUnderFaults() {
+	id := "integration-fault-injection-activity-test"
+	wt := "integration-fault-injection-activity-test-type"
+	tl := "integration-fault-injection-activity-test-tasklist"
+	identity := "worker1"
+	activityName := "activity_type1"
+	activityCount := 5
+
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	we := s.startWorkflow(id, wt, taskList, identity)
+
+	// decisions are derived from history only, so that a decision or activity lost to an
+	// injected error is simply retried after it times out
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
+		scheduled, completed, closed := 0, 0, 0
+		for _, event := range history.Events {
+			switch event.GetEventType() {
+			case workflow.EventTypeActivityTaskScheduled:
+				scheduled++
+			case workflow.EventTypeActivityTaskCompleted:
+				completed++
+				closed++
+			case workflow.EventTypeActivityTaskFailed, workflow.EventTypeActivityTaskTimedOut:
+				closed++
+			}
+		}
+
+		if completed >= activityCount {
+			return nil, []*workflow.Decision{{
+				DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+				CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+					Result: []byte("Done."),
+				},
+			}}, nil
+		}
+		if scheduled > closed {
+			return nil, []*workflow.Decision{}, nil
+		}
+		return nil, []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeScheduleActivityTask),
+			ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+				ActivityId:                    common.StringPtr(strconv.Itoa(scheduled + 1)),
+				ActivityType:                  &workflow.ActivityType{Name: common.StringPtr(activityName)},
+				TaskList:                      taskList,
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(20),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(2),
+			},
+		}}, nil
+	}
+
+	atHandler := func(execution *workflow.WorkflowExecution, activityType *workflow.ActivityType,
+		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
+		s.Equal(id, execution.GetWorkflowId())
+		s.Equal(activityName, activityType.GetName())
+		return []byte("Activity Result."), false, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		ActivityHandler: atHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	for i := 0; i < faultInjectionMaxIterations; i++ {
+		response, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr(s.domainName),
+			Execution: we,
+		})
+		if err != nil {
+			s.Logger.Info("DescribeWorkflowExecution", tag.Error(err))
+			continue
+		}
+		if response.WorkflowExecutionInfo.CloseStatus != nil {
+			break
+		}
+
+		if len(response.PendingActivities) > 0 {
+			err = poller.PollAndProcessActivityTask(false)
+			s.Logger.Info("PollAndProcessActivityTask", tag.Error(err))
+		} else {
+			_, err = poller.PollAndProcessDecisionTask(false, false)
+			s.Logger.Info("PollAndProcessDecisionTask", tag.Error(err))
+		}
+	}
+
+	s.testCluster.SetFaultInjectionEnabled(false)
+	events := s.getHistory(s.domainName, we)
+	completed := 0
+	for _, event := range events {
+		if event.GetEventType() == workflow.EventTypeActivityTaskCompleted {
+			completed++
+		}
+	}
+	s.Equal(activityCount, completed)
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, events[len(events)-1].GetEventType())
+}
+
+func (s *faultInjectionIntegrationSuite) TestSignalWorkflowUnderFaults() {
+	id := "integration-fault-injection-signal-test"
+	wt := "integration-fault-injection-signal-test-type"
+	tl := "integration-fault-injection-signal-test-tasklist"
+	identity := "worker1"
+	signalName := "signal_name"
+	signalCount := 3
+
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	we := s.startWorkflow(id, wt, taskList, identity)
+
+	for i := 0; i < signalCount; i++ {
+		request := &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(s.domainName),
+			WorkflowExecution: we,
+			SignalName:        common.StringPtr(signalName),
+			Input:             []byte(strconv.Itoa(i)),
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr(uuid.New()),
+		}
+		err := s.retryOnError(func() error {
+			return s.engine.SignalWorkflowExecution(createContext(), request)
+		})
+		s.NoError(err)
+	}
+
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
+		signaled := 0
+		for _, event := range history.Events {
+			if event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+				signaled++
+			}
+		}
+		if signaled < signalCount {
+			return nil, []*workflow.Decision{}, nil
+		}
+		return nil, []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+			CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}}, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	for i := 0; i < faultInjectionMaxIterations; i++ {
+		response, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr(s.domainName),
+			Execution: we,
+		})
+		if err != nil {
+			s.Logger.Info("DescribeWorkflowExecution", tag.Error(err))
+			continue
+		}
+		if response.WorkflowExecutionInfo.CloseStatus != nil {
+			break
+		}
+		_, err = poller.PollAndProcessDecisionTask(false, false)
+		s.Logger.Info("PollAndProcessDecisionTask", tag.Error(err))
+	}
+
+	s.testCluster.SetFaultInjectionEnabled(false)
+	events := s.getHistory(s.domainName, we)
+	signaled := 0
+	for _, event := range events {
+		if event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+			signaled++
+		}
+	}
+	s.Equal(signalCount, signaled)
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, events[len(events)-1].GetEventType())
+}
+
+func (s *faultInjectionIntegrationSuite) startWorkflow(id, wt string, taskList *workflow.TaskList,
+	identity string) *workflow.WorkflowExecution {
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:                            taskList,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(300),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	// retries reuse the request ID, so a start that already succeeded returns the same run
+	var runID string
+	err := s.retryOnError(func() error {
+		response, err := s.engine.StartWorkflowExecution(createContext(), request)
+		if err == nil {
+			runID = response.GetRunId()
+		}
+		return err
+	})
+	s.NoError(err)
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(runID))
+	return &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      common.StringPtr(runID),
+	}
+}
+
+func (s *faultInjectionIntegrationSuite) retryOnError(operation func() error) error {
+	var err error
+	for attempt := 0; attempt < faultInjectionMaxAttempts; attempt++ {
+		if err = operation(); err == nil {
+			return nil
+		}
+		s.Logger.Info("Retrying operation after error.", tag.Error(err))
+	}
+	return err
+}
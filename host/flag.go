@@ -26,6 +26,7 @@ import "flag"
 var TestFlags struct {
 	EnableEventsV2        bool
 	FrontendAddr          string
+	FrontendGRPCAddr      string
 	PersistenceType       string
 	TestClusterConfigFile string
 }
@@ -33,6 +34,7 @@ var TestFlags struct {
 func init() {
 	flag.BoolVar(&TestFlags.EnableEventsV2, "eventsV2", false, "run integration tests with eventsV2")
 	flag.StringVar(&TestFlags.FrontendAddr, "frontendAddress", "", "host:port for cadence frontend service")
-	flag.StringVar(&TestFlags.PersistenceType, "persistenceType", "cassandra", "type of persistence store - [cassandra or sql]")
+	flag.StringVar(&TestFlags.FrontendGRPCAddr, "frontendGRPCAddress", "", "host:port for the gRPC inbound of cadence frontend service")
+	flag.StringVar(&TestFlags.PersistenceType, "persistenceType", "cassandra", "type of persistence store - [cassandra, sql or memory]")
 	flag.StringVar(&TestFlags.TestClusterConfigFile, "TestClusterConfigFile", "", "test cluster config file location")
 }
@@ -0,0 +1,200 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pborman/uuid"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/frontend"
+)
+
+// httpCall invokes a WorkflowService API through the frontend HTTP/JSON gateway, decoding the
+// response into response on success and returning the error body otherwise
+func (s *integrationSuite) httpCall(api string, request interface{}, response interface{}) (int, *frontend.HTTPError) {
+	var body []byte
+	if request != nil {
+		var err error
+		body, err = json.Marshal(request)
+		s.NoError(err)
+	}
+
+	url := fmt.Sprintf("http://%v%v%v", s.testCluster.GetFrontendHTTPAddress(), frontend.HTTPAPIPathPrefix, api)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	s.NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(frontend.HTTPTimeoutHeaderName, "90000")
+
+	client := &http.Client{Timeout: 90 * time.Second}
+	resp, err := client.Do(req)
+	s.NoError(err)
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	s.NoError(err)
+
+	if resp.StatusCode != http.StatusOK {
+		httpErr := &frontend.HTTPError{}
+		s.NoError(json.Unmarshal(respBody, httpErr), string(respBody))
+		return resp.StatusCode, httpErr
+	}
+	if response != nil {
+		s.NoError(json.Unmarshal(respBody, response), string(respBody))
+	}
+	return resp.StatusCode, nil
+}
+
+func (s *integrationSuite) TestHTTPGateway_WorkflowLifecycle() {
+	if s.testCluster == nil {
+		s.T().Skip("HTTP gateway is only exercised against the test cluster")
+	}
+
+	id := "integration-http-gateway-workflow-test"
+	wt := "integration-http-gateway-workflow-test-type"
+	tl := "integration-http-gateway-workflow-test-tasklist"
+	identity := "http-worker"
+	input := []byte{0, 1, 2, 254, 255}
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr(identity),
+	}
+	var startResp workflow.StartWorkflowExecutionResponse
+	status, httpErr := s.httpCall("StartWorkflowExecution", request, &startResp)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+	s.NotEmpty(startResp.GetRunId())
+
+	// starting the same workflow with a different request id is a conflict
+	request.RequestId = common.StringPtr(uuid.New())
+	status, httpErr = s.httpCall("StartWorkflowExecution", request, nil)
+	s.Equal(http.StatusConflict, status)
+	s.Equal("WorkflowExecutionAlreadyStartedError", httpErr.Type)
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      startResp.RunId,
+	}
+
+	// the workflow started through the gateway is visible to the TChannel path
+	history := s.getHistory(s.domainName, execution)
+	s.Equal(workflow.EventTypeWorkflowExecutionStarted, history[0].GetEventType())
+	s.Equal(input, history[0].WorkflowExecutionStartedEventAttributes.Input)
+
+	var pollResp workflow.PollForDecisionTaskResponse
+	status, httpErr = s.httpCall("PollForDecisionTask", &workflow.PollForDecisionTaskRequest{
+		Domain:   common.StringPtr(s.domainName),
+		TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+		Identity: common.StringPtr(identity),
+	}, &pollResp)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+	s.NotEmpty(pollResp.TaskToken)
+	s.Equal(id, pollResp.WorkflowExecution.GetWorkflowId())
+	s.Equal(input, pollResp.History.Events[0].WorkflowExecutionStartedEventAttributes.Input)
+
+	status, httpErr = s.httpCall("RespondDecisionTaskCompleted", &workflow.RespondDecisionTaskCompletedRequest{
+		TaskToken: pollResp.TaskToken,
+		Identity:  common.StringPtr(identity),
+		Decisions: []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+			CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}},
+	}, nil)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+
+	var historyResp workflow.GetWorkflowExecutionHistoryResponse
+	status, httpErr = s.httpCall("GetWorkflowExecutionHistory", &workflow.GetWorkflowExecutionHistoryRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: execution,
+	}, &historyResp)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+	events := historyResp.History.Events
+	lastEvent := events[len(events)-1]
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, lastEvent.GetEventType())
+	s.Equal([]byte("Done."), lastEvent.WorkflowExecutionCompletedEventAttributes.Result)
+
+	var describeResp workflow.DescribeWorkflowExecutionResponse
+	status, httpErr = s.httpCall("DescribeWorkflowExecution", &workflow.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: execution,
+	}, &describeResp)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+	s.Equal(workflow.WorkflowExecutionCloseStatusCompleted, describeResp.WorkflowExecutionInfo.GetCloseStatus())
+}
+
+func (s *integrationSuite) TestHTTPGateway_Errors() {
+	if s.testCluster == nil {
+		s.T().Skip("HTTP gateway is only exercised against the test cluster")
+	}
+
+	status, httpErr := s.httpCall("DescribeDomain", &workflow.DescribeDomainRequest{
+		Name: common.StringPtr("integration-http-gateway-unknown-domain"),
+	}, nil)
+	s.Equal(http.StatusNotFound, status)
+	s.Equal("EntityNotExistsError", httpErr.Type)
+
+	status, httpErr = s.httpCall("SignalWorkflowExecution", &workflow.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}, nil)
+	s.Equal(http.StatusBadRequest, status)
+	s.Equal("BadRequestError", httpErr.Type)
+
+	status, httpErr = s.httpCall("NotAnAPI", nil, nil)
+	s.Equal(http.StatusNotFound, status)
+	s.NotNil(httpErr)
+
+	resp, err := http.Get(fmt.Sprintf("http://%v%v", s.testCluster.GetFrontendHTTPAddress(), frontend.HTTPHealthPath))
+	s.NoError(err)
+	resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *integrationSuite) TestHTTPGateway_DomainAndSearchAttributes() {
+	if s.testCluster == nil {
+		s.T().Skip("HTTP gateway is only exercised against the test cluster")
+	}
+
+	var describeResp workflow.DescribeDomainResponse
+	status, httpErr := s.httpCall("DescribeDomain", &workflow.DescribeDomainRequest{
+		Name: common.StringPtr(s.domainName),
+	}, &describeResp)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+	s.Equal(s.domainName, describeResp.DomainInfo.GetName())
+
+	var searchAttrResp workflow.GetSearchAttributesResponse
+	status, httpErr = s.httpCall("GetSearchAttributes", nil, &searchAttrResp)
+	s.Equal(http.StatusOK, status, "%v", httpErr)
+	s.NotEmpty(searchAttrResp.Keys)
+}
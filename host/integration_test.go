@@ -69,6 +69,13 @@ func TestIntegrationSuite(t *testing.T) {
 	suite.Run(t, new(integrationSuite))
 }
 
+func TestIntegrationSuiteGRPC(t *testing.T) {
+	flag.Parse()
+	s := new(integrationSuite)
+	s.useGRPC = true
+	suite.Run(t, s)
+}
+
 func (s *integrationSuite) TestStartWorkflowExecution() {
 	id := "integration-start-workflow-test"
 	wt := "integration-start-workflow-test-type"
@@ -38,6 +38,8 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/environment"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
@@ -56,6 +58,8 @@ type (
 		domainName         string
 		foreignDomainName  string
 		archivalDomainName string
+		// useGRPC makes the suite call the frontend over gRPC instead of TChannel
+		useGRPC bool
 	}
 )
 
@@ -70,11 +74,21 @@ func (s *IntegrationBase) setupSuite(defaultClusterConfigFile string) {
 		s.Logger.Info("Running integration test against specified frontend", tag.Address(TestFlags.FrontendAddr))
 		channel, err := tchannel.NewChannelTransport(tchannel.ServiceName("cadence-frontend"))
 		s.Require().NoError(err)
+		outbounds := yarpc.Outbounds{
+			"cadence-frontend": {Unary: channel.NewSingleOutbound(TestFlags.FrontendAddr)},
+		}
+		if s.useGRPC {
+			if TestFlags.FrontendGRPCAddr == "" {
+				s.T().Skip("frontendGRPCAddress is required to run the gRPC suite against a frontend")
+			}
+			outbounds[frontendGRPCOutbound] = transport.Outbounds{
+				ServiceName: "cadence-frontend",
+				Unary:       grpc.NewTransport().NewSingleOutbound(TestFlags.FrontendGRPCAddr),
+			}
+		}
 		dispatcher := yarpc.NewDispatcher(yarpc.Config{
-			Name: "unittest",
-			Outbounds: yarpc.Outbounds{
-				"cadence-frontend": {Unary: channel.NewSingleOutbound(TestFlags.FrontendAddr)},
-			},
+			Name:      "unittest",
+			Outbounds: outbounds,
 			InboundMiddleware: yarpc.InboundMiddleware{
 				Unary: &versionMiddleware{},
 			},
@@ -84,6 +98,9 @@ func (s *IntegrationBase) setupSuite(defaultClusterConfigFile string) {
 		}
 
 		s.engine = NewFrontendClient(dispatcher)
+		if s.useGRPC {
+			s.engine = NewFrontendGRPCClient(dispatcher.ClientConfig(frontendGRPCOutbound))
+		}
 		s.adminClient = NewAdminClient(dispatcher)
 	} else {
 		s.Logger.Info("Running integration test against test cluster")
@@ -91,6 +108,9 @@ func (s *IntegrationBase) setupSuite(defaultClusterConfigFile string) {
 		s.Require().NoError(err)
 		s.testCluster = cluster
 		s.engine = s.testCluster.GetFrontendClient()
+		if s.useGRPC {
+			s.engine = s.testCluster.GetFrontendGRPCClient()
+		}
 		s.adminClient = s.testCluster.GetAdminClient()
 	}
 
@@ -236,7 +256,7 @@ func (s *IntegrationBase) registerArchivalDomain() error {
 		IsGlobalDomain:  false,
 		FailoverVersion: 0,
 	}
-	response, err := s.testCluster.testBase.MetadataProxy.CreateDomain(domainRequest)
+	response, err := s.testCluster.testBase.MetadataProxy.CreateDomain(context.Background(), domainRequest)
 
 	s.Logger.Info("Register domain succeeded",
 		tag.WorkflowDomainName(s.archivalDomainName),
@@ -23,6 +23,7 @@ package host
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/pborman/uuid"
@@ -31,9 +32,11 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/elasticsearch"
@@ -57,10 +60,17 @@ import (
 	cwsc "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
-const archivalBlobSize = 5 * 1024 // 5KB
+const (
+	archivalBlobSize = 5 * 1024 // 5KB
+
+	// frontendGRPCOutbound is the outbound of the frontend dispatcher which calls
+	// the frontend over gRPC, for integration tests to generate a gRPC client
+	frontendGRPCOutbound = "cadence-frontend-grpc"
+)
 
 // Cadence hosts all of cadence services in one process
 type Cadence interface {
@@ -69,6 +79,9 @@ type Cadence interface {
 	GetAdminClient() adminserviceclient.Interface
 	GetFrontendClient() workflowserviceclient.Interface
 	FrontendAddress() string
+	FrontendHTTPAddress() string
+	FrontendGRPCAddress() string
+	GetFrontendGRPCClient() workflowserviceclient.Interface
 	GetFrontendService() service.Service
 }
 
@@ -76,6 +89,7 @@ type (
 	cadenceImpl struct {
 		adminHandler        *frontend.AdminHandler
 		frontendHandler     *frontend.WorkflowHandler
+		frontendHTTPHandler *frontend.HTTPHandler
 		matchingHandler     *matching.Handler
 		historyHandlers     []*history.Handler
 		logger              log.Logger
@@ -216,6 +230,7 @@ func (c *cadenceImpl) Stop() {
 	} else {
 		c.shutdownWG.Add(3)
 	}
+	c.frontendHTTPHandler.Stop()
 	c.frontendHandler.Stop()
 	c.adminHandler.Stop()
 	for _, historyHandler := range c.historyHandlers {
@@ -247,6 +262,36 @@ func (c *cadenceImpl) FrontendAddress() string {
 	}
 }
 
+func (c *cadenceImpl) FrontendHTTPAddress() string {
+	switch c.clusterNo {
+	case 0:
+		return "127.0.0.1:7110"
+	case 1:
+		return "127.0.0.1:8110"
+	case 2:
+		return "127.0.0.1:9110"
+	case 3:
+		return "127.0.0.1:10110"
+	default:
+		return "127.0.0.1:7110"
+	}
+}
+
+func (c *cadenceImpl) FrontendGRPCAddress() string {
+	switch c.clusterNo {
+	case 0:
+		return "127.0.0.1:7111"
+	case 1:
+		return "127.0.0.1:8111"
+	case 2:
+		return "127.0.0.1:9111"
+	case 3:
+		return "127.0.0.1:10111"
+	default:
+		return "127.0.0.1:7111"
+	}
+}
+
 func (c *cadenceImpl) FrontendPProfPort() int {
 	switch c.clusterNo {
 	case 0:
@@ -378,6 +423,10 @@ func (c *cadenceImpl) GetFrontendClient() workflowserviceclient.Interface {
 	return NewFrontendClient(c.frontEndService.GetDispatcher())
 }
 
+func (c *cadenceImpl) GetFrontendGRPCClient() workflowserviceclient.Interface {
+	return NewFrontendGRPCClient(c.frontEndService.GetDispatcher().ClientConfig(frontendGRPCOutbound))
+}
+
 // For integration tests to get hold of FE instance.
 func (c *cadenceImpl) GetFrontendService() service.Service {
 	return c.frontEndService
@@ -390,7 +439,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.FrontendPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.logger)
+	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.FrontendGRPCAddress(), c.logger)
 	params.MetricScope = tally.NewTestScope(common.FrontendServiceName, make(map[string]string))
 	params.MembershipFactory = newMembershipFactory(params.Name, hosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -400,6 +449,8 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.PersistenceConfig = c.persistenceConfig
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger))
 	params.DynamicConfig = newIntegrationConfigClient(dynamicconfig.NewNopClient())
+	params.ESConfig = c.esConfig
+	params.ESClient = c.esClient
 
 	// TODO when cross DC is public, remove this temporary override
 	var kafkaProducer messaging.Producer
@@ -416,17 +467,22 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 
 	c.frontEndService = service.New(params)
 
+	dc := dynamicconfig.NewCollection(params.DynamicConfig, c.logger)
+	frontendConfig := frontend.NewConfig(dc, c.historyConfig.NumHistoryShards, c.workerConfig.EnableIndexer)
+
 	c.adminHandler = frontend.NewAdminHandler(
-		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr)
+		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr,
+		params, frontendConfig)
 	c.adminHandler.RegisterHandler()
 
-	dc := dynamicconfig.NewCollection(params.DynamicConfig, c.logger)
-	frontendConfig := frontend.NewConfig(dc, c.historyConfig.NumHistoryShards, c.workerConfig.EnableIndexer)
 	c.frontendHandler = frontend.NewWorkflowHandler(
 		c.frontEndService, frontendConfig, c.metadataMgr, c.historyMgr, c.historyV2Mgr,
-		c.visibilityMgr, kafkaProducer, params.BlobstoreClient)
+		c.visibilityMgr, kafkaProducer, params.BlobstoreClient,
+		payload.NewOffloader(c.blobstoreClient, frontendConfig.GetPayloadOffloadConfig()))
 	dcRedirectionHandler := frontend.NewDCRedirectionHandler(c.frontendHandler, params.DCRedirectionPolicy)
 	dcRedirectionHandler.RegisterHandler()
+	c.frontEndService.GetDispatcher().Register(
+		apiv1.BuildWorkflowServiceYARPCProcedures(frontend.NewGRPCHandler(dcRedirectionHandler)))
 
 	// must start base service first
 	c.frontEndService.Start()
@@ -438,6 +494,11 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	if err != nil {
 		c.logger.Fatal("Failed to start frontend", tag.Error(err))
 	}
+	c.frontendHTTPHandler = frontend.NewHTTPHandler(dcRedirectionHandler, c.FrontendHTTPAddress(), c.logger)
+	err = c.frontendHTTPHandler.Start()
+	if err != nil {
+		c.logger.Fatal("Failed to start frontend HTTP gateway", tag.Error(err))
+	}
 
 	startWG.Done()
 	<-c.shutdownCh
@@ -453,7 +514,7 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 		params.Logger = c.logger
 		params.ThrottledLogger = c.logger
 		params.PProfInitializer = newPProfInitializerImpl(c.logger, pprofPorts[i])
-		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, hostport, c.logger)
+		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, hostport, "", c.logger)
 		params.MetricScope = tally.NewTestScope(common.HistoryServiceName, make(map[string]string))
 		params.MembershipFactory = newMembershipFactory(params.Name, hosts)
 		params.ClusterMetadata = c.clusterMetadata
@@ -481,7 +542,8 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 			historyConfig.HistoryCountLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(hConfig.HistoryCountLimitError)
 		}
 		handler := history.NewHandler(service, historyConfig, c.shardMgr, c.metadataMgr,
-			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, params.PublicClient)
+			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, params.PublicClient,
+			payload.NewOffloader(c.blobstoreClient, historyConfig.GetPayloadOffloadConfig()))
 		handler.RegisterHandler()
 
 		service.Start()
@@ -504,7 +566,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]string, startWG *sync.Wai
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.MatchingPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingServiceAddress(), c.logger)
+	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingServiceAddress(), "", c.logger)
 	params.MetricScope = tally.NewTestScope(common.MatchingServiceName, make(map[string]string))
 	params.MembershipFactory = newMembershipFactory(params.Name, hosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -536,7 +598,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.WorkerPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.WorkerServiceName, c.WorkerServiceAddress(), c.logger)
+	params.RPCFactory = newRPCFactoryImpl(common.WorkerServiceName, c.WorkerServiceAddress(), "", c.logger)
 	params.MetricScope = tally.NewTestScope(common.WorkerServiceName, make(map[string]string))
 	params.MembershipFactory = newMembershipFactory(params.Name, hosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -555,7 +617,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 
 	var replicatorDomainCache cache.DomainCache
 	if c.workerConfig.EnableReplicator {
-		metadataManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgrV2, service.GetMetricsClient(), c.logger)
+		metadataManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgrV2, service.GetMetricsClient(), c.logger, service.GetTracer())
 		replicatorDomainCache = cache.NewDomainCache(metadataManager, params.ClusterMetadata, service.GetMetricsClient(), service.GetLogger())
 		replicatorDomainCache.Start()
 		c.startWorkerReplicator(params, service, replicatorDomainCache)
@@ -563,14 +625,18 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 
 	var clientWorkerDomainCache cache.DomainCache
 	if c.workerConfig.EnableArchiver {
-		metadataProxyManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgr, service.GetMetricsClient(), c.logger)
+		metadataProxyManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgr, service.GetMetricsClient(), c.logger, service.GetTracer())
 		clientWorkerDomainCache = cache.NewDomainCache(metadataProxyManager, params.ClusterMetadata, service.GetMetricsClient(), service.GetLogger())
 		clientWorkerDomainCache.Start()
 		c.startWorkerClientWorker(params, service, clientWorkerDomainCache)
 	}
 
+	var indexerDomainCache cache.DomainCache
 	if c.workerConfig.EnableIndexer {
-		c.startWorkerIndexer(params, service)
+		metadataProxyManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgr, service.GetMetricsClient(), c.logger, service.GetTracer())
+		indexerDomainCache = cache.NewDomainCache(metadataProxyManager, params.ClusterMetadata, service.GetMetricsClient(), service.GetLogger())
+		indexerDomainCache.Start()
+		c.startWorkerIndexer(params, service, indexerDomainCache)
 	}
 
 	startWG.Done()
@@ -581,11 +647,14 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	if c.workerConfig.EnableArchiver {
 		clientWorkerDomainCache.Stop()
 	}
+	if c.workerConfig.EnableIndexer {
+		indexerDomainCache.Stop()
+	}
 	c.shutdownWG.Done()
 }
 
 func (c *cadenceImpl) startWorkerReplicator(params *service.BootstrapParams, service service.Service, domainCache cache.DomainCache) {
-	metadataManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgrV2, service.GetMetricsClient(), c.logger)
+	metadataManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgrV2, service.GetMetricsClient(), c.logger, service.GetTracer())
 	workerConfig := worker.NewConfig(params)
 	workerConfig.ReplicationCfg.ReplicatorMessageConcurrency = dynamicconfig.GetIntPropertyFn(10)
 	c.replicator = replicator.NewReplicator(
@@ -595,6 +664,8 @@ func (c *cadenceImpl) startWorkerReplicator(params *service.BootstrapParams, ser
 		service.GetClientBean(),
 		workerConfig.ReplicationCfg,
 		c.messagingClient,
+		c.executionMgrFactory,
+		c.historyConfig.NumHistoryShards,
 		c.logger,
 		service.GetMetricsClient())
 	if err := c.replicator.Start(); err != nil {
@@ -629,12 +700,13 @@ func (c *cadenceImpl) startWorkerClientWorker(params *service.BootstrapParams, s
 	}
 }
 
-func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, service service.Service) {
+func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, service service.Service, domainCache cache.DomainCache) {
 	workerConfig := worker.NewConfig(params)
 	c.indexer = indexer.NewIndexer(
 		workerConfig.IndexerCfg,
 		c.messagingClient,
-		c.esClient,
+		elasticsearch.NewStaticRouter(c.esClient, c.esConfig.Indices[common.VisibilityAppName]),
+		domainCache,
 		c.esConfig,
 		c.logger,
 		service.GetMetricsClient())
@@ -648,7 +720,7 @@ func (c *cadenceImpl) createSystemDomain() error {
 	if c.metadataMgrV2 == nil {
 		return nil
 	}
-	_, err := c.metadataMgrV2.CreateDomain(&persistence.CreateDomainRequest{
+	_, err := c.metadataMgrV2.CreateDomain(context.Background(), &persistence.CreateDomainRequest{
 		Info: &persistence.DomainInfo{
 			ID:          uuid.New(),
 			Name:        "cadence-system",
@@ -691,17 +763,21 @@ func newPProfInitializerImpl(logger log.Logger, port int) common.PProfInitialize
 }
 
 type rpcFactoryImpl struct {
-	ch          *tchannel.ChannelTransport
-	serviceName string
-	hostPort    string
-	logger      log.Logger
+	ch           *tchannel.ChannelTransport
+	serviceName  string
+	hostPort     string
+	grpcHostPort string
+	logger       log.Logger
 }
 
-func newRPCFactoryImpl(sName string, hostPort string, logger log.Logger) common.RPCFactory {
+// newRPCFactoryImpl creates the rpc factory of a onebox service, the service
+// also accepts gRPC calls on grpcHostPort when it is not empty
+func newRPCFactoryImpl(sName string, hostPort string, grpcHostPort string, logger log.Logger) common.RPCFactory {
 	return &rpcFactoryImpl{
-		serviceName: sName,
-		hostPort:    hostPort,
-		logger:      logger,
+		serviceName:  sName,
+		hostPort:     hostPort,
+		grpcHostPort: grpcHostPort,
+		logger:       logger,
 	}
 }
 
@@ -713,13 +789,27 @@ func (c *rpcFactoryImpl) CreateDispatcher() *yarpc.Dispatcher {
 	if err != nil {
 		c.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
+	inbounds := yarpc.Inbounds{c.ch.NewInbound()}
+	// For integration tests to generate client out of the same outbound.
+	outbounds := yarpc.Outbounds{
+		c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
+	}
+	if c.grpcHostPort != "" {
+		listener, err := net.Listen("tcp", c.grpcHostPort)
+		if err != nil {
+			c.logger.Fatal("Failed to listen on gRPC address", tag.Error(err))
+		}
+		grpcTransport := grpc.NewTransport()
+		inbounds = append(inbounds, grpcTransport.NewInbound(listener))
+		outbounds[frontendGRPCOutbound] = transport.Outbounds{
+			ServiceName: c.serviceName,
+			Unary:       grpcTransport.NewSingleOutbound(c.grpcHostPort),
+		}
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
-		Name:     c.serviceName,
-		Inbounds: yarpc.Inbounds{c.ch.NewInbound()},
-		// For integration tests to generate client out of the same outbound.
-		Outbounds: yarpc.Outbounds{
-			c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
-		},
+		Name:      c.serviceName,
+		Inbounds:  inbounds,
+		Outbounds: outbounds,
 		InboundMiddleware: yarpc.InboundMiddleware{
 			Unary: &versionMiddleware{},
 		},
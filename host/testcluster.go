@@ -23,6 +23,7 @@ package host
 import (
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/uber/cadence/common/definition"
 
@@ -41,15 +42,17 @@ import (
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
 type (
 	// TestCluster is a base struct for integration tests
 	TestCluster struct {
-		testBase  persistencetests.TestBase
-		blobstore *BlobstoreBase
-		host      Cadence
+		testBase              persistencetests.TestBase
+		blobstore             *BlobstoreBase
+		host                  Cadence
+		faultInjectionEnabled *atomic.Bool
 	}
 
 	// TestClusterConfig are config for a test cluster
@@ -65,6 +68,7 @@ type (
 		HistoryConfig         *HistoryConfig
 		ESConfig              elasticsearch.Config
 		WorkerConfig          *WorkerConfig
+		FaultInjection        *FaultInjectionConfig
 	}
 
 	// MessagingClientConfig is the config for messaging config
@@ -79,6 +83,13 @@ type (
 		EnableIndexer    bool
 		EnableReplicator bool
 	}
+
+	// FaultInjectionConfig is the config for injecting persistence faults, faults are only
+	// injected while enabled through SetFaultInjectionEnabled
+	FaultInjectionConfig struct {
+		ErrorRate  float64
+		MaxLatency time.Duration
+	}
 )
 
 const defaultTestValueOfESIndexMaxResultWindow = 5
@@ -107,6 +118,19 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 
 	options.Persistence.StoreType = TestFlags.PersistenceType
 	options.Persistence.ClusterMetadata = clusterMetadata
+	faultInjectionEnabled := atomic.NewBool(false)
+	if options.FaultInjection != nil {
+		options.Persistence.FaultInjection = &config.FaultInjectionConfig{
+			Enabled: func(opts ...dynamicconfig.FilterOption) bool {
+				return faultInjectionEnabled.Load()
+			},
+			ErrorRate:        dynamicconfig.GetFloatPropertyFn(options.FaultInjection.ErrorRate),
+			MethodErrorRates: dynamicconfig.GetMapPropertyFn(nil),
+			ErrorTypes:       dynamicconfig.GetMapPropertyFn(nil),
+			MaxLatency:       dynamicconfig.GetDurationPropertyFn(options.FaultInjection.MaxLatency),
+			Domains:          dynamicconfig.GetMapPropertyFn(nil),
+		}
+	}
 	testBase := persistencetests.NewTestBase(&options.Persistence)
 	testBase.Setup()
 	setupShards(testBase, options.HistoryConfig.NumHistoryShards, logger)
@@ -131,8 +155,8 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			ESIndexMaxResultWindow: dynamicconfig.GetIntPropertyFn(defaultTestValueOfESIndexMaxResultWindow),
 			ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 		}
-		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, indexName, visProducer, visConfig, logger)
-		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger)
+		esVisibilityStore := pes.NewElasticSearchVisibilityStore(elasticsearch.NewStaticRouter(esClient, indexName), visProducer, visConfig, logger)
+		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger, nil)
 	}
 	visibilityMgr := persistence.NewVisibilityManagerWrapper(testBase.VisibilityMgr, esVisibilityMgr,
 		dynamicconfig.GetBoolPropertyFnFilteredByDomain(options.WorkerConfig.EnableIndexer))
@@ -166,7 +190,12 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		return nil, err
 	}
 
-	return &TestCluster{testBase: testBase, blobstore: blobstore, host: cluster}, nil
+	return &TestCluster{
+		testBase:              testBase,
+		blobstore:             blobstore,
+		host:                  cluster,
+		faultInjectionEnabled: faultInjectionEnabled,
+	}, nil
 }
 
 func setupShards(testBase persistencetests.TestBase, numHistoryShards int, logger log.Logger) {
@@ -217,11 +246,27 @@ func (tc *TestCluster) TearDownCluster() {
 	os.RemoveAll(tc.blobstore.storeDirectory)
 }
 
+// SetFaultInjectionEnabled turns persistence fault injection on or off, it has no effect
+// unless the cluster was created with a fault injection config
+func (tc *TestCluster) SetFaultInjectionEnabled(enabled bool) {
+	tc.faultInjectionEnabled.Store(enabled)
+}
+
 // GetFrontendClient returns a frontend client from the test cluster
 func (tc *TestCluster) GetFrontendClient() FrontendClient {
 	return tc.host.GetFrontendClient()
 }
 
+// GetFrontendGRPCClient returns a frontend client from the test cluster which calls it over gRPC
+func (tc *TestCluster) GetFrontendGRPCClient() FrontendClient {
+	return tc.host.GetFrontendGRPCClient()
+}
+
+// GetFrontendHTTPAddress returns the address of the frontend HTTP/JSON gateway of the test cluster
+func (tc *TestCluster) GetFrontendHTTPAddress() string {
+	return tc.host.FrontendHTTPAddress()
+}
+
 // GetAdminClient returns an admin client from the test cluster
 func (tc *TestCluster) GetAdminClient() AdminClient {
 	return tc.host.GetAdminClient()
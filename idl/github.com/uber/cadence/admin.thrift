@@ -21,6 +21,8 @@
 namespace java com.uber.cadence.admin
 
 include "shared.thrift"
+include "history.thrift"
+include "replicator.thrift"
 
 /**
 * AdminService provides advanced APIs for debugging and analysis with admin privillege
@@ -58,6 +60,102 @@ service AdminService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * VerifyMutableState rebuilds the mutable state of a workflow execution from its history and returns the
+  * differences against the stored mutable state. If repair is set, the stored mutable state is overwritten
+  * with the rebuilt one.
+  **/
+  VerifyMutableStateResponse VerifyMutableState(1: VerifyMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.AccessDeniedError       accessDeniedError,
+    )
+
+  /**
+  * GetReplicationMessages is called by history hosts of a remote cluster to pull the replication tasks
+  * of their shards from this cluster
+  **/
+  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.LimitExceededError      limitExceededError,
+      4: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * ReadDLQMessages returns the replication tasks from the given source cluster which failed to apply on
+  * the given shard and are kept in the replication dead letter queue
+  **/
+  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+      4: shared.EntityNotExistsError    entityNotExistError,
+    )
+
+  /**
+  * PurgeDLQMessages deletes the replication tasks up to and including the given message ID
+  * from the replication dead letter queue
+  **/
+  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+      4: shared.EntityNotExistsError    entityNotExistError,
+    )
+
+  /**
+  * MergeDLQMessages re-applies the replication tasks in the replication dead letter queue by
+  * resending their history from the source cluster, and deletes the tasks which are applied
+  **/
+  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+      4: shared.EntityNotExistsError    entityNotExistError,
+    )
+
+  /**
+  * DescribeReplicationStatus returns the replication backlog of this cluster towards the target cluster,
+  * by shard and by domain. The backlog is unknown, and BadRequestError is returned, when the replication
+  * tasks are published to kafka rather than pulled by the target cluster
+  **/
+  replicator.DescribeReplicationStatusResponse DescribeReplicationStatus(1: replicator.DescribeReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+      4: shared.EntityNotExistsError    entityNotExistError,
+    )
+
+  /**
+  * FailoverDomains fails over a batch of global domains, selected either by name or by their
+  * domain data, to the target cluster and returns the result of each failover
+  **/
+  FailoverDomainsResponse FailoverDomains(1: FailoverDomainsRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
+
+  /**
+  * AddSearchAttribute registers new search attributes, it adds them to the ElasticSearch
+  * visibility index, which every service reads the valid search attributes from
+  **/
+  void AddSearchAttribute(1: AddSearchAttributeRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.ServiceBusyError        serviceBusyError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -86,4 +184,42 @@ struct GetWorkflowExecutionRawHistoryResponse {
   20: optional list<shared.DataBlob> historyBatches
   30: optional map<string, shared.ReplicationInfo> replicationInfo
   40: optional i32 eventStoreVersion
-}
\ No newline at end of file
+}
+
+struct VerifyMutableStateRequest {
+  10: optional string                       domain
+  20: optional shared.WorkflowExecution     execution
+  30: optional bool                         repair
+}
+
+struct VerifyMutableStateResponse {
+  10: optional list<history.MutableStateDifference> differences
+  20: optional bool                                 repaired
+}
+
+struct FailoverDomainsRequest {
+  10: optional list<string> domains
+  // selects the domains whose data contains all of the given key-value pairs, when no domains are given
+  20: optional map<string,string> domainDataFilter
+  30: optional string targetCluster
+  40: optional i32 concurrency
+  50: optional string operator
+  60: optional string reason
+  70: optional string securityToken
+}
+
+struct FailoverDomainResult {
+  10: optional string domain
+  20: optional string fromCluster
+  30: optional bool succeeded
+  40: optional string error
+}
+
+struct FailoverDomainsResponse {
+  10: optional list<FailoverDomainResult> results
+}
+
+struct AddSearchAttributeRequest {
+  10: optional map<string, shared.IndexedValueType> searchAttribute
+  20: optional string securityToken
+}
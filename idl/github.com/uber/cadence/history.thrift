@@ -19,6 +19,7 @@
 // THE SOFTWARE.
 
 include "shared.thrift"
+include "replicator.thrift"
 
 namespace java com.uber.cadence.history
 
@@ -61,6 +62,25 @@ struct DescribeMutableStateResponse{
   40: optional string mutableStateInDatabase
 }
 
+struct MutableStateDifference {
+  10: optional string category
+  20: optional string key
+  30: optional string field
+  40: optional string storedValue
+  50: optional string rebuiltValue
+}
+
+struct VerifyMutableStateRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional bool repair
+}
+
+struct VerifyMutableStateResponse {
+  10: optional list<MutableStateDifference> differences
+  20: optional bool repaired
+}
+
 struct GetMutableStateRequest {
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
@@ -679,6 +699,21 @@ service HistoryService {
       6: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * VerifyMutableState rebuilds the mutable state of a workflow execution from its history and returns the
+  * differences against the stored mutable state. If repair is set, the stored mutable state is overwritten
+  * with the rebuilt one.
+  **/
+  VerifyMutableStateResponse VerifyMutableState(1: VerifyMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * DescribeHistoryHost returns information about the internal states of a history host
   **/
@@ -688,4 +723,66 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * GetReplicationMessages returns new replication tasks of the requested shards since the read level
+  * given in their tokens, and acknowledges the tasks processed by the requesting cluster
+  **/
+  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.LimitExceededError limitExceededError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReadDLQMessages returns the replication tasks from the given source cluster which failed to apply on
+  * the given shard and are kept in the replication dead letter queue
+  **/
+  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * PurgeDLQMessages deletes the replication tasks up to and including the given message ID
+  * from the replication dead letter queue
+  **/
+  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * MergeDLQMessages re-applies the replication tasks in the replication dead letter queue by
+  * resending their history from the source cluster, and deletes the tasks which are applied
+  **/
+  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DescribeReplicationStatus returns the replication backlog of the requested shards towards the target cluster
+  **/
+  replicator.DescribeReplicationStatusResponse DescribeReplicationStatus(1: replicator.DescribeReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
 }
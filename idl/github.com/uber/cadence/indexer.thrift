@@ -49,4 +49,13 @@ struct Message {
   40: optional string runID
   50: optional i64 (js.type = "Long") version
   60: optional map<string,Field> fields
-}
\ No newline at end of file
+}
+// DLQMessage is a visibility message ElasticSearch rejected permanently, with the reason of the rejection
+struct DLQMessage {
+  10: optional Message message
+  20: optional string index
+  30: optional i32 status
+  40: optional string errorType
+  50: optional string errorReason
+  60: optional i64 (js.type = "Long") timestamp
+}
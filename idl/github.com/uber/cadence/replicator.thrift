@@ -21,7 +21,6 @@
 namespace java com.uber.cadence.replicator
 
 include "shared.thrift"
-include "history.thrift"
 
 enum ReplicationTaskType {
   Domain
@@ -98,5 +97,128 @@ struct ReplicationTask {
   40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes
   50: optional SyncActicvityTaskAttributes syncActicvityTaskAttributes
   60: optional HistoryMetadataTaskAttributes historyMetadataTaskAttributes
+  // sourceTaskId is the id of the task on the source cluster shard, it is used as the key of the replication DLQ
+  70: optional i64 (js.type = "Long") sourceTaskId
+  // creationTime is when the task was created on the source cluster, it is used to measure the replication lag
+  80: optional i64 (js.type = "Long") creationTime
 }
 
+struct ReplicationToken {
+  10: optional i32 shardID
+  // lastRetrievedMessageId is where the next fetch should begin with
+  20: optional i64 (js.type = "Long") lastRetrievedMessageId
+  // lastProcessedMessageId is the last messageId that is processed on the receiving side,
+  // tasks up to and including it can be cleaned up on the sending side
+  30: optional i64 (js.type = "Long") lastProcessedMessageId
+}
+
+struct SyncShardStatus {
+  10: optional i64 (js.type = "Long") timestamp
+}
+
+struct ReplicationMessages {
+  10: optional list<ReplicationTask> replicationTasks
+  // This can be different than the last taskId in the above list, because sender can decide to skip tasks
+  20: optional i64 (js.type = "Long") lastRetrievedMessageId
+  30: optional bool hasMore // Hint for flow control
+  40: optional SyncShardStatus syncShardStatus
+}
+
+struct GetReplicationMessagesRequest {
+  10: optional list<ReplicationToken> tokens
+  20: optional string clusterName
+}
+
+struct GetReplicationMessagesResponse {
+  10: optional map<i32, ReplicationMessages> messagesByShard
+}
+
+struct ReplicationTaskInfo {
+  10: optional string domainID
+  20: optional string workflowID
+  30: optional string runID
+  40: optional ReplicationTaskType taskType
+  50: optional i64 (js.type = "Long") taskID
+  60: optional i64 (js.type = "Long") version
+  70: optional i64 (js.type = "Long") firstEventID
+  80: optional i64 (js.type = "Long") nextEventID
+  90: optional i64 (js.type = "Long") scheduledID
+}
+
+struct ReadDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardID
+  30: optional i64 (js.type = "Long") inclusiveEndMessageID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+  // domain, workflowID and runID are optional filters on the messages
+  60: optional string domain
+  70: optional string workflowID
+  80: optional string runID
+}
+
+struct ReadDLQMessagesResponse {
+  10: optional list<ReplicationTaskInfo> replicationTasks
+  20: optional binary nextPageToken
+}
+
+struct PurgeDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardID
+  30: optional i64 (js.type = "Long") inclusiveEndMessageID
+  // domain, workflowID and runID are optional filters on the messages
+  40: optional string domain
+  50: optional string workflowID
+  60: optional string runID
+}
+
+struct MergeDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardID
+  30: optional i64 (js.type = "Long") inclusiveEndMessageID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+  // domain, workflowID and runID are optional filters on the messages
+  60: optional string domain
+  70: optional string workflowID
+  80: optional string runID
+}
+
+struct MergeDLQMessagesResponse {
+  10: optional binary nextPageToken
+}
+
+struct DescribeReplicationStatusRequest {
+  10: optional string targetCluster
+  // shardIDs limits the status to the given shards, all shards are described if not set
+  20: optional list<i32> shardIDs
+  // domain limits the pending task count to the given domain
+  30: optional string domain
+}
+
+struct ShardReplicationStatus {
+  10: optional i32 shardID
+  // ackLevel is the last task ID replicated to the target cluster
+  20: optional i64 (js.type = "Long") ackLevel
+  // maxTaskID is the max task ID allocated by the shard, task IDs are shared with other tasks
+  // of the shard, so maxTaskID - ackLevel is the upper bound of the replication backlog
+  30: optional i64 (js.type = "Long") maxTaskID
+  // pendingTasks is the number of replication tasks after the ack level
+  40: optional i64 (js.type = "Long") pendingTasks
+  // truncated is set if not all pending tasks are counted
+  50: optional bool truncated
+}
+
+struct DomainReplicationStatus {
+  10: optional string domain
+  20: optional i64 (js.type = "Long") pendingTasks
+}
+
+struct DescribeReplicationStatusResponse {
+  10: optional list<ShardReplicationStatus> shards
+  20: optional list<DomainReplicationStatus> domains
+  // pendingTasks is the number of replication tasks counted on all shards
+  30: optional i64 (js.type = "Long") pendingTasks
+  // truncated is set if not all pending tasks are counted on some shard, the counts are lower bounds then
+  40: optional bool truncated
+}
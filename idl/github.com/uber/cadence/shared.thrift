@@ -963,6 +963,15 @@ struct DescribeDomainResponse {
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional list<FailoverEvent> failoverHistory
+}
+
+struct FailoverEvent {
+  10: optional i64 (js.type = "Long") failoverTimeNano
+  20: optional string fromCluster
+  30: optional string toCluster
+  40: optional string operator
+  50: optional string reason
 }
 
 struct UpdateDomainRequest {
@@ -972,6 +981,14 @@ struct UpdateDomainRequest {
  40: optional DomainReplicationConfiguration replicationConfiguration
  50: optional string securityToken
  60: optional string deleteBadBinary
+ // when set together with replicationConfiguration.activeClusterName, the domain is not
+ // failed over but stops accepting new workflows for the given time, so that the
+ // replication backlog towards the target cluster can drain before the failover,
+ // a zero timeout ends a pending drain
+ 70: optional i32 failoverDrainTimeoutInSeconds
+ // recorded in the domain failover history when the request fails over the domain
+ 80: optional string failoverOperator
+ 90: optional string failoverReason
 }
 
 struct UpdateDomainResponse {
@@ -32,6 +32,7 @@ struct ShardInfo {
   34: optional map<string, i64> clusterTransferAckLevel
   36: optional map<string, i64> clusterTimerAckLevel
   38: optional string owner
+  40: optional map<string, i64> clusterReplicationLevel
 }
 
 struct DomainInfo {
@@ -52,6 +53,7 @@ struct DomainInfo {
   38: optional map<string, string> data
   39: optional binary badBinaries
   40: optional string badBinariesEncoding
+  42: optional i64 (js.type = "Long") failoverDrainEndTime
 }
 
 struct HistoryTreeInfo {
@@ -122,6 +124,9 @@ struct WorkflowExecutionInfo {
   115: optional binary autoResetPoints
   116: optional string autoResetPointsEncoding
   118: optional map<string, binary> searchAttributes
+  120: optional i32 checksumVersion
+  122: optional i32 checksumFlavor
+  124: optional binary checksum
 }
 
 struct ActivityInfo {
@@ -106,6 +106,30 @@ CREATE TABLE replication_tasks (
   PRIMARY KEY (shard_id, task_id)
 );
 
+CREATE TABLE replication_tasks_dlq (
+  source_cluster_name VARCHAR(255) NOT NULL,
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (source_cluster_name, shard_id, task_id)
+);
+
+CREATE TABLE cluster_membership (
+  host_id VARCHAR(255) NOT NULL,
+  --
+  rpc_address VARCHAR(255) NOT NULL,
+  role VARCHAR(255) NOT NULL,
+  session_start DATETIME(6) NOT NULL,
+  last_heartbeat DATETIME(6) NOT NULL,
+  record_expiry DATETIME(6) NOT NULL,
+  PRIMARY KEY (host_id)
+);
+
+CREATE INDEX cm_role_expiry_idx ON cluster_membership (role, record_expiry);
+CREATE INDEX cm_expiry_idx ON cluster_membership (record_expiry);
+
 CREATE TABLE timer_tasks (
   shard_id INT NOT NULL,
   visibility_timestamp DATETIME(6) NOT NULL,
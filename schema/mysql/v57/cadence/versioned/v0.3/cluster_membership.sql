@@ -0,0 +1,13 @@
+CREATE TABLE cluster_membership (
+  host_id VARCHAR(255) NOT NULL,
+  --
+  rpc_address VARCHAR(255) NOT NULL,
+  role VARCHAR(255) NOT NULL,
+  session_start DATETIME(6) NOT NULL,
+  last_heartbeat DATETIME(6) NOT NULL,
+  record_expiry DATETIME(6) NOT NULL,
+  PRIMARY KEY (host_id)
+);
+
+CREATE INDEX cm_role_expiry_idx ON cluster_membership (role, record_expiry);
+CREATE INDEX cm_expiry_idx ON cluster_membership (record_expiry);
@@ -22,6 +22,9 @@ package frontend
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -33,20 +36,32 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/tracing"
 	historyService "github.com/uber/cadence/service/history"
 )
 
 var _ adminserviceserver.Interface = (*AdminHandler)(nil)
 
+const (
+	defaultFailoverDomainsConcurrency = 10
+	maxFailoverDomainsConcurrency     = 100
+)
+
+// searchAttributeKeyRegexp is the valid name of custom search attributes, which are also field names in ES mapping
+var searchAttributeKeyRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
 type (
 	// AdminHandler - Thrift handler inteface for admin service
 	AdminHandler struct {
@@ -58,6 +73,8 @@ type (
 		metricsClient metrics.Client
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
+		params        *service.BootstrapParams
+		config        *Config
 		startWG       sync.WaitGroup
 	}
 )
@@ -65,7 +82,8 @@ type (
 // NewAdminHandler creates a thrift handler for the cadence admin service
 func NewAdminHandler(
 	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager,
-	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager) *AdminHandler {
+	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	params *service.BootstrapParams, config *Config) *AdminHandler {
 	handler := &AdminHandler{
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: numberOfHistoryShards,
@@ -73,6 +91,8 @@ func NewAdminHandler(
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
+		params:                params,
+		config:                config,
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -109,6 +129,8 @@ func (adh *AdminHandler) Stop() {
 
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest) (resp *admin.DescribeWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.DescribeWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 	scope := metrics.AdminDescribeWorkflowExecutionScope
 	if request == nil {
@@ -148,6 +170,8 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.DescribeHistoryHostRequest) (resp *gen.DescribeHistoryHostResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.DescribeHistoryHost")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 	scope := metrics.AdminDescribeHistoryHostScope
 	if request == nil || (request.ShardIdForHost == nil && request.ExecutionForHost == nil && request.HostAddress == nil) {
@@ -164,9 +188,433 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 	return resp, err
 }
 
+// VerifyMutableState rebuilds the mutable state of the specified workflow execution from its history
+// and returns the differences against the stored mutable state, optionally repairing the stored one.
+func (adh *AdminHandler) VerifyMutableState(ctx context.Context, request *admin.VerifyMutableStateRequest) (resp *admin.VerifyMutableStateResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.VerifyMutableState")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminVerifyMutableStateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp2, err := adh.history.VerifyMutableState(ctx, &hist.VerifyMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+		Repair:     request.Repair,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.VerifyMutableStateResponse{
+		Differences: resp2.Differences,
+		Repaired:    resp2.Repaired,
+	}, nil
+}
+
+// GetReplicationMessages returns new replication tasks of the requested shards since the read levels
+// in the request tokens, it is polled by remote clusters to pull replication tasks from this cluster.
+func (adh *AdminHandler) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+) (resp *replicator.GetReplicationMessagesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.GetReplicationMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminGetReplicationMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+
+	resp, err := adh.history.GetReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReadDLQMessages reads replication tasks from the persistence-backed dead letter queue of a shard
+func (adh *AdminHandler) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+) (resp *replicator.ReadDLQMessagesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.ReadDLQMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminReadDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetSourceCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if request.ShardID == nil {
+		return nil, adh.error(errShardIDNotSet, scope)
+	}
+
+	resp, err := adh.history.ReadDLQMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PurgeDLQMessages deletes replication tasks from the persistence-backed dead letter queue of a shard
+func (adh *AdminHandler) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.PurgeDLQMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminPurgeDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetSourceCluster() == "" {
+		return adh.error(errClusterNameNotSet, scope)
+	}
+	if request.ShardID == nil {
+		return adh.error(errShardIDNotSet, scope)
+	}
+
+	if err := adh.history.PurgeDLQMessages(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// MergeDLQMessages re-applies replication tasks from the persistence-backed dead letter queue of a shard
+func (adh *AdminHandler) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+) (resp *replicator.MergeDLQMessagesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.MergeDLQMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminMergeDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetSourceCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if request.ShardID == nil {
+		return nil, adh.error(errShardIDNotSet, scope)
+	}
+
+	resp, err := adh.history.MergeDLQMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeReplicationStatus returns the replication backlog of this cluster towards the target cluster
+func (adh *AdminHandler) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+) (resp *replicator.DescribeReplicationStatusResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.DescribeReplicationStatus")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeReplicationStatusScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTargetCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+
+	shardIDs := request.ShardIDs
+	if len(shardIDs) == 0 {
+		shardIDs = make([]int32, adh.numberOfHistoryShards)
+		for i := range shardIDs {
+			shardIDs[i] = int32(i)
+		}
+	}
+	for _, shardID := range shardIDs {
+		if shardID < 0 || int(shardID) >= adh.numberOfHistoryShards {
+			return nil, adh.error(errInvalidShardID, scope)
+		}
+	}
+
+	resp, err := adh.history.DescribeReplicationStatus(ctx, &replicator.DescribeReplicationStatusRequest{
+		TargetCluster: request.TargetCluster,
+		ShardIDs:      shardIDs,
+		Domain:        request.Domain,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// FailoverDomains fails over a batch of global domains to the target cluster
+func (adh *AdminHandler) FailoverDomains(
+	ctx context.Context,
+	request *admin.FailoverDomainsRequest,
+) (resp *admin.FailoverDomainsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.FailoverDomains")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminFailoverDomainsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTargetCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if info, ok := adh.GetClusterMetadata().GetAllClusterInfo()[request.GetTargetCluster()]; !ok || !info.Enabled {
+		return nil, adh.error(errInvalidTargetCluster, scope)
+	}
+	if (len(request.Domains) == 0) == (len(request.DomainDataFilter) == 0) {
+		return nil, adh.error(errFailoverDomainsNotSet, scope)
+	}
+	concurrency := defaultFailoverDomainsConcurrency
+	if request.Concurrency != nil {
+		concurrency = int(request.GetConcurrency())
+	}
+	if concurrency <= 0 || concurrency > maxFailoverDomainsConcurrency {
+		return nil, adh.error(errInvalidFailoverConcurrency, scope)
+	}
+
+	domains := request.Domains
+	if len(domains) == 0 {
+		domains = adh.getGlobalDomainsByData(request.DomainDataFilter)
+	}
+
+	results := make([]*admin.FailoverDomainResult, len(domains))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, domain := range domains {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, domain string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = adh.failoverDomain(ctx, domain, request)
+		}(i, domain)
+	}
+	wg.Wait()
+
+	return &admin.FailoverDomainsResponse{Results: results}, nil
+}
+
+// AddSearchAttribute registers the search attributes by adding them to the mapping of every visibility index
+// the ES router knows, every host reads the valid search attributes from the mapping of the default visibility
+// index so no dynamic config change is needed
+func (adh *AdminHandler) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.AddSearchAttribute")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminAddSearchAttributeScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if len(request.SearchAttribute) == 0 {
+		return adh.error(errSearchAttributesNotSet, scope)
+	}
+	if err := adh.checkPermission(request.SecurityToken); err != nil {
+		return adh.error(err, scope)
+	}
+	if adh.params.ESConfig == nil || !adh.params.ESConfig.Enable || adh.params.ESRouter == nil {
+		return adh.error(errAdvancedVisibilityNotEnabled, scope)
+	}
+
+	validAttributes := adh.config.ValidSearchAttributes()
+	for key, valueType := range request.SearchAttribute {
+		if err := validateNewSearchAttribute(key, valueType, validAttributes); err != nil {
+			return adh.error(err, scope)
+		}
+	}
+
+	defaultIndex := adh.params.ESConfig.Indices[common.VisibilityAppName]
+	for key, valueType := range request.SearchAttribute {
+		esType, _ := es.GetSearchAttributeESType(valueType)
+		if err := putSearchAttributeMapping(ctx, adh.params.ESRouter, defaultIndex, key, esType); err != nil {
+			return adh.error(&gen.InternalServiceError{
+				Message: fmt.Sprintf("Failed to add search attribute %v to ElasticSearch mapping. Error: %v", key, err),
+			}, scope)
+		}
+		adh.GetLogger().Info("Search attribute added.", tag.ESField(key), tag.Value(valueType))
+	}
+	return nil
+}
+
+// putSearchAttributeMapping adds the search attribute to the mapping of every index of every cluster. The default
+// index is updated last, the attribute becomes valid only once all indices have it and a failed request can be retried
+func putSearchAttributeMapping(ctx context.Context, router es.Router, defaultIndex, key, esType string) error {
+	clients := router.Clients()
+	for cluster, indices := range router.Indices() {
+		for _, index := range indices {
+			if cluster == es.DefaultCluster && index == defaultIndex {
+				continue
+			}
+			if err := clients[cluster].PutMapping(ctx, index, definition.Attr, key, esType); err != nil {
+				return fmt.Errorf("index %v on cluster %q: %v", index, cluster, err)
+			}
+		}
+	}
+	return clients[es.DefaultCluster].PutMapping(ctx, defaultIndex, definition.Attr, key, esType)
+}
+
+func validateNewSearchAttribute(key string, valueType gen.IndexedValueType, validAttributes map[string]interface{}) error {
+	if !searchAttributeKeyRegexp.MatchString(key) {
+		return &gen.BadRequestError{Message: fmt.Sprintf("Search attribute name %v is invalid.", key)}
+	}
+	if definition.IsSystemIndexedKey(key) || key == definition.Attr || key == definition.Memo ||
+		key == definition.Encoding || key == definition.KafkaKey {
+		return &gen.BadRequestError{Message: fmt.Sprintf("Search attribute name %v is reserved.", key)}
+	}
+	if _, ok := validAttributes[key]; ok {
+		return &gen.BadRequestError{Message: fmt.Sprintf("Search attribute %v is already registered.", key)}
+	}
+	if _, ok := es.GetSearchAttributeESType(valueType); !ok {
+		return &gen.BadRequestError{Message: fmt.Sprintf("Search attribute type %v of %v is unknown.", valueType, key)}
+	}
+	return nil
+}
+
+func (adh *AdminHandler) checkPermission(securityToken *string) error {
+	if adh.config.EnableAdminProtection() {
+		if securityToken == nil {
+			return errNoPermission
+		}
+		requiredToken := adh.config.AdminOperationToken()
+		if *securityToken != requiredToken {
+			return errNoPermission
+		}
+	}
+	return nil
+}
+
+func (adh *AdminHandler) failoverDomain(
+	ctx context.Context,
+	domain string,
+	request *admin.FailoverDomainsRequest,
+) *admin.FailoverDomainResult {
+
+	result := &admin.FailoverDomainResult{
+		Domain:    common.StringPtr(domain),
+		Succeeded: common.BoolPtr(false),
+	}
+	entry, err := adh.domainCache.GetDomain(domain)
+	if err != nil {
+		result.Error = common.StringPtr(err.Error())
+		return result
+	}
+	if !entry.IsGlobalDomain() {
+		result.Error = common.StringPtr(errNotGlobalDomain.Message)
+		return result
+	}
+	fromCluster := entry.GetReplicationConfig().ActiveClusterName
+	result.FromCluster = common.StringPtr(fromCluster)
+	// domains already active in the target cluster are left untouched, so that a batch can be retried
+	if fromCluster != request.GetTargetCluster() {
+		_, err = adh.GetClientBean().GetFrontendClient().UpdateDomain(ctx, &gen.UpdateDomainRequest{
+			Name: common.StringPtr(domain),
+			ReplicationConfiguration: &gen.DomainReplicationConfiguration{
+				ActiveClusterName: request.TargetCluster,
+			},
+			SecurityToken:    request.SecurityToken,
+			FailoverOperator: request.Operator,
+			FailoverReason:   request.Reason,
+		})
+		if err != nil {
+			adh.GetLogger().Warn("Failed to fail over domain",
+				tag.WorkflowDomainName(domain),
+				tag.ClusterName(request.GetTargetCluster()),
+				tag.Error(err),
+			)
+			result.Error = common.StringPtr(err.Error())
+			return result
+		}
+	}
+	result.Succeeded = common.BoolPtr(true)
+	return result
+}
+
+// getGlobalDomainsByData returns the names of the global domains whose data contains all of the filter entries
+func (adh *AdminHandler) getGlobalDomainsByData(filter map[string]string) []string {
+	var domains []string
+	for _, entry := range adh.domainCache.GetAllDomain() {
+		if !entry.IsGlobalDomain() {
+			continue
+		}
+		data := entry.GetInfo().Data
+		matched := true
+		for k, v := range filter {
+			if value, ok := data[k]; !ok || value != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			domains = append(domains, entry.GetInfo().Name)
+		}
+	}
+	sort.Strings(domains)
+	return domains
+}
+
 // GetWorkflowExecutionRawHistory - retrieves the history of workflow execution
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) (resp *admin.GetWorkflowExecutionRawHistoryResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, adh.GetTracer(), "admin.GetWorkflowExecutionRawHistory")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(adh.GetLogger(), &retError)
 
 	scope := metrics.AdminGetWorkflowExecutionRawHistoryScope
@@ -269,6 +717,7 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	var historyBatches []*gen.History
 	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), adh.numberOfHistoryShards)
 	_, historyBatches, token.PersistenceToken, size, err = historyService.PaginateHistory(
+		ctx,
 		adh.historyMgr,
 		adh.historyV2Mgr,
 		adh.metricsClient,
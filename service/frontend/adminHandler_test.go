@@ -0,0 +1,94 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+	es "github.com/uber/cadence/common/elasticsearch"
+	esmocks "github.com/uber/cadence/common/elasticsearch/mocks"
+)
+
+type (
+	adminHandlerSuite struct {
+		suite.Suite
+	}
+)
+
+func TestAdminHandlerSuite(t *testing.T) {
+	s := new(adminHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *adminHandlerSuite) TestValidateNewSearchAttribute() {
+	validAttributes := map[string]interface{}{
+		"CustomKeywordField": gen.IndexedValueTypeKeyword,
+	}
+
+	s.NoError(validateNewSearchAttribute("NewKey", gen.IndexedValueTypeInt, validAttributes))
+	s.NoError(validateNewSearchAttribute("new_key_2", gen.IndexedValueTypeDatetime, validAttributes))
+
+	s.Error(validateNewSearchAttribute("", gen.IndexedValueTypeInt, validAttributes))
+	s.Error(validateNewSearchAttribute("1Key", gen.IndexedValueTypeInt, validAttributes))
+	s.Error(validateNewSearchAttribute("Attr.Key", gen.IndexedValueTypeInt, validAttributes))
+	s.Error(validateNewSearchAttribute(definition.WorkflowID, gen.IndexedValueTypeKeyword, validAttributes))
+	s.Error(validateNewSearchAttribute(definition.Attr, gen.IndexedValueTypeKeyword, validAttributes))
+	s.Error(validateNewSearchAttribute("CustomKeywordField", gen.IndexedValueTypeKeyword, validAttributes))
+	s.Error(validateNewSearchAttribute("NewKey", gen.IndexedValueType(100), validAttributes))
+}
+
+func (s *adminHandlerSuite) TestPutSearchAttributeMapping() {
+	defaultClient := &esmocks.Client{}
+	tenantClient := &esmocks.Client{}
+	router := es.NewRouter(defaultClient, "test-index", map[string]es.Client{"tenant-cluster": tenantClient},
+		map[string][]string{
+			es.DefaultCluster: {"domain-index"},
+			"tenant-cluster":  {"tenant-index"},
+		}, nil, nil)
+
+	defaultClient.On("PutMapping", mock.Anything, "domain-index", definition.Attr, "NewKey", "long").Return(nil).Once()
+	tenantClient.On("PutMapping", mock.Anything, "tenant-index", definition.Attr, "NewKey", "long").Return(nil).Once()
+	defaultClient.On("PutMapping", mock.Anything, "test-index", definition.Attr, "NewKey", "long").Return(nil).Once()
+	s.NoError(putSearchAttributeMapping(context.Background(), router, "test-index", "NewKey", "long"))
+	defaultClient.AssertExpectations(s.T())
+	tenantClient.AssertExpectations(s.T())
+	// the default index is updated last
+	s.Equal("test-index", defaultClient.Calls[len(defaultClient.Calls)-1].Arguments.String(1))
+}
+
+func (s *adminHandlerSuite) TestPutSearchAttributeMapping_Failed() {
+	defaultClient := &esmocks.Client{}
+	tenantClient := &esmocks.Client{}
+	router := es.NewRouter(defaultClient, "test-index", map[string]es.Client{"tenant-cluster": tenantClient},
+		map[string][]string{"tenant-cluster": {"tenant-index"}}, nil, nil)
+
+	tenantClient.On("PutMapping", mock.Anything, "tenant-index", definition.Attr, "NewKey", "long").Return(errors.New("some error")).Once()
+	s.Error(putSearchAttributeMapping(context.Background(), router, "test-index", "NewKey", "long"))
+	tenantClient.AssertExpectations(s.T())
+	// the attribute isn't valid until the default index has it
+	defaultClient.AssertNotCalled(s.T(), "PutMapping", mock.Anything, "test-index", definition.Attr, "NewKey", "long")
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common/cache"
@@ -344,8 +345,8 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) setupLocalDomain() {
 		TableVersion:   persistence.DomainTableVersionV1,
 	}
 
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: s.domainID}).Return(domainRecord, nil)
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{ID: s.domainID}).Return(domainRecord, nil)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
 }
 
 func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithOneReplicationCluster() {
@@ -362,8 +363,8 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithOneR
 		TableVersion:   persistence.DomainTableVersionV1,
 	}
 
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: s.domainID}).Return(domainRecord, nil)
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{ID: s.domainID}).Return(domainRecord, nil)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
 }
 
 func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithTwoReplicationCluster(forwardingEnabled bool, isRecordActive bool) {
@@ -385,7 +386,7 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithTwoR
 		TableVersion:   persistence.DomainTableVersionV1,
 	}
 
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: s.domainID}).Return(domainRecord, nil)
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{ID: s.domainID}).Return(domainRecord, nil)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
 	s.mockConfig.EnableDomainNotActiveAutoForwarding = dynamicconfig.GetBoolPropertyFnFilteredByDomain(forwardingEnabled)
 }
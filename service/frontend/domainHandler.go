@@ -22,6 +22,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
@@ -77,6 +79,10 @@ func (d *domainHandlerImpl) registerDomain(ctx context.Context,
 		return err
 	}
 
+	if err := d.validateDomainData(registerRequest.Data); err != nil {
+		return err
+	}
+
 	if registerRequest.GetName() == "" {
 		return errDomainNotSet
 	}
@@ -102,7 +108,7 @@ func (d *domainHandlerImpl) registerDomain(ctx context.Context,
 	}
 
 	// first check if the name is already registered as the local domain
-	_, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: registerRequest.GetName()})
+	_, err := d.metadataMgr.GetDomain(ctx, &persistence.GetDomainRequest{Name: registerRequest.GetName()})
 	switch err.(type) {
 	case nil:
 		// domain already exists, cannot proceed
@@ -191,7 +197,7 @@ func (d *domainHandlerImpl) registerDomain(ctx context.Context,
 		FailoverVersion: failoverVersion,
 	}
 
-	domainResponse, err := d.metadataMgr.CreateDomain(domainRequest)
+	domainResponse, err := d.metadataMgr.CreateDomain(ctx, domainRequest)
 	if err != nil {
 		return err
 	}
@@ -225,7 +231,7 @@ func (d *domainHandlerImpl) listDomains(ctx context.Context,
 		pageSize = int(listRequest.GetPageSize())
 	}
 
-	resp, err := d.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+	resp, err := d.metadataMgr.ListDomains(ctx, &persistence.ListDomainsRequest{
 		PageSize:      pageSize,
 		NextPageToken: listRequest.NextPageToken,
 	})
@@ -241,6 +247,7 @@ func (d *domainHandlerImpl) listDomains(ctx context.Context,
 			FailoverVersion: common.Int64Ptr(domain.FailoverVersion),
 		}
 		desc.DomainInfo, desc.Configuration, desc.ReplicationConfiguration = d.createResponse(ctx, domain.Info, domain.Config, domain.ReplicationConfig)
+		desc.FailoverHistory = d.getFailoverHistory(domain.Info)
 		domains = append(domains, desc)
 	}
 
@@ -268,7 +275,7 @@ func (d *domainHandlerImpl) describeDomain(ctx context.Context,
 		Name: describeRequest.GetName(),
 		ID:   describeRequest.GetUUID(),
 	}
-	resp, err := d.metadataMgr.GetDomain(req)
+	resp, err := d.metadataMgr.GetDomain(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -278,6 +285,7 @@ func (d *domainHandlerImpl) describeDomain(ctx context.Context,
 		FailoverVersion: common.Int64Ptr(resp.FailoverVersion),
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(ctx, resp.Info, resp.Config, resp.ReplicationConfig)
+	response.FailoverHistory = d.getFailoverHistory(resp.Info)
 	return response, nil
 }
 
@@ -296,12 +304,12 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 	// this version can be regarded as the lock on the v2 domain table
 	// and since we do not know which table will return the domain afterwards
 	// this call has to be made
-	metadata, err := d.metadataMgr.GetMetadata()
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
 	if err != nil {
 		return nil, err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: updateRequest.GetName()})
+	getResponse, err := d.metadataMgr.GetDomain(ctx, &persistence.GetDomainRequest{Name: updateRequest.GetName()})
 	if err != nil {
 		return nil, err
 	}
@@ -316,12 +324,18 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 		}
 	}
 
+	if updateRequest.FailoverDrainTimeoutInSeconds != nil {
+		return d.drainDomainForFailover(ctx, updateRequest, getResponse, notificationVersion)
+	}
+
 	info := getResponse.Info
 	config := getResponse.Config
 	replicationConfig := getResponse.ReplicationConfig
 	configVersion := getResponse.ConfigVersion
 	failoverVersion := getResponse.FailoverVersion
 	failoverNotificationVersion := getResponse.FailoverNotificationVersion
+	failoverDrainEndTime := getResponse.FailoverDrainEndTime
+	previousActiveClusterName := replicationConfig.ActiveClusterName
 
 	currentArchivalState := &archivalState{
 		bucket: config.ArchivalBucket,
@@ -425,6 +439,9 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 			info.OwnerEmail = updatedInfo.GetOwnerEmail()
 		}
 		if updatedInfo.Data != nil {
+			if err := d.validateDomainData(updatedInfo.Data); err != nil {
+				return nil, err
+			}
 			configurationChanged = true
 			// only do merging
 			info.Data = d.mergeDomainData(info.Data, updatedInfo.Data)
@@ -494,6 +511,20 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 		if activeClusterChanged {
 			failoverVersion = d.clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, failoverVersion)
 			failoverNotificationVersion = notificationVersion
+			// a pending drain ends with the failover
+			failoverDrainEndTime = 0
+			if replicationConfig.ActiveClusterName != previousActiveClusterName {
+				info.Data, err = appendFailoverHistory(info.Data, &shared.FailoverEvent{
+					FailoverTimeNano: common.Int64Ptr(time.Now().UnixNano()),
+					FromCluster:      common.StringPtr(previousActiveClusterName),
+					ToCluster:        common.StringPtr(replicationConfig.ActiveClusterName),
+					Operator:         updateRequest.FailoverOperator,
+					Reason:           updateRequest.FailoverReason,
+				}, d.config.FailoverHistoryMaxSize(info.Name))
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		updateReq := &persistence.UpdateDomainRequest{
@@ -503,6 +534,7 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 			ConfigVersion:               configVersion,
 			FailoverVersion:             failoverVersion,
 			FailoverNotificationVersion: failoverNotificationVersion,
+			FailoverDrainEndTime:        failoverDrainEndTime,
 		}
 
 		switch getResponse.TableVersion {
@@ -515,7 +547,7 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 		default:
 			return nil, errors.New("domain table version is not set")
 		}
-		err = d.metadataMgr.UpdateDomain(updateReq)
+		err = d.metadataMgr.UpdateDomain(ctx, updateReq)
 		if err != nil {
 			return nil, err
 		}
@@ -571,12 +603,12 @@ func (d *domainHandlerImpl) deprecateDomain(ctx context.Context,
 	// this version can be regarded as the lock on the v2 domain table
 	// and since we do not know which table will return the domain afterwards
 	// this call has to be made
-	metadata, err := d.metadataMgr.GetMetadata()
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
 	if err != nil {
 		return err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: deprecateRequest.GetName()})
+	getResponse, err := d.metadataMgr.GetDomain(ctx, &persistence.GetDomainRequest{Name: deprecateRequest.GetName()})
 	if err != nil {
 		return err
 	}
@@ -584,11 +616,12 @@ func (d *domainHandlerImpl) deprecateDomain(ctx context.Context,
 	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
 	getResponse.Info.Status = persistence.DomainStatusDeprecated
 	updateReq := &persistence.UpdateDomainRequest{
-		Info:              getResponse.Info,
-		Config:            getResponse.Config,
-		ReplicationConfig: getResponse.ReplicationConfig,
-		ConfigVersion:     getResponse.ConfigVersion,
-		FailoverVersion:   getResponse.FailoverVersion,
+		Info:                 getResponse.Info,
+		Config:               getResponse.Config,
+		ReplicationConfig:    getResponse.ReplicationConfig,
+		ConfigVersion:        getResponse.ConfigVersion,
+		FailoverVersion:      getResponse.FailoverVersion,
+		FailoverDrainEndTime: getResponse.FailoverDrainEndTime,
 	}
 
 	switch getResponse.TableVersion {
@@ -602,7 +635,7 @@ func (d *domainHandlerImpl) deprecateDomain(ctx context.Context,
 	default:
 		return errors.New("domain table version is not set")
 	}
-	err = d.metadataMgr.UpdateDomain(updateReq)
+	err = d.metadataMgr.UpdateDomain(ctx, updateReq)
 	if err != nil {
 		return err
 	}
@@ -613,6 +646,86 @@ func (d *domainHandlerImpl) deprecateDomain(ctx context.Context,
 	return nil
 }
 
+// drainDomainForFailover stops the domain from accepting new workflows for the requested time, without
+// changing the active cluster. The drain is local to the current (active) cluster and is not replicated,
+// it ends either with the failover, when the drain timeout passes or when a zero drain timeout is requested.
+func (d *domainHandlerImpl) drainDomainForFailover(ctx context.Context, updateRequest *shared.UpdateDomainRequest,
+	getResponse *persistence.GetDomainResponse, notificationVersion int64) (*shared.UpdateDomainResponse, error) {
+
+	if updateRequest.UpdatedInfo != nil || updateRequest.Configuration != nil || updateRequest.DeleteBadBinary != nil {
+		return nil, errCannotDoDomainFailoverAndUpdate
+	}
+	if !getResponse.IsGlobalDomain || !isFailoverRequest(updateRequest) || len(updateRequest.ReplicationConfiguration.Clusters) != 0 {
+		return nil, errFailoverDrainNotFailover
+	}
+	if updateRequest.GetFailoverDrainTimeoutInSeconds() < 0 {
+		return nil, errInvalidFailoverDrainTimeout
+	}
+	if getResponse.TableVersion != persistence.DomainTableVersionV2 {
+		return nil, errFailoverDrainNotSupported
+	}
+
+	info := getResponse.Info
+	replicationConfig := getResponse.ReplicationConfig
+	currentCluster := d.clusterMetadata.GetCurrentClusterName()
+	if replicationConfig.ActiveClusterName != currentCluster {
+		return nil, ce.NewDomainNotActiveError(info.Name, currentCluster, replicationConfig.ActiveClusterName)
+	}
+	targetCluster := updateRequest.ReplicationConfiguration.GetActiveClusterName()
+	if targetCluster == currentCluster {
+		return nil, errDomainAlreadyActive
+	}
+	if err := d.validateClusterName(targetCluster); err != nil {
+		return nil, err
+	}
+	targetClusterInClusters := false
+	for _, cluster := range replicationConfig.Clusters {
+		if cluster.ClusterName == targetCluster {
+			targetClusterInClusters = true
+		}
+	}
+	if !targetClusterInClusters {
+		return nil, errActiveClusterNotInClusters
+	}
+
+	failoverDrainEndTime := int64(0)
+	if drainTimeout := time.Duration(updateRequest.GetFailoverDrainTimeoutInSeconds()) * time.Second; drainTimeout > 0 {
+		failoverDrainEndTime = time.Now().Add(drainTimeout).UnixNano()
+	}
+	err := d.metadataMgr.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+		Info:                        info,
+		Config:                      getResponse.Config,
+		ReplicationConfig:           replicationConfig,
+		ConfigVersion:               getResponse.ConfigVersion,
+		FailoverVersion:             getResponse.FailoverVersion,
+		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+		FailoverDrainEndTime:        failoverDrainEndTime,
+		NotificationVersion:         notificationVersion,
+		TableVersion:                persistence.DomainTableVersionV2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &shared.UpdateDomainResponse{
+		IsGlobalDomain:  common.BoolPtr(getResponse.IsGlobalDomain),
+		FailoverVersion: common.Int64Ptr(getResponse.FailoverVersion),
+	}
+	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(ctx, info, getResponse.Config, replicationConfig)
+
+	msg := "Domain draining for failover"
+	if failoverDrainEndTime == 0 {
+		msg = "Domain drain for failover ended"
+	}
+	d.logger.Info(msg,
+		tag.WorkflowDomainName(info.Name),
+		tag.WorkflowDomainID(info.ID),
+		tag.ClusterName(targetCluster),
+		tag.Timestamp(time.Unix(0, failoverDrainEndTime)),
+	)
+	return response, nil
+}
+
 func (d *domainHandlerImpl) createResponse(
 	ctx context.Context,
 	info *persistence.DomainInfo,
@@ -620,12 +733,23 @@ func (d *domainHandlerImpl) createResponse(
 	replicationConfig *persistence.DomainReplicationConfig,
 ) (*shared.DomainInfo, *shared.DomainConfiguration, *shared.DomainReplicationConfiguration) {
 
+	// the failover history is returned separately, not as part of the domain data
+	data := info.Data
+	if _, ok := data[common.DomainDataKeyForFailoverHistory]; ok {
+		data = make(map[string]string, len(info.Data))
+		for k, v := range info.Data {
+			if k != common.DomainDataKeyForFailoverHistory {
+				data[k] = v
+			}
+		}
+	}
+
 	infoResult := &shared.DomainInfo{
 		Name:        common.StringPtr(info.Name),
 		Status:      getDomainStatus(info),
 		Description: common.StringPtr(info.Description),
 		OwnerEmail:  common.StringPtr(info.OwnerEmail),
-		Data:        info.Data,
+		Data:        data,
 		UUID:        common.StringPtr(info.ID),
 	}
 
@@ -652,6 +776,18 @@ func (d *domainHandlerImpl) createResponse(
 	return infoResult, configResult, replicationConfigResult
 }
 
+func (d *domainHandlerImpl) getFailoverHistory(info *persistence.DomainInfo) []*shared.FailoverEvent {
+	history, err := decodeFailoverHistory(info.Data)
+	if err != nil {
+		d.logger.Warn("Failed to decode domain failover history",
+			tag.WorkflowDomainName(info.Name),
+			tag.WorkflowDomainID(info.ID),
+			tag.Error(err),
+		)
+	}
+	return history
+}
+
 func (d *domainHandlerImpl) mergeBadBinaries(old map[string]*shared.BadBinaryInfo, new map[string]*shared.BadBinaryInfo, createTimeNano int64) shared.BadBinaries {
 	if old == nil {
 		old = map[string]*shared.BadBinaryInfo{}
@@ -675,6 +811,13 @@ func (d *domainHandlerImpl) mergeDomainData(old map[string]string, new map[strin
 	return old
 }
 
+func (d *domainHandlerImpl) validateDomainData(data map[string]string) error {
+	if _, ok := data[common.DomainDataKeyForFailoverHistory]; ok {
+		return errDomainDataKeyReserved
+	}
+	return nil
+}
+
 func (d *domainHandlerImpl) validateClusterName(clusterName string) error {
 	if info, ok := d.clusterMetadata.GetAllClusterInfo()[clusterName]; !ok || !info.Enabled {
 		errMsg := "Invalid cluster name: %s"
@@ -729,3 +872,40 @@ func (d *domainHandlerImpl) toArchivalUpdateEvent(request *shared.UpdateDomainRe
 	}
 	return event, nil
 }
+
+// appendFailoverHistory adds the failover event to the failover history kept in the domain data,
+// newest event first, and drops the oldest events beyond maxSize
+func appendFailoverHistory(
+	data map[string]string,
+	event *shared.FailoverEvent,
+	maxSize int,
+) (map[string]string, error) {
+
+	// a corrupted history is replaced rather than failing the failover
+	history, _ := decodeFailoverHistory(data)
+	history = append([]*shared.FailoverEvent{event}, history...)
+	if len(history) > maxSize {
+		history = history[:maxSize]
+	}
+	encoded, err := json.Marshal(history)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		data = map[string]string{}
+	}
+	data[common.DomainDataKeyForFailoverHistory] = string(encoded)
+	return data, nil
+}
+
+func decodeFailoverHistory(data map[string]string) ([]*shared.FailoverEvent, error) {
+	encoded, ok := data[common.DomainDataKeyForFailoverHistory]
+	if !ok {
+		return nil, nil
+	}
+	var history []*shared.FailoverEvent
+	if err := json.Unmarshal([]byte(encoded), &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
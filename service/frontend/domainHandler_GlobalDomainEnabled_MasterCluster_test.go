@@ -707,6 +707,8 @@ func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain
 		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(s.ClusterMetadata.GetCurrentClusterName()),
 		},
+		FailoverOperator: common.StringPtr("some random operator"),
+		FailoverReason:   common.StringPtr("some random reason"),
 	})
 	s.Nil(err)
 	fnTest(updateResp.DomainInfo, updateResp.Configuration, updateResp.ReplicationConfiguration, updateResp.GetIsGlobalDomain(), updateResp.GetFailoverVersion())
@@ -716,6 +718,13 @@ func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain
 	})
 	s.Nil(err)
 	fnTest(getResp.DomainInfo, getResp.Configuration, getResp.ReplicationConfiguration, getResp.GetIsGlobalDomain(), getResp.GetFailoverVersion())
+	s.Equal(1, len(getResp.FailoverHistory))
+	event := getResp.FailoverHistory[0]
+	s.True(event.GetFailoverTimeNano() > 0)
+	s.Equal(prevActiveClusterName, event.GetFromCluster())
+	s.Equal(nextActiveClusterName, event.GetToCluster())
+	s.Equal("some random operator", event.GetOperator())
+	s.Equal("some random reason", event.GetReason())
 }
 
 func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) getRandomDomainName() string {
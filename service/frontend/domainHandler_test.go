@@ -414,6 +414,56 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_InvalidRetentionPeriod() {
 	s.Equal(errInvalidRetentionPeriod, err)
 }
 
+func (s *domainHandlerCommonSuite) TestRegisterDomain_ReservedDomainData() {
+	registerRequest := &workflow.RegisterDomainRequest{
+		Name:                                   common.StringPtr(s.getRandomDomainName()),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(int32(10)),
+		IsGlobalDomain:                         common.BoolPtr(false),
+		Data:                                   map[string]string{common.DomainDataKeyForFailoverHistory: "[]"},
+	}
+	err := s.handler.registerDomain(context.Background(), registerRequest)
+	s.Equal(errDomainDataKeyReserved, err)
+}
+
+func (s *domainHandlerCommonSuite) TestAppendFailoverHistory() {
+	newEvent := func(from, to string) *workflow.FailoverEvent {
+		return &workflow.FailoverEvent{
+			FailoverTimeNano: common.Int64Ptr(time.Now().UnixNano()),
+			FromCluster:      common.StringPtr(from),
+			ToCluster:        common.StringPtr(to),
+		}
+	}
+	event1 := newEvent("a", "b")
+	event2 := newEvent("b", "c")
+	event3 := newEvent("c", "a")
+
+	data, err := appendFailoverHistory(nil, event1, 2)
+	s.NoError(err)
+	data["k"] = "v"
+	data, err = appendFailoverHistory(data, event2, 2)
+	s.NoError(err)
+	history, err := decodeFailoverHistory(data)
+	s.NoError(err)
+	s.Equal([]*workflow.FailoverEvent{event2, event1}, history)
+
+	data, err = appendFailoverHistory(data, event3, 2)
+	s.NoError(err)
+	history, err = decodeFailoverHistory(data)
+	s.NoError(err)
+	s.Equal([]*workflow.FailoverEvent{event3, event2}, history)
+	s.Equal("v", data["k"])
+
+	// a corrupted history is replaced
+	data[common.DomainDataKeyForFailoverHistory] = "not json"
+	_, err = decodeFailoverHistory(data)
+	s.Error(err)
+	data, err = appendFailoverHistory(data, event1, 2)
+	s.NoError(err)
+	history, err = decodeFailoverHistory(data)
+	s.NoError(err)
+	s.Equal([]*workflow.FailoverEvent{event1}, history)
+}
+
 func (s *domainHandlerCommonSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
@@ -0,0 +1,456 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common/protoconv"
+)
+
+type (
+	// grpcHandler serves the protobuf mirror of the WorkflowService, the requests are converted
+	// to thrift and served by the same handler as the TChannel inbound, so both transports share
+	// its authorization, rate limiting and metrics
+	grpcHandler struct {
+		handler workflowserviceserver.Interface
+	}
+)
+
+var _ apiv1.WorkflowServiceYARPCServer = (*grpcHandler)(nil)
+
+// NewGRPCHandler creates the handler of the WorkflowService gRPC procedures, backed by the given
+// thrift handler. The procedures are registered with the dispatcher of the frontend, which
+// serves them on its gRPC inbound
+func NewGRPCHandler(handler workflowserviceserver.Interface) apiv1.WorkflowServiceYARPCServer {
+	return &grpcHandler{
+		handler: handler,
+	}
+}
+
+func (g *grpcHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.CountWorkflowExecutionsRequest,
+) (*apiv1.CountWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.CountWorkflowExecutions(ctx, protoconv.FromProtoCountWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoCountWorkflowExecutionsResponse(response), nil
+}
+
+func (g *grpcHandler) DeprecateDomain(
+	ctx context.Context,
+	request *apiv1.DeprecateDomainRequest,
+) (*apiv1.DeprecateDomainResponse, error) {
+
+	if err := g.handler.DeprecateDomain(ctx, protoconv.FromProtoDeprecateDomainRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.DeprecateDomainResponse{}, nil
+}
+
+func (g *grpcHandler) DescribeDomain(
+	ctx context.Context,
+	request *apiv1.DescribeDomainRequest,
+) (*apiv1.DescribeDomainResponse, error) {
+
+	response, err := g.handler.DescribeDomain(ctx, protoconv.FromProtoDescribeDomainRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoDescribeDomainResponse(response), nil
+}
+
+func (g *grpcHandler) DescribeTaskList(
+	ctx context.Context,
+	request *apiv1.DescribeTaskListRequest,
+) (*apiv1.DescribeTaskListResponse, error) {
+
+	response, err := g.handler.DescribeTaskList(ctx, protoconv.FromProtoDescribeTaskListRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoDescribeTaskListResponse(response), nil
+}
+
+func (g *grpcHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.DescribeWorkflowExecutionRequest,
+) (*apiv1.DescribeWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.DescribeWorkflowExecution(ctx, protoconv.FromProtoDescribeWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoDescribeWorkflowExecutionResponse(response), nil
+}
+
+func (g *grpcHandler) GetSearchAttributes(
+	ctx context.Context,
+	request *apiv1.GetSearchAttributesRequest,
+) (*apiv1.GetSearchAttributesResponse, error) {
+
+	response, err := g.handler.GetSearchAttributes(ctx)
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoGetSearchAttributesResponse(response), nil
+}
+
+func (g *grpcHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *apiv1.GetWorkflowExecutionHistoryRequest,
+) (*apiv1.GetWorkflowExecutionHistoryResponse, error) {
+
+	response, err := g.handler.GetWorkflowExecutionHistory(ctx, protoconv.FromProtoGetWorkflowExecutionHistoryRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+func (g *grpcHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListClosedWorkflowExecutionsRequest,
+) (*apiv1.ListClosedWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListClosedWorkflowExecutions(ctx, protoconv.FromProtoListClosedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoListClosedWorkflowExecutionsResponse(response), nil
+}
+
+func (g *grpcHandler) ListDomains(
+	ctx context.Context,
+	request *apiv1.ListDomainsRequest,
+) (*apiv1.ListDomainsResponse, error) {
+
+	response, err := g.handler.ListDomains(ctx, protoconv.FromProtoListDomainsRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoListDomainsResponse(response), nil
+}
+
+func (g *grpcHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListOpenWorkflowExecutionsRequest,
+) (*apiv1.ListOpenWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListOpenWorkflowExecutions(ctx, protoconv.FromProtoListOpenWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoListOpenWorkflowExecutionsResponse(response), nil
+}
+
+func (g *grpcHandler) ListWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListWorkflowExecutionsRequest,
+) (*apiv1.ListWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListWorkflowExecutions(ctx, protoconv.FromProtoListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoListWorkflowExecutionsResponse(response), nil
+}
+
+func (g *grpcHandler) PollForActivityTask(
+	ctx context.Context,
+	request *apiv1.PollForActivityTaskRequest,
+) (*apiv1.PollForActivityTaskResponse, error) {
+
+	response, err := g.handler.PollForActivityTask(ctx, protoconv.FromProtoPollForActivityTaskRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoPollForActivityTaskResponse(response), nil
+}
+
+func (g *grpcHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *apiv1.PollForDecisionTaskRequest,
+) (*apiv1.PollForDecisionTaskResponse, error) {
+
+	response, err := g.handler.PollForDecisionTask(ctx, protoconv.FromProtoPollForDecisionTaskRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoPollForDecisionTaskResponse(response), nil
+}
+
+func (g *grpcHandler) QueryWorkflow(
+	ctx context.Context,
+	request *apiv1.QueryWorkflowRequest,
+) (*apiv1.QueryWorkflowResponse, error) {
+
+	response, err := g.handler.QueryWorkflow(ctx, protoconv.FromProtoQueryWorkflowRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoQueryWorkflowResponse(response), nil
+}
+
+func (g *grpcHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *apiv1.RecordActivityTaskHeartbeatRequest,
+) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := g.handler.RecordActivityTaskHeartbeat(ctx, protoconv.FromProtoRecordActivityTaskHeartbeatRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (g *grpcHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *apiv1.RecordActivityTaskHeartbeatByIDRequest,
+) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := g.handler.RecordActivityTaskHeartbeatByID(ctx, protoconv.FromProtoRecordActivityTaskHeartbeatByIDRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (g *grpcHandler) RegisterDomain(
+	ctx context.Context,
+	request *apiv1.RegisterDomainRequest,
+) (*apiv1.RegisterDomainResponse, error) {
+
+	if err := g.handler.RegisterDomain(ctx, protoconv.FromProtoRegisterDomainRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RegisterDomainResponse{}, nil
+}
+
+func (g *grpcHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.RequestCancelWorkflowExecutionRequest,
+) (*apiv1.RequestCancelWorkflowExecutionResponse, error) {
+
+	if err := g.handler.RequestCancelWorkflowExecution(ctx, protoconv.FromProtoRequestCancelWorkflowExecutionRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+func (g *grpcHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *apiv1.ResetStickyTaskListRequest,
+) (*apiv1.ResetStickyTaskListResponse, error) {
+
+	response, err := g.handler.ResetStickyTaskList(ctx, protoconv.FromProtoResetStickyTaskListRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoResetStickyTaskListResponse(response), nil
+}
+
+func (g *grpcHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.ResetWorkflowExecutionRequest,
+) (*apiv1.ResetWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.ResetWorkflowExecution(ctx, protoconv.FromProtoResetWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoResetWorkflowExecutionResponse(response), nil
+}
+
+func (g *grpcHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCanceledRequest,
+) (*apiv1.RespondActivityTaskCanceledResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCanceled(ctx, protoconv.FromProtoRespondActivityTaskCanceledRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledResponse{}, nil
+}
+
+func (g *grpcHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCanceledByIDRequest,
+) (*apiv1.RespondActivityTaskCanceledByIDResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCanceledByID(ctx, protoconv.FromProtoRespondActivityTaskCanceledByIDRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDResponse{}, nil
+}
+
+func (g *grpcHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCompletedRequest,
+) (*apiv1.RespondActivityTaskCompletedResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCompleted(ctx, protoconv.FromProtoRespondActivityTaskCompletedRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedResponse{}, nil
+}
+
+func (g *grpcHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCompletedByIDRequest,
+) (*apiv1.RespondActivityTaskCompletedByIDResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCompletedByID(ctx, protoconv.FromProtoRespondActivityTaskCompletedByIDRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDResponse{}, nil
+}
+
+func (g *grpcHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskFailedRequest,
+) (*apiv1.RespondActivityTaskFailedResponse, error) {
+
+	if err := g.handler.RespondActivityTaskFailed(ctx, protoconv.FromProtoRespondActivityTaskFailedRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedResponse{}, nil
+}
+
+func (g *grpcHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskFailedByIDRequest,
+) (*apiv1.RespondActivityTaskFailedByIDResponse, error) {
+
+	if err := g.handler.RespondActivityTaskFailedByID(ctx, protoconv.FromProtoRespondActivityTaskFailedByIDRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedByIDResponse{}, nil
+}
+
+func (g *grpcHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondDecisionTaskCompletedRequest,
+) (*apiv1.RespondDecisionTaskCompletedResponse, error) {
+
+	response, err := g.handler.RespondDecisionTaskCompleted(ctx, protoconv.FromProtoRespondDecisionTaskCompletedRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoRespondDecisionTaskCompletedResponse(response), nil
+}
+
+func (g *grpcHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *apiv1.RespondDecisionTaskFailedRequest,
+) (*apiv1.RespondDecisionTaskFailedResponse, error) {
+
+	if err := g.handler.RespondDecisionTaskFailed(ctx, protoconv.FromProtoRespondDecisionTaskFailedRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondDecisionTaskFailedResponse{}, nil
+}
+
+func (g *grpcHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondQueryTaskCompletedRequest,
+) (*apiv1.RespondQueryTaskCompletedResponse, error) {
+
+	if err := g.handler.RespondQueryTaskCompleted(ctx, protoconv.FromProtoRespondQueryTaskCompletedRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.RespondQueryTaskCompletedResponse{}, nil
+}
+
+func (g *grpcHandler) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListWorkflowExecutionsRequest,
+) (*apiv1.ListWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ScanWorkflowExecutions(ctx, protoconv.FromProtoListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoListWorkflowExecutionsResponse(response), nil
+}
+
+func (g *grpcHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWithStartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.SignalWithStartWorkflowExecution(ctx, protoconv.FromProtoSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoStartWorkflowExecutionResponse(response), nil
+}
+
+func (g *grpcHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWorkflowExecutionRequest,
+) (*apiv1.SignalWorkflowExecutionResponse, error) {
+
+	if err := g.handler.SignalWorkflowExecution(ctx, protoconv.FromProtoSignalWorkflowExecutionRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.SignalWorkflowExecutionResponse{}, nil
+}
+
+func (g *grpcHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.StartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.StartWorkflowExecution(ctx, protoconv.FromProtoStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoStartWorkflowExecutionResponse(response), nil
+}
+
+func (g *grpcHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.TerminateWorkflowExecutionRequest,
+) (*apiv1.TerminateWorkflowExecutionResponse, error) {
+
+	if err := g.handler.TerminateWorkflowExecution(ctx, protoconv.FromProtoTerminateWorkflowExecutionRequest(request)); err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return &apiv1.TerminateWorkflowExecutionResponse{}, nil
+}
+
+func (g *grpcHandler) UpdateDomain(
+	ctx context.Context,
+	request *apiv1.UpdateDomainRequest,
+) (*apiv1.UpdateDomainResponse, error) {
+
+	response, err := g.handler.UpdateDomain(ctx, protoconv.FromProtoUpdateDomainRequest(request))
+	if err != nil {
+		return nil, protoconv.ToProtoError(err)
+	}
+	return protoconv.ToProtoUpdateDomainResponse(response), nil
+}
@@ -0,0 +1,505 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+const (
+	// HTTPAPIPathPrefix is the path prefix of the WorkflowService APIs served by the HTTP gateway,
+	// the API name follows the prefix, e.g. /api/v1/StartWorkflowExecution
+	HTTPAPIPathPrefix = "/api/v1/"
+	// HTTPHealthPath is the path of the HTTP gateway health check
+	HTTPHealthPath = "/health"
+	// HTTPTimeoutHeaderName is the header which carries the request timeout in milliseconds
+	HTTPTimeoutHeaderName = "Context-TTL-MS"
+	// HTTPCallerHeaderName is the header which carries the name of the calling service
+	HTTPCallerHeaderName = "Rpc-Caller"
+
+	httpEncoding               = "json"
+	httpDefaultCaller          = "cadence-http-gateway"
+	httpDefaultRequestTimeout  = 10 * time.Second
+	httpDefaultLongPollTimeout = 70 * time.Second
+	httpMaxRequestBodyBytes    = 32 * 1024 * 1024
+	httpShutdownTimeout        = 10 * time.Second
+)
+
+type (
+	// HTTPHandler serves the WorkflowService APIs as JSON over HTTP. Requests and responses are the
+	// thrift structs encoded as JSON, binary payloads are base64 encoded. Calls go through the same
+	// handler as the TChannel inbound, so they share its authorization, rate limiting and metrics.
+	HTTPHandler struct {
+		handler workflowserviceserver.Interface
+		address string
+		logger  log.Logger
+		server  *http.Server
+	}
+
+	// HTTPError is the body of a failed HTTP gateway call
+	HTTPError struct {
+		// Type is the name of the thrift error, e.g. EntityNotExistsError
+		Type    string `json:"type"`
+		Message string `json:"message"`
+		// Details is the thrift error encoded as JSON
+		Details error `json:"details,omitempty"`
+	}
+
+	httpRoute struct {
+		longPoll   bool
+		newRequest func() interface{}
+		invoke     func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error)
+	}
+)
+
+// forwardedHTTPHeaders are the cadence client headers passed on to the handler as rpc headers
+var forwardedHTTPHeaders = []string{
+	common.LibraryVersionHeaderName,
+	common.FeatureVersionHeaderName,
+	common.ClientImplHeaderName,
+}
+
+var httpRoutes = map[string]httpRoute{
+	"CountWorkflowExecutions": {
+		newRequest: func() interface{} { return &gen.CountWorkflowExecutionsRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.CountWorkflowExecutions(ctx, req.(*gen.CountWorkflowExecutionsRequest))
+		},
+	},
+	"DeprecateDomain": {
+		newRequest: func() interface{} { return &gen.DeprecateDomainRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.DeprecateDomain(ctx, req.(*gen.DeprecateDomainRequest))
+		},
+	},
+	"DescribeDomain": {
+		newRequest: func() interface{} { return &gen.DescribeDomainRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.DescribeDomain(ctx, req.(*gen.DescribeDomainRequest))
+		},
+	},
+	"DescribeTaskList": {
+		newRequest: func() interface{} { return &gen.DescribeTaskListRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.DescribeTaskList(ctx, req.(*gen.DescribeTaskListRequest))
+		},
+	},
+	"DescribeWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.DescribeWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.DescribeWorkflowExecution(ctx, req.(*gen.DescribeWorkflowExecutionRequest))
+		},
+	},
+	"GetSearchAttributes": {
+		newRequest: nil,
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.GetSearchAttributes(ctx)
+		},
+	},
+	"GetWorkflowExecutionHistory": {
+		longPoll:   true,
+		newRequest: func() interface{} { return &gen.GetWorkflowExecutionHistoryRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.GetWorkflowExecutionHistory(ctx, req.(*gen.GetWorkflowExecutionHistoryRequest))
+		},
+	},
+	"ListClosedWorkflowExecutions": {
+		newRequest: func() interface{} { return &gen.ListClosedWorkflowExecutionsRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ListClosedWorkflowExecutions(ctx, req.(*gen.ListClosedWorkflowExecutionsRequest))
+		},
+	},
+	"ListDomains": {
+		newRequest: func() interface{} { return &gen.ListDomainsRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ListDomains(ctx, req.(*gen.ListDomainsRequest))
+		},
+	},
+	"ListOpenWorkflowExecutions": {
+		newRequest: func() interface{} { return &gen.ListOpenWorkflowExecutionsRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ListOpenWorkflowExecutions(ctx, req.(*gen.ListOpenWorkflowExecutionsRequest))
+		},
+	},
+	"ListWorkflowExecutions": {
+		newRequest: func() interface{} { return &gen.ListWorkflowExecutionsRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ListWorkflowExecutions(ctx, req.(*gen.ListWorkflowExecutionsRequest))
+		},
+	},
+	"PollForActivityTask": {
+		longPoll:   true,
+		newRequest: func() interface{} { return &gen.PollForActivityTaskRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.PollForActivityTask(ctx, req.(*gen.PollForActivityTaskRequest))
+		},
+	},
+	"PollForDecisionTask": {
+		longPoll:   true,
+		newRequest: func() interface{} { return &gen.PollForDecisionTaskRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.PollForDecisionTask(ctx, req.(*gen.PollForDecisionTaskRequest))
+		},
+	},
+	"QueryWorkflow": {
+		newRequest: func() interface{} { return &gen.QueryWorkflowRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.QueryWorkflow(ctx, req.(*gen.QueryWorkflowRequest))
+		},
+	},
+	"RecordActivityTaskHeartbeat": {
+		newRequest: func() interface{} { return &gen.RecordActivityTaskHeartbeatRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.RecordActivityTaskHeartbeat(ctx, req.(*gen.RecordActivityTaskHeartbeatRequest))
+		},
+	},
+	"RecordActivityTaskHeartbeatByID": {
+		newRequest: func() interface{} { return &gen.RecordActivityTaskHeartbeatByIDRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.RecordActivityTaskHeartbeatByID(ctx, req.(*gen.RecordActivityTaskHeartbeatByIDRequest))
+		},
+	},
+	"RegisterDomain": {
+		newRequest: func() interface{} { return &gen.RegisterDomainRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RegisterDomain(ctx, req.(*gen.RegisterDomainRequest))
+		},
+	},
+	"RequestCancelWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.RequestCancelWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RequestCancelWorkflowExecution(ctx, req.(*gen.RequestCancelWorkflowExecutionRequest))
+		},
+	},
+	"ResetStickyTaskList": {
+		newRequest: func() interface{} { return &gen.ResetStickyTaskListRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ResetStickyTaskList(ctx, req.(*gen.ResetStickyTaskListRequest))
+		},
+	},
+	"ResetWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.ResetWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ResetWorkflowExecution(ctx, req.(*gen.ResetWorkflowExecutionRequest))
+		},
+	},
+	"RespondActivityTaskCanceled": {
+		newRequest: func() interface{} { return &gen.RespondActivityTaskCanceledRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondActivityTaskCanceled(ctx, req.(*gen.RespondActivityTaskCanceledRequest))
+		},
+	},
+	"RespondActivityTaskCanceledByID": {
+		newRequest: func() interface{} { return &gen.RespondActivityTaskCanceledByIDRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondActivityTaskCanceledByID(ctx, req.(*gen.RespondActivityTaskCanceledByIDRequest))
+		},
+	},
+	"RespondActivityTaskCompleted": {
+		newRequest: func() interface{} { return &gen.RespondActivityTaskCompletedRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondActivityTaskCompleted(ctx, req.(*gen.RespondActivityTaskCompletedRequest))
+		},
+	},
+	"RespondActivityTaskCompletedByID": {
+		newRequest: func() interface{} { return &gen.RespondActivityTaskCompletedByIDRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondActivityTaskCompletedByID(ctx, req.(*gen.RespondActivityTaskCompletedByIDRequest))
+		},
+	},
+	"RespondActivityTaskFailed": {
+		newRequest: func() interface{} { return &gen.RespondActivityTaskFailedRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondActivityTaskFailed(ctx, req.(*gen.RespondActivityTaskFailedRequest))
+		},
+	},
+	"RespondActivityTaskFailedByID": {
+		newRequest: func() interface{} { return &gen.RespondActivityTaskFailedByIDRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondActivityTaskFailedByID(ctx, req.(*gen.RespondActivityTaskFailedByIDRequest))
+		},
+	},
+	"RespondDecisionTaskCompleted": {
+		newRequest: func() interface{} { return &gen.RespondDecisionTaskCompletedRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.RespondDecisionTaskCompleted(ctx, req.(*gen.RespondDecisionTaskCompletedRequest))
+		},
+	},
+	"RespondDecisionTaskFailed": {
+		newRequest: func() interface{} { return &gen.RespondDecisionTaskFailedRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondDecisionTaskFailed(ctx, req.(*gen.RespondDecisionTaskFailedRequest))
+		},
+	},
+	"RespondQueryTaskCompleted": {
+		newRequest: func() interface{} { return &gen.RespondQueryTaskCompletedRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.RespondQueryTaskCompleted(ctx, req.(*gen.RespondQueryTaskCompletedRequest))
+		},
+	},
+	"ScanWorkflowExecutions": {
+		newRequest: func() interface{} { return &gen.ListWorkflowExecutionsRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.ScanWorkflowExecutions(ctx, req.(*gen.ListWorkflowExecutionsRequest))
+		},
+	},
+	"SignalWithStartWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.SignalWithStartWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.SignalWithStartWorkflowExecution(ctx, req.(*gen.SignalWithStartWorkflowExecutionRequest))
+		},
+	},
+	"SignalWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.SignalWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.SignalWorkflowExecution(ctx, req.(*gen.SignalWorkflowExecutionRequest))
+		},
+	},
+	"StartWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.StartWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.StartWorkflowExecution(ctx, req.(*gen.StartWorkflowExecutionRequest))
+		},
+	},
+	"TerminateWorkflowExecution": {
+		newRequest: func() interface{} { return &gen.TerminateWorkflowExecutionRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return nil, h.TerminateWorkflowExecution(ctx, req.(*gen.TerminateWorkflowExecutionRequest))
+		},
+	},
+	"UpdateDomain": {
+		newRequest: func() interface{} { return &gen.UpdateDomainRequest{} },
+		invoke: func(ctx context.Context, h workflowserviceserver.Interface, req interface{}) (interface{}, error) {
+			return h.UpdateDomain(ctx, req.(*gen.UpdateDomainRequest))
+		},
+	},
+}
+
+// NewHTTPHandler creates a HTTP/JSON gateway for the given handler, listening on address
+func NewHTTPHandler(handler workflowserviceserver.Interface, address string, logger log.Logger) *HTTPHandler {
+	h := &HTTPHandler{
+		handler: handler,
+		address: address,
+		logger:  logger.WithTags(tag.Address(address)),
+	}
+	h.server = &http.Server{Handler: h}
+	return h
+}
+
+// Start starts serving the HTTP gateway
+func (h *HTTPHandler) Start() error {
+	listener, err := net.Listen("tcp", h.address)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := h.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			h.logger.Error("HTTP gateway stopped serving", tag.Error(err))
+		}
+	}()
+	h.logger.Info("Created HTTP gateway and listening")
+	return nil
+}
+
+// Stop stops the HTTP gateway, waiting for in flight calls to complete
+func (h *HTTPHandler) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+	if err := h.server.Shutdown(ctx); err != nil {
+		h.logger.Warn("HTTP gateway shutdown failed", tag.Error(err))
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (h *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == HTTPHealthPath {
+		h.writeResponse(w, http.StatusOK, map[string]interface{}{"ok": true})
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, HTTPAPIPathPrefix)
+	route, ok := httpRoutes[name]
+	if !ok {
+		h.writeError(w, http.StatusNotFound, &HTTPError{
+			Type:    "NotFound",
+			Message: fmt.Sprintf("unknown API %v", r.URL.Path),
+		})
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.writeError(w, http.StatusMethodNotAllowed, &HTTPError{
+			Type:    "MethodNotAllowed",
+			Message: fmt.Sprintf("%v only accepts POST", r.URL.Path),
+		})
+		return
+	}
+
+	var request interface{}
+	if route.newRequest != nil {
+		request = route.newRequest()
+		body := http.MaxBytesReader(w, r.Body, httpMaxRequestBodyBytes)
+		if err := json.NewDecoder(body).Decode(request); err != nil && err != io.EOF {
+			h.writeError(w, http.StatusBadRequest, &HTTPError{
+				Type:    "BadRequestError",
+				Message: fmt.Sprintf("unable to decode request body: %v", err),
+			})
+			return
+		}
+	}
+
+	ctx, cancel, err := h.newContext(r, name, route)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, &HTTPError{Type: "BadRequestError", Message: err.Error()})
+		return
+	}
+	defer cancel()
+
+	response, err := route.invoke(ctx, h.handler, request)
+	if err != nil {
+		status, httpErr := toHTTPError(err)
+		h.writeError(w, status, httpErr)
+		return
+	}
+	if response == nil {
+		response = struct{}{}
+	}
+	h.writeResponse(w, http.StatusOK, response)
+}
+
+// newContext builds the context of a call, carrying the timeout and the cadence client
+// headers of the HTTP request the same way the TChannel inbound does
+func (h *HTTPHandler) newContext(r *http.Request, name string, route httpRoute) (context.Context, context.CancelFunc, error) {
+	timeout := httpDefaultRequestTimeout
+	if route.longPoll {
+		timeout = httpDefaultLongPollTimeout
+	}
+	if ttl := r.Header.Get(HTTPTimeoutHeaderName); ttl != "" {
+		ms, err := strconv.Atoi(ttl)
+		if err != nil || ms <= 0 {
+			return nil, nil, fmt.Errorf("invalid %v header: %v", HTTPTimeoutHeaderName, ttl)
+		}
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	caller := r.Header.Get(HTTPCallerHeaderName)
+	if caller == "" {
+		caller = httpDefaultCaller
+	}
+	headers := transport.NewHeaders()
+	for _, key := range forwardedHTTPHeaders {
+		if value := r.Header.Get(key); value != "" {
+			headers = headers.With(key, value)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	ctx, call := encoding.NewInboundCall(ctx)
+	if err := call.ReadFromRequest(&transport.Request{
+		Caller:    caller,
+		Service:   common.FrontendServiceName,
+		Encoding:  httpEncoding,
+		Procedure: "WorkflowService::" + name,
+		Headers:   headers,
+	}); err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return ctx, cancel, nil
+}
+
+func (h *HTTPHandler) writeResponse(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.logger.Warn("Failed to write HTTP gateway response", tag.Error(err))
+	}
+}
+
+func (h *HTTPHandler) writeError(w http.ResponseWriter, status int, err *HTTPError) {
+	h.writeResponse(w, status, err)
+}
+
+// toHTTPError maps the errors returned by the handler to a HTTP status and error body
+func toHTTPError(err error) (int, *HTTPError) {
+	var status int
+	switch e := err.(type) {
+	case *gen.BadRequestError, *gen.DomainNotActiveError, *gen.ClientVersionNotSupportedError:
+		status = http.StatusBadRequest
+	case *gen.AccessDeniedError:
+		status = http.StatusForbidden
+	case *gen.EntityNotExistsError:
+		status = http.StatusNotFound
+	case *gen.WorkflowExecutionAlreadyStartedError, *gen.DomainAlreadyExistsError, *gen.CancellationAlreadyRequestedError:
+		status = http.StatusConflict
+	case *gen.QueryFailedError:
+		status = http.StatusUnprocessableEntity
+	case *gen.ServiceBusyError, *gen.LimitExceededError:
+		status = http.StatusTooManyRequests
+	case *yarpcerrors.Status:
+		if e.Code() == yarpcerrors.CodeDeadlineExceeded {
+			return http.StatusGatewayTimeout, &HTTPError{Type: "DeadlineExceeded", Message: e.Message()}
+		}
+		return http.StatusInternalServerError, &HTTPError{Type: "InternalServiceError", Message: e.Message()}
+	default:
+		// internal errors are not returned as thrift errors by the handler, see WorkflowHandler.error
+		return http.StatusInternalServerError, &HTTPError{Type: "InternalServiceError", Message: err.Error()}
+	}
+	return status, &HTTPError{
+		Type:    strings.TrimPrefix(fmt.Sprintf("%T", err), "*shared."),
+		Message: thriftErrorMessage(err),
+		Details: err,
+	}
+}
+
+// thriftErrorMessage returns the message field of a thrift error, or its string form when it has none
+func thriftErrorMessage(err error) string {
+	v := reflect.Indirect(reflect.ValueOf(err))
+	if v.Kind() != reflect.Struct {
+		return err.Error()
+	}
+	field := v.FieldByName("Message")
+	switch {
+	case field.Kind() == reflect.String:
+		return field.String()
+	case field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().Kind() == reflect.String:
+		return field.Elem().String()
+	}
+	return err.Error()
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	httpHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		mockHandler *MockWorkflowHandler
+		handler     *HTTPHandler
+	}
+)
+
+func TestHTTPHandlerSuite(t *testing.T) {
+	s := new(httpHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *httpHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockHandler = &MockWorkflowHandler{}
+	s.handler = NewHTTPHandler(s.mockHandler, "127.0.0.1:0", loggerimpl.NewNopLogger())
+}
+
+func (s *httpHandlerSuite) TearDownTest() {
+	s.mockHandler.AssertExpectations(s.T())
+}
+
+func (s *httpHandlerSuite) serve(method string, path string, body []byte, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func (s *httpHandlerSuite) TestRoutes_CoverWorkflowService() {
+	serviceType := reflect.TypeOf((*workflowserviceserver.Interface)(nil)).Elem()
+	s.Equal(serviceType.NumMethod(), len(httpRoutes))
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		_, ok := httpRoutes[serviceType.Method(i).Name]
+		s.True(ok, "missing route for %v", serviceType.Method(i).Name)
+	}
+}
+
+func (s *httpHandlerSuite) TestStartWorkflowExecution() {
+	input := []byte{0, 1, 2, 255}
+	s.mockHandler.On("StartWorkflowExecution", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *shared.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse {
+			call := yarpc.CallFromContext(ctx)
+			s.Equal("test-caller", call.Caller())
+			s.Equal("1.0.0", call.Header(common.FeatureVersionHeaderName))
+			s.Equal("test-domain", request.GetDomain())
+			s.Equal(input, request.Input)
+			_, ok := ctx.Deadline()
+			s.True(ok)
+			return &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("run-id")}
+		}, nil)
+
+	body := []byte(`{"domain":"test-domain","workflowId":"wid","input":"AAEC/w=="}`)
+	resp := s.serve(http.MethodPost, HTTPAPIPathPrefix+"StartWorkflowExecution", body, map[string]string{
+		HTTPCallerHeaderName:            "test-caller",
+		common.FeatureVersionHeaderName: "1.0.0",
+	})
+	s.Equal(http.StatusOK, resp.Code)
+	s.Equal("application/json", resp.Header().Get("Content-Type"))
+
+	var response shared.StartWorkflowExecutionResponse
+	s.NoError(json.Unmarshal(resp.Body.Bytes(), &response))
+	s.Equal("run-id", response.GetRunId())
+}
+
+func (s *httpHandlerSuite) TestNoResponseAndNoRequest() {
+	s.mockHandler.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(nil)
+	resp := s.serve(http.MethodPost, HTTPAPIPathPrefix+"SignalWorkflowExecution", nil, nil)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{}`, resp.Body.String())
+
+	s.mockHandler.On("GetSearchAttributes", mock.Anything).Return(&shared.GetSearchAttributesResponse{
+		Keys: map[string]shared.IndexedValueType{"CustomKeywordField": shared.IndexedValueTypeKeyword},
+	}, nil)
+	resp = s.serve(http.MethodPost, HTTPAPIPathPrefix+"GetSearchAttributes", nil, nil)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"keys":{"CustomKeywordField":"KEYWORD"}}`, resp.Body.String())
+}
+
+func (s *httpHandlerSuite) TestErrorMapping() {
+	testCases := []struct {
+		err    error
+		status int
+		typ    string
+	}{
+		{&shared.BadRequestError{Message: "bad"}, http.StatusBadRequest, "BadRequestError"},
+		{&shared.EntityNotExistsError{Message: "missing"}, http.StatusNotFound, "EntityNotExistsError"},
+		{&shared.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr("started")}, http.StatusConflict, "WorkflowExecutionAlreadyStartedError"},
+		{&shared.ServiceBusyError{Message: "busy"}, http.StatusTooManyRequests, "ServiceBusyError"},
+		{&shared.LimitExceededError{Message: "limit"}, http.StatusTooManyRequests, "LimitExceededError"},
+		{&shared.QueryFailedError{Message: "query"}, http.StatusUnprocessableEntity, "QueryFailedError"},
+		{yarpcerrors.DeadlineExceededErrorf("timeout"), http.StatusGatewayTimeout, "DeadlineExceeded"},
+		{yarpcerrors.UnavailableErrorf("unavailable"), http.StatusInternalServerError, "InternalServiceError"},
+		{&shared.InternalServiceError{Message: "internal"}, http.StatusInternalServerError, "InternalServiceError"},
+	}
+
+	for _, tc := range testCases {
+		status, httpErr := toHTTPError(tc.err)
+		s.Equal(tc.status, status, tc.typ)
+		s.Equal(tc.typ, httpErr.Type)
+	}
+
+	s.mockHandler.On("DescribeDomain", mock.Anything, mock.Anything).Return(nil, &shared.EntityNotExistsError{Message: "missing"})
+	resp := s.serve(http.MethodPost, HTTPAPIPathPrefix+"DescribeDomain", []byte(`{"name":"unknown"}`), nil)
+	s.Equal(http.StatusNotFound, resp.Code)
+	s.JSONEq(`{"type":"EntityNotExistsError","message":"missing","details":{"message":"missing"}}`, resp.Body.String())
+}
+
+func (s *httpHandlerSuite) TestBadRequests() {
+	resp := s.serve(http.MethodPost, HTTPAPIPathPrefix+"UnknownAPI", nil, nil)
+	s.Equal(http.StatusNotFound, resp.Code)
+
+	resp = s.serve(http.MethodGet, HTTPAPIPathPrefix+"DescribeDomain", nil, nil)
+	s.Equal(http.StatusMethodNotAllowed, resp.Code)
+
+	resp = s.serve(http.MethodPost, HTTPAPIPathPrefix+"DescribeDomain", []byte(`{"name":`), nil)
+	s.Equal(http.StatusBadRequest, resp.Code)
+
+	resp = s.serve(http.MethodPost, HTTPAPIPathPrefix+"DescribeDomain", []byte(`{}`), map[string]string{HTTPTimeoutHeaderName: "soon"})
+	s.Equal(http.StatusBadRequest, resp.Code)
+
+	resp = s.serve(http.MethodGet, HTTPHealthPath, nil, nil)
+	s.Equal(http.StatusOK, resp.Code)
+}
@@ -21,8 +21,10 @@
 package frontend
 
 import (
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
@@ -40,6 +42,7 @@ import (
 type Config struct {
 	NumHistoryShards                int
 	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
+	PersistenceFaultInjection       *config.FaultInjectionConfig
 	VisibilityMaxPageSize           dynamicconfig.IntPropertyFnWithDomainFilter
 	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
@@ -59,6 +62,7 @@ type Config struct {
 
 	MaxDecisionStartToCloseTimeout dynamicconfig.IntPropertyFnWithDomainFilter
 	MaxBadBinaries                 dynamicconfig.IntPropertyFnWithDomainFilter
+	FailoverHistoryMaxSize         dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// security protection settings
 	EnableAdminProtection         dynamicconfig.BoolPropertyFn
@@ -69,6 +73,12 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// payload offloading settings
+	EnablePayloadOffload           dynamicconfig.BoolPropertyFnWithDomainFilter
+	PayloadOffloadThreshold        dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadBucket           dynamicconfig.StringPropertyFn
+	OffloadedPayloadSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Domain specific config
@@ -86,6 +96,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableVisibil
 	return &Config{
 		NumHistoryShards:                    numHistoryShards,
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
+		PersistenceFaultInjection:           config.NewFaultInjectionConfig(dc, dynamicconfig.FrontendPersistenceFaultInjectionEnabled),
 		VisibilityMaxPageSize:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
 		EnableVisibilitySampling:            dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
@@ -100,11 +111,16 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableVisibil
 		HistoryMgrNumConns:                  dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxDecisionStartToCloseTimeout:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseTimeout, 600),
 		MaxBadBinaries:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries, 10),
+		FailoverHistoryMaxSize:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendFailoverHistoryMaxSize, 5),
 		EnableAdminProtection:               dc.GetBoolProperty(dynamicconfig.EnableAdminProtection, false),
 		AdminOperationToken:                 dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 		DisableListVisibilityByFilter:       dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		EnablePayloadOffload:                dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.FrontendEnablePayloadOffload, false),
+		PayloadOffloadThreshold:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 256*1024),
+		PayloadOffloadBucket:                dc.GetStringProperty(dynamicconfig.PayloadOffloadBucket, ""),
+		OffloadedPayloadSizeLimitError:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.OffloadedPayloadSizeLimitError, 16*1024*1024),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		EnableDomainNotActiveAutoForwarding: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableDomainNotActiveAutoForwarding, false),
 		EnableClientVersionCheck:            dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, false),
@@ -116,6 +132,15 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableVisibil
 	}
 }
 
+// GetPayloadOffloadConfig returns the config used to offload payloads to the blobstore
+func (c *Config) GetPayloadOffloadConfig() *payload.Config {
+	return &payload.Config{
+		EnableOffload: c.EnablePayloadOffload,
+		Threshold:     c.PayloadOffloadThreshold,
+		Bucket:        c.PayloadOffloadBucket,
+	}
+}
+
 // Service represents the cadence-frontend service
 type Service struct {
 	stopC  chan struct{}
@@ -148,12 +173,13 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.FaultInjection = s.config.PersistenceFaultInjection
 	pConfig.VisibilityConfig = &config.VisibilityConfig{
 		VisibilityListMaxQPS:            s.config.VisibilityListMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), base.GetTracer(), log)
 
 	metadata, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
 	if err != nil {
@@ -167,15 +193,18 @@ func (s *Service) Start() {
 
 	var visibilityFromES persistence.VisibilityManager
 	if s.config.EnableVisibilityToKafka() {
-		visibilityIndexName := params.ESConfig.Indices[common.VisibilityAppName]
 		visibilityConfigForES := &config.VisibilityConfig{
 			MaxQPS:                 s.config.PersistenceMaxQPS,
 			VisibilityListMaxQPS:   s.config.ESVisibilityListMaxQPS,
 			ESIndexMaxResultWindow: s.config.ESIndexMaxResultWindow,
 			ValidSearchAttributes:  s.config.ValidSearchAttributes,
 		}
-		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
-			nil, base.GetMetricsClient(), log)
+		keyProvider, err := persistence.NewKeyProvider(params.PersistenceConfig.Encryption)
+		if err != nil {
+			log.Fatal("Creating encryption key provider failed", tag.Error(err))
+		}
+		visibilityFromES = espersistence.NewESVisibilityManager(params.ESRouter, visibilityConfigForES,
+			nil, base.GetMetricsClient(), keyProvider, log)
 	}
 	visibility := persistence.NewVisibilityManagerWrapper(visibilityFromDB, visibilityFromES, s.config.EnableReadVisibilityFromES)
 
@@ -200,11 +229,22 @@ func (s *Service) Start() {
 	}
 
 	metricsBlobstore := blobstore.NewMetricClient(params.BlobstoreClient, base.GetMetricsClient())
-	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, metricsBlobstore)
+	var offloadBlobstore blobstore.Client
+	if params.BlobstoreClient != nil {
+		offloadBlobstore = blobstore.NewRetryableClient(
+			metricsBlobstore,
+			params.BlobstoreClient.GetRetryPolicy(),
+			params.BlobstoreClient.IsRetryableError)
+	}
+	payloadOffloader := payload.NewOffloader(offloadBlobstore, s.config.GetPayloadOffloadConfig())
+	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, metricsBlobstore,
+		payloadOffloader)
 	dcRedirectionHandler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
 	dcRedirectionHandler.RegisterHandler()
+	// the protobuf procedures are served on the gRPC inbound when it is configured
+	base.GetDispatcher().Register(apiv1.BuildWorkflowServiceYARPCProcedures(NewGRPCHandler(dcRedirectionHandler)))
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2)
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, params, s.config)
 	adminHandler.RegisterHandler()
 
 	// must start base service first
@@ -220,10 +260,21 @@ func (s *Service) Start() {
 
 	// base (service is not started in frontend or admin handler) in case of race condition in yarpc registration function
 
+	var httpHandler *HTTPHandler
+	if len(params.HTTPGatewayAddress) > 0 {
+		httpHandler = NewHTTPHandler(dcRedirectionHandler, params.HTTPGatewayAddress, log)
+		if err := httpHandler.Start(); err != nil {
+			log.Fatal("HTTP gateway failed to start", tag.Error(err))
+		}
+	}
+
 	log.Info("started", tag.Service(common.FrontendServiceName))
 
 	<-s.stopC
 
+	if httpHandler != nil {
+		httpHandler.Stop()
+	}
 	base.Stop()
 }
 
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -51,6 +52,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/service/worker/archiver"
 	"go.uber.org/yarpc/yarpcerrors"
 )
@@ -78,6 +80,7 @@ type (
 		domainHandler            *domainHandlerImpl
 		visibilityQueryValidator *common.VisibilityQueryValidator
 		historyBlobDownloader    archiver.HistoryBlobDownloader
+		payloadOffloader         payload.Offloader
 		service.Service
 	}
 
@@ -122,6 +125,15 @@ var (
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
 	errInvalidRetentionPeriod                     = &gen.BadRequestError{Message: "A valid retention period is not set on request."}
+	errClusterNameNotSet                          = &gen.BadRequestError{Message: "Cluster name is not set on request."}
+	errShardIDNotSet                              = &gen.BadRequestError{Message: "Shard ID is not set on request."}
+	errInvalidShardID                             = &gen.BadRequestError{Message: "Shard ID is out of range."}
+	errFailoverDomainsNotSet                      = &gen.BadRequestError{Message: "Either domains or a domain data filter must be set on request."}
+	errInvalidFailoverConcurrency                 = &gen.BadRequestError{Message: "Failover concurrency is out of range."}
+	errInvalidTargetCluster                       = &gen.BadRequestError{Message: "Target cluster is not a known enabled cluster."}
+	errNotGlobalDomain                            = &gen.BadRequestError{Message: "Domain is not a global domain."}
+	errSearchAttributesNotSet                     = &gen.BadRequestError{Message: "SearchAttributes are not set on request."}
+	errAdvancedVisibilityNotEnabled               = &gen.BadRequestError{Message: "Advanced visibility with ElasticSearch is not enabled."}
 
 	// err for archival
 	errHistoryHasPassedRetentionPeriod = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
@@ -141,6 +153,12 @@ var (
 	errCannotModifyClustersFromDomain  = &gen.BadRequestError{Message: "Cannot modify existing replicated clusters from a domain."}
 	errActiveClusterNotInClusters      = &gen.BadRequestError{Message: "Active cluster is not contained in all clusters."}
 	errCannotDoDomainFailoverAndUpdate = &gen.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
+	errFailoverDrainNotFailover        = &gen.BadRequestError{Message: "Failover drain timeout can only be set on a failover of a global domain."}
+	errInvalidFailoverDrainTimeout     = &gen.BadRequestError{Message: "A valid failover drain timeout is not set on request."}
+	errFailoverDrainNotSupported       = &gen.BadRequestError{Message: "Failover drain is not supported for domains in the v1 domain table."}
+	errDomainAlreadyActive             = &gen.BadRequestError{Message: "Domain is already active in the target cluster."}
+	errDomainDataKeyReserved           = &gen.BadRequestError{Message: "Domain data key is reserved for the failover history."}
+	errDomainDraining                  = &gen.ServiceBusyError{Message: "Domain is draining for a failover, new workflows are not accepted."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -149,7 +167,7 @@ var (
 func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	visibilityMgr persistence.VisibilityManager, kafkaProducer messaging.Producer,
-	blobstoreClient blobstore.Client) *WorkflowHandler {
+	blobstoreClient blobstore.Client, payloadOffloader payload.Offloader) *WorkflowHandler {
 	handler := &WorkflowHandler{
 		Service:         sVice,
 		config:          config,
@@ -173,6 +191,7 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 		),
 		visibilityQueryValidator: common.NewQueryValidator(config.ValidSearchAttributes),
 		historyBlobDownloader:    archiver.NewHistoryBlobDownloader(blobstoreClient),
+		payloadOffloader:         payloadOffloader,
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -220,6 +239,8 @@ func (wh *WorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, er
 // acts as a sandbox and provides isolation for all resources within the domain.  All resources belongs to exactly one
 // domain.
 func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *gen.RegisterDomainRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RegisterDomain")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRegisterDomainScope)
@@ -239,6 +260,8 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 // ListDomains returns the information and configuration for a registered domain.
 func (wh *WorkflowHandler) ListDomains(ctx context.Context,
 	listRequest *gen.ListDomainsRequest) (response *gen.ListDomainsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ListDomains")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendListDomainsScope)
@@ -258,6 +281,8 @@ func (wh *WorkflowHandler) ListDomains(ctx context.Context,
 // DescribeDomain returns the information and configuration for a registered domain.
 func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 	describeRequest *gen.DescribeDomainRequest) (response *gen.DescribeDomainResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.DescribeDomain")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendDescribeDomainScope)
@@ -277,6 +302,8 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 // UpdateDomain is used to update the information and configuration for a registered domain.
 func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	updateRequest *gen.UpdateDomainRequest) (resp *gen.UpdateDomainResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.UpdateDomain")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendUpdateDomainScope)
@@ -297,6 +324,8 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 // it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on
 // deprecated domains.
 func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest *gen.DeprecateDomainRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.DeprecateDomain")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendDeprecateDomainScope)
@@ -317,6 +346,8 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 func (wh *WorkflowHandler) PollForActivityTask(
 	ctx context.Context,
 	pollRequest *gen.PollForActivityTaskRequest) (resp *gen.PollForActivityTaskResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.PollForActivityTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	callTime := time.Now()
@@ -389,6 +420,11 @@ func (wh *WorkflowHandler) PollForActivityTask(
 			return nil, wh.error(err, scope)
 		}
 	}
+	if resp != nil && payload.IsReference(resp.Input) {
+		if resp.Input, err = wh.payloadOffloader.Hydrate(ctx, resp.Input); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
 	return resp, nil
 }
 
@@ -396,6 +432,8 @@ func (wh *WorkflowHandler) PollForActivityTask(
 func (wh *WorkflowHandler) PollForDecisionTask(
 	ctx context.Context,
 	pollRequest *gen.PollForDecisionTaskRequest) (resp *gen.PollForDecisionTaskResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.PollForDecisionTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	callTime := time.Now()
@@ -501,6 +539,38 @@ func (wh *WorkflowHandler) checkBadBinary(domainEntry *cache.DomainCacheEntry, b
 	return nil
 }
 
+// getDomainIDForStart returns the ID of a domain a new workflow is started in, domains
+// draining for a graceful failover do not accept new workflows until the failover is done
+func (wh *WorkflowHandler) getDomainIDForStart(domainName string) (string, error) {
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return "", err
+	}
+	if domainEntry.IsDraining(time.Now()) {
+		return "", errDomainDraining
+	}
+	return domainEntry.GetInfo().ID, nil
+}
+
+// getPayloadSizeLimitError returns the size limit of a user payload, payloads of domains
+// which offload them to the blobstore are subject to a larger limit. Global domains never
+// offload their payloads, so they keep the regular limit
+func (wh *WorkflowHandler) getPayloadSizeLimitError(domainName string) int {
+	if wh.payloadOffloader.IsEnabled(domainName) && !wh.isGlobalDomain(domainName) {
+		return wh.config.OffloadedPayloadSizeLimitError(domainName)
+	}
+	return wh.config.BlobSizeLimitError(domainName)
+}
+
+func (wh *WorkflowHandler) isGlobalDomain(domainName string) bool {
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		// be conservative, an unknown domain is rejected later on anyway
+		return true
+	}
+	return domainEntry.IsGlobalDomain()
+}
+
 func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error, domainID string, taskListType int32,
 	taskList *gen.TaskList, pollerID string) error {
 	// First check if this err is due to context cancellation.  This means client connection to frontend is closed.
@@ -530,6 +600,8 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	heartbeatRequest *gen.RecordActivityTaskHeartbeatRequest) (resp *gen.RecordActivityTaskHeartbeatResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RecordActivityTaskHeartbeat")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRecordActivityTaskHeartbeatScope)
@@ -611,6 +683,8 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 	ctx context.Context,
 	heartbeatRequest *gen.RecordActivityTaskHeartbeatByIDRequest) (resp *gen.RecordActivityTaskHeartbeatResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RecordActivityTaskHeartbeatByID")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRecordActivityTaskHeartbeatByIDScope)
@@ -717,6 +791,8 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	ctx context.Context,
 	completeRequest *gen.RespondActivityTaskCompletedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondActivityTaskCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondActivityTaskCompletedScope)
@@ -755,7 +831,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -799,6 +875,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	ctx context.Context,
 	completeRequest *gen.RespondActivityTaskCompletedByIDRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondActivityTaskCompletedByID")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondActivityTaskCompletedByIDScope)
@@ -857,7 +935,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -907,6 +985,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	ctx context.Context,
 	failedRequest *gen.RespondActivityTaskFailedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondActivityTaskFailed")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondActivityTaskFailedScope)
@@ -946,7 +1026,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		return wh.error(errIdentityTooLong, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -978,6 +1058,8 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	ctx context.Context,
 	failedRequest *gen.RespondActivityTaskFailedByIDRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondActivityTaskFailedByID")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondActivityTaskFailedByIDScope)
@@ -1035,7 +1117,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -1074,6 +1156,8 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	ctx context.Context,
 	cancelRequest *gen.RespondActivityTaskCanceledRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondActivityTaskCanceled")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondActivityTaskCanceledScope)
@@ -1113,7 +1197,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		return wh.error(errIdentityTooLong, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -1157,6 +1241,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	ctx context.Context,
 	cancelRequest *gen.RespondActivityTaskCanceledByIDRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondActivityTaskCanceledByID")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondActivityTaskCanceledScope)
@@ -1214,7 +1300,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -1264,6 +1350,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	completeRequest *gen.RespondDecisionTaskCompletedRequest) (resp *gen.RespondDecisionTaskCompletedResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondDecisionTaskCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondDecisionTaskCompletedScope)
@@ -1342,6 +1430,8 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 	ctx context.Context,
 	failedRequest *gen.RespondDecisionTaskFailedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondDecisionTaskFailed")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondDecisionTaskFailedScope)
@@ -1412,6 +1502,8 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 	ctx context.Context,
 	completeRequest *gen.RespondQueryTaskCompletedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RespondQueryTaskCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendRespondQueryTaskCompletedScope)
@@ -1465,6 +1557,8 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 func (wh *WorkflowHandler) StartWorkflowExecution(
 	ctx context.Context,
 	startRequest *gen.StartWorkflowExecutionRequest) (resp *gen.StartWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.StartWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendStartWorkflowExecutionScope, startRequest)
@@ -1568,7 +1662,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	wh.Service.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainID, err := wh.getDomainIDForStart(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1576,7 +1670,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainName))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	actualSize := len(startRequest.Input)
 	if startRequest.Memo != nil {
@@ -1595,6 +1689,9 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
+	// the workflow header carries the trace to the workers and to the child workflows
+	startRequest.Header = tracing.InjectHeader(span, startRequest.Header)
+
 	wh.Service.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
 	resp, err = wh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(domainID, startRequest))
 
@@ -1608,6 +1705,8 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	getRequest *gen.GetWorkflowExecutionHistoryRequest) (resp *gen.GetWorkflowExecutionHistoryResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.GetWorkflowExecutionHistory")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendGetWorkflowExecutionHistoryScope, getRequest)
@@ -1738,6 +1837,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	if isCloseEventOnly {
 		if !isWorkflowRunning {
 			history, _, err = wh.getHistory(
+				ctx,
 				scope,
 				domainID,
 				*execution,
@@ -1771,6 +1871,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			}
 		} else {
 			history, token.PersistenceToken, err = wh.getHistory(
+				ctx,
 				scope,
 				domainID,
 				*execution,
@@ -1810,6 +1911,8 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
 func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 	signalRequest *gen.SignalWorkflowExecutionRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.SignalWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendSignalWorkflowExecutionScope, signalRequest)
@@ -1856,7 +1959,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(err, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(signalRequest.GetDomain())
+	sizeLimitError := wh.getPayloadSizeLimitError(signalRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(signalRequest.GetDomain())
 	if err := common.CheckEventBlobSizeLimit(
 		len(signalRequest.Input),
@@ -1889,6 +1992,8 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 // event recorded in history, and a decision task being created for the execution
 func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	signalWithStartRequest *gen.SignalWithStartWorkflowExecutionRequest) (resp *gen.StartWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.SignalWithStartWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendSignalWithStartWorkflowExecutionScope, signalWithStartRequest)
@@ -1993,12 +2098,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: fmt.Sprintf("TaskStartToCloseTimeoutSeconds is larger than ExecutionStartToCloseTimeout or MaxDecisionStartToCloseTimeout (%ds).", maxDecisionTimeout)}, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainID, err := wh.getDomainIDForStart(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	if err := common.CheckEventBlobSizeLimit(
 		len(signalWithStartRequest.SignalInput),
@@ -2026,6 +2131,9 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	// the workflow header carries the trace to the workers and to the child workflows
+	signalWithStartRequest.Header = tracing.InjectHeader(span, signalWithStartRequest.Header)
+
 	op := func() error {
 		var err error
 		resp, err = wh.history.SignalWithStartWorkflowExecution(ctx, &h.SignalWithStartWorkflowExecutionRequest{
@@ -2047,6 +2155,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 // in the history and immediately terminating the execution instance.
 func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 	terminateRequest *gen.TerminateWorkflowExecutionRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.TerminateWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendTerminateWorkflowExecutionScope, terminateRequest)
@@ -2092,6 +2202,8 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
 	resetRequest *gen.ResetWorkflowExecutionRequest) (resp *gen.ResetWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ResetWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendResetWorkflowExecutionScope, resetRequest)
@@ -2137,6 +2249,8 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	cancelRequest *gen.RequestCancelWorkflowExecutionRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.RequestCancelWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendRequestCancelWorkflowExecutionScope, cancelRequest)
@@ -2181,6 +2295,8 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 // ListOpenWorkflowExecutions - retrieves info for open workflow executions in a domain
 func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 	listRequest *gen.ListOpenWorkflowExecutionsRequest) (resp *gen.ListOpenWorkflowExecutionsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ListOpenWorkflowExecutions")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendListOpenWorkflowExecutionsScope, listRequest)
@@ -2252,7 +2368,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		if wh.config.DisableListVisibilityByFilter(domain) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.visibilityMgr.ListOpenWorkflowExecutionsByWorkflowID(
+			persistenceResp, err = wh.visibilityMgr.ListOpenWorkflowExecutionsByWorkflowID(ctx,
 				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 					ListWorkflowExecutionsRequest: baseReq,
 					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowId(),
@@ -2264,7 +2380,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		if wh.config.DisableListVisibilityByFilter(domain) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.visibilityMgr.ListOpenWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+			persistenceResp, err = wh.visibilityMgr.ListOpenWorkflowExecutionsByType(ctx, &persistence.ListWorkflowExecutionsByTypeRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowTypeName:              listRequest.TypeFilter.GetName(),
 			})
@@ -2272,7 +2388,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		wh.Service.GetLogger().Info("List open workflow with filter",
 			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
 	} else {
-		persistenceResp, err = wh.visibilityMgr.ListOpenWorkflowExecutions(&baseReq)
+		persistenceResp, err = wh.visibilityMgr.ListOpenWorkflowExecutions(ctx, &baseReq)
 	}
 
 	if err != nil {
@@ -2288,6 +2404,8 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 // ListClosedWorkflowExecutions - retrieves info for closed workflow executions in a domain
 func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	listRequest *gen.ListClosedWorkflowExecutionsRequest) (resp *gen.ListClosedWorkflowExecutionsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ListClosedWorkflowExecutions")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendListClosedWorkflowExecutionsScope, listRequest)
@@ -2367,7 +2485,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		if wh.config.DisableListVisibilityByFilter(domain) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID(
+			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID(ctx,
 				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 					ListWorkflowExecutionsRequest: baseReq,
 					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowId(),
@@ -2379,7 +2497,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		if wh.config.DisableListVisibilityByFilter(domain) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByType(ctx, &persistence.ListWorkflowExecutionsByTypeRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowTypeName:              listRequest.TypeFilter.GetName(),
 			})
@@ -2390,7 +2508,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		if wh.config.DisableListVisibilityByFilter(domain) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
+			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByStatus(ctx, &persistence.ListClosedWorkflowExecutionsByStatusRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				Status:                        listRequest.GetStatusFilter(),
 			})
@@ -2398,7 +2516,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		wh.Service.GetLogger().Info("List closed workflow with filter",
 			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByStatus)
 	} else {
-		persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutions(&baseReq)
+		persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutions(ctx, &baseReq)
 	}
 
 	if err != nil {
@@ -2413,6 +2531,8 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 
 // ListWorkflowExecutions - retrieves info for workflow executions in a domain
 func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, listRequest *gen.ListWorkflowExecutionsRequest) (resp *gen.ListWorkflowExecutionsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ListWorkflowExecutions")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendListWorkflowExecutionsScope, listRequest)
@@ -2460,7 +2580,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, listReque
 		NextPageToken: listRequest.NextPageToken,
 		Query:         listRequest.GetQuery(),
 	}
-	persistenceResp, err := wh.visibilityMgr.ListWorkflowExecutions(req)
+	persistenceResp, err := wh.visibilityMgr.ListWorkflowExecutions(ctx, req)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2473,6 +2593,8 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, listReque
 
 // ScanWorkflowExecutions - retrieves info for large amount of workflow executions in a domain without order
 func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, listRequest *gen.ListWorkflowExecutionsRequest) (resp *gen.ListWorkflowExecutionsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ScanWorkflowExecutions")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendScanWorkflowExecutionsScope, listRequest)
@@ -2520,7 +2642,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, listReque
 		NextPageToken: listRequest.NextPageToken,
 		Query:         listRequest.GetQuery(),
 	}
-	persistenceResp, err := wh.visibilityMgr.ScanWorkflowExecutions(req)
+	persistenceResp, err := wh.visibilityMgr.ScanWorkflowExecutions(ctx, req)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2533,6 +2655,8 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, listReque
 
 // CountWorkflowExecutions - count number of workflow executions in a domain
 func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, countRequest *gen.CountWorkflowExecutionsRequest) (resp *gen.CountWorkflowExecutionsResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.CountWorkflowExecutions")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendCountWorkflowExecutionsScope, countRequest)
@@ -2569,7 +2693,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, countReq
 		Domain:     domain,
 		Query:      countRequest.GetQuery(),
 	}
-	persistenceResp, err := wh.visibilityMgr.CountWorkflowExecutions(req)
+	persistenceResp, err := wh.visibilityMgr.CountWorkflowExecutions(ctx, req)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2582,6 +2706,8 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, countReq
 
 // GetSearchAttributes return valid indexed keys
 func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context) (resp *gen.GetSearchAttributesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.GetSearchAttributes")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfile(metrics.FrontendGetSearchAttributesScope)
@@ -2600,6 +2726,8 @@ func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context) (resp *gen.G
 
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest *gen.ResetStickyTaskListRequest) (resp *gen.ResetStickyTaskListResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.ResetStickyTaskList")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendResetStickyTaskListScope, resetRequest)
@@ -2642,6 +2770,8 @@ func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest
 // QueryWorkflow returns query result for a specified workflow execution
 func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 	queryRequest *gen.QueryWorkflowRequest) (resp *gen.QueryWorkflowResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.QueryWorkflow")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendQueryWorkflowScope, queryRequest)
@@ -2744,6 +2874,8 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, request *gen.DescribeWorkflowExecutionRequest) (resp *gen.DescribeWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.DescribeWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeWorkflowExecutionScope, request)
@@ -2789,6 +2921,8 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 // pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
 // it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.DescribeTaskListRequest) (resp *gen.DescribeTaskListResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, wh.GetTracer(), "frontend.DescribeTaskList")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeTaskListScope, request)
@@ -2841,6 +2975,7 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 }
 
 func (wh *WorkflowHandler) getHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	domainID string,
 	execution gen.WorkflowExecution,
@@ -2857,7 +2992,7 @@ func (wh *WorkflowHandler) getHistory(
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
 		shardID := common.WorkflowIDToHistoryShard(*execution.WorkflowId, wh.config.NumHistoryShards)
 		var err error
-		historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
+		historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(ctx, wh.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    nextEventID,
@@ -2869,7 +3004,7 @@ func (wh *WorkflowHandler) getHistory(
 			return nil, nil, err
 		}
 	} else {
-		response, err := wh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		response, err := wh.historyMgr.GetWorkflowExecutionHistory(ctx, &persistence.GetWorkflowExecutionHistoryRequest{
 			DomainID:      domainID,
 			Execution:     execution,
 			FirstEventID:  firstEventID,
@@ -2886,6 +3021,10 @@ func (wh *WorkflowHandler) getHistory(
 		size = response.Size
 	}
 
+	if err := wh.payloadOffloader.HydrateEvents(ctx, historyEvents); err != nil {
+		return nil, nil, err
+	}
+
 	if len(historyEvents) > 0 {
 		scope.RecordTimer(metrics.HistorySize, time.Duration(size))
 
@@ -3095,6 +3234,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		}
 		scope = scope.Tagged(metrics.DomainTag(domain.GetInfo().Name))
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			scope,
 			domainID,
 			*matchingResp.WorkflowExecution,
@@ -3223,22 +3363,12 @@ func (wh *WorkflowHandler) getArchivedHistory(
 func (wh *WorkflowHandler) convertIndexedKeyToThrift(keys map[string]interface{}) map[string]gen.IndexedValueType {
 	converted := make(map[string]gen.IndexedValueType)
 	for k, v := range keys {
-		switch v {
-		case gen.IndexedValueTypeString:
-			converted[k] = gen.IndexedValueTypeString
-		case gen.IndexedValueTypeKeyword:
-			converted[k] = gen.IndexedValueTypeKeyword
-		case gen.IndexedValueTypeInt:
-			converted[k] = gen.IndexedValueTypeInt
-		case gen.IndexedValueTypeDouble:
-			converted[k] = gen.IndexedValueTypeDouble
-		case gen.IndexedValueTypeBool:
-			converted[k] = gen.IndexedValueTypeBool
-		case gen.IndexedValueTypeDatetime:
-			converted[k] = gen.IndexedValueTypeDatetime
-		default:
+		valueType, ok := common.ConvertIndexedValueTypeToThriftType(v)
+		if !ok {
 			wh.GetLogger().Error("unknown index value type", tag.Value(v))
+			continue
 		}
+		converted[k] = valueType
 	}
 	return converted
 }
@@ -3256,6 +3386,7 @@ func (wh *WorkflowHandler) validateSearchAttributes(input *gen.SearchAttributes,
 		return fmt.Errorf("number of keys %d exceed limit", lengthOfFields)
 	}
 
+	validAttributes := wh.config.ValidSearchAttributes()
 	totalSize := 0
 	for key, val := range fields {
 		if !wh.visibilityQueryValidator.IsValidSearchAttributes(key) {
@@ -3273,6 +3404,11 @@ func (wh *WorkflowHandler) validateSearchAttributes(input *gen.SearchAttributes,
 				Error("value size of search attribute exceed limit")
 			return fmt.Errorf("size limit exceed for key %s", key)
 		}
+		if err := validateSearchAttributeValue(key, val, validAttributes[key]); err != nil {
+			wh.GetLogger().WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain), tag.Error(err)).
+				Error("value of search attribute doesn't match its type")
+			return err
+		}
 		totalSize += len(key) + len(val)
 	}
 
@@ -3285,6 +3421,22 @@ func (wh *WorkflowHandler) validateSearchAttributes(input *gen.SearchAttributes,
 	return nil
 }
 
+// validateSearchAttributeValue checks the value decodes as the registered type of the search attribute,
+// otherwise the ES indexer would fail to index the document of the workflow
+func validateSearchAttributeValue(key string, val []byte, fieldType interface{}) error {
+	valueType, ok := common.ConvertIndexedValueTypeToThriftType(fieldType)
+	if !ok {
+		// the type is misconfigured in dynamic config, leave it to the indexer
+		return nil
+	}
+	if _, err := common.DeserializeSearchAttributeValue(val, valueType); err != nil {
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("%s is not a valid value of search attribute %s, expected type is %v", val, key, valueType),
+		}
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, domain string) bool {
 	return wh.config.EnableReadVisibilityFromES(domain) &&
 		pageSize > int32(wh.config.ESIndexMaxResultWindow())
@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -33,12 +35,12 @@ type mockConflictResolver struct {
 var _ conflictResolver = (*mockConflictResolver)(nil)
 
 // reset is mock implementation for reset of conflictResolver
-func (_m *mockConflictResolver) reset(_a0 string, _a1 int64, _a2 int, _a3 string, _a4 int64, _a5 *persistence.WorkflowExecutionInfo) (mutableState, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+func (_m *mockConflictResolver) reset(_a0 context.Context, _a1 string, _a2 int64, _a3 int, _a4 string, _a5 int64, _a6 *persistence.WorkflowExecutionInfo) (mutableState, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func(string, int64, int, string, int64, *persistence.WorkflowExecutionInfo) mutableState); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, string, int64, *persistence.WorkflowExecutionInfo) mutableState); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(mutableState)
@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	gohistory "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -89,6 +90,29 @@ func (_m *MockHistoryEngine) DescribeMutableState(ctx context.Context, request *
 	return r0, r1
 }
 
+// VerifyMutableState is mock implementation for VerifyMutableState of HistoryEngine
+func (_m *MockHistoryEngine) VerifyMutableState(ctx context.Context, request *gohistory.VerifyMutableStateRequest) (*gohistory.VerifyMutableStateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.VerifyMutableStateResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.VerifyMutableStateRequest) *gohistory.VerifyMutableStateResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.VerifyMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.VerifyMutableStateRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState is mock implementation for GetMutableState of HistoryEngine
 func (_m *MockHistoryEngine) GetMutableState(ctx context.Context, request *gohistory.GetMutableStateRequest) (*gohistory.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -493,4 +517,110 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// GetReplicationMessages is mock implementation for GetReplicationMessages of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, token *replicator.ReplicationToken) (*replicator.ReplicationMessages, error) {
+	ret := _m.Called(ctx, pollingCluster, token)
+
+	var r0 *replicator.ReplicationMessages
+	if rf, ok := ret.Get(0).(func(string, *replicator.ReplicationToken) *replicator.ReplicationMessages); ok {
+		r0 = rf(pollingCluster, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReplicationMessages)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *replicator.ReplicationToken) error); ok {
+		r1 = rf(pollingCluster, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadDLQMessages is mock implementation for ReadDLQMessages of HistoryEngine
+func (_m *MockHistoryEngine) ReadDLQMessages(ctx context.Context, request *replicator.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.ReadDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(*replicator.ReadDLQMessagesRequest) *replicator.ReadDLQMessagesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReadDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*replicator.ReadDLQMessagesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDLQMessages is mock implementation for PurgeDLQMessages of HistoryEngine
+func (_m *MockHistoryEngine) PurgeDLQMessages(ctx context.Context, request *replicator.PurgeDLQMessagesRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*replicator.PurgeDLQMessagesRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MergeDLQMessages is mock implementation for MergeDLQMessages of HistoryEngine
+func (_m *MockHistoryEngine) MergeDLQMessages(ctx context.Context, request *replicator.MergeDLQMessagesRequest) (*replicator.MergeDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.MergeDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(*replicator.MergeDLQMessagesRequest) *replicator.MergeDLQMessagesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.MergeDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*replicator.MergeDLQMessagesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReplicationStatus is mock implementation for DescribeReplicationStatus of HistoryEngine
+func (_m *MockHistoryEngine) DescribeReplicationStatus(ctx context.Context, request *replicator.DescribeReplicationStatusRequest) (*replicator.DescribeReplicationStatusResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.DescribeReplicationStatusResponse
+	if rf, ok := ret.Get(0).(func(*replicator.DescribeReplicationStatusRequest) *replicator.DescribeReplicationStatusResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.DescribeReplicationStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*replicator.DescribeReplicationStatusRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
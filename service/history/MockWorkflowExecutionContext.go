@@ -39,19 +39,19 @@ type mockWorkflowExecutionContext struct {
 
 var _ workflowExecutionContext = (*mockWorkflowExecutionContext)(nil)
 
-func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForActive(_a0 mutableState, _a1 bool) (int, persistence.Task, error) {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForActive(_a0 context.Context, _a1 mutableState, _a2 bool) (int, persistence.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 int
-	if rf, ok := ret.Get(0).(func(mutableState, bool) int); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, mutableState, bool) int); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
 	var r1 persistence.Task
-	if rf, ok := ret.Get(1).(func(mutableState, bool) persistence.Task); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, mutableState, bool) persistence.Task); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(persistence.Task)
@@ -59,8 +59,8 @@ func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForActive(_a0 muta
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(mutableState, bool) error); ok {
-		r2 = rf(_a0, _a1)
+	if rf, ok := ret.Get(2).(func(context.Context, mutableState, bool) error); ok {
+		r2 = rf(_a0, _a1, _a2)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -68,19 +68,19 @@ func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForActive(_a0 muta
 	return r0, r1, r2
 }
 
-func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForStandby(_a0 mutableState, _a1 []*workflow.HistoryEvent) (int, persistence.Task, error) {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForStandby(_a0 context.Context, _a1 mutableState, _a2 []*workflow.HistoryEvent) (int, persistence.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 int
-	if rf, ok := ret.Get(0).(func(mutableState, []*workflow.HistoryEvent) int); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, mutableState, []*workflow.HistoryEvent) int); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
 	var r1 persistence.Task
-	if rf, ok := ret.Get(1).(func(mutableState, []*workflow.HistoryEvent) persistence.Task); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, mutableState, []*workflow.HistoryEvent) persistence.Task); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(persistence.Task)
@@ -88,8 +88,8 @@ func (_m *mockWorkflowExecutionContext) appendFirstBatchEventsForStandby(_a0 mut
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(mutableState, []*workflow.HistoryEvent) error); ok {
-		r2 = rf(_a0, _a1)
+	if rf, ok := ret.Get(2).(func(context.Context, mutableState, []*workflow.HistoryEvent) error); ok {
+		r2 = rf(_a0, _a1, _a2)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -101,12 +101,12 @@ func (_m *mockWorkflowExecutionContext) clear() {
 	_m.Called()
 }
 
-func (_m *mockWorkflowExecutionContext) continueAsNewWorkflowExecution(_a0 []byte, _a1 mutableState, _a2 []persistence.Task, _a3 []persistence.Task, _a4 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+func (_m *mockWorkflowExecutionContext) continueAsNewWorkflowExecution(_a0 context.Context, _a1 []byte, _a2 mutableState, _a3 []persistence.Task, _a4 []persistence.Task, _a5 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, mutableState, []persistence.Task, []persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, mutableState, []persistence.Task, []persistence.Task, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -114,13 +114,13 @@ func (_m *mockWorkflowExecutionContext) continueAsNewWorkflowExecution(_a0 []byt
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) createWorkflowExecution(_a0 mutableState, _a1 string, _a2 bool, _a3 time.Time, _a4 []persistence.Task, _a5 []persistence.Task, _a6 []persistence.Task, _a7 int, _a8 string, _a9 int64) error {
+func (_m *mockWorkflowExecutionContext) createWorkflowExecution(_a0 context.Context, _a1 mutableState, _a2 string, _a3 bool, _a4 time.Time, _a5 []persistence.Task, _a6 []persistence.Task, _a7 []persistence.Task, _a8 int, _a9 string, _a10 int64) error {
 
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a9)
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a9, _a10)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(mutableState, string, bool, time.Time, []persistence.Task, []persistence.Task, []persistence.Task, int, string, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a9)
+	if rf, ok := ret.Get(0).(func(context.Context, mutableState, string, bool, time.Time, []persistence.Task, []persistence.Task, []persistence.Task, int, string, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a9, _a10)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -173,12 +173,12 @@ func (_m *mockWorkflowExecutionContext) getLogger() log.Logger {
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) loadWorkflowExecution() (mutableState, error) {
-	ret := _m.Called()
+func (_m *mockWorkflowExecutionContext) loadWorkflowExecution(_a0 context.Context) (mutableState, error) {
+	ret := _m.Called(_a0)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func() mutableState); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) mutableState); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(mutableState)
@@ -186,8 +186,8 @@ func (_m *mockWorkflowExecutionContext) loadWorkflowExecution() (mutableState, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,12 +208,12 @@ func (_m *mockWorkflowExecutionContext) lock(_a0 context.Context) error {
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) appendFirstBatchHistoryForContinueAsNew(_a0 mutableState, _a1 int64) error {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) appendFirstBatchHistoryForContinueAsNew(_a0 context.Context, _a1 mutableState, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(mutableState, int64) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, mutableState, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -221,12 +221,12 @@ func (_m *mockWorkflowExecutionContext) appendFirstBatchHistoryForContinueAsNew(
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) replicateWorkflowExecution(_a0 *h.ReplicateEventsRequest, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64, _a4 time.Time) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+func (_m *mockWorkflowExecutionContext) replicateWorkflowExecution(_a0 context.Context, _a1 *h.ReplicateEventsRequest, _a2 []persistence.Task, _a3 []persistence.Task, _a4 int64, _a5 time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*h.ReplicateEventsRequest, []persistence.Task, []persistence.Task, int64, time.Time) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(context.Context, *h.ReplicateEventsRequest, []persistence.Task, []persistence.Task, int64, time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -234,12 +234,12 @@ func (_m *mockWorkflowExecutionContext) replicateWorkflowExecution(_a0 *h.Replic
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 string, _a1 int64, _a2 int, _a3 []persistence.Task, _a4 []persistence.Task, _a5 []persistence.Task, _a6 mutableState) (mutableState, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 context.Context, _a1 string, _a2 int64, _a3 int, _a4 []persistence.Task, _a5 []persistence.Task, _a6 []persistence.Task, _a7 mutableState) (mutableState, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func(string, int64, int, []persistence.Task, []persistence.Task, []persistence.Task, mutableState) mutableState); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, []persistence.Task, []persistence.Task, []persistence.Task, mutableState) mutableState); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(mutableState)
@@ -247,8 +247,8 @@ func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 string, _a1 int64,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, int64, int, []persistence.Task, []persistence.Task, []persistence.Task, mutableState) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int, []persistence.Task, []persistence.Task, []persistence.Task, mutableState) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -256,11 +256,11 @@ func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 string, _a1 int64,
 	return r0, r1
 }
 
-func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState, _a1 bool, _a2, _a3 persistence.Task, _a4 mutableState, _a5, _a6, _a7, _a7_0 []persistence.Task, _a8 string, _a9, _a10 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a7_0, _a8, _a9, _a10)
+func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 context.Context, _a1 mutableState, _a2 bool, _a3, _a4 persistence.Task, _a5 mutableState, _a6, _a7, _a8, _a8_0 []persistence.Task, _a9 string, _a10, _a11 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a8_0, _a9, _a10, _a11)
 	var r0 error
-	if rf, ok := ret.Get(1).(func(mutableState, bool, persistence.Task, persistence.Task, mutableState, []persistence.Task, []persistence.Task, []persistence.Task, []persistence.Task, string, int64, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a7_0, _a8, _a9, _a10)
+	if rf, ok := ret.Get(1).(func(context.Context, mutableState, bool, persistence.Task, persistence.Task, mutableState, []persistence.Task, []persistence.Task, []persistence.Task, []persistence.Task, string, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a8_0, _a9, _a10, _a11)
 	} else {
 		r0 = ret.Error(1)
 	}
@@ -268,12 +268,12 @@ func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState,
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 []persistence.Task, _a1 []persistence.Task) ([]persistence.Task, []persistence.Task, error) {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 context.Context, _a1 []persistence.Task, _a2 []persistence.Task) ([]persistence.Task, []persistence.Task, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []persistence.Task
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task) []persistence.Task); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task) []persistence.Task); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]persistence.Task)
@@ -281,8 +281,8 @@ func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 []persistence.Ta
 	}
 
 	var r1 []persistence.Task
-	if rf, ok := ret.Get(1).(func([]persistence.Task, []persistence.Task) []persistence.Task); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, []persistence.Task, []persistence.Task) []persistence.Task); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]persistence.Task)
@@ -290,8 +290,8 @@ func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 []persistence.Ta
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func([]persistence.Task, []persistence.Task) error); ok {
-		r2 = rf(_a0, _a1)
+	if rf, ok := ret.Get(2).(func(context.Context, []persistence.Task, []persistence.Task) error); ok {
+		r2 = rf(_a0, _a1, _a2)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -303,12 +303,12 @@ func (_m *mockWorkflowExecutionContext) unlock() {
 	_m.Called()
 }
 
-func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionForStandby(_a0 []persistence.Task, _a1 []persistence.Task, _a2 int64, _a3 time.Time, _a4 bool, _a5 *historyBuilder, _a6 string) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionForStandby(_a0 context.Context, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64, _a4 time.Time, _a5 bool, _a6 *historyBuilder, _a7 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task, int64, time.Time, bool, *historyBuilder, string) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task, int64, time.Time, bool, *historyBuilder, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -316,12 +316,12 @@ func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionForStandby(_a0 []
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) updateWorkflowExecution(_a0 []persistence.Task, _a1 []persistence.Task, _a2 int64) error {
-	ret := _m.Called(_a0, _a1, _a2)
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecution(_a0 context.Context, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -329,12 +329,12 @@ func (_m *mockWorkflowExecutionContext) updateWorkflowExecution(_a0 []persistenc
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithContext(_a0 []byte, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithContext(_a0 context.Context, _a1 []byte, _a2 []persistence.Task, _a3 []persistence.Task, _a4 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []persistence.Task, []persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, []persistence.Task, []persistence.Task, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
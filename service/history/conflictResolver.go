@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
@@ -32,6 +34,7 @@ import (
 type (
 	conflictResolver interface {
 		reset(
+			ctx context.Context,
 			prevRunID string,
 			prevLastWriteVersion int64,
 			prevState int,
@@ -65,6 +68,7 @@ func newConflictResolver(shard ShardContext, context workflowExecutionContext, h
 }
 
 func (r *conflictResolverImpl) reset(
+	ctx context.Context,
 	prevRunID string,
 	prevLastWriteVersion int64,
 	prevState int,
@@ -73,6 +77,38 @@ func (r *conflictResolverImpl) reset(
 	info *persistence.WorkflowExecutionInfo,
 ) (mutableState, error) {
 
+	resetMutableStateBuilder, err := r.rebuild(ctx, requestID, replayEventID, info, true)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("All events applied for execution.", tag.WorkflowResetNextEventID(resetMutableStateBuilder.GetNextEventID()))
+	msBuilder, err := r.context.resetMutableState(
+		ctx,
+		prevRunID,
+		prevLastWriteVersion,
+		prevState,
+		nil,
+		nil,
+		nil,
+		resetMutableStateBuilder,
+	)
+	if err != nil {
+		r.logError("Conflict resolution err reset workflow.", err)
+	}
+	return msBuilder, err
+}
+
+// rebuild replays the history of the workflow execution up to replayEventID (inclusive)
+// into a new in memory mutable state, without persisting anything
+func (r *conflictResolverImpl) rebuild(
+	ctx context.Context,
+	requestID string,
+	replayEventID int64,
+	info *persistence.WorkflowExecutionInfo,
+	withReplicationState bool,
+) (*mutableStateBuilder, error) {
+
 	domainID := r.context.getDomainID()
 	execution := *r.context.getExecution()
 	startTime := info.StartTimestamp
@@ -91,7 +127,7 @@ func (r *conflictResolverImpl) reset(
 
 	eventsToApply := replayNextEventID - common.FirstEventID
 	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
-		history, size, lastFirstEventID, nextPageToken, err = r.getHistory(domainID, execution, common.FirstEventID, replayNextEventID, nextPageToken, eventStoreVersion, branchToken)
+		history, size, lastFirstEventID, nextPageToken, err = r.getHistory(ctx, domainID, execution, common.FirstEventID, replayNextEventID, nextPageToken, eventStoreVersion, branchToken)
 		if err != nil {
 			r.logError("Conflict resolution err getting history.", err)
 			return nil, err
@@ -113,13 +149,22 @@ func (r *conflictResolverImpl) reset(
 		firstEvent := history[0]
 		lastEvent = history[len(history)-1]
 		if firstEvent.GetEventId() == common.FirstEventID {
-			resetMutableStateBuilder = newMutableStateBuilderWithReplicationState(
-				r.clusterMetadata.GetCurrentClusterName(),
-				r.shard,
-				r.shard.GetEventsCache(),
-				r.logger,
-				firstEvent.GetVersion(),
-			)
+			if withReplicationState {
+				resetMutableStateBuilder = newMutableStateBuilderWithReplicationState(
+					r.clusterMetadata.GetCurrentClusterName(),
+					r.shard,
+					r.shard.GetEventsCache(),
+					r.logger,
+					firstEvent.GetVersion(),
+				)
+			} else {
+				resetMutableStateBuilder = newMutableStateBuilder(
+					r.clusterMetadata.GetCurrentClusterName(),
+					r.shard,
+					r.shard.GetEventsCache(),
+					r.logger,
+				)
+			}
 
 			resetMutableStateBuilder.executionInfo.EventStoreVersion = eventStoreVersion
 			sBuilder = newStateBuilder(r.shard, resetMutableStateBuilder, r.logger)
@@ -135,6 +180,10 @@ func (r *conflictResolverImpl) reset(
 		resetMutableStateBuilder.IncrementHistorySize(size)
 	}
 
+	if resetMutableStateBuilder == nil {
+		return nil, &shared.InternalServiceError{Message: "Unable to rebuild mutable state, workflow history is empty."}
+	}
+
 	// reset branchToken to the original one(it has been set to a wrong branchToken in applyEvents for startEvent)
 	resetMutableStateBuilder.executionInfo.BranchToken = branchToken
 	// similarly, in case of resetWF, the runID in startEvent is incorrect
@@ -145,30 +194,19 @@ func (r *conflictResolverImpl) reset(
 	// the last updated time is not important here, since this should be updated with event time afterwards
 	resetMutableStateBuilder.executionInfo.LastUpdatedTimestamp = startTime
 
-	sourceCluster := r.clusterMetadata.ClusterNameForFailoverVersion(lastEvent.GetVersion())
-	resetMutableStateBuilder.UpdateReplicationStateLastEventID(sourceCluster, lastEvent.GetVersion(), replayEventID)
-
-	r.logger.Info("All events applied for execution.", tag.WorkflowResetNextEventID(resetMutableStateBuilder.GetNextEventID()))
-	msBuilder, err := r.context.resetMutableState(
-		prevRunID,
-		prevLastWriteVersion,
-		prevState,
-		nil,
-		nil,
-		nil,
-		resetMutableStateBuilder,
-	)
-	if err != nil {
-		r.logError("Conflict resolution err reset workflow.", err)
+	if withReplicationState {
+		sourceCluster := r.clusterMetadata.ClusterNameForFailoverVersion(lastEvent.GetVersion())
+		resetMutableStateBuilder.UpdateReplicationStateLastEventID(sourceCluster, lastEvent.GetVersion(), replayEventID)
 	}
-	return msBuilder, err
+
+	return resetMutableStateBuilder, nil
 }
 
-func (r *conflictResolverImpl) getHistory(domainID string, execution shared.WorkflowExecution, firstEventID,
+func (r *conflictResolverImpl) getHistory(ctx context.Context, domainID string, execution shared.WorkflowExecution, firstEventID,
 	nextEventID int64, nextPageToken []byte, eventStoreVersion int32, branchToken []byte) ([]*shared.HistoryEvent, int, int64, []byte, error) {
 
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		response, err := r.historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		response, err := r.historyV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    nextEventID,
@@ -181,7 +219,7 @@ func (r *conflictResolverImpl) getHistory(domainID string, execution shared.Work
 		}
 		return response.HistoryEvents, response.Size, response.LastFirstEventID, response.NextPageToken, nil
 	}
-	response, err := r.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+	response, err := r.historyMgr.GetWorkflowExecutionHistory(ctx, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
 		FirstEventID:  firstEventID,
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -39,7 +40,7 @@ const (
 
 type (
 	conflictResolverV2 interface {
-		reset(prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error)
+		reset(ctx context.Context, prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error)
 	}
 
 	conflictResolverV2Impl struct {
@@ -64,7 +65,7 @@ func newConflictResolverV2(shard ShardContext, context workflowExecutionContext,
 }
 
 //TODO: the branchToken is the LCA branch between existing versionhistories and new version history
-func (r *conflictResolverV2Impl) reset(prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo, branchToken []byte) (mutableState, error) {
+func (r *conflictResolverV2Impl) reset(ctx context.Context, prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo, branchToken []byte) (mutableState, error) {
 	domainID := r.context.getDomainID()
 	execution := *r.context.getExecution()
 	replayNextEventID := replayEventID + 1
@@ -73,7 +74,7 @@ func (r *conflictResolverV2Impl) reset(prevRunID string, requestID string, repla
 	var sBuilder stateBuilder
 	var lastEvent *shared.HistoryEvent
 
-	iter := collection.NewPagingIterator(r.getHistoryBatch(domainID, execution, common.FirstEventID, replayNextEventID, nil, conflictResolverEventStoreVersion, branchToken))
+	iter := collection.NewPagingIterator(r.getHistoryBatch(ctx, domainID, execution, common.FirstEventID, replayNextEventID, nil, conflictResolverEventStoreVersion, branchToken))
 	for iter.HasNext() {
 		batch, err := iter.Next()
 		if err != nil {
@@ -101,18 +102,19 @@ func (r *conflictResolverV2Impl) reset(prevRunID string, requestID string, repla
 		}
 	}
 
-	msBuilder, err := r.resetMutableState(resetMutableStateBuilder, info, lastEvent, replayEventID, prevRunID)
+	msBuilder, err := r.resetMutableState(ctx, resetMutableStateBuilder, info, lastEvent, replayEventID, prevRunID)
 	if err != nil {
 		r.logger.Error("conflict resolution err reset workflow.", tag.Error(err))
 	}
 	return msBuilder, err
 }
 
-func (r *conflictResolverV2Impl) getHistoryBatch(domainID string, execution shared.WorkflowExecution, firstEventID,
+func (r *conflictResolverV2Impl) getHistoryBatch(ctx context.Context, domainID string, execution shared.WorkflowExecution, firstEventID,
 	nextEventID int64, nextPageToken []byte, eventStoreVersion int32, branchToken []byte) collection.PaginationFn {
 	return func(paginationToken []byte) ([]interface{}, []byte, error) {
 		var paginateItems []interface{}
 		_, historyBatches, token, _, err := PaginateHistory(
+			ctx,
 			nil,
 			r.historyV2Mgr,
 			nil,
@@ -148,7 +150,7 @@ func (r *conflictResolverV2Impl) initializeBuilders(firstEvent *shared.HistoryEv
 	return resetMutableStateBuilder, sBuilder
 }
 
-func (r *conflictResolverV2Impl) resetMutableState(msBuilder *mutableStateBuilder,
+func (r *conflictResolverV2Impl) resetMutableState(ctx context.Context, msBuilder *mutableStateBuilder,
 	execution *persistence.WorkflowExecutionInfo,
 	lastEvent *shared.HistoryEvent,
 	replayEventID int64,
@@ -163,5 +165,5 @@ func (r *conflictResolverV2Impl) resetMutableState(msBuilder *mutableStateBuilde
 	// the last updated time is not important here, since this should be updated with event time afterwards
 	msBuilder.executionInfo.LastUpdatedTimestamp = execution.StartTimestamp
 	r.logger.Info("All events applied for execution.", tag.WorkflowResetNextEventID(msBuilder.GetNextEventID()))
-	return r.context.resetMutableState(prevRunID, 0, 0, nil, nil, nil, msBuilder)
+	return r.context.resetMutableState(ctx, prevRunID, 0, 0, nil, nil, nil, msBuilder)
 }
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -109,6 +111,7 @@ func (s *conflictResolverSuite) SetupTest() {
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 		eventsCache:               s.mockEventsCache,
 		timeSource:                clock.NewRealTimeSource(),
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 	}
 	s.mockContext = newWorkflowExecutionContext(validDomainID, shared.WorkflowExecution{
 		WorkflowId: common.StringPtr("some random workflow ID"),
@@ -157,7 +160,7 @@ func (s *conflictResolverSuite) TestGetHistory() {
 	}
 
 	pageToken := []byte("some random token")
-	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
 		FirstEventID:  common.FirstEventID,
@@ -169,13 +172,13 @@ func (s *conflictResolverSuite) TestGetHistory() {
 		NextPageToken:    pageToken,
 		LastFirstEventID: event1.GetEventId(),
 	}, nil)
-	history, _, firstEventID, token, err := s.conflictResolver.getHistory(domainID, execution, common.FirstEventID, nextEventID, nil, 0, nil)
+	history, _, firstEventID, token, err := s.conflictResolver.getHistory(context.Background(), domainID, execution, common.FirstEventID, nextEventID, nil, 0, nil)
 	s.Nil(err)
 	s.Equal(history, []*shared.HistoryEvent{event1, event2})
 	s.Equal(pageToken, token)
 	s.Equal(firstEventID, event1.GetEventId())
 
-	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
 		FirstEventID:  common.FirstEventID,
@@ -187,7 +190,7 @@ func (s *conflictResolverSuite) TestGetHistory() {
 		NextPageToken:    nil,
 		LastFirstEventID: event4.GetEventId(),
 	}, nil)
-	history, _, firstEventID, token, err = s.conflictResolver.getHistory(domainID, execution, common.FirstEventID, nextEventID, token, 0, nil)
+	history, _, firstEventID, token, err = s.conflictResolver.getHistory(context.Background(), domainID, execution, common.FirstEventID, nextEventID, token, 0, nil)
 	s.Nil(err)
 	s.Equal(history, []*shared.HistoryEvent{event3, event4, event5})
 	s.Empty(token)
@@ -222,7 +225,7 @@ func (s *conflictResolverSuite) TestReset() {
 	}
 
 	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", event1.GetVersion()).Return(sourceCluster)
-	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
 		FirstEventID:  common.FirstEventID,
@@ -269,7 +272,7 @@ func (s *conflictResolverSuite) TestReset() {
 	// this is only a shallow test, meaning
 	// the mutable state only has the minimal information
 	// so we can test the conflict resolver
-	s.mockExecutionMgr.On("ResetMutableState", &persistence.ResetMutableStateRequest{
+	s.mockExecutionMgr.On("ResetMutableState", mock.Anything, &persistence.ResetMutableStateRequest{
 		PrevRunID:            prevRunID,
 		PrevLastWriteVersion: prevLastWriteVersion,
 		PrevState:            prevState,
@@ -299,7 +302,7 @@ func (s *conflictResolverSuite) TestReset() {
 		InsertTimerTasks:          nil,
 		Encoding:                  common.EncodingType(s.mockShard.GetConfig().EventEncodingType(domainID)),
 	}).Return(nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
 		DomainID:  domainID,
 		Execution: execution,
 	}).Return(&persistence.GetWorkflowExecutionResponse{}, nil).Once() // return empty resoonse since we are not testing the load
@@ -309,6 +312,6 @@ func (s *conflictResolverSuite) TestReset() {
 	), nil)
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	_, err := s.conflictResolver.reset(prevRunID, prevLastWriteVersion, prevState, createRequestID, nextEventID-1, executionInfo)
+	_, err := s.conflictResolver.reset(context.Background(), prevRunID, prevLastWriteVersion, prevState, createRequestID, nextEventID-1, executionInfo)
 	s.Nil(err)
 }
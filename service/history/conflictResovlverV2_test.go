@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -100,6 +102,7 @@ func (s *conflictResolverV2Suite) SetupTest() {
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 		eventsCache:               s.mockEventsCache,
 		timeSource:                clock.NewRealTimeSource(),
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 	}
 	s.mockContext = newWorkflowExecutionContext(validDomainID, shared.WorkflowExecution{
 		WorkflowId: common.StringPtr("some random workflow ID"),
@@ -149,7 +152,7 @@ func (s *conflictResolverV2Suite) TestGetHistoryBatch() {
 	history := []*shared.History{{[]*shared.HistoryEvent{event1, event2, event3, event4, event5}}}
 	pageToken := []byte("some random token")
 
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", &persistence.ReadHistoryBranchRequest{
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   []byte{},
 		MinEventID:    common.FirstEventID,
 		MaxEventID:    nextEventID,
@@ -163,7 +166,7 @@ func (s *conflictResolverV2Suite) TestGetHistoryBatch() {
 		LastFirstEventID: int64(0),
 	}, nil)
 
-	paginationFn := s.conflictResolver.getHistoryBatch(domainID, execution, common.FirstEventID, nextEventID,
+	paginationFn := s.conflictResolver.getHistoryBatch(context.Background(), domainID, execution, common.FirstEventID, nextEventID,
 		pageToken, conflictResolverEventStoreVersion, []byte{})
 	iter := collection.NewPagingIterator(paginationFn)
 
@@ -242,8 +245,8 @@ func (s *conflictResolverV2Suite) TestReset() {
 	// this is only a shallow test, meaning
 	// the mutable state only has the minimal information
 	// so we can test the conflict resolver
-	s.mockExecutionMgr.On("ResetMutableState", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+	s.mockExecutionMgr.On("ResetMutableState", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
 		DomainID:  domainID,
 		Execution: execution,
 	}).Return(&persistence.GetWorkflowExecutionResponse{}, nil).Once() // return empty resoonse since we are not testing the load
@@ -252,13 +255,13 @@ func (s *conflictResolverV2Suite) TestReset() {
 		&persistence.DomainInfo{}, nil, "", nil,
 	), nil)
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
 		History:          history,
 		NextPageToken:    nil,
 		Size:             len(history[0].Events),
 		LastFirstEventID: int64(0),
 	}, nil)
 
-	_, err := s.conflictResolver.reset(prevRunID, createRequestID, nextEventID-1, executionInfo, []byte{})
+	_, err := s.conflictResolver.reset(context.Background(), prevRunID, createRequestID, nextEventID-1, executionInfo, []byte{})
 	s.Nil(err)
 }
@@ -27,6 +27,7 @@ import (
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
@@ -294,7 +295,7 @@ func (handler *decisionHandlerImpl) handleDecisionTaskCompleted(
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err := context.loadWorkflowExecution()
+		msBuilder, err := context.loadWorkflowExecution(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -383,7 +384,7 @@ Update_History_Loop:
 			)
 			decisionBlobSizeChecker := newDecisionBlobSizeChecker(
 				handler.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name),
-				handler.config.BlobSizeLimitError(domainEntry.GetInfo().Name),
+				getDecisionBlobSizeLimitError(handler.config, handler.shard.GetPayloadOffloader(), domainEntry),
 				completedEvent.GetEventId(),
 				msBuilder,
 				handler.metricsClient,
@@ -437,7 +438,7 @@ Update_History_Loop:
 				tag.WorkflowID(token.WorkflowID),
 				tag.WorkflowRunID(token.RunID),
 				tag.WorkflowDomainID(domainID))
-			msBuilder, err = handler.historyEngine.failDecision(context, scheduleID, startedID, failCause, []byte(failMessage), request)
+			msBuilder, err = handler.historyEngine.failDecision(ctx, context, scheduleID, startedID, failCause, []byte(failMessage), request)
 			if err != nil {
 				return nil, err
 			}
@@ -515,10 +516,10 @@ Update_History_Loop:
 		var updateErr error
 		if continueAsNewBuilder != nil {
 			continueAsNewTimerTasks = msBuilder.GetContinueAsNew().TimerTasks
-			updateErr = context.continueAsNewWorkflowExecution(request.ExecutionContext, continueAsNewBuilder,
+			updateErr = context.continueAsNewWorkflowExecution(ctx, request.ExecutionContext, continueAsNewBuilder,
 				transferTasks, timerTasks, transactionID)
 		} else {
-			updateErr = context.updateWorkflowExecutionWithContext(request.ExecutionContext, transferTasks, timerTasks,
+			updateErr = context.updateWorkflowExecutionWithContext(ctx, request.ExecutionContext, transferTasks, timerTasks,
 				transactionID)
 		}
 
@@ -533,7 +534,7 @@ Update_History_Loop:
 			case *persistence.TransactionSizeLimitError:
 				// must reload mutable state because the first call to updateWorkflowExecutionWithContext or continueAsNewWorkflowExecution
 				// clears mutable state if error is returned
-				msBuilder, err = context.loadWorkflowExecution()
+				msBuilder, err = context.loadWorkflowExecution(ctx)
 				if err != nil {
 					return nil, err
 				}
@@ -556,7 +557,7 @@ Update_History_Loop:
 				if err != nil {
 					return nil, err
 				}
-				if err := context.updateWorkflowExecutionWithContext(request.ExecutionContext, transferTasks, timerTasks, transactionID); err != nil {
+				if err := context.updateWorkflowExecutionWithContext(ctx, request.ExecutionContext, transferTasks, timerTasks, transactionID); err != nil {
 					return nil, err
 				}
 				handler.timerProcessor.NotifyNewTimers(handler.currentClusterName, handler.shard.GetCurrentTime(handler.currentClusterName), timerTasks)
@@ -624,3 +625,13 @@ func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 
 	return response
 }
+
+// getDecisionBlobSizeLimitError returns the max size of decision payloads of the domain, payloads above the
+// offload threshold are moved to the blobstore, except for global domains whose events are replicated as is
+func getDecisionBlobSizeLimitError(config *Config, offloader payload.Offloader, domainEntry *cache.DomainCacheEntry) int {
+	domainName := domainEntry.GetInfo().Name
+	if !domainEntry.IsGlobalDomain() && offloader.IsEnabled(domainName) {
+		return config.OffloadedPayloadSizeLimitError(domainName)
+	}
+	return config.BlobSizeLimitError(domainName)
+}
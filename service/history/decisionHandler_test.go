@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/blobstore/payload"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type enabledOffloader struct {
+	payload.Offloader
+}
+
+func (o *enabledOffloader) IsEnabled(domainName string) bool {
+	return true
+}
+
+func TestGetDecisionBlobSizeLimitError(t *testing.T) {
+	config := NewDynamicConfigForTest()
+	config.BlobSizeLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(2 * 1024 * 1024)
+	config.OffloadedPayloadSizeLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(16 * 1024 * 1024)
+	info := &persistence.DomainInfo{ID: "domain-id", Name: "domain"}
+	localDomain := cache.NewLocalDomainCacheEntryForTest(info, &persistence.DomainConfig{}, "active", nil)
+	globalDomain := cache.NewGlobalDomainCacheEntryForTest(info, &persistence.DomainConfig{}, &persistence.DomainReplicationConfig{
+		ActiveClusterName: "active",
+		Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: "active"}, {ClusterName: "standby"}},
+	}, 1, nil)
+
+	require.Equal(t, 2*1024*1024, getDecisionBlobSizeLimitError(config, payload.NewOffloader(nil, nil), localDomain))
+	require.Equal(t, 16*1024*1024, getDecisionBlobSizeLimitError(config, &enabledOffloader{}, localDomain))
+	// payloads of global domains are never offloaded, so they keep the regular limit
+	require.Equal(t, 2*1024*1024, getDecisionBlobSizeLimitError(config, &enabledOffloader{}, globalDomain))
+}
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -58,6 +59,11 @@ type (
 	}
 )
 
+const (
+	// eventsCachePersistenceTimeout bounds the persistence reads made on a cache miss
+	eventsCachePersistenceTimeout = 10 * time.Second
+)
+
 var (
 	errEventNotFoundInBatch = &shared.InternalServiceError{Message: "History event not found within expected batch"}
 )
@@ -153,9 +159,12 @@ func (e *eventsCacheImpl) getHistoryEventFromStore(domainID, workflowID, runID s
 	sw := e.metricsClient.StartTimer(metrics.EventsCacheGetFromStoreScope, metrics.CacheLatency)
 	defer sw.Stop()
 
+	ctx, cancel := context.WithTimeout(context.Background(), eventsCachePersistenceTimeout)
+	defer cancel()
+
 	var historyEvents []*shared.HistoryEvent
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		response, err := e.eventsV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		response, err := e.eventsV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    eventID + 1,
@@ -171,7 +180,7 @@ func (e *eventsCacheImpl) getHistoryEventFromStore(domainID, workflowID, runID s
 
 		historyEvents = response.HistoryEvents
 	} else {
-		response, err := e.eventsMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		response, err := e.eventsMgr.GetWorkflowExecutionHistory(ctx, &persistence.GetWorkflowExecutionHistoryRequest{
 			DomainID: domainID,
 			Execution: shared.WorkflowExecution{
 				WorkflowId: common.StringPtr(workflowID),
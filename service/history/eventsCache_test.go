@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
@@ -118,7 +119,7 @@ func (s *eventsCacheSuite) TestEventsCacheMissSuccess() {
 		ActivityTaskStartedEventAttributes: &shared.ActivityTaskStartedEventAttributes{},
 	}
 
-	s.mockEventsMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+	s.mockEventsMgr.On("GetWorkflowExecutionHistory", mock.Anything, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     shared.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)},
 		FirstEventID:  event2ID,
@@ -172,7 +173,7 @@ func (s *eventsCacheSuite) TestEventsCacheMissMultiEventsBatchSuccess() {
 		ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{},
 	}
 
-	s.mockEventsMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+	s.mockEventsMgr.On("GetWorkflowExecutionHistory", mock.Anything, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     shared.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)},
 		FirstEventID:  event1.GetEventId(),
@@ -226,7 +227,7 @@ func (s *eventsCacheSuite) TestEventsCacheMissMultiEventsBatchV2Success() {
 		ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{},
 	}
 
-	s.mockEventsV2Mgr.On("ReadHistoryBranch", &persistence.ReadHistoryBranchRequest{
+	s.mockEventsV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   []byte("store_token"),
 		MinEventID:    event1.GetEventId(),
 		MaxEventID:    event6.GetEventId() + 1,
@@ -252,7 +253,7 @@ func (s *eventsCacheSuite) TestEventsCacheMissFailure() {
 	runID := "events-cache-miss-failure-run-id"
 
 	expectedErr := errors.New("persistence call failed")
-	s.mockEventsMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+	s.mockEventsMgr.On("GetWorkflowExecutionHistory", mock.Anything, &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     shared.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)},
 		FirstEventID:  int64(11),
@@ -272,7 +273,7 @@ func (s *eventsCacheSuite) TestEventsCacheMissV2Failure() {
 	runID := "events-cache-miss-failure-run-id"
 
 	expectedErr := errors.New("persistence call failed")
-	s.mockEventsV2Mgr.On("ReadHistoryBranch", &persistence.ReadHistoryBranchRequest{
+	s.mockEventsV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   []byte("store_token"),
 		MinEventID:    int64(11),
 		MaxEventID:    int64(15),
@@ -302,7 +303,7 @@ func (s *eventsCacheSuite) TestEventsCacheDisableSuccess() {
 		ActivityTaskStartedEventAttributes: &shared.ActivityTaskStartedEventAttributes{},
 	}
 
-	s.mockEventsV2Mgr.On("ReadHistoryBranch", &persistence.ReadHistoryBranchRequest{
+	s.mockEventsV2Mgr.On("ReadHistoryBranch", mock.Anything, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   []byte("store_token"),
 		MinEventID:    event2.GetEventId(),
 		MaxEventID:    event2.GetEventId() + 1,
@@ -30,10 +30,12 @@ import (
 	"github.com/uber/cadence/.gen/go/health/metaserver"
 	hist "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceserver"
+	r "github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
@@ -44,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
+	"github.com/uber/cadence/common/tracing"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc/yarpcerrors"
 )
@@ -70,6 +73,7 @@ type (
 		historyEventNotifier  historyEventNotifier
 		publisher             messaging.Producer
 		rateLimiter           tokenbucket.TokenBucket
+		payloadOffloader      payload.Offloader
 		service.Service
 	}
 )
@@ -85,6 +89,7 @@ var (
 	errRunIDNotValid           = &gen.BadRequestError{Message: "RunID is not valid UUID."}
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
+	errClusterNameNotSet       = &gen.BadRequestError{Message: "Cluster name not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
@@ -93,7 +98,8 @@ var (
 func NewHandler(sVice service.Service, config *Config, shardManager persistence.ShardManager,
 	metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
-	executionMgrFactory persistence.ExecutionManagerFactory, publicClient workflowserviceclient.Interface) *Handler {
+	executionMgrFactory persistence.ExecutionManagerFactory, publicClient workflowserviceclient.Interface,
+	payloadOffloader payload.Offloader) *Handler {
 	handler := &Handler{
 		Service:             sVice,
 		config:              config,
@@ -106,6 +112,7 @@ func NewHandler(sVice service.Service, config *Config, shardManager persistence.
 		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
 		rateLimiter:         tokenbucket.NewDynamicTokenBucket(config.RPS, clock.NewRealTimeSource()),
 		publicClient:        publicClient,
+		payloadOffloader:    payloadOffloader,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -142,7 +149,8 @@ func (h *Handler) Start() error {
 	h.hServiceResolver = hServiceResolver
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
-	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
+	// history replication tasks are not published to kafka when remote clusters pull them
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() && !h.config.EnableReplicationPull() {
 		var err error
 		h.publisher, err = h.GetMessagingClient().NewProducerWithClusterName(h.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
@@ -153,7 +161,7 @@ func (h *Handler) Start() error {
 	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
 	h.domainCache.Start()
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
-		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
+		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient(), h.payloadOffloader)
 	h.metricsClient = h.GetMetricsClient()
 	h.historyEventNotifier = newHistoryEventNotifier(h.Service.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
@@ -196,6 +204,8 @@ func (h *Handler) Health(ctx context.Context) (*health.HealthStatus, error) {
 // RecordActivityTaskHeartbeat - Record Activity Task Heart beat.
 func (h *Handler) RecordActivityTaskHeartbeat(ctx context.Context,
 	wrappedRequest *hist.RecordActivityTaskHeartbeatRequest) (resp *gen.RecordActivityTaskHeartbeatResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RecordActivityTaskHeartbeat")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -242,6 +252,8 @@ func (h *Handler) RecordActivityTaskHeartbeat(ctx context.Context,
 // RecordActivityTaskStarted - Record Activity Task started.
 func (h *Handler) RecordActivityTaskStarted(ctx context.Context,
 	recordRequest *hist.RecordActivityTaskStartedRequest) (resp *hist.RecordActivityTaskStartedResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RecordActivityTaskStarted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -277,6 +289,8 @@ func (h *Handler) RecordActivityTaskStarted(ctx context.Context,
 // RecordDecisionTaskStarted - Record Decision Task started.
 func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 	recordRequest *hist.RecordDecisionTaskStartedRequest) (resp *hist.RecordDecisionTaskStartedResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RecordDecisionTaskStarted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 	h.Service.GetLogger().Debug(fmt.Sprintf("RecordDecisionTaskStarted. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
@@ -327,6 +341,8 @@ func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 // RespondActivityTaskCompleted - records completion of an activity task
 func (h *Handler) RespondActivityTaskCompleted(ctx context.Context,
 	wrappedRequest *hist.RespondActivityTaskCompletedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RespondActivityTaskCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -373,6 +389,8 @@ func (h *Handler) RespondActivityTaskCompleted(ctx context.Context,
 // RespondActivityTaskFailed - records failure of an activity task
 func (h *Handler) RespondActivityTaskFailed(ctx context.Context,
 	wrappedRequest *hist.RespondActivityTaskFailedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RespondActivityTaskFailed")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -419,6 +437,8 @@ func (h *Handler) RespondActivityTaskFailed(ctx context.Context,
 // RespondActivityTaskCanceled - records failure of an activity task
 func (h *Handler) RespondActivityTaskCanceled(ctx context.Context,
 	wrappedRequest *hist.RespondActivityTaskCanceledRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RespondActivityTaskCanceled")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -465,6 +485,8 @@ func (h *Handler) RespondActivityTaskCanceled(ctx context.Context,
 // RespondDecisionTaskCompleted - records completion of a decision task
 func (h *Handler) RespondDecisionTaskCompleted(ctx context.Context,
 	wrappedRequest *hist.RespondDecisionTaskCompletedRequest) (resp *hist.RespondDecisionTaskCompletedResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RespondDecisionTaskCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -517,6 +539,8 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx context.Context,
 // RespondDecisionTaskFailed - failed response to decision task
 func (h *Handler) RespondDecisionTaskFailed(ctx context.Context,
 	wrappedRequest *hist.RespondDecisionTaskFailedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RespondDecisionTaskFailed")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -569,6 +593,8 @@ func (h *Handler) RespondDecisionTaskFailed(ctx context.Context,
 // StartWorkflowExecution - creates a new workflow execution
 func (h *Handler) StartWorkflowExecution(ctx context.Context,
 	wrappedRequest *hist.StartWorkflowExecutionRequest) (resp *gen.StartWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.StartWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -604,6 +630,8 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context,
 // DescribeHistoryHost returns information about the internal states of a history host
 func (h *Handler) DescribeHistoryHost(ctx context.Context,
 	request *gen.DescribeHistoryHostRequest) (resp *gen.DescribeHistoryHostResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.DescribeHistoryHost")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -641,6 +669,8 @@ func (h *Handler) DescribeHistoryHost(ctx context.Context,
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *Handler) DescribeMutableState(ctx context.Context,
 	request *hist.DescribeMutableStateRequest) (resp *hist.DescribeMutableStateResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.DescribeMutableState")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -668,9 +698,212 @@ func (h *Handler) DescribeMutableState(ctx context.Context,
 	return resp, nil
 }
 
+// VerifyMutableState - rebuilds the workflow mutable state from history and compares it with the stored one
+func (h *Handler) VerifyMutableState(ctx context.Context,
+	request *hist.VerifyMutableStateRequest) (resp *hist.VerifyMutableStateResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.VerifyMutableState")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryVerifyMutableStateScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.VerifyMutableState(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// GetReplicationMessages returns the replication tasks of the shards owned by this host since the read levels
+// in the request tokens, it is called by remote clusters pulling replication tasks from this cluster
+func (h *Handler) GetReplicationMessages(ctx context.Context,
+	request *r.GetReplicationMessagesRequest) (resp *r.GetReplicationMessagesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.GetReplicationMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	pollingCluster := request.GetClusterName()
+	if pollingCluster == "" {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+
+	messagesByShard := make(map[int32]*r.ReplicationMessages, len(request.Tokens))
+	for _, token := range request.Tokens {
+		if token.ShardID == nil {
+			return nil, h.error(errShardIDNotSet, scope, "", "")
+		}
+
+		engine, err := h.controller.getEngineForShard(int(token.GetShardID()))
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+
+		messages, err := engine.GetReplicationMessages(ctx, pollingCluster, token)
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		messagesByShard[token.GetShardID()] = messages
+	}
+
+	return &r.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+}
+
+// ReadDLQMessages reads replication tasks from the replication DLQ of a shard
+func (h *Handler) ReadDLQMessages(ctx context.Context,
+	request *r.ReadDLQMessagesRequest) (resp *r.ReadDLQMessagesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.ReadDLQMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReadDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetSourceCluster() == "" {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ReadDLQMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// PurgeDLQMessages deletes replication tasks from the replication DLQ of a shard
+func (h *Handler) PurgeDLQMessages(ctx context.Context,
+	request *r.PurgeDLQMessagesRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.PurgeDLQMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetSourceCluster() == "" {
+		return h.error(errClusterNameNotSet, scope, "", "")
+	}
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.PurgeDLQMessages(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// MergeDLQMessages re-applies replication tasks from the replication DLQ of a shard
+func (h *Handler) MergeDLQMessages(ctx context.Context,
+	request *r.MergeDLQMessagesRequest) (resp *r.MergeDLQMessagesResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.MergeDLQMessages")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryMergeDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetSourceCluster() == "" {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.MergeDLQMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// DescribeReplicationStatus returns the replication backlog of the requested shards towards the target cluster
+func (h *Handler) DescribeReplicationStatus(ctx context.Context,
+	request *r.DescribeReplicationStatusRequest) (resp *r.DescribeReplicationStatusResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.DescribeReplicationStatus")
+	defer tracing.FinishSpan(span, &retError)
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeReplicationStatusScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetTargetCluster() == "" {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+
+	responses := make([]*r.DescribeReplicationStatusResponse, 0, len(request.ShardIDs))
+	for _, shardID := range request.ShardIDs {
+		engine, err := h.controller.getEngineForShard(int(shardID))
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+
+		response, err := engine.DescribeReplicationStatus(ctx, request)
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		responses = append(responses, response)
+	}
+
+	return hc.MergeReplicationStatus(responses), nil
+}
+
 // GetMutableState - returns the id of the next event in the execution's history
 func (h *Handler) GetMutableState(ctx context.Context,
 	getRequest *hist.GetMutableStateRequest) (resp *hist.GetMutableStateResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.GetMutableState")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -704,6 +937,8 @@ func (h *Handler) GetMutableState(ctx context.Context,
 
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *hist.DescribeWorkflowExecutionRequest) (resp *gen.DescribeWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.DescribeWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -738,6 +973,8 @@ func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *hist.D
 // RequestCancelWorkflowExecution - requests cancellation of a workflow
 func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context,
 	request *hist.RequestCancelWorkflowExecutionRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RequestCancelWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -780,6 +1017,8 @@ func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context,
 // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
 func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	wrappedRequest *hist.SignalWorkflowExecutionRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.SignalWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -819,6 +1058,8 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 // event recorded in history, and a decision task being created for the execution
 func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context,
 	wrappedRequest *hist.SignalWithStartWorkflowExecutionRequest) (resp *gen.StartWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.SignalWithStartWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -855,6 +1096,8 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context,
 // used to clean execution info when signal decision finished.
 func (h *Handler) RemoveSignalMutableState(ctx context.Context,
 	wrappedRequest *hist.RemoveSignalMutableStateRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RemoveSignalMutableState")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -891,6 +1134,8 @@ func (h *Handler) RemoveSignalMutableState(ctx context.Context,
 // in the history and immediately terminating the execution instance.
 func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	wrappedRequest *hist.TerminateWorkflowExecutionRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.TerminateWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -927,6 +1172,8 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 // in the history and immediately terminating the execution instance.
 func (h *Handler) ResetWorkflowExecution(ctx context.Context,
 	wrappedRequest *hist.ResetWorkflowExecutionRequest) (resp *gen.ResetWorkflowExecutionResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.ResetWorkflowExecution")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -964,6 +1211,8 @@ func (h *Handler) ResetWorkflowExecution(ctx context.Context,
 // child execution without creating the decision task and then calls this API after updating the mutable state of
 // parent execution.
 func (h *Handler) ScheduleDecisionTask(ctx context.Context, request *hist.ScheduleDecisionTaskRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.ScheduleDecisionTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -1003,6 +1252,8 @@ func (h *Handler) ScheduleDecisionTask(ctx context.Context, request *hist.Schedu
 // RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
 // This is mainly called by transfer queue processor during the processing of DeleteExecution task.
 func (h *Handler) RecordChildExecutionCompleted(ctx context.Context, request *hist.RecordChildExecutionCompletedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.RecordChildExecutionCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -1047,6 +1298,8 @@ func (h *Handler) RecordChildExecutionCompleted(ctx context.Context, request *hi
 // 4. ClientFeatureVersion
 // 5. ClientImpl
 func (h *Handler) ResetStickyTaskList(ctx context.Context, resetRequest *hist.ResetStickyTaskListRequest) (resp *hist.ResetStickyTaskListResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.ResetStickyTaskList")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -1080,6 +1333,8 @@ func (h *Handler) ResetStickyTaskList(ctx context.Context, resetRequest *hist.Re
 
 // ReplicateEvents is called by processor to replicate history events for passive domains
 func (h *Handler) ReplicateEvents(ctx context.Context, replicateRequest *hist.ReplicateEventsRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.ReplicateEvents")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -1114,6 +1369,8 @@ func (h *Handler) ReplicateEvents(ctx context.Context, replicateRequest *hist.Re
 
 // ReplicateRawEvents is called by processor to replicate history raw events for passive domains
 func (h *Handler) ReplicateRawEvents(ctx context.Context, replicateRequest *hist.ReplicateRawEventsRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.ReplicateRawEvents")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -1148,6 +1405,8 @@ func (h *Handler) ReplicateRawEvents(ctx context.Context, replicateRequest *hist
 
 // SyncShardStatus is called by processor to sync history shard information from another cluster
 func (h *Handler) SyncShardStatus(ctx context.Context, syncShardStatusRequest *hist.SyncShardStatusRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.SyncShardStatus")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -1188,6 +1447,8 @@ func (h *Handler) SyncShardStatus(ctx context.Context, syncShardStatusRequest *h
 
 // SyncActivity is called by processor to sync activity
 func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.SyncActivityRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "history.SyncActivity")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -111,7 +111,7 @@ func (c *historyCache) getAndCreateWorkflowExecution(
 	sw := c.metricsClient.StartTimer(scope, metrics.CacheLatency)
 	defer sw.Stop()
 
-	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+	if err := c.validateWorkflowExecutionInfo(ctx, domainID, &execution); err != nil {
 		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
 		return nil, nil, nil, false, err
 	}
@@ -159,7 +159,7 @@ func (c *historyCache) getOrCreateWorkflowExecution(
 	sw := c.metricsClient.StartTimer(scope, metrics.CacheLatency)
 	defer sw.Stop()
 
-	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+	if err := c.validateWorkflowExecutionInfo(ctx, domainID, &execution); err != nil {
 		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
 		return nil, nil, err
 	}
@@ -215,6 +215,7 @@ func (c *historyCache) getOrCreateWorkflowExecutionInternal(
 }
 
 func (c *historyCache) validateWorkflowExecutionInfo(
+	ctx context.Context,
 	domainID string,
 	execution *workflow.WorkflowExecution,
 ) error {
@@ -225,7 +226,7 @@ func (c *historyCache) validateWorkflowExecutionInfo(
 
 	// RunID is not provided, lets try to retrieve the RunID for current active execution
 	if execution.GetRunId() == "" {
-		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+		response, err := c.getCurrentExecutionWithRetry(ctx, &persistence.GetCurrentExecutionRequest{
 			DomainID:   domainID,
 			WorkflowID: execution.GetWorkflowId(),
 		})
@@ -261,6 +262,7 @@ func (c *historyCache) makeReleaseFunc(
 }
 
 func (c *historyCache) getCurrentExecutionWithRetry(
+	ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest,
 ) (*persistence.GetCurrentExecutionResponse, error) {
 
@@ -271,7 +273,7 @@ func (c *historyCache) getCurrentExecutionWithRetry(
 	var response *persistence.GetCurrentExecutionResponse
 	op := func() error {
 		var err error
-		response, err = c.executionManager.GetCurrentExecution(request)
+		response, err = c.executionManager.GetCurrentExecution(ctx, request)
 
 		return err
 	}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -70,7 +71,9 @@ type (
 		timerProcessor       timerQueueProcessor
 		taskAllocator        taskAllocator
 		replicator           *historyReplicator
-		replicatorProcessor  queueProcessor
+		replicatorProcessor  replicatorQueueProcessor
+		pullProcessors       []*replicationTaskProcessor
+		replicationPull      bool
 		historyEventNotifier historyEventNotifier
 		tokenSerializer      common.TaskTokenSerializer
 		historyCache         *historyCache
@@ -124,6 +127,13 @@ var (
 	ErrBufferedEventsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for buffered events"}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for signal events"}
+	// errReplicationNotEnabled is the error indicating replication tasks of this cluster cannot be pulled
+	errReplicationNotEnabled = &workflow.BadRequestError{Message: "Replication is not enabled on this cluster."}
+	// errReplicationStatusUnknown is the error indicating the replication backlog of the target cluster is not known
+	// to this cluster, since the replication tasks are published to kafka rather than pulled by the target cluster
+	errReplicationStatusUnknown = &workflow.BadRequestError{Message: common.ReplicationStatusUnknownMessage}
+	// errInvalidTargetCluster is the error indicating the target cluster is not a remote cluster of this cluster
+	errInvalidTargetCluster = &workflow.BadRequestError{Message: "Target cluster is not a known remote cluster."}
 	// ErrEventsAterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
 	ErrEventsAterWorkflowFinish = &workflow.InternalServiceError{Message: "error validating last event being workflow finish event."}
 
@@ -190,6 +200,14 @@ func NewEngineWithShardContext(
 		shardWrapper.replicatorProcessor = replicatorProcessor
 		historyEngImpl.replicator = newHistoryReplicator(shard, clock.NewRealTimeSource(), historyEngImpl, historyCache, shard.GetDomainCache(), historyManager, historyV2Manager,
 			logger)
+	} else if config.EnableReplicationPull() && shard.GetService().GetClusterMetadata().IsGlobalDomainEnabled() {
+		// replication tasks are pulled by remote clusters through GetReplicationMessages instead of being published,
+		// so the replicator processor is only used to serve and ack the tasks, and is never started
+		historyEngImpl.replicatorProcessor = newReplicatorQueueProcessor(shard, historyEngImpl.historyCache, nil, executionManager, historyManager, historyV2Manager, logger)
+		historyEngImpl.replicator = newHistoryReplicator(shard, clock.NewRealTimeSource(), historyEngImpl, historyCache, shard.GetDomainCache(), historyManager, historyV2Manager,
+			logger)
+		historyEngImpl.pullProcessors = newReplicationTaskProcessors(shard, historyEngImpl, config, logger)
+		historyEngImpl.replicationPull = true
 	}
 	historyEngImpl.resetor = newWorkflowResetor(historyEngImpl)
 	historyEngImpl.decisionHandler = newDecisionHandler(historyEngImpl)
@@ -208,9 +226,12 @@ func (e *historyEngineImpl) Start() {
 
 	e.txProcessor.Start()
 	e.timerProcessor.Start()
-	if e.replicatorProcessor != nil {
+	if e.replicatorProcessor != nil && !e.replicationPull {
 		e.replicatorProcessor.Start()
 	}
+	for _, replicationTaskProcessor := range e.pullProcessors {
+		replicationTaskProcessor.Start()
+	}
 }
 
 // Stop the service.
@@ -223,6 +244,9 @@ func (e *historyEngineImpl) Stop() {
 	if e.replicatorProcessor != nil {
 		e.replicatorProcessor.Stop()
 	}
+	for _, replicationTaskProcessor := range e.pullProcessors {
+		replicationTaskProcessor.Stop()
+	}
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(e.shard.GetShardID())
@@ -450,7 +474,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	createReplicationTask := domainEntry.CanReplicateEvent()
 	replicationTasks := []persistence.Task{}
 	var replicationTask persistence.Task
-	_, replicationTask, retError = context.appendFirstBatchEventsForActive(msBuilder, createReplicationTask)
+	_, replicationTask, retError = context.appendFirstBatchEventsForActive(ctx, msBuilder, createReplicationTask)
 	if retError != nil {
 		return
 	}
@@ -462,7 +486,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	shouldDeleteHistory := true
 	defer func() {
 		if shouldDeleteHistory {
-			e.deleteEvents(domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
+			e.deleteEvents(ctx, domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
 		}
 	}()
 
@@ -471,6 +495,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	retError = context.createWorkflowExecution(
+		ctx,
 		msBuilder, e.currentClusterName, createReplicationTask, e.timeSource.Now(),
 		transferTasks, replicationTasks, timerTasks,
 		createMode, prevRunID, prevLastWriteVersion,
@@ -503,6 +528,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 				return
 			}
 			retError = context.createWorkflowExecution(
+				ctx,
 				msBuilder, e.currentClusterName, createReplicationTask, e.timeSource.Now(),
 				transferTasks, replicationTasks, timerTasks,
 				createMode, prevRunID, prevLastWriteVersion,
@@ -607,7 +633,7 @@ func (e *historyEngineImpl) getMutableState(
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, retError := context.loadWorkflowExecution()
+	msBuilder, retError := context.loadWorkflowExecution(ctx)
 	if retError != nil {
 		return
 	}
@@ -672,7 +698,7 @@ func (e *historyEngineImpl) DescribeMutableState(
 		retResp.MutableStateInCache, retError = e.toMutableStateJSON(msb)
 	}
 
-	msb, retError := dbCtx.loadWorkflowExecution()
+	msb, retError := dbCtx.loadWorkflowExecution(ctx)
 	retResp.MutableStateInDatabase, retError = e.toMutableStateJSON(msb)
 
 	return
@@ -688,6 +714,40 @@ func (e *historyEngineImpl) toMutableStateJSON(msb mutableState) (*string, error
 	return common.StringPtr(string(jsonBytes)), nil
 }
 
+// VerifyMutableState rebuilds the mutable state of a workflow by replaying its history and compares it
+// with the mutable state in database. If requested, the mutable state in database is repaired.
+func (e *historyEngineImpl) VerifyMutableState(
+	ctx ctx.Context,
+	request *h.VerifyMutableStateRequest,
+) (retResp *h.VerifyMutableStateResponse, retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	// drop the cached copy, so the verification is done against the mutable state in database
+	context.clear()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier := newMutableStateVerifier(e.shard, context, e.historyMgr, e.historyV2Mgr, e.logger)
+	return verifier.verify(ctx, msBuilder, request.GetRepair())
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 // Volatile information are the information related to client, such as:
 // 1. StickyTaskList
@@ -740,7 +800,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err1 := context.loadWorkflowExecution()
+	msBuilder, err1 := context.loadWorkflowExecution(ctx)
 	if err1 != nil {
 		return nil, err1
 	}
@@ -1388,7 +1448,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	Just_Signal_Loop:
 		for ; attempt < conditionalRetryCount; attempt++ {
 			// workflow not exist, will create workflow then signal
-			msBuilder, err1 := context.loadWorkflowExecution()
+			msBuilder, err1 := context.loadWorkflowExecution(ctx)
 			if err1 != nil {
 				if _, ok := err1.(*workflow.EntityNotExistsError); ok {
 					break
@@ -1447,7 +1507,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 			// the history and try the operation again.
-			if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+			if err := context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID); err != nil {
 				if err == ErrConflict {
 					continue Just_Signal_Loop
 				}
@@ -1553,7 +1613,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	createReplicationTask := domainEntry.CanReplicateEvent()
 	replicationTasks := []persistence.Task{}
 	var replicationTask persistence.Task
-	_, replicationTask, retError = context.appendFirstBatchEventsForActive(msBuilder, createReplicationTask)
+	_, replicationTask, retError = context.appendFirstBatchEventsForActive(ctx, msBuilder, createReplicationTask)
 	if retError != nil {
 		return
 	}
@@ -1565,7 +1625,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	shouldDeleteHistory := true
 	defer func() {
 		if shouldDeleteHistory {
-			e.deleteEvents(domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
+			e.deleteEvents(ctx, domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
 		}
 	}()
 
@@ -1578,6 +1638,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		prevLastWriteVersion = prevMutableState.GetLastWriteVersion()
 	}
 	retError = context.createWorkflowExecution(
+		ctx,
 		msBuilder, e.currentClusterName, createReplicationTask, e.timeSource.Now(),
 		transferTasks, replicationTasks, timerTasks,
 		createMode, prevRunID, prevLastWriteVersion,
@@ -1766,6 +1827,82 @@ func (e *historyEngineImpl) SyncActivity(
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+func (e *historyEngineImpl) GetReplicationMessages(
+	ctx ctx.Context,
+	pollingCluster string,
+	token *replicator.ReplicationToken,
+) (*replicator.ReplicationMessages, error) {
+
+	if e.replicatorProcessor == nil {
+		return nil, errReplicationNotEnabled
+	}
+
+	// the tasks up to the last processed message are applied by the polling cluster
+	if lastProcessedMessageID := token.GetLastProcessedMessageId(); lastProcessedMessageID != common.EmptyMessageID {
+		if err := e.replicatorProcessor.ackTasks(ctx, pollingCluster, lastProcessedMessageID); err != nil {
+			e.logger.Warn("Failed to ack replication tasks.", tag.ClusterName(pollingCluster), tag.Error(err))
+		}
+	}
+
+	return e.replicatorProcessor.getTasks(ctx, pollingCluster, token.GetLastRetrievedMessageId())
+}
+
+func (e *historyEngineImpl) DescribeReplicationStatus(
+	ctx ctx.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	if e.replicatorProcessor == nil {
+		return nil, errReplicationNotEnabled
+	}
+
+	targetCluster := request.GetTargetCluster()
+	clusterInfo, ok := e.shard.GetService().GetClusterMetadata().GetAllClusterInfo()[targetCluster]
+	if !ok || !clusterInfo.Enabled || targetCluster == e.currentClusterName {
+		return nil, errInvalidTargetCluster
+	}
+
+	domainID := ""
+	if request.GetDomain() != "" {
+		var err error
+		if domainID, err = e.shard.GetDomainCache().GetDomainID(request.GetDomain()); err != nil {
+			return nil, err
+		}
+	}
+
+	shardStatus, pendingTasksByDomainID, err := e.replicatorProcessor.getReplicationStatus(
+		ctx,
+		targetCluster,
+		e.config.ReplicationStatusScanLimit(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := []*replicator.DomainReplicationStatus{}
+	for id, pendingTasks := range pendingTasksByDomainID {
+		if domainID != "" && id != domainID {
+			continue
+		}
+		// the tasks of a deleted domain are reported by domain ID
+		domainName := id
+		if domainEntry, err := e.shard.GetDomainCache().GetDomainByID(id); err == nil {
+			domainName = domainEntry.GetInfo().Name
+		}
+		domains = append(domains, &replicator.DomainReplicationStatus{
+			Domain:       common.StringPtr(domainName),
+			PendingTasks: common.Int64Ptr(pendingTasks),
+		})
+	}
+
+	return &replicator.DescribeReplicationStatusResponse{
+		Shards:       []*replicator.ShardReplicationStatus{shardStatus},
+		Domains:      domains,
+		PendingTasks: shardStatus.PendingTasks,
+		Truncated:    shardStatus.Truncated,
+	}, nil
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(
 	ctx ctx.Context,
 	resetRequest *h.ResetWorkflowExecutionRequest,
@@ -1801,13 +1938,13 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	}
 	defer func() { baseRelease(retError) }()
 
-	baseMutableState, retError := baseContext.loadWorkflowExecution()
+	baseMutableState, retError := baseContext.loadWorkflowExecution(ctx)
 	if retError != nil {
 		return
 	}
 
 	// also load the current run of the workflow, it can be different from the base runID
-	resp, retError := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+	resp, retError := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: request.WorkflowExecution.GetWorkflowId(),
 	})
@@ -1833,7 +1970,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 			return
 		}
 		defer func() { currRelease(retError) }()
-		currMutableState, retError = currContext.loadWorkflowExecution()
+		currMutableState, retError = currContext.loadWorkflowExecution(ctx)
 		if retError != nil {
 			return
 		}
@@ -1855,6 +1992,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 }
 
 func (e *historyEngineImpl) DeleteExecutionFromVisibility(
+	ctx ctx.Context,
 	task *persistence.TimerTaskInfo,
 ) error {
 
@@ -1864,7 +2002,7 @@ func (e *historyEngineImpl) DeleteExecutionFromVisibility(
 		RunID:      task.RunID,
 		TaskID:     task.TaskID,
 	}
-	return e.visibilityMgr.DeleteWorkflowExecution(request) // delete from db
+	return e.visibilityMgr.DeleteWorkflowExecution(ctx, request) // delete from db
 }
 
 type updateWorkflowAction struct {
@@ -1890,7 +2028,7 @@ func (e *historyEngineImpl) updateWorkflowExecutionWithAction(
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err1 := context.loadWorkflowExecution()
+		msBuilder, err1 := context.loadWorkflowExecution(ctx)
 		if err1 != nil {
 			return err1
 		}
@@ -1955,7 +2093,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
@@ -2033,6 +2171,7 @@ func createDeleteHistoryEventTimerTask(
 }
 
 func (e *historyEngineImpl) deleteEvents(
+	ctx ctx.Context,
 	domainID string,
 	execution workflow.WorkflowExecution,
 	eventStoreVersion int32,
@@ -2044,12 +2183,12 @@ func (e *historyEngineImpl) deleteEvents(
 	// are always created for a unique run_id which is not visible beyond this call yet.
 	// TODO: Handle error on deletion of execution history
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		e.historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+		e.historyV2Mgr.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 			BranchToken: branchToken,
 			ShardID:     common.IntPtr(e.shard.GetShardID()),
 		})
 	} else {
-		e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+		e.historyMgr.DeleteWorkflowExecutionHistory(ctx, &persistence.DeleteWorkflowExecutionHistoryRequest{
 			DomainID:  domainID,
 			Execution: execution,
 		})
@@ -2057,6 +2196,7 @@ func (e *historyEngineImpl) deleteEvents(
 }
 
 func (e *historyEngineImpl) failDecision(
+	ctx ctx.Context,
 	context workflowExecutionContext,
 	scheduleID int64,
 	startedID int64,
@@ -2069,7 +2209,7 @@ func (e *historyEngineImpl) failDecision(
 	context.clear()
 
 	// Reload workflow execution so we can apply the decision task failure event
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2093,10 +2233,11 @@ func (e *historyEngineImpl) getTimerBuilder(
 }
 
 func (s *shardContextWrapper) UpdateWorkflowExecution(
+	ctx ctx.Context,
 	request *persistence.UpdateWorkflowExecutionRequest,
 ) (*persistence.UpdateWorkflowExecutionResponse, error) {
 
-	resp, err := s.ShardContext.UpdateWorkflowExecution(request)
+	resp, err := s.ShardContext.UpdateWorkflowExecution(ctx, request)
 	if err == nil {
 		s.txProcessor.NotifyNewTask(s.currentClusterName, request.TransferTasks)
 		if len(request.ReplicationTasks) > 0 {
@@ -2107,10 +2248,11 @@ func (s *shardContextWrapper) UpdateWorkflowExecution(
 }
 
 func (s *shardContextWrapper) CreateWorkflowExecution(
+	ctx ctx.Context,
 	request *persistence.CreateWorkflowExecutionRequest,
 ) (*persistence.CreateWorkflowExecutionResponse, error) {
 
-	resp, err := s.ShardContext.CreateWorkflowExecution(request)
+	resp, err := s.ShardContext.CreateWorkflowExecution(ctx, request)
 	if err == nil {
 		s.txProcessor.NotifyNewTask(s.currentClusterName, request.TransferTasks)
 		if len(request.ReplicationTasks) > 0 {
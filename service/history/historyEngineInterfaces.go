@@ -25,6 +25,7 @@ import (
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
@@ -49,6 +50,7 @@ type (
 			error)
 		GetMutableState(ctx context.Context, request *h.GetMutableStateRequest) (*h.GetMutableStateResponse, error)
 		DescribeMutableState(ctx context.Context, request *h.DescribeMutableStateRequest) (*h.DescribeMutableStateResponse, error)
+		VerifyMutableState(ctx context.Context, request *h.VerifyMutableStateRequest) (*h.VerifyMutableStateResponse, error)
 		ResetStickyTaskList(ctx context.Context, resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error)
 		DescribeWorkflowExecution(ctx context.Context,
 			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
@@ -73,6 +75,11 @@ type (
 		ReplicateRawEvents(ctx context.Context, request *h.ReplicateRawEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		GetReplicationMessages(ctx context.Context, pollingCluster string, token *replicator.ReplicationToken) (*replicator.ReplicationMessages, error)
+		ReadDLQMessages(ctx context.Context, request *replicator.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error)
+		PurgeDLQMessages(ctx context.Context, request *replicator.PurgeDLQMessagesRequest) error
+		MergeDLQMessages(ctx context.Context, request *replicator.MergeDLQMessagesRequest) (*replicator.MergeDLQMessagesResponse, error)
+		DescribeReplicationStatus(ctx context.Context, request *replicator.DescribeReplicationStatusRequest) (*replicator.DescribeReplicationStatusResponse, error)
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -85,6 +92,13 @@ type (
 		notifyNewTask()
 	}
 
+	replicatorQueueProcessor interface {
+		queueProcessor
+		getTasks(ctx context.Context, pollingCluster string, lastReadTaskID int64) (*replicator.ReplicationMessages, error)
+		ackTasks(ctx context.Context, pollingCluster string, lastProcessedTaskID int64) error
+		getReplicationStatus(ctx context.Context, targetCluster string, scanLimit int) (*replicator.ShardReplicationStatus, map[string]int64, error)
+	}
+
 	queueAckMgr interface {
 		getFinishedChan() <-chan struct{}
 		readQueueTasks() ([]queueTaskInfo, bool, error)
@@ -186,7 +186,7 @@ func (r *historyReplicator) SyncActivity(
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
 			return err
@@ -280,7 +280,7 @@ func (r *historyReplicator) SyncActivity(
 		timerTasks = append(timerTasks, tt)
 	}
 
-	return r.updateMutableStateWithTimer(context, msBuilder, now, timerTasks)
+	return r.updateMutableStateWithTimer(ctx, context, msBuilder, now, timerTasks)
 }
 
 func (r *historyReplicator) ApplyRawEvents(
@@ -388,7 +388,7 @@ func (r *historyReplicator) ApplyEvents(
 	firstEvent := request.History.Events[0]
 	switch firstEvent.GetEventType() {
 	case shared.EventTypeWorkflowExecutionStarted:
-		_, err := context.loadWorkflowExecution()
+		_, err := context.loadWorkflowExecution(ctx)
 		if err == nil {
 			// Workflow execution already exist, looks like a duplicate start event, it is safe to ignore it
 			logger.Debug(fmt.Sprintf("Dropping stale replication task for start event."))
@@ -404,7 +404,7 @@ func (r *historyReplicator) ApplyEvents(
 	default:
 		// apply events, other than simple start workflow execution
 		// the continue as new + start workflow execution combination will also be processed here
-		msBuilder, err := context.loadWorkflowExecution()
+		msBuilder, err := context.loadWorkflowExecution(ctx)
 		if err != nil {
 			if _, ok := err.(*shared.EntityNotExistsError); !ok {
 				return err
@@ -532,7 +532,7 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(
 		// must get the current run ID first
 		// if trying to getCurrentWorkflowRunID function (which use mutable state cache)
 		// there can be deadlock if current workflow is this workflow
-		currentRunID, err := r.getCurrentWorkflowRunID(context.getDomainID(), context.getExecution().GetWorkflowId())
+		currentRunID, err := r.getCurrentWorkflowRunID(ctx, context.getDomainID(), context.getExecution().GetWorkflowId())
 		if currentRunID == context.getExecution().GetRunId() {
 			err = r.reapplyEvents(ctx, context, msBuilder, events, logger)
 			return nil, err
@@ -618,7 +618,7 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(
 		return nil, ErrCorruptedReplicationInfo
 	}
 
-	err = r.flushEventsBuffer(context, msBuilder)
+	err = r.flushEventsBuffer(ctx, context, msBuilder)
 	if err != nil {
 		return nil, err
 	}
@@ -723,7 +723,7 @@ func (r *historyReplicator) ApplyReplicationTask(
 			return err
 		}
 		// continueAsNew
-		err = context.appendFirstBatchHistoryForContinueAsNew(newRunStateBuilder, transactionID)
+		err = context.appendFirstBatchHistoryForContinueAsNew(ctx, newRunStateBuilder, transactionID)
 		if err != nil {
 			return err
 		}
@@ -736,7 +736,7 @@ func (r *historyReplicator) ApplyReplicationTask(
 			logger)
 	default:
 		now := time.Unix(0, lastEvent.GetTimestamp())
-		err = context.replicateWorkflowExecution(request, sBuilder.getTransferTasks(), sBuilder.getTimerTasks(), lastEvent.GetEventId(), now)
+		err = context.replicateWorkflowExecution(ctx, request, sBuilder.getTransferTasks(), sBuilder.getTimerTasks(), lastEvent.GetEventId(), now)
 	}
 
 	if err == nil {
@@ -769,7 +769,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	executionInfo.SetLastFirstEventID(firstEvent.GetEventId())
 	executionInfo.SetNextEventID(lastEvent.GetEventId() + 1)
 
-	_, _, err := context.appendFirstBatchEventsForStandby(msBuilder, history.Events)
+	_, _, err := context.appendFirstBatchEventsForStandby(ctx, msBuilder, history.Events)
 	if err != nil {
 		return err
 	}
@@ -784,12 +784,12 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	deleteHistory := func() {
 		// this function should be only called when we drop start workflow execution
 		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-			r.shard.GetHistoryV2Manager().DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+			r.shard.GetHistoryV2Manager().DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 				BranchToken: msBuilder.GetCurrentBranch(),
 				ShardID:     common.IntPtr(r.shard.GetShardID()),
 			})
 		} else {
-			r.shard.GetHistoryManager().DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+			r.shard.GetHistoryManager().DeleteWorkflowExecutionHistory(ctx, &persistence.DeleteWorkflowExecutionHistoryRequest{
 				DomainID:  domainID,
 				Execution: execution,
 			})
@@ -802,6 +802,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	err = context.createWorkflowExecution(
+		ctx,
 		msBuilder, sourceCluster, createReplicationTask, now, transferTasks, replicationTasks, timerTasks,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
@@ -835,6 +836,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 		prevRunID = currentRunID
 		prevLastWriteVersion = currentLastWriteVersion
 		return context.createWorkflowExecution(
+			ctx,
 			msBuilder, sourceCluster, createReplicationTask, now, transferTasks, replicationTasks, timerTasks,
 			createMode, prevRunID, prevLastWriteVersion,
 		)
@@ -909,6 +911,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	prevRunID = currentRunID
 	prevLastWriteVersion = currentLastWriteVersion
 	return context.createWorkflowExecution(
+		ctx,
 		msBuilder, sourceCluster, createReplicationTask, now, transferTasks, replicationTasks, timerTasks,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
@@ -936,7 +939,7 @@ func (r *historyReplicator) conflictResolutionTerminateCurrentRunningIfNotSelf(
 	// cannot use history cache to get current workflow since there can be deadlock
 	domainID := msBuilder.GetExecutionInfo().DomainID
 	workflowID := msBuilder.GetExecutionInfo().WorkflowID
-	resp, err := r.shard.GetExecutionManager().GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+	resp, err := r.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: workflowID,
 	})
@@ -1000,7 +1003,7 @@ func (r *historyReplicator) getCurrentWorkflowMutableState(
 		return nil, nil, nil, err
 	}
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		// no matter what error happen, we need to retry
 		release(err)
@@ -1009,8 +1012,8 @@ func (r *historyReplicator) getCurrentWorkflowMutableState(
 	return context, msBuilder, release, nil
 }
 
-func (r *historyReplicator) getCurrentWorkflowRunID(domainID string, workflowID string) (string, error) {
-	resp, err := r.historyEngine.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+func (r *historyReplicator) getCurrentWorkflowRunID(ctx ctx.Context, domainID string, workflowID string) (string, error) {
+	resp, err := r.historyEngine.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: workflowID,
 	})
@@ -1155,6 +1158,7 @@ func (r *historyReplicator) resetMutableState(
 
 	resolver := r.getNewConflictResolver(context, logger)
 	msBuilder, err = resolver.reset(
+		ctx,
 		currentRunID,
 		currentLastWriteVersion,
 		currentState,
@@ -1170,13 +1174,15 @@ func (r *historyReplicator) resetMutableState(
 }
 
 func (r *historyReplicator) updateMutableStateOnly(
+	ctx ctx.Context,
 	context workflowExecutionContext,
 	msBuilder mutableState,
 ) error {
-	return r.updateMutableStateWithTimer(context, msBuilder, time.Time{}, nil)
+	return r.updateMutableStateWithTimer(ctx, context, msBuilder, time.Time{}, nil)
 }
 
 func (r *historyReplicator) updateMutableStateWithTimer(
+	ctx ctx.Context,
 	context workflowExecutionContext,
 	msBuilder mutableState,
 	now time.Time,
@@ -1193,7 +1199,7 @@ func (r *historyReplicator) updateMutableStateWithTimer(
 	// so nothing on the replication state should be changed
 	lastWriteVersion := msBuilder.GetLastWriteVersion()
 	sourceCluster := r.clusterMetadata.ClusterNameForFailoverVersion(lastWriteVersion)
-	return context.updateWorkflowExecutionForStandby(nil, timerTasks, transactionID, now, false, nil, sourceCluster)
+	return context.updateWorkflowExecutionForStandby(ctx, nil, timerTasks, transactionID, now, false, nil, sourceCluster)
 }
 
 func (r *historyReplicator) deserializeBlob(
@@ -1217,6 +1223,7 @@ func (r *historyReplicator) deserializeBlob(
 }
 
 func (r *historyReplicator) flushEventsBuffer(
+	ctx ctx.Context,
 	context workflowExecutionContext,
 	msBuilder mutableState,
 ) error {
@@ -1246,7 +1253,7 @@ func (r *historyReplicator) flushEventsBuffer(
 		return err
 	}
 
-	return r.persistWorkflowMutation(context, msBuilder, []persistence.Task{}, []persistence.Task{})
+	return r.persistWorkflowMutation(ctx, context, msBuilder, []persistence.Task{}, []persistence.Task{})
 }
 
 func (r *historyReplicator) reapplyEvents(
@@ -1308,7 +1315,7 @@ func (r *historyReplicator) reapplyEventsToCurrentRunningWorkflow(
 	}
 
 	r.logger.Info(fmt.Sprintf("reapplying %v signals", numSignals))
-	return r.persistWorkflowMutation(context, msBuilder, []persistence.Task{}, []persistence.Task{})
+	return r.persistWorkflowMutation(ctx, context, msBuilder, []persistence.Task{}, []persistence.Task{})
 }
 
 func (r *historyReplicator) reapplyEventsToCurrentClosedWorkflow(
@@ -1368,7 +1375,7 @@ func (r *historyReplicator) reapplyEventsToCurrentClosedWorkflow(
 		return err
 	}
 	defer func() { resetNewRelease(retError) }()
-	resetNewMsBuilder, err := context.loadWorkflowExecution()
+	resetNewMsBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
@@ -1413,6 +1420,7 @@ func (r *historyReplicator) prepareWorkflowMutation(
 }
 
 func (r *historyReplicator) persistWorkflowMutation(
+	ctx ctx.Context,
 	context workflowExecutionContext,
 	msBuilder mutableState,
 	transferTasks []persistence.Task,
@@ -1445,7 +1453,7 @@ func (r *historyReplicator) persistWorkflowMutation(
 	if err != nil {
 		return err
 	}
-	return context.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+	return context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID)
 }
 
 func logError(
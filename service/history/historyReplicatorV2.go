@@ -130,7 +130,7 @@ func (r *historyReplicatorV2) applyEvents(ctx ctx.Context, context workflowExecu
 
 	switch task.getFirstEvent().GetEventType() {
 	case shared.EventTypeWorkflowExecutionStarted:
-		_, err := context.loadWorkflowExecution()
+		_, err := context.loadWorkflowExecution(ctx)
 		switch err.(type) {
 		case nil:
 			r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.DuplicateReplicationEventsCounter)
@@ -146,7 +146,7 @@ func (r *historyReplicatorV2) applyEvents(ctx ctx.Context, context workflowExecu
 	default:
 		// apply events, other than simple start workflow execution
 		// the continue as new + start workflow execution combination will also be processed here
-		mutableState, err := context.loadWorkflowExecution()
+		mutableState, err := context.loadWorkflowExecution(ctx)
 		switch err.(type) {
 		case nil:
 			mutableState, err = r.applyNonStartEventsBranchChecking(ctx, context, mutableState, task)
@@ -189,7 +189,7 @@ func (r *historyReplicatorV2) applyStartEvents(ctx ctx.Context, context workflow
 		return err
 	}
 
-	_, _, err = context.appendFirstBatchEventsForStandby(msBuilder, task.getEvents())
+	_, _, err = context.appendFirstBatchEventsForStandby(ctx, msBuilder, task.getEvents())
 	if err != nil {
 		return err
 	}
@@ -210,6 +210,7 @@ func (r *historyReplicatorV2) applyStartEvents(ctx ctx.Context, context workflow
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	err = context.createWorkflowExecution(
+		ctx,
 		msBuilder, task.getSourceCluster(), createReplicationTask, task.getEventTime(), transferTasks, replicationTasks, timerTasks,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
@@ -239,6 +240,7 @@ func (r *historyReplicatorV2) applyStartEvents(ctx ctx.Context, context workflow
 		prevRunID = currentRunID
 		prevLastWriteVersion = currentLastWriteVersion
 		return context.createWorkflowExecution(
+			ctx,
 			msBuilder, task.getSourceCluster(), createReplicationTask, task.getEventTime(), transferTasks, replicationTasks, timerTasks,
 			createMode, prevRunID, prevLastWriteVersion,
 		)
@@ -295,6 +297,7 @@ func (r *historyReplicatorV2) applyStartEvents(ctx ctx.Context, context workflow
 	prevRunID = currentRunID
 	prevLastWriteVersion = task.getVersion()
 	return context.createWorkflowExecution(
+		ctx,
 		msBuilder, task.getSourceCluster(), createReplicationTask, task.getEventTime(), transferTasks, replicationTasks, timerTasks,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
@@ -355,13 +358,14 @@ func (r *historyReplicatorV2) applyNonStartEvents(ctx ctx.Context, context workf
 			return err
 		}
 		// continueAsNew
-		err = context.appendFirstBatchHistoryForContinueAsNew(newRunMutableState, transactionID)
+		err = context.appendFirstBatchHistoryForContinueAsNew(ctx, newRunMutableState, transactionID)
 		if err != nil {
 			return err
 		}
 	}
 
 	err = context.replicateWorkflowExecution(
+		ctx,
 		task.getRequest(),
 		sBuilder.getTransferTasks(),
 		sBuilder.getTimerTasks(),
@@ -455,7 +459,7 @@ func (r *historyReplicatorV2) getCurrentWorkflowMutableState(ctx ctx.Context, do
 		return nil, nil, nil, err
 	}
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		// no matter what error happen, we need to retry
 		release(err)
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -30,6 +31,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -77,6 +79,7 @@ type (
 		config                    *Config
 		logger                    log.Logger
 		metricsClient             metrics.Client
+		payloadOffloader          payload.Offloader
 		standbyClusterCurrentTime map[string]time.Time
 		timerMaxReadLevelMap      map[string]time.Time
 	}
@@ -130,6 +133,7 @@ func newTestShardContext(shardInfo *persistence.ShardInfo, transferSequenceNumbe
 		config:                    config,
 		logger:                    logger,
 		metricsClient:             metricsClient,
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 		standbyClusterCurrentTime: standbyClusterCurrentTime,
 		timerMaxReadLevelMap:      timerMaxReadLevelMap,
 	}
@@ -287,6 +291,29 @@ func (s *TestShardContext) UpdateTimerClusterAckLevel(cluster string, ackLevel t
 	return nil
 }
 
+// GetClusterReplicationLevel test implementation
+func (s *TestShardContext) GetClusterReplicationLevel(cluster string) int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	if replicationLevel, ok := s.shardInfo.ClusterReplicationLevel[cluster]; ok {
+		return replicationLevel
+	}
+	return common.EmptyMessageID
+}
+
+// UpdateClusterReplicationLevel test implementation
+func (s *TestShardContext) UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ClusterReplicationLevel == nil {
+		s.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterReplicationLevel[cluster] = lastTaskID
+	return nil
+}
+
 // UpdateTransferFailoverLevel test implementation
 func (s *TestShardContext) UpdateTransferFailoverLevel(failoverID string, level persistence.TransferFailoverLevel) error {
 	s.Lock()
@@ -365,13 +392,13 @@ func (s *TestShardContext) UpdateDomainNotificationVersion(domainNotificationVer
 }
 
 // CreateWorkflowExecution test implementation
-func (s *TestShardContext) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+func (s *TestShardContext) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
-	return s.executionMgr.CreateWorkflowExecution(request)
+	return s.executionMgr.CreateWorkflowExecution(ctx, request)
 }
 
 // UpdateWorkflowExecution test implementation
-func (s *TestShardContext) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+func (s *TestShardContext) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
 	// assign IDs for the timer tasks. They need to be assigned under shard lock.
 	clusterMetadata := s.GetService().GetClusterMetadata()
 	clusterName := clusterMetadata.GetCurrentClusterName()
@@ -396,7 +423,7 @@ func (s *TestShardContext) UpdateWorkflowExecution(request *persistence.UpdateWo
 		s.logger.Info(fmt.Sprintf("%v: TestShardContext: Assigning timer (timestamp: %v, seq: %v)",
 			time.Now().UTC(), visibilityTs, task.GetTaskID()))
 	}
-	resp, err := s.executionMgr.UpdateWorkflowExecution(request)
+	resp, err := s.executionMgr.UpdateWorkflowExecution(ctx, request)
 	return resp, err
 }
 
@@ -421,26 +448,27 @@ func (s *TestShardContext) GetTimerMaxReadLevel(cluster string) time.Time {
 }
 
 // ResetMutableState test implementation
-func (s *TestShardContext) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
-	return s.executionMgr.ResetMutableState(request)
+func (s *TestShardContext) ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
+	return s.executionMgr.ResetMutableState(ctx, request)
 }
 
 // ResetWorkflowExecution test implementation
-func (s *TestShardContext) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
-	return s.executionMgr.ResetWorkflowExecution(request)
+func (s *TestShardContext) ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error {
+	return s.executionMgr.ResetWorkflowExecution(ctx, request)
 }
 
 // AppendHistoryEvents test implementation
-func (s *TestShardContext) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error) {
-	resp, err := s.historyMgr.AppendHistoryEvents(request)
+func (s *TestShardContext) AppendHistoryEvents(ctx context.Context, request *persistence.AppendHistoryEventsRequest) (int, error) {
+	resp, err := s.historyMgr.AppendHistoryEvents(ctx, request)
 	return resp.Size, err
 }
 
 // AppendHistoryV2Events append history V2 events
 func (s *TestShardContext) AppendHistoryV2Events(
+	ctx context.Context,
 	request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error) {
 	request.ShardID = common.IntPtr(s.shardID)
-	resp, err := s.historyV2Mgr.AppendHistoryNodes(request)
+	resp, err := s.historyV2Mgr.AppendHistoryNodes(ctx, request)
 	return resp.Size, err
 }
 
@@ -469,6 +497,11 @@ func (s *TestShardContext) GetMetricsClient() metrics.Client {
 	return s.metricsClient
 }
 
+// GetPayloadOffloader test implementation
+func (s *TestShardContext) GetPayloadOffloader() payload.Offloader {
+	return s.payloadOffloader
+}
+
 // Reset test implementation
 func (s *TestShardContext) Reset() {
 	atomic.StoreInt64(&s.shardInfo.RangeID, 0)
@@ -555,15 +588,15 @@ func (s *TestBase) SetupDomains() {
 		},
 		IsGlobalDomain: testDomainIsGlobalDomain,
 	}
-	s.MetadataManager.CreateDomain(createDomainRequest)
+	s.MetadataManager.CreateDomain(context.Background(), createDomainRequest)
 	createDomainRequest.Info.ID = testDomainStandbyID
 	createDomainRequest.Info.Name = testDomainStandbyName
 	createDomainRequest.ReplicationConfig.ActiveClusterName = testDomainStandbyClusterName
-	s.MetadataManager.CreateDomain(createDomainRequest)
+	s.MetadataManager.CreateDomain(context.Background(), createDomainRequest)
 }
 
 // TeardownDomains delete the domains used for testing
 func (s *TestBase) TeardownDomains() {
-	s.MetadataManager.DeleteDomain(&persistence.DeleteDomainRequest{ID: testDomainActiveID})
-	s.MetadataManager.DeleteDomain(&persistence.DeleteDomainRequest{ID: testDomainStandbyID})
+	s.MetadataManager.DeleteDomain(context.Background(), &persistence.DeleteDomainRequest{ID: testDomainActiveID})
+	s.MetadataManager.DeleteDomain(context.Background(), &persistence.DeleteDomainRequest{ID: testDomainStandbyID})
 }
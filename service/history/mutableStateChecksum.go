@@ -0,0 +1,150 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// mutableStateChecksumPayloadV1 is the version of the payload layout produced by newMutableStateChecksumPayload,
+	// bump this whenever fields are added to or removed from the payload
+	mutableStateChecksumPayloadV1 = 1
+
+	// mutableStateChecksumVerifyModeMetric only emits a metric on checksum mismatch
+	mutableStateChecksumVerifyModeMetric = "metric"
+	// mutableStateChecksumVerifyModeLog emits a metric and logs an error on checksum mismatch
+	mutableStateChecksumVerifyModeLog = "log"
+	// mutableStateChecksumVerifyModeFail emits a metric, logs an error and fails the load on checksum mismatch
+	mutableStateChecksumVerifyModeFail = "fail"
+)
+
+func generateMutableStateChecksum(
+	state *persistence.WorkflowMutableState,
+) checksum.Checksum {
+
+	payload := newMutableStateChecksumPayload(state)
+	return checksum.GenerateCRC32(payload, mutableStateChecksumPayloadV1)
+}
+
+func verifyMutableStateChecksum(
+	state *persistence.WorkflowMutableState,
+	csum checksum.Checksum,
+) error {
+
+	if csum.Version != mutableStateChecksumPayloadV1 {
+		return fmt.Errorf("unknown mutable state checksum payload version %v", csum.Version)
+	}
+	payload := newMutableStateChecksumPayload(state)
+	return checksum.Verify(payload, csum)
+}
+
+// newMutableStateChecksumPayload serializes the canonical mutable state fields into a deterministic binary payload.
+// Only fields which are persisted faithfully by both the create and the update path are included.
+func newMutableStateChecksumPayload(
+	state *persistence.WorkflowMutableState,
+) []byte {
+
+	buf := &bytes.Buffer{}
+	write := func(values ...int64) {
+		for _, value := range values {
+			binary.Write(buf, binary.BigEndian, value)
+		}
+	}
+	writeBool := func(value bool) {
+		if value {
+			write(1)
+		} else {
+			write(0)
+		}
+	}
+
+	info := state.ExecutionInfo
+	write(
+		int64(info.State),
+		int64(info.CloseStatus),
+		info.NextEventID,
+		info.LastProcessedEvent,
+		info.DecisionVersion,
+		info.DecisionScheduleID,
+		info.DecisionStartedID,
+		info.DecisionAttempt,
+	)
+	writeBool(info.CancelRequested)
+	writeBool(len(info.StickyTaskList) != 0)
+
+	replicationState := state.ReplicationState
+	writeBool(replicationState != nil)
+	if replicationState != nil {
+		write(
+			replicationState.StartVersion,
+			replicationState.LastWriteVersion,
+			replicationState.LastWriteEventID,
+		)
+	}
+
+	timerStartedIDs := make([]int64, 0, len(state.TimerInfos))
+	for _, timerInfo := range state.TimerInfos {
+		timerStartedIDs = append(timerStartedIDs, timerInfo.StartedID)
+	}
+	activityScheduleIDs := make([]int64, 0, len(state.ActivityInfos))
+	for scheduleID := range state.ActivityInfos {
+		activityScheduleIDs = append(activityScheduleIDs, scheduleID)
+	}
+	childInitiatedIDs := make([]int64, 0, len(state.ChildExecutionInfos))
+	for initiatedID := range state.ChildExecutionInfos {
+		childInitiatedIDs = append(childInitiatedIDs, initiatedID)
+	}
+	requestCancelInitiatedIDs := make([]int64, 0, len(state.RequestCancelInfos))
+	for initiatedID := range state.RequestCancelInfos {
+		requestCancelInitiatedIDs = append(requestCancelInitiatedIDs, initiatedID)
+	}
+	signalInitiatedIDs := make([]int64, 0, len(state.SignalInfos))
+	for initiatedID := range state.SignalInfos {
+		signalInitiatedIDs = append(signalInitiatedIDs, initiatedID)
+	}
+	for _, ids := range [][]int64{
+		timerStartedIDs,
+		activityScheduleIDs,
+		childInitiatedIDs,
+		requestCancelInitiatedIDs,
+		signalInitiatedIDs,
+	} {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		write(int64(len(ids)))
+		write(ids...)
+	}
+
+	signalRequestedIDs := sortedStringKeys(state.SignalRequestedIDs)
+	write(int64(len(signalRequestedIDs)))
+	for _, requestID := range signalRequestedIDs {
+		write(int64(len(requestID)))
+		buf.WriteString(requestID)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	mutableStateChecksumSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockDomainCache     *cache.DomainCacheMock
+		metricsScope        tally.TestScope
+		config              *Config
+		context             *workflowExecutionContextImpl
+	}
+)
+
+func TestMutableStateChecksumSuite(t *testing.T) {
+	s := new(mutableStateChecksumSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateChecksumSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	logger := loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.metricsScope = tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(s.metricsScope, metrics.History)
+	s.config = NewDynamicConfigForTest()
+
+	shard := &shardContextImpl{
+		service:                   service.NewTestService(s.mockClusterMetadata, nil, metricsClient, &client.MockClientBean{}),
+		shardInfo:                 &persistence.ShardInfo{ShardID: 10, RangeID: 1},
+		executionManager:          s.mockExecutionMgr,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    s.config,
+		logger:                    logger,
+		domainCache:               s.mockDomainCache,
+		metricsClient:             metricsClient,
+		eventsCache:               &MockEventsCache{},
+		timeSource:                clock.NewRealTimeSource(),
+		payloadOffloader:          payload.NewOffloader(nil, nil),
+	}
+	s.context = newWorkflowExecutionContext(validDomainID, workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}, shard, s.mockExecutionMgr, logger)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockDomainCache.On("GetDomainByID", validDomainID).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: validDomainID, Name: "some random domain"}, nil, "", nil,
+	), nil)
+}
+
+func (s *mutableStateChecksumSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+}
+
+func (s *mutableStateChecksumSuite) TestVerify_Match() {
+	csum := generateMutableStateChecksum(s.newMutableState())
+	s.Equal(mutableStateChecksumPayloadV1, csum.Version)
+	s.Equal(checksum.FlavorIEEECRC32OverBinary, csum.Flavor)
+
+	// fields outside of the payload do not affect the checksum
+	state := s.newMutableState()
+	state.ExecutionInfo.LastUpdatedTimestamp = state.ExecutionInfo.LastUpdatedTimestamp.Add(1)
+	state.ExecutionInfo.SignalCount = 3
+	state.ActivityInfos[5].Details = []byte("heartbeat details")
+	s.NoError(verifyMutableStateChecksum(state, csum))
+}
+
+func (s *mutableStateChecksumSuite) TestVerify_Mismatch() {
+	csum := generateMutableStateChecksum(s.newMutableState())
+
+	state := s.newMutableState()
+	state.ExecutionInfo.NextEventID = 11
+	s.Equal(checksum.ErrMismatch, verifyMutableStateChecksum(state, csum))
+
+	state = s.newMutableState()
+	delete(state.TimerInfos, "timer")
+	s.Equal(checksum.ErrMismatch, verifyMutableStateChecksum(state, csum))
+
+	state = s.newMutableState()
+	state.SignalRequestedIDs["signal-request"] = struct{}{}
+	s.Equal(checksum.ErrMismatch, verifyMutableStateChecksum(state, csum))
+
+	state = s.newMutableState()
+	state.ReplicationState = &persistence.ReplicationState{LastWriteVersion: 1}
+	s.Equal(checksum.ErrMismatch, verifyMutableStateChecksum(state, csum))
+}
+
+func (s *mutableStateChecksumSuite) TestVerify_UnknownVersion() {
+	csum := generateMutableStateChecksum(s.newMutableState())
+	csum.Version = mutableStateChecksumPayloadV1 + 1
+	s.Error(verifyMutableStateChecksum(s.newMutableState(), csum))
+}
+
+func (s *mutableStateChecksumSuite) TestLoad_DefaultConfigSkipsVerification() {
+	s.Equal(mutableStateChecksumVerifyModeLog, s.config.MutableStateChecksumVerifyMode("some random domain"))
+	s.Equal(0, s.config.MutableStateChecksumVerifyProbability("some random domain"))
+	s.setupStoredMutableState(true)
+
+	_, err := s.context.loadWorkflowExecution(context.Background())
+	s.NoError(err)
+	s.Equal(int64(0), s.checksumMismatchCount())
+}
+
+func (s *mutableStateChecksumSuite) TestLoad_ChecksumMatch() {
+	s.setupVerifyMode(mutableStateChecksumVerifyModeFail)
+	s.setupStoredMutableState(false)
+
+	_, err := s.context.loadWorkflowExecution(context.Background())
+	s.NoError(err)
+	s.Equal(int64(0), s.checksumMismatchCount())
+}
+
+func (s *mutableStateChecksumSuite) TestLoad_ChecksumMismatch_ModeMetric() {
+	s.setupVerifyMode(mutableStateChecksumVerifyModeMetric)
+	s.setupStoredMutableState(true)
+
+	msBuilder, err := s.context.loadWorkflowExecution(context.Background())
+	s.NoError(err)
+	s.Equal(int64(11), msBuilder.GetNextEventID())
+	s.Equal(int64(1), s.checksumMismatchCount())
+}
+
+func (s *mutableStateChecksumSuite) TestLoad_ChecksumMismatch_ModeLog() {
+	s.setupVerifyMode(mutableStateChecksumVerifyModeLog)
+	s.setupStoredMutableState(true)
+
+	msBuilder, err := s.context.loadWorkflowExecution(context.Background())
+	s.NoError(err)
+	s.Equal(int64(11), msBuilder.GetNextEventID())
+	s.Equal(int64(1), s.checksumMismatchCount())
+}
+
+func (s *mutableStateChecksumSuite) TestLoad_ChecksumMismatch_ModeFail() {
+	s.setupVerifyMode(mutableStateChecksumVerifyModeFail)
+	s.setupStoredMutableState(true)
+
+	_, err := s.context.loadWorkflowExecution(context.Background())
+	s.IsType(&workflow.InternalServiceError{}, err)
+	s.Equal(int64(1), s.checksumMismatchCount())
+	s.Nil(s.context.msBuilder, "the mutable state failing verification is not cached")
+}
+
+func (s *mutableStateChecksumSuite) setupVerifyMode(mode string) {
+	s.config.MutableStateChecksumVerifyProbability = dynamicconfig.GetIntPropertyFilteredByDomain(100)
+	s.config.MutableStateChecksumVerifyMode = dynamicconfig.GetStringPropertyFnFilteredByDomain(mode)
+}
+
+// setupStoredMutableState mocks the load of a mutable state along with its checksum,
+// the checksum is computed before the next event ID is changed when mismatch is set
+func (s *mutableStateChecksumSuite) setupStoredMutableState(mismatch bool) {
+	state := s.newMutableState()
+	state.Checksum = generateMutableStateChecksum(state)
+	if mismatch {
+		state.ExecutionInfo.NextEventID = 11
+	}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
+		DomainID:  validDomainID,
+		Execution: *s.context.getExecution(),
+	}).Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Once()
+}
+
+func (s *mutableStateChecksumSuite) checksumMismatchCount() int64 {
+	count := int64(0)
+	for _, counter := range s.metricsScope.Snapshot().Counters() {
+		if counter.Name() == "test.mutable_state_checksum_mismatch" {
+			count += counter.Value()
+		}
+	}
+	return count
+}
+
+func (s *mutableStateChecksumSuite) newMutableState() *persistence.WorkflowMutableState {
+	return &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			RunID:              "run",
+			State:              persistence.WorkflowStateRunning,
+			NextEventID:        10,
+			LastProcessedEvent: 4,
+			DecisionScheduleID: common.EmptyEventID,
+			DecisionStartedID:  common.EmptyEventID,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5, StartedID: common.EmptyEventID, ActivityID: "activity"},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer": {TimerID: "timer", StartedID: 6, TaskID: TimerTaskStatusNone},
+		},
+		ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{
+			7: {InitiatedID: 7, StartedID: common.EmptyEventID},
+		},
+		RequestCancelInfos: map[int64]*persistence.RequestCancelInfo{
+			8: {InitiatedID: 8},
+		},
+		SignalInfos: map[int64]*persistence.SignalInfo{
+			9: {InitiatedID: 9},
+		},
+		SignalRequestedIDs: map[string]struct{}{},
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	mutableStateCategoryExecutionInfo     = "ExecutionInfo"
+	mutableStateCategoryReplicationState  = "ReplicationState"
+	mutableStateCategoryActivityInfo      = "ActivityInfo"
+	mutableStateCategoryTimerInfo         = "TimerInfo"
+	mutableStateCategoryChildExecution    = "ChildExecutionInfo"
+	mutableStateCategoryRequestCancelInfo = "RequestCancelInfo"
+	mutableStateCategorySignalInfo        = "SignalInfo"
+	mutableStateCategorySignalRequested   = "SignalRequested"
+)
+
+type (
+	mutableStateVerifier struct {
+		shard    ShardContext
+		context  workflowExecutionContext
+		resolver *conflictResolverImpl
+		logger   log.Logger
+	}
+
+	mutableStateDiff struct {
+		differences []*h.MutableStateDifference
+	}
+)
+
+func newMutableStateVerifier(shard ShardContext, context workflowExecutionContext, historyMgr persistence.HistoryManager,
+	historyV2Mgr persistence.HistoryV2Manager, logger log.Logger) *mutableStateVerifier {
+
+	return &mutableStateVerifier{
+		shard:    shard,
+		context:  context,
+		resolver: newConflictResolver(shard, context, historyMgr, historyV2Mgr, logger),
+		logger:   logger,
+	}
+}
+
+// verify rebuilds the mutable state from history and compares it with the stored one.
+// Only the fields which can be derived from history events are compared.
+// If repair is true and differences are found, the stored mutable state is overwritten
+// by the rebuilt one, with the non derivable fields carried over from the stored copy.
+func (v *mutableStateVerifier) verify(
+	ctx context.Context,
+	msBuilder mutableState,
+	repair bool,
+) (*h.VerifyMutableStateResponse, error) {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	rebuilt, err := v.resolver.rebuild(
+		ctx,
+		executionInfo.CreateRequestID,
+		msBuilder.GetNextEventID()-1,
+		executionInfo,
+		msBuilder.GetReplicationState() != nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := msBuilder.CopyToPersistence()
+	diff := &mutableStateDiff{}
+	diff.compare(stored, rebuilt.CopyToPersistence())
+
+	resp := &h.VerifyMutableStateResponse{
+		Differences: diff.differences,
+		Repaired:    common.BoolPtr(false),
+	}
+	if !repair || len(diff.differences) == 0 {
+		return resp, nil
+	}
+
+	if err := v.validateRepair(ctx, msBuilder); err != nil {
+		return nil, err
+	}
+
+	carryOverNonDerivableFields(stored, rebuilt.CopyToPersistence())
+	v.logger.Info("Repairing mutable state with the one rebuilt from history.",
+		tag.WorkflowResetNextEventID(rebuilt.GetNextEventID()))
+	if _, err := v.context.resetMutableState(
+		ctx,
+		executionInfo.RunID,
+		msBuilder.GetLastWriteVersion(),
+		executionInfo.State,
+		nil,
+		nil,
+		nil,
+		rebuilt,
+	); err != nil {
+		v.logger.Error("Mutable state repair err reset workflow.", tag.Error(err))
+		return nil, err
+	}
+
+	resp.Repaired = common.BoolPtr(true)
+	return resp, nil
+}
+
+func (v *mutableStateVerifier) validateRepair(
+	ctx context.Context,
+	msBuilder mutableState,
+) error {
+
+	if msBuilder.HasBufferedEvents() {
+		return &workflow.BadRequestError{Message: "Repair is not supported for workflows with buffered events."}
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	resp, err := v.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   executionInfo.DomainID,
+		WorkflowID: executionInfo.WorkflowID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.RunID != executionInfo.RunID {
+		return &workflow.BadRequestError{Message: "Repair is only supported for the current run of the workflow."}
+	}
+	return nil
+}
+
+// carryOverNonDerivableFields copies the fields which are not recorded in history,
+// e.g. random request IDs, heartbeat details and timer task status, from the stored
+// mutable state into the rebuilt one
+func carryOverNonDerivableFields(
+	stored *persistence.WorkflowMutableState,
+	rebuilt *persistence.WorkflowMutableState,
+) {
+
+	storedInfo := stored.ExecutionInfo
+	rebuiltInfo := rebuilt.ExecutionInfo
+	rebuiltInfo.ExecutionContext = storedInfo.ExecutionContext
+	rebuiltInfo.LastUpdatedTimestamp = storedInfo.LastUpdatedTimestamp
+	rebuiltInfo.StickyTaskList = storedInfo.StickyTaskList
+	rebuiltInfo.StickyScheduleToStartTimeout = storedInfo.StickyScheduleToStartTimeout
+	rebuiltInfo.ClientLibraryVersion = storedInfo.ClientLibraryVersion
+	rebuiltInfo.ClientFeatureVersion = storedInfo.ClientFeatureVersion
+	rebuiltInfo.ClientImpl = storedInfo.ClientImpl
+	if isTransientDecision(storedInfo) {
+		// transient decision is not recorded in history until completion
+		rebuiltInfo.DecisionVersion = storedInfo.DecisionVersion
+		rebuiltInfo.DecisionScheduleID = storedInfo.DecisionScheduleID
+		rebuiltInfo.DecisionStartedID = storedInfo.DecisionStartedID
+		rebuiltInfo.DecisionTimeout = storedInfo.DecisionTimeout
+		rebuiltInfo.DecisionAttempt = storedInfo.DecisionAttempt
+		rebuiltInfo.DecisionStartedTimestamp = storedInfo.DecisionStartedTimestamp
+		rebuiltInfo.DecisionScheduledTimestamp = storedInfo.DecisionScheduledTimestamp
+		rebuiltInfo.DecisionRequestID = storedInfo.DecisionRequestID
+	}
+
+	for id, ai := range rebuilt.ActivityInfos {
+		if storedAI, ok := stored.ActivityInfos[id]; ok {
+			ai.Details = storedAI.Details
+			ai.LastHeartBeatUpdatedTime = storedAI.LastHeartBeatUpdatedTime
+			ai.TimerTaskStatus = storedAI.TimerTaskStatus
+		}
+	}
+	for id, ti := range rebuilt.TimerInfos {
+		if storedTI, ok := stored.TimerInfos[id]; ok {
+			ti.ExpiryTime = storedTI.ExpiryTime
+			ti.TaskID = storedTI.TaskID
+		}
+	}
+	for id, ci := range rebuilt.ChildExecutionInfos {
+		if storedCI, ok := stored.ChildExecutionInfos[id]; ok {
+			ci.CreateRequestID = storedCI.CreateRequestID
+		}
+	}
+	for id, rci := range rebuilt.RequestCancelInfos {
+		if storedRCI, ok := stored.RequestCancelInfos[id]; ok {
+			rci.CancelRequestID = storedRCI.CancelRequestID
+		}
+	}
+	for id, si := range rebuilt.SignalInfos {
+		if storedSI, ok := stored.SignalInfos[id]; ok {
+			si.SignalRequestID = storedSI.SignalRequestID
+		}
+	}
+}
+
+func isTransientDecision(info *persistence.WorkflowExecutionInfo) bool {
+	return info.DecisionScheduleID != common.EmptyEventID && info.DecisionAttempt > 0
+}
+
+func (d *mutableStateDiff) compare(
+	stored *persistence.WorkflowMutableState,
+	rebuilt *persistence.WorkflowMutableState,
+) {
+
+	d.compareExecutionInfo(stored.ExecutionInfo, rebuilt.ExecutionInfo)
+	d.compareReplicationState(stored.ReplicationState, rebuilt.ReplicationState)
+
+	activityIDs := make(map[int64]struct{})
+	for id := range stored.ActivityInfos {
+		activityIDs[id] = struct{}{}
+	}
+	for id := range rebuilt.ActivityInfos {
+		activityIDs[id] = struct{}{}
+	}
+	for _, id := range sortedInt64Keys(activityIDs) {
+		key := strconv.FormatInt(id, 10)
+		storedAI, rebuiltAI := stored.ActivityInfos[id], rebuilt.ActivityInfos[id]
+		if storedAI == nil || rebuiltAI == nil {
+			d.addEntry(mutableStateCategoryActivityInfo, key, storedAI, rebuiltAI)
+			continue
+		}
+		category := mutableStateCategoryActivityInfo
+		d.addField(category, key, "Version", storedAI.Version, rebuiltAI.Version)
+		d.addField(category, key, "StartedID", storedAI.StartedID, rebuiltAI.StartedID)
+		d.addField(category, key, "ActivityID", storedAI.ActivityID, rebuiltAI.ActivityID)
+		d.addField(category, key, "ScheduleToStartTimeout", storedAI.ScheduleToStartTimeout, rebuiltAI.ScheduleToStartTimeout)
+		d.addField(category, key, "ScheduleToCloseTimeout", storedAI.ScheduleToCloseTimeout, rebuiltAI.ScheduleToCloseTimeout)
+		d.addField(category, key, "StartToCloseTimeout", storedAI.StartToCloseTimeout, rebuiltAI.StartToCloseTimeout)
+		d.addField(category, key, "HeartbeatTimeout", storedAI.HeartbeatTimeout, rebuiltAI.HeartbeatTimeout)
+		d.addField(category, key, "CancelRequested", storedAI.CancelRequested, rebuiltAI.CancelRequested)
+		d.addField(category, key, "CancelRequestID", storedAI.CancelRequestID, rebuiltAI.CancelRequestID)
+		d.addField(category, key, "TaskList", storedAI.TaskList, rebuiltAI.TaskList)
+	}
+
+	timerIDs := make(map[string]struct{})
+	for id := range stored.TimerInfos {
+		timerIDs[id] = struct{}{}
+	}
+	for id := range rebuilt.TimerInfos {
+		timerIDs[id] = struct{}{}
+	}
+	for _, id := range sortedStringKeys(timerIDs) {
+		storedTI, rebuiltTI := stored.TimerInfos[id], rebuilt.TimerInfos[id]
+		if storedTI == nil || rebuiltTI == nil {
+			d.addEntry(mutableStateCategoryTimerInfo, id, storedTI, rebuiltTI)
+			continue
+		}
+		d.addField(mutableStateCategoryTimerInfo, id, "Version", storedTI.Version, rebuiltTI.Version)
+		d.addField(mutableStateCategoryTimerInfo, id, "StartedID", storedTI.StartedID, rebuiltTI.StartedID)
+	}
+
+	childIDs := make(map[int64]struct{})
+	for id := range stored.ChildExecutionInfos {
+		childIDs[id] = struct{}{}
+	}
+	for id := range rebuilt.ChildExecutionInfos {
+		childIDs[id] = struct{}{}
+	}
+	for _, id := range sortedInt64Keys(childIDs) {
+		key := strconv.FormatInt(id, 10)
+		storedCI, rebuiltCI := stored.ChildExecutionInfos[id], rebuilt.ChildExecutionInfos[id]
+		if storedCI == nil || rebuiltCI == nil {
+			d.addEntry(mutableStateCategoryChildExecution, key, storedCI, rebuiltCI)
+			continue
+		}
+		category := mutableStateCategoryChildExecution
+		d.addField(category, key, "Version", storedCI.Version, rebuiltCI.Version)
+		d.addField(category, key, "StartedID", storedCI.StartedID, rebuiltCI.StartedID)
+		d.addField(category, key, "StartedWorkflowID", storedCI.StartedWorkflowID, rebuiltCI.StartedWorkflowID)
+		d.addField(category, key, "StartedRunID", storedCI.StartedRunID, rebuiltCI.StartedRunID)
+		d.addField(category, key, "DomainName", storedCI.DomainName, rebuiltCI.DomainName)
+		d.addField(category, key, "WorkflowTypeName", storedCI.WorkflowTypeName, rebuiltCI.WorkflowTypeName)
+	}
+
+	requestCancelIDs := make(map[int64]struct{})
+	for id := range stored.RequestCancelInfos {
+		requestCancelIDs[id] = struct{}{}
+	}
+	for id := range rebuilt.RequestCancelInfos {
+		requestCancelIDs[id] = struct{}{}
+	}
+	for _, id := range sortedInt64Keys(requestCancelIDs) {
+		key := strconv.FormatInt(id, 10)
+		storedRCI, rebuiltRCI := stored.RequestCancelInfos[id], rebuilt.RequestCancelInfos[id]
+		if storedRCI == nil || rebuiltRCI == nil {
+			d.addEntry(mutableStateCategoryRequestCancelInfo, key, storedRCI, rebuiltRCI)
+			continue
+		}
+		d.addField(mutableStateCategoryRequestCancelInfo, key, "Version", storedRCI.Version, rebuiltRCI.Version)
+	}
+
+	signalIDs := make(map[int64]struct{})
+	for id := range stored.SignalInfos {
+		signalIDs[id] = struct{}{}
+	}
+	for id := range rebuilt.SignalInfos {
+		signalIDs[id] = struct{}{}
+	}
+	for _, id := range sortedInt64Keys(signalIDs) {
+		key := strconv.FormatInt(id, 10)
+		storedSI, rebuiltSI := stored.SignalInfos[id], rebuilt.SignalInfos[id]
+		if storedSI == nil || rebuiltSI == nil {
+			d.addEntry(mutableStateCategorySignalInfo, key, storedSI, rebuiltSI)
+			continue
+		}
+		d.addField(mutableStateCategorySignalInfo, key, "Version", storedSI.Version, rebuiltSI.Version)
+		d.addField(mutableStateCategorySignalInfo, key, "SignalName", storedSI.SignalName, rebuiltSI.SignalName)
+		d.addField(mutableStateCategorySignalInfo, key, "Input", string(storedSI.Input), string(rebuiltSI.Input))
+		d.addField(mutableStateCategorySignalInfo, key, "Control", string(storedSI.Control), string(rebuiltSI.Control))
+	}
+
+	signalRequestedIDs := make(map[string]struct{})
+	for id := range stored.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	for id := range rebuilt.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	for _, id := range sortedStringKeys(signalRequestedIDs) {
+		_, inStored := stored.SignalRequestedIDs[id]
+		_, inRebuilt := rebuilt.SignalRequestedIDs[id]
+		d.addField(mutableStateCategorySignalRequested, id, "", inStored, inRebuilt)
+	}
+}
+
+func (d *mutableStateDiff) compareExecutionInfo(
+	stored *persistence.WorkflowExecutionInfo,
+	rebuilt *persistence.WorkflowExecutionInfo,
+) {
+
+	category := mutableStateCategoryExecutionInfo
+	key := stored.RunID
+	d.addField(category, key, "ParentDomainID", stored.ParentDomainID, rebuilt.ParentDomainID)
+	d.addField(category, key, "ParentWorkflowID", stored.ParentWorkflowID, rebuilt.ParentWorkflowID)
+	d.addField(category, key, "ParentRunID", stored.ParentRunID, rebuilt.ParentRunID)
+	d.addField(category, key, "InitiatedID", stored.InitiatedID, rebuilt.InitiatedID)
+	d.addField(category, key, "TaskList", stored.TaskList, rebuilt.TaskList)
+	d.addField(category, key, "WorkflowTypeName", stored.WorkflowTypeName, rebuilt.WorkflowTypeName)
+	d.addField(category, key, "WorkflowTimeout", stored.WorkflowTimeout, rebuilt.WorkflowTimeout)
+	d.addField(category, key, "DecisionTimeoutValue", stored.DecisionTimeoutValue, rebuilt.DecisionTimeoutValue)
+	d.addField(category, key, "State", stored.State, rebuilt.State)
+	d.addField(category, key, "CloseStatus", stored.CloseStatus, rebuilt.CloseStatus)
+	d.addField(category, key, "NextEventID", stored.NextEventID, rebuilt.NextEventID)
+	d.addField(category, key, "LastProcessedEvent", stored.LastProcessedEvent, rebuilt.LastProcessedEvent)
+	d.addField(category, key, "SignalCount", stored.SignalCount, rebuilt.SignalCount)
+	d.addField(category, key, "CancelRequested", stored.CancelRequested, rebuilt.CancelRequested)
+	d.addField(category, key, "Attempt", stored.Attempt, rebuilt.Attempt)
+	d.addField(category, key, "HasRetryPolicy", stored.HasRetryPolicy, rebuilt.HasRetryPolicy)
+	d.addField(category, key, "CronSchedule", stored.CronSchedule, rebuilt.CronSchedule)
+	if !isTransientDecision(stored) {
+		// transient decision is not recorded in history, so it cannot be verified
+		d.addField(category, key, "DecisionScheduleID", stored.DecisionScheduleID, rebuilt.DecisionScheduleID)
+		d.addField(category, key, "DecisionStartedID", stored.DecisionStartedID, rebuilt.DecisionStartedID)
+		d.addField(category, key, "DecisionAttempt", stored.DecisionAttempt, rebuilt.DecisionAttempt)
+	}
+}
+
+func (d *mutableStateDiff) compareReplicationState(
+	stored *persistence.ReplicationState,
+	rebuilt *persistence.ReplicationState,
+) {
+
+	if stored == nil || rebuilt == nil {
+		if stored != rebuilt {
+			d.addEntry(mutableStateCategoryReplicationState, "", stored, rebuilt)
+		}
+		return
+	}
+
+	category := mutableStateCategoryReplicationState
+	d.addField(category, "", "StartVersion", stored.StartVersion, rebuilt.StartVersion)
+	d.addField(category, "", "LastWriteVersion", stored.LastWriteVersion, rebuilt.LastWriteVersion)
+	d.addField(category, "", "LastWriteEventID", stored.LastWriteEventID, rebuilt.LastWriteEventID)
+}
+
+func (d *mutableStateDiff) addField(
+	category string,
+	key string,
+	field string,
+	storedValue interface{},
+	rebuiltValue interface{},
+) {
+
+	stored := fmt.Sprintf("%v", storedValue)
+	rebuilt := fmt.Sprintf("%v", rebuiltValue)
+	if stored == rebuilt {
+		return
+	}
+	d.differences = append(d.differences, &h.MutableStateDifference{
+		Category:     common.StringPtr(category),
+		Key:          common.StringPtr(key),
+		Field:        common.StringPtr(field),
+		StoredValue:  common.StringPtr(stored),
+		RebuiltValue: common.StringPtr(rebuilt),
+	})
+}
+
+// addEntry records an entry which only exists in one of the stored and rebuilt mutable states
+func (d *mutableStateDiff) addEntry(
+	category string,
+	key string,
+	storedEntry interface{},
+	rebuiltEntry interface{},
+) {
+
+	d.differences = append(d.differences, &h.MutableStateDifference{
+		Category:     common.StringPtr(category),
+		Key:          common.StringPtr(key),
+		StoredValue:  common.StringPtr(toJSONString(storedEntry)),
+		RebuiltValue: common.StringPtr(toJSONString(rebuiltEntry)),
+	})
+}
+
+func toJSONString(entry interface{}) string {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Sprintf("%v", entry)
+	}
+	return string(data)
+}
+
+func sortedInt64Keys(keys map[int64]struct{}) []int64 {
+	result := make([]int64, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func sortedStringKeys(keys map[string]struct{}) []string {
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	mutableStateVerifierSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		logger              log.Logger
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockHistoryMgr      *mocks.HistoryManager
+		mockHistoryV2Mgr    *mocks.HistoryV2Manager
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockDomainCache     *cache.DomainCacheMock
+		mockEventsCache     *MockEventsCache
+		mockMutableState    *mockMutableState
+		mockShard           *shardContextImpl
+		mockContext         *workflowExecutionContextImpl
+
+		verifier *mutableStateVerifier
+	}
+)
+
+func TestMutableStateVerifierSuite(t *testing.T) {
+	s := new(mutableStateVerifierSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateVerifierSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockEventsCache = &MockEventsCache{}
+	s.mockMutableState = &mockMutableState{}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	mockService := service.NewTestService(s.mockClusterMetadata, nil, metricsClient, &client.MockClientBean{})
+
+	s.mockShard = &shardContextImpl{
+		service:                   mockService,
+		shardInfo:                 &persistence.ShardInfo{ShardID: 10, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		executionManager:          s.mockExecutionMgr,
+		historyMgr:                s.mockHistoryMgr,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    NewDynamicConfigForTest(),
+		logger:                    s.logger,
+		domainCache:               s.mockDomainCache,
+		metricsClient:             metricsClient,
+		eventsCache:               s.mockEventsCache,
+		timeSource:                clock.NewRealTimeSource(),
+		payloadOffloader:          payload.NewOffloader(nil, nil),
+	}
+	s.mockContext = newWorkflowExecutionContext(validDomainID, shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}, s.mockShard, s.mockExecutionMgr, s.logger)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{}, nil, "", nil,
+	), nil)
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	s.verifier = newMutableStateVerifier(s.mockShard, s.mockContext, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.logger)
+}
+
+func (s *mutableStateVerifierSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockMutableState.AssertExpectations(s.T())
+}
+
+func (s *mutableStateVerifierSuite) TestVerify_Differences() {
+	s.setupStoredMutableState(1)
+
+	resp, err := s.verifier.verify(context.Background(), s.mockMutableState, false)
+	s.NoError(err)
+	s.False(resp.GetRepaired())
+	s.Equal(1, len(resp.Differences))
+	s.Equal(mutableStateCategoryExecutionInfo, resp.Differences[0].GetCategory())
+	s.Equal(validRunID, resp.Differences[0].GetKey())
+	s.Equal("SignalCount", resp.Differences[0].GetField())
+	s.Equal("1", resp.Differences[0].GetStoredValue())
+	s.Equal("0", resp.Differences[0].GetRebuiltValue())
+}
+
+func (s *mutableStateVerifierSuite) TestVerify_NoDifferenceNotRepaired() {
+	s.setupStoredMutableState(0)
+
+	resp, err := s.verifier.verify(context.Background(), s.mockMutableState, true)
+	s.NoError(err)
+	s.False(resp.GetRepaired())
+	s.Empty(resp.Differences)
+}
+
+func (s *mutableStateVerifierSuite) TestVerify_RepairWithoutReplicationState() {
+	s.setupStoredMutableState(1)
+	s.mockMutableState.On("HasBufferedEvents").Return(false)
+	s.mockMutableState.On("GetLastWriteVersion").Return(common.EmptyVersion)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, &persistence.GetCurrentExecutionRequest{
+		DomainID:   validDomainID,
+		WorkflowID: "some random workflow ID",
+	}).Return(&persistence.GetCurrentExecutionResponse{RunID: validRunID}, nil).Once()
+	s.mockExecutionMgr.On("ResetMutableState", mock.Anything, mock.MatchedBy(func(request *persistence.ResetMutableStateRequest) bool {
+		return request.ReplicationState == nil &&
+			request.PrevRunID == validRunID &&
+			request.PrevLastWriteVersion == common.EmptyVersion &&
+			request.ExecutionInfo.SignalCount == 0 &&
+			request.ExecutionInfo.StickyTaskList == "sticky"
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(&persistence.GetWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.verifier.verify(context.Background(), s.mockMutableState, true)
+	s.NoError(err)
+	s.True(resp.GetRepaired())
+	s.Equal(1, len(resp.Differences))
+}
+
+func (s *mutableStateVerifierSuite) TestVerify_RepairRejected_BufferedEvents() {
+	s.setupStoredMutableState(1)
+	s.mockMutableState.On("HasBufferedEvents").Return(true)
+
+	_, err := s.verifier.verify(context.Background(), s.mockMutableState, true)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *mutableStateVerifierSuite) TestVerify_RepairRejected_NotCurrentRun() {
+	s.setupStoredMutableState(1)
+	s.mockMutableState.On("HasBufferedEvents").Return(false)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).
+		Return(&persistence.GetCurrentExecutionResponse{RunID: "another run ID"}, nil).Once()
+
+	_, err := s.verifier.verify(context.Background(), s.mockMutableState, true)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *mutableStateVerifierSuite) TestCompare_NoDifference() {
+	stored := s.newMutableState()
+	rebuilt := s.newMutableState()
+	rebuilt.ActivityInfos[5].Details = []byte("heartbeat details are not derivable")
+	rebuilt.ChildExecutionInfos[7].CreateRequestID = "another request ID"
+
+	diff := &mutableStateDiff{}
+	diff.compare(stored, rebuilt)
+	s.Empty(diff.differences)
+}
+
+func (s *mutableStateVerifierSuite) TestCompare_Differences() {
+	stored := s.newMutableState()
+	rebuilt := s.newMutableState()
+	rebuilt.ExecutionInfo.NextEventID = 11
+	rebuilt.ActivityInfos[5].StartedID = 9
+	delete(rebuilt.TimerInfos, "timer")
+	rebuilt.SignalRequestedIDs["signal-request"] = struct{}{}
+
+	diff := &mutableStateDiff{}
+	diff.compare(stored, rebuilt)
+	s.Equal(4, len(diff.differences))
+
+	s.Equal(mutableStateCategoryExecutionInfo, diff.differences[0].GetCategory())
+	s.Equal("NextEventID", diff.differences[0].GetField())
+	s.Equal("10", diff.differences[0].GetStoredValue())
+	s.Equal("11", diff.differences[0].GetRebuiltValue())
+
+	s.Equal(mutableStateCategoryActivityInfo, diff.differences[1].GetCategory())
+	s.Equal("5", diff.differences[1].GetKey())
+	s.Equal("StartedID", diff.differences[1].GetField())
+
+	s.Equal(mutableStateCategoryTimerInfo, diff.differences[2].GetCategory())
+	s.Equal("timer", diff.differences[2].GetKey())
+	s.Equal("null", diff.differences[2].GetRebuiltValue())
+
+	s.Equal(mutableStateCategorySignalRequested, diff.differences[3].GetCategory())
+	s.Equal("false", diff.differences[3].GetStoredValue())
+	s.Equal("true", diff.differences[3].GetRebuiltValue())
+}
+
+func (s *mutableStateVerifierSuite) TestCompare_TransientDecisionIgnored() {
+	stored := s.newMutableState()
+	stored.ExecutionInfo.DecisionScheduleID = 10
+	stored.ExecutionInfo.DecisionAttempt = 2
+	rebuilt := s.newMutableState()
+
+	diff := &mutableStateDiff{}
+	diff.compare(stored, rebuilt)
+	s.Empty(diff.differences)
+}
+
+func (s *mutableStateVerifierSuite) TestCarryOverNonDerivableFields() {
+	stored := s.newMutableState()
+	stored.ExecutionInfo.StickyTaskList = "sticky"
+	stored.ActivityInfos[5].Details = []byte("details")
+	stored.ActivityInfos[5].TimerTaskStatus = TimerTaskStatusCreatedHeartbeat
+	stored.TimerInfos["timer"].TaskID = TimerTaskStatusCreated
+	stored.ChildExecutionInfos[7].CreateRequestID = "stored create request ID"
+	rebuilt := s.newMutableState()
+
+	carryOverNonDerivableFields(stored, rebuilt)
+	s.Equal("sticky", rebuilt.ExecutionInfo.StickyTaskList)
+	s.Equal([]byte("details"), rebuilt.ActivityInfos[5].Details)
+	s.Equal(int32(TimerTaskStatusCreatedHeartbeat), rebuilt.ActivityInfos[5].TimerTaskStatus)
+	s.Equal(int64(TimerTaskStatusCreated), rebuilt.TimerInfos["timer"].TaskID)
+	s.Equal("stored create request ID", rebuilt.ChildExecutionInfos[7].CreateRequestID)
+}
+
+// setupStoredMutableState mocks the stored mutable state of a workflow whose history has a started and a
+// decision scheduled event, the stored mutable state differs from the rebuilt one by its signal count
+func (s *mutableStateVerifierSuite) setupStoredMutableState(storedSignalCount int32) {
+	startTime := time.Now()
+	event1 := &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(1),
+		Version:   common.Int64Ptr(common.EmptyVersion),
+		Timestamp: common.Int64Ptr(startTime.UnixNano()),
+		WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("some random workflow type")},
+			TaskList:                            &shared.TaskList{Name: common.StringPtr("some random task list")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(123),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(233),
+		},
+	}
+	event2 := &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(2),
+		Version:   common.Int64Ptr(common.EmptyVersion),
+		Timestamp: common.Int64Ptr(startTime.UnixNano()),
+		DecisionTaskScheduledEventAttributes: &shared.DecisionTaskScheduledEventAttributes{
+			TaskList:                   &shared.TaskList{Name: common.StringPtr("some random task list")},
+			StartToCloseTimeoutSeconds: common.Int32Ptr(233),
+		},
+	}
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).
+		Return(&persistence.GetWorkflowExecutionHistoryResponse{
+			History:          &shared.History{Events: []*shared.HistoryEvent{event1, event2}},
+			LastFirstEventID: event1.GetEventId(),
+		}, nil).Once()
+
+	// the verifier rebuilds the mutable state up to the last event of the history
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:           validDomainID,
+		WorkflowID:         "some random workflow ID",
+		RunID:              validRunID,
+		CreateRequestID:    "some random request ID",
+		StartTimestamp:     startTime,
+		NextEventID:        3,
+		StickyTaskList:     "sticky",
+		DecisionScheduleID: common.EmptyEventID,
+		DecisionStartedID:  common.EmptyEventID,
+	}
+	s.mockMutableState.On("GetExecutionInfo").Return(executionInfo)
+	s.mockMutableState.On("GetNextEventID").Return(int64(3))
+	s.mockMutableState.On("GetReplicationState").Return(nil)
+
+	rebuilt, err := newConflictResolver(s.mockShard, s.mockContext, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.logger).
+		rebuild(context.Background(), executionInfo.CreateRequestID, 2, executionInfo, false)
+	s.NoError(err)
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).
+		Return(&persistence.GetWorkflowExecutionHistoryResponse{
+			History:          &shared.History{Events: []*shared.HistoryEvent{event1, event2}},
+			LastFirstEventID: event1.GetEventId(),
+		}, nil).Once()
+
+	stored := rebuilt.CopyToPersistence()
+	stored.ExecutionInfo.SignalCount = storedSignalCount
+	stored.ExecutionInfo.StickyTaskList = "sticky"
+	s.mockMutableState.On("CopyToPersistence").Return(stored)
+}
+
+func (s *mutableStateVerifierSuite) newMutableState() *persistence.WorkflowMutableState {
+	return &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			RunID:              "run",
+			NextEventID:        10,
+			DecisionScheduleID: common.EmptyEventID,
+			DecisionStartedID:  common.EmptyEventID,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5, StartedID: common.EmptyEventID, ActivityID: "activity"},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer": {TimerID: "timer", StartedID: 6, TaskID: TimerTaskStatusNone},
+		},
+		ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{
+			7: {InitiatedID: 7, StartedID: common.EmptyEventID},
+		},
+		RequestCancelInfos: map[int64]*persistence.RequestCancelInfo{},
+		SignalInfos:        map[int64]*persistence.SignalInfo{},
+		SignalRequestedIDs: map[string]struct{}{},
+	}
+}
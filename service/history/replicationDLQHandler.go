@@ -0,0 +1,336 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	ctx "context"
+	"math"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+const (
+	replicationDLQDefaultPageSize = 100
+)
+
+type (
+	// replicationDLQFilter selects the replication DLQ tasks of a domain, workflow or run,
+	// an empty field matches all tasks
+	replicationDLQFilter struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+)
+
+var (
+	errInvalidSourceCluster = &workflow.BadRequestError{Message: "Source cluster is not a known remote cluster."}
+)
+
+func (e *historyEngineImpl) ReadDLQMessages(
+	ctx ctx.Context,
+	request *replicator.ReadDLQMessagesRequest,
+) (*replicator.ReadDLQMessagesResponse, error) {
+
+	filter, err := e.newReplicationDLQFilter(request.GetDomain(), request.GetWorkflowID(), request.GetRunID())
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, nextPageToken, err := e.readDLQTasks(
+		ctx,
+		request.GetSourceCluster(),
+		request.InclusiveEndMessageID,
+		request.GetMaximumPageSize(),
+		request.NextPageToken,
+		filter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTasks := make([]*replicator.ReplicationTaskInfo, 0, len(tasks))
+	for _, task := range tasks {
+		replicationTasks = append(replicationTasks, toThriftReplicationTaskInfo(task))
+	}
+	return &replicator.ReadDLQMessagesResponse{
+		ReplicationTasks: replicationTasks,
+		NextPageToken:    nextPageToken,
+	}, nil
+}
+
+func (e *historyEngineImpl) PurgeDLQMessages(
+	ctx ctx.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+) error {
+
+	sourceCluster := request.GetSourceCluster()
+	if err := e.validateDLQSourceCluster(sourceCluster); err != nil {
+		return err
+	}
+
+	filter, err := e.newReplicationDLQFilter(request.GetDomain(), request.GetWorkflowID(), request.GetRunID())
+	if err != nil {
+		return err
+	}
+
+	if filter.isEmpty() {
+		return e.executionManager.RangeDeleteReplicationTaskFromDLQ(ctx, &persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    sourceCluster,
+			ExclusiveBeginTaskID: common.EmptyMessageID,
+			InclusiveEndTaskID:   getInclusiveEndMessageID(request.InclusiveEndMessageID),
+		})
+	}
+
+	var nextPageToken []byte
+	for {
+		tasks, token, err := e.readDLQTasks(
+			ctx, sourceCluster, request.InclusiveEndMessageID, replicationDLQDefaultPageSize, nextPageToken, filter,
+		)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if err := e.executionManager.DeleteReplicationTaskFromDLQ(ctx, &persistence.DeleteReplicationTaskFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				TaskID:            task.GetTaskID(),
+			}); err != nil {
+				return err
+			}
+		}
+		if len(token) == 0 {
+			return nil
+		}
+		nextPageToken = token
+	}
+}
+
+func (e *historyEngineImpl) MergeDLQMessages(
+	ctx ctx.Context,
+	request *replicator.MergeDLQMessagesRequest,
+) (*replicator.MergeDLQMessagesResponse, error) {
+
+	sourceCluster := request.GetSourceCluster()
+	if err := e.validateDLQSourceCluster(sourceCluster); err != nil {
+		return nil, err
+	}
+
+	filter, err := e.newReplicationDLQFilter(request.GetDomain(), request.GetWorkflowID(), request.GetRunID())
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, nextPageToken, err := e.readDLQTasks(
+		ctx,
+		sourceCluster,
+		request.InclusiveEndMessageID,
+		request.GetMaximumPageSize(),
+		request.NextPageToken,
+		filter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	historyRereplicator := e.newDLQHistoryRereplicator(sourceCluster)
+	for _, task := range tasks {
+		if err := e.mergeDLQTask(historyRereplicator, task); err != nil {
+			e.logger.Error("Failed to merge replication DLQ task.",
+				tag.SourceCluster(sourceCluster),
+				tag.TaskID(task.GetTaskID()),
+				tag.WorkflowDomainID(task.DomainID),
+				tag.WorkflowID(task.WorkflowID),
+				tag.WorkflowRunID(task.RunID),
+				tag.Error(err))
+			return nil, err
+		}
+		if err := e.executionManager.DeleteReplicationTaskFromDLQ(ctx, &persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: sourceCluster,
+			TaskID:            task.GetTaskID(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &replicator.MergeDLQMessagesResponse{
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// validateDLQSourceCluster makes sure the DLQ of an enabled remote cluster is changed, so that
+// a mistyped cluster name fails instead of silently changing nothing or another cluster's tasks
+func (e *historyEngineImpl) validateDLQSourceCluster(sourceCluster string) error {
+	clusterInfo, ok := e.shard.GetService().GetClusterMetadata().GetAllClusterInfo()[sourceCluster]
+	if !ok || !clusterInfo.Enabled || sourceCluster == e.currentClusterName {
+		return errInvalidSourceCluster
+	}
+	return nil
+}
+
+func (e *historyEngineImpl) newDLQHistoryRereplicator(
+	sourceCluster string,
+) xdc.HistoryRereplicator {
+
+	return xdc.NewHistoryRereplicator(
+		e.currentClusterName,
+		e.shard.GetDomainCache(),
+		e.shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster),
+		func(ctx ctx.Context, request *h.ReplicateRawEventsRequest) error {
+			return e.ReplicateRawEvents(ctx, request)
+		},
+		persistence.NewPayloadSerializer(),
+		historyRereplicationTimeout,
+		e.logger,
+	)
+}
+
+// mergeDLQTask re-applies the task by fetching the events it covers from the source cluster,
+// the activity heartbeat carried by a sync activity task cannot be recovered this way,
+// it will be synced again on the next heartbeat of the activity
+func (e *historyEngineImpl) mergeDLQTask(
+	historyRereplicator xdc.HistoryRereplicator,
+	task *persistence.ReplicationTaskInfo,
+) error {
+
+	switch task.TaskType {
+	case persistence.ReplicationTaskTypeHistory:
+		return historyRereplicator.SendMultiWorkflowHistory(
+			task.DomainID, task.WorkflowID,
+			task.RunID, task.FirstEventID,
+			task.RunID, task.NextEventID,
+		)
+	case persistence.ReplicationTaskTypeSyncActivity:
+		// the next event ID should be at activity schedule ID + 1
+		return historyRereplicator.SendMultiWorkflowHistory(
+			task.DomainID, task.WorkflowID,
+			task.RunID, common.FirstEventID,
+			task.RunID, task.ScheduledID+1,
+		)
+	default:
+		return errUnknownReplicationTask
+	}
+}
+
+// readDLQTasks reads one page of the replication DLQ and returns the tasks matching the filter
+func (e *historyEngineImpl) readDLQTasks(
+	ctx ctx.Context,
+	sourceCluster string,
+	inclusiveEndMessageID *int64,
+	pageSize int32,
+	pageToken []byte,
+	filter *replicationDLQFilter,
+) ([]*persistence.ReplicationTaskInfo, []byte, error) {
+
+	if pageSize <= 0 {
+		pageSize = replicationDLQDefaultPageSize
+	}
+	response, err := e.executionManager.GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:     common.EmptyMessageID,
+			MaxReadLevel:  getInclusiveEndMessageID(inclusiveEndMessageID),
+			BatchSize:     int(pageSize),
+			NextPageToken: pageToken,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*persistence.ReplicationTaskInfo
+	for _, task := range response.Tasks {
+		if filter.match(task) {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, response.NextPageToken, nil
+}
+
+func (e *historyEngineImpl) newReplicationDLQFilter(
+	domainName string,
+	workflowID string,
+	runID string,
+) (*replicationDLQFilter, error) {
+
+	filter := &replicationDLQFilter{
+		workflowID: workflowID,
+		runID:      runID,
+	}
+	if domainName != "" {
+		domainID, err := e.shard.GetDomainCache().GetDomainID(domainName)
+		if err != nil {
+			return nil, err
+		}
+		filter.domainID = domainID
+	}
+	return filter, nil
+}
+
+func (f *replicationDLQFilter) isEmpty() bool {
+	return f.domainID == "" && f.workflowID == "" && f.runID == ""
+}
+
+func (f *replicationDLQFilter) match(task *persistence.ReplicationTaskInfo) bool {
+	if f.domainID != "" && f.domainID != task.DomainID {
+		return false
+	}
+	if f.workflowID != "" && f.workflowID != task.WorkflowID {
+		return false
+	}
+	if f.runID != "" && f.runID != task.RunID {
+		return false
+	}
+	return true
+}
+
+func getInclusiveEndMessageID(inclusiveEndMessageID *int64) int64 {
+	if inclusiveEndMessageID == nil {
+		return math.MaxInt64
+	}
+	return *inclusiveEndMessageID
+}
+
+func toThriftReplicationTaskInfo(task *persistence.ReplicationTaskInfo) *replicator.ReplicationTaskInfo {
+	var taskType replicator.ReplicationTaskType
+	switch task.TaskType {
+	case persistence.ReplicationTaskTypeSyncActivity:
+		taskType = replicator.ReplicationTaskTypeSyncActivity
+	default:
+		taskType = replicator.ReplicationTaskTypeHistory
+	}
+	return &replicator.ReplicationTaskInfo{
+		DomainID:     common.StringPtr(task.DomainID),
+		WorkflowID:   common.StringPtr(task.WorkflowID),
+		RunID:        common.StringPtr(task.RunID),
+		TaskType:     taskType.Ptr(),
+		TaskID:       common.Int64Ptr(task.GetTaskID()),
+		Version:      common.Int64Ptr(task.Version),
+		FirstEventID: common.Int64Ptr(task.FirstEventID),
+		NextEventID:  common.Int64Ptr(task.NextEventID),
+		ScheduledID:  common.Int64Ptr(task.ScheduledID),
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	replicationDLQHandlerSuite struct {
+		suite.Suite
+
+		logger              log.Logger
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockClientBean      *client.MockClientBean
+		mockAdminClient     *mocks.AdminClient
+		mockDomainCache     *cache.DomainCacheMock
+
+		historyEngine *historyEngineImpl
+	}
+)
+
+const (
+	testDLQSourceCluster = cluster.TestAlternativeClusterName
+)
+
+func TestReplicationDLQHandlerSuite(t *testing.T) {
+	s := new(replicationDLQHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationDLQHandlerSuite) SetupTest() {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClientBean = &client.MockClientBean{}
+	s.mockAdminClient = &mocks.AdminClient{}
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	mockService := service.NewTestService(s.mockClusterMetadata, nil, metricsClient, s.mockClientBean)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+
+	mockShard := &shardContextImpl{
+		service:          mockService,
+		shardInfo:        &persistence.ShardInfo{ShardID: 0, RangeID: 1},
+		config:           NewDynamicConfigForTest(),
+		logger:           s.logger,
+		metricsClient:    metricsClient,
+		domainCache:      s.mockDomainCache,
+		executionManager: s.mockExecutionMgr,
+	}
+	s.historyEngine = &historyEngineImpl{
+		currentClusterName: cluster.TestCurrentClusterName,
+		shard:              mockShard,
+		executionManager:   s.mockExecutionMgr,
+		metricsClient:      metricsClient,
+		logger:             s.logger,
+	}
+}
+
+func (s *replicationDLQHandlerSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockClientBean.AssertExpectations(s.T())
+	s.mockAdminClient.AssertExpectations(s.T())
+	s.mockDomainCache.AssertExpectations(s.T())
+}
+
+func (s *replicationDLQHandlerSuite) TestReadDLQMessages_FilterByWorkflow() {
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: testDLQSourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:     common.EmptyMessageID,
+			MaxReadLevel:  100,
+			BatchSize:     10,
+			NextPageToken: []byte("some random page token"),
+		},
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			s.newHistoryTask(11, "some random workflow ID", 1, 5),
+			s.newHistoryTask(12, "some other workflow ID", 1, 5),
+			s.newSyncActivityTask(13, "some random workflow ID", 21),
+		},
+		NextPageToken: []byte("some random next page token"),
+	}, nil).Once()
+
+	response, err := s.historyEngine.ReadDLQMessages(context.Background(), &replicator.ReadDLQMessagesRequest{
+		SourceCluster:         common.StringPtr(testDLQSourceCluster),
+		InclusiveEndMessageID: common.Int64Ptr(100),
+		MaximumPageSize:       common.Int32Ptr(10),
+		NextPageToken:         []byte("some random page token"),
+		WorkflowID:            common.StringPtr("some random workflow ID"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("some random next page token"), response.NextPageToken)
+	s.Len(response.ReplicationTasks, 2)
+	s.Equal(int64(11), response.ReplicationTasks[0].GetTaskID())
+	s.Equal(replicator.ReplicationTaskTypeHistory, response.ReplicationTasks[0].GetTaskType())
+	s.Equal(int64(1), response.ReplicationTasks[0].GetFirstEventID())
+	s.Equal(int64(5), response.ReplicationTasks[0].GetNextEventID())
+	s.Equal(int64(13), response.ReplicationTasks[1].GetTaskID())
+	s.Equal(replicator.ReplicationTaskTypeSyncActivity, response.ReplicationTasks[1].GetTaskType())
+	s.Equal(int64(21), response.ReplicationTasks[1].GetScheduledID())
+}
+
+func (s *replicationDLQHandlerSuite) TestReadDLQMessages_UnknownDomain() {
+	s.mockDomainCache.On("GetDomainID", "some random domain").Return("", errors.New("some random error")).Once()
+
+	_, err := s.historyEngine.ReadDLQMessages(context.Background(), &replicator.ReadDLQMessagesRequest{
+		SourceCluster: common.StringPtr(testDLQSourceCluster),
+		Domain:        common.StringPtr("some random domain"),
+	})
+	s.Error(err)
+}
+
+func (s *replicationDLQHandlerSuite) TestPurgeDLQMessages_NoFilter() {
+	s.mockExecutionMgr.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything, &persistence.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceClusterName:    testDLQSourceCluster,
+		ExclusiveBeginTaskID: common.EmptyMessageID,
+		InclusiveEndTaskID:   100,
+	}).Return(nil).Once()
+
+	err := s.historyEngine.PurgeDLQMessages(context.Background(), &replicator.PurgeDLQMessagesRequest{
+		SourceCluster:         common.StringPtr(testDLQSourceCluster),
+		InclusiveEndMessageID: common.Int64Ptr(100),
+	})
+	s.NoError(err)
+}
+
+func (s *replicationDLQHandlerSuite) TestPurgeDLQMessages_FilterByRun() {
+	task1 := s.newHistoryTask(11, "some random workflow ID", 1, 5)
+	task2 := s.newHistoryTask(12, "some random workflow ID", 5, 9)
+	task2.RunID = "some other run ID"
+	task3 := s.newSyncActivityTask(13, "some random workflow ID", 21)
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything, mock.MatchedBy(func(request *persistence.GetReplicationTasksFromDLQRequest) bool {
+		return request.NextPageToken == nil
+	})).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         []*persistence.ReplicationTaskInfo{task1, task2},
+		NextPageToken: []byte("some random next page token"),
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything, mock.MatchedBy(func(request *persistence.GetReplicationTasksFromDLQRequest) bool {
+		return string(request.NextPageToken) == "some random next page token"
+	})).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{task3},
+	}, nil).Once()
+	for _, taskID := range []int64{11, 13} {
+		s.mockExecutionMgr.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: testDLQSourceCluster,
+			TaskID:            taskID,
+		}).Return(nil).Once()
+	}
+
+	err := s.historyEngine.PurgeDLQMessages(context.Background(), &replicator.PurgeDLQMessagesRequest{
+		SourceCluster: common.StringPtr(testDLQSourceCluster),
+		RunID:         common.StringPtr(task1.RunID),
+	})
+	s.NoError(err)
+}
+
+func (s *replicationDLQHandlerSuite) TestPurgeDLQMessages_InvalidSourceCluster() {
+	for _, sourceCluster := range []string{cluster.TestCurrentClusterName, "some random cluster"} {
+		err := s.historyEngine.PurgeDLQMessages(context.Background(), &replicator.PurgeDLQMessagesRequest{
+			SourceCluster:         common.StringPtr(sourceCluster),
+			InclusiveEndMessageID: common.Int64Ptr(100),
+		})
+		s.Equal(errInvalidSourceCluster, err)
+	}
+}
+
+func (s *replicationDLQHandlerSuite) TestMergeDLQMessages_InvalidSourceCluster() {
+	for _, sourceCluster := range []string{cluster.TestCurrentClusterName, "some random cluster"} {
+		_, err := s.historyEngine.MergeDLQMessages(context.Background(), &replicator.MergeDLQMessagesRequest{
+			SourceCluster: common.StringPtr(sourceCluster),
+		})
+		s.Equal(errInvalidSourceCluster, err)
+	}
+}
+
+func (s *replicationDLQHandlerSuite) TestMergeDLQMessages_Success() {
+	// the task covers no missing events, so nothing needs to be fetched from the source cluster
+	task := s.newHistoryTask(11, "some random workflow ID", 5, 5)
+	s.mockClientBean.On("GetRemoteAdminClient", testDLQSourceCluster).Return(s.mockAdminClient).Once()
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         []*persistence.ReplicationTaskInfo{task},
+		NextPageToken: []byte("some random next page token"),
+	}, nil).Once()
+	s.mockExecutionMgr.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: testDLQSourceCluster,
+		TaskID:            11,
+	}).Return(nil).Once()
+
+	response, err := s.historyEngine.MergeDLQMessages(context.Background(), &replicator.MergeDLQMessagesRequest{
+		SourceCluster: common.StringPtr(testDLQSourceCluster),
+	})
+	s.NoError(err)
+	s.Equal([]byte("some random next page token"), response.NextPageToken)
+}
+
+func (s *replicationDLQHandlerSuite) TestMergeDLQMessages_FailedToMerge() {
+	task := s.newHistoryTask(11, "some random workflow ID", 1, 5)
+	s.mockClientBean.On("GetRemoteAdminClient", testDLQSourceCluster).Return(s.mockAdminClient).Once()
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{task},
+	}, nil).Once()
+	s.mockDomainCache.On("GetDomainByID", task.DomainID).Return(nil, errors.New("some random error")).Once()
+
+	// the task is kept in the DLQ when it cannot be merged
+	_, err := s.historyEngine.MergeDLQMessages(context.Background(), &replicator.MergeDLQMessagesRequest{
+		SourceCluster: common.StringPtr(testDLQSourceCluster),
+	})
+	s.Error(err)
+}
+
+func (s *replicationDLQHandlerSuite) newHistoryTask(
+	taskID int64,
+	workflowID string,
+	firstEventID int64,
+	nextEventID int64,
+) *persistence.ReplicationTaskInfo {
+
+	return &persistence.ReplicationTaskInfo{
+		TaskID:       taskID,
+		TaskType:     persistence.ReplicationTaskTypeHistory,
+		DomainID:     validDomainID,
+		WorkflowID:   workflowID,
+		RunID:        validRunID,
+		FirstEventID: firstEventID,
+		NextEventID:  nextEventID,
+		ScheduledID:  common.EmptyEventID,
+	}
+}
+
+func (s *replicationDLQHandlerSuite) newSyncActivityTask(
+	taskID int64,
+	workflowID string,
+	scheduledID int64,
+) *persistence.ReplicationTaskInfo {
+
+	return &persistence.ReplicationTaskInfo{
+		TaskID:       taskID,
+		TaskType:     persistence.ReplicationTaskTypeSyncActivity,
+		DomainID:     validDomainID,
+		WorkflowID:   workflowID,
+		RunID:        validRunID,
+		FirstEventID: common.EmptyEventID,
+		NextEventID:  common.EmptyEventID,
+		ScheduledID:  scheduledID,
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	ctx "context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	r "github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+const (
+	replicationTaskFetchTimeout = 30 * time.Second
+	replicationTaskApplyTimeout = 30 * time.Second
+)
+
+var (
+	errReplicationTaskNotStorable = errors.New("replication task cannot be put to DLQ")
+)
+
+type (
+	// replicationTaskProcessor pulls the replication tasks of one shard from a remote cluster
+	// and applies them to the shard through the history replicator
+	replicationTaskProcessor struct {
+		currentCluster      string
+		sourceCluster       string
+		status              int32
+		shard               ShardContext
+		historyEngine       Engine
+		adminClient         admin.Client
+		historyRereplicator xdc.HistoryRereplicator
+		config              *Config
+		metricsClient       metrics.Client
+		logger              log.Logger
+		retryPolicy         backoff.RetryPolicy
+		shutdownCh          chan struct{}
+
+		lastRetrievedMessageID int64
+		lastProcessedMessageID int64
+	}
+)
+
+// newReplicationTaskProcessors creates a replication task processor for each remote cluster
+func newReplicationTaskProcessors(
+	shard ShardContext,
+	historyEngine *historyEngineImpl,
+	config *Config,
+	logger log.Logger,
+) []*replicationTaskProcessor {
+
+	var processors []*replicationTaskProcessor
+	clusterMetadata := shard.GetService().GetClusterMetadata()
+	for clusterName, info := range clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || clusterName == clusterMetadata.GetCurrentClusterName() {
+			continue
+		}
+
+		adminClient := shard.GetService().GetClientBean().GetRemoteAdminClient(clusterName)
+		historyRereplicator := xdc.NewHistoryRereplicator(
+			clusterMetadata.GetCurrentClusterName(),
+			shard.GetDomainCache(),
+			adminClient,
+			func(ctx ctx.Context, request *h.ReplicateRawEventsRequest) error {
+				return historyEngine.ReplicateRawEvents(ctx, request)
+			},
+			persistence.NewPayloadSerializer(),
+			historyRereplicationTimeout,
+			logger,
+		)
+		processors = append(processors, newReplicationTaskProcessor(
+			shard, historyEngine, clusterName, adminClient, historyRereplicator, config, logger,
+		))
+	}
+	return processors
+}
+
+func newReplicationTaskProcessor(
+	shard ShardContext,
+	historyEngine Engine,
+	sourceCluster string,
+	adminClient admin.Client,
+	historyRereplicator xdc.HistoryRereplicator,
+	config *Config,
+	logger log.Logger,
+) *replicationTaskProcessor {
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(100 * time.Millisecond)
+	retryPolicy.SetMaximumInterval(5 * time.Second)
+	retryPolicy.SetMaximumAttempts(config.ReplicationTaskProcessorMaxRetryCount())
+
+	return &replicationTaskProcessor{
+		currentCluster:         shard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		sourceCluster:          sourceCluster,
+		status:                 common.DaemonStatusInitialized,
+		shard:                  shard,
+		historyEngine:          historyEngine,
+		adminClient:            adminClient,
+		historyRereplicator:    historyRereplicator,
+		config:                 config,
+		metricsClient:          shard.GetMetricsClient(),
+		logger:                 logger.WithTags(tag.ComponentReplicationTaskProcessor, tag.SourceCluster(sourceCluster)),
+		retryPolicy:            retryPolicy,
+		shutdownCh:             make(chan struct{}),
+		lastRetrievedMessageID: common.EmptyMessageID,
+		lastProcessedMessageID: common.EmptyMessageID,
+	}
+}
+
+func (p *replicationTaskProcessor) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go p.processorLoop()
+	p.logger.Info("", tag.LifeCycleStarted)
+}
+
+func (p *replicationTaskProcessor) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.shutdownCh)
+	p.logger.Info("", tag.LifeCycleStopped)
+}
+
+func (p *replicationTaskProcessor) processorLoop() {
+	timer := time.NewTimer(backoff.NewJitter().JitDuration(p.config.ReplicationTaskFetcherPollInterval(), 0.99))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		case <-timer.C:
+			if p.pollAndProcess() {
+				// the source shard has more tasks, poll again right away
+				timer.Reset(0)
+			} else {
+				timer.Reset(p.config.ReplicationTaskFetcherPollInterval())
+			}
+		}
+	}
+}
+
+// pollAndProcess fetches one batch of replication tasks and applies them, returns whether there are more tasks to fetch
+func (p *replicationTaskProcessor) pollAndProcess() bool {
+	shardID := int32(p.shard.GetShardID())
+	request := &r.GetReplicationMessagesRequest{
+		Tokens: []*r.ReplicationToken{
+			{
+				ShardID:                common.Int32Ptr(shardID),
+				LastRetrievedMessageId: common.Int64Ptr(p.lastRetrievedMessageID),
+				LastProcessedMessageId: common.Int64Ptr(p.lastProcessedMessageID),
+			},
+		},
+		ClusterName: common.StringPtr(p.currentCluster),
+	}
+
+	fetchCtx, cancel := ctx.WithTimeout(ctx.Background(), replicationTaskFetchTimeout)
+	response, err := p.adminClient.GetReplicationMessages(fetchCtx, request)
+	cancel()
+	if err != nil {
+		p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationTaskFetcherErrors)
+		p.logger.Warn("Failed to fetch replication tasks.", tag.Error(err))
+		return false
+	}
+
+	messages, ok := response.GetMessagesByShard()[shardID]
+	if !ok || messages == nil {
+		return false
+	}
+
+	p.metricsClient.AddCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationTasksFetched, int64(len(messages.ReplicationTasks)))
+	for _, task := range messages.ReplicationTasks {
+		if err := p.processTask(task); err != nil {
+			// the task is neither applied nor stored in the DLQ, stop here and only ack up to
+			// the previous task, so this task and the ones after it are fetched again
+			p.lastRetrievedMessageID = p.lastProcessedMessageID
+			return false
+		}
+		if task.SourceTaskId != nil {
+			p.lastProcessedMessageID = task.GetSourceTaskId()
+		}
+	}
+
+	// every task in the batch is handled, the tasks skipped by the source are acked as well
+	p.lastRetrievedMessageID = messages.GetLastRetrievedMessageId()
+	p.lastProcessedMessageID = messages.GetLastRetrievedMessageId()
+
+	if syncShardStatus := messages.SyncShardStatus; syncShardStatus != nil {
+		syncCtx, cancel := ctx.WithTimeout(ctx.Background(), replicationTaskApplyTimeout)
+		err := p.historyEngine.SyncShardStatus(syncCtx, &h.SyncShardStatusRequest{
+			SourceCluster: common.StringPtr(p.sourceCluster),
+			ShardId:       common.Int64Ptr(int64(shardID)),
+			Timestamp:     syncShardStatus.Timestamp,
+		})
+		cancel()
+		if err != nil {
+			p.logger.Warn("Failed to sync shard status.", tag.Error(err))
+		}
+	}
+	return messages.GetHasMore()
+}
+
+// processTask applies the task, or puts it to the DLQ if it cannot be applied,
+// returns error if the task is neither applied nor stored in the DLQ
+func (p *replicationTaskProcessor) processTask(task *r.ReplicationTask) error {
+	op := func() error {
+		return p.applyTask(task)
+	}
+	err := backoff.Retry(op, p.retryPolicy, isTransientReplicationError)
+	if err != nil {
+		p.metricsClient.IncCounter(metrics.ReplicationTaskProcessorScope, metrics.ReplicationTasksFailed)
+		p.logger.Error("Failed to apply replication task, putting to DLQ.",
+			tag.TaskType(int(task.GetTaskType())),
+			tag.Error(err))
+		return p.putToDLQ(task)
+	}
+	p.metricsClient.IncCounter(metrics.ReplicationTaskProcessorScope, metrics.ReplicationTasksApplied)
+	if task.CreationTime != nil {
+		p.metricsClient.RecordTimer(
+			metrics.ReplicationTaskProcessorScope,
+			metrics.ReplicationTaskLag,
+			p.shard.GetTimeSource().Now().Sub(time.Unix(0, task.GetCreationTime())),
+		)
+	}
+	return nil
+}
+
+// putToDLQ puts the replication task to the replication DLQ of the shard,
+// so it can be inspected and merged later through the admin DLQ APIs
+func (p *replicationTaskProcessor) putToDLQ(task *r.ReplicationTask) error {
+	taskInfo, ok := xdc.ToDLQReplicationTaskInfo(task)
+	if !ok {
+		p.logger.Error("Replication task cannot be put to DLQ.", tag.TaskType(int(task.GetTaskType())))
+		return errReplicationTaskNotStorable
+	}
+
+	putCtx, cancel := ctx.WithTimeout(ctx.Background(), replicationTaskApplyTimeout)
+	defer cancel()
+	if err := p.shard.GetExecutionManager().PutReplicationTaskToDLQ(putCtx, &persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: p.sourceCluster,
+		TaskInfo:          taskInfo,
+	}); err != nil {
+		p.logger.Error("Failed to put replication task to DLQ.",
+			tag.TaskID(taskInfo.TaskID),
+			tag.WorkflowDomainID(taskInfo.DomainID),
+			tag.WorkflowID(taskInfo.WorkflowID),
+			tag.WorkflowRunID(taskInfo.RunID),
+			tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *replicationTaskProcessor) applyTask(task *r.ReplicationTask) error {
+	switch task.GetTaskType() {
+	case r.ReplicationTaskTypeSyncActivity:
+		return p.applySyncActivityTask(task.SyncActicvityTaskAttributes)
+	case r.ReplicationTaskTypeHistory:
+		return p.applyHistoryTask(task.HistoryTaskAttributes)
+	default:
+		return errUnknownReplicationTask
+	}
+}
+
+func (p *replicationTaskProcessor) applySyncActivityTask(attr *r.SyncActicvityTaskAttributes) error {
+	request := &h.SyncActivityRequest{
+		DomainId:          attr.DomainId,
+		WorkflowId:        attr.WorkflowId,
+		RunId:             attr.RunId,
+		Version:           attr.Version,
+		ScheduledId:       attr.ScheduledId,
+		ScheduledTime:     attr.ScheduledTime,
+		StartedId:         attr.StartedId,
+		StartedTime:       attr.StartedTime,
+		LastHeartbeatTime: attr.LastHeartbeatTime,
+		Details:           attr.Details,
+		Attempt:           attr.Attempt,
+	}
+	apply := func() error {
+		applyCtx, cancel := ctx.WithTimeout(ctx.Background(), replicationTaskApplyTimeout)
+		defer cancel()
+		return p.historyEngine.SyncActivity(applyCtx, request)
+	}
+
+	// the next event ID should be at activity schedule ID + 1
+	return p.applyWithRereplication(apply, attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId(), attr.GetScheduledId()+1)
+}
+
+func (p *replicationTaskProcessor) applyHistoryTask(attr *r.HistoryTaskAttributes) error {
+	request := &h.ReplicateEventsRequest{
+		SourceCluster: common.StringPtr(p.sourceCluster),
+		DomainUUID:    attr.DomainId,
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: attr.WorkflowId,
+			RunId:      attr.RunId,
+		},
+		FirstEventId:            attr.FirstEventId,
+		NextEventId:             attr.NextEventId,
+		Version:                 attr.Version,
+		ReplicationInfo:         attr.ReplicationInfo,
+		History:                 attr.History,
+		NewRunHistory:           attr.NewRunHistory,
+		ForceBufferEvents:       common.BoolPtr(false),
+		EventStoreVersion:       attr.EventStoreVersion,
+		NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
+		ResetWorkflow:           attr.ResetWorkflow,
+	}
+	apply := func() error {
+		applyCtx, cancel := ctx.WithTimeout(ctx.Background(), replicationTaskApplyTimeout)
+		defer cancel()
+		return p.historyEngine.ReplicateEvents(applyCtx, request)
+	}
+
+	return p.applyWithRereplication(apply, attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId(), attr.GetFirstEventId())
+}
+
+// applyWithRereplication applies the task, and if the workflow is missing events before the task,
+// fetches the missing history from the source cluster and applies the task again
+func (p *replicationTaskProcessor) applyWithRereplication(
+	apply func() error,
+	domainID string,
+	workflowID string,
+	endRunID string,
+	endEventID int64,
+) error {
+
+	err := apply()
+	retryErr, ok := err.(*shared.RetryTaskError)
+	if !ok || retryErr.GetRunId() == "" {
+		return err
+	}
+
+	if resendErr := p.historyRereplicator.SendMultiWorkflowHistory(
+		domainID, workflowID,
+		retryErr.GetRunId(), retryErr.GetNextEventId(),
+		endRunID, endEventID,
+	); resendErr != nil {
+		p.logger.Error("error resend history", tag.Error(resendErr))
+		// should return the replication error, not the resending error
+		return err
+	}
+	return apply()
+}
+
+func isTransientReplicationError(err error) bool {
+	switch err.(type) {
+	case *shared.BadRequestError, *shared.EntityNotExistsError:
+		return false
+	default:
+		return err != errUnknownReplicationTask
+	}
+}
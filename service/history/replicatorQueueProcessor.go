@@ -21,7 +21,10 @@
 package history
 
 import (
+	"context"
 	"errors"
+	"math"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/replicator"
@@ -52,6 +55,9 @@ type (
 		queueAckMgr
 
 		lastShardSyncTimestamp time.Time
+
+		cleanupLock          sync.Mutex
+		lastCleanupTimestamp time.Time
 	}
 )
 
@@ -62,7 +68,7 @@ var (
 )
 
 func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache, replicator messaging.Producer,
-	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger log.Logger) queueProcessor {
+	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger log.Logger) replicatorQueueProcessor {
 
 	currentClusterNamer := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -120,20 +126,21 @@ func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo, shouldProces
 	// replication queue should always process all tasks
 	// so should not do anything to shouldProcessTask variable
 
+	ctx := context.Background()
 	switch task.TaskType {
 	case persistence.ReplicationTaskTypeSyncActivity:
-		err := p.processSyncActivityTask(task)
+		err := p.processSyncActivityTask(ctx, task)
 		if err == nil {
-			err = p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
+			err = p.executionMgr.CompleteReplicationTask(ctx, &persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
 		}
 		return metrics.ReplicatorTaskSyncActivityScope, err
 	case persistence.ReplicationTaskTypeHistory:
-		err := p.processHistoryReplicationTask(task)
+		err := p.processHistoryReplicationTask(ctx, task)
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
 			err = errHistoryNotFoundTask
 		}
 		if err == nil {
-			err = p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
+			err = p.executionMgr.CompleteReplicationTask(ctx, &persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
 		}
 		return metrics.ReplicatorTaskHistoryScope, err
 	default:
@@ -146,33 +153,42 @@ func (p *replicatorQueueProcessorImpl) queueShutdown() error {
 	return nil
 }
 
-func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) (retError error) {
+func (p *replicatorQueueProcessorImpl) processSyncActivityTask(ctx context.Context, task *persistence.ReplicationTaskInfo) error {
+	replicationTask, err := p.generateSyncActivityTask(ctx, task)
+	if err != nil || replicationTask == nil {
+		return err
+	}
+
+	return p.replicator.Publish(replicationTask)
+}
+
+func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(ctx context.Context, task *persistence.ReplicationTaskInfo) (_ *replicator.ReplicationTask, retError error) {
 	domainID := task.DomainID
 	execution := shared.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
 		RunId:      common.StringPtr(task.RunID),
 	}
-	context, release, err := p.historyCache.getOrCreateWorkflowExecutionForBackground(domainID, execution)
+	context, release, err := p.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 	if !msBuilder.IsWorkflowExecutionRunning() {
 		// workflow already finished, no need to process the timer
-		return nil
+		return nil, nil
 	}
 
 	activityInfo, ok := msBuilder.GetActivityInfo(task.ScheduledID)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 
 	var startedTime *int64
@@ -184,8 +200,19 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 	// LastHeartBeatUpdatedTime must be valid when getting the sync activity replication task
 	heartbeatTime = common.Int64Ptr(activityInfo.LastHeartBeatUpdatedTime.UnixNano())
 
-	replicationTask := &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+	// the task is created by the latest change of the activity
+	creationTime := activityInfo.ScheduledTime
+	if activityInfo.StartedTime.After(creationTime) {
+		creationTime = activityInfo.StartedTime
+	}
+	if activityInfo.LastHeartBeatUpdatedTime.After(creationTime) {
+		creationTime = activityInfo.LastHeartBeatUpdatedTime
+	}
+
+	return &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SourceTaskId: common.Int64Ptr(task.GetTaskID()),
+		CreationTime: common.Int64Ptr(creationTime.UnixNano()),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(task.DomainID),
 			WorkflowId:        common.StringPtr(task.WorkflowID),
@@ -199,25 +226,15 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 			Details:           activityInfo.Details,
 			Attempt:           common.Int32Ptr(activityInfo.Attempt),
 		},
-	}
-
-	return p.replicator.Publish(replicationTask)
+	}, nil
 }
 
-func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persistence.ReplicationTaskInfo) error {
-
-	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
-	if err != nil {
-		return err
-	}
-	targetClusters := []string{}
-	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
-		targetClusters = append(targetClusters, cluster.ClusterName)
-	}
-	replicationTask, err := GenerateReplicationTask(targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil, common.IntPtr(p.shard.GetShardID()))
+func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(ctx context.Context, task *persistence.ReplicationTaskInfo) error {
+	replicationTask, err := p.generateHistoryReplicationTask(ctx, task)
 	if err != nil || replicationTask == nil {
 		return err
 	}
+	targetClusters := replicationTask.HistoryTaskAttributes.TargetClusters
 
 	err = p.replicator.Publish(replicationTask)
 	if err == messaging.ErrMessageSizeLimit {
@@ -229,9 +246,32 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 	return err
 }
 
+func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(ctx context.Context, task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return nil, err
+	}
+	targetClusters := []string{}
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		targetClusters = append(targetClusters, cluster.ClusterName)
+	}
+	replicationTask, err := GenerateReplicationTask(ctx, targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil, common.IntPtr(p.shard.GetShardID()))
+	if err != nil || replicationTask == nil {
+		return nil, err
+	}
+	// the source task ID is the key of the task in the replication DLQ of the receiving cluster
+	replicationTask.SourceTaskId = common.Int64Ptr(task.GetTaskID())
+	// the task is created along with the last event it replicates
+	if events := replicationTask.HistoryTaskAttributes.History.GetEvents(); len(events) > 0 {
+		replicationTask.CreationTime = events[len(events)-1].Timestamp
+	}
+	return replicationTask, nil
+}
+
 func (p *replicatorQueueProcessorImpl) generateHistoryMetadataTask(targetClusters []string, task *persistence.ReplicationTaskInfo) *replicator.ReplicationTask {
 	return &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskTypeHistoryMetadata.Ptr(),
+		TaskType:     replicator.ReplicationTaskTypeHistoryMetadata.Ptr(),
+		SourceTaskId: common.Int64Ptr(task.GetTaskID()),
 		HistoryMetadataTaskAttributes: &replicator.HistoryMetadataTaskAttributes{
 			TargetClusters: targetClusters,
 			DomainId:       common.StringPtr(task.DomainID),
@@ -244,13 +284,13 @@ func (p *replicatorQueueProcessorImpl) generateHistoryMetadataTask(targetCluster
 }
 
 // GenerateReplicationTask generate replication task
-func GenerateReplicationTask(targetClusters []string, task *persistence.ReplicationTaskInfo,
+func GenerateReplicationTask(ctx context.Context, targetClusters []string, task *persistence.ReplicationTaskInfo,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger log.Logger, history *shared.History, shardID *int,
 ) (*replicator.ReplicationTask, error) {
 	var err error
 	if history == nil {
-		history, _, err = GetAllHistory(historyMgr, historyV2Mgr, metricsClient, logger, false,
+		history, _, err = GetAllHistory(ctx, historyMgr, historyV2Mgr, metricsClient, logger, false,
 			task.DomainID, task.WorkflowID, task.RunID, task.FirstEventID, task.NextEventID, task.EventStoreVersion, task.BranchToken, shardID)
 		if err != nil {
 			return nil, err
@@ -269,7 +309,7 @@ func GenerateReplicationTask(targetClusters []string, task *persistence.Replicat
 		if lastEvent.GetEventType() == shared.EventTypeWorkflowExecutionContinuedAsNew {
 			// Check if this is replication task for ContinueAsNew event, then retrieve the history for new execution
 			newRunID := lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
-			newRunHistory, _, err = GetAllHistory(historyMgr, historyV2Mgr, metricsClient, logger, false,
+			newRunHistory, _, err = GetAllHistory(ctx, historyMgr, historyV2Mgr, metricsClient, logger, false,
 				task.DomainID, task.WorkflowID, newRunID, common.FirstEventID, int64(3), task.NewRunEventStoreVersion, task.NewRunBranchToken, shardID)
 			if err != nil {
 				return nil, err
@@ -299,7 +339,7 @@ func GenerateReplicationTask(targetClusters []string, task *persistence.Replicat
 }
 
 func (p *replicatorQueueProcessorImpl) readTasks(readLevel int64) ([]queueTaskInfo, bool, error) {
-	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+	response, err := p.executionMgr.GetReplicationTasks(context.Background(), &persistence.GetReplicationTasksRequest{
 		ReadLevel:    readLevel,
 		MaxReadLevel: p.shard.GetTransferMaxReadLevel(),
 		BatchSize:    p.options.BatchSize(),
@@ -340,8 +380,204 @@ func (p *replicatorQueueProcessorImpl) updateAckLevel(ackLevel int64) error {
 	return err
 }
 
+// getTasks returns the replication tasks after the given read level to a remote cluster pulling from this shard,
+// the read level of common.EmptyMessageID means resuming from the level last acked by the polling cluster
+func (p *replicatorQueueProcessorImpl) getTasks(ctx context.Context, pollingCluster string, lastReadTaskID int64) (*replicator.ReplicationMessages, error) {
+	ackLevel := p.shard.GetClusterReplicationLevel(pollingCluster)
+	p.recordTaskIDLag(p.metricsClient.Scope(metrics.ReplicatorQueueProcessorScope, metrics.TargetClusterTag(pollingCluster)), ackLevel)
+	if lastReadTaskID == common.EmptyMessageID {
+		lastReadTaskID = ackLevel
+	}
+
+	// the shard status is captured before reading the tasks, so the polling cluster
+	// will never move its view of this shard ahead of tasks it has not received
+	now := p.shard.GetTimeSource().Now()
+	response, err := p.executionMgr.GetReplicationTasks(ctx, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    lastReadTaskID,
+		MaxReadLevel: p.shard.GetTransferMaxReadLevel(),
+		BatchSize:    p.options.BatchSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	readLevel := lastReadTaskID
+	replicationTasks := []*replicator.ReplicationTask{}
+	for _, task := range response.Tasks {
+		replicationTask, err := p.toReplicationTask(ctx, task)
+		if err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); !ok {
+				return nil, err
+			}
+			// workflow or history is already deleted, nothing to replicate
+			replicationTask = nil
+		}
+		if replicationTask != nil {
+			replicationTasks = append(replicationTasks, replicationTask)
+		}
+		readLevel = task.GetTaskID()
+	}
+
+	hasMore := len(response.NextPageToken) != 0
+	messages := &replicator.ReplicationMessages{
+		ReplicationTasks:       replicationTasks,
+		LastRetrievedMessageId: common.Int64Ptr(readLevel),
+		HasMore:                common.BoolPtr(hasMore),
+	}
+	if !hasMore {
+		messages.SyncShardStatus = &replicator.SyncShardStatus{
+			Timestamp: common.Int64Ptr(now.UnixNano()),
+		}
+	}
+	return messages, nil
+}
+
+// ackTasks records the last replication task applied by the polling cluster,
+// and purges the tasks which are acked by all remote clusters
+func (p *replicatorQueueProcessorImpl) ackTasks(ctx context.Context, pollingCluster string, lastProcessedTaskID int64) error {
+	if lastProcessedTaskID <= p.shard.GetClusterReplicationLevel(pollingCluster) {
+		return nil
+	}
+	if err := p.shard.UpdateClusterReplicationLevel(pollingCluster, lastProcessedTaskID); err != nil {
+		return err
+	}
+	return p.purgeAckedTasks(ctx)
+}
+
+func (p *replicatorQueueProcessorImpl) purgeAckedTasks(ctx context.Context) error {
+	p.cleanupLock.Lock()
+	defer p.cleanupLock.Unlock()
+
+	now := clock.NewRealTimeSource().Now()
+	if p.lastCleanupTimestamp.Add(p.options.UpdateAckInterval()).After(now) {
+		return nil
+	}
+	p.lastCleanupTimestamp = now
+
+	minAckLevel := int64(math.MaxInt64)
+	for clusterName, info := range p.shard.GetService().GetClusterMetadata().GetAllClusterInfo() {
+		if !info.Enabled || clusterName == p.currentClusterNamer {
+			continue
+		}
+		if ackLevel := p.shard.GetClusterReplicationLevel(clusterName); ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+	ackLevel := p.shard.GetReplicatorAckLevel()
+	if minAckLevel == math.MaxInt64 || minAckLevel <= ackLevel {
+		return nil
+	}
+
+	for readLevel := ackLevel; readLevel < minAckLevel; {
+		response, err := p.executionMgr.GetReplicationTasks(ctx, &persistence.GetReplicationTasksRequest{
+			ReadLevel:    readLevel,
+			MaxReadLevel: minAckLevel,
+			BatchSize:    p.options.BatchSize(),
+		})
+		if err != nil {
+			return err
+		}
+		for _, task := range response.Tasks {
+			if err := p.executionMgr.CompleteReplicationTask(ctx, &persistence.CompleteReplicationTaskRequest{
+				TaskID: task.GetTaskID(),
+			}); err != nil {
+				return err
+			}
+			readLevel = task.GetTaskID()
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+	}
+	return p.shard.UpdateReplicatorAckLevel(minAckLevel)
+}
+
+// getAckLevel returns the last replication task ID replicated to the target cluster. When the tasks are
+// published to kafka, the level applied by the target cluster is not known to this cluster, the level
+// published to kafka says nothing about the backlog of the target cluster, so errReplicationStatusUnknown
+// is returned instead
+func (p *replicatorQueueProcessorImpl) getAckLevel(targetCluster string) (int64, error) {
+	if p.replicator != nil {
+		return 0, errReplicationStatusUnknown
+	}
+	return p.shard.GetClusterReplicationLevel(targetCluster), nil
+}
+
+// recordTaskIDLag records the distance between the replication ack level and the max task ID of the shard,
+// it is recorded as a histogram so the lag of all shards on the host is kept as a distribution
+func (p *replicatorQueueProcessorImpl) recordTaskIDLag(scope metrics.Scope, ackLevel int64) {
+	lag := p.shard.GetTransferMaxReadLevel() - ackLevel
+	if lag < 0 {
+		lag = 0
+	}
+	scope.RecordHistogramValue(metrics.ReplicationTaskIDLag, float64(lag))
+}
+
+// getReplicationStatus returns the replication backlog of the shard towards the target cluster, along with
+// the number of pending replication tasks by domain ID, at most scanLimit pending tasks are counted
+func (p *replicatorQueueProcessorImpl) getReplicationStatus(
+	ctx context.Context,
+	targetCluster string,
+	scanLimit int,
+) (*replicator.ShardReplicationStatus, map[string]int64, error) {
+
+	ackLevel, err := p.getAckLevel(targetCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	maxReadLevel := p.shard.GetTransferMaxReadLevel()
+	pendingTasksByDomainID := make(map[string]int64)
+	pendingTasks := 0
+	truncated := false
+
+	var nextPageToken []byte
+scanLoop:
+	for {
+		response, err := p.executionMgr.GetReplicationTasks(ctx, &persistence.GetReplicationTasksRequest{
+			ReadLevel:     ackLevel,
+			MaxReadLevel:  maxReadLevel,
+			BatchSize:     p.options.BatchSize(),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, task := range response.Tasks {
+			if pendingTasks >= scanLimit {
+				truncated = true
+				break scanLoop
+			}
+			pendingTasksByDomainID[task.DomainID]++
+			pendingTasks++
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = response.NextPageToken
+	}
+
+	return &replicator.ShardReplicationStatus{
+		ShardID:      common.Int32Ptr(int32(p.shard.GetShardID())),
+		AckLevel:     common.Int64Ptr(ackLevel),
+		MaxTaskID:    common.Int64Ptr(maxReadLevel),
+		PendingTasks: common.Int64Ptr(int64(pendingTasks)),
+		Truncated:    common.BoolPtr(truncated),
+	}, pendingTasksByDomainID, nil
+}
+
+func (p *replicatorQueueProcessorImpl) toReplicationTask(ctx context.Context, task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+	switch task.TaskType {
+	case persistence.ReplicationTaskTypeSyncActivity:
+		return p.generateSyncActivityTask(ctx, task)
+	case persistence.ReplicationTaskTypeHistory:
+		return p.generateHistoryReplicationTask(ctx, task)
+	default:
+		return nil, errUnknownReplicationTask
+	}
+}
+
 // GetAllHistory return history
-func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+func GetAllHistory(ctx context.Context, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger log.Logger, byBatch bool,
 	domainID string, workflowID string, runID string, firstEventID int64,
 	nextEventID int64, eventStoreVersion int32, branchToken []byte, shardID *int) (*shared.History, []*shared.History, error) {
@@ -360,6 +596,7 @@ func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persisten
 
 	for hasMore := true; hasMore; hasMore = len(pageToken) > 0 {
 		pageHistoryEvents, pageHistoryBatches, pageToken, pageHistorySize, err = PaginateHistory(
+			ctx,
 			historyMgr, historyV2Mgr, metricsClient, logger, byBatch,
 			domainID, workflowID, runID, firstEventID, nextEventID, pageToken,
 			eventStoreVersion, branchToken, defaultHistoryPageSize, shardID,
@@ -392,7 +629,7 @@ func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persisten
 }
 
 // PaginateHistory return paged history
-func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+func PaginateHistory(ctx context.Context, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger log.Logger, byBatch bool,
 	domainID, workflowID, runID string, firstEventID,
 	nextEventID int64, tokenIn []byte, eventStoreVersion int32, branchToken []byte, pageSize int, shardID *int) ([]*shared.HistoryEvent, []*shared.History, []byte, int, error) {
@@ -412,7 +649,7 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 			ShardID:       shardID,
 		}
 		if byBatch {
-			response, err := historyV2Mgr.ReadHistoryBranchByBatch(req)
+			response, err := historyV2Mgr.ReadHistoryBranchByBatch(ctx, req)
 			if err != nil {
 				return nil, nil, nil, 0, err
 			}
@@ -423,7 +660,7 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 			tokenOut = response.NextPageToken
 
 		} else {
-			response, err := historyV2Mgr.ReadHistoryBranch(req)
+			response, err := historyV2Mgr.ReadHistoryBranch(ctx, req)
 			if err != nil {
 				return nil, nil, nil, 0, err
 			}
@@ -447,7 +684,7 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 		}
 
 		if byBatch {
-			response, err := historyMgr.GetWorkflowExecutionHistoryByBatch(req)
+			response, err := historyMgr.GetWorkflowExecutionHistoryByBatch(ctx, req)
 			if err != nil {
 				return nil, nil, nil, 0, err
 			}
@@ -458,7 +695,7 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 			tokenOut = response.NextPageToken
 
 		} else {
-			response, err := historyMgr.GetWorkflowExecutionHistory(req)
+			response, err := historyMgr.GetWorkflowExecutionHistory(ctx, req)
 			if err != nil {
 				return nil, nil, nil, 0, err
 			}
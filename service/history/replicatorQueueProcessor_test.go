@@ -21,10 +21,12 @@
 package history
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/replicator"
@@ -32,6 +34,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -92,7 +95,7 @@ func (s *replicatorQueueProcessorSuite) SetupTest() {
 	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, metricsClient, s.mockClientBean)
 	s.mockShard = &shardContextImpl{
 		service:                   s.mockService,
-		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0},
+		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0, ClusterReplicationLevel: map[string]int64{}},
 		transferSequenceNumber:    1,
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   make(chan int, 100),
@@ -103,6 +106,8 @@ func (s *replicatorQueueProcessorSuite) SetupTest() {
 		executionManager:          s.mockExecutionMgr,
 		standbyClusterCurrentTime: make(map[string]time.Time),
 		timeSource:                clock.NewRealTimeSource(),
+		payloadOffloader:          payload.NewOffloader(nil, nil),
+		lastUpdated:               time.Now(),
 	}
 	historyCache := newHistoryCache(s.mockShard)
 	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
@@ -135,8 +140,8 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_WorkflowMissing() {
 		RunID:       runID,
 		ScheduledID: scheduleID,
 	}
-	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
 		DomainID: domainID,
 		Execution: shared.WorkflowExecution{
 			WorkflowId: common.StringPtr(workflowID),
@@ -164,7 +169,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_WorkflowCompleted() {
 		RunID:       runID,
 		ScheduledID: scheduleID,
 	}
-	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
 
 	context, release, _ := s.replicatorQueueProcessor.historyCache.getOrCreateWorkflowExecutionForBackground(
 		domainID,
@@ -205,7 +210,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityCompleted() {
 		RunID:       runID,
 		ScheduledID: scheduleID,
 	}
-	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
 
 	context, release, _ := s.replicatorQueueProcessor.historyCache.getOrCreateWorkflowExecutionForBackground(
 		domainID,
@@ -228,7 +233,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityCompleted() {
 	msBuilder.On("GetLastWriteVersion").Return(version)
 	msBuilder.On("UpdateReplicationStateVersion", version, false).Once()
 	msBuilder.On("GetActivityInfo", scheduleID).Return(nil, false)
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{ID: domainID}).Return(
 		&persistence.GetDomainResponse{
 			Info:   &persistence.DomainInfo{ID: domainID, Name: domainName},
 			Config: &persistence.DomainConfig{Retention: 1},
@@ -265,7 +270,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRetry() {
 		RunID:       runID,
 		ScheduledID: scheduleID,
 	}
-	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
 
 	context, release, _ := s.replicatorQueueProcessor.historyCache.getOrCreateWorkflowExecutionForBackground(
 		domainID,
@@ -306,7 +311,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRetry() {
 		Details:                  activityDetails,
 		Attempt:                  activityAttempt,
 	}, true)
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{ID: domainID}).Return(
 		&persistence.GetDomainResponse{
 			Info:   &persistence.DomainInfo{ID: domainID, Name: domainName},
 			Config: &persistence.DomainConfig{Retention: 1},
@@ -322,7 +327,9 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRetry() {
 		}, nil,
 	).Once()
 	s.mockProducer.On("Publish", &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SourceTaskId: common.Int64Ptr(taskID),
+		CreationTime: common.Int64Ptr(activityScheduledTime.UnixNano()),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(domainID),
 			WorkflowId:        common.StringPtr(workflowID),
@@ -359,7 +366,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 		RunID:       runID,
 		ScheduledID: scheduleID,
 	}
-	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: taskID}).Return(nil).Once()
 
 	context, release, _ := s.replicatorQueueProcessor.historyCache.getOrCreateWorkflowExecutionForBackground(
 		domainID,
@@ -400,7 +407,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 		Details:                  activityDetails,
 		Attempt:                  activityAttempt,
 	}, true)
-	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{ID: domainID}).Return(
 		&persistence.GetDomainResponse{
 			Info:   &persistence.DomainInfo{ID: domainID, Name: domainName},
 			Config: &persistence.DomainConfig{Retention: 1},
@@ -416,7 +423,9 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 		}, nil,
 	).Once()
 	s.mockProducer.On("Publish", &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SourceTaskId: common.Int64Ptr(taskID),
+		CreationTime: common.Int64Ptr(activityHeartbeatTime.UnixNano()),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(domainID),
 			WorkflowId:        common.StringPtr(workflowID),
@@ -436,6 +445,157 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 	s.Nil(err)
 }
 
+func (s *replicatorQueueProcessorSuite) TestGetTasks_ResumeFromClusterReplicationLevel() {
+	domainID := validDomainID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	ackLevel := int64(1000)
+	taskID := int64(1444)
+	s.mockShard.UpdateClusterReplicationLevel(cluster.TestAlternativeClusterName, ackLevel)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.mockShard.GetConfig().ReplicatorTaskBatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+				TaskID:      taskID,
+				DomainID:    domainID,
+				WorkflowID:  workflowID,
+				RunID:       runID,
+				ScheduledID: 144,
+			},
+		},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
+		DomainID: domainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	}).Return(nil, &shared.EntityNotExistsError{})
+
+	messages, err := s.replicatorQueueProcessor.getTasks(context.Background(), cluster.TestAlternativeClusterName, common.EmptyMessageID)
+	s.NoError(err)
+	s.Empty(messages.ReplicationTasks)
+	s.Equal(taskID, messages.GetLastRetrievedMessageId())
+	s.False(messages.GetHasMore())
+	s.NotNil(messages.SyncShardStatus)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_HasMore() {
+	readLevel := int64(1000)
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    readLevel,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.mockShard.GetConfig().ReplicatorTaskBatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		NextPageToken: []byte("some random page token"),
+	}, nil).Once()
+
+	messages, err := s.replicatorQueueProcessor.getTasks(context.Background(), cluster.TestAlternativeClusterName, readLevel)
+	s.NoError(err)
+	s.Equal(readLevel, messages.GetLastRetrievedMessageId())
+	s.True(messages.GetHasMore())
+	s.Nil(messages.SyncShardStatus)
+}
+
+func (s *replicatorQueueProcessorSuite) TestAckTasks_PurgeTasksAckedByAllClusters() {
+	ackLevel := int64(5)
+	lastProcessedTaskID := int64(20)
+	s.mockShard.UpdateReplicatorAckLevel(ackLevel)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: lastProcessedTaskID,
+		BatchSize:    s.mockShard.GetConfig().ReplicatorTaskBatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 10}, {TaskID: 20}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: 10}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", mock.Anything, &persistence.CompleteReplicationTaskRequest{TaskID: 20}).Return(nil).Once()
+
+	err := s.replicatorQueueProcessor.ackTasks(context.Background(), cluster.TestAlternativeClusterName, lastProcessedTaskID)
+	s.NoError(err)
+	s.Equal(lastProcessedTaskID, s.mockShard.GetClusterReplicationLevel(cluster.TestAlternativeClusterName))
+	s.Equal(lastProcessedTaskID, s.mockShard.GetReplicatorAckLevel())
+}
+
+func (s *replicatorQueueProcessorSuite) TestAckTasks_StaleAck() {
+	s.mockShard.UpdateClusterReplicationLevel(cluster.TestAlternativeClusterName, 100)
+
+	err := s.replicatorQueueProcessor.ackTasks(context.Background(), cluster.TestAlternativeClusterName, 50)
+	s.NoError(err)
+	s.Equal(int64(100), s.mockShard.GetClusterReplicationLevel(cluster.TestAlternativeClusterName))
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetReplicationStatus_CountByDomain() {
+	ackLevel := int64(1000)
+	// the tasks are pulled, so the ack level is the level acked by the target cluster
+	s.replicatorQueueProcessor.replicator = nil
+	s.mockShard.UpdateClusterReplicationLevel(cluster.TestAlternativeClusterName, ackLevel)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.mockShard.GetConfig().ReplicatorTaskBatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks:         []*persistence.ReplicationTaskInfo{{TaskID: 1001, DomainID: "domain 1"}, {TaskID: 1002, DomainID: "domain 2"}},
+		NextPageToken: []byte("some random page token"),
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:     ackLevel,
+		MaxReadLevel:  s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:     s.mockShard.GetConfig().ReplicatorTaskBatchSize(),
+		NextPageToken: []byte("some random page token"),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 1003, DomainID: "domain 1"}},
+	}, nil).Once()
+
+	status, pendingTasksByDomainID, err := s.replicatorQueueProcessor.getReplicationStatus(context.Background(), cluster.TestAlternativeClusterName, 100)
+	s.NoError(err)
+	s.Equal(ackLevel, status.GetAckLevel())
+	s.Equal(s.mockShard.GetTransferMaxReadLevel(), status.GetMaxTaskID())
+	s.Equal(int64(3), status.GetPendingTasks())
+	s.False(status.GetTruncated())
+	s.Equal(map[string]int64{"domain 1": 2, "domain 2": 1}, pendingTasksByDomainID)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetReplicationStatus_Truncated() {
+	ackLevel := int64(5)
+	s.replicatorQueueProcessor.replicator = nil
+	s.mockShard.UpdateClusterReplicationLevel(cluster.TestAlternativeClusterName, ackLevel)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.mockShard.GetConfig().ReplicatorTaskBatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks:         []*persistence.ReplicationTaskInfo{{TaskID: 10, DomainID: "domain 1"}, {TaskID: 20, DomainID: "domain 1"}},
+		NextPageToken: []byte("some random page token"),
+	}, nil).Once()
+
+	status, pendingTasksByDomainID, err := s.replicatorQueueProcessor.getReplicationStatus(context.Background(), cluster.TestAlternativeClusterName, 1)
+	s.NoError(err)
+	s.Equal(ackLevel, status.GetAckLevel())
+	s.Equal(int64(1), status.GetPendingTasks())
+	s.True(status.GetTruncated())
+	s.Equal(map[string]int64{"domain 1": 1}, pendingTasksByDomainID)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetReplicationStatus_PublishedToKafka() {
+	// the tasks are published to kafka, the level applied by the target cluster is unknown
+	s.mockShard.UpdateReplicatorAckLevel(int64(5))
+
+	status, pendingTasksByDomainID, err := s.replicatorQueueProcessor.getReplicationStatus(context.Background(), cluster.TestAlternativeClusterName, 100)
+	s.Equal(errReplicationStatusUnknown, err)
+	s.Nil(status)
+	s.Nil(pendingTasksByDomainID)
+}
+
 func (s *replicatorQueueProcessorSuite) TestPaginateHistoryWithShardID() {
 	domainID := validDomainID
 	workflowID := "some random workflow ID"
@@ -453,7 +613,7 @@ func (s *replicatorQueueProcessorSuite) TestPaginateHistoryWithShardID() {
 		NextPageToken: []byte{},
 		ShardID:       shardID,
 	}
-	s.mockHistoryV2Mgr.On("ReadHistoryBranch", req).Return(&persistence.ReadHistoryBranchResponse{
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, req).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents: []*workflow.HistoryEvent{
 			{
 				EventId: common.Int64Ptr(int64(1)),
@@ -463,7 +623,7 @@ func (s *replicatorQueueProcessorSuite) TestPaginateHistoryWithShardID() {
 		Size:             1,
 		LastFirstEventID: nextEventID,
 	}, nil).Once()
-	hEvents, bEvents, token, size, err := PaginateHistory(s.mockHistoryMgr, s.mockHistoryV2Mgr, nil, nil,
+	hEvents, bEvents, token, size, err := PaginateHistory(context.Background(), s.mockHistoryMgr, s.mockHistoryV2Mgr, nil, nil,
 		false, domainID, workflowID, runID, firstEventID, nextEventID, []byte{},
 		persistence.EventStoreVersionV2, []byte{}, pageSize, shardID)
 	s.NotNil(hEvents)
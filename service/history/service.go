@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -42,6 +44,7 @@ type Config struct {
 	RPS                             dynamicconfig.IntPropertyFn
 	MaxIDLengthLimit                dynamicconfig.IntPropertyFn
 	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
+	PersistenceFaultInjection       *config.FaultInjectionConfig
 	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
 	VisibilityOpenMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
@@ -112,6 +115,12 @@ type Config struct {
 	ReplicatorProcessorUpdateAckInterval                  dynamicconfig.DurationPropertyFn
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 
+	// Pull based replication settings
+	EnableReplicationPull                 dynamicconfig.BoolPropertyFn
+	ReplicationTaskFetcherPollInterval    dynamicconfig.DurationPropertyFn
+	ReplicationTaskProcessorMaxRetryCount dynamicconfig.IntPropertyFn
+	ReplicationStatusScanLimit            dynamicconfig.IntPropertyFn
+
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
 	HistoryMgrNumConns   dynamicconfig.IntPropertyFn
@@ -144,7 +153,18 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// payload offloading settings
+	EnablePayloadOffload           dynamicconfig.BoolPropertyFnWithDomainFilter
+	PayloadOffloadThreshold        dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadBucket           dynamicconfig.StringPropertyFn
+	OffloadedPayloadSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
+
+	// mutable state checksum settings
+	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithDomainFilter
+	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithDomainFilter
+	MutableStateChecksumVerifyMode        dynamicconfig.StringPropertyFnWithDomainFilter
 }
 
 const (
@@ -158,6 +178,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		RPS:                                                   dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
 		MaxIDLengthLimit:                                      dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		PersistenceMaxQPS:                                     dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
+		PersistenceFaultInjection:                             config.NewFaultInjectionConfig(dc, dynamicconfig.HistoryPersistenceFaultInjectionEnabled),
 		EnableVisibilitySampling:                              dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:                       dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
 		VisibilityOpenMaxQPS:                                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
@@ -211,6 +232,10 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		ReplicatorProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		ReplicatorProcessorUpdateAckInterval:                  dc.GetDurationProperty(dynamicconfig.ReplicatorProcessorUpdateAckInterval, 5*time.Second),
 		ReplicatorProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorUpdateAckIntervalJitterCoefficient, 0.15),
+		EnableReplicationPull:                                 dc.GetBoolProperty(dynamicconfig.EnableReplicationPull, false),
+		ReplicationTaskFetcherPollInterval:                    dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherPollInterval, 2*time.Second),
+		ReplicationTaskProcessorMaxRetryCount:                 dc.GetIntProperty(dynamicconfig.ReplicationTaskProcessorMaxRetryCount, 10),
+		ReplicationStatusScanLimit:                            dc.GetIntProperty(dynamicconfig.ReplicationStatusScanLimit, 10000),
 		ExecutionMgrNumConns:                                  dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns, 50),
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
@@ -233,12 +258,30 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		EnablePayloadOffload:           dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.HistoryEnablePayloadOffload, false),
+		PayloadOffloadThreshold:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 256*1024),
+		PayloadOffloadBucket:           dc.GetStringProperty(dynamicconfig.PayloadOffloadBucket, ""),
+		OffloadedPayloadSizeLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.OffloadedPayloadSizeLimitError, 16*1024*1024),
+
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 20),
+
+		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability, 0),
+		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
+		MutableStateChecksumVerifyMode:        dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.MutableStateChecksumVerifyMode, mutableStateChecksumVerifyModeLog),
 	}
 
 	return cfg
 }
 
+// GetPayloadOffloadConfig returns the config used to offload payloads to the blobstore
+func (config *Config) GetPayloadOffloadConfig() *payload.Config {
+	return &payload.Config{
+		EnableOffload: config.EnablePayloadOffload,
+		Threshold:     config.PayloadOffloadThreshold,
+		Bucket:        config.PayloadOffloadBucket,
+	}
+}
+
 // GetShardID return the corresponding shard ID for a given workflow ID
 func (config *Config) GetShardID(workflowID string) int {
 	return common.WorkflowIDToHistoryShard(workflowID, config.NumberOfShards)
@@ -283,13 +326,14 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.FaultInjection = s.config.PersistenceFaultInjection
 	pConfig.VisibilityConfig = &config.VisibilityConfig{
 		VisibilityOpenMaxQPS:            s.config.VisibilityOpenMaxQPS,
 		VisibilityClosedMaxQPS:          s.config.VisibilityClosedMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, base.GetTracer(), log)
 
 	shardMgr, err := pFactory.NewShardManager()
 	if err != nil {
@@ -312,8 +356,12 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
-		esVisibility = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
-			s.metricsClient, log)
+		keyProvider, err := persistence.NewKeyProvider(params.PersistenceConfig.Encryption)
+		if err != nil {
+			log.Fatal("Creating encryption key provider failed", tag.Error(err))
+		}
+		esVisibility = espersistence.NewESVisibilityManager(nil, nil, visibilityProducer,
+			s.metricsClient, keyProvider, log)
 	}
 	visibility = persistence.NewVisibilityManagerWrapper(visibility, esVisibility, dynamicconfig.GetBoolPropertyFnFilteredByDomain(false))
 
@@ -327,7 +375,17 @@ func (s *Service) Start() {
 		log.Fatal("Creating historyV2 manager persistence failed", tag.Error(err))
 	}
 
-	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, params.PublicClient)
+	var blobstoreClient blobstore.Client
+	if params.BlobstoreClient != nil {
+		blobstoreClient = blobstore.NewRetryableClient(
+			blobstore.NewMetricClient(params.BlobstoreClient, s.metricsClient),
+			params.BlobstoreClient.GetRetryPolicy(),
+			params.BlobstoreClient.IsRetryableError)
+	}
+	payloadOffloader := payload.NewOffloader(blobstoreClient, s.config.GetPayloadOffloadConfig())
+
+	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, params.PublicClient,
+		payloadOffloader)
 	handler.RegisterHandler()
 
 	// must start base service first
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -29,6 +30,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
@@ -60,6 +62,9 @@ type (
 		UpdateTimerAckLevel(ackLevel time.Time) error
 		GetTimerClusterAckLevel(cluster string) time.Time
 		UpdateTimerClusterAckLevel(cluster string, ackLevel time.Time) error
+
+		GetClusterReplicationLevel(cluster string) int64
+		UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error
 		UpdateTransferFailoverLevel(failoverID string, level persistence.TransferFailoverLevel) error
 		DeleteTransferFailoverLevel(failoverID string) error
 		GetAllTransferFailoverLevels() map[string]persistence.TransferFailoverLevel
@@ -68,19 +73,20 @@ type (
 		GetAllTimerFailoverLevels() map[string]persistence.TimerFailoverLevel
 		GetDomainNotificationVersion() int64
 		UpdateDomainNotificationVersion(domainNotificationVersion int64) error
-		CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+		CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 			*persistence.CreateWorkflowExecutionResponse, error)
-		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
-		ResetMutableState(request *persistence.ResetMutableStateRequest) error
-		ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error
-		AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error)
-		AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error)
+		UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
+		ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error
+		ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error
+		AppendHistoryEvents(ctx context.Context, request *persistence.AppendHistoryEventsRequest) (int, error)
+		AppendHistoryV2Events(ctx context.Context, request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error)
 		NotifyNewHistoryEvent(event *historyEventNotification) error
 		GetConfig() *Config
 		GetEventsCache() eventsCache
 		GetLogger() log.Logger
 		GetThrottledLogger() log.Logger
 		GetMetricsClient() metrics.Client
+		GetPayloadOffloader() payload.Offloader
 		GetTimeSource() clock.TimeSource
 		SetCurrentTime(cluster string, currentTime time.Time)
 		GetCurrentTime(cluster string) time.Time
@@ -107,6 +113,7 @@ type (
 		throttledLogger  log.Logger
 		metricsClient    metrics.Client
 		timeSource       clock.TimeSource
+		payloadOffloader payload.Offloader
 
 		sync.RWMutex
 		lastUpdated               time.Time
@@ -272,6 +279,27 @@ func (s *shardContextImpl) UpdateTimerClusterAckLevel(cluster string, ackLevel t
 	return s.updateShardInfoLocked()
 }
 
+func (s *shardContextImpl) GetClusterReplicationLevel(cluster string) int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	// if we can find corresponding replication level
+	if replicationLevel, ok := s.shardInfo.ClusterReplicationLevel[cluster]; ok {
+		return replicationLevel
+	}
+	// new cluster always starts from the beginning of the replication queue
+	return common.EmptyMessageID
+}
+
+func (s *shardContextImpl) UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.ClusterReplicationLevel[cluster] = lastTaskID
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
 func (s *shardContextImpl) UpdateTransferFailoverLevel(failoverID string, level persistence.TransferFailoverLevel) error {
 	s.Lock()
 	defer s.Unlock()
@@ -367,7 +395,7 @@ func (s *shardContextImpl) UpdateTimerMaxReadLevel(cluster string) time.Time {
 	return s.timerMaxReadLevelMap[cluster]
 }
 
-func (s *shardContextImpl) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+func (s *shardContextImpl) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
 
 	// do not try to get domain cache within shard lock
@@ -415,7 +443,7 @@ Create_Loop:
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
 
-		response, err := s.executionManager.CreateWorkflowExecution(request)
+		response, err := s.executionManager.CreateWorkflowExecution(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *shared.WorkflowExecutionAlreadyStartedError,
@@ -462,7 +490,22 @@ func (s *shardContextImpl) getDefaultEncoding(domainEntry *cache.DomainCacheEntr
 	return common.EncodingType(s.config.EventEncodingType(domainEntry.GetInfo().Name))
 }
 
-func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+// offloadEvents moves large payloads of the events to the blobstore. Events of global domains
+// are kept inline since they are replicated, and served by the raw history APIs, as stored and
+// the remote clusters may not have access to this cluster's blobstore
+func (s *shardContextImpl) offloadEvents(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	runID string,
+	events []*shared.HistoryEvent,
+) error {
+	if domainEntry.IsGlobalDomain() {
+		return nil
+	}
+	return s.payloadOffloader.OffloadEvents(ctx, domainEntry.GetInfo().ID, domainEntry.GetInfo().Name, runID, events)
+}
+
+func (s *shardContextImpl) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
 
 	// do not try to get domain cache within shard lock
 	domainEntry, err := s.domainCache.GetDomainByID(request.ExecutionInfo.DomainID)
@@ -535,7 +578,7 @@ Update_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		resp, err := s.executionManager.UpdateWorkflowExecution(request)
+		resp, err := s.executionManager.UpdateWorkflowExecution(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError,
@@ -589,7 +632,7 @@ func (s *shardContextImpl) allocateTransferIDsLocked(tasks []persistence.Task, t
 	return nil
 }
 
-func (s *shardContextImpl) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+func (s *shardContextImpl) ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error {
 	// do not try to get domain cache within shard lock
 	domainEntry, err := s.domainCache.GetDomainByID(request.CurrExecutionInfo.DomainID)
 	if err != nil {
@@ -635,7 +678,7 @@ Reset_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		err := s.executionManager.ResetWorkflowExecution(request)
+		err := s.executionManager.ResetWorkflowExecution(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError,
@@ -677,7 +720,7 @@ Reset_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (s *shardContextImpl) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
+func (s *shardContextImpl) ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
 	// do not try to get domain cache within shard lock
 	domainEntry, err := s.domainCache.GetDomainByID(request.ExecutionInfo.DomainID)
 	if err != nil {
@@ -692,7 +735,7 @@ Reset_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		err := s.executionManager.ResetMutableState(request)
+		err := s.executionManager.ResetMutableState(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError,
@@ -734,6 +777,7 @@ Reset_Loop:
 }
 
 func (s *shardContextImpl) AppendHistoryV2Events(
+	ctx context.Context,
 	request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error) {
 
 	domainEntry, err := s.domainCache.GetDomainByID(domainID)
@@ -742,6 +786,9 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
 	request.ShardID = common.IntPtr(s.shardID)
+	if err := s.offloadEvents(ctx, domainEntry, execution.GetRunId(), request.Events); err != nil {
+		return 0, err
+	}
 	size := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
@@ -758,20 +805,23 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 				tag.WorkflowHistorySizeBytes(size))
 		}
 	}()
-	resp, err0 := s.historyV2Mgr.AppendHistoryNodes(request)
+	resp, err0 := s.historyV2Mgr.AppendHistoryNodes(ctx, request)
 	if resp != nil {
 		size = resp.Size
 	}
 	return size, err0
 }
 
-func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error) {
+func (s *shardContextImpl) AppendHistoryEvents(ctx context.Context, request *persistence.AppendHistoryEventsRequest) (int, error) {
 
 	domainEntry, err := s.domainCache.GetDomainByID(request.DomainID)
 	if err != nil {
 		return 0, err
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
+	if err := s.offloadEvents(ctx, domainEntry, request.Execution.GetRunId(), request.Events); err != nil {
+		return 0, err
+	}
 
 	size := 0
 	defer func() {
@@ -794,7 +844,7 @@ func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistor
 	// No need to lock context here, as we can write concurrently to append history events
 	currentRangeID := atomic.LoadInt64(&s.rangeID)
 	request.RangeID = currentRangeID
-	resp, err0 := s.historyMgr.AppendHistoryEvents(request)
+	resp, err0 := s.historyMgr.AppendHistoryEvents(ctx, request)
 	if resp != nil {
 		size = resp.Size
 	}
@@ -803,7 +853,7 @@ func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistor
 		if _, ok := err0.(*persistence.ConditionFailedError); ok {
 			// Inserting a new event failed, lets try to overwrite the tail
 			request.Overwrite = true
-			resp, err1 := s.historyMgr.AppendHistoryEvents(request)
+			resp, err1 := s.historyMgr.AppendHistoryEvents(ctx, request)
 			if resp != nil {
 				size = resp.Size
 			}
@@ -841,6 +891,10 @@ func (s *shardContextImpl) GetMetricsClient() metrics.Client {
 	return s.metricsClient
 }
 
+func (s *shardContextImpl) GetPayloadOffloader() payload.Offloader {
+	return s.payloadOffloader
+}
+
 func (s *shardContextImpl) getRangeID() int64 {
 	return s.shardInfo.RangeID
 }
@@ -891,7 +945,9 @@ func (s *shardContextImpl) renewRangeLocked(isStealing bool) error {
 		updatedShardInfo.StolenSinceRenew++
 	}
 
-	err := s.shardManager.UpdateShard(&persistence.UpdateShardRequest{
+	// the range is renewed on behalf of the shard, not of the request which may have triggered it,
+	// so the update must not be cut short when that request is canceled
+	err := s.shardManager.UpdateShard(context.Background(), &persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo,
 		PreviousRangeID: s.shardInfo.RangeID})
 	if err != nil {
@@ -940,7 +996,7 @@ func (s *shardContextImpl) updateShardInfoLocked() error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
-	err = s.shardManager.UpdateShard(&persistence.UpdateShardRequest{
+	err = s.shardManager.UpdateShard(context.Background(), &persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo,
 		PreviousRangeID: s.shardInfo.RangeID,
 	})
@@ -1099,7 +1155,7 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 	}
 
 	getShard := func() error {
-		resp, err := shardItem.shardMgr.GetShard(&persistence.GetShardRequest{
+		resp, err := shardItem.shardMgr.GetShard(context.Background(), &persistence.GetShardRequest{
 			ShardID: shardItem.shardID,
 		})
 		if err == nil {
@@ -1116,7 +1172,7 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 			RangeID:          0,
 			TransferAckLevel: 0,
 		}
-		return shardItem.shardMgr.CreateShard(&persistence.CreateShardRequest{ShardInfo: shardInfo})
+		return shardItem.shardMgr.CreateShard(context.Background(), &persistence.CreateShardRequest{ShardInfo: shardInfo})
 	}
 
 	err := backoff.Retry(getShard, retryPolicy, retryPredicate)
@@ -1164,6 +1220,7 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 		metricsClient:             shardItem.metricsClient,
 		config:                    shardItem.config,
 		timeSource:                shardItem.service.GetTimeSource(),
+		payloadOffloader:          shardItem.offloader,
 		standbyClusterCurrentTime: standbyClusterCurrentTime,
 		timerMaxReadLevelMap:      timerMaxReadLevelMap, // use ack to init read level
 	}
@@ -1196,6 +1253,10 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 	for k, v := range shardInfo.ClusterTimerAckLevel {
 		clusterTimerAckLevel[k] = v
 	}
+	clusterReplicationLevel := make(map[string]int64)
+	for k, v := range shardInfo.ClusterReplicationLevel {
+		clusterReplicationLevel[k] = v
+	}
 	shardInfoCopy := &persistence.ShardInfo{
 		ShardID:                   shardInfo.ShardID,
 		Owner:                     shardInfo.Owner,
@@ -1209,6 +1270,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
+		ClusterReplicationLevel:   clusterReplicationLevel,
 	}
 
 	return shardInfoCopy
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -61,6 +62,7 @@ type (
 		throttledLoggger    log.Logger
 		config              *Config
 		metricsClient       metrics.Client
+		payloadOffloader    payload.Offloader
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
@@ -86,6 +88,7 @@ type (
 		logger          log.Logger
 		throttledLogger log.Logger
 		metricsClient   metrics.Client
+		offloader       payload.Offloader
 	}
 )
 
@@ -98,7 +101,7 @@ const (
 func newShardController(svc service.Service, host *membership.HostInfo, resolver membership.ServiceResolver,
 	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory,
-	config *Config, logger log.Logger, metricsClient metrics.Client) *shardController {
+	config *Config, logger log.Logger, metricsClient metrics.Client, payloadOffloader payload.Offloader) *shardController {
 	logger = logger.WithTags(tag.ComponentShardController)
 	return &shardController{
 		service:             svc,
@@ -118,13 +121,15 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 		throttledLoggger:    svc.GetThrottledLogger(),
 		config:              config,
 		metricsClient:       metricsClient,
+		payloadOffloader:    payloadOffloader,
 	}
 }
 
 func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence.ShardManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
-	config *Config, logger log.Logger, throttledLog log.Logger, metricsClient metrics.Client) (*historyShardsItem, error) {
+	config *Config, logger log.Logger, throttledLog log.Logger, metricsClient metrics.Client,
+	offloader payload.Offloader) (*historyShardsItem, error) {
 
 	executionMgr, err := executionMgrFactory.NewExecutionManager(shardID)
 	if err != nil {
@@ -146,6 +151,7 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 		logger:          logger.WithTags(tag.ShardID(shardID)),
 		throttledLogger: throttledLog.WithTags(tag.ShardID(shardID)),
 		metricsClient:   metricsClient,
+		offloader:       offloader,
 	}, nil
 }
 
@@ -241,7 +247,7 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 
 	if info.Identity() == c.host.Identity() {
 		shardItem, err := newHistoryShardsItem(shardID, c.service, c.shardMgr, c.historyMgr, c.historyV2Mgr, c.domainCache,
-			c.executionMgrFactory, c.engineFactory, c.host, c.config, c.logger, c.throttledLoggger, c.metricsClient)
+			c.executionMgrFactory, c.engineFactory, c.host, c.config, c.logger, c.throttledLoggger, c.metricsClient, c.payloadOffloader)
 		if err != nil {
 			return nil, err
 		}
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
@@ -92,7 +93,7 @@ func (s *shardControllerSuite) SetupTest() {
 	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.metricsClient, s.mockClientBean)
 	s.domainCache = cache.NewDomainCache(s.mockMetadaraMgr, s.mockClusterMetadata, s.metricsClient, s.logger)
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager,
-		s.mockHistoryMgr, s.mockHistoryV2Mgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.mockHistoryMgr, s.mockHistoryV2Mgr, s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient, payload.NewOffloader(nil, nil))
 }
 
 func (s *shardControllerSuite) TearDownTest() {
@@ -125,7 +126,7 @@ func (s *shardControllerSuite) TestAcquireShardSuccess() {
 			mockEngine.On("Start").Return().Once()
 			s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
 			s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
-			s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+			s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 				&persistence.GetShardResponse{
 					ShardInfo: &persistence.ShardInfo{
 						ShardID:             shardID,
@@ -144,7 +145,7 @@ func (s *shardControllerSuite) TestAcquireShardSuccess() {
 						},
 					},
 				}, nil).Once()
-			s.mockShardManager.On("UpdateShard", &persistence.UpdateShardRequest{
+			s.mockShardManager.On("UpdateShard", mock.Anything, &persistence.UpdateShardRequest{
 				ShardInfo: &persistence.ShardInfo{
 					ShardID:             shardID,
 					Owner:               s.hostInfo.Identity(),
@@ -161,8 +162,9 @@ func (s *shardControllerSuite) TestAcquireShardSuccess() {
 						cluster.TestCurrentClusterName:     currentClusterTimerAck,
 						cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 					},
-					TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-					TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+					TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+					TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
+					ClusterReplicationLevel: map[string]int64{},
 				},
 				PreviousRangeID: 5,
 			}).Return(nil).Once()
@@ -215,7 +217,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 		mockEngine.On("Start").Return().Once()
 		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
 		s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
-		s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+		s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 			&persistence.GetShardResponse{
 				ShardInfo: &persistence.ShardInfo{
 					ShardID:             shardID,
@@ -234,7 +236,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 					},
 				},
 			}, nil).Once()
-		s.mockShardManager.On("UpdateShard", &persistence.UpdateShardRequest{
+		s.mockShardManager.On("UpdateShard", mock.Anything, &persistence.UpdateShardRequest{
 			ShardInfo: &persistence.ShardInfo{
 				ShardID:             shardID,
 				Owner:               s.hostInfo.Identity(),
@@ -251,8 +253,9 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 					cluster.TestCurrentClusterName:     currentClusterTimerAck,
 					cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 				},
-				TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-				TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+				TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+				TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
+				ClusterReplicationLevel: map[string]int64{},
 			},
 			PreviousRangeID: 5,
 		}).Return(nil).Once()
@@ -290,7 +293,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 		mockEngine.On("Start").Return().Once()
 		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
 		s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
-		s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+		s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 			&persistence.GetShardResponse{
 				ShardInfo: &persistence.ShardInfo{
 					ShardID:             shardID,
@@ -309,7 +312,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 					},
 				},
 			}, nil).Once()
-		s.mockShardManager.On("UpdateShard", &persistence.UpdateShardRequest{
+		s.mockShardManager.On("UpdateShard", mock.Anything, &persistence.UpdateShardRequest{
 			ShardInfo: &persistence.ShardInfo{
 				ShardID:             shardID,
 				Owner:               s.hostInfo.Identity(),
@@ -326,8 +329,9 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 					cluster.TestCurrentClusterName:     currentClusterTimerAck,
 					cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 				},
-				TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-				TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+				TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+				TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
+				ClusterReplicationLevel: map[string]int64{},
 			},
 			PreviousRangeID: 5,
 		}).Return(nil).Once()
@@ -352,7 +356,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient, payload.NewOffloader(nil, nil))
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := &MockHistoryEngine{}
@@ -445,7 +449,7 @@ func (s *shardControllerSuite) TestRingUpdated() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient, payload.NewOffloader(nil, nil))
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := &MockHistoryEngine{}
@@ -525,7 +529,7 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	numShards := 4
 	s.config.NumberOfShards = numShards
 	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient, payload.NewOffloader(nil, nil))
 	historyEngines := make(map[int]*MockHistoryEngine)
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := &MockHistoryEngine{}
@@ -586,7 +590,7 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 	mockEngine.On("Start").Return().Once()
 	s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
 	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
-	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 		&persistence.GetShardResponse{
 			ShardInfo: &persistence.ShardInfo{
 				ShardID:             shardID,
@@ -605,7 +609,7 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 				},
 			},
 		}, nil).Once()
-	s.mockShardManager.On("UpdateShard", &persistence.UpdateShardRequest{
+	s.mockShardManager.On("UpdateShard", mock.Anything, &persistence.UpdateShardRequest{
 		ShardInfo: &persistence.ShardInfo{
 			ShardID:             shardID,
 			Owner:               s.hostInfo.Identity(),
@@ -622,8 +626,9 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 				cluster.TestCurrentClusterName:     currentClusterTimerAck,
 				cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 			},
-			TransferFailoverLevels: map[string]persistence.TransferFailoverLevel{},
-			TimerFailoverLevels:    map[string]persistence.TimerFailoverLevel{},
+			TransferFailoverLevels:  map[string]persistence.TransferFailoverLevel{},
+			TimerFailoverLevels:     map[string]persistence.TimerFailoverLevel{},
+			ClusterReplicationLevel: map[string]int64{},
 		},
 		PreviousRangeID: currentRangeID,
 	}).Return(nil).Once()
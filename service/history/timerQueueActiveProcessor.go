@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -204,47 +205,48 @@ func (t *timerQueueActiveProcessorImpl) notifyNewTimers(timerTasks []persistence
 
 func (t *timerQueueActiveProcessorImpl) process(timerTask *persistence.TimerTaskInfo, shouldProcessTask bool) (int, error) {
 
+	ctx := context.Background()
 	var err error
 	switch timerTask.TaskType {
 	case persistence.TaskTypeUserTimer:
 		if shouldProcessTask {
-			err = t.processExpiredUserTimer(timerTask)
+			err = t.processExpiredUserTimer(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskUserTimerScope, err
 
 	case persistence.TaskTypeActivityTimeout:
 		if shouldProcessTask {
-			err = t.processActivityTimeout(timerTask)
+			err = t.processActivityTimeout(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskActivityTimeoutScope, err
 
 	case persistence.TaskTypeDecisionTimeout:
 		if shouldProcessTask {
-			err = t.processDecisionTimeout(timerTask)
+			err = t.processDecisionTimeout(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskDecisionTimeoutScope, err
 
 	case persistence.TaskTypeWorkflowTimeout:
 		if shouldProcessTask {
-			err = t.processWorkflowTimeout(timerTask)
+			err = t.processWorkflowTimeout(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskWorkflowTimeoutScope, err
 
 	case persistence.TaskTypeActivityRetryTimer:
 		if shouldProcessTask {
-			err = t.processActivityRetryTimer(timerTask)
+			err = t.processActivityRetryTimer(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskActivityRetryTimerScope, err
 
 	case persistence.TaskTypeWorkflowBackoffTimer:
 		if shouldProcessTask {
-			err = t.processWorkflowBackoffTimer(timerTask)
+			err = t.processWorkflowBackoffTimer(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskWorkflowBackoffTimerScope, err
 
 	case persistence.TaskTypeDeleteHistoryEvent:
 		if shouldProcessTask {
-			err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
+			err = t.timerQueueProcessorBase.processDeleteHistoryEvent(ctx, timerTask)
 		}
 		return metrics.TimerActiveTaskDeleteHistoryEventScope, err
 
@@ -253,7 +255,7 @@ func (t *timerQueueActiveProcessorImpl) process(timerTask *persistence.TimerTask
 	}
 }
 
-func (t *timerQueueActiveProcessorImpl) processExpiredUserTimer(task *persistence.TimerTaskInfo) (retError error) {
+func (t *timerQueueActiveProcessorImpl) processExpiredUserTimer(ctx context.Context, task *persistence.TimerTaskInfo) (retError error) {
 	context, release, err0 := t.cache.getOrCreateWorkflowExecutionForBackground(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
 	if err0 != nil {
 		return err0
@@ -262,7 +264,7 @@ func (t *timerQueueActiveProcessorImpl) processExpiredUserTimer(task *persistenc
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+		msBuilder, err := loadMutableStateForTimerTask(ctx, context, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -306,7 +308,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		err = t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision, false, timerTasks)
+		err = t.updateWorkflowExecution(ctx, context, msBuilder, scheduleNewDecision, false, timerTasks)
 		if err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
@@ -317,7 +319,7 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueActiveProcessorImpl) processActivityTimeout(timerTask *persistence.TimerTaskInfo) (retError error) {
+func (t *timerQueueActiveProcessorImpl) processActivityTimeout(ctx context.Context, timerTask *persistence.TimerTaskInfo) (retError error) {
 
 	context, release, err0 := t.cache.getOrCreateWorkflowExecutionForBackground(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(timerTask))
 	if err0 != nil {
@@ -328,7 +330,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityTimeout(timerTask *persis
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err := loadMutableStateForTimerTask(context, timerTask, t.metricsClient, t.logger)
+		msBuilder, err := loadMutableStateForTimerTask(ctx, context, timerTask, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -462,7 +464,7 @@ Update_History_Loop:
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 			// the history and try the operation again.
 			scheduleNewDecision := updateHistory && !msBuilder.HasPendingDecisionTask()
-			err := t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision, false, timerTasks)
+			err := t.updateWorkflowExecution(ctx, context, msBuilder, scheduleNewDecision, false, timerTasks)
 			if err != nil {
 				if err == ErrConflict {
 					continue Update_History_Loop
@@ -478,7 +480,7 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(task *persistence.TimerTaskInfo) (retError error) {
+func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(ctx context.Context, task *persistence.TimerTaskInfo) (retError error) {
 
 	context, release, err0 := t.cache.getOrCreateWorkflowExecutionForBackground(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
 	if err0 != nil {
@@ -488,7 +490,7 @@ func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(task *persistence
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+		msBuilder, err := loadMutableStateForTimerTask(ctx, context, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -535,7 +537,7 @@ Update_History_Loop:
 		if scheduleNewDecision {
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 			// the history and try the operation again.
-			err := t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision, false, nil)
+			err := t.updateWorkflowExecution(ctx, context, msBuilder, scheduleNewDecision, false, nil)
 			if err != nil {
 				if err == ErrConflict {
 					continue Update_History_Loop
@@ -550,7 +552,7 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persistence.TimerTaskInfo) (retError error) {
+func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(ctx context.Context, task *persistence.TimerTaskInfo) (retError error) {
 
 	context, release, err0 := t.cache.getOrCreateWorkflowExecutionForBackground(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
 	if err0 != nil {
@@ -566,7 +568,7 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persis
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+		msBuilder, err := loadMutableStateForTimerTask(ctx, context, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -579,7 +581,7 @@ Update_History_Loop:
 		}
 
 		// schedule first decision task
-		err = t.updateWorkflowExecution(context, msBuilder, true, false, nil)
+		err = t.updateWorkflowExecution(ctx, context, msBuilder, true, false, nil)
 		if err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
@@ -591,7 +593,7 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persistence.TimerTaskInfo) error {
+func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(ctx context.Context, task *persistence.TimerTaskInfo) error {
 
 	processFn := func() error {
 		context, release, err0 := t.cache.getOrCreateWorkflowExecutionForBackground(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
@@ -599,7 +601,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 		if err0 != nil {
 			return err0
 		}
-		msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+		msBuilder, err := loadMutableStateForTimerTask(ctx, context, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -677,7 +679,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(task *persistence.TimerTaskInfo) (retError error) {
+func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(ctx context.Context, task *persistence.TimerTaskInfo) (retError error) {
 
 	domainID, workflowExecution := t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task)
 	context, release, err0 := t.cache.getOrCreateWorkflowExecutionForBackground(domainID, workflowExecution)
@@ -688,7 +690,7 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(task *persistence
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+		msBuilder, err := loadMutableStateForTimerTask(ctx, context, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -720,7 +722,7 @@ Update_History_Loop:
 
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 			// the history and try the operation again.
-			err = t.updateWorkflowExecution(context, msBuilder, false, true, nil)
+			err = t.updateWorkflowExecution(ctx, context, msBuilder, false, true, nil)
 			if err != nil {
 				if err == ErrConflict {
 					continue Update_History_Loop
@@ -783,7 +785,7 @@ Update_History_Loop:
 		}
 
 		timersToNotify := append(timerTasks, msBuilder.GetContinueAsNew().TimerTasks...)
-		err = context.continueAsNewWorkflowExecution(nil, continueAsNewBuilder, transferTasks, timerTasks, transactionID)
+		err = context.continueAsNewWorkflowExecution(ctx, nil, continueAsNewBuilder, transferTasks, timerTasks, transactionID)
 
 		if err != nil {
 			if err == ErrConflict {
@@ -798,6 +800,7 @@ Update_History_Loop:
 }
 
 func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
+	ctx context.Context,
 	context workflowExecutionContext,
 	msBuilder mutableState,
 	scheduleNewDecision bool,
@@ -809,7 +812,7 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	var err error
 	if scheduleNewDecision {
 		// Schedule a new decision.
-		transferTasks, timerTasks, err = context.scheduleNewDecision(transferTasks, timerTasks)
+		transferTasks, timerTasks, err = context.scheduleNewDecision(ctx, transferTasks, timerTasks)
 		if err != nil {
 			return err
 		}
@@ -834,7 +837,7 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 		return err1
 	}
 
-	err = context.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+	err = context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID)
 	if err != nil {
 		if isShardOwnershiptLostError(err) {
 			// Shard is stolen.  Stop timer processing to reduce duplicates
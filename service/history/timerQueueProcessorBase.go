@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -563,7 +564,7 @@ func (t *timerQueueProcessorBase) getDomainIDAndWorkflowExecution(task *persiste
 	}
 }
 
-func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.TimerTaskInfo) (retError error) {
+func (t *timerQueueProcessorBase) processDeleteHistoryEvent(ctx context.Context, task *persistence.TimerTaskInfo) (retError error) {
 
 	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(t.getDomainIDAndWorkflowExecution(task))
 	if err != nil {
@@ -571,7 +572,7 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err := loadMutableStateForTimerTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || msBuilder.IsWorkflowExecutionRunning() {
@@ -594,36 +595,40 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 	switch clusterArchivalStatus {
 	case cluster.ArchivalDisabled:
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-		return t.deleteWorkflow(task, msBuilder, context)
+		return t.deleteWorkflow(ctx, task, msBuilder, context)
 	case cluster.ArchivalPaused:
 		// TODO: @dandrew once archival backfill is in place cluster:paused && domain:enabled should be a nop rather than a delete
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-		return t.deleteWorkflow(task, msBuilder, context)
+		return t.deleteWorkflow(ctx, task, msBuilder, context)
 	case cluster.ArchivalEnabled:
 		if domainArchivalStatus == workflow.ArchivalStatusDisabled {
 			t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-			return t.deleteWorkflow(task, msBuilder, context)
+			return t.deleteWorkflow(ctx, task, msBuilder, context)
 		}
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupArchiveCount)
-		return t.archiveWorkflow(task, msBuilder, context)
+		return t.archiveWorkflow(ctx, task, msBuilder, context)
 	}
 	return nil
 }
 
-func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
-	if err := t.deleteCurrentWorkflowExecution(task); err != nil {
+func (t *timerQueueProcessorBase) deleteWorkflow(ctx context.Context, task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
+	if err := t.deleteCurrentWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
 
-	if err := t.deleteWorkflowExecution(task); err != nil {
+	if err := t.deleteWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
 
-	if err := t.deleteWorkflowHistory(task, msBuilder); err != nil {
+	if err := t.deleteWorkflowHistory(ctx, task, msBuilder); err != nil {
 		return err
 	}
 
-	if err := t.deleteWorkflowVisibility(task); err != nil {
+	if err := t.deleteWorkflowPayloads(ctx, task); err != nil {
+		return err
+	}
+
+	if err := t.deleteWorkflowVisibility(ctx, task); err != nil {
 		return err
 	}
 	// calling clear here to force accesses of mutable state to read database
@@ -632,7 +637,7 @@ func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo
 	return nil
 }
 
-func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
+func (t *timerQueueProcessorBase) archiveWorkflow(ctx context.Context, task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
 	domainCacheEntry, err := t.historyService.shard.GetDomainCache().GetDomainByID(task.DomainID)
 	if err != nil {
 		return err
@@ -663,13 +668,13 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 			tag.TaskType(task.GetTaskType()))
 		return err
 	}
-	if err := t.deleteCurrentWorkflowExecution(task); err != nil {
+	if err := t.deleteCurrentWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
-	if err := t.deleteWorkflowExecution(task); err != nil {
+	if err := t.deleteWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
-	if err := t.deleteWorkflowVisibility(task); err != nil {
+	if err := t.deleteWorkflowVisibility(ctx, task); err != nil {
 		return err
 	}
 	// calling clear here to force accesses of mutable state to read database
@@ -678,9 +683,9 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 	return nil
 }
 
-func (t *timerQueueProcessorBase) deleteWorkflowExecution(task *persistence.TimerTaskInfo) error {
+func (t *timerQueueProcessorBase) deleteWorkflowExecution(ctx context.Context, task *persistence.TimerTaskInfo) error {
 	op := func() error {
-		return t.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		return t.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
 			DomainID:   task.DomainID,
 			WorkflowID: task.WorkflowID,
 			RunID:      task.RunID,
@@ -689,9 +694,9 @@ func (t *timerQueueProcessorBase) deleteWorkflowExecution(task *persistence.Time
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
-func (t *timerQueueProcessorBase) deleteCurrentWorkflowExecution(task *persistence.TimerTaskInfo) error {
+func (t *timerQueueProcessorBase) deleteCurrentWorkflowExecution(ctx context.Context, task *persistence.TimerTaskInfo) error {
 	op := func() error {
-		return t.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		return t.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
 			DomainID:   task.DomainID,
 			WorkflowID: task.WorkflowID,
 			RunID:      task.RunID,
@@ -700,7 +705,7 @@ func (t *timerQueueProcessorBase) deleteCurrentWorkflowExecution(task *persisten
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
-func (t *timerQueueProcessorBase) deleteWorkflowHistory(task *persistence.TimerTaskInfo, msBuilder mutableState) error {
+func (t *timerQueueProcessorBase) deleteWorkflowHistory(ctx context.Context, task *persistence.TimerTaskInfo, msBuilder mutableState) error {
 	domainID, workflowExecution := t.getDomainIDAndWorkflowExecution(task)
 	op := func() error {
 		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
@@ -711,9 +716,9 @@ func (t *timerQueueProcessorBase) deleteWorkflowHistory(task *persistence.TimerT
 				tag.TaskID(task.GetTaskID()),
 				tag.FailoverVersion(task.GetVersion()),
 				tag.TaskType(task.GetTaskType()))
-			return persistence.DeleteWorkflowExecutionHistoryV2(t.historyService.historyV2Mgr, msBuilder.GetCurrentBranch(), common.IntPtr(t.shard.GetShardID()), logger)
+			return persistence.DeleteWorkflowExecutionHistoryV2(ctx, t.historyService.historyV2Mgr, msBuilder.GetCurrentBranch(), common.IntPtr(t.shard.GetShardID()), logger)
 		}
-		return t.historyService.historyMgr.DeleteWorkflowExecutionHistory(
+		return t.historyService.historyMgr.DeleteWorkflowExecutionHistory(ctx,
 			&persistence.DeleteWorkflowExecutionHistoryRequest{
 				DomainID:  domainID,
 				Execution: workflowExecution,
@@ -722,9 +727,21 @@ func (t *timerQueueProcessorBase) deleteWorkflowHistory(task *persistence.TimerT
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
-func (t *timerQueueProcessorBase) deleteWorkflowVisibility(task *persistence.TimerTaskInfo) error {
+func (t *timerQueueProcessorBase) deleteWorkflowPayloads(ctx context.Context, task *persistence.TimerTaskInfo) error {
+	// blobstore client used by the offloader retries on its own
+	err := t.shard.GetPayloadOffloader().DeleteWorkflowPayloads(ctx, task.DomainID, task.RunID)
+	if err != nil {
+		t.logger.Error("failed to delete offloaded payloads", tag.Error(err),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowDomainID(task.DomainID))
+	}
+	return err
+}
+
+func (t *timerQueueProcessorBase) deleteWorkflowVisibility(ctx context.Context, task *persistence.TimerTaskInfo) error {
 	op := func() error {
-		return t.historyService.DeleteExecutionFromVisibility(task)
+		return t.historyService.DeleteExecutionFromVisibility(ctx, task)
 	}
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
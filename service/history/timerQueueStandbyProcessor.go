@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -141,30 +142,31 @@ func (t *timerQueueStandbyProcessorImpl) notifyNewTimers(timerTasks []persistenc
 
 func (t *timerQueueStandbyProcessorImpl) process(timerTask *persistence.TimerTaskInfo, shouldProcessTask bool) (int, error) {
 
+	ctx := context.Background()
 	var err error
 	lastAttempt := false
 	switch timerTask.TaskType {
 	case persistence.TaskTypeUserTimer:
 		if shouldProcessTask {
-			err = t.processExpiredUserTimer(timerTask, lastAttempt)
+			err = t.processExpiredUserTimer(ctx, timerTask, lastAttempt)
 		}
 		return metrics.TimerStandbyTaskUserTimerScope, err
 
 	case persistence.TaskTypeActivityTimeout:
 		if shouldProcessTask {
-			err = t.processActivityTimeout(timerTask, lastAttempt)
+			err = t.processActivityTimeout(ctx, timerTask, lastAttempt)
 		}
 		return metrics.TimerStandbyTaskActivityTimeoutScope, err
 
 	case persistence.TaskTypeDecisionTimeout:
 		if shouldProcessTask {
-			err = t.processDecisionTimeout(timerTask, lastAttempt)
+			err = t.processDecisionTimeout(ctx, timerTask, lastAttempt)
 		}
 		return metrics.TimerStandbyTaskDecisionTimeoutScope, err
 
 	case persistence.TaskTypeWorkflowTimeout:
 		// guarantee the processing of workflow execution history deletion
-		err = t.processWorkflowTimeout(timerTask, lastAttempt)
+		err = t.processWorkflowTimeout(ctx, timerTask, lastAttempt)
 		return metrics.TimerStandbyTaskWorkflowTimeoutScope, err
 
 	case persistence.TaskTypeActivityRetryTimer:
@@ -173,24 +175,24 @@ func (t *timerQueueStandbyProcessorImpl) process(timerTask *persistence.TimerTas
 
 	case persistence.TaskTypeWorkflowBackoffTimer:
 		if shouldProcessTask {
-			err = t.processWorkflowBackoffTimer(timerTask, lastAttempt)
+			err = t.processWorkflowBackoffTimer(ctx, timerTask, lastAttempt)
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope, err
 
 	case persistence.TaskTypeDeleteHistoryEvent:
 		// guarantee the processing of workflow execution history deletion
-		return metrics.TimerStandbyTaskDeleteHistoryEventScope, t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
+		return metrics.TimerStandbyTaskDeleteHistoryEventScope, t.timerQueueProcessorBase.processDeleteHistoryEvent(ctx, timerTask)
 
 	default:
 		return metrics.TimerStandbyQueueProcessorScope, errUnknownTimerTask
 	}
 }
 
-func (t *timerQueueStandbyProcessorImpl) processExpiredUserTimer(timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
+func (t *timerQueueStandbyProcessorImpl) processExpiredUserTimer(ctx context.Context, timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(timerTask, nextEventID, t.processExpiredUserTimer)
+		return t.fetchHistoryAndVerifyOnce(ctx, timerTask, nextEventID, t.processExpiredUserTimer)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -198,7 +200,7 @@ func (t *timerQueueStandbyProcessorImpl) processExpiredUserTimer(timerTask *pers
 		}
 	}
 
-	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
+	return t.processTimer(ctx, timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
 		tBuilder := t.getTimerBuilder()
 
 	ExpireUserTimers:
@@ -234,7 +236,7 @@ func (t *timerQueueStandbyProcessorImpl) processExpiredUserTimer(timerTask *pers
 	}, postProcessingFn)
 }
 
-func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
+func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(ctx context.Context, timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
 
 	// activity heartbeat timer task is a special snowflake.
 	// normal activity timer task on the passive side will be generated by events related to activity in history replicator,
@@ -250,7 +252,7 @@ func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(timerTask *persi
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(timerTask, nextEventID, t.processActivityTimeout)
+		return t.fetchHistoryAndVerifyOnce(ctx, timerTask, nextEventID, t.processActivityTimeout)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -258,7 +260,7 @@ func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(timerTask *persi
 		}
 	}
 
-	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
+	return t.processTimer(ctx, timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
 		tBuilder := t.getTimerBuilder()
 
 	ExpireActivityTimers:
@@ -320,7 +322,7 @@ func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(timerTask *persi
 		// since the job being done here is update the activity and possibly write a timer task to DB
 		// also need to reset the current version.
 		msBuilder.UpdateReplicationStateVersion(lastWriteVersion, true)
-		err = context.updateWorkflowExecutionForStandby(nil, newTimerTasks, transactionID, now, false, nil, sourceCluster)
+		err = context.updateWorkflowExecutionForStandby(ctx, nil, newTimerTasks, transactionID, now, false, nil, sourceCluster)
 		if err == nil {
 			t.notifyNewTimers(newTimerTasks)
 		}
@@ -328,11 +330,11 @@ func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(timerTask *persi
 	}, postProcessingFn)
 }
 
-func (t *timerQueueStandbyProcessorImpl) processDecisionTimeout(timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
+func (t *timerQueueStandbyProcessorImpl) processDecisionTimeout(ctx context.Context, timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(timerTask, nextEventID, t.processDecisionTimeout)
+		return t.fetchHistoryAndVerifyOnce(ctx, timerTask, nextEventID, t.processDecisionTimeout)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -340,7 +342,7 @@ func (t *timerQueueStandbyProcessorImpl) processDecisionTimeout(timerTask *persi
 		}
 	}
 
-	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
+	return t.processTimer(ctx, timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
 		di, isPending := msBuilder.GetPendingDecision(timerTask.EventID)
 
 		if !isPending {
@@ -371,11 +373,11 @@ func (t *timerQueueStandbyProcessorImpl) processDecisionTimeout(timerTask *persi
 	}, postProcessingFn)
 }
 
-func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
+func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(ctx context.Context, timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(timerTask, nextEventID, t.processWorkflowBackoffTimer)
+		return t.fetchHistoryAndVerifyOnce(ctx, timerTask, nextEventID, t.processWorkflowBackoffTimer)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -383,7 +385,7 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(timerTask *
 		}
 	}
 
-	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
+	return t.processTimer(ctx, timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
 
 		if msBuilder.HasProcessedOrPendingDecisionTask() {
 			// if there is one decision already been processed
@@ -414,11 +416,11 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(timerTask *
 	}, postProcessingFn)
 }
 
-func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
+func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(ctx context.Context, timerTask *persistence.TimerTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(timerTask, nextEventID, t.processWorkflowTimeout)
+		return t.fetchHistoryAndVerifyOnce(ctx, timerTask, nextEventID, t.processWorkflowTimeout)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -426,7 +428,7 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(timerTask *persi
 		}
 	}
 
-	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
+	return t.processTimer(ctx, timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
 		// we do not need to notity new timer to base, since if there is no new event being replicated
 		// checking again if the timer can be completed is meaningless
 
@@ -460,7 +462,7 @@ func (t *timerQueueStandbyProcessorImpl) getTimerBuilder() *timerBuilder {
 	return newTimerBuilder(t.shard.GetConfig(), t.logger, timeSource)
 }
 
-func (t *timerQueueStandbyProcessorImpl) processTimer(timerTask *persistence.TimerTaskInfo,
+func (t *timerQueueStandbyProcessorImpl) processTimer(ctx context.Context, timerTask *persistence.TimerTaskInfo,
 	action func(workflowExecutionContext, mutableState) error, postAction func() error) (retError error) {
 	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(timerTask))
 	if err != nil {
@@ -474,7 +476,7 @@ func (t *timerQueueStandbyProcessorImpl) processTimer(timerTask *persistence.Tim
 		}
 	}()
 
-	msBuilder, err := loadMutableStateForTimerTask(context, timerTask, t.metricsClient, t.logger)
+	msBuilder, err := loadMutableStateForTimerTask(ctx, context, timerTask, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil {
@@ -496,8 +498,8 @@ func (t *timerQueueStandbyProcessorImpl) processTimer(timerTask *persistence.Tim
 	return err
 }
 
-func (t *timerQueueStandbyProcessorImpl) fetchHistoryAndVerifyOnce(timerTask *persistence.TimerTaskInfo, nextEventID *int64,
-	verifyFn func(*persistence.TimerTaskInfo, bool) error) error {
+func (t *timerQueueStandbyProcessorImpl) fetchHistoryAndVerifyOnce(ctx context.Context, timerTask *persistence.TimerTaskInfo, nextEventID *int64,
+	verifyFn func(context.Context, *persistence.TimerTaskInfo, bool) error) error {
 
 	if nextEventID == nil {
 		return nil
@@ -508,7 +510,7 @@ func (t *timerQueueStandbyProcessorImpl) fetchHistoryAndVerifyOnce(timerTask *pe
 		return ErrTaskDiscarded
 	}
 	lastAttempt := true
-	err = verifyFn(timerTask, lastAttempt)
+	err = verifyFn(ctx, timerTask, lastAttempt)
 	if err != nil {
 		// task still pending, just discard the task
 		return ErrTaskDiscarded
@@ -21,7 +21,7 @@
 package history
 
 import (
-	ctx "context"
+	"context"
 	"fmt"
 
 	"github.com/pborman/uuid"
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 )
 
 const identityHistoryService = "history-service"
@@ -204,52 +205,53 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo, shouldPr
 		return metrics.TransferActiveQueueProcessorScope, errUnexpectedQueueTask
 	}
 
+	ctx := context.Background()
 	var err error
 	switch task.TaskType {
 	case persistence.TransferTaskTypeActivityTask:
 		if shouldProcessTask {
-			err = t.processActivityTask(task)
+			err = t.processActivityTask(ctx, task)
 		}
 		return metrics.TransferActiveTaskActivityScope, err
 
 	case persistence.TransferTaskTypeDecisionTask:
 		if shouldProcessTask {
-			err = t.processDecisionTask(task)
+			err = t.processDecisionTask(ctx, task)
 		}
 		return metrics.TransferActiveTaskDecisionScope, err
 
 	case persistence.TransferTaskTypeCloseExecution:
 		if shouldProcessTask {
-			err = t.processCloseExecution(task)
+			err = t.processCloseExecution(ctx, task)
 		}
 		return metrics.TransferActiveTaskCloseExecutionScope, err
 
 	case persistence.TransferTaskTypeCancelExecution:
 		if shouldProcessTask {
-			err = t.processCancelExecution(task)
+			err = t.processCancelExecution(ctx, task)
 		}
 		return metrics.TransferActiveTaskCancelExecutionScope, err
 
 	case persistence.TransferTaskTypeSignalExecution:
 		if shouldProcessTask {
-			err = t.processSignalExecution(task)
+			err = t.processSignalExecution(ctx, task)
 		}
 		return metrics.TransferActiveTaskSignalExecutionScope, err
 
 	case persistence.TransferTaskTypeStartChildExecution:
 		if shouldProcessTask {
-			err = t.processStartChildExecution(task)
+			err = t.processStartChildExecution(ctx, task)
 		}
 		return metrics.TransferActiveTaskStartChildExecutionScope, err
 
 	case persistence.TransferTaskTypeRecordWorkflowStarted:
 		if shouldProcessTask {
-			err = t.processRecordWorkflowStarted(task)
+			err = t.processRecordWorkflowStarted(ctx, task)
 		}
 		return metrics.TransferActiveTaskRecordWorkflowStartedScope, err
 	case persistence.TransferTaskTypeResetWorkflow:
 		if shouldProcessTask {
-			err = t.processResetWorkflow(task)
+			err = t.processResetWorkflow(ctx, task)
 		}
 		return metrics.TransferActiveTaskResetWorkflowScope, err
 	default:
@@ -257,7 +259,7 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo, shouldPr
 	}
 }
 
-func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processActivityTask(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 
 	var err error
 	execution := workflow.WorkflowExecution{
@@ -271,7 +273,7 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	defer func() { release(retError) }()
 
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -297,7 +299,7 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	return t.pushActivity(task, timeout)
 }
 
-func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processDecisionTask(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 	var err error
 	execution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -315,7 +317,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	defer func() { release(retError) }()
 
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -356,7 +358,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	return t.pushDecision(task, tasklist, decisionTimeout)
 }
 
-func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processCloseExecution(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 
 	var err error
 	domainID := task.DomainID
@@ -372,7 +374,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	defer func() { release(retError) }()
 
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || msBuilder.IsWorkflowExecutionRunning() {
@@ -418,6 +420,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 	err = t.recordWorkflowClosed(
+		ctx,
 		domainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
 		workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, task.GetTaskID(), visibilityMemo,
 		searchAttr,
@@ -451,7 +454,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processCancelExecution(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 
 	var err error
 	domainID := task.DomainID
@@ -471,7 +474,7 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 
 	// First load the execution to validate if there is pending request cancellation for this transfer task
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -505,7 +508,7 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 				Identity: common.StringPtr(identityHistoryService),
 			},
 		}
-		err = t.requestCancelFailed(task, context, cancelRequest)
+		err = t.requestCancelFailed(ctx, task, context, cancelRequest)
 		if _, ok := err.(*workflow.EntityNotExistsError); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 			return nil
@@ -548,7 +551,7 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 			// Check to see if the error is non-transient, in which case add RequestCancelFailed
 			// event and complete transfer task by setting the err = nil
 			if common.IsServiceNonRetryableError(err) {
-				err = t.requestCancelFailed(task, context, cancelRequest)
+				err = t.requestCancelFailed(ctx, task, context, cancelRequest)
 				if _, ok := err.(*workflow.EntityNotExistsError); ok {
 					// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 					return nil
@@ -562,7 +565,7 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 		task.TargetWorkflowID, task.TargetRunID))
 
 	// Record ExternalWorkflowExecutionCancelRequested in source execution
-	err = t.requestCancelCompleted(task, context, cancelRequest)
+	err = t.requestCancelCompleted(ctx, task, context, cancelRequest)
 	if _, ok := err.(*workflow.EntityNotExistsError); ok {
 		// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 		return nil
@@ -571,7 +574,7 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processSignalExecution(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 
 	var err error
 	domainID := task.DomainID
@@ -588,7 +591,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 	defer func() { release(retError) }()
 
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -626,7 +629,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 				Control:  si.Control,
 			},
 		}
-		err = t.requestSignalFailed(task, context, signalRequest)
+		err = t.requestSignalFailed(ctx, task, context, signalRequest)
 		if _, ok := err.(*workflow.EntityNotExistsError); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 			return nil
@@ -664,7 +667,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 		// Check to see if the error is non-transient, in which case add SignalFailed
 		// event and complete transfer task by setting the err = nil
 		if common.IsServiceNonRetryableError(err) {
-			err = t.requestSignalFailed(task, context, signalRequest)
+			err = t.requestSignalFailed(ctx, task, context, signalRequest)
 			if _, ok := err.(*workflow.EntityNotExistsError); ok {
 				// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 				return nil
@@ -676,7 +679,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 	t.logger.Debug(fmt.Sprintf("Signal successfully recorded to external workflow execution.  WorkflowID: %v, RunID: %v",
 		task.TargetWorkflowID, task.TargetRunID))
 
-	err = t.requestSignalCompleted(task, context, signalRequest)
+	err = t.requestSignalCompleted(ctx, task, context, signalRequest)
 	if _, ok := err.(*workflow.EntityNotExistsError); ok {
 		// this could happen if this is a duplicate processing of the task, and the execution has already completed.
 		return nil
@@ -700,7 +703,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processStartChildExecution(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 
 	var err error
 	domainID := task.DomainID
@@ -718,7 +721,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 
 	// First step is to load workflow execution so we can retrieve the initiated event
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -797,8 +800,11 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 			FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(attributes.GetCronSchedule(), t.timeSource.Now())),
 		}
 
+		// the child execution continues the trace carried by the header of the initiated event
+		span, startCtx := tracing.StartSpanFromHeader(nil, t.shard.GetService().GetTracer(), "history.StartChildExecution", attributes.Header)
 		var startResponse *workflow.StartWorkflowExecutionResponse
-		startResponse, err = t.historyClient.StartWorkflowExecution(nil, startRequest)
+		startResponse, err = t.historyClient.StartWorkflowExecution(startCtx, startRequest)
+		tracing.FinishSpan(span, &err)
 		if err != nil {
 			t.logger.Debug(fmt.Sprintf("Failed to start child workflow execution. Error: %v", err))
 
@@ -806,7 +812,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 			// event and complete transfer task by setting the err = nil
 			switch err.(type) {
 			case *workflow.WorkflowExecutionAlreadyStartedError:
-				err = t.recordStartChildExecutionFailed(task, context, attributes)
+				err = t.recordStartChildExecutionFailed(ctx, task, context, attributes)
 			}
 			return err
 		}
@@ -815,7 +821,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 			*attributes.WorkflowId, *startResponse.RunId))
 
 		// Child execution is successfully started, record ChildExecutionStartedEvent in parent execution
-		err = t.recordChildExecutionStarted(task, context, attributes, *startResponse.RunId)
+		err = t.recordChildExecutionStarted(ctx, task, context, attributes, *startResponse.RunId)
 
 		if err != nil {
 			return err
@@ -837,7 +843,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStarted(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStarted(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 	var err error
 	execution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -852,7 +858,7 @@ func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStarted(task *pe
 	defer func() { release(retError) }()
 
 	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	msBuilder, err = loadMutableStateForTransferTask(ctx, context, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
@@ -881,11 +887,11 @@ func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStarted(task *pe
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.recordWorkflowStarted(task.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
+	return t.recordWorkflowStarted(ctx, task.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
 		workflowTimeout, task.GetTaskID(), visibilityMemo, searchAttr)
 }
 
-func (t *transferQueueActiveProcessorImpl) processResetWorkflow(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processResetWorkflow(ctx context.Context, task *persistence.TransferTaskInfo) (retError error) {
 	var err error
 	execution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -905,7 +911,7 @@ func (t *transferQueueActiveProcessorImpl) processResetWorkflow(task *persistenc
 	defer func() { currRelease(retError) }()
 
 	var currMutableState mutableState
-	currMutableState, err = loadMutableStateForTransferTask(currContext, task, t.metricsClient, t.logger)
+	currMutableState, err = loadMutableStateForTransferTask(ctx, currContext, task, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if currMutableState == nil {
@@ -915,7 +921,7 @@ func (t *transferQueueActiveProcessorImpl) processResetWorkflow(task *persistenc
 	if !currMutableState.IsWorkflowExecutionRunning() {
 		//it means this this might not be current anymore, we need to check
 		var resp *persistence.GetCurrentExecutionResponse
-		resp, retError = t.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		resp, retError = t.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
 			DomainID:   task.DomainID,
 			WorkflowID: task.WorkflowID,
 		})
@@ -972,7 +978,7 @@ func (t *transferQueueActiveProcessorImpl) processResetWorkflow(task *persistenc
 			return err
 		}
 		defer func() { baseRelease(retError) }()
-		baseMutableState, err = loadMutableStateForTransferTask(baseContext, task, t.metricsClient, t.logger)
+		baseMutableState, err = loadMutableStateForTransferTask(ctx, baseContext, task, t.metricsClient, t.logger)
 		if err != nil {
 			return err
 		}
@@ -988,7 +994,7 @@ func (t *transferQueueActiveProcessorImpl) processResetWorkflow(task *persistenc
 		tag.WorkflowBinaryChecksum(resetPt.GetBinaryChecksum()),
 		tag.WorkflowEventID(resetPt.GetFirstDecisionCompletedId()))
 
-	resp, err := t.historyService.resetor.ResetWorkflowExecution(ctx.Background(), &workflow.ResetWorkflowExecutionRequest{
+	resp, err := t.historyService.resetor.ResetWorkflowExecution(ctx, &workflow.ResetWorkflowExecutionRequest{
 		Domain:                common.StringPtr(domainEntry.GetInfo().Name),
 		WorkflowExecution:     &baseExecution,
 		Reason:                common.StringPtr(fmt.Sprintf("auto-reset reason:%v, binaryChecksum:%v ", reason, resetPt.GetBinaryChecksum())),
@@ -1011,11 +1017,11 @@ func (t *transferQueueActiveProcessorImpl) processResetWorkflow(task *persistenc
 	return nil
 }
 
-func (t *transferQueueActiveProcessorImpl) recordChildExecutionStarted(task *persistence.TransferTaskInfo,
+func (t *transferQueueActiveProcessorImpl) recordChildExecutionStarted(ctx context.Context, task *persistence.TransferTaskInfo,
 	context workflowExecutionContext, initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
 	runID string) error {
 
-	return t.updateWorkflowExecution(task.DomainID, context, true,
+	return t.updateWorkflowExecution(ctx, task.DomainID, context, true,
 		func(msBuilder mutableState) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
@@ -1043,11 +1049,11 @@ func (t *transferQueueActiveProcessorImpl) recordChildExecutionStarted(task *per
 		})
 }
 
-func (t *transferQueueActiveProcessorImpl) recordStartChildExecutionFailed(task *persistence.TransferTaskInfo,
+func (t *transferQueueActiveProcessorImpl) recordStartChildExecutionFailed(ctx context.Context, task *persistence.TransferTaskInfo,
 	context workflowExecutionContext,
 	initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes) error {
 
-	return t.updateWorkflowExecution(task.DomainID, context, true,
+	return t.updateWorkflowExecution(ctx, task.DomainID, context, true,
 		func(msBuilder mutableState) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
@@ -1087,10 +1093,10 @@ func (t *transferQueueActiveProcessorImpl) createFirstDecisionTask(domainID stri
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) requestCancelCompleted(task *persistence.TransferTaskInfo,
+func (t *transferQueueActiveProcessorImpl) requestCancelCompleted(ctx context.Context, task *persistence.TransferTaskInfo,
 	context workflowExecutionContext, request *h.RequestCancelWorkflowExecutionRequest) error {
 
-	return t.updateWorkflowExecution(task.DomainID, context, true,
+	return t.updateWorkflowExecution(ctx, task.DomainID, context, true,
 		func(msBuilder mutableState) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
@@ -1113,11 +1119,11 @@ func (t *transferQueueActiveProcessorImpl) requestCancelCompleted(task *persiste
 		})
 }
 
-func (t *transferQueueActiveProcessorImpl) requestSignalCompleted(task *persistence.TransferTaskInfo,
+func (t *transferQueueActiveProcessorImpl) requestSignalCompleted(ctx context.Context, task *persistence.TransferTaskInfo,
 	context workflowExecutionContext,
 	request *h.SignalWorkflowExecutionRequest) error {
 
-	return t.updateWorkflowExecution(task.DomainID, context, true,
+	return t.updateWorkflowExecution(ctx, task.DomainID, context, true,
 		func(msBuilder mutableState) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
@@ -1140,10 +1146,10 @@ func (t *transferQueueActiveProcessorImpl) requestSignalCompleted(task *persiste
 		})
 }
 
-func (t *transferQueueActiveProcessorImpl) requestCancelFailed(task *persistence.TransferTaskInfo,
+func (t *transferQueueActiveProcessorImpl) requestCancelFailed(ctx context.Context, task *persistence.TransferTaskInfo,
 	context workflowExecutionContext, request *h.RequestCancelWorkflowExecutionRequest) error {
 
-	return t.updateWorkflowExecution(task.DomainID, context, true,
+	return t.updateWorkflowExecution(ctx, task.DomainID, context, true,
 		func(msBuilder mutableState) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
@@ -1167,11 +1173,11 @@ func (t *transferQueueActiveProcessorImpl) requestCancelFailed(task *persistence
 		})
 }
 
-func (t *transferQueueActiveProcessorImpl) requestSignalFailed(task *persistence.TransferTaskInfo,
+func (t *transferQueueActiveProcessorImpl) requestSignalFailed(ctx context.Context, task *persistence.TransferTaskInfo,
 	context workflowExecutionContext,
 	request *h.SignalWorkflowExecutionRequest) error {
 
-	return t.updateWorkflowExecution(task.DomainID, context, true,
+	return t.updateWorkflowExecution(ctx, task.DomainID, context, true,
 		func(msBuilder mutableState) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return &workflow.EntityNotExistsError{Message: "Workflow is not running."}
@@ -1196,11 +1202,11 @@ func (t *transferQueueActiveProcessorImpl) requestSignalFailed(task *persistence
 		})
 }
 
-func (t *transferQueueActiveProcessorImpl) updateWorkflowExecution(domainID string, context workflowExecutionContext,
+func (t *transferQueueActiveProcessorImpl) updateWorkflowExecution(ctx context.Context, domainID string, context workflowExecutionContext,
 	createDecisionTask bool, action func(builder mutableState) error) error {
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err1 := context.loadWorkflowExecution()
+		msBuilder, err1 := context.loadWorkflowExecution(ctx)
 		if err1 != nil {
 			return err1
 		}
@@ -1214,7 +1220,7 @@ Update_History_Loop:
 		if createDecisionTask {
 			// Create a transfer task to schedule a decision task
 			var err error
-			transferTasks, timerTasks, err = context.scheduleNewDecision(transferTasks, timerTasks)
+			transferTasks, timerTasks, err = context.scheduleNewDecision(ctx, transferTasks, timerTasks)
 			if err != nil {
 				return err
 			}
@@ -1228,7 +1234,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
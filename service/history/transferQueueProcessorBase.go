@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"time"
 
 	m "github.com/uber/cadence/.gen/go/matching"
@@ -71,7 +72,7 @@ func newTransferQueueProcessorBase(shard ShardContext, options *QueueProcessorOp
 }
 
 func (t *transferQueueProcessorBase) readTasks(readLevel int64) ([]queueTaskInfo, bool, error) {
-	response, err := t.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+	response, err := t.executionManager.GetTransferTasks(context.Background(), &persistence.GetTransferTasksRequest{
 		ReadLevel:    readLevel,
 		MaxReadLevel: t.maxReadAckLevel(),
 		BatchSize:    t.options.BatchSize(),
@@ -137,6 +138,7 @@ func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTask
 }
 
 func (t *transferQueueProcessorBase) recordWorkflowStarted(
+	ctx context.Context,
 	domainID string, execution workflow.WorkflowExecution, workflowTypeName string, startTimeUnixNano,
 	executionTimeUnixNano int64, workflowTimeout int32, taskID int64, visibilityMemo *workflow.Memo,
 	searchAttributes map[string][]byte) error {
@@ -173,10 +175,11 @@ func (t *transferQueueProcessorBase) recordWorkflowStarted(
 		SearchAttributes:   searchAttributes,
 	}
 
-	return t.visibilityMgr.RecordWorkflowExecutionStarted(request)
+	return t.visibilityMgr.RecordWorkflowExecutionStarted(ctx, request)
 }
 
 func (t *transferQueueProcessorBase) recordWorkflowClosed(
+	ctx context.Context,
 	domainID string, execution workflow.WorkflowExecution, workflowTypeName string,
 	startTimeUnixNano int64, executionTimeUnixNano int64, endTimeUnixNano int64, closeStatus workflow.WorkflowExecutionCloseStatus,
 	historyLength int64, taskID int64, visibilityMemo *workflow.Memo, searchAttributes map[string][]byte) error {
@@ -221,7 +224,7 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 		SearchAttributes:   searchAttributes,
 	}
 
-	return t.visibilityMgr.RecordWorkflowExecutionClosed(request)
+	return t.visibilityMgr.RecordWorkflowExecutionClosed(ctx, request)
 }
 
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
@@ -123,47 +125,48 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo, shouldP
 		return metrics.TransferStandbyQueueProcessorScope, errUnexpectedQueueTask
 	}
 
+	ctx := context.Background()
 	var err error
 	lastAttempt := false
 	switch task.TaskType {
 	case persistence.TransferTaskTypeActivityTask:
 		if shouldProcessTask {
-			err = t.processActivityTask(task)
+			err = t.processActivityTask(ctx, task)
 		}
 		return metrics.TransferStandbyTaskActivityScope, err
 
 	case persistence.TransferTaskTypeDecisionTask:
 		if shouldProcessTask {
-			err = t.processDecisionTask(task)
+			err = t.processDecisionTask(ctx, task)
 		}
 		return metrics.TransferStandbyTaskDecisionScope, err
 
 	case persistence.TransferTaskTypeCloseExecution:
 		// guarantee the processing of workflow execution close
-		err = t.processCloseExecution(task)
+		err = t.processCloseExecution(ctx, task)
 		return metrics.TransferStandbyTaskCloseExecutionScope, err
 
 	case persistence.TransferTaskTypeCancelExecution:
 		if shouldProcessTask {
-			err = t.processCancelExecution(task, lastAttempt)
+			err = t.processCancelExecution(ctx, task, lastAttempt)
 		}
 		return metrics.TransferStandbyTaskCancelExecutionScope, err
 
 	case persistence.TransferTaskTypeSignalExecution:
 		if shouldProcessTask {
-			err = t.processSignalExecution(task, lastAttempt)
+			err = t.processSignalExecution(ctx, task, lastAttempt)
 		}
 		return metrics.TransferStandbyTaskSignalExecutionScope, err
 
 	case persistence.TransferTaskTypeStartChildExecution:
 		if shouldProcessTask {
-			err = t.processStartChildExecution(task, lastAttempt)
+			err = t.processStartChildExecution(ctx, task, lastAttempt)
 		}
 		return metrics.TransferStandbyTaskStartChildExecutionScope, err
 
 	case persistence.TransferTaskTypeRecordWorkflowStarted:
 		if shouldProcessTask {
-			err = t.processRecordWorkflowStarted(task)
+			err = t.processRecordWorkflowStarted(ctx, task)
 		}
 		return metrics.TransferStandbyTaskRecordWorkflowStartedScope, err
 	case persistence.TransferTaskTypeResetWorkflow:
@@ -174,11 +177,11 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo, shouldP
 	}
 }
 
-func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *persistence.TransferTaskInfo) error {
+func (t *transferQueueStandbyProcessorImpl) processActivityTask(ctx context.Context, transferTask *persistence.TransferTaskInfo) error {
 
 	var activityScheduleToStartTimeout *int32
 	processTaskIfClosed := false
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, isPending := msBuilder.GetActivityInfo(transferTask.ScheduleID)
 
 		if !isPending {
@@ -214,12 +217,12 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 	})
 }
 
-func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *persistence.TransferTaskInfo) error {
+func (t *transferQueueStandbyProcessorImpl) processDecisionTask(ctx context.Context, transferTask *persistence.TransferTaskInfo) error {
 	var decisionScheduleToStartTimeout *int32
 	var tasklist *workflow.TaskList
 	processTaskIfClosed := false
 
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		decisionInfo, isPending := msBuilder.GetPendingDecision(transferTask.ScheduleID)
 		if !isPending {
 			return nil
@@ -260,7 +263,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 	})
 }
 
-func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *persistence.TransferTaskInfo) error {
+func (t *transferQueueStandbyProcessorImpl) processCloseExecution(ctx context.Context, transferTask *persistence.TransferTaskInfo) error {
 
 	processTaskIfClosed := true
 	execution := workflow.WorkflowExecution{
@@ -268,7 +271,7 @@ func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *
 		RunId:      common.StringPtr(transferTask.RunID),
 	}
 
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 
 		if msBuilder.IsWorkflowExecutionRunning() {
 			// this can happen if workflow is reset.
@@ -306,17 +309,18 @@ func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *
 		// since event replication should be done by active cluster
 
 		return t.recordWorkflowClosed(
+			ctx,
 			transferTask.DomainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
 			workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, transferTask.GetTaskID(), visibilityMemo, searchAttr,
 		)
 	}, standbyTaskPostActionNoOp) // no op post action, since the entire workflow is finished
 }
 
-func (t *transferQueueStandbyProcessorImpl) processCancelExecution(transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
+func (t *transferQueueStandbyProcessorImpl) processCancelExecution(ctx context.Context, transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(transferTask, nextEventID, t.processCancelExecution)
+		return t.fetchHistoryAndVerifyOnce(ctx, transferTask, nextEventID, t.processCancelExecution)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -325,7 +329,7 @@ func (t *transferQueueStandbyProcessorImpl) processCancelExecution(transferTask
 	}
 
 	processTaskIfClosed := false
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		requestCancelInfo, isPending := msBuilder.GetRequestCancelInfo(transferTask.ScheduleID)
 
 		if !isPending {
@@ -348,11 +352,11 @@ func (t *transferQueueStandbyProcessorImpl) processCancelExecution(transferTask
 	}, postProcessingFn)
 }
 
-func (t *transferQueueStandbyProcessorImpl) processSignalExecution(transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
+func (t *transferQueueStandbyProcessorImpl) processSignalExecution(ctx context.Context, transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(transferTask, nextEventID, t.processSignalExecution)
+		return t.fetchHistoryAndVerifyOnce(ctx, transferTask, nextEventID, t.processSignalExecution)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -361,7 +365,7 @@ func (t *transferQueueStandbyProcessorImpl) processSignalExecution(transferTask
 	}
 
 	processTaskIfClosed := false
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		signalInfo, isPending := msBuilder.GetSignalInfo(transferTask.ScheduleID)
 
 		if !isPending {
@@ -384,11 +388,11 @@ func (t *transferQueueStandbyProcessorImpl) processSignalExecution(transferTask
 	}, postProcessingFn)
 }
 
-func (t *transferQueueStandbyProcessorImpl) processStartChildExecution(transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
+func (t *transferQueueStandbyProcessorImpl) processStartChildExecution(ctx context.Context, transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
 	postProcessingFn := func() error {
-		return t.fetchHistoryAndVerifyOnce(transferTask, nextEventID, t.processStartChildExecution)
+		return t.fetchHistoryAndVerifyOnce(ctx, transferTask, nextEventID, t.processStartChildExecution)
 	}
 	if lastAttempt {
 		postProcessingFn = func() error {
@@ -397,7 +401,7 @@ func (t *transferQueueStandbyProcessorImpl) processStartChildExecution(transferT
 	}
 
 	processTaskIfClosed := false
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		childWorkflowInfo, isPending := msBuilder.GetChildExecutionInfo(transferTask.ScheduleID)
 
 		if !isPending {
@@ -424,7 +428,7 @@ func (t *transferQueueStandbyProcessorImpl) processStartChildExecution(transferT
 	}, postProcessingFn)
 }
 
-func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStarted(transferTask *persistence.TransferTaskInfo) error {
+func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStarted(ctx context.Context, transferTask *persistence.TransferTaskInfo) error {
 	processTaskIfClosed := false
 
 	execution := workflow.WorkflowExecution{
@@ -432,7 +436,7 @@ func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStarted(transfe
 		RunId:      common.StringPtr(transferTask.RunID),
 	}
 
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+	return t.processTransfer(ctx, processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		ok, err := verifyTaskVersion(t.shard, t.logger, transferTask.DomainID, msBuilder.GetStartVersion(), transferTask.Version, transferTask)
 		if err != nil {
 			return err
@@ -452,12 +456,12 @@ func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStarted(transfe
 		visibilityMemo := getVisibilityMemo(startEvent)
 		searchAttr := executionInfo.SearchAttributes
 
-		return t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
+		return t.recordWorkflowStarted(ctx, transferTask.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
 			workflowTimeout, transferTask.GetTaskID(), visibilityMemo, searchAttr)
 	}, standbyTaskPostActionNoOp)
 }
 
-func (t *transferQueueStandbyProcessorImpl) processTransfer(processTaskIfClosed bool, transferTask *persistence.TransferTaskInfo,
+func (t *transferQueueStandbyProcessorImpl) processTransfer(ctx context.Context, processTaskIfClosed bool, transferTask *persistence.TransferTaskInfo,
 	action func(mutableState) error, postAction func() error) (retError error) {
 	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(t.getDomainIDAndWorkflowExecution(transferTask))
 	if err != nil {
@@ -471,7 +475,7 @@ func (t *transferQueueStandbyProcessorImpl) processTransfer(processTaskIfClosed
 		}
 	}()
 
-	msBuilder, err := loadMutableStateForTransferTask(context, transferTask, t.metricsClient, t.logger)
+	msBuilder, err := loadMutableStateForTransferTask(ctx, context, transferTask, t.metricsClient, t.logger)
 	if err != nil {
 		return err
 	} else if msBuilder == nil {
@@ -500,8 +504,8 @@ func (t *transferQueueStandbyProcessorImpl) getDomainIDAndWorkflowExecution(tran
 	}
 }
 
-func (t *transferQueueStandbyProcessorImpl) fetchHistoryAndVerifyOnce(transferTask *persistence.TransferTaskInfo, nextEventID *int64,
-	verifyFn func(*persistence.TransferTaskInfo, bool) error) error {
+func (t *transferQueueStandbyProcessorImpl) fetchHistoryAndVerifyOnce(ctx context.Context, transferTask *persistence.TransferTaskInfo, nextEventID *int64,
+	verifyFn func(context.Context, *persistence.TransferTaskInfo, bool) error) error {
 
 	if nextEventID == nil {
 		return nil
@@ -512,7 +516,7 @@ func (t *transferQueueStandbyProcessorImpl) fetchHistoryAndVerifyOnce(transferTa
 		return ErrTaskDiscarded
 	}
 	lastAttempt := true
-	err = verifyFn(transferTask, lastAttempt)
+	err = verifyFn(ctx, transferTask, lastAttempt)
 	if err != nil {
 		// task still pending, just discard the task
 		return ErrTaskDiscarded
@@ -23,6 +23,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
@@ -30,6 +31,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/errors"
@@ -47,19 +49,23 @@ const (
 type (
 	workflowExecutionContext interface {
 		appendFirstBatchHistoryForContinueAsNew(
+			ctx context.Context,
 			newStateBuilder mutableState,
 			transactionID int64,
 		) error
 		appendFirstBatchEventsForActive(
+			ctx context.Context,
 			msBuilder mutableState,
 			createReplicationTask bool,
 		) (int, persistence.Task, error)
 		appendFirstBatchEventsForStandby(
+			ctx context.Context,
 			msBuilder mutableState,
 			history []*workflow.HistoryEvent,
 		) (int, persistence.Task, error)
 		clear()
 		continueAsNewWorkflowExecution(
+			ctx context.Context,
 			context []byte,
 			newStateBuilder mutableState,
 			transferTasks []persistence.Task,
@@ -67,6 +73,7 @@ type (
 			transactionID int64,
 		) error
 		createWorkflowExecution(
+			ctx context.Context,
 			msBuilder mutableState,
 			sourceCluster string,
 			createReplicationTask bool,
@@ -81,9 +88,10 @@ type (
 		getDomainID() string
 		getExecution() *workflow.WorkflowExecution
 		getLogger() log.Logger
-		loadWorkflowExecution() (mutableState, error)
+		loadWorkflowExecution(ctx context.Context) (mutableState, error)
 		lock(ctx context.Context) error
 		replicateWorkflowExecution(
+			ctx context.Context,
 			request *h.ReplicateEventsRequest,
 			transferTasks []persistence.Task,
 			timerTasks []persistence.Task,
@@ -91,6 +99,7 @@ type (
 			now time.Time,
 		) error
 		resetMutableState(
+			ctx context.Context,
 			prevRunID string,
 			prevLastWriteVersion int64,
 			prevState int,
@@ -100,6 +109,7 @@ type (
 			resetBuilder mutableState,
 		) (mutableState, error)
 		resetWorkflowExecution(
+			ctx context.Context,
 			currMutableState mutableState,
 			updateCurr bool,
 			closeTask persistence.Task,
@@ -114,11 +124,13 @@ type (
 			prevRunVersion int64,
 		) (retError error)
 		scheduleNewDecision(
+			ctx context.Context,
 			transferTasks []persistence.Task,
 			timerTasks []persistence.Task,
 		) ([]persistence.Task, []persistence.Task, error)
 		unlock()
 		updateWorkflowExecutionForStandby(
+			ctx context.Context,
 			transferTasks []persistence.Task,
 			timerTasks []persistence.Task,
 			transactionID int64,
@@ -128,11 +140,13 @@ type (
 			sourceCluster string,
 		) error
 		updateWorkflowExecution(
+			ctx context.Context,
 			transferTasks []persistence.Task,
 			timerTasks []persistence.Task,
 			transactionID int64,
 		) error
 		updateWorkflowExecutionWithContext(
+			ctx context.Context,
 			context []byte,
 			transferTasks []persistence.Task,
 			timerTasks []persistence.Task,
@@ -212,8 +226,8 @@ func (c *workflowExecutionContextImpl) getLogger() log.Logger {
 	return c.logger
 }
 
-func (c *workflowExecutionContextImpl) loadWorkflowExecution() (mutableState, error) {
-	err := c.loadWorkflowExecutionInternal()
+func (c *workflowExecutionContextImpl) loadWorkflowExecution(ctx context.Context) (mutableState, error) {
+	err := c.loadWorkflowExecutionInternal(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -224,12 +238,12 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecution() (mutableState, er
 	return c.msBuilder, nil
 }
 
-func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
+func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal(ctx context.Context) error {
 	if c.msBuilder != nil {
 		return nil
 	}
 
-	response, err := c.getWorkflowExecutionWithRetry(&persistence.GetWorkflowExecutionRequest{
+	response, err := c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
 		DomainID:  c.domainID,
 		Execution: c.workflowExecution,
 	})
@@ -246,6 +260,9 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 		c.shard.GetEventsCache(), c.logger)
 	if response != nil && response.State != nil {
 		state := response.State
+		if err := c.verifyChecksum(state); err != nil {
+			return err
+		}
 		msBuilder.Load(state)
 		info := state.ExecutionInfo
 		c.updateCondition = info.NextEventID
@@ -258,6 +275,7 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 }
 
 func (c *workflowExecutionContextImpl) createWorkflowExecution(
+	ctx context.Context,
 	msBuilder mutableState,
 	sourceCluster string,
 	createReplicationTask bool,
@@ -337,13 +355,16 @@ func (c *workflowExecutionContextImpl) createWorkflowExecution(
 		CreateWorkflowMode:       createMode,
 		PreviousRunID:            prevRunID,
 		PreviousLastWriteVersion: prevLastWriteVersion,
+
+		Checksum: c.generateChecksum(msBuilder),
 	}
 
-	_, err := c.shard.CreateWorkflowExecution(createRequest)
+	_, err := c.shard.CreateWorkflowExecution(ctx, createRequest)
 	return err
 }
 
 func (c *workflowExecutionContextImpl) resetMutableState(
+	ctx context.Context,
 	prevRunID string,
 	prevLastWriteVersion int64,
 	prevState int,
@@ -363,14 +384,15 @@ func (c *workflowExecutionContextImpl) resetMutableState(
 		timerTasks,
 	)
 	snapshotRequest.Condition = c.updateCondition
+	snapshotRequest.Checksum = c.generateChecksum(resetBuilder)
 
-	err := c.shard.ResetMutableState(snapshotRequest)
+	err := c.shard.ResetMutableState(ctx, snapshotRequest)
 	if err != nil {
 		return nil, err
 	}
 
 	c.clear()
-	return c.loadWorkflowExecution()
+	return c.loadWorkflowExecution(ctx)
 }
 
 // this reset is more complex than "resetMutableState", it involes currentMutableState and newMutableState:
@@ -378,6 +400,7 @@ func (c *workflowExecutionContextImpl) resetMutableState(
 // 2. append history to current run if current run is not closed
 // 3. update mutableState(terminate current run if not closed) and create new run
 func (c *workflowExecutionContextImpl) resetWorkflowExecution(
+	ctx context.Context,
 	currMutableState mutableState,
 	updateCurr bool,
 	closeTask, cleanupTask persistence.Task,
@@ -433,7 +456,7 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 		var size int
 		// TODO workflow execution reset logic generates replication tasks in its own business logic
 		// should use append history events in the future
-		size, _, retError = c.appendHistoryEvents(hBuilder.GetHistory().GetEvents(), transactionID, true, false, nil)
+		size, _, retError = c.appendHistoryEvents(ctx, hBuilder.GetHistory().GetEvents(), transactionID, true, false, nil)
 		if retError != nil {
 			return
 		}
@@ -442,7 +465,7 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 
 	// Note: we already made sure that newMutableState is using eventsV2
 	hBuilder := newMutableState.GetHistoryBuilder()
-	size, retError := c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+	size, retError := c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 		IsNewBranch:   false,
 		BranchToken:   newMutableState.GetCurrentBranch(),
 		Events:        hBuilder.GetHistory().GetEvents(),
@@ -495,10 +518,11 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 		InsertRequestCancelInfos: snapshotRequest.InsertRequestCancelInfos,
 	}
 
-	return c.shard.ResetWorkflowExecution(resetWFReq)
+	return c.shard.ResetWorkflowExecution(ctx, resetWFReq)
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithContext(
+	ctx context.Context,
 	context []byte,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
@@ -506,10 +530,11 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithContext(
 ) error {
 
 	c.msBuilder.GetExecutionInfo().ExecutionContext = context
-	return c.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+	return c.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID)
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRunAndContext(
+	ctx context.Context,
 	context []byte,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
@@ -518,10 +543,11 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRunAndConte
 ) error {
 
 	c.msBuilder.GetExecutionInfo().ExecutionContext = context
-	return c.updateWorkflowExecutionForActive(transferTasks, timerTasks, transactionID, newStateBuilder)
+	return c.updateWorkflowExecutionForActive(ctx, transferTasks, timerTasks, transactionID, newStateBuilder)
 }
 
 func (c *workflowExecutionContextImpl) replicateWorkflowExecution(
+	ctx context.Context,
 	request *h.ReplicateEventsRequest,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
@@ -539,6 +565,7 @@ func (c *workflowExecutionContextImpl) replicateWorkflowExecution(
 
 	standbyHistoryBuilder := newHistoryBuilderFromEvents(request.History.Events, c.logger)
 	return c.updateWorkflowExecutionForStandby(
+		ctx,
 		transferTasks,
 		timerTasks,
 		transactionID,
@@ -569,6 +596,7 @@ func (c *workflowExecutionContextImpl) updateVersion() error {
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionForActive(
+	ctx context.Context,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	transactionID int64,
@@ -605,7 +633,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionForActive(
 				}
 
 				var transT, timerT []persistence.Task
-				transT, timerT, err := c.scheduleNewDecision(transT, timerT)
+				transT, timerT, err := c.scheduleNewDecision(ctx, transT, timerT)
 				if err != nil {
 					return err
 				}
@@ -650,6 +678,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionForActive(
 
 	now := c.timeSource.Now()
 	return c.update(
+		ctx,
 		transferTasks,
 		timerTasks,
 		transactionID,
@@ -662,14 +691,16 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionForActive(
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecution(
+	ctx context.Context,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	transactionID int64,
 ) error {
-	return c.updateWorkflowExecutionForActive(transferTasks, timerTasks, transactionID, nil)
+	return c.updateWorkflowExecutionForActive(ctx, transferTasks, timerTasks, transactionID, nil)
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionForStandby(
+	ctx context.Context,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	transactionID int64,
@@ -680,6 +711,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionForStandby(
 ) (errRet error) {
 
 	return c.update(
+		ctx,
 		transferTasks,
 		timerTasks,
 		transactionID,
@@ -692,6 +724,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionForStandby(
 }
 
 func (c *workflowExecutionContextImpl) update(
+	ctx context.Context,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	transactionID int64,
@@ -713,7 +746,7 @@ func (c *workflowExecutionContextImpl) update(
 	updates, err := c.msBuilder.CloseUpdateSession()
 	if err != nil {
 		if err == ErrBufferedEventsLimitExceeded {
-			if err1 := c.failInflightDecision(); err1 != nil {
+			if err1 := c.failInflightDecision(ctx); err1 != nil {
 				return err1
 			}
 
@@ -773,7 +806,7 @@ func (c *workflowExecutionContextImpl) update(
 	if hasNewStandbyHistoryEvents {
 		firstEvent := standbyHistoryBuilder.GetFirstEvent()
 		// Note: standby events has no transient decision events
-		newHistorySize, _, err = c.appendHistoryEvents(standbyHistoryBuilder.history, transactionID, true, false, nil)
+		newHistorySize, _, err = c.appendHistoryEvents(ctx, standbyHistoryBuilder.history, transactionID, true, false, nil)
 		if err != nil {
 			return err
 		}
@@ -788,7 +821,7 @@ func (c *workflowExecutionContextImpl) update(
 		// Transient decision events need to be written as a separate batch
 		if activeHistoryBuilder.HasTransientEvents() {
 			// transient decision events batch should not perform last event check
-			newHistorySize, newReplicationTask, err = c.appendHistoryEvents(activeHistoryBuilder.transientHistory, transactionID, false, createReplicationTask, newStateBuilder)
+			newHistorySize, newReplicationTask, err = c.appendHistoryEvents(ctx, activeHistoryBuilder.transientHistory, transactionID, false, createReplicationTask, newStateBuilder)
 			if err != nil {
 				return err
 			}
@@ -799,7 +832,7 @@ func (c *workflowExecutionContextImpl) update(
 		}
 
 		var size int
-		size, newReplicationTask, err = c.appendHistoryEvents(activeHistoryBuilder.history, transactionID, true, createReplicationTask, newStateBuilder)
+		size, newReplicationTask, err = c.appendHistoryEvents(ctx, activeHistoryBuilder.history, transactionID, true, createReplicationTask, newStateBuilder)
 		if err != nil {
 			return err
 		}
@@ -843,8 +876,8 @@ func (c *workflowExecutionContextImpl) update(
 			countLimitError := config.HistoryCountLimitError(executionInfo.DomainID)
 			if (historySize > sizeLimitError || historyCount > countLimitError) && c.msBuilder.IsWorkflowExecutionRunning() {
 				// hard terminate workflow if it is still running
-				c.clear()                            // discard pending changes
-				_, err1 := c.loadWorkflowExecution() // reload mutable state
+				c.clear()                               // discard pending changes
+				_, err1 := c.loadWorkflowExecution(ctx) // reload mutable state
 				if err1 != nil {
 					return err1
 				}
@@ -878,7 +911,7 @@ func (c *workflowExecutionContextImpl) update(
 				if err1 != nil {
 					return err1
 				}
-				newHistorySize, newReplicationTask, err = c.appendHistoryEvents(activeHistoryBuilder.history, terminateTransactionID, true, createReplicationTask, newStateBuilder)
+				newHistorySize, newReplicationTask, err = c.appendHistoryEvents(ctx, activeHistoryBuilder.history, terminateTransactionID, true, createReplicationTask, newStateBuilder)
 				if err != nil {
 					return err
 				}
@@ -916,7 +949,7 @@ func (c *workflowExecutionContextImpl) update(
 
 	var resp *persistence.UpdateWorkflowExecutionResponse
 	var err1 error
-	if resp, err1 = c.updateWorkflowExecutionWithRetry(&persistence.UpdateWorkflowExecutionRequest{
+	if resp, err1 = c.updateWorkflowExecutionWithRetry(ctx, &persistence.UpdateWorkflowExecutionRequest{
 		ExecutionInfo:                 executionInfo,
 		ReplicationState:              c.msBuilder.GetReplicationState(),
 		TransferTasks:                 transferTasks,
@@ -940,6 +973,7 @@ func (c *workflowExecutionContextImpl) update(
 		NewBufferedReplicationTask:    updates.newBufferedReplicationEventsInfo,
 		DeleteBufferedReplicationTask: updates.deleteBufferedReplicationEvent,
 		ContinueAsNew:                 continueAsNew,
+		Checksum:                      c.generateChecksum(c.msBuilder),
 	}); err1 != nil {
 		switch err1.(type) {
 		case *persistence.ConditionFailedError:
@@ -982,6 +1016,7 @@ func (c *workflowExecutionContextImpl) update(
 }
 
 func (c *workflowExecutionContextImpl) appendFirstBatchEventsForActive(
+	ctx context.Context,
 	msBuilder mutableState,
 	createReplicationTask bool,
 ) (int, persistence.Task, error) {
@@ -993,18 +1028,20 @@ func (c *workflowExecutionContextImpl) appendFirstBatchEventsForActive(
 		return 0, nil, err
 	}
 	events := msBuilder.GetHistoryBuilder().GetHistory().Events
-	return c.appendFirstBatchEvents(msBuilder, events, createReplicationTask)
+	return c.appendFirstBatchEvents(ctx, msBuilder, events, createReplicationTask)
 }
 
 func (c *workflowExecutionContextImpl) appendFirstBatchEventsForStandby(
+	ctx context.Context,
 	msBuilder mutableState,
 	history []*workflow.HistoryEvent,
 ) (int, persistence.Task, error) {
 
-	return c.appendFirstBatchEvents(msBuilder, history, false)
+	return c.appendFirstBatchEvents(ctx, msBuilder, history, false)
 }
 
 func (c *workflowExecutionContextImpl) appendFirstBatchEvents(
+	ctx context.Context,
 	msBuilder mutableState,
 	history []*workflow.HistoryEvent,
 	replicateEvents bool,
@@ -1016,7 +1053,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchEvents(
 	var err error
 
 	if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-		historySize, err = c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch: true,
 			Info: historyGarbageCleanupInfo(
 				c.domainID,
@@ -1030,7 +1067,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchEvents(
 			TransactionID: 0,
 		}, c.domainID, c.workflowExecution)
 	} else {
-		historySize, err = c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		historySize, err = c.shard.AppendHistoryEvents(ctx, &persistence.AppendHistoryEventsRequest{
 			DomainID:  c.domainID,
 			Execution: c.workflowExecution,
 			// It is ok to use 0 for TransactionID because RunID is unique so there are
@@ -1062,6 +1099,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchEvents(
 }
 
 func (c *workflowExecutionContextImpl) appendHistoryEvents(
+	ctx context.Context,
 	history []*workflow.HistoryEvent,
 	transactionID int64,
 	doLastEventValidation bool,
@@ -1081,14 +1119,14 @@ func (c *workflowExecutionContextImpl) appendHistoryEvents(
 	var err error
 
 	if c.msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-		historySize, err = c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch:   false,
 			BranchToken:   c.msBuilder.GetCurrentBranch(),
 			Events:        history,
 			TransactionID: transactionID,
 		}, c.domainID, c.workflowExecution)
 	} else {
-		historySize, err = c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		historySize, err = c.shard.AppendHistoryEvents(ctx, &persistence.AppendHistoryEventsRequest{
 			DomainID:          c.domainID,
 			Execution:         c.workflowExecution,
 			TransactionID:     transactionID,
@@ -1135,6 +1173,7 @@ func (c *workflowExecutionContextImpl) appendHistoryEvents(
 }
 
 func (c *workflowExecutionContextImpl) continueAsNewWorkflowExecution(
+	ctx context.Context,
 	context []byte,
 	newStateBuilder mutableState,
 	transferTasks []persistence.Task,
@@ -1142,12 +1181,12 @@ func (c *workflowExecutionContextImpl) continueAsNewWorkflowExecution(
 	transactionID int64,
 ) error {
 
-	err1 := c.appendFirstBatchHistoryForContinueAsNew(newStateBuilder, transactionID)
+	err1 := c.appendFirstBatchHistoryForContinueAsNew(ctx, newStateBuilder, transactionID)
 	if err1 != nil {
 		return err1
 	}
 
-	err2 := c.updateWorkflowExecutionWithNewRunAndContext(context, transferTasks, timerTasks, transactionID, newStateBuilder)
+	err2 := c.updateWorkflowExecutionWithNewRunAndContext(ctx, context, transferTasks, timerTasks, transactionID, newStateBuilder)
 	if err2 != nil {
 		// TODO: Delete new execution if update fails due to conflict or shard being lost
 	}
@@ -1156,6 +1195,7 @@ func (c *workflowExecutionContextImpl) continueAsNewWorkflowExecution(
 }
 
 func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(
+	ctx context.Context,
 	newStateBuilder mutableState,
 	transactionID int64,
 ) error {
@@ -1172,7 +1212,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(
 	var historySize int
 	var err error
 	if newStateBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-		historySize, err = c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch:   true,
 			Info:          historyGarbageCleanupInfo(domainID, newExecution.GetWorkflowId(), newExecution.GetRunId()),
 			BranchToken:   newStateBuilder.GetCurrentBranch(),
@@ -1180,7 +1220,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(
 			TransactionID: transactionID,
 		}, newStateBuilder.GetExecutionInfo().DomainID, newExecution)
 	} else {
-		historySize, err = c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		historySize, err = c.shard.AppendHistoryEvents(ctx, &persistence.AppendHistoryEventsRequest{
 			DomainID:          domainID,
 			Execution:         newExecution,
 			TransactionID:     transactionID,
@@ -1200,13 +1240,14 @@ func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(
 }
 
 func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(
+	ctx context.Context,
 	request *persistence.GetWorkflowExecutionRequest,
 ) (*persistence.GetWorkflowExecutionResponse, error) {
 
 	var response *persistence.GetWorkflowExecutionResponse
 	op := func() error {
 		var err error
-		response, err = c.executionManager.GetWorkflowExecution(request)
+		response, err = c.executionManager.GetWorkflowExecution(ctx, request)
 
 		return err
 	}
@@ -1220,13 +1261,14 @@ func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithRetry(
+	ctx context.Context,
 	request *persistence.UpdateWorkflowExecutionRequest,
 ) (*persistence.UpdateWorkflowExecutionResponse, error) {
 
 	resp := &persistence.UpdateWorkflowExecutionResponse{}
 	op := func() error {
 		var err error
-		resp, err = c.shard.UpdateWorkflowExecution(request)
+		resp, err = c.shard.UpdateWorkflowExecution(ctx, request)
 		return err
 	}
 
@@ -1245,11 +1287,12 @@ func (c *workflowExecutionContextImpl) clear() {
 // caller to assign returned slice to original passed in slices.  For this reason we return the original slices
 // even if the method fails due to an error on loading workflow execution.
 func (c *workflowExecutionContextImpl) scheduleNewDecision(
+	ctx context.Context,
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 ) ([]persistence.Task, []persistence.Task, error) {
 
-	msBuilder, err := c.loadWorkflowExecution()
+	msBuilder, err := c.loadWorkflowExecution(ctx)
 	if err != nil {
 		return transferTasks, timerTasks, err
 	}
@@ -1276,11 +1319,11 @@ func (c *workflowExecutionContextImpl) scheduleNewDecision(
 	return transferTasks, timerTasks, nil
 }
 
-func (c *workflowExecutionContextImpl) failInflightDecision() error {
+func (c *workflowExecutionContextImpl) failInflightDecision(ctx context.Context) error {
 	c.clear()
 
 	// Reload workflow execution so we can apply the decision task failure event
-	msBuilder, err := c.loadWorkflowExecution()
+	msBuilder, err := c.loadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
@@ -1301,7 +1344,7 @@ func (c *workflowExecutionContextImpl) failInflightDecision() error {
 		}
 
 		var transT, timerT []persistence.Task
-		transT, timerT, err = c.scheduleNewDecision(transT, timerT)
+		transT, timerT, err = c.scheduleNewDecision(ctx, transT, timerT)
 		if err != nil {
 			return err
 		}
@@ -1311,7 +1354,7 @@ func (c *workflowExecutionContextImpl) failInflightDecision() error {
 		if err != nil {
 			return err
 		}
-		err = c.updateWorkflowExecution(transT, timerT, transactionID)
+		err = c.updateWorkflowExecution(ctx, transT, timerT, transactionID)
 		if err != nil {
 			return err
 		}
@@ -1319,6 +1362,64 @@ func (c *workflowExecutionContextImpl) failInflightDecision() error {
 	return nil
 }
 
+func (c *workflowExecutionContextImpl) generateChecksum(
+	msBuilder mutableState,
+) checksum.Checksum {
+
+	probability := c.shard.GetConfig().MutableStateChecksumGenProbability(c.getDomainName())
+	if rand.Intn(100) >= probability {
+		return checksum.Checksum{}
+	}
+	return generateMutableStateChecksum(msBuilder.CopyToPersistence())
+}
+
+func (c *workflowExecutionContextImpl) verifyChecksum(
+	state *persistence.WorkflowMutableState,
+) error {
+
+	if state.Checksum.IsEmpty() {
+		return nil
+	}
+
+	config := c.shard.GetConfig()
+	domain := c.getDomainName()
+	if rand.Intn(100) >= config.MutableStateChecksumVerifyProbability(domain) {
+		return nil
+	}
+
+	err := verifyMutableStateChecksum(state, state.Checksum)
+	if err == nil {
+		return nil
+	}
+
+	c.metricsClient.Scope(metrics.WorkflowContextScope, metrics.DomainTag(domain)).IncCounter(metrics.MutableStateChecksumMismatch)
+	mode := config.MutableStateChecksumVerifyMode(domain)
+	if mode == mutableStateChecksumVerifyModeMetric {
+		return nil
+	}
+
+	c.logger.Error("Mutable state checksum mismatch",
+		tag.WorkflowDomainID(c.domainID),
+		tag.WorkflowID(c.workflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(c.workflowExecution.GetRunId()),
+		tag.WorkflowNextEventID(state.ExecutionInfo.NextEventID),
+		tag.Error(err))
+	if mode == mutableStateChecksumVerifyModeFail {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("mutable state checksum mismatch: %v", err),
+		}
+	}
+	return nil
+}
+
+func (c *workflowExecutionContextImpl) getDomainName() string {
+	domain := ""
+	if entry, err := c.shard.GetDomainCache().GetDomainByID(c.domainID); err == nil && entry != nil && entry.GetInfo() != nil {
+		domain = entry.GetInfo().Name
+	}
+	return domain
+}
+
 func (c *workflowExecutionContextImpl) emitWorkflowExecutionStats(
 	stats *persistence.MutableStateStats,
 	executionInfoHistorySize int64,
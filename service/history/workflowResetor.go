@@ -109,7 +109,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 	// complete the fork process at the end, it is OK even if this defer fails, because our timer task can still clean up correctly
 	defer func() {
 		if newMutableState != nil && len(newMutableState.GetExecutionInfo().GetCurrentBranch()) > 0 {
-			w.eng.historyV2Mgr.CompleteForkBranch(&persistence.CompleteForkBranchRequest{
+			w.eng.historyV2Mgr.CompleteForkBranch(ctx, &persistence.CompleteForkBranchRequest{
 				BranchToken: newMutableState.GetExecutionInfo().GetCurrentBranch(),
 				Success:     retError == nil || persistence.IsTimeoutError(retError),
 				ShardID:     common.IntPtr(w.eng.shard.GetShardID()),
@@ -132,6 +132,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 
 	// finally, write to persistence
 	retError = currContext.resetWorkflowExecution(
+		ctx,
 		currMutableState, currTerminated, currCloseTask, currCleanupTask,
 		newMutableState, newTransferTasks, newTimerTasks,
 		currReplicationTasks, newReplicationTasks, baseMutableState.GetExecutionInfo().RunID,
@@ -259,6 +260,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 
 	// replay history to reset point(exclusive) to rebuild mutableState
 	forkEventVersion, wfTimeoutSecs, receivedSignals, continueRunID, newStateBuilder, retError := w.replayHistoryEvents(
+		ctx,
 		resetDecisionCompletedEventID, requestedID, baseMutableState, newRunID,
 	)
 	if retError != nil {
@@ -331,7 +333,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	)
 
 	// fork a new history branch
-	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(&persistence.ForkHistoryBranchRequest{
+	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(ctx, &persistence.ForkHistoryBranchRequest{
 		ForkBranchToken: baseMutableState.GetCurrentBranch(),
 		ForkNodeID:      resetDecisionCompletedEventID,
 		Info:            historyGarbageCleanupInfo(domainID, workflowID, newRunID),
@@ -456,7 +458,7 @@ func (w *workflowResetorImpl) replayReceivedSignals(
 			if err != nil {
 				return err
 			}
-			continueMutableState, err = continueContext.loadWorkflowExecution()
+			continueMutableState, err = continueContext.loadWorkflowExecution(ctx)
 			if err != nil {
 				return err
 			}
@@ -476,7 +478,7 @@ func (w *workflowResetorImpl) replayReceivedSignals(
 		}
 		for {
 			var readResp *persistence.ReadHistoryBranchByBatchResponse
-			readResp, err := w.eng.historyV2Mgr.ReadHistoryBranchByBatch(readReq)
+			readResp, err := w.eng.historyV2Mgr.ReadHistoryBranchByBatch(ctx, readReq)
 			if err != nil {
 				return err
 			}
@@ -551,6 +553,7 @@ func getRespondActivityTaskFailedRequestFromActivity(ai *persistence.ActivityInf
 
 // TODO: @shreyassrivatsan reduce the number of return parameters from this method or return a struct
 func (w *workflowResetorImpl) replayHistoryEvents(
+	ctx context.Context,
 	decisionFinishEventID int64,
 	requestID string,
 	prevMutableState mutableState,
@@ -578,7 +581,7 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 
 	for {
 		var readResp *persistence.ReadHistoryBranchByBatchResponse
-		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(readReq)
+		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(ctx, readReq)
 		if retError != nil {
 			return
 		}
@@ -724,7 +727,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 		return baseErr
 	}
 	defer func() { baseRelease(retError) }()
-	baseMutableState, retError = baseContext.loadWorkflowExecution()
+	baseMutableState, retError = baseContext.loadWorkflowExecution(ctx)
 	if retError != nil {
 		return retError
 	}
@@ -748,21 +751,21 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 			return currErr
 		}
 		defer func() { currRelease(retError) }()
-		currMutableState, retError = currContext.loadWorkflowExecution()
+		currMutableState, retError = currContext.loadWorkflowExecution(ctx)
 		if retError != nil {
 			return retError
 		}
 	}
 	// before changing mutable state
 	prevRunVersion := currMutableState.GetLastWriteVersion()
-	newMsBuilder, newRunTransferTasks, newRunTimerTasks, retError = w.replicateResetEvent(baseMutableState, &baseExecution, historyAfterReset, resetAttr.GetForkEventVersion())
+	newMsBuilder, newRunTransferTasks, newRunTimerTasks, retError = w.replicateResetEvent(ctx, baseMutableState, &baseExecution, historyAfterReset, resetAttr.GetForkEventVersion())
 	if retError != nil {
 		return retError
 	}
 
 	// fork a new history branch
 	shardID := common.IntPtr(w.eng.shard.GetShardID())
-	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(&persistence.ForkHistoryBranchRequest{
+	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(ctx, &persistence.ForkHistoryBranchRequest{
 		ForkBranchToken: baseMutableState.GetCurrentBranch(),
 		ForkNodeID:      decisionFinishEventID,
 		Info:            historyGarbageCleanupInfo(domainID, workflowID, resetAttr.GetNewRunId()),
@@ -772,7 +775,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 		return retError
 	}
 	defer func() {
-		w.eng.historyV2Mgr.CompleteForkBranch(&persistence.CompleteForkBranchRequest{
+		w.eng.historyV2Mgr.CompleteForkBranch(ctx, &persistence.CompleteForkBranchRequest{
 			BranchToken: newMsBuilder.GetExecutionInfo().GetCurrentBranch(),
 			Success:     retError == nil || persistence.IsTimeoutError(retError),
 			ShardID:     shardID,
@@ -786,6 +789,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 	newMsBuilder.SetHistoryBuilder(hBuilder)
 
 	retError = currContext.resetWorkflowExecution(
+		ctx,
 		currMutableState,
 		false,
 		nil,
@@ -809,6 +813,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 
 // TODO: @shreyassrivatsan reduce number of return parameters from this method
 func (w *workflowResetorImpl) replicateResetEvent(
+	ctx context.Context,
 	baseMutableState mutableState,
 	baseExecution *workflow.WorkflowExecution,
 	newRunHistory []*workflow.HistoryEvent,
@@ -839,7 +844,7 @@ func (w *workflowResetorImpl) replicateResetEvent(
 	}
 	for {
 		var readResp *persistence.ReadHistoryBranchByBatchResponse
-		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(readReq)
+		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(ctx, readReq)
 		if retError != nil {
 			return
 		}
@@ -37,6 +37,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -143,6 +144,7 @@ func (s *resetorSuite) SetupTest() {
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 		standbyClusterCurrentTime: map[string]time.Time{},
 		timeSource:                clock.NewRealTimeSource(),
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 	}
 
 	historyCache := newHistoryCache(mockShard)
@@ -747,16 +749,16 @@ func (s *resetorSuite) TestResetWorkflowExecution_NoReplication() {
 		Size: 200,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(appendV1Resp, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(appendV2Resp, nil).Once()
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything, mock.Anything).Return(appendV1Resp, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -1431,11 +1433,11 @@ func (s *resetorSuite) TestResetWorkflowExecution_NoReplication_WithRequestCance
 		}
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything).Return(nil).Maybe()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, mock.Anything).Return(nil).Maybe()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	_, err = s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -2049,16 +2051,16 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_WithTerminatingCur
 		Size: 200,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(appendV1Resp, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(appendV2Resp, nil).Once()
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything, mock.Anything).Return(appendV1Resp, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -2759,12 +2761,12 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_NotActive() {
 		ShardID:     common.IntPtr(s.shardID),
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Once()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	_, err = s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -3376,15 +3378,15 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_NoTerminatingCurre
 		Size: 200,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(appendV2Resp, nil).Once()
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
 	s.Nil(err)
 	s.NotNil(response.RunId)
@@ -4091,14 +4093,14 @@ func (s *resetorSuite) TestApplyReset() {
 		History:           historyAfterReset,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", forkReq).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", appendV2Req).Return(appendV2Resp, nil).Once()
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, forkReq).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, appendV2Req).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	err := s.resetor.ApplyResetEvent(context.Background(), request, domainID, wid, currRunID)
 	s.Nil(err)
 
@@ -21,6 +21,7 @@
 package matching
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 
@@ -53,10 +54,10 @@ type (
 //
 // This class will serialize writes to persistence that do condition updates. There are
 // two reasons for doing this:
-// - To work around known Cassandra issue where concurrent LWT to the same partition cause timeout errors
-// - To provide the guarantee that there is only writer who updates taskList in persistence at any given point in time
-//   This guarantee makes some of the other code simpler and there is no impact to perf because updates to tasklist are
-//   spread out and happen in background routines
+//   - To work around known Cassandra issue where concurrent LWT to the same partition cause timeout errors
+//   - To provide the guarantee that there is only writer who updates taskList in persistence at any given point in time
+//     This guarantee makes some of the other code simpler and there is no impact to perf because updates to tasklist are
+//     spread out and happen in background routines
 func newTaskListDB(store persistence.TaskManager, domainID string, name string, taskType int, kind int, logger log.Logger) *taskListDB {
 	return &taskListDB{
 		domainID:     domainID,
@@ -77,10 +78,10 @@ func (db *taskListDB) RangeID() int64 {
 
 // RenewLease renews the lease on a tasklist. If there is no previous lease,
 // this method will attempt to steal tasklist from current owner
-func (db *taskListDB) RenewLease() (taskListState, error) {
+func (db *taskListDB) RenewLease(ctx context.Context) (taskListState, error) {
 	db.Lock()
 	defer db.Unlock()
-	resp, err := db.store.LeaseTaskList(&persistence.LeaseTaskListRequest{
+	resp, err := db.store.LeaseTaskList(ctx, &persistence.LeaseTaskListRequest{
 		DomainID:     db.domainID,
 		TaskList:     db.taskListName,
 		TaskType:     db.taskType,
@@ -96,10 +97,10 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 }
 
 // UpdateState updates the taskList state with the given value
-func (db *taskListDB) UpdateState(ackLevel int64) error {
+func (db *taskListDB) UpdateState(ctx context.Context, ackLevel int64) error {
 	db.Lock()
 	defer db.Unlock()
-	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+	_, err := db.store.UpdateTaskList(ctx, &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
 			DomainID: db.domainID,
 			Name:     db.taskListName,
@@ -116,10 +117,10 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 }
 
 // CreateTasks creates a batch of given tasks for this task list
-func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
+func (db *taskListDB) CreateTasks(ctx context.Context, tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
-	return db.store.CreateTasks(&persistence.CreateTasksRequest{
+	return db.store.CreateTasks(ctx, &persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
 			DomainID: db.domainID,
 			Name:     db.taskListName,
@@ -133,8 +134,8 @@ func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persist
 }
 
 // GetTasks returns a batch of tasks between the given range
-func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
-	return db.store.GetTasks(&persistence.GetTasksRequest{
+func (db *taskListDB) GetTasks(ctx context.Context, minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
+	return db.store.GetTasks(ctx, &persistence.GetTasksRequest{
 		DomainID:     db.domainID,
 		TaskList:     db.taskListName,
 		TaskType:     db.taskType,
@@ -145,8 +146,8 @@ func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int)
 }
 
 // CompleteTask deletes a single task from this task list
-func (db *taskListDB) CompleteTask(taskID int64) error {
-	err := db.store.CompleteTask(&persistence.CompleteTaskRequest{
+func (db *taskListDB) CompleteTask(ctx context.Context, taskID int64) error {
+	err := db.store.CompleteTask(ctx, &persistence.CompleteTaskRequest{
 		TaskList: &persistence.TaskListInfo{
 			DomainID: db.domainID,
 			Name:     db.taskListName,
@@ -168,8 +169,8 @@ func (db *taskListDB) CompleteTask(taskID int64) error {
 // CompleteTasksLessThan deletes of tasks less than the given taskID. Limit is
 // the upper bound of number of tasks that can be deleted by this method. It may
 // or may not be honored
-func (db *taskListDB) CompleteTasksLessThan(taskID int64, limit int) (int, error) {
-	n, err := db.store.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+func (db *taskListDB) CompleteTasksLessThan(ctx context.Context, taskID int64, limit int) (int, error) {
+	n, err := db.store.CompleteTasksLessThan(ctx, &persistence.CompleteTasksLessThanRequest{
 		DomainID:     db.domainID,
 		TaskListName: db.taskListName,
 		TaskType:     db.taskType,
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
+	"github.com/uber/cadence/common/tracing"
 )
 
 var _ matchingserviceserver.Interface = (*Handler)(nil)
@@ -119,6 +120,8 @@ func (h *Handler) startRequestProfile(api string, scope int) tally.Stopwatch {
 
 // AddActivityTask - adds an activity task.
 func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivityTaskRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.AddActivityTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	startT := time.Now()
 	scope := metrics.MatchingAddActivityTaskScope
@@ -139,6 +142,8 @@ func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivity
 
 // AddDecisionTask - adds a decision task.
 func (h *Handler) AddDecisionTask(ctx context.Context, addRequest *m.AddDecisionTaskRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.AddDecisionTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	startT := time.Now()
 	scope := metrics.MatchingAddDecisionTaskScope
@@ -159,6 +164,8 @@ func (h *Handler) AddDecisionTask(ctx context.Context, addRequest *m.AddDecision
 // PollForActivityTask - long poll for an activity task.
 func (h *Handler) PollForActivityTask(ctx context.Context,
 	pollRequest *m.PollForActivityTaskRequest) (resp *gen.PollForActivityTaskResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.PollForActivityTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 
 	scope := metrics.MatchingPollForActivityTaskScope
@@ -180,6 +187,8 @@ func (h *Handler) PollForActivityTask(ctx context.Context,
 // PollForDecisionTask - long poll for a decision task.
 func (h *Handler) PollForDecisionTask(ctx context.Context,
 	pollRequest *m.PollForDecisionTaskRequest) (resp *m.PollForDecisionTaskResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.PollForDecisionTask")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 
 	scope := metrics.MatchingPollForDecisionTaskScope
@@ -201,6 +210,8 @@ func (h *Handler) PollForDecisionTask(ctx context.Context,
 // QueryWorkflow queries a given workflow synchronously and return the query result.
 func (h *Handler) QueryWorkflow(ctx context.Context,
 	queryRequest *m.QueryWorkflowRequest) (resp *gen.QueryWorkflowResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.QueryWorkflow")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	scope := metrics.MatchingQueryWorkflowScope
 	sw := h.startRequestProfile("QueryWorkflow", scope)
@@ -216,6 +227,8 @@ func (h *Handler) QueryWorkflow(ctx context.Context,
 
 // RespondQueryTaskCompleted responds a query task completed
 func (h *Handler) RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.RespondQueryTaskCompleted")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	scope := metrics.MatchingRespondQueryTaskCompletedScope
 	sw := h.startRequestProfile("RespondQueryTaskCompleted", scope)
@@ -231,6 +244,8 @@ func (h *Handler) RespondQueryTaskCompleted(ctx context.Context, request *m.Resp
 // CancelOutstandingPoll is used to cancel outstanding pollers
 func (h *Handler) CancelOutstandingPoll(ctx context.Context,
 	request *m.CancelOutstandingPollRequest) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.CancelOutstandingPoll")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	scope := metrics.MatchingCancelOutstandingPollScope
 	sw := h.startRequestProfile("CancelOutstandingPoll", scope)
@@ -247,6 +262,8 @@ func (h *Handler) CancelOutstandingPoll(ctx context.Context,
 // pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
 // it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (resp *gen.DescribeTaskListResponse, retError error) {
+	span, ctx := tracing.StartSpan(ctx, h.GetTracer(), "matching.DescribeTaskList")
+	defer tracing.FinishSpan(span, &retError)
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	scope := metrics.MatchingDescribeTaskListScope
 	sw := h.startRequestProfile("DescribeTaskList", scope)
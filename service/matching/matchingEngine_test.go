@@ -1740,7 +1740,7 @@ func newTestTaskListManager() *testTaskListManager {
 }
 
 // LeaseTaskList provides a mock function with given fields: request
-func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+func (m *testTaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1760,7 +1760,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 }
 
 // UpdateTaskList provides a mock function with given fields: request
-func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+func (m *testTaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
 	m.logger.Debug(fmt.Sprintf("UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
@@ -1778,7 +1778,7 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 }
 
 // CompleteTask provides a mock function with given fields: request
-func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
+func (m *testTaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) error {
 	m.logger.Debug(fmt.Sprintf("CompleteTask taskID=%v, ackLevel=%v", request.TaskID, request.TaskList.AckLevel))
 	if request.TaskID <= 0 {
 		panic(fmt.Errorf("Invalid taskID=%v", request.TaskID))
@@ -1794,7 +1794,7 @@ func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	return nil
 }
 
-func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+func (m *testTaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskListName, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1808,12 +1808,12 @@ func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTas
 	return persistence.UnknownNumRowsAffected, nil
 }
 
-func (m *testTaskManager) ListTaskList(
+func (m *testTaskManager) ListTaskList(ctx context.Context,
 	request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
 	return nil, fmt.Errorf("unsupported operation")
 }
 
-func (m *testTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+func (m *testTaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) error {
 	m.Lock()
 	defer m.Unlock()
 	key := newTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
@@ -1822,7 +1822,7 @@ func (m *testTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequ
 }
 
 // CreateTask provides a mock function with given fields: request
-func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+func (m *testTaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	domainID := request.TaskListInfo.DomainID
 	taskList := request.TaskListInfo.Name
 	taskType := request.TaskListInfo.TaskType
@@ -1875,7 +1875,7 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 }
 
 // GetTasks provides a mock function with given fields: request
-func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+func (m *testTaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, request.MaxReadLevel))
 
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskList, request.TaskType))
@@ -28,14 +28,16 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 // Config represents configuration for cadence-matching service
 type Config struct {
-	PersistenceMaxQPS dynamicconfig.IntPropertyFn
-	EnableSyncMatch   dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
-	RPS               dynamicconfig.IntPropertyFn
+	PersistenceMaxQPS         dynamicconfig.IntPropertyFn
+	PersistenceFaultInjection *config.FaultInjectionConfig
+	EnableSyncMatch           dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+	RPS                       dynamicconfig.IntPropertyFn
 
 	// taskListManager configuration
 	RangeSize                 int64
@@ -59,6 +61,7 @@ type Config struct {
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
+		PersistenceFaultInjection:       config.NewFaultInjectionConfig(dc, dynamicconfig.MatchingPersistenceFaultInjectionEnabled),
 		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
 		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
@@ -106,7 +109,8 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pConfig.FaultInjection = s.config.PersistenceFaultInjection
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), base.GetTracer(), log)
 
 	taskPersistence, err := pFactory.NewTaskManager()
 	if err != nil {
@@ -21,6 +21,7 @@
 package matching
 
 import (
+	"context"
 	"sync/atomic"
 	"time"
 )
@@ -72,7 +73,7 @@ func (tgc *taskGC) tryDeleteNextBatch(ackLevel int64, ignoreTimeCond bool) {
 		return
 	}
 	tgc.lastDeleteTime = time.Now()
-	n, err := tgc.db.CompleteTasksLessThan(ackLevel, batchSize)
+	n, err := tgc.db.CompleteTasksLessThan(context.Background(), ackLevel, batchSize)
 	switch {
 	case err != nil:
 		return
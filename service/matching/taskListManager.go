@@ -392,7 +392,7 @@ func (c *taskListManagerImpl) GetTaskContext(
 }
 
 func (c *taskListManagerImpl) persistAckLevel() error {
-	return c.db.UpdateState(c.taskAckManager.getAckLevel())
+	return c.db.UpdateState(context.Background(), c.taskAckManager.getAckLevel())
 }
 
 func (c *taskListManagerImpl) getAckLevel() (ackLevel int64) {
@@ -498,7 +498,7 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 	var newState taskListState
 	op := func() (err error) {
-		newState, err = c.db.RenewLease()
+		newState, err = c.db.RenewLease(context.Background())
 		return
 	}
 	c.domainScope().IncCounter(metrics.LeaseRequestCounter)
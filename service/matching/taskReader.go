@@ -140,7 +140,7 @@ getTasksPumpLoop:
 
 func (c *taskListManagerImpl) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	response, err := c.executeWithRetry(func() (interface{}, error) {
-		return c.db.GetTasks(readLevel, maxReadLevel, c.config.GetTasksBatchSize())
+		return c.db.GetTasks(c.cancelCtx, readLevel, maxReadLevel, c.config.GetTasksBatchSize())
 	})
 	if err != nil {
 		return nil, err
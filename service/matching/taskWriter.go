@@ -21,6 +21,7 @@
 package matching
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 
@@ -171,7 +172,7 @@ writerLoop:
 					maxReadLevel = taskIDs[i]
 				}
 
-				r, err := w.tlMgr.db.CreateTasks(tasks)
+				r, err := w.tlMgr.db.CreateTasks(context.Background(), tasks)
 				if err != nil {
 					w.logger.Error("Persistent store operation failure",
 						tag.StoreOperationCreateTask,
@@ -71,6 +71,7 @@ const (
 
 	errDeleteHistoryV1 = "failed to delete history from events_v1"
 	errDeleteHistoryV2 = "failed to delete history from events_v2"
+	errDeletePayloads  = "failed to delete offloaded payloads"
 
 	errHistoryMutated = "history was mutated during uploading"
 
@@ -80,7 +81,7 @@ const (
 var (
 	uploadHistoryActivityNonRetryableErrors = []string{errGetDomainByID, errConstructKey, errGetTags, errUploadBlob, errReadBlob, errEmptyBucket, errConstructBlob, errDownloadBlob, errHistoryMutated, errActivityPanic}
 	deleteBlobActivityNonRetryableErrors    = []string{errConstructKey, errGetTags, errUploadBlob, errEmptyBucket, errDeleteBlob}
-	deleteHistoryActivityNonRetryableErrors = []string{errDeleteHistoryV1, errDeleteHistoryV2}
+	deleteHistoryActivityNonRetryableErrors = []string{errDeleteHistoryV1, errDeleteHistoryV2, errDeletePayloads}
 	errContextTimeout                       = errors.New("activity aborted because context timed out")
 )
 
@@ -118,12 +119,14 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 			logger.Error("failed to delete history from events v2", tag.ArchivalDeleteHistoryFailReason(errorDetails(err)), tag.Error(err))
 			return err
 		}
-		return nil
-	}
-	if err := deleteHistoryV1(ctx, container, request); err != nil {
+	} else if err := deleteHistoryV1(ctx, container, request); err != nil {
 		logger.Error("failed to delete history from events v1", tag.ArchivalDeleteHistoryFailReason(errorDetails(err)), tag.Error(err))
 		return err
 	}
+	if err := deletePayloads(ctx, container, request); err != nil {
+		logger.Error("failed to delete offloaded payloads", tag.ArchivalDeleteHistoryFailReason(errorDetails(err)), tag.Error(err))
+		return err
+	}
 	return nil
 }
 
@@ -205,9 +208,9 @@ func deleteBlobActivity(ctx context.Context, request ArchiveRequest, blobsToDele
 }
 
 func getBlob(ctx context.Context, historyBlobReader HistoryBlobReader, blobPage int) (*HistoryBlob, error) {
-	blob, err := historyBlobReader.GetBlob(blobPage)
+	blob, err := historyBlobReader.GetBlob(ctx, blobPage)
 	op := func() error {
-		blob, err = historyBlobReader.GetBlob(blobPage)
+		blob, err = historyBlobReader.GetBlob(ctx, blobPage)
 		return err
 	}
 	for err != nil {
@@ -327,12 +330,12 @@ func deleteHistoryV1(ctx context.Context, container *BootstrapContainer, request
 			RunId:      common.StringPtr(request.RunID),
 		},
 	}
-	err := container.HistoryManager.DeleteWorkflowExecutionHistory(deleteHistoryReq)
+	err := container.HistoryManager.DeleteWorkflowExecutionHistory(ctx, deleteHistoryReq)
 	if err == nil {
 		return nil
 	}
 	op := func() error {
-		return container.HistoryManager.DeleteWorkflowExecutionHistory(deleteHistoryReq)
+		return container.HistoryManager.DeleteWorkflowExecutionHistory(ctx, deleteHistoryReq)
 	}
 	for err != nil {
 		if !common.IsPersistenceTransientError(err) {
@@ -346,13 +349,26 @@ func deleteHistoryV1(ctx context.Context, container *BootstrapContainer, request
 	return nil
 }
 
+func deletePayloads(ctx context.Context, container *BootstrapContainer, request ArchiveRequest) error {
+	if container.PayloadOffloader == nil {
+		return nil
+	}
+	if err := container.PayloadOffloader.DeleteWorkflowPayloads(ctx, request.DomainID, request.RunID); err != nil {
+		if contextExpired(ctx) {
+			return errContextTimeout
+		}
+		return cadence.NewCustomError(errDeletePayloads, err.Error())
+	}
+	return nil
+}
+
 func deleteHistoryV2(ctx context.Context, container *BootstrapContainer, request ArchiveRequest) error {
-	err := persistence.DeleteWorkflowExecutionHistoryV2(container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
+	err := persistence.DeleteWorkflowExecutionHistoryV2(ctx, container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
 	if err == nil {
 		return nil
 	}
 	op := func() error {
-		return persistence.DeleteWorkflowExecutionHistoryV2(container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
+		return persistence.DeleteWorkflowExecutionHistoryV2(ctx, container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
 	}
 	for err != nil {
 		if !common.IsPersistenceTransientError(err) {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
@@ -60,6 +61,7 @@ type (
 		HistoryManager   persistence.HistoryManager
 		HistoryV2Manager persistence.HistoryV2Manager
 		Blobstore        blobstore.Client
+		PayloadOffloader payload.Offloader
 		DomainCache      cache.DomainCache
 		Config           *Config
 
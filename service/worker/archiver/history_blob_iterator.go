@@ -21,12 +21,14 @@
 package archiver
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -39,7 +41,7 @@ makes any concurrent uploads of history safe.
 type (
 	// HistoryBlobIterator is used to get history blobs
 	HistoryBlobIterator interface {
-		Next() (*HistoryBlob, error)
+		Next(ctx context.Context) (*HistoryBlob, error)
 		HasNext() bool
 		GetState() ([]byte, error)
 	}
@@ -69,6 +71,7 @@ type (
 		closeFailoverVersion int64
 		shardID              int
 		sizeEstimator        SizeEstimator
+		payloadOffloader     payload.Offloader
 	}
 )
 
@@ -105,6 +108,7 @@ func NewHistoryBlobIterator(
 		closeFailoverVersion: request.CloseFailoverVersion,
 		shardID:              request.ShardID,
 		sizeEstimator:        container.HistorySizeEstimator,
+		payloadOffloader:     container.PayloadOffloader,
 	}
 	if it.sizeEstimator == nil {
 		it.sizeEstimator = NewJSONSizeEstimator()
@@ -121,11 +125,11 @@ func NewHistoryBlobIterator(
 // Next returns historyBlob and advances iterator.
 // Returns error if iterator is empty, or if history could not be read.
 // If error is returned then no iterator is state is advanced.
-func (i *historyBlobIterator) Next() (*HistoryBlob, error) {
+func (i *historyBlobIterator) Next(ctx context.Context) (*HistoryBlob, error) {
 	if !i.HasNext() {
 		return nil, errIteratorDepleted
 	}
-	events, newIterState, err := i.readBlobEvents(i.PersistencePageToken, i.NumEventsToSkip)
+	events, newIterState, err := i.readBlobEvents(ctx, i.PersistencePageToken, i.NumEventsToSkip)
 	if err != nil {
 		return nil, err
 	}
@@ -186,13 +190,13 @@ func (i *historyBlobIterator) GetState() ([]byte, error) {
 // 1. HistoryEvents: Either all of history starting from given pageToken or enough history to satisfy blob size target.
 // 2. NewIteratorState: Including persistence page token, finished iteration and numEventsToSkip
 // 3. Error: Any error that occurred while reading history
-func (i *historyBlobIterator) readBlobEvents(pageToken []byte, numEventsToSkip int) ([]*shared.HistoryEvent, historyBlobIteratorState, error) {
+func (i *historyBlobIterator) readBlobEvents(ctx context.Context, pageToken []byte, numEventsToSkip int) ([]*shared.HistoryEvent, historyBlobIteratorState, error) {
 	currSize := 0
 	targetSize := i.config.TargetArchivalBlobSize(i.domain)
 	var historyEvents []*shared.HistoryEvent
 	newIterState := historyBlobIteratorState{}
 	for currSize == 0 || (len(pageToken) > 0 && currSize < targetSize) {
-		currHistoryEvents, nextPageToken, err := i.readHistory(pageToken)
+		currHistoryEvents, nextPageToken, err := i.readHistory(ctx, pageToken)
 		if err != nil {
 			return nil, newIterState, err
 		}
@@ -226,7 +230,7 @@ func (i *historyBlobIterator) readBlobEvents(pageToken []byte, numEventsToSkip i
 	// This occurs if history was read exactly to the last event.
 	// Here we look forward one page so that we can treat reading exactly to the end of history
 	// the same way as reading through the end of history.
-	lookAheadHistoryEvents, _, err := i.readHistory(pageToken)
+	lookAheadHistoryEvents, _, err := i.readHistory(ctx, pageToken)
 	if err != nil {
 		return nil, newIterState, err
 	}
@@ -241,7 +245,19 @@ func (i *historyBlobIterator) readBlobEvents(pageToken []byte, numEventsToSkip i
 // readHistory fetches a single page of history events identified by given pageToken.
 // Does not modify any iterator state (i.e. calls to readHistory are idempotent).
 // Returns historyEvents, nextPageToken and error.
-func (i *historyBlobIterator) readHistory(pageToken []byte) ([]*shared.HistoryEvent, []byte, error) {
+func (i *historyBlobIterator) readHistory(ctx context.Context, pageToken []byte) ([]*shared.HistoryEvent, []byte, error) {
+	historyEvents, nextPageToken, err := i.readPersistedHistory(ctx, pageToken)
+	if err != nil || i.payloadOffloader == nil {
+		return historyEvents, nextPageToken, err
+	}
+	// archived history is self contained, offloaded payloads are deleted along with the workflow
+	if err := i.payloadOffloader.HydrateEvents(ctx, historyEvents); err != nil {
+		return nil, nil, err
+	}
+	return historyEvents, nextPageToken, nil
+}
+
+func (i *historyBlobIterator) readPersistedHistory(ctx context.Context, pageToken []byte) ([]*shared.HistoryEvent, []byte, error) {
 	if i.eventStoreVersion == persistence.EventStoreVersionV2 {
 		req := &persistence.ReadHistoryBranchRequest{
 			BranchToken:   i.branchToken,
@@ -251,7 +267,7 @@ func (i *historyBlobIterator) readHistory(pageToken []byte) ([]*shared.HistoryEv
 			NextPageToken: pageToken,
 			ShardID:       common.IntPtr(i.shardID),
 		}
-		historyEvents, _, nextPageToken, err := persistence.ReadFullPageV2Events(i.historyV2Manager, req)
+		historyEvents, _, nextPageToken, err := persistence.ReadFullPageV2Events(ctx, i.historyV2Manager, req)
 		return historyEvents, nextPageToken, err
 	}
 	req := &persistence.GetWorkflowExecutionHistoryRequest{
@@ -265,7 +281,7 @@ func (i *historyBlobIterator) readHistory(pageToken []byte) ([]*shared.HistoryEv
 		PageSize:      i.config.HistoryPageSize(i.domain),
 		NextPageToken: pageToken,
 	}
-	resp, err := i.historyManager.GetWorkflowExecutionHistory(req)
+	resp, err := i.historyManager.GetWorkflowExecutionHistory(ctx, req)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -21,6 +21,7 @@
 package archiver
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -87,9 +88,9 @@ func (s *HistoryBlobIteratorSuite) SetupTest() {
 
 func (s *HistoryBlobIteratorSuite) TestReadHistory_Failed_EventsV2() {
 	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
-	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything).Return(nil, errors.New("got error reading history branch"))
+	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(nil, errors.New("got error reading history branch"))
 	itr := s.constructTestHistoryBlobIterator(nil, mockHistoryV2Manager, nil, nil)
-	events, nextPageToken, err := itr.readHistory([]byte{})
+	events, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.Error(err)
 	s.Nil(events)
 	s.Nil(nextPageToken)
@@ -101,9 +102,9 @@ func (s *HistoryBlobIteratorSuite) TestReadHistory_Success_EventsV2() {
 		HistoryEvents: []*shared.HistoryEvent{},
 		NextPageToken: []byte{},
 	}
-	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything).Return(&resp, nil)
+	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&resp, nil)
 	itr := s.constructTestHistoryBlobIterator(nil, mockHistoryV2Manager, nil, nil)
-	events, nextPageToken, err := itr.readHistory([]byte{})
+	events, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.NoError(err)
 	s.NotNil(events)
 	s.Empty(nextPageToken)
@@ -111,9 +112,9 @@ func (s *HistoryBlobIteratorSuite) TestReadHistory_Success_EventsV2() {
 
 func (s *HistoryBlobIteratorSuite) TestReadHistory_Failed_EventsV1() {
 	mockHistoryManager := &mocks.HistoryManager{}
-	mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(nil, errors.New("error getting workflow execution history"))
+	mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).Return(nil, errors.New("error getting workflow execution history"))
 	itr := s.constructTestHistoryBlobIterator(mockHistoryManager, nil, nil, nil)
-	events, nextPageToken, err := itr.readHistory([]byte{})
+	events, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.Error(err)
 	s.Nil(events)
 	s.Nil(nextPageToken)
@@ -126,9 +127,9 @@ func (s *HistoryBlobIteratorSuite) TestReadHistory_Success_EventsV1() {
 			Events: []*shared.HistoryEvent{},
 		},
 	}
-	mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(&resp, nil)
+	mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything, mock.Anything).Return(&resp, nil)
 	itr := s.constructTestHistoryBlobIterator(mockHistoryManager, nil, nil, nil)
-	events, nextPageToken, err := itr.readHistory([]byte{})
+	events, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.NotNil(events)
 	s.Empty(nextPageToken)
 	s.NoError(err)
@@ -146,7 +147,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Fail_FirstCallToReadHistor
 	historyManager, pageTokens := s.constructMockHistoryManager(0, false, pages...)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, nil, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextIterState, err := itr.readBlobEvents(pageTokens[0], 0)
+	events, nextIterState, err := itr.readBlobEvents(context.Background(), pageTokens[0], 0)
 	s.Nil(events)
 	s.Nil(nextIterState.PersistencePageToken)
 	s.False(nextIterState.FinishedIteration)
@@ -173,7 +174,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Fail_NonFirstCallToReadHis
 	historyManager, pageTokens := s.constructMockHistoryManager(1, false, pages...)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, nil, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextIterState, err := itr.readBlobEvents(pageTokens[0], 0)
+	events, nextIterState, err := itr.readBlobEvents(context.Background(), pageTokens[0], 0)
 	s.Nil(events)
 	s.Nil(nextIterState.PersistencePageToken)
 	s.False(nextIterState.FinishedIteration)
@@ -208,7 +209,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_ReadToHistoryEnd()
 	config := constructConfig(testDefaultPersistencePageSize, 20*testDefaultHistoryEventSize)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextIterState, err := itr.readBlobEvents(pageTokens[0], 0)
+	events, nextIterState, err := itr.readBlobEvents(context.Background(), pageTokens[0], 0)
 	s.NotNil(events)
 	s.Len(events, 16)
 	s.Empty(nextIterState.PersistencePageToken)
@@ -244,7 +245,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_TargetSizeSatisfie
 	config := constructConfig(testDefaultPersistencePageSize, 11*testDefaultHistoryEventSize)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextIterState, err := itr.readBlobEvents(pageTokens[0], 0)
+	events, nextIterState, err := itr.readBlobEvents(context.Background(), pageTokens[0], 0)
 	s.NotNil(events)
 	s.Len(events, 11)
 	s.NotEmpty(nextIterState.PersistencePageToken)
@@ -281,7 +282,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_ReadExactlyToHisto
 	config := constructConfig(testDefaultPersistencePageSize, 16*testDefaultHistoryEventSize)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextIterState, err := itr.readBlobEvents(pageTokens[0], 0)
+	events, nextIterState, err := itr.readBlobEvents(context.Background(), pageTokens[0], 0)
 	s.NotNil(events)
 	s.Len(events, 16)
 	s.Empty(nextIterState.PersistencePageToken)
@@ -305,7 +306,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_ReadPageMultipleTi
 	config := constructConfig(testDefaultPersistencePageSize, 2*testDefaultHistoryEventSize)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextIterState, err := itr.readBlobEvents(pageTokens[0], 0)
+	events, nextIterState, err := itr.readBlobEvents(context.Background(), pageTokens[0], 0)
 	s.NotNil(events)
 	s.Len(events, 2)
 	s.Empty(nextIterState.PersistencePageToken)
@@ -314,7 +315,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_ReadPageMultipleTi
 	s.NoError(err)
 	s.assertStateMatches(startingIteratorState, itr)
 
-	events, nextIterState, err = itr.readBlobEvents(nextIterState.PersistencePageToken, nextIterState.NumEventsToSkip)
+	events, nextIterState, err = itr.readBlobEvents(context.Background(), nextIterState.PersistencePageToken, nextIterState.NumEventsToSkip)
 	s.NotNil(events)
 	s.Len(events, 2)
 	s.Empty(nextIterState.PersistencePageToken)
@@ -324,7 +325,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_ReadPageMultipleTi
 	s.Equal(int64(3), events[0].GetEventId())
 	s.assertStateMatches(startingIteratorState, itr)
 
-	events, nextIterState, err = itr.readBlobEvents(nextIterState.PersistencePageToken, nextIterState.NumEventsToSkip)
+	events, nextIterState, err = itr.readBlobEvents(context.Background(), nextIterState.PersistencePageToken, nextIterState.NumEventsToSkip)
 	s.NotNil(events)
 	s.Len(events, 2)
 	s.Empty(nextIterState.PersistencePageToken)
@@ -361,7 +362,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_IteratorDepleted() {
 	config := constructConfig(testDefaultPersistencePageSize, 16*testDefaultHistoryEventSize)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	blob, err := itr.Next()
+	blob, err := itr.Next(context.Background())
 	expectedIteratorState := historyBlobIteratorState{
 		// when iteration is finished page token is not advanced
 		BlobPageToken:        startingIteratorState.BlobPageToken,
@@ -381,7 +382,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_IteratorDepleted() {
 	s.NoError(err)
 	s.False(itr.HasNext())
 
-	blob, err = itr.Next()
+	blob, err = itr.Next(context.Background())
 	s.Error(err)
 	s.Nil(blob)
 	s.assertStateMatches(expectedIteratorState, itr)
@@ -419,7 +420,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_ReturnErrOnSecondCallToNext() {
 	config := constructConfig(testDefaultPersistencePageSize, 6*testDefaultHistoryEventSize)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	blob, err := itr.Next()
+	blob, err := itr.Next(context.Background())
 	expectedIteratorState := historyBlobIteratorState{
 		BlobPageToken:        startingIteratorState.BlobPageToken + 1,
 		PersistencePageToken: pageTokens[2],
@@ -437,7 +438,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_ReturnErrOnSecondCallToNext() {
 	s.NoError(err)
 	s.True(itr.HasNext())
 
-	blob, err = itr.Next()
+	blob, err = itr.Next(context.Background())
 	s.Error(err)
 	s.Nil(blob)
 	s.assertStateMatches(expectedIteratorState, itr)
@@ -467,7 +468,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Success_TenCallsToNext() {
 	for i := 0; i < 10; i++ {
 		s.assertStateMatches(expectedIteratorState, itr)
 		s.True(itr.HasNext())
-		blob, err := itr.Next()
+		blob, err := itr.Next(context.Background())
 		s.NoError(err)
 		s.NotNil(blob)
 		s.Equal(common.FirstEventID+int64(i*200), *blob.Header.FirstEventID)
@@ -568,11 +569,11 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_SameHistoryDiffere
 	totalPages := 4
 	for i := 0; i != totalPages; i++ {
 		s.True(itr1.HasNext())
-		blob1, err := itr1.Next()
+		blob1, err := itr1.Next(context.Background())
 		s.NoError(err)
 
 		s.True(itr2.HasNext())
-		blob2, err := itr2.Next()
+		blob2, err := itr2.Next(context.Background())
 		s.NoError(err)
 
 		s.Equal(len(blob1.Body.Events), len(blob2.Body.Events))
@@ -613,7 +614,7 @@ func (s *HistoryBlobIteratorSuite) constructMockHistoryManager(returnErrorOnPage
 			NextPageToken: pageToken,
 		}
 		if returnErrorOnPage == i {
-			mockHistoryManager.On("GetWorkflowExecutionHistory", req).Return(nil, errors.New("got error getting workflow execution history"))
+			mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything, req).Return(nil, errors.New("got error getting workflow execution history"))
 			return mockHistoryManager, pageTokens
 		}
 		var nextPageToken []byte
@@ -626,7 +627,7 @@ func (s *HistoryBlobIteratorSuite) constructMockHistoryManager(returnErrorOnPage
 			},
 			NextPageToken: nextPageToken,
 		}
-		mockHistoryManager.On("GetWorkflowExecutionHistory", req).Return(resp, nil)
+		mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything, req).Return(resp, nil)
 	}
 	if lastPageHasNextPageToken {
 		req := &persistence.GetWorkflowExecutionHistoryRequest{
@@ -645,7 +646,7 @@ func (s *HistoryBlobIteratorSuite) constructMockHistoryManager(returnErrorOnPage
 			NextPageToken: nil,
 			Size:          0,
 		}
-		mockHistoryManager.On("GetWorkflowExecutionHistory", req).Return(resp, nil)
+		mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything, req).Return(resp, nil)
 	}
 	return mockHistoryManager, pageTokens
 }
@@ -21,6 +21,7 @@
 package archiver
 
 import (
+	"context"
 	"errors"
 )
 
@@ -33,7 +34,7 @@ This property makes any concurrent uploads of history safe.
 type (
 	// HistoryBlobReader is used to read history blobs
 	HistoryBlobReader interface {
-		GetBlob(pageToken int) (*HistoryBlob, error)
+		GetBlob(ctx context.Context, pageToken int) (*HistoryBlob, error)
 	}
 
 	historyBlobReader struct {
@@ -55,13 +56,13 @@ func NewHistoryBlobReader(itr HistoryBlobIterator) HistoryBlobReader {
 }
 
 // GetBlob returns the HistoryBlob with given pageToken
-func (r *historyBlobReader) GetBlob(pageToken int) (*HistoryBlob, error) {
+func (r *historyBlobReader) GetBlob(ctx context.Context, pageToken int) (*HistoryBlob, error) {
 	result, ok := r.cache[pageToken]
 	if ok {
 		return result, nil
 	}
 	for r.itr.HasNext() {
-		hb, err := r.itr.Next()
+		hb, err := r.itr.Next(ctx)
 		if err != nil {
 			return nil, err
 		}
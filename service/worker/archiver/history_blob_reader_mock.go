@@ -22,20 +22,24 @@
 
 package archiver
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
 
 // HistoryBlobReaderMock is an autogenerated mock type for the HistoryBlobReader type
 type HistoryBlobReaderMock struct {
 	mock.Mock
 }
 
-// GetBlob provides a mock function with given fields: pageToken
-func (_m *HistoryBlobReaderMock) GetBlob(pageToken int) (*HistoryBlob, error) {
-	ret := _m.Called(pageToken)
+// GetBlob provides a mock function with given fields: ctx, pageToken
+func (_m *HistoryBlobReaderMock) GetBlob(ctx context.Context, pageToken int) (*HistoryBlob, error) {
+	ret := _m.Called(ctx, pageToken)
 
 	var r0 *HistoryBlob
-	if rf, ok := ret.Get(0).(func(int) *HistoryBlob); ok {
-		r0 = rf(pageToken)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *HistoryBlob); ok {
+		r0 = rf(ctx, pageToken)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*HistoryBlob)
@@ -43,8 +47,8 @@ func (_m *HistoryBlobReaderMock) GetBlob(pageToken int) (*HistoryBlob, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(pageToken)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, pageToken)
 	} else {
 		r1 = ret.Error(1)
 	}
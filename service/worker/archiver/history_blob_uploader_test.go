@@ -419,7 +419,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_ReadBlobNonRet
 	mockBlobstore := &mocks.BlobstoreClient{}
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(nil, errPersistenceNonRetryable)
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(nil, errPersistenceNonRetryable)
 	container := &BootstrapContainer{
 		Logger:            s.logger,
 		MetricsClient:     s.metricsClient,
@@ -457,7 +457,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_ReadBlobTimeou
 	mockBlobstore := &mocks.BlobstoreClient{}
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(nil, errPersistenceRetryable)
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(nil, errPersistenceRetryable)
 	container := &BootstrapContainer{
 		Logger:            s.logger,
 		MetricsClient:     s.metricsClient,
@@ -503,7 +503,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_CouldNotRunChe
 	mockBlobstore.On("Download", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("some error"))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -550,7 +550,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_CheckFailed()
 	mockBlobstore.On("Download", mock.Anything, mock.Anything, mock.Anything).Return(&blob.Blob{Body: []byte{1, 2, 3, 4}}, nil)
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -592,7 +592,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_UploadBlobNonR
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New(testErrDetails))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -634,7 +634,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_UploadBlobTime
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some error"))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(true)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -675,7 +675,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Success_BlobDoesNot
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Twice()
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			LastFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
 			LastEventID:         common.Int64Ptr(testNextEventID - 1),
@@ -727,7 +727,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Success_ConcurrentU
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, historyIndexBlobKey).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, historyIndexBlobKey, mock.Anything).Return(nil).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", common.FirstBlobPageToken+1).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, common.FirstBlobPageToken+1).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			LastFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
 			LastEventID:         common.Int64Ptr(testNextEventID - 1),
@@ -774,7 +774,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_HistoryMutated
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, firstKey).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
 	// Return a history blob with a larger failover version
-	mockHistoryBlobReader.On("GetBlob", common.FirstBlobPageToken).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, common.FirstBlobPageToken).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			LastFailoverVersion: common.Int64Ptr(testCloseFailoverVersion + 1),
 			IsLast:              common.BoolPtr(true),
@@ -820,7 +820,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_CouldNotRunBlo
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Twice()
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			LastFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
 			LastEventID:         common.Int64Ptr(testNextEventID - 1),
@@ -888,7 +888,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_BlobIntegrityC
 			},
 		},
 	}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(uploadedBlob, nil)
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(uploadedBlob, nil)
 	mockDownloader := &HistoryBlobDownloaderMock{}
 	fetchedBlob := &HistoryBlob{
 		Header: &HistoryBlobHeader{
@@ -982,8 +982,8 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Success_BlobIntegri
 			},
 		},
 	}
-	mockHistoryBlobReader.On("GetBlob", common.FirstBlobPageToken).Return(firstUploadedBlob, nil).Once()
-	mockHistoryBlobReader.On("GetBlob", common.FirstBlobPageToken+1).Return(secondUploadedBlob, nil).Once()
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, common.FirstBlobPageToken).Return(firstUploadedBlob, nil).Once()
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, common.FirstBlobPageToken+1).Return(secondUploadedBlob, nil).Once()
 	firstFetchReq := &DownloadBlobRequest{
 		DomainID:             testDomainID,
 		WorkflowID:           testWorkflowID,
@@ -1090,7 +1090,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_AfterUploadedS
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, secondBlobKey, mock.Anything).Return(errors.New(testErrDetails))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{IsLast: common.BoolPtr(false)},
 	}, nil)
 	container := &BootstrapContainer{
@@ -1141,7 +1141,7 @@ func (s *historyBlobUploaderSuite) TestUploadHistoryActivity_Fail_ContinueFromPr
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, fourthBlobKey, mock.Anything).Return(errors.New(testErrDetails))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{IsLast: common.BoolPtr(false)},
 	}, nil)
 	container := &BootstrapContainer{
@@ -1208,7 +1208,7 @@ func (s *historyBlobUploaderSuite) archivalConfig(
 	mockClusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(clusterArchivalStatus, clusterDefaultBucket, clusterEnablesArchival))
 	mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	mockClusterMetadata.On("GetCurrentClusterName").Return(testCurrentClusterName)
-	mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+	mockMetadataMgr.On("GetDomain", mock.Anything, mock.Anything).Return(
 		&persistence.GetDomainResponse{
 			Info: &persistence.DomainInfo{ID: testDomainID, Name: testDomain},
 			Config: &persistence.DomainConfig{
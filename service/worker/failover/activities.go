@@ -0,0 +1,141 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failover
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+)
+
+// drainActivity stops the domain from accepting new workflows, it fails if the
+// domain cannot be failed over from the current cluster to the target cluster
+func drainActivity(ctx context.Context, domain string, targetCluster string, drainTimeout time.Duration) error {
+	fc := ctx.Value(failoverContextKey).(failoverContext)
+	drainTimeoutInSeconds := int32(math.Ceil(drainTimeout.Seconds()))
+	_, err := fc.frontendClient.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domain),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(targetCluster),
+		},
+		FailoverDrainTimeoutInSeconds: common.Int32Ptr(drainTimeoutInSeconds),
+	})
+	if err != nil {
+		return toActivityError(err)
+	}
+	fc.logger.Info("Domain draining for graceful failover",
+		tag.WorkflowDomainName(domain), tag.ClusterName(targetCluster), tag.WorkflowID(activity.GetInfo(ctx).WorkflowExecution.ID))
+	return nil
+}
+
+// endDrainActivity makes the domain accept new workflows again, it is a no-op if the domain is not draining
+func endDrainActivity(ctx context.Context, domain string, targetCluster string) error {
+	fc := ctx.Value(failoverContextKey).(failoverContext)
+	_, err := fc.frontendClient.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domain),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(targetCluster),
+		},
+		FailoverDrainTimeoutInSeconds: common.Int32Ptr(0),
+	})
+	if err != nil {
+		return toActivityError(err)
+	}
+	fc.logger.Info("Domain drain for graceful failover ended",
+		tag.WorkflowDomainName(domain), tag.ClusterName(targetCluster), tag.WorkflowID(activity.GetInfo(ctx).WorkflowExecution.ID))
+	return nil
+}
+
+// getBacklogActivity returns the number of replication tasks of the domain pending to the target cluster,
+// shards are described in batches and the activity heartbeats after each of them. The backlog is returned
+// as unknown when history does not know it, i.e. when the replication tasks are published to kafka
+func getBacklogActivity(ctx context.Context, domain string, targetCluster string) (replicationBacklog, error) {
+	fc := ctx.Value(failoverContextKey).(failoverContext)
+	backlog := replicationBacklog{}
+	for start := 0; start < fc.cfg.NumHistoryShards; start += getBacklogShardBatchSize {
+		end := start + getBacklogShardBatchSize
+		if end > fc.cfg.NumHistoryShards {
+			end = fc.cfg.NumHistoryShards
+		}
+		shardIDs := make([]int32, 0, end-start)
+		for shardID := start; shardID < end; shardID++ {
+			shardIDs = append(shardIDs, int32(shardID))
+		}
+		resp, err := fc.historyClient.DescribeReplicationStatus(ctx, &replicator.DescribeReplicationStatusRequest{
+			TargetCluster: common.StringPtr(targetCluster),
+			ShardIDs:      shardIDs,
+			Domain:        common.StringPtr(domain),
+		})
+		if isReplicationStatusUnknown(err) {
+			return replicationBacklog{Unknown: true}, nil
+		}
+		if err != nil {
+			return replicationBacklog{}, toActivityError(err)
+		}
+		backlog.Truncated = backlog.Truncated || resp.GetTruncated()
+		for _, domainStatus := range resp.Domains {
+			backlog.PendingTasks += domainStatus.GetPendingTasks()
+		}
+		activity.RecordHeartbeat(ctx, end)
+	}
+	return backlog, nil
+}
+
+// failoverActivity changes the active cluster of the domain to the target cluster, which also ends the drain
+func failoverActivity(ctx context.Context, domain string, targetCluster string, operator string, reason string) error {
+	fc := ctx.Value(failoverContextKey).(failoverContext)
+	_, err := fc.frontendClient.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domain),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(targetCluster),
+		},
+		FailoverOperator: common.StringPtr(operator),
+		FailoverReason:   common.StringPtr(reason),
+	})
+	if err != nil {
+		return toActivityError(err)
+	}
+	fc.logger.Info("Domain failed over gracefully",
+		tag.WorkflowDomainName(domain), tag.ClusterName(targetCluster), tag.WorkflowID(activity.GetInfo(ctx).WorkflowExecution.ID))
+	return nil
+}
+
+func isReplicationStatusUnknown(err error) bool {
+	badRequest, ok := err.(*shared.BadRequestError)
+	return ok && badRequest.Message == common.ReplicationStatusUnknownMessage
+}
+
+// toActivityError marks errors which retrying cannot fix as non retryable
+func toActivityError(err error) error {
+	switch err.(type) {
+	case *shared.BadRequestError, *shared.EntityNotExistsError, *shared.DomainNotActiveError:
+		return cadence.NewCustomError(errReasonInvalidRequest, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failover
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+)
+
+type (
+	// Config defines the configuration for graceful failover
+	Config struct {
+		// NumHistoryShards is the number of history shards of this cluster
+		NumHistoryShards int
+		// ClusterMetadata contains the metadata for this cluster
+		ClusterMetadata cluster.Metadata
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the graceful failover sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for graceful failover
+		Config Config
+		// SDKClient is an instance of cadence sdk client
+		SDKClient workflowserviceclient.Interface
+		// FrontendClient is the frontend client used to update domains
+		FrontendClient frontend.Client
+		// HistoryClient is the history client used to read the replication backlog
+		HistoryClient history.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// failoverContext is the context object that gets
+	// passed around within the failover workflows / activities
+	failoverContext struct {
+		cfg            Config
+		frontendClient frontend.Client
+		historyClient  history.Client
+		metricsClient  metrics.Client
+		logger         log.Logger
+	}
+
+	// Failover is the background sub-system that executes
+	// graceful failovers of global domains
+	Failover struct {
+		context    failoverContext
+		sdkClient  workflowserviceclient.Interface
+		tallyScope tally.Scope
+	}
+)
+
+// New returns a new instance of the graceful failover sub-system
+func New(params *BootstrapParams) *Failover {
+	return &Failover{
+		context: failoverContext{
+			cfg:            params.Config,
+			frontendClient: params.FrontendClient,
+			historyClient:  params.HistoryClient,
+			metricsClient:  params.MetricsClient,
+			logger:         params.Logger.WithTags(tag.ComponentFailover),
+		},
+		sdkClient:  params.SDKClient,
+		tallyScope: params.TallyScope,
+	}
+}
+
+// Start starts the worker for graceful failover workflows
+func (f *Failover) Start() error {
+	workerOpts := worker.Options{
+		MetricsScope:              f.tallyScope,
+		BackgroundActivityContext: context.WithValue(context.Background(), failoverContextKey, f.context),
+	}
+	worker := worker.New(f.sdkClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	return worker.Start()
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failover
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type contextKey int
+
+const (
+	failoverContextKey = contextKey(0)
+
+	// TaskListName is the task list of graceful failover workflows
+	TaskListName = "cadence-sys-failover-tasklist"
+	// GracefulFailoverWorkflowTypeName is the workflow type of graceful failover workflows
+	GracefulFailoverWorkflowTypeName = "cadence-sys-graceful-failover-workflow"
+	// StatusQueryType is the query type returning the GracefulFailoverStatus of a graceful failover workflow
+	StatusQueryType = "status"
+
+	workflowIDPrefix       = "cadence-sys-graceful-failover-"
+	drainActivityName      = "cadence-sys-failover-drain-activity"
+	endDrainActivityName   = "cadence-sys-failover-end-drain-activity"
+	getBacklogActivityName = "cadence-sys-failover-get-backlog-activity"
+	failoverActivityName   = "cadence-sys-failover-activity"
+
+	// errReasonInvalidRequest is the reason of activity errors which are not retried
+	errReasonInvalidRequest = "cadence-sys-failover-invalid-request"
+
+	// the domain keeps draining a bit longer than the workflow waits for the backlog,
+	// so that new workflows are not accepted until the active cluster is changed
+	drainTimeoutBuffer = time.Minute
+	// number of shards whose replication backlog is described between two heartbeats
+	getBacklogShardBatchSize = 100
+	// DefaultCheckInterval is the default interval of checking the replication backlog
+	DefaultCheckInterval = 10 * time.Second
+)
+
+// States of a graceful failover
+const (
+	StateDraining              = "draining"
+	StateWaitingForReplication = "waiting-for-replication"
+	StateFailingOver           = "failing-over"
+	StateCompleted             = "completed"
+	StateFailed                = "failed"
+)
+
+type (
+	// GracefulFailoverParams are the parameters of a graceful failover workflow
+	GracefulFailoverParams struct {
+		Domain        string
+		TargetCluster string
+		// DrainTimeout is the max time to wait for the replication backlog to drain,
+		// the domain is failed over when it passes, even if the backlog is not empty
+		DrainTimeout time.Duration
+		// CheckInterval is the interval of checking the replication backlog
+		CheckInterval time.Duration
+		// Operator and Reason are recorded in the failover history of the domain
+		Operator string
+		Reason   string
+	}
+
+	// GracefulFailoverStatus is the progress of a graceful failover workflow
+	GracefulFailoverStatus struct {
+		Domain        string
+		TargetCluster string
+		State         string
+		StartTime     time.Time
+		DrainDeadline time.Time
+		// PendingTasks is the replication backlog of the domain at LastCheckTime, it is
+		// a lower bound if PendingTasksTruncated is set
+		PendingTasks          int64
+		PendingTasksTruncated bool
+		LastCheckTime         time.Time
+		// ReplicationStatusUnknown is set if the backlog cannot be known, e.g. when the replication tasks are
+		// published to kafka, the domain is then failed over once the drain timeout passes
+		ReplicationStatusUnknown bool
+		// TimedOut is set if the domain was failed over before the backlog drained
+		TimedOut bool
+		Error    string
+	}
+
+	replicationBacklog struct {
+		PendingTasks int64
+		Truncated    bool
+		Unknown      bool
+	}
+)
+
+var (
+	errDomainNotSet        = errors.New("domain is not set")
+	errTargetClusterNotSet = errors.New("target cluster is not set")
+	errInvalidDrainTimeout = errors.New("drain timeout must be positive")
+
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          time.Minute,
+		ExpirationInterval:       10 * time.Minute,
+		NonRetriableErrorReasons: []string{errReasonInvalidRequest},
+	}
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+	// the backlog is described shard by shard, which takes a while with many shards,
+	// the activity heartbeats after every batch of shards
+	getBacklogActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    10 * time.Minute,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(GracefulFailoverWorkflow, workflow.RegisterOptions{Name: GracefulFailoverWorkflowTypeName})
+	activity.RegisterWithOptions(drainActivity, activity.RegisterOptions{Name: drainActivityName})
+	activity.RegisterWithOptions(endDrainActivity, activity.RegisterOptions{Name: endDrainActivityName})
+	activity.RegisterWithOptions(getBacklogActivity, activity.RegisterOptions{Name: getBacklogActivityName})
+	activity.RegisterWithOptions(failoverActivity, activity.RegisterOptions{Name: failoverActivityName})
+}
+
+// GetWorkflowID returns the ID of the graceful failover workflow of a domain,
+// there is at most one graceful failover of a domain running at a time
+func GetWorkflowID(domain string) string {
+	return workflowIDPrefix + domain
+}
+
+// GracefulFailoverWorkflow fails over a global domain to the target cluster after its replication
+// backlog drained: the domain first stops accepting new workflows, then the workflow waits until
+// no replication task of the domain is pending to the target cluster or the drain timeout passes,
+// and only then the active cluster is changed. When the backlog cannot be known, e.g. when the
+// replication tasks are published to kafka, the workflow waits for the whole drain timeout. If the workflow fails after the drain started, the
+// drain is ended so that the domain accepts new workflows again.
+func GracefulFailoverWorkflow(ctx workflow.Context, params GracefulFailoverParams) error {
+	status := &GracefulFailoverStatus{
+		Domain:        params.Domain,
+		TargetCluster: params.TargetCluster,
+		State:         StateDraining,
+		StartTime:     workflow.Now(ctx),
+	}
+	status.DrainDeadline = status.StartTime.Add(params.DrainTimeout)
+	if err := workflow.SetQueryHandler(ctx, StatusQueryType, func() (*GracefulFailoverStatus, error) {
+		return status, nil
+	}); err != nil {
+		return err
+	}
+	drainStarted := false
+	fail := func(err error) error {
+		status.State = StateFailed
+		status.Error = err.Error()
+		if drainStarted {
+			endDrain(ctx, params)
+		}
+		return err
+	}
+
+	if err := validateParams(params); err != nil {
+		return fail(err)
+	}
+	checkInterval := params.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = DefaultCheckInterval
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	drainTimeout := params.DrainTimeout + drainTimeoutBuffer
+	// the drain may be applied even if the activity fails, e.g. when it times out
+	drainStarted = true
+	if err := workflow.ExecuteActivity(ctx, drainActivityName, params.Domain, params.TargetCluster, drainTimeout).Get(ctx, nil); err != nil {
+		return fail(err)
+	}
+
+	status.State = StateWaitingForReplication
+	getBacklogCtx := workflow.WithActivityOptions(ctx, getBacklogActivityOptions)
+	for {
+		var backlog replicationBacklog
+		if err := workflow.ExecuteActivity(getBacklogCtx, getBacklogActivityName, params.Domain, params.TargetCluster).Get(ctx, &backlog); err != nil {
+			return fail(err)
+		}
+		status.PendingTasks = backlog.PendingTasks
+		status.PendingTasksTruncated = backlog.Truncated
+		status.ReplicationStatusUnknown = backlog.Unknown
+		status.LastCheckTime = workflow.Now(ctx)
+		if backlog.PendingTasks == 0 && !backlog.Truncated && !backlog.Unknown {
+			break
+		}
+		if !status.LastCheckTime.Before(status.DrainDeadline) {
+			status.TimedOut = true
+			break
+		}
+		// checking again cannot tell more when the backlog is unknown, so the drain timeout is awaited at once
+		sleep := checkInterval
+		if backlog.Unknown {
+			sleep = status.DrainDeadline.Sub(status.LastCheckTime)
+		}
+		if err := workflow.Sleep(ctx, sleep); err != nil {
+			return fail(err)
+		}
+	}
+
+	status.State = StateFailingOver
+	if err := workflow.ExecuteActivity(ctx, failoverActivityName, params.Domain, params.TargetCluster, params.Operator, params.Reason).Get(ctx, nil); err != nil {
+		return fail(err)
+	}
+	status.State = StateCompleted
+	return nil
+}
+
+// endDrain makes the domain accept new workflows again after a failed graceful failover, it runs
+// in a disconnected context so that it also ends the drain when the workflow is cancelled
+func endDrain(ctx workflow.Context, params GracefulFailoverParams) {
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	if err := workflow.ExecuteActivity(ctx, endDrainActivityName, params.Domain, params.TargetCluster).Get(ctx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("failed to end the drain of a failed graceful failover",
+			zap.String("domain", params.Domain), zap.Error(err))
+	}
+}
+
+func validateParams(params GracefulFailoverParams) error {
+	if params.Domain == "" {
+		return errDomainNotSet
+	}
+	if params.TargetCluster == "" {
+		return errTargetClusterNotSet
+	}
+	if params.DrainTimeout <= 0 {
+		return errInvalidDrainTimeout
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failover
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+)
+
+type failoverWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestFailoverWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(failoverWorkflowTestSuite))
+}
+
+func (s *failoverWorkflowTestSuite) newParams() GracefulFailoverParams {
+	return GracefulFailoverParams{
+		Domain:        "some random domain",
+		TargetCluster: "standby",
+		DrainTimeout:  time.Minute,
+		CheckInterval: time.Second,
+		Operator:      "some random operator",
+		Reason:        "some random reason",
+	}
+}
+
+func (s *failoverWorkflowTestSuite) getStatus(env *testsuite.TestWorkflowEnvironment) *GracefulFailoverStatus {
+	value, err := env.QueryWorkflow(StatusQueryType)
+	s.NoError(err)
+	status := &GracefulFailoverStatus{}
+	s.NoError(value.Get(status))
+	return status
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_BacklogDrained() {
+	params := s.newParams()
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(drainActivityName, mock.Anything, params.Domain, params.TargetCluster, params.DrainTimeout+drainTimeoutBuffer).Return(nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(replicationBacklog{PendingTasks: 10}, nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(replicationBacklog{PendingTasks: 1, Truncated: true}, nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(replicationBacklog{}, nil).Once()
+	env.OnActivity(failoverActivityName, mock.Anything, params.Domain, params.TargetCluster, params.Operator, params.Reason).Return(nil).Once()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+
+	status := s.getStatus(env)
+	s.Equal(StateCompleted, status.State)
+	s.Equal(int64(0), status.PendingTasks)
+	s.False(status.PendingTasksTruncated)
+	s.False(status.TimedOut)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_DrainTimeout() {
+	params := s.newParams()
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(drainActivityName, mock.Anything, params.Domain, params.TargetCluster, params.DrainTimeout+drainTimeoutBuffer).Return(nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(replicationBacklog{PendingTasks: 10}, nil)
+	env.OnActivity(failoverActivityName, mock.Anything, params.Domain, params.TargetCluster, params.Operator, params.Reason).Return(nil).Once()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+
+	status := s.getStatus(env)
+	s.Equal(StateCompleted, status.State)
+	s.Equal(int64(10), status.PendingTasks)
+	s.True(status.TimedOut)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_ReplicationStatusUnknown() {
+	params := s.newParams()
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(drainActivityName, mock.Anything, params.Domain, params.TargetCluster, params.DrainTimeout+drainTimeoutBuffer).Return(nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(replicationBacklog{Unknown: true}, nil).Twice()
+	env.OnActivity(failoverActivityName, mock.Anything, params.Domain, params.TargetCluster, params.Operator, params.Reason).Return(nil).Once()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+
+	status := s.getStatus(env)
+	s.Equal(StateCompleted, status.State)
+	s.True(status.ReplicationStatusUnknown)
+	s.True(status.TimedOut)
+	s.False(status.LastCheckTime.Before(status.DrainDeadline))
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_DrainFailed() {
+	params := s.newParams()
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(drainActivityName, mock.Anything, params.Domain, params.TargetCluster, params.DrainTimeout+drainTimeoutBuffer).
+		Return(cadence.NewCustomError(errReasonInvalidRequest, "domain is not active")).Once()
+	env.OnActivity(endDrainActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(nil).Once()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+
+	status := s.getStatus(env)
+	s.Equal(StateFailed, status.State)
+	s.NotEmpty(status.Error)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_GetBacklogFailed() {
+	params := s.newParams()
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(drainActivityName, mock.Anything, params.Domain, params.TargetCluster, params.DrainTimeout+drainTimeoutBuffer).Return(nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).
+		Return(replicationBacklog{}, cadence.NewCustomError(errReasonInvalidRequest, "domain does not exist")).Once()
+	env.OnActivity(endDrainActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(nil).Once()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+
+	status := s.getStatus(env)
+	s.Equal(StateFailed, status.State)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_FailoverFailed_EndDrainFailed() {
+	params := s.newParams()
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(drainActivityName, mock.Anything, params.Domain, params.TargetCluster, params.DrainTimeout+drainTimeoutBuffer).Return(nil).Once()
+	env.OnActivity(getBacklogActivityName, mock.Anything, params.Domain, params.TargetCluster).Return(replicationBacklog{}, nil).Once()
+	env.OnActivity(failoverActivityName, mock.Anything, params.Domain, params.TargetCluster, params.Operator, params.Reason).
+		Return(cadence.NewCustomError(errReasonInvalidRequest, "domain is not active")).Once()
+	env.OnActivity(endDrainActivityName, mock.Anything, params.Domain, params.TargetCluster).
+		Return(cadence.NewCustomError(errReasonInvalidRequest, "domain is not active")).Once()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+
+	status := s.getStatus(env)
+	s.Equal(StateFailed, status.State)
+	s.Contains(status.Error, "domain is not active")
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	params := s.newParams()
+	params.DrainTimeout = 0
+	env := s.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(GracefulFailoverWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
@@ -27,7 +27,9 @@ import (
 
 	"github.com/olivere/elastic"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/collection"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
@@ -61,6 +63,8 @@ type (
 	esProcessorImpl struct {
 		processor     ElasticBulkProcessor
 		mapToKafkaMsg collection.ConcurrentTxMap // used to map ES request to kafka message
+		dlqProducer   messaging.Producer         // optional, permanent failures are nacked without it
+		msgEncoder    codec.BinaryEncoder
 		config        *Config
 		logger        log.Logger
 		metricsClient metrics.Client
@@ -81,10 +85,13 @@ const (
 	esProcessorMaxRetryInterval     = 20 * time.Second
 )
 
-// NewESProcessorAndStart create new ESProcessor and start
-func NewESProcessorAndStart(config *Config, client es.Client, processorName string,
+// NewESProcessorAndStart create new ESProcessor and start, requests ES rejects permanently
+// are sent to the DLQ producer when it's not nil
+func NewESProcessorAndStart(config *Config, client es.Client, processorName string, dlqProducer messaging.Producer,
 	logger log.Logger, metricsClient metrics.Client) (ESProcessor, error) {
 	p := &esProcessorImpl{
+		dlqProducer:   dlqProducer,
+		msgEncoder:    codec.NewThriftRWEncoder(),
 		config:        config,
 		logger:        logger.WithTags(tag.ComponentIndexerESProcessor),
 		metricsClient: metricsClient,
@@ -163,7 +170,11 @@ func (p *esProcessorImpl) bulkAfterAction(id int64, requests []elastic.BulkableR
 			case !isResponseRetriable(resp.Status):
 				p.logger.Error("ES request failed.",
 					tag.ESResponseStatus(resp.Status), tag.ESResponseError(getErrorMsgFromESResp(resp)))
-				p.nackKafkaMsg(key)
+				if p.dlqProducer != nil && isResponsePermanentFailure(resp.Status) {
+					p.sendKafkaMsgToDLQ(key, resp)
+				} else {
+					p.nackKafkaMsg(key)
+				}
 			default: // bulk processor will retry
 				p.logger.Info("ES request retried.", tag.ESResponseStatus(resp.Status))
 				p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorRetries)
@@ -199,6 +210,41 @@ func (p *esProcessorImpl) ackKafkaMsgHelper(key string, nack bool) {
 	p.mapToKafkaMsg.Remove(key)
 }
 
+// sendKafkaMsgToDLQ publishes the message with the ES error to DLQ then acks it,
+// the message is nacked if it can't be sent to DLQ
+func (p *esProcessorImpl) sendKafkaMsgToDLQ(key string, resp *elastic.BulkResponseItem) {
+	msg, ok := p.mapToKafkaMsg.Get(key)
+	if !ok {
+		return // duplicate kafka message
+	}
+	kafkaMsg, ok := msg.(*kafkaMessageWithMetrics)
+	if !ok { // must be bug in code and bad deployment
+		p.logger.Fatal("Message is not kafka message.", tag.ESKey(key))
+	}
+
+	var indexMsg indexer.Message
+	err := p.msgEncoder.Decode(kafkaMsg.message.Value(), &indexMsg)
+	if err == nil {
+		err = p.dlqProducer.Publish(&indexer.DLQMessage{
+			Message:     &indexMsg,
+			Index:       common.StringPtr(resp.Index),
+			Status:      common.Int32Ptr(int32(resp.Status)),
+			ErrorType:   common.StringPtr(getErrorTypeFromESResp(resp)),
+			ErrorReason: common.StringPtr(getErrorMsgFromESResp(resp)),
+			Timestamp:   common.Int64Ptr(time.Now().UnixNano()),
+		})
+	}
+	if err != nil {
+		p.logger.Error("Failed to send ES request to DLQ.", tag.ESKey(key), tag.Error(err))
+		p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorDLQFailures)
+		p.nackKafkaMsg(key)
+		return
+	}
+
+	p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorDLQMessages)
+	p.ackKafkaMsg(key)
+}
+
 func (p *esProcessorImpl) hashFn(key interface{}) uint32 {
 	id, ok := key.(string)
 	if !ok {
@@ -281,6 +327,20 @@ func isResponseRetriable(status int) bool {
 	return false
 }
 
+// isResponsePermanentFailure tells if the failure is caused by the request itself, so retrying it
+// fails again until the index is fixed, e.g. mapping conflicts(400) or oversized requests(413)
+func isResponsePermanentFailure(status int) bool {
+	return status >= 400 && status < 500 && !isResponseSuccess(status) && !isResponseRetriable(status)
+}
+
+func getErrorTypeFromESResp(resp *elastic.BulkResponseItem) string {
+	var errType string
+	if resp.Error != nil {
+		errType = resp.Error.Type
+	}
+	return errType
+}
+
 func getErrorMsgFromESResp(resp *elastic.BulkResponseItem) string {
 	var errMsg string
 	if resp.Error != nil {
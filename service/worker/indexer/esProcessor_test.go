@@ -30,6 +30,9 @@ import (
 	"github.com/olivere/elastic"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/collection"
 	es "github.com/uber/cadence/common/elasticsearch"
 	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
@@ -37,6 +40,7 @@ import (
 	msgMocks "github.com/uber/cadence/common/messaging/mocks"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
+	cmocks "github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/indexer/mocks"
 	"go.uber.org/zap"
@@ -82,6 +86,7 @@ func (s *esProcessorSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	p := &esProcessorImpl{
+		msgEncoder:    codec.NewThriftRWEncoder(),
 		config:        config,
 		logger:        loggerimpl.NewLogger(zapLogger),
 		metricsClient: s.mockMetricClient,
@@ -119,7 +124,7 @@ func (s *esProcessorSuite) TestNewESProcessorAndStart() {
 		s.NotNil(input.AfterFunc)
 		return true
 	})).Return(&elastic.BulkProcessor{}, nil).Once()
-	p, err := NewESProcessorAndStart(config, s.mockESClient, processorName, s.esProcessor.logger, &mmocks.Client{})
+	p, err := NewESProcessorAndStart(config, s.mockESClient, processorName, nil, s.esProcessor.logger, &mmocks.Client{})
 	s.NoError(err)
 
 	processor, ok := p.(*esProcessorImpl)
@@ -247,6 +252,142 @@ func (s *esProcessorSuite) TestBulkAfterAction_Nack() {
 	mockKafkaMsg.AssertExpectations(s.T())
 }
 
+func (s *esProcessorSuite) TestBulkAfterAction_DLQ() {
+	dlqProducer := &cmocks.KafkaProducer{}
+	s.esProcessor.dlqProducer = dlqProducer
+
+	version := int64(3)
+	testKey := "testKey"
+	request := elastic.NewBulkIndexRequest().
+		Index(testIndex).
+		Type(testType).
+		Id(testID).
+		VersionType(versionTypeExternal).
+		Version(version).
+		Doc(map[string]interface{}{es.KafkaKey: testKey})
+	requests := []elastic.BulkableRequest{request}
+
+	mFailed := map[string]*elastic.BulkResponseItem{
+		"index": {
+			Index:   testIndex,
+			Type:    testType,
+			Id:      testID,
+			Version: version,
+			Status:  400,
+			Error:   &elastic.ErrorDetails{Type: "mapper_parsing_exception", Reason: "failed to parse"},
+		},
+	}
+	response := &elastic.BulkResponse{
+		Took:   3,
+		Errors: true,
+		Items:  []map[string]*elastic.BulkResponseItem{mFailed},
+	}
+
+	indexMsg := &indexer.Message{
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+		DomainID:    common.StringPtr("test-domain-id"),
+		WorkflowID:  common.StringPtr("test-workflow-id"),
+		RunID:       common.StringPtr("test-run-id"),
+		Version:     common.Int64Ptr(version),
+	}
+	payload, err := codec.NewThriftRWEncoder().Encode(indexMsg)
+	s.NoError(err)
+
+	mockKafkaMsg := &msgMocks.Message{}
+	mapVal := newKafkaMessageWithMetrics(mockKafkaMsg, &testStopWatch)
+	s.esProcessor.mapToKafkaMsg.Put(testKey, mapVal)
+	mockKafkaMsg.On("Value").Return(payload).Once()
+	mockKafkaMsg.On("Ack").Return(nil).Once()
+	dlqProducer.On("Publish", mock.MatchedBy(func(input *indexer.DLQMessage) bool {
+		s.Equal(indexMsg, input.Message)
+		s.Equal(testIndex, input.GetIndex())
+		s.Equal(int32(400), input.GetStatus())
+		s.Equal("mapper_parsing_exception", input.GetErrorType())
+		s.Equal("failed to parse", input.GetErrorReason())
+		return true
+	})).Return(nil).Once()
+	s.mockMetricClient.On("IncCounter", metrics.ESProcessorScope, metrics.ESProcessorDLQMessages).Once()
+	s.esProcessor.bulkAfterAction(0, requests, response, nil)
+	mockKafkaMsg.AssertExpectations(s.T())
+	dlqProducer.AssertExpectations(s.T())
+}
+
+func (s *esProcessorSuite) TestBulkAfterAction_DLQ_PublishFailed() {
+	dlqProducer := &cmocks.KafkaProducer{}
+	s.esProcessor.dlqProducer = dlqProducer
+
+	testKey := "testKey"
+	request := elastic.NewBulkIndexRequest().
+		Index(testIndex).
+		Type(testType).
+		Id(testID).
+		Doc(map[string]interface{}{es.KafkaKey: testKey})
+	requests := []elastic.BulkableRequest{request}
+
+	mFailed := map[string]*elastic.BulkResponseItem{
+		"index": {
+			Index:  testIndex,
+			Type:   testType,
+			Id:     testID,
+			Status: 413,
+		},
+	}
+	response := &elastic.BulkResponse{
+		Took:   3,
+		Errors: true,
+		Items:  []map[string]*elastic.BulkResponseItem{mFailed},
+	}
+
+	payload, err := codec.NewThriftRWEncoder().Encode(&indexer.Message{WorkflowID: common.StringPtr("test-workflow-id")})
+	s.NoError(err)
+
+	mockKafkaMsg := &msgMocks.Message{}
+	mapVal := newKafkaMessageWithMetrics(mockKafkaMsg, &testStopWatch)
+	s.esProcessor.mapToKafkaMsg.Put(testKey, mapVal)
+	mockKafkaMsg.On("Value").Return(payload).Once()
+	mockKafkaMsg.On("Nack").Return(nil).Once()
+	dlqProducer.On("Publish", mock.Anything).Return(errors.New("some error")).Once()
+	s.mockMetricClient.On("IncCounter", metrics.ESProcessorScope, metrics.ESProcessorDLQFailures).Once()
+	s.esProcessor.bulkAfterAction(0, requests, response, nil)
+	mockKafkaMsg.AssertExpectations(s.T())
+	dlqProducer.AssertExpectations(s.T())
+}
+
+func (s *esProcessorSuite) TestBulkAfterAction_ServerError_NotSentToDLQ() {
+	dlqProducer := &cmocks.KafkaProducer{}
+	s.esProcessor.dlqProducer = dlqProducer
+
+	testKey := "testKey"
+	request := elastic.NewBulkIndexRequest().
+		Index(testIndex).
+		Type(testType).
+		Id(testID).
+		Doc(map[string]interface{}{es.KafkaKey: testKey})
+	requests := []elastic.BulkableRequest{request}
+
+	mFailed := map[string]*elastic.BulkResponseItem{
+		"index": {
+			Index:  testIndex,
+			Type:   testType,
+			Id:     testID,
+			Status: 500,
+		},
+	}
+	response := &elastic.BulkResponse{
+		Took:   3,
+		Errors: true,
+		Items:  []map[string]*elastic.BulkResponseItem{mFailed},
+	}
+
+	mockKafkaMsg := &msgMocks.Message{}
+	mapVal := newKafkaMessageWithMetrics(mockKafkaMsg, &testStopWatch)
+	s.esProcessor.mapToKafkaMsg.Put(testKey, mapVal)
+	mockKafkaMsg.On("Nack").Return(nil).Once()
+	s.esProcessor.bulkAfterAction(0, requests, response, nil)
+	mockKafkaMsg.AssertExpectations(s.T())
+	dlqProducer.AssertExpectations(s.T())
+}
+
 func (s *esProcessorSuite) TestBulkAfterAction_Error() {
 	version := int64(3)
 	request := elastic.NewBulkIndexRequest().
@@ -382,3 +523,14 @@ func (s *esProcessorSuite) TestGetErrorMsgFromESResp() {
 	resp.Error = &elastic.ErrorDetails{Reason: reason}
 	s.Equal(reason, getErrorMsgFromESResp(resp))
 }
+
+func (s *esProcessorSuite) TestIsResponsePermanentFailure() {
+	status := []int{400, 403, 413}
+	for _, code := range status {
+		s.True(isResponsePermanentFailure(code))
+	}
+	status = []int{200, 404, 408, 429, 500, 503, 507}
+	for _, code := range status {
+		s.False(isResponsePermanentFailure(code))
+	}
+}
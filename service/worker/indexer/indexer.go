@@ -23,6 +23,7 @@ package indexer
 import (
 	"fmt"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -36,7 +37,8 @@ type (
 	Indexer struct {
 		config              *Config
 		kafkaClient         messaging.Client
-		esClient            es.Client
+		esRouter            es.Router
+		domainCache         cache.DomainCache
 		logger              log.Logger
 		metricsClient       metrics.Client
 		dynamicCollection   *dynamicconfig.Collection
@@ -59,15 +61,16 @@ const (
 	visibilityProcessorName = "visibility-processor"
 )
 
-// NewIndexer create a new Indexer
-func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
-	logger log.Logger, metricsClient metrics.Client) *Indexer {
+// NewIndexer create a new Indexer, the router decides the ElasticSearch cluster and index of each domain
+func NewIndexer(config *Config, client messaging.Client, esRouter es.Router, domainCache cache.DomainCache,
+	esConfig *es.Config, logger log.Logger, metricsClient metrics.Client) *Indexer {
 	logger = logger.WithTags(tag.ComponentIndexer)
 
 	return &Indexer{
 		config:              config,
 		kafkaClient:         client,
-		esClient:            esClient,
+		esRouter:            esRouter,
+		domainCache:         domainCache,
 		logger:              logger,
 		metricsClient:       metricsClient,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
@@ -78,8 +81,15 @@ func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esC
 func (x Indexer) Start() error {
 	visibilityApp := common.VisibilityAppName
 	visConsumerName := getConsumerName(x.visibilityIndexName)
-	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
+	dlqProducer, err := x.kafkaClient.NewProducer(common.VisibilityESDLQAppName)
+	if err == messaging.ErrApplicationNotConfigured {
+		x.logger.Info("ElasticSearch DLQ is not configured, permanently failed requests are nacked")
+		dlqProducer = nil
+	} else if err != nil {
+		return err
+	}
+	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esRouter,
+		x.domainCache, visibilityProcessorName, dlqProducer, x.config, x.logger, x.metricsClient)
 	return x.visibilityProcessor.Start()
 }
 
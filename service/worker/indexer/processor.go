@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/definition"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -44,10 +45,11 @@ type indexProcessor struct {
 	consumerName    string
 	kafkaClient     messaging.Client
 	consumer        messaging.Consumer
-	esClient        es.Client
-	esProcessor     ESProcessor
+	esRouter        es.Router
+	domainCache     cache.DomainCache
+	esProcessors    map[string]ESProcessor // keyed by ElasticSearch cluster name
 	esProcessorName string
-	esIndexName     string
+	dlqProducer     messaging.Producer
 	config          *Config
 	logger          log.Logger
 	metricsClient   metrics.Client
@@ -69,15 +71,17 @@ var (
 	errUnknownMessageType = &shared.BadRequestError{Message: "unknown message type"}
 )
 
-func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esRouter es.Router,
+	domainCache cache.DomainCache, esProcessorName string, dlqProducer messaging.Producer, config *Config,
+	logger log.Logger, metricsClient metrics.Client) *indexProcessor {
 	return &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
 		kafkaClient:     kafkaClient,
-		esClient:        esClient,
+		esRouter:        esRouter,
+		domainCache:     domainCache,
 		esProcessorName: esProcessorName,
-		esIndexName:     esIndexName,
+		dlqProducer:     dlqProducer,
 		config:          config,
 		logger:          logger.WithTags(tag.ComponentIndexerProcessor),
 		metricsClient:   metricsClient,
@@ -103,14 +107,25 @@ func (p *indexProcessor) Start() error {
 		return err
 	}
 
-	esProcessor, err := NewESProcessorAndStart(p.config, p.esClient, p.esProcessorName, p.logger, p.metricsClient)
-	if err != nil {
-		p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
-		return err
+	esProcessors := make(map[string]ESProcessor)
+	for cluster, esClient := range p.esRouter.Clients() {
+		processorName := p.esProcessorName
+		if cluster != es.DefaultCluster {
+			processorName = fmt.Sprintf("%v-%v", p.esProcessorName, cluster)
+		}
+		esProcessor, err := NewESProcessorAndStart(p.config, esClient, processorName, p.dlqProducer, p.logger, p.metricsClient)
+		if err != nil {
+			p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
+			for _, started := range esProcessors {
+				started.Stop()
+			}
+			return err
+		}
+		esProcessors[cluster] = esProcessor
 	}
 
 	p.consumer = consumer
-	p.esProcessor = esProcessor
+	p.esProcessors = esProcessors
 	p.shutdownWG.Add(1)
 	go p.processorPump()
 
@@ -148,7 +163,9 @@ func (p *indexProcessor) processorPump() {
 	case <-p.shutdownCh:
 		// Processor is shutting down, close the underlying consumer and esProcessor
 		p.consumer.Stop()
-		p.esProcessor.Stop()
+		for _, esProcessor := range p.esProcessors {
+			esProcessor.Stop()
+		}
 	}
 
 	p.logger.Info("Index processor pump shutting down.")
@@ -199,6 +216,31 @@ func (p *indexProcessor) deserialize(payload []byte) (*indexer.Message, error) {
 }
 
 func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg messaging.Message, logger log.Logger) error {
+	domainEntry, err := p.domainCache.GetDomainByID(indexMsg.GetDomainID())
+	if _, ok := err.(*shared.EntityNotExistsError); ok {
+		// the domain is deleted so the message never succeeds, it is acked rather than
+		// retried forever, which would block every later message of the partition
+		logger.Warn("Dropping index message of deleted domain.", tag.WorkflowDomainID(indexMsg.GetDomainID()),
+			tag.WorkflowID(indexMsg.GetWorkflowID()), tag.WorkflowRunID(indexMsg.GetRunID()))
+		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorDeletedDomainMessages)
+		kafkaMsg.Ack()
+		return nil
+	}
+	if err != nil {
+		logger.Error("Failed to get domain for index message.", tag.WorkflowDomainID(indexMsg.GetDomainID()), tag.Error(err))
+		return err
+	}
+	route, err := p.esRouter.Route(domainEntry.GetInfo().Name)
+	if err != nil {
+		logger.Error("Failed to route index message.", tag.WorkflowDomainName(domainEntry.GetInfo().Name), tag.Error(err))
+		return err
+	}
+	esProcessor, ok := p.esProcessors[route.Cluster]
+	if !ok {
+		// clusters are static config, so the router and processors always agree
+		return fmt.Errorf("no ElasticSearch processor for cluster %v", route.Cluster)
+	}
+
 	docID := indexMsg.GetWorkflowID() + esDocIDDelimiter + indexMsg.GetRunID()
 
 	var keyToKafkaMsg string
@@ -208,7 +250,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
 		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
 		req = elastic.NewBulkIndexRequest().
-			Index(p.esIndexName).
+			Index(route.Index).
 			Type(esDocType).
 			Id(docID).
 			VersionType(versionTypeExternal).
@@ -217,7 +259,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 	case indexer.MessageTypeDelete:
 		keyToKafkaMsg = docID
 		req = elastic.NewBulkDeleteRequest().
-			Index(p.esIndexName).
+			Index(route.Index).
 			Type(esDocType).
 			Id(docID).
 			VersionType(versionTypeExternal).
@@ -228,7 +270,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 		return errUnknownMessageType
 	}
 
-	p.esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
+	esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
 	return nil
 }
 
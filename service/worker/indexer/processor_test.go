@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	msgMocks "github.com/uber/cadence/common/messaging/mocks"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type indexProcessorSuite struct {
+	suite.Suite
+	processor       *indexProcessor
+	mockDomainCache *cache.DomainCacheMock
+}
+
+func TestIndexProcessorSuite(t *testing.T) {
+	s := new(indexProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *indexProcessorSuite) SetupTest() {
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.processor = newIndexProcessor("test-app", "test-consumer", nil, nil, s.mockDomainCache, "test-processor", nil,
+		&Config{}, loggerimpl.NewNopLogger(), metrics.NewClient(tally.NoopScope, metrics.Worker))
+}
+
+func (s *indexProcessorSuite) TearDownTest() {
+	s.mockDomainCache.AssertExpectations(s.T())
+}
+
+func (s *indexProcessorSuite) TestAddMessageToES_DeletedDomain() {
+	indexMsg := &indexer.Message{
+		DomainID:    common.StringPtr("some random domain ID"),
+		WorkflowID:  common.StringPtr("some random workflow ID"),
+		RunID:       common.StringPtr("some random run ID"),
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+	}
+	s.mockDomainCache.On("GetDomainByID", indexMsg.GetDomainID()).Return(nil, &shared.EntityNotExistsError{}).Once()
+	kafkaMsg := &msgMocks.Message{}
+	kafkaMsg.On("Ack").Return(nil).Once()
+
+	s.NoError(s.processor.addMessageToES(indexMsg, kafkaMsg, s.processor.logger))
+	kafkaMsg.AssertExpectations(s.T())
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// dlqWriter puts the replication tasks which cannot be applied to the replication
+	// DLQ of the history shard owning the workflow
+	dlqWriter struct {
+		executionManagerFactory persistence.ExecutionManagerFactory
+		numberOfHistoryShards   int
+
+		sync.Mutex
+		executionManagers map[int]persistence.ExecutionManager
+	}
+
+	// dlqMessage is a kafka message which is put to the replication DLQ when nacked,
+	// instead of being sent to the kafka DLQ topic
+	dlqMessage struct {
+		messaging.Message
+		sourceCluster string
+		taskInfo      *persistence.ReplicationTaskInfo
+		writer        *dlqWriter
+		logger        log.Logger
+	}
+)
+
+func newDLQWriter(executionManagerFactory persistence.ExecutionManagerFactory, numberOfHistoryShards int) *dlqWriter {
+	return &dlqWriter{
+		executionManagerFactory: executionManagerFactory,
+		numberOfHistoryShards:   numberOfHistoryShards,
+		executionManagers:       make(map[int]persistence.ExecutionManager),
+	}
+}
+
+func (w *dlqWriter) put(sourceCluster string, taskInfo *persistence.ReplicationTaskInfo) error {
+	shardID := common.WorkflowIDToHistoryShard(taskInfo.WorkflowID, w.numberOfHistoryShards)
+	executionManager, err := w.getExecutionManager(shardID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+	return executionManager.PutReplicationTaskToDLQ(ctx, &persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: sourceCluster,
+		TaskInfo:          taskInfo,
+	})
+}
+
+func (w *dlqWriter) getExecutionManager(shardID int) (persistence.ExecutionManager, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if executionManager, ok := w.executionManagers[shardID]; ok {
+		return executionManager, nil
+	}
+	executionManager, err := w.executionManagerFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	w.executionManagers[shardID] = executionManager
+	return executionManager, nil
+}
+
+func (w *dlqWriter) close() {
+	w.Lock()
+	defer w.Unlock()
+
+	for _, executionManager := range w.executionManagers {
+		executionManager.Close()
+	}
+	w.executionManagers = make(map[int]persistence.ExecutionManager)
+}
+
+// Nack puts the replication task to the replication DLQ and acks the message,
+// the message is sent to the kafka DLQ topic only if the task cannot be persisted
+func (m *dlqMessage) Nack() error {
+	if err := m.writer.put(m.sourceCluster, m.taskInfo); err != nil {
+		m.logger.Error("Unable to put replication task to DLQ, sending to kafka DLQ.", tag.Error(err))
+		return m.Message.Nack()
+	}
+	return m.Message.Ack()
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	messageMocks "github.com/uber/cadence/common/messaging/mocks"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/zap"
+)
+
+type (
+	dlqWriterSuite struct {
+		suite.Suite
+		logger log.Logger
+
+		mockMsg                     *messageMocks.Message
+		mockExecutionManager        *mocks.ExecutionManager
+		mockExecutionManagerFactory *mocks.ExecutionManagerFactory
+
+		writer *dlqWriter
+	}
+)
+
+func TestDLQWriterSuite(t *testing.T) {
+	s := new(dlqWriterSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqWriterSuite) SetupTest() {
+	zapLogger, err := zap.NewDevelopment()
+	s.Require().NoError(err)
+	s.logger = loggerimpl.NewLogger(zapLogger)
+
+	s.mockMsg = &messageMocks.Message{}
+	s.mockExecutionManager = &mocks.ExecutionManager{}
+	s.mockExecutionManagerFactory = &mocks.ExecutionManagerFactory{}
+	s.writer = newDLQWriter(s.mockExecutionManagerFactory, 4)
+}
+
+func (s *dlqWriterSuite) TearDownTest() {
+	s.mockMsg.AssertExpectations(s.T())
+	s.mockExecutionManager.AssertExpectations(s.T())
+	s.mockExecutionManagerFactory.AssertExpectations(s.T())
+}
+
+func (s *dlqWriterSuite) TestNack_PutToDLQ() {
+	taskInfo := &persistence.ReplicationTaskInfo{TaskID: 1234, WorkflowID: "some random workflow ID"}
+	shardID := common.WorkflowIDToHistoryShard(taskInfo.WorkflowID, 4)
+	s.mockExecutionManagerFactory.On("NewExecutionManager", shardID).Return(s.mockExecutionManager, nil).Once()
+	s.mockExecutionManager.On("PutReplicationTaskToDLQ", mock.Anything, &persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: "some random source cluster",
+		TaskInfo:          taskInfo,
+	}).Return(nil).Twice()
+	s.mockMsg.On("Ack").Return(nil).Twice()
+
+	msg := &dlqMessage{
+		Message:       s.mockMsg,
+		sourceCluster: "some random source cluster",
+		taskInfo:      taskInfo,
+		writer:        s.writer,
+		logger:        s.logger,
+	}
+	s.NoError(msg.Nack())
+	// the execution manager of the shard is reused
+	s.NoError(msg.Nack())
+}
+
+func (s *dlqWriterSuite) TestNack_FallbackToKafkaDLQ() {
+	taskInfo := &persistence.ReplicationTaskInfo{TaskID: 1234, WorkflowID: "some random workflow ID"}
+	shardID := common.WorkflowIDToHistoryShard(taskInfo.WorkflowID, 4)
+	s.mockExecutionManagerFactory.On("NewExecutionManager", shardID).Return(s.mockExecutionManager, nil).Once()
+	s.mockExecutionManager.On("PutReplicationTaskToDLQ", mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
+	s.mockMsg.On("Nack").Return(nil).Once()
+
+	msg := &dlqMessage{
+		Message:       s.mockMsg,
+		sourceCluster: "some random source cluster",
+		taskInfo:      taskInfo,
+		writer:        s.writer,
+		logger:        s.logger,
+	}
+	s.NoError(msg.Nack())
+}
@@ -21,8 +21,11 @@
 package replicator
 
 import (
+	"context"
+
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -55,7 +58,7 @@ var (
 type (
 	// DomainReplicator is the interface which can replicate the domain
 	DomainReplicator interface {
-		HandleReceivingTask(task *replicator.DomainTaskAttributes) error
+		HandleReceivingTask(ctx context.Context, task *replicator.DomainTaskAttributes) error
 	}
 
 	domainReplicatorImpl struct {
@@ -73,23 +76,23 @@ func NewDomainReplicator(metadataManagerV2 persistence.MetadataManager, logger l
 }
 
 // HandleReceiveTask handle receiving of the domain replication task
-func (domainReplicator *domainReplicatorImpl) HandleReceivingTask(task *replicator.DomainTaskAttributes) error {
+func (domainReplicator *domainReplicatorImpl) HandleReceivingTask(ctx context.Context, task *replicator.DomainTaskAttributes) error {
 	if err := domainReplicator.validateDomainReplicationTask(task); err != nil {
 		return err
 	}
 
 	switch task.GetDomainOperation() {
 	case replicator.DomainOperationCreate:
-		return domainReplicator.handleDomainCreationReplicationTask(task)
+		return domainReplicator.handleDomainCreationReplicationTask(ctx, task)
 	case replicator.DomainOperationUpdate:
-		return domainReplicator.handleDomainUpdateReplicationTask(task)
+		return domainReplicator.handleDomainUpdateReplicationTask(ctx, task)
 	default:
 		return ErrInvalidDomainOperation
 	}
 }
 
 // handleDomainCreationReplicationTask handle the domain creation replication task
-func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTask(task *replicator.DomainTaskAttributes) error {
+func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTask(ctx context.Context, task *replicator.DomainTaskAttributes) error {
 	// task already validated
 	status, err := domainReplicator.convertDomainStatusFromThrift(task.Info.Status)
 	if err != nil {
@@ -120,14 +123,14 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 		FailoverVersion: task.GetFailoverVersion(),
 	}
 
-	_, err = domainReplicator.metadataManagerV2.CreateDomain(request)
+	_, err = domainReplicator.metadataManagerV2.CreateDomain(ctx, request)
 	if err != nil {
 		// SQL and Cassandra handle domain UUID collision differently
 		// here, whenever seeing a error replicating a domain
 		// do a check if there is a name / UUID collision
 
 		recordExists := true
-		resp, getErr := domainReplicator.metadataManagerV2.GetDomain(&persistence.GetDomainRequest{
+		resp, getErr := domainReplicator.metadataManagerV2.GetDomain(ctx, &persistence.GetDomainRequest{
 			Name: task.Info.GetName(),
 		})
 		switch getErr.(type) {
@@ -143,7 +146,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 			return err
 		}
 
-		resp, getErr = domainReplicator.metadataManagerV2.GetDomain(&persistence.GetDomainRequest{
+		resp, getErr = domainReplicator.metadataManagerV2.GetDomain(ctx, &persistence.GetDomainRequest{
 			ID: task.GetID(),
 		})
 		switch getErr.(type) {
@@ -170,7 +173,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 }
 
 // handleDomainUpdateReplicationTask handle the domain update replication task
-func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(task *replicator.DomainTaskAttributes) error {
+func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(ctx context.Context, task *replicator.DomainTaskAttributes) error {
 	// task already validated
 	status, err := domainReplicator.convertDomainStatusFromThrift(task.Info.Status)
 	if err != nil {
@@ -178,7 +181,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 	}
 
 	// first we need to get the current notification version since we need to it for conditional update
-	metadata, err := domainReplicator.metadataManagerV2.GetMetadata()
+	metadata, err := domainReplicator.metadataManagerV2.GetMetadata(ctx)
 	if err != nil {
 		return err
 	}
@@ -186,14 +189,14 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 
 	// plus, we need to check whether the config version is <= the config version set in the input
 	// plus, we need to check whether the failover version is <= the failover version set in the input
-	resp, err := domainReplicator.metadataManagerV2.GetDomain(&persistence.GetDomainRequest{
+	resp, err := domainReplicator.metadataManagerV2.GetDomain(ctx, &persistence.GetDomainRequest{
 		Name: task.Info.GetName(),
 	})
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
 			// this can happen if the create domain replication task is to processed.
 			// e.g. new cluster which does not have anything
-			return domainReplicator.handleDomainCreationReplicationTask(task)
+			return domainReplicator.handleDomainCreationReplicationTask(ctx, task)
 		}
 		return err
 	}
@@ -206,6 +209,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		ConfigVersion:               resp.ConfigVersion,
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
+		FailoverDrainEndTime:        resp.FailoverDrainEndTime,
 		NotificationVersion:         notificationVersion,
 	}
 
@@ -236,13 +240,22 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
 		request.FailoverVersion = task.GetFailoverVersion()
 		request.FailoverNotificationVersion = notificationVersion
+		// the drain is local to this cluster and ends with the failover
+		request.FailoverDrainEndTime = 0
+		// the failover history is recorded without bumping the config version
+		if history, ok := task.Info.Data[common.DomainDataKeyForFailoverHistory]; ok {
+			if request.Info.Data == nil {
+				request.Info.Data = map[string]string{}
+			}
+			request.Info.Data[common.DomainDataKeyForFailoverHistory] = history
+		}
 	}
 
 	if !recordUpdated {
 		return nil
 	}
 
-	return domainReplicator.metadataManagerV2.UpdateDomain(request)
+	return domainReplicator.metadataManagerV2.UpdateDomain(ctx, request)
 }
 
 func (domainReplicator *domainReplicatorImpl) validateDomainReplicationTask(task *replicator.DomainTaskAttributes) error {
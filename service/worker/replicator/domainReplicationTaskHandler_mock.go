@@ -20,6 +20,7 @@
 
 package replicator
 
+import "context"
 import mock "github.com/stretchr/testify/mock"
 import replicator "github.com/uber/cadence/.gen/go/replicator"
 
@@ -28,13 +29,13 @@ type MockDomainReplicator struct {
 	mock.Mock
 }
 
-// HandleReceivingTask provides a mock function with given fields: task
-func (_m *MockDomainReplicator) HandleReceivingTask(task *replicator.DomainTaskAttributes) error {
-	ret := _m.Called(task)
+// HandleReceivingTask provides a mock function with given fields: ctx, task
+func (_m *MockDomainReplicator) HandleReceivingTask(ctx context.Context, task *replicator.DomainTaskAttributes) error {
+	ret := _m.Called(ctx, task)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*replicator.DomainTaskAttributes) error); ok {
-		r0 = rf(task)
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.DomainTaskAttributes) error); ok {
+		r0 = rf(ctx, task)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -21,6 +21,7 @@
 package replicator
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pborman/uuid"
@@ -119,18 +120,18 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_RegisterDomainTask_NameU
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	err := s.domainReplicator.HandleReceivingTask(task)
+	err := s.domainReplicator.HandleReceivingTask(context.Background(), task)
 	s.Nil(err)
 
 	task.ID = common.StringPtr(uuid.New())
 	task.Info.Name = common.StringPtr(name)
-	err = s.domainReplicator.HandleReceivingTask(task)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), task)
 	s.NotNil(err)
 	s.IsType(&shared.BadRequestError{}, err)
 
 	task.ID = common.StringPtr(id)
 	task.Info.Name = common.StringPtr("other random domain test name")
-	err = s.domainReplicator.HandleReceivingTask(task)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), task)
 	s.NotNil(err)
 	s.IsType(&shared.BadRequestError{}, err)
 }
@@ -184,13 +185,13 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_RegisterDomainTask() {
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	metadata, err := s.MetadataManagerV2.GetMetadata()
+	metadata, err := s.MetadataManagerV2.GetMetadata(context.Background())
 	s.Nil(err)
 	notificationVersion := metadata.NotificationVersion
-	err = s.domainReplicator.HandleReceivingTask(task)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), task)
 	s.Nil(err)
 
-	resp, err := s.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{ID: id})
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{ID: id})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(id, resp.Info.ID)
@@ -211,7 +212,7 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_RegisterDomainTask() {
 	s.Equal(notificationVersion, resp.NotificationVersion)
 
 	// handle duplicated task
-	err = s.domainReplicator.HandleReceivingTask(task)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), task)
 	s.Nil(err)
 }
 
@@ -264,13 +265,13 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_DomainN
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	metadata, err := s.MetadataManagerV2.GetMetadata()
+	metadata, err := s.MetadataManagerV2.GetMetadata(context.Background())
 	s.Nil(err)
 	notificationVersion := metadata.NotificationVersion
-	err = s.domainReplicator.HandleReceivingTask(updateTask)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), updateTask)
 	s.Nil(err)
 
-	resp, err := s.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{Name: name})
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(id, resp.Info.ID)
@@ -340,7 +341,7 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	err := s.domainReplicator.HandleReceivingTask(createTask)
+	err := s.domainReplicator.HandleReceivingTask(context.Background(), createTask)
 	s.Nil(err)
 
 	// success update case
@@ -387,12 +388,12 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 		ConfigVersion:   common.Int64Ptr(updateConfigVersion),
 		FailoverVersion: common.Int64Ptr(updateFailoverVersion),
 	}
-	metadata, err := s.MetadataManagerV2.GetMetadata()
+	metadata, err := s.MetadataManagerV2.GetMetadata(context.Background())
 	s.Nil(err)
 	notificationVersion := metadata.NotificationVersion
-	err = s.domainReplicator.HandleReceivingTask(updateTask)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), updateTask)
 	s.Nil(err)
-	resp, err := s.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{Name: name})
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(id, resp.Info.ID)
@@ -462,7 +463,7 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	err := s.domainReplicator.HandleReceivingTask(createTask)
+	err := s.domainReplicator.HandleReceivingTask(context.Background(), createTask)
 	s.Nil(err)
 
 	// success update case
@@ -510,12 +511,12 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 		ConfigVersion:   common.Int64Ptr(updateConfigVersion),
 		FailoverVersion: common.Int64Ptr(updateFailoverVersion),
 	}
-	metadata, err := s.MetadataManagerV2.GetMetadata()
+	metadata, err := s.MetadataManagerV2.GetMetadata(context.Background())
 	s.Nil(err)
 	notificationVersion := metadata.NotificationVersion
-	err = s.domainReplicator.HandleReceivingTask(updateTask)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), updateTask)
 	s.Nil(err)
-	resp, err := s.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{Name: name})
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(id, resp.Info.ID)
@@ -585,7 +586,7 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdat
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	err := s.domainReplicator.HandleReceivingTask(createTask)
+	err := s.domainReplicator.HandleReceivingTask(context.Background(), createTask)
 	s.Nil(err)
 
 	// success update case
@@ -631,12 +632,12 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdat
 		ConfigVersion:   common.Int64Ptr(updateConfigVersion),
 		FailoverVersion: common.Int64Ptr(updateFailoverVersion),
 	}
-	metadata, err := s.MetadataManagerV2.GetMetadata()
+	metadata, err := s.MetadataManagerV2.GetMetadata(context.Background())
 	s.Nil(err)
 	notificationVersion := metadata.NotificationVersion
-	err = s.domainReplicator.HandleReceivingTask(updateTask)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), updateTask)
 	s.Nil(err)
-	resp, err := s.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{Name: name})
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(id, resp.Info.ID)
@@ -657,6 +658,61 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdat
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
 
+func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdateConfig_UpdateActiveCluster_FailoverHistory() {
+	operation := replicator.DomainOperationCreate
+	id := uuid.New()
+	name := "some random domain test name"
+	status := shared.DomainStatusRegistered
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	configVersion := int64(0)
+	failoverVersion := int64(59)
+	clusters := []*shared.ClusterReplicationConfiguration{
+		&shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterActive),
+		},
+		&shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterStandby),
+		},
+	}
+	newTask := func(operation replicator.DomainOperation, data map[string]string, activeCluster string,
+		configVersion int64, failoverVersion int64) *replicator.DomainTaskAttributes {
+		return &replicator.DomainTaskAttributes{
+			DomainOperation: &operation,
+			ID:              common.StringPtr(id),
+			Info: &shared.DomainInfo{
+				Name:   common.StringPtr(name),
+				Status: &status,
+				Data:   data,
+			},
+			Config: &shared.DomainConfiguration{
+				WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(1),
+				EmitMetric:                             common.BoolPtr(true),
+			},
+			ReplicationConfig: &shared.DomainReplicationConfiguration{
+				ActiveClusterName: common.StringPtr(activeCluster),
+				Clusters:          clusters,
+			},
+			ConfigVersion:   common.Int64Ptr(configVersion),
+			FailoverVersion: common.Int64Ptr(failoverVersion),
+		}
+	}
+
+	err := s.domainReplicator.HandleReceivingTask(context.Background(), newTask(operation, map[string]string{"k": "v"}, clusterActive, configVersion, failoverVersion))
+	s.Nil(err)
+
+	// the failover history is taken from the task, the rest of the domain data is not
+	history := `[{"failoverTimeNano":1,"fromCluster":"some random active cluster name","toCluster":"some random standby cluster name"}]`
+	updateData := map[string]string{"k": "v2", common.DomainDataKeyForFailoverHistory: history}
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), newTask(replicator.DomainOperationUpdate, updateData, clusterStandby, configVersion, failoverVersion+1))
+	s.Nil(err)
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
+	s.Nil(err)
+	s.Equal(clusterStandby, resp.ReplicationConfig.ActiveClusterName)
+	s.Equal(failoverVersion+1, resp.FailoverVersion)
+	s.Equal(map[string]string{"k": "v", common.DomainDataKeyForFailoverHistory: history}, resp.Info.Data)
+}
+
 func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdateConfig_NoUpdateActiveCluster() {
 	operation := replicator.DomainOperationCreate
 	id := uuid.New()
@@ -705,10 +761,10 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdat
 		ConfigVersion:   common.Int64Ptr(configVersion),
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
-	metadata, err := s.MetadataManagerV2.GetMetadata()
+	metadata, err := s.MetadataManagerV2.GetMetadata(context.Background())
 	s.Nil(err)
 	notificationVersion := metadata.NotificationVersion
-	err = s.domainReplicator.HandleReceivingTask(createTask)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), createTask)
 	s.Nil(err)
 
 	// success update case
@@ -754,9 +810,9 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdat
 		ConfigVersion:   common.Int64Ptr(updateConfigVersion),
 		FailoverVersion: common.Int64Ptr(updateFailoverVersion),
 	}
-	err = s.domainReplicator.HandleReceivingTask(updateTask)
+	err = s.domainReplicator.HandleReceivingTask(context.Background(), updateTask)
 	s.Nil(err)
-	resp, err := s.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{Name: name})
+	resp, err := s.MetadataManagerV2.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: name})
 	s.Nil(err)
 	s.NotNil(resp)
 	s.Equal(id, resp.Info.ID)
@@ -64,6 +64,7 @@ type (
 		msgEncoder              codec.BinaryEncoder
 		timeSource              clock.TimeSource
 		sequentialTaskProcessor task.SequentialTaskProcessor
+		dlqWriter               *dlqWriter
 	}
 )
 
@@ -83,7 +84,7 @@ var (
 func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string, client messaging.Client, config *Config,
 	logger log.Logger, metricsClient metrics.Client, domainReplicator DomainReplicator,
 	historyRereplicator xdc.HistoryRereplicator, historyClient history.Client,
-	sequentialTaskProcessor task.SequentialTaskProcessor) *replicationTaskProcessor {
+	sequentialTaskProcessor task.SequentialTaskProcessor, dlqWriter *dlqWriter) *replicationTaskProcessor {
 
 	retryableHistoryClient := history.NewRetryableClient(historyClient, common.CreateHistoryServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
@@ -103,6 +104,7 @@ func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string,
 		msgEncoder:              codec.NewThriftRWEncoder(),
 		timeSource:              clock.NewRealTimeSource(),
 		sequentialTaskProcessor: sequentialTaskProcessor,
+		dlqWriter:               dlqWriter,
 	}
 }
 
@@ -285,7 +287,9 @@ func (p *replicationTaskProcessor) handleDomainReplicationTask(task *replicator.
 		}
 	}()
 
-	err := p.domainReplicator.HandleReceivingTask(task.DomainTaskAttributes)
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+	err := p.domainReplicator.HandleReceivingTask(ctx, task.DomainTaskAttributes)
 	if err != nil {
 		return err
 	}
@@ -319,23 +323,42 @@ func (p *replicationTaskProcessor) handleSyncShardTask(task *replicator.Replicat
 }
 
 func (p *replicationTaskProcessor) handleActivityTask(task *replicator.ReplicationTask, msg messaging.Message, logger log.Logger) error {
-	activityReplicationTask := newActivityReplicationTask(task, msg, logger,
+	activityReplicationTask := newActivityReplicationTask(task, p.wrapDLQMessage(task, msg, logger), logger,
 		p.config, p.timeSource, p.historyClient, p.metricsClient, p.historyRereplicator)
 	return p.sequentialTaskProcessor.Submit(activityReplicationTask)
 }
 
 func (p *replicationTaskProcessor) handleHistoryReplicationTask(task *replicator.ReplicationTask, msg messaging.Message, logger log.Logger) error {
-	historyReplicationTask := newHistoryReplicationTask(task, msg, p.sourceCluster, logger,
+	historyReplicationTask := newHistoryReplicationTask(task, p.wrapDLQMessage(task, msg, logger), p.sourceCluster, logger,
 		p.config, p.timeSource, p.historyClient, p.metricsClient, p.historyRereplicator)
 	return p.sequentialTaskProcessor.Submit(historyReplicationTask)
 }
 
 func (p *replicationTaskProcessor) handleHistoryMetadataReplicationTask(task *replicator.ReplicationTask, msg messaging.Message, logger log.Logger) error {
-	historyMetadataReplicationTask := newHistoryMetadataReplicationTask(task, msg, p.sourceCluster, logger,
+	historyMetadataReplicationTask := newHistoryMetadataReplicationTask(task, p.wrapDLQMessage(task, msg, logger), p.sourceCluster, logger,
 		p.config, p.timeSource, p.historyClient, p.metricsClient, p.historyRereplicator)
 	return p.sequentialTaskProcessor.Submit(historyMetadataReplicationTask)
 }
 
+// wrapDLQMessage makes the workflow replication task go to the replication DLQ when it
+// exhausts all retries, the kafka DLQ topic is used if the task cannot be put into the replication DLQ
+func (p *replicationTaskProcessor) wrapDLQMessage(task *replicator.ReplicationTask, msg messaging.Message, logger log.Logger) messaging.Message {
+	if p.dlqWriter == nil {
+		return msg
+	}
+	taskInfo, ok := xdc.ToDLQReplicationTaskInfo(task)
+	if !ok {
+		return msg
+	}
+	return &dlqMessage{
+		Message:       msg,
+		sourceCluster: p.sourceCluster,
+		taskInfo:      taskInfo,
+		writer:        p.dlqWriter,
+		logger:        logger,
+	}
+}
+
 func (p *replicationTaskProcessor) updateFailureMetric(scope int, err error) {
 	// Always update failure counter for all replicator errors
 	p.metricsClient.IncCounter(scope, metrics.ReplicatorFailures)
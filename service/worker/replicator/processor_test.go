@@ -107,6 +107,7 @@ func (s *replicationTaskProcessorSuite) SetupTest() {
 		s.mockRereplicator,
 		s.mockHistoryClient,
 		s.mockSequentialTaskProcessor,
+		nil,
 	)
 }
 
@@ -138,7 +139,7 @@ func (s *replicationTaskProcessorSuite) TestDecodeMsgAndSubmit_Domain_Success()
 	replicationTaskBinary, err := s.msgEncoder.Encode(replicationTask)
 	s.Nil(err)
 	s.mockMsg.On("Value").Return(replicationTaskBinary)
-	s.mockDomainReplicator.On("HandleReceivingTask", replicationAttr).Return(nil).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", mock.Anything, replicationAttr).Return(nil).Once()
 	s.mockMsg.On("Ack").Return(nil).Once()
 
 	s.processor.decodeMsgAndSubmit(s.mockMsg)
@@ -156,8 +157,8 @@ func (s *replicationTaskProcessorSuite) TestDecodeMsgAndSubmit_Domain_FailedThen
 	replicationTaskBinary, err := s.msgEncoder.Encode(replicationTask)
 	s.Nil(err)
 	s.mockMsg.On("Value").Return(replicationTaskBinary)
-	s.mockDomainReplicator.On("HandleReceivingTask", replicationAttr).Return(errors.New("some random error")).Once()
-	s.mockDomainReplicator.On("HandleReceivingTask", replicationAttr).Return(nil).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", mock.Anything, replicationAttr).Return(errors.New("some random error")).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", mock.Anything, replicationAttr).Return(nil).Once()
 	s.mockMsg.On("Ack").Return(nil).Once()
 
 	s.processor.decodeMsgAndSubmit(s.mockMsg)
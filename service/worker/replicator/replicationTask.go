@@ -45,6 +45,7 @@ type (
 	workflowReplicationTask struct {
 		metricsScope int
 		startTime    time.Time
+		creationTime time.Time
 		queueID      definition.WorkflowIdentifier
 		taskID       int64
 		attempt      int
@@ -100,6 +101,7 @@ func newActivityReplicationTask(task *replicator.ReplicationTask, msg messaging.
 		workflowReplicationTask: workflowReplicationTask{
 			metricsScope: metrics.SyncActivityTaskScope,
 			startTime:    timeSource.Now(),
+			creationTime: getCreationTime(task),
 			queueID: definition.NewWorkflowIdentifier(
 				attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId(),
 			),
@@ -144,6 +146,7 @@ func newHistoryReplicationTask(task *replicator.ReplicationTask, msg messaging.M
 		workflowReplicationTask: workflowReplicationTask{
 			metricsScope: metrics.HistoryReplicationTaskScope,
 			startTime:    timeSource.Now(),
+			creationTime: getCreationTime(task),
 			queueID: definition.NewWorkflowIdentifier(
 				attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId(),
 			),
@@ -192,6 +195,7 @@ func newHistoryMetadataReplicationTask(task *replicator.ReplicationTask, msg mes
 		workflowReplicationTask: workflowReplicationTask{
 			metricsScope: metrics.HistoryMetadataReplicationTaskScope,
 			startTime:    timeSource.Now(),
+			creationTime: getCreationTime(task),
 			queueID: definition.NewWorkflowIdentifier(
 				attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId(),
 			),
@@ -344,6 +348,9 @@ func (t *workflowReplicationTask) RetryErr(err error) bool {
 func (t *workflowReplicationTask) Ack() {
 	t.metricsClient.IncCounter(t.metricsScope, metrics.ReplicatorMessages)
 	t.metricsClient.RecordTimer(t.metricsScope, metrics.ReplicatorLatency, t.timeSource.Now().Sub(t.startTime))
+	if !t.creationTime.IsZero() {
+		t.metricsClient.RecordTimer(t.metricsScope, metrics.ReplicatorTaskLag, t.timeSource.Now().Sub(t.creationTime))
+	}
 
 	// the underlying implementation will not return anything other than nil
 	// do logging just in case
@@ -365,6 +372,15 @@ func (t *workflowReplicationTask) Nack() {
 	}
 }
 
+// getCreationTime returns when the task was created on the source cluster,
+// or the zero time if the source cluster does not report it
+func getCreationTime(task *replicator.ReplicationTask) time.Time {
+	if task.CreationTime == nil {
+		return time.Time{}
+	}
+	return time.Unix(0, task.GetCreationTime())
+}
+
 func (t *workflowReplicationTask) convertRetryTaskError(err error) (*shared.RetryTaskError, bool) {
 	retError, ok := err.(*shared.RetryTaskError)
 	return retError, ok
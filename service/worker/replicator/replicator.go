@@ -56,6 +56,7 @@ type (
 		logger            log.Logger
 		metricsClient     metrics.Client
 		historySerializer persistence.PayloadSerializer
+		dlqWriter         *dlqWriter
 	}
 
 	// Config contains all the replication config for worker
@@ -78,7 +79,8 @@ const (
 // NewReplicator creates a new replicator for processing replication tasks
 func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persistence.MetadataManager,
 	domainCache cache.DomainCache, clientBean client.Bean, config *Config,
-	client messaging.Client, logger log.Logger, metricsClient metrics.Client) *Replicator {
+	client messaging.Client, executionManagerFactory persistence.ExecutionManagerFactory, numberOfHistoryShards int,
+	logger log.Logger, metricsClient metrics.Client) *Replicator {
 
 	logger = logger.WithTags(tag.ComponentReplicator)
 	return &Replicator{
@@ -92,6 +94,7 @@ func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persisten
 		logger:            logger,
 		metricsClient:     metricsClient,
 		historySerializer: persistence.NewPayloadSerializer(),
+		dlqWriter:         newDLQWriter(executionManagerFactory, numberOfHistoryShards),
 	}
 }
 
@@ -138,6 +141,7 @@ func (r *Replicator) Start() error {
 					r.metricsClient,
 					logger,
 				),
+				r.dlqWriter,
 			))
 		}
 	}
@@ -156,6 +160,7 @@ func (r *Replicator) Stop() {
 	for _, processor := range r.processors {
 		processor.Stop()
 	}
+	r.dlqWriter.close()
 	r.domainCache.Stop()
 }
 
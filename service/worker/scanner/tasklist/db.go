@@ -21,6 +21,7 @@
 package tasklist
 
 import (
+	"context"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -30,11 +31,11 @@ import (
 
 var retryForeverPolicy = newRetryForeverPolicy()
 
-func (s *Scavenger) completeTasks(key *taskListKey, taskID int64, limit int) (int, error) {
+func (s *Scavenger) completeTasks(ctx context.Context, key *taskListKey, taskID int64, limit int) (int, error) {
 	var n int
 	var err error
 	s.retryForever(func() error {
-		n, err = s.db.CompleteTasksLessThan(&p.CompleteTasksLessThanRequest{
+		n, err = s.db.CompleteTasksLessThan(ctx, &p.CompleteTasksLessThanRequest{
 			DomainID:     key.DomainID,
 			TaskListName: key.Name,
 			TaskType:     key.TaskType,
@@ -46,11 +47,11 @@ func (s *Scavenger) completeTasks(key *taskListKey, taskID int64, limit int) (in
 	return n, err
 }
 
-func (s *Scavenger) getTasks(key *taskListKey, batchSize int) (*p.GetTasksResponse, error) {
+func (s *Scavenger) getTasks(ctx context.Context, key *taskListKey, batchSize int) (*p.GetTasksResponse, error) {
 	var err error
 	var resp *p.GetTasksResponse
 	s.retryForever(func() error {
-		resp, err = s.db.GetTasks(&p.GetTasksRequest{
+		resp, err = s.db.GetTasks(ctx, &p.GetTasksRequest{
 			DomainID:  key.DomainID,
 			TaskList:  key.Name,
 			TaskType:  key.TaskType,
@@ -62,11 +63,11 @@ func (s *Scavenger) getTasks(key *taskListKey, batchSize int) (*p.GetTasksRespon
 	return resp, err
 }
 
-func (s *Scavenger) listTaskList(pageSize int, pageToken []byte) (*p.ListTaskListResponse, error) {
+func (s *Scavenger) listTaskList(ctx context.Context, pageSize int, pageToken []byte) (*p.ListTaskListResponse, error) {
 	var err error
 	var resp *p.ListTaskListResponse
 	s.retryForever(func() error {
-		resp, err = s.db.ListTaskList(&p.ListTaskListRequest{
+		resp, err = s.db.ListTaskList(ctx, &p.ListTaskListRequest{
 			PageSize:  pageSize,
 			PageToken: pageToken,
 		})
@@ -75,10 +76,10 @@ func (s *Scavenger) listTaskList(pageSize int, pageToken []byte) (*p.ListTaskLis
 	return resp, err
 }
 
-func (s *Scavenger) deleteTaskList(key *taskListKey, rangeID int64) error {
+func (s *Scavenger) deleteTaskList(ctx context.Context, key *taskListKey, rangeID int64) error {
 	// retry only on service busy errors
 	return backoff.Retry(func() error {
-		return s.db.DeleteTaskList(&p.DeleteTaskListRequest{
+		return s.db.DeleteTaskList(ctx, &p.DeleteTaskListRequest{
 			DomainID:     key.DomainID,
 			TaskListName: key.Name,
 			TaskListType: key.TaskType,
@@ -62,7 +62,7 @@ func (s *Scavenger) deleteHandler(key *taskListKey, state *taskListState) handle
 	defer func() { s.deleteHandlerLog(key, state, nProcessed, nDeleted, err) }()
 
 	for nProcessed < maxTasksPerJob {
-		resp, err1 := s.getTasks(key, taskBatchSize)
+		resp, err1 := s.getTasks(s.cancelCtx, key, taskBatchSize)
 		if err1 != nil {
 			err = err1
 			return handlerStatusErr
@@ -82,7 +82,7 @@ func (s *Scavenger) deleteHandler(key *taskListKey, state *taskListState) handle
 		}
 
 		taskID := resp.Tasks[nTasks-1].TaskID
-		if _, err = s.completeTasks(key, taskID, nTasks); err != nil {
+		if _, err = s.completeTasks(s.cancelCtx, key, taskID, nTasks); err != nil {
 			return handlerStatusErr
 		}
 
@@ -112,7 +112,7 @@ func (s *Scavenger) tryDeleteTaskList(key *taskListKey, state *taskListState) {
 	//     of idle timeout). If any new host has to take ownership of this at this time, it can only
 	//     do so by updating the rangeID
 	//   - deleteTaskList is a conditional delete where condition is the rangeID
-	if err := s.deleteTaskList(key, state.rangeID); err != nil {
+	if err := s.deleteTaskList(s.cancelCtx, key, state.rangeID); err != nil {
 		s.logger.Error("deleteTaskList error", tag.Error(err))
 		return
 	}
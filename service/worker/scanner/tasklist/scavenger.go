@@ -21,6 +21,7 @@
 package tasklist
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -36,14 +37,16 @@ import (
 type (
 	// Scavenger is the type that holds the state for task list scavenger daemon
 	Scavenger struct {
-		db       p.TaskManager
-		executor executor.Executor
-		metrics  metrics.Client
-		logger   log.Logger
-		stats    stats
-		status   int32
-		stopC    chan struct{}
-		stopWG   sync.WaitGroup
+		db         p.TaskManager
+		executor   executor.Executor
+		metrics    metrics.Client
+		logger     log.Logger
+		stats      stats
+		status     int32
+		stopC      chan struct{}
+		stopWG     sync.WaitGroup
+		cancelCtx  context.Context
+		cancelFunc context.CancelFunc
 	}
 
 	taskListKey struct {
@@ -102,14 +105,17 @@ var (
 //  - Stop() method is called to stop the scavenger
 func NewScavenger(db p.TaskManager, metricsClient metrics.Client, logger log.Logger) *Scavenger {
 	stopC := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
 	taskExecutor := executor.NewFixedSizePoolExecutor(
 		taskListBatchSize, executorMaxDeferredTasks, metricsClient, metrics.TaskListScavengerScope)
 	return &Scavenger{
-		db:       db,
-		metrics:  metricsClient,
-		logger:   logger,
-		stopC:    stopC,
-		executor: taskExecutor,
+		db:         db,
+		metrics:    metricsClient,
+		logger:     logger,
+		stopC:      stopC,
+		executor:   taskExecutor,
+		cancelCtx:  ctx,
+		cancelFunc: cancel,
 	}
 }
 
@@ -134,6 +140,7 @@ func (s *Scavenger) Stop() {
 	s.metrics.IncCounter(metrics.TaskListScavengerScope, metrics.StoppedCount)
 	s.logger.Info("Tasklist scavenger stopping")
 	close(s.stopC)
+	s.cancelFunc()
 	s.executor.Stop()
 	s.stopWG.Wait()
 	s.logger.Info("Tasklist scavenger stopped")
@@ -154,7 +161,7 @@ func (s *Scavenger) run() {
 
 	var pageToken []byte
 	for {
-		resp, err := s.listTaskList(taskListBatchSize, pageToken)
+		resp, err := s.listTaskList(s.cancelCtx, taskListBatchSize, pageToken)
 		if err != nil {
 			s.logger.Error("listTaskList error", tag.Error(err))
 			return
@@ -56,7 +56,7 @@ func (s *scannerWorkflowTestSuite) TestWorkflow() {
 func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	env := s.NewTestActivityEnvironment()
 	taskDB := &mocks.TaskManager{}
-	taskDB.On("ListTaskList", mock.Anything).Return(&p.ListTaskListResponse{}, nil)
+	taskDB.On("ListTaskList", mock.Anything, mock.Anything).Return(&p.ListTaskListResponse{}, nil)
 	ctx := scannerContext{
 		taskDB:        taskDB,
 		domainDB:      &mocks.MetadataManager{},
@@ -21,6 +21,7 @@
 package worker
 
 import (
+	"context"
 	"sync/atomic"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/payload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
@@ -41,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/uber/cadence/service/worker/failover"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
@@ -51,6 +54,7 @@ type (
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
 	// 2. Indexer: Handles uploading of visibility records to elastic search.
 	// 3. Archiver: Handles archival of workflow histories.
+	// 4. Failover: Handles graceful failovers of global domains.
 	Service struct {
 		stopC         chan struct{}
 		isStopped     int32
@@ -65,10 +69,14 @@ type (
 		ReplicationCfg  *replicator.Config
 		ArchiverConfig  *archiver.Config
 		IndexerCfg      *indexer.Config
+		PayloadCfg      *payload.Config
 		ScannerCfg      *scanner.Config
 		BatcherCfg      *batcher.Config
+		FailoverCfg     *failover.Config
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 		EnableBatcher   dynamicconfig.BoolPropertyFn
+
+		PersistenceFaultInjection *config.FaultInjectionConfig
 	}
 )
 
@@ -110,6 +118,11 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			BlobIntegrityCheckProbability:             dc.GetFloat64Property(dynamicconfig.WorkerBlobIntegrityCheckProbability, 0.002),
 			TimeLimitPerArchivalIteration:             dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 		},
+		PayloadCfg: &payload.Config{
+			EnableOffload: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.HistoryEnablePayloadOffload, false),
+			Threshold:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 256*1024),
+			Bucket:        dc.GetStringProperty(dynamicconfig.PayloadOffloadBucket, ""),
+		},
 		IndexerCfg: &indexer.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
 			ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
@@ -127,8 +140,13 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
-		EnableBatcher:   dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
-		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
+		FailoverCfg: &failover.Config{
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+			ClusterMetadata:  params.ClusterMetadata,
+		},
+		EnableBatcher:             dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
+		ThrottledLogRPS:           dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
+		PersistenceFaultInjection: config.NewFaultInjectionConfig(dc, dynamicconfig.WorkerPersistenceFaultInjectionEnabled),
 	}
 }
 
@@ -140,8 +158,13 @@ func (s *Service) Start() {
 	s.metricsClient = base.GetMetricsClient()
 	s.logger.Info("service starting", tag.ComponentWorker)
 
+	pConfig := s.params.PersistenceConfig
+	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
+	pConfig.FaultInjection = s.config.PersistenceFaultInjection
+	pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, base.GetTracer(), s.logger)
+
 	if s.params.ESConfig.Enable {
-		s.startIndexer(base)
+		s.startIndexer(base, pFactory)
 	}
 
 	replicatorEnabled := base.GetClusterMetadata().IsGlobalDomainEnabled()
@@ -150,15 +173,12 @@ func (s *Service) Start() {
 	batcherEnabled := s.config.EnableBatcher()
 
 	if replicatorEnabled || archiverEnabled || scannerEnabled {
-		pConfig := s.params.PersistenceConfig
-		pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
-		pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
-
-		if archiverEnabled || scannerEnabled {
+		if replicatorEnabled || archiverEnabled || scannerEnabled {
 			s.ensureSystemDomainExists(pFactory, base.GetClusterMetadata().GetCurrentClusterName())
 		}
 		if replicatorEnabled {
 			s.startReplicator(base, pFactory)
+			s.startFailover(base)
 		}
 		if archiverEnabled {
 			s.startArchiver(base, pFactory)
@@ -199,6 +219,21 @@ func (s *Service) startBatcher(base service.Service) {
 	}
 }
 
+func (s *Service) startFailover(base service.Service) {
+	params := &failover.BootstrapParams{
+		Config:         *s.config.FailoverCfg,
+		SDKClient:      s.params.PublicClient,
+		FrontendClient: base.GetClientBean().GetFrontendClient(),
+		HistoryClient:  base.GetClientBean().GetHistoryClient(),
+		MetricsClient:  s.metricsClient,
+		Logger:         s.logger,
+		TallyScope:     s.params.MetricScope,
+	}
+	if err := failover.New(params).Start(); err != nil {
+		s.logger.Fatal("error starting graceful failover worker", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
@@ -228,6 +263,8 @@ func (s *Service) startReplicator(base service.Service, pFactory persistencefact
 		base.GetClientBean(),
 		s.config.ReplicationCfg,
 		base.GetMessagingClient(),
+		pFactory,
+		s.params.PersistenceConfig.NumHistoryShards,
 		s.logger,
 		s.metricsClient)
 	if err := replicator.Start(); err != nil {
@@ -236,11 +273,20 @@ func (s *Service) startReplicator(base service.Service, pFactory persistencefact
 	}
 }
 
-func (s *Service) startIndexer(base service.Service) {
+func (s *Service) startIndexer(base service.Service, pFactory persistencefactory.Factory) {
+	metadataMgr, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
+	if err != nil {
+		s.logger.Fatal("failed to start indexer, could not create MetadataManager", tag.Error(err))
+	}
+	// the indexer routes visibility records by domain name, which is not part of the kafka message
+	domainCache := cache.NewDomainCache(metadataMgr, base.GetClusterMetadata(), s.metricsClient, s.logger)
+	domainCache.Start()
+
 	indexer := indexer.NewIndexer(
 		s.config.IndexerCfg,
 		base.GetMessagingClient(),
-		s.params.ESClient,
+		s.params.ESRouter,
+		domainCache,
 		s.params.ESConfig,
 		s.logger,
 		s.metricsClient)
@@ -282,6 +328,7 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 		HistoryV2Manager: historyV2Manager,
 		Blobstore:        blobstoreClient,
 		DomainCache:      domainCache,
+		PayloadOffloader: payload.NewOffloader(blobstoreClient, s.config.PayloadCfg),
 		Config:           s.config.ArchiverConfig,
 	}
 	clientWorker := archiver.NewClientWorker(bc)
@@ -297,7 +344,7 @@ func (s *Service) ensureSystemDomainExists(pFactory persistencefactory.Factory,
 		s.logger.Fatal("error creating metadataMgr proxy", tag.Error(err))
 	}
 	defer metadataProxy.Close()
-	_, err = metadataProxy.GetDomain(&persistence.GetDomainRequest{Name: common.SystemLocalDomainName})
+	_, err = metadataProxy.GetDomain(context.Background(), &persistence.GetDomainRequest{Name: common.SystemLocalDomainName})
 	switch err.(type) {
 	case nil:
 		return
@@ -315,7 +362,7 @@ func (s *Service) registerSystemDomain(pFactory persistencefactory.Factory, clus
 		s.logger.Fatal("error creating metadataV2Mgr", tag.Error(err))
 	}
 	defer metadataV2.Close()
-	_, err = metadataV2.CreateDomain(&persistence.CreateDomainRequest{
+	_, err = metadataV2.CreateDomain(context.Background(), &persistence.CreateDomainRequest{
 		Info: &persistence.DomainInfo{
 			ID:          common.SystemDomainID,
 			Name:        common.SystemLocalDomainName,
@@ -106,6 +106,28 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDescribeWorkflow(c)
 			},
 		},
+		{
+			Name:    "verify",
+			Aliases: []string{"vf"},
+			Usage:   "Rebuild mutableState from history and compare it with the one in database",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "overwrite the mutableState in database with the rebuilt one if they differ, only the current run without buffered events can be repaired",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVerifyWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -245,6 +267,41 @@ func newAdminDomainCommands() []cli.Command {
 				AdminGetDomainIDOrName(c)
 			},
 		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Change the active cluster of a batch of global domains",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomains,
+					Usage: "Names of the domains to fail over, in format of d1,d2,d3",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainDataWithAlias,
+					Usage: "Fail over the domains whose domain data contains all of the key value pairs, in format of k1:v1,k2:v2",
+				},
+				cli.StringFlag{
+					Name:  FlagActiveClusterNameWithAlias,
+					Usage: "New active cluster name",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: 10,
+					Usage: "Optional, max number of domains failed over at the same time",
+				},
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the failover, recorded in the failover history of the domains",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Security token with permission",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverDomains(c)
+			},
+		},
 	}
 }
 
@@ -520,6 +577,187 @@ func newAdminElasticSearchCommands() []cli.Command {
 				AdminIndex(c)
 			},
 		},
+		{
+			Name:    "putTemplate",
+			Aliases: []string{"pt"},
+			Usage:   "Put index template of a visibility index on ElasticSearch, e.g. the dedicated index of a domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagURL,
+					Usage: "URL of ElasticSearch cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagMuttleyDestinationWithAlias,
+					Usage: "Optional muttely destination to ElasticSearch cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagIndex,
+					Usage: "ElasticSearch visibility index the template applies to",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Optional index template file, default to " + defaultIndexTemplateFile,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPutIndexTemplate(c)
+			},
+		},
+		{
+			Name:    "backfill",
+			Aliases: []string{"bf"},
+			Usage:   "Backfill the visibility records of a domain from Cassandra or MySQL visibility store into ElasticSearch",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagURL,
+					Usage: "URL of ElasticSearch cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagMuttleyDestinationWithAlias,
+					Usage: "Optional muttely destination to ElasticSearch cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagIndex,
+					Usage: "ElasticSearch visibility index of the domain",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainID,
+					Usage: "DomainID",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Optional, backfill workflows started after this time, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional, backfill workflows started before this time, default to now",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Value: 100,
+					Usage: "number of records read from visibility store and indexed per batch",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "max number of records indexed per second",
+				},
+				cli.StringFlag{
+					Name:  FlagCheckpointFile,
+					Usage: "Optional file to checkpoint the progress, an interrupted backfill resumes from it",
+				},
+				cli.BoolFlag{
+					Name:  FlagVerifyOnly,
+					Usage: "only count the records in both stores without indexing",
+				},
+
+				// for visibility store connection
+				cli.StringFlag{
+					Name:  FlagDBType,
+					Value: dbTypeCassandra,
+					Usage: "visibility store type, cassandra or mysql",
+				},
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "visibility store host address",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Value: 9042,
+					Usage: "visibility store port for the host",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "visibility store username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "visibility store password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace",
+				},
+				cli.StringFlag{
+					Name:  FlagDBName,
+					Usage: "mysql database name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminBackfillES(c)
+			},
+		},
+		{
+			Name:        "dlq",
+			Usage:       "Run admin operation on the DLQ of visibility messages ElasticSearch rejected permanently",
+			Subcommands: newAdminElasticSearchDLQCommands(),
+		},
+	}
+}
+
+func newAdminElasticSearchDLQCommands() []cli.Command {
+	dlqFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagInputTopicWithAlias,
+			Usage: "ElasticSearch DLQ topic",
+		},
+		cli.StringFlag{
+			Name:  FlagInputCluster,
+			Usage: "Name of the Kafka cluster of ElasticSearch DLQ topic",
+		},
+		cli.StringFlag{
+			Name: FlagHostFile,
+			Usage: "Kafka host config file in format of: " + `
+tls:
+    enabled: false
+    certFile: ""
+    keyFile: ""
+    bundleFile: ""
+clusters:
+	localKafka:
+		brokers:
+		- 127.0.0.1
+		- 127.0.0.2`,
+		},
+	}
+
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the messages in ElasticSearch DLQ with the rejection reasons",
+			Flags: append(dlqFlags,
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Optional, only the messages of this workflow are included",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminListESDLQ(c)
+			},
+		},
+		{
+			Name:    "replay",
+			Aliases: []string{"r"},
+			Usage:   "Publish the messages in ElasticSearch DLQ to the visibility topic to index them again, e.g. after the mapping is fixed",
+			Flags: append(dlqFlags,
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the Kafka cluster of visibility topic",
+				},
+				cli.StringFlag{
+					Name:  FlagTopic,
+					Usage: "Visibility topic to publish the messages",
+				},
+				cli.StringFlag{
+					Name:  FlagGroup,
+					Usage: "Group to read DLQ, the messages replayed by the group are skipped next time. All messages are replayed, since the group can only remember how far it read",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminReplayESDLQ(c)
+			},
+		},
 	}
 }
 
@@ -546,3 +784,192 @@ func newAdminTaskListCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminEncryptionCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "reencrypt",
+			Aliases: []string{"re"},
+			Usage:   "Re-encrypt history, mutable states and visibility memos stored in database and ElasticSearch with the active key of the keyring",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagKeyringFile,
+					Usage: "keyring file used by the cadence servers",
+				},
+				cli.BoolFlag{
+					Name:  FlagEncryptPlaintext,
+					Usage: "also encrypt history which was written before encryption was enabled",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Value: 100,
+					Usage: "number of rows read from database per page",
+				},
+
+				// for database connection
+				cli.StringFlag{
+					Name:  FlagDBType,
+					Value: dbTypeCassandra,
+					Usage: "database type, cassandra, mysql or sqlite3",
+				},
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "database host address, or the directory of the database files for sqlite3",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Value: 9042,
+					Usage: "database port for the host",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "database username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "database password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace",
+				},
+				cli.StringFlag{
+					Name:  FlagDBName,
+					Usage: "mysql or sqlite3 database name",
+				},
+				cli.StringFlag{
+					Name:  FlagVisibilityDBName,
+					Usage: "visibility keyspace or database name",
+				},
+
+				// for ElasticSearch connection
+				cli.StringFlag{
+					Name:  FlagURL,
+					Usage: "URL of ElasticSearch cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagIndex,
+					Usage: "optional, ElasticSearch index of the visibility documents whose memos are re-encrypted",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReencrypt(c)
+			},
+		},
+	}
+}
+
+func newAdminDLQCommands() []cli.Command {
+	dlqFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagSourceCluster,
+			Usage: "the cluster which the replication tasks are replicated from",
+		},
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "the history shard owning the DLQ",
+		},
+		cli.Int64Flag{
+			Name:  FlagLastMessageID,
+			Usage: "optional, only the tasks up to and including this task ID are included",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "optional, only the tasks of this workflow are included",
+		},
+		cli.StringFlag{
+			Name:  FlagRunIDWithAlias,
+			Usage: "optional, only the tasks of this run are included",
+		},
+	}
+	pageSizeFlag := cli.IntFlag{
+		Name:  FlagPageSizeWithAlias,
+		Value: 100,
+		Usage: "number of tasks processed per request",
+	}
+
+	return []cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r"},
+			Usage:   "Read the replication tasks in the DLQ of a shard, the global domain option filters the tasks by domain",
+			Flags:   append(dlqFlags, pageSizeFlag),
+			Action: func(c *cli.Context) {
+				AdminReadDLQMessages(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete the replication tasks in the DLQ of a shard, the global domain option filters the tasks by domain",
+			Flags:   dlqFlags,
+			Action: func(c *cli.Context) {
+				AdminPurgeDLQMessages(c)
+			},
+		},
+		{
+			Name:    "merge",
+			Aliases: []string{"m"},
+			Usage:   "Re-apply the replication tasks in the DLQ of a shard, the global domain option filters the tasks by domain",
+			Flags:   append(dlqFlags, pageSizeFlag),
+			Action: func(c *cli.Context) {
+				AdminMergeDLQMessages(c)
+			},
+		},
+	}
+}
+
+func newAdminReplicationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "status",
+			Aliases: []string{"s"},
+			Usage:   "Describe the replication backlog of this cluster to the target cluster, the global domain option limits the domain backlog to the domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTargetCluster,
+					Usage: "the cluster which the replication tasks are replicated to",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "optional, only describe this shard",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintFullyDetailWithAlias,
+					Usage: "print the status of each shard",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeReplicationStatus(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "add-search-attr",
+			Aliases: []string{"asa"},
+			Usage:   "Whitelist search attribute",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Search Attribute key to be whitelisted",
+				},
+				cli.IntFlag{
+					Name:  FlagSearchAttributesType,
+					Value: -1,
+					Usage: "Search Attribute value type. [0:String, 1:Keyword, 2:Int, 3:Double, 4:Bool, 5:Datetime]",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAddSearchAttribute(c)
+			},
+		},
+	}
+}
@@ -24,10 +24,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strconv"
 	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/olekukonko/tablewriter"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -51,12 +53,14 @@ func AdminShowWorkflow(c *cli.Context) {
 	sid := c.Int(FlagShardID)
 	outputFileName := c.String(FlagOutputFilename)
 
+	ctx, cancel := newContext(c)
+	defer cancel()
 	session := connectToCassandra(c)
 	serializer := persistence.NewPayloadSerializer()
 	var history []*persistence.DataBlob
 	if len(wid) != 0 {
 		histV1 := cassp.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-		resp, err := histV1.GetWorkflowExecutionHistory(&persistence.InternalGetWorkflowExecutionHistoryRequest{
+		resp, err := histV1.GetWorkflowExecutionHistory(ctx, &persistence.InternalGetWorkflowExecutionHistoryRequest{
 			LastEventBatchVersion: common.EmptyVersion,
 			DomainID:              domainID,
 			Execution: shared.WorkflowExecution{
@@ -74,7 +78,7 @@ func AdminShowWorkflow(c *cli.Context) {
 		history = resp.History
 	} else if len(tid) != 0 {
 		histV2 := cassp.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-		resp, err := histV2.ReadHistoryBranch(&persistence.InternalReadHistoryBranchRequest{
+		resp, err := histV2.ReadHistoryBranch(ctx, &persistence.InternalReadHistoryBranchRequest{
 			TreeID:    tid,
 			BranchID:  bid,
 			MinNodeID: 1,
@@ -182,6 +186,50 @@ func describeMutableState(c *cli.Context) *admin.DescribeWorkflowExecutionRespon
 	return resp
 }
 
+// AdminVerifyWorkflow verifies the mutableState of a workflow execution against its history
+func AdminVerifyWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.VerifyMutableState(ctx, &admin.VerifyMutableStateRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Repair: common.BoolPtr(c.Bool(FlagRepair)),
+	})
+	if err != nil {
+		ErrorAndExit("Verify workflow mutableState failed", err)
+	}
+
+	if len(resp.Differences) == 0 {
+		fmt.Println("MutableState matches the one rebuilt from history.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Category", "Key", "Field", "Stored", "Rebuilt"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, d := range resp.Differences {
+		table.Append([]string{d.GetCategory(), d.GetKey(), d.GetField(), d.GetStoredValue(), d.GetRebuiltValue()})
+	}
+	table.Render()
+
+	if resp.GetRepaired() {
+		fmt.Println("MutableState repaired.")
+	}
+}
+
 // AdminDeleteWorkflow describe a new workflow execution for admin
 func AdminDeleteWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -196,6 +244,8 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	}
 	domainID := ms.ExecutionInfo.DomainID
 	skipError := c.Bool(FlagSkipErrorMode)
+	ctx, cancel := newContext(c)
+	defer cancel()
 	session := connectToCassandra(c)
 	shardID := resp.GetShardId()
 	shardIDInt, err := strconv.Atoi(shardID)
@@ -212,7 +262,7 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		fmt.Println("deleting history events for ...")
 		prettyPrintJSONObject(branchInfo)
 		histV2 := cassp.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-		err = histV2.DeleteHistoryBranch(&persistence.InternalDeleteHistoryBranchRequest{
+		err = histV2.DeleteHistoryBranch(ctx, &persistence.InternalDeleteHistoryBranchRequest{
 			BranchInfo: branchInfo,
 			ShardID:    shardIDInt,
 		})
@@ -225,7 +275,7 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		}
 	} else {
 		histV1 := cassp.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-		err = histV1.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+		err = histV1.DeleteWorkflowExecutionHistory(ctx, &persistence.DeleteWorkflowExecutionHistoryRequest{
 			DomainID: domainID,
 			Execution: shared.WorkflowExecution{
 				WorkflowId: common.StringPtr(wid),
@@ -248,7 +298,7 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		RunID:      rid,
 	}
 
-	err = exeStore.DeleteWorkflowExecution(req)
+	err = exeStore.DeleteWorkflowExecution(ctx, req)
 	if err != nil {
 		if skipError {
 			fmt.Println("delete mutableState row failed, ", err)
@@ -264,7 +314,7 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		RunID:      rid,
 	}
 
-	err = exeStore.DeleteCurrentWorkflowExecution(deleteCurrentReq)
+	err = exeStore.DeleteCurrentWorkflowExecution(ctx, deleteCurrentReq)
 	if err != nil {
 		if skipError {
 			fmt.Println("delete current row failed, ", err)
@@ -0,0 +1,137 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminReadDLQMessages prints the replication tasks in the DLQ of a shard
+func AdminReadDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredShardID(c)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ReadDLQMessages(ctx, &replicator.ReadDLQMessagesRequest{
+			SourceCluster:         common.StringPtr(sourceCluster),
+			ShardID:               common.Int32Ptr(shardID),
+			InclusiveEndMessageID: getLastMessageID(c),
+			MaximumPageSize:       common.Int32Ptr(int32(c.Int(FlagPageSize))),
+			NextPageToken:         nextPageToken,
+			Domain:                getOptionalString(c.GlobalString(FlagDomain)),
+			WorkflowID:            getOptionalString(c.String(FlagWorkflowID)),
+			RunID:                 getOptionalString(c.String(FlagRunID)),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ReadDLQMessages failed.", err)
+		}
+
+		for _, task := range resp.ReplicationTasks {
+			prettyPrintJSONObject(task)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+// AdminPurgeDLQMessages deletes the replication tasks in the DLQ of a shard
+func AdminPurgeDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredShardID(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.PurgeDLQMessages(ctx, &replicator.PurgeDLQMessagesRequest{
+		SourceCluster:         common.StringPtr(sourceCluster),
+		ShardID:               common.Int32Ptr(shardID),
+		InclusiveEndMessageID: getLastMessageID(c),
+		Domain:                getOptionalString(c.GlobalString(FlagDomain)),
+		WorkflowID:            getOptionalString(c.String(FlagWorkflowID)),
+		RunID:                 getOptionalString(c.String(FlagRunID)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation PurgeDLQMessages failed.", err)
+	}
+	fmt.Println("Successfully purged DLQ messages.")
+}
+
+// AdminMergeDLQMessages re-applies the replication tasks in the DLQ of a shard
+func AdminMergeDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredShardID(c)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.MergeDLQMessages(ctx, &replicator.MergeDLQMessagesRequest{
+			SourceCluster:         common.StringPtr(sourceCluster),
+			ShardID:               common.Int32Ptr(shardID),
+			InclusiveEndMessageID: getLastMessageID(c),
+			MaximumPageSize:       common.Int32Ptr(int32(c.Int(FlagPageSize))),
+			NextPageToken:         nextPageToken,
+			Domain:                getOptionalString(c.GlobalString(FlagDomain)),
+			WorkflowID:            getOptionalString(c.String(FlagWorkflowID)),
+			RunID:                 getOptionalString(c.String(FlagRunID)),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation MergeDLQMessages failed.", err)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	fmt.Println("Successfully merged DLQ messages.")
+}
+
+func getRequiredShardID(c *cli.Context) int32 {
+	if !c.IsSet(FlagShardID) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagShardID), nil)
+	}
+	return int32(c.Int(FlagShardID))
+}
+
+func getLastMessageID(c *cli.Context) *int64 {
+	if !c.IsSet(FlagLastMessageID) {
+		return nil
+	}
+	return common.Int64Ptr(c.Int64(FlagLastMessageID))
+}
+
+func getOptionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return common.StringPtr(value)
+}
@@ -0,0 +1,345 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olivere/elastic"
+	"github.com/uber/cadence/common/clock"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	cassp "github.com/uber/cadence/common/persistence/cassandra"
+	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/tokenbucket"
+	"github.com/urfave/cli"
+)
+
+const (
+	backfillPhaseOpen   = "open"
+	backfillPhaseClosed = "closed"
+	backfillPhaseDone   = "done"
+
+	dbTypeCassandra = "cassandra"
+	dbTypeMySQL     = "mysql"
+
+	// backfilled documents are indexed with the lowest external version, so ES rejects them
+	// with a conflict when the indexer already wrote the workflow, and live updates always win
+	backfillDocVersion = 0
+
+	backfillRateLimitTimeout = time.Minute
+)
+
+type (
+	// backfillCheckpoint is written after every page, an interrupted backfill resumes from the checkpoint
+	backfillCheckpoint struct {
+		Phase         string              `json:"phase"`
+		NextPageToken []byte              `json:"nextPageToken"`
+		Open          *backfillPhaseStats `json:"open"`
+		Closed        *backfillPhaseStats `json:"closed"`
+	}
+
+	backfillPhaseStats struct {
+		// Read is the number of records read from the visibility store
+		Read int64 `json:"read"`
+		// Indexed is the number of records indexed into ES
+		Indexed int64 `json:"indexed"`
+		// Existing is the number of records ES already has, which are written by the indexer or a previous run
+		Existing int64 `json:"existing"`
+		// Failed is the number of records ES rejected
+		Failed int64 `json:"failed"`
+	}
+)
+
+// AdminBackfillES reads the open and closed visibility records of a domain from the Cassandra or SQL visibility store
+// and bulk indexes them into ElasticSearch, so that workflows started before advanced visibility was enabled are
+// searchable. The progress is checkpointed to a file after every page, and the record counts of both stores are
+// printed at the end for cross checking.
+func AdminBackfillES(c *cli.Context) {
+	domainID := getRequiredOption(c, FlagDomainID)
+	indexName := getRequiredOption(c, FlagIndex)
+	earliestTime := parseTime(c.String(FlagEarliestTime), 0)
+	latestTime := parseTime(c.String(FlagLatestTime), time.Now().UnixNano())
+	pageSize := c.Int(FlagBatchSize)
+	rps := c.Int(FlagRPS)
+	if pageSize <= 0 || rps <= 0 {
+		ErrorAndExit("Batch size and rps must be positive.", nil)
+	}
+	checkpointFile := c.String(FlagCheckpointFile)
+	verifyOnly := c.Bool(FlagVerifyOnly)
+
+	esClient := getESClient(c)
+	store := newVisibilityStore(c)
+	defer store.Close()
+
+	checkpoint, err := loadBackfillCheckpoint(checkpointFile)
+	if err != nil {
+		ErrorAndExit("Unable to load checkpoint", err)
+	}
+	if verifyOnly {
+		// counting always starts over, it doesn't touch the checkpoint of the backfill
+		checkpoint = newBackfillCheckpoint()
+		checkpointFile = ""
+	}
+	rateLimiter := tokenbucket.New(rps, clock.NewRealTimeSource())
+
+	for checkpoint.Phase != backfillPhaseDone {
+		request := &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        domainID,
+			EarliestStartTime: earliestTime,
+			LatestStartTime:   latestTime,
+			PageSize:          pageSize,
+			NextPageToken:     checkpoint.NextPageToken,
+		}
+
+		ctx, cancel := newContext(c)
+		var resp *persistence.InternalListWorkflowExecutionsResponse
+		var stats *backfillPhaseStats
+		if checkpoint.Phase == backfillPhaseOpen {
+			resp, err = store.ListOpenWorkflowExecutions(ctx, request)
+			stats = checkpoint.Open
+		} else {
+			resp, err = store.ListClosedWorkflowExecutions(ctx, request)
+			stats = checkpoint.Closed
+		}
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Unable to read %v workflows from visibility store", checkpoint.Phase), err)
+		}
+
+		stats.Read += int64(len(resp.Executions))
+		if !verifyOnly && len(resp.Executions) != 0 {
+			// one token per record throttles the writes to ES, Consume gives up only after the timeout
+			for i := 0; i < len(resp.Executions); i++ {
+				for !rateLimiter.Consume(1, backfillRateLimitTimeout) {
+				}
+			}
+			backfillPage(c, esClient, indexName, domainID, resp.Executions, stats)
+		}
+
+		checkpoint.NextPageToken = resp.NextPageToken
+		if len(resp.NextPageToken) == 0 {
+			if checkpoint.Phase == backfillPhaseOpen {
+				checkpoint.Phase = backfillPhaseClosed
+			} else {
+				checkpoint.Phase = backfillPhaseDone
+			}
+		}
+		if err := saveBackfillCheckpoint(checkpointFile, checkpoint); err != nil {
+			ErrorAndExit("Unable to save checkpoint", err)
+		}
+		fmt.Printf("%v workflows: read %v, indexed %v, existing %v, failed %v\n",
+			checkpoint.Phase, stats.Read, stats.Indexed, stats.Existing, stats.Failed)
+	}
+
+	printBackfillReport(c, esClient, indexName, domainID, earliestTime, latestTime, checkpoint)
+}
+
+func backfillPage(
+	c *cli.Context,
+	esClient *elastic.Client,
+	indexName string,
+	domainID string,
+	records []*persistence.VisibilityWorkflowExecutionInfo,
+	stats *backfillPhaseStats,
+) {
+
+	bulkRequest := esClient.Bulk()
+	for _, record := range records {
+		msg, err := espersistence.GetVisibilityMessageFromRecord(domainID, record, backfillDocVersion)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Unable to convert visibility record of workflow %v", record.WorkflowID), err)
+		}
+		req := elastic.NewBulkIndexRequest().
+			Index(indexName).
+			Type(esDocType).
+			Id(msg.GetWorkflowID() + esDocIDDelimiter + msg.GetRunID()).
+			VersionType(versionTypeExternal).
+			Version(msg.GetVersion()).
+			Doc(generateESDoc(msg))
+		bulkRequest.Add(req)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := bulkRequest.Do(ctx)
+	if err != nil {
+		ErrorAndExit("Bulk failed", err)
+	}
+	for _, item := range resp.Indexed() {
+		switch {
+		case item.Status >= http.StatusOK && item.Status < http.StatusMultipleChoices:
+			stats.Indexed++
+		case item.Status == http.StatusConflict:
+			stats.Existing++
+		default:
+			stats.Failed++
+			reason := ""
+			if item.Error != nil {
+				reason = item.Error.Reason
+			}
+			fmt.Printf("Failed to index %v, status %v: %v\n", item.Id, item.Status, reason)
+		}
+	}
+}
+
+// printBackfillReport compares the records read from the visibility store with the documents in ES
+func printBackfillReport(
+	c *cli.Context,
+	esClient *elastic.Client,
+	indexName string,
+	domainID string,
+	earliestTime int64,
+	latestTime int64,
+	checkpoint *backfillCheckpoint,
+) {
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	countFn := func(open bool) int64 {
+		query := elastic.NewBoolQuery().
+			Must(elastic.NewTermQuery(es.DomainID, domainID)).
+			Must(elastic.NewRangeQuery(es.StartTime).Gte(earliestTime).Lte(latestTime))
+		if open {
+			query = query.MustNot(elastic.NewExistsQuery(es.CloseTime))
+		} else {
+			query = query.Must(elastic.NewExistsQuery(es.CloseTime))
+		}
+		count, err := esClient.Count(indexName).Query(query).Do(ctx)
+		if err != nil {
+			ErrorAndExit("Unable to count documents in ElasticSearch", err)
+		}
+		return count
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Workflows", "Visibility store", "Indexed", "Existing", "Failed", "ElasticSearch"})
+	for _, phase := range []struct {
+		name  string
+		open  bool
+		stats *backfillPhaseStats
+	}{
+		{backfillPhaseOpen, true, checkpoint.Open},
+		{backfillPhaseClosed, false, checkpoint.Closed},
+	} {
+		table.Append([]string{
+			phase.name,
+			strconv.FormatInt(phase.stats.Read, 10),
+			strconv.FormatInt(phase.stats.Indexed, 10),
+			strconv.FormatInt(phase.stats.Existing, 10),
+			strconv.FormatInt(phase.stats.Failed, 10),
+			strconv.FormatInt(countFn(phase.open), 10),
+		})
+	}
+	table.Render()
+}
+
+// newVisibilityStore connects to the Cassandra or MySQL visibility store
+func newVisibilityStore(c *cli.Context) persistence.VisibilityStore {
+	host := getRequiredOption(c, FlagAddress)
+	port := c.Int(FlagPort)
+	user := c.String(FlagUsername)
+	password := c.String(FlagPassword)
+	logger := loggerimpl.NewNopLogger()
+
+	var store persistence.VisibilityStore
+	var err error
+	switch dbType := c.String(FlagDBType); dbType {
+	case dbTypeCassandra:
+		cfg := config.Cassandra{
+			Hosts:    host,
+			Port:     port,
+			User:     user,
+			Password: password,
+			Keyspace: getRequiredOption(c, FlagKeyspace),
+		}
+		store, err = cassp.NewFactory(cfg, "", logger).NewVisibilityStore()
+	case dbTypeMySQL:
+		cfg := config.SQL{
+			User:            user,
+			Password:        password,
+			DriverName:      dbTypeMySQL,
+			DatabaseName:    getRequiredOption(c, FlagDBName),
+			ConnectAddr:     net.JoinHostPort(host, strconv.Itoa(port)),
+			ConnectProtocol: "tcp",
+		}
+		store, err = sql.NewFactory(cfg, 0, "", logger).NewVisibilityStore()
+	default:
+		ErrorAndExit(fmt.Sprintf("Unknown database type %v, supported types are %v and %v", dbType, dbTypeCassandra, dbTypeMySQL), nil)
+	}
+	if err != nil {
+		ErrorAndExit("Unable to connect to visibility store", err)
+	}
+	return store
+}
+
+func newBackfillCheckpoint() *backfillCheckpoint {
+	return &backfillCheckpoint{
+		Phase:  backfillPhaseOpen,
+		Open:   &backfillPhaseStats{},
+		Closed: &backfillPhaseStats{},
+	}
+}
+
+func loadBackfillCheckpoint(fileName string) (*backfillCheckpoint, error) {
+	if fileName == "" {
+		return newBackfillCheckpoint(), nil
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return newBackfillCheckpoint(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := newBackfillCheckpoint()
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+func saveBackfillCheckpoint(fileName string, checkpoint *backfillCheckpoint) error {
+	if fileName == "" {
+		return nil
+	}
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	// write to a temp file first, so that an interrupted write doesn't corrupt the checkpoint
+	tmpFileName := fileName + ".tmp"
+	if err := ioutil.WriteFile(tmpFileName, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFileName, fileName)
+}
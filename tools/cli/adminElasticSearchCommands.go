@@ -27,9 +27,12 @@ import (
 	"fmt"
 	"github.com/olekukonko/tablewriter"
 	"github.com/olivere/elastic"
+	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/codec"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/urfave/cli"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"strconv"
@@ -49,6 +52,15 @@ const (
 	headerDestination = "rpc-service"
 )
 
+const (
+	defaultIndexTemplateFile = "schema/elasticsearch/visibility/index_template.json"
+	indexTemplateSuffix      = "-template"
+	indexTemplatePatterns    = "index_patterns"
+
+	// reading DLQ stops when no more message arrives in this time
+	esDLQReadIdleTimeout = 10 * time.Second
+)
+
 // muttleyTransport wraps around default http.Transport to add muttley specific headers to all requests
 type muttleyTransport struct {
 	http.Transport
@@ -177,6 +189,107 @@ func AdminIndex(c *cli.Context) {
 	}
 }
 
+// AdminPutIndexTemplate creates or updates the index template of a visibility index,
+// used to prepare the dedicated index of a domain before routing the domain to it
+func AdminPutIndexTemplate(c *cli.Context) {
+	esClient := getESClient(c)
+	indexName := getRequiredOption(c, FlagIndex)
+	templateFile := c.String(FlagInputFile)
+	if templateFile == "" {
+		templateFile = defaultIndexTemplateFile
+	}
+
+	data, err := ioutil.ReadFile(templateFile)
+	if err != nil {
+		ErrorAndExit("Unable to read index template file", err)
+	}
+	var template map[string]interface{}
+	if err := json.Unmarshal(data, &template); err != nil {
+		ErrorAndExit("Unable to parse index template file", err)
+	}
+	// the schema template matches the default visibility indices, restrict it to the given index
+	template[indexTemplatePatterns] = []string{indexName}
+
+	templateName := indexName + indexTemplateSuffix
+	resp, err := esClient.IndexPutTemplate(templateName).BodyJson(template).Do(context.Background())
+	if err != nil {
+		ErrorAndExit("Unable to put index template", err)
+	}
+	if !resp.Acknowledged {
+		ErrorAndExit(fmt.Sprintf("Put index template %v not acknowledged", templateName), nil)
+	}
+	fmt.Printf("Index template %v is put for index %v\n", templateName, indexName)
+}
+
+// AdminListESDLQ prints the visibility messages ElasticSearch rejected permanently, with the rejection reasons
+func AdminListESDLQ(c *cli.Context) {
+	// a new consumer group reads the DLQ from the beginning
+	group := "cadence-cli-" + uuid.New()
+	count := 0
+	readESDLQ(c, group, c.String(FlagWorkflowID), func(dlqMsg *indexer.DLQMessage) {
+		data, err := json.Marshal(dlqMsg)
+		if err != nil {
+			ErrorAndExit("Unable to serialize DLQ message", err)
+		}
+		fmt.Println(string(data))
+		count++
+	})
+	fmt.Printf("Listed %v DLQ messages\n", count)
+}
+
+// AdminReplayESDLQ publishes the visibility messages in DLQ to the visibility topic for indexing them again,
+// the consumer group remembers the replayed messages. Replay can't be filtered by workflow, the committed offset
+// of a partition covers all the messages before it, so a skipped message would be lost to the group
+func AdminReplayESDLQ(c *cli.Context) {
+	group := getRequiredOption(c, FlagGroup)
+	producer := newKafkaProducer(c)
+	defer producer.Close()
+
+	count := 0
+	readESDLQ(c, group, "", func(dlqMsg *indexer.DLQMessage) {
+		if err := producer.Publish(dlqMsg.Message); err != nil {
+			ErrorAndExit(fmt.Sprintf("Unable to replay DLQ message of workflow %v", dlqMsg.GetMessage().GetWorkflowID()), err)
+		}
+		count++
+	})
+	fmt.Printf("Replayed %v DLQ messages\n", count)
+}
+
+// readESDLQ reads the ES DLQ topic until no more message arrives, only the messages of the workflow
+// are handled unless workflowID is empty
+func readESDLQ(c *cli.Context, group string, workflowID string, handleFn func(*indexer.DLQMessage)) {
+	hostFile := getRequiredOption(c, FlagHostFile)
+	fromTopic := getRequiredOption(c, FlagInputTopic)
+	fromCluster := getRequiredOption(c, FlagInputCluster)
+
+	brokers, tlsConfig, err := loadBrokerConfig(hostFile, fromCluster)
+	if err != nil {
+		ErrorAndExit("", err)
+	}
+	consumer := createConsumerAndWaitForReady(brokers, tlsConfig, group, fromTopic)
+	defer consumer.Close()
+
+	encoder := codec.NewThriftRWEncoder()
+	for {
+		select {
+		case msg, ok := <-consumer.Messages():
+			if !ok {
+				return
+			}
+			var dlqMsg indexer.DLQMessage
+			if err := encoder.Decode(msg.Value, &dlqMsg); err != nil {
+				ErrorAndExit(fmt.Sprintf("Unable to deserialize DLQ message [%v],[%v]", msg.Partition, msg.Offset), err)
+			}
+			if workflowID == "" || dlqMsg.GetMessage().GetWorkflowID() == workflowID {
+				handleFn(&dlqMsg)
+			}
+			consumer.MarkOffset(msg, "")
+		case <-time.After(esDLQReadIdleTimeout):
+			return
+		}
+	}
+}
+
 func parseIndexerMessage(fileName string) (messages []*indexer.Message, err error) {
 	file, err := os.Open(fileName)
 	if err != nil {
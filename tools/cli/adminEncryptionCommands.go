@@ -0,0 +1,994 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/jmoiron/sqlx"
+	"github.com/olivere/elastic"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/storage"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/urfave/cli"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+const (
+	dbTypeSQLite = "sqlite3"
+
+	// cassandraRowTypeExecution is the type of the executions table rows holding mutable states
+	cassandraRowTypeExecution       = 1
+	cassandraExecutionKeyConditions = "shard_id = ? AND type = ? AND domain_id = ? AND workflow_id = ? AND run_id = ? " +
+		"AND visibility_ts = ? AND task_id = ?"
+
+	// a re-encrypted document is indexed with the version it was read with,
+	// so ES rejects it if the indexer updated the document meanwhile
+	versionTypeExternalGTE = "external_gte"
+)
+
+type (
+	reencryptor struct {
+		keyProvider      persistence.KeyProvider
+		activeKeyID      string
+		encryptPlaintext bool
+		pageSize         int
+		// conflicts is the total number of rows which changed while they were re-encrypted
+		conflicts int
+	}
+
+	reencryptStats struct {
+		scanned     int
+		reencrypted int
+		skipped     int
+		conflicts   int
+	}
+
+	// cqlReencryptTable describes a cassandra table holding one blob column,
+	// keyColumns must be the full primary key of the table
+	cqlReencryptTable struct {
+		name           string
+		keyColumns     []string
+		dataColumn     string
+		encodingColumn string
+	}
+
+	// cqlBlobGroup lists the blob fields of a user defined type which share one encoding field
+	cqlBlobGroup struct {
+		encodingField string
+		blobFields    []string
+	}
+
+	// cqlRawValue keeps a value as serialized by cassandra, so that it can be compared
+	// in a lightweight transaction and partially rewritten without a lossy round trip
+	cqlRawValue struct {
+		info gocql.TypeInfo
+		data []byte
+	}
+
+	// cqlUDT is a serialized user defined type value split into its fields, null fields are nil
+	cqlUDT struct {
+		info   gocql.UDTTypeInfo
+		fields [][]byte
+	}
+
+	// sqlBlobColumn pairs a blob column of a SQL table with its encoding column
+	sqlBlobColumn struct {
+		dataColumn     string
+		encodingColumn string
+	}
+
+	// sqlReencryptTable describes a SQL table holding blobs, keyColumns must be the full primary
+	// key of the table. The blobs are either stored in blobColumns, or in the fields of the thriftrw
+	// struct of the data column, which reencryptData rewrites
+	sqlReencryptTable struct {
+		name          string
+		keyColumns    []string
+		blobColumns   []sqlBlobColumn
+		reencryptData func(r *reencryptor, data []byte) ([]byte, error)
+	}
+
+	// sqlBlobField is a blob field of a thriftrw struct stored in a SQL data column
+	sqlBlobField struct {
+		data     *[]byte
+		encoding **string
+	}
+)
+
+var errMalformedUDT = errors.New("malformed user defined type value")
+
+var (
+	cqlReencryptTables = []cqlReencryptTable{
+		{
+			name:           "history_node",
+			keyColumns:     []string{"tree_id", "branch_id", "node_id", "txn_id"},
+			dataColumn:     "data",
+			encodingColumn: "data_encoding",
+		},
+		{
+			name:           "events",
+			keyColumns:     []string{"domain_id", "workflow_id", "run_id", "first_event_id"},
+			dataColumn:     "data",
+			encodingColumn: "data_encoding",
+		},
+	}
+
+	cqlReencryptVisibilityTables = []cqlReencryptTable{
+		{
+			name:           "open_executions",
+			keyColumns:     []string{"domain_id", "domain_partition", "start_time", "run_id"},
+			dataColumn:     "memo",
+			encodingColumn: "encoding",
+		},
+		{
+			name:           "closed_executions",
+			keyColumns:     []string{"domain_id", "domain_partition", "start_time", "run_id"},
+			dataColumn:     "memo",
+			encodingColumn: "encoding",
+		},
+		{
+			name:           "closed_executions_v2",
+			keyColumns:     []string{"domain_id", "domain_partition", "close_time", "run_id"},
+			dataColumn:     "memo",
+			encodingColumn: "encoding",
+		},
+	}
+
+	cqlExecutionBlobGroups = []cqlBlobGroup{
+		{encodingField: "completion_event_data_encoding", blobFields: []string{"completion_event"}},
+		{encodingField: "auto_reset_points_encoding", blobFields: []string{"auto_reset_points"}},
+	}
+	cqlActivityBlobGroups = []cqlBlobGroup{
+		{encodingField: "event_data_encoding", blobFields: []string{"scheduled_event", "started_event"}},
+	}
+	cqlChildExecutionBlobGroups = []cqlBlobGroup{
+		{encodingField: "event_data_encoding", blobFields: []string{"initiated_event", "started_event"}},
+	}
+	cqlEventBatchBlobGroups = []cqlBlobGroup{
+		{encodingField: "encoding_type", blobFields: []string{"data"}},
+	}
+	// buffered replication tasks hold their histories in nested event batches
+	cqlBufferedReplicationTaskNestedGroups = map[string][]cqlBlobGroup{
+		"history":         cqlEventBatchBlobGroups,
+		"new_run_history": cqlEventBatchBlobGroups,
+	}
+
+	sqlReencryptTables = []sqlReencryptTable{
+		{
+			name:        "history_node",
+			keyColumns:  []string{"shard_id", "tree_id", "branch_id", "node_id", "txn_id"},
+			blobColumns: []sqlBlobColumn{{dataColumn: "data", encodingColumn: "data_encoding"}},
+		},
+		{
+			name:        "events",
+			keyColumns:  []string{"domain_id", "workflow_id", "run_id", "first_event_id"},
+			blobColumns: []sqlBlobColumn{{dataColumn: "data", encodingColumn: "data_encoding"}},
+		},
+		{
+			name:        "buffered_events",
+			keyColumns:  []string{"id"},
+			blobColumns: []sqlBlobColumn{{dataColumn: "data", encodingColumn: "data_encoding"}},
+		},
+		{
+			name:       "buffered_replication_task_maps",
+			keyColumns: []string{"shard_id", "domain_id", "workflow_id", "run_id", "first_event_id"},
+			blobColumns: []sqlBlobColumn{
+				{dataColumn: "history", encodingColumn: "history_encoding"},
+				{dataColumn: "new_run_history", encodingColumn: "new_run_history_encoding"},
+			},
+		},
+		{
+			name:          "executions",
+			keyColumns:    []string{"shard_id", "domain_id", "workflow_id", "run_id"},
+			reencryptData: (*reencryptor).reencryptExecutionInfo,
+		},
+		{
+			name:          "activity_info_maps",
+			keyColumns:    []string{"shard_id", "domain_id", "workflow_id", "run_id", "schedule_id"},
+			reencryptData: (*reencryptor).reencryptActivityInfo,
+		},
+		{
+			name:          "child_execution_info_maps",
+			keyColumns:    []string{"shard_id", "domain_id", "workflow_id", "run_id", "initiated_id"},
+			reencryptData: (*reencryptor).reencryptChildExecutionInfo,
+		},
+	}
+
+	sqlReencryptVisibilityTables = []sqlReencryptTable{
+		{
+			name:        "executions_visibility",
+			keyColumns:  []string{"domain_id", "run_id"},
+			blobColumns: []sqlBlobColumn{{dataColumn: "memo", encodingColumn: "encoding"}},
+		},
+	}
+)
+
+// AdminReencrypt scans every table holding blobs the servers may encrypt, history, mutable states and
+// visibility records, and rewrites every blob which is not encrypted with the active key of the keyring,
+// so that retired keys can be removed. A row is only rewritten if it didn't change since it was read,
+// rows the servers updated meanwhile are reported as conflicts and are re-encrypted by running the
+// command again.
+func AdminReencrypt(c *cli.Context) {
+	keyProvider, err := persistence.NewFileKeyProvider(getRequiredOption(c, FlagKeyringFile))
+	if err != nil {
+		ErrorAndExit("Failed to load keyring", err)
+	}
+	activeKeyID, _, err := keyProvider.ActiveKey()
+	if err != nil {
+		ErrorAndExit("Failed to get active key", err)
+	}
+	r := &reencryptor{
+		keyProvider:      keyProvider,
+		activeKeyID:      activeKeyID,
+		encryptPlaintext: c.Bool(FlagEncryptPlaintext),
+		pageSize:         c.Int(FlagBatchSize),
+	}
+	if r.pageSize <= 0 {
+		ErrorAndExit("Batch size must be positive.", nil)
+	}
+	visibilityDBName := getRequiredOption(c, FlagVisibilityDBName)
+
+	switch dbType := c.String(FlagDBType); dbType {
+	case dbTypeCassandra:
+		r.reencryptCassandra(c, visibilityDBName)
+	case dbTypeMySQL, dbTypeSQLite:
+		r.reencryptSQL(c, dbType, visibilityDBName)
+	default:
+		ErrorAndExit(fmt.Sprintf("Unknown database type %v, supported types are %v, %v and %v",
+			dbType, dbTypeCassandra, dbTypeMySQL, dbTypeSQLite), nil)
+	}
+	if c.IsSet(FlagIndex) {
+		r.reencryptES(c, c.String(FlagIndex))
+	}
+
+	if r.conflicts > 0 {
+		fmt.Printf("%v rows changed while they were re-encrypted and were left as is, run the command again to re-encrypt them\n",
+			r.conflicts)
+	}
+}
+
+// reencryptBlob returns the blob encrypted with the active key, or nil if the blob is empty,
+// already encrypted with the active key, or plaintext which is not asked to be encrypted
+func (r *reencryptor) reencryptBlob(data []byte, encoding string) (*persistence.DataBlob, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	blob := &persistence.DataBlob{Data: data, Encoding: common.EncodingType(encoding)}
+
+	if blob.GetEncoding() == common.EncodingTypeEncrypted {
+		keyID, err := persistence.GetEncryptionKeyID(blob)
+		if err != nil {
+			return nil, err
+		}
+		if keyID == r.activeKeyID {
+			return nil, nil
+		}
+		blob, err = persistence.DecryptBlob(blob, r.keyProvider)
+		if err != nil {
+			return nil, err
+		}
+	} else if !r.encryptPlaintext {
+		return nil, nil
+	}
+	return persistence.EncryptBlob(blob, r.keyProvider)
+}
+
+func (r *reencryptor) report(table string, stats *reencryptStats, err error) {
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to re-encrypt table %v", table), err)
+	}
+	fmt.Printf("table %v: scanned %v rows, re-encrypted %v, skipped %v, conflicts %v\n",
+		table, stats.scanned, stats.reencrypted, stats.skipped, stats.conflicts)
+	r.conflicts += stats.conflicts
+}
+
+func (s *reencryptStats) recordUpdate(applied bool) {
+	if applied {
+		s.reencrypted++
+	} else {
+		s.conflicts++
+	}
+}
+
+func (r *reencryptor) reencryptCassandra(c *cli.Context, visibilityKeyspace string) {
+	session := connectToCassandra(c)
+	defer session.Close()
+
+	for _, table := range cqlReencryptTables {
+		stats, err := r.reencryptCQLTable(session, table)
+		r.report(table.name, stats, err)
+	}
+	stats, err := r.reencryptCQLExecutions(session)
+	r.report("executions", stats, err)
+	for _, table := range cqlReencryptVisibilityTables {
+		table.name = visibilityKeyspace + "." + table.name
+		stats, err := r.reencryptCQLTable(session, table)
+		r.report(table.name, stats, err)
+	}
+}
+
+func (r *reencryptor) reencryptCQLTable(session *gocql.Session, table cqlReencryptTable) (*reencryptStats, error) {
+	selectQuery := fmt.Sprintf("SELECT %v, %v, %v, TTL(%v) AS data_ttl FROM %v",
+		strings.Join(table.keyColumns, ", "), table.dataColumn, table.encodingColumn, table.dataColumn, table.name)
+	// the row keeps its remaining TTL, a TTL of 0 means the row doesn't expire
+	updateQuery := fmt.Sprintf("UPDATE %v USING TTL ? SET %v = ?, %v = ? WHERE %v IF %v = ?",
+		table.name, table.dataColumn, table.encodingColumn, whereColumns(table.keyColumns), table.dataColumn)
+
+	stats := &reencryptStats{}
+	iter := session.Query(selectQuery).PageSize(r.pageSize).Iter()
+	for {
+		row := make(map[string]interface{})
+		if !iter.MapScan(row) {
+			break
+		}
+		stats.scanned++
+
+		data, _ := row[table.dataColumn].([]byte)
+		encoding, _ := row[table.encodingColumn].(string)
+		blob, err := r.reencryptBlob(data, encoding)
+		if err != nil {
+			return stats, err
+		}
+		if blob == nil {
+			stats.skipped++
+			continue
+		}
+
+		ttl, _ := row["data_ttl"].(int)
+		args := []interface{}{ttl, blob.Data, string(blob.Encoding)}
+		for _, column := range table.keyColumns {
+			args = append(args, row[column])
+		}
+		args = append(args, data)
+		applied, err := casUpdate(session.Query(updateQuery, args...))
+		if err != nil {
+			return stats, err
+		}
+		stats.recordUpdate(applied)
+	}
+	return stats, iter.Close()
+}
+
+// reencryptCQLExecutions re-encrypts the blobs of the mutable states, each frozen value
+// is replaced only if it is still the value which was read
+func (r *reencryptor) reencryptCQLExecutions(session *gocql.Session) (*reencryptStats, error) {
+	selectQuery := "SELECT shard_id, type, domain_id, workflow_id, run_id, visibility_ts, task_id, " +
+		"execution, activity_map, child_executions_map, buffered_events_list, buffered_replication_tasks_map " +
+		"FROM executions"
+	updateExecutionQuery := "UPDATE executions SET execution = ? WHERE " + cassandraExecutionKeyConditions +
+		" IF execution = ?"
+	updateBufferedEventsQuery := "UPDATE executions SET buffered_events_list = ? WHERE " + cassandraExecutionKeyConditions +
+		" IF buffered_events_list = ?"
+
+	stats := &reencryptStats{}
+	iter := session.Query(selectQuery).PageSize(r.pageSize).Iter()
+	for {
+		var shardID, rowType int
+		var domainID, runID gocql.UUID
+		var workflowID string
+		var visibilityTS time.Time
+		var taskID int64
+		var execution cqlRawValue
+		var activityMap, childExecutionsMap, bufferedReplicationTasksMap map[int64]cqlRawValue
+		var bufferedEvents []cqlRawValue
+		if !iter.Scan(&shardID, &rowType, &domainID, &workflowID, &runID, &visibilityTS, &taskID,
+			&execution, &activityMap, &childExecutionsMap, &bufferedEvents, &bufferedReplicationTasksMap) {
+			break
+		}
+		if rowType != cassandraRowTypeExecution {
+			continue
+		}
+		stats.scanned++
+		keys := []interface{}{shardID, rowType, domainID, workflowID, runID, visibilityTS, taskID}
+
+		var updates []*gocql.Query
+		newExecution, changed, err := r.reencryptUDT(execution, cqlExecutionBlobGroups, nil)
+		if err != nil {
+			return stats, err
+		}
+		if changed {
+			args := append([]interface{}{newExecution}, keys...)
+			updates = append(updates, session.Query(updateExecutionQuery, append(args, execution)...))
+		}
+
+		for _, m := range []struct {
+			column string
+			values map[int64]cqlRawValue
+			groups []cqlBlobGroup
+			nested map[string][]cqlBlobGroup
+		}{
+			{column: "activity_map", values: activityMap, groups: cqlActivityBlobGroups},
+			{column: "child_executions_map", values: childExecutionsMap, groups: cqlChildExecutionBlobGroups},
+			{column: "buffered_replication_tasks_map", values: bufferedReplicationTasksMap, nested: cqlBufferedReplicationTaskNestedGroups},
+		} {
+			updateQuery := fmt.Sprintf("UPDATE executions SET %[1]v[?] = ? WHERE %[2]v IF %[1]v[?] = ?",
+				m.column, cassandraExecutionKeyConditions)
+			for key, value := range m.values {
+				newValue, changed, err := r.reencryptUDT(value, m.groups, m.nested)
+				if err != nil {
+					return stats, err
+				}
+				if changed {
+					args := append([]interface{}{key, newValue}, keys...)
+					updates = append(updates, session.Query(updateQuery, append(args, key, value)...))
+				}
+			}
+		}
+
+		newBufferedEvents := make([]cqlRawValue, len(bufferedEvents))
+		bufferedEventsChanged := false
+		for i, value := range bufferedEvents {
+			newBufferedEvents[i], changed, err = r.reencryptUDT(value, cqlEventBatchBlobGroups, nil)
+			if err != nil {
+				return stats, err
+			}
+			bufferedEventsChanged = bufferedEventsChanged || changed
+		}
+		if bufferedEventsChanged {
+			args := append([]interface{}{newBufferedEvents}, keys...)
+			updates = append(updates, session.Query(updateBufferedEventsQuery, append(args, bufferedEvents)...))
+		}
+
+		if len(updates) == 0 {
+			stats.skipped++
+			continue
+		}
+		allApplied := true
+		for _, update := range updates {
+			applied, err := casUpdate(update)
+			if err != nil {
+				return stats, err
+			}
+			allApplied = allApplied && applied
+		}
+		stats.recordUpdate(allApplied)
+	}
+	return stats, iter.Close()
+}
+
+// reencryptUDT re-encrypts the blob fields of a user defined type value, nested lists the fields
+// holding user defined type values with blob fields of their own
+func (r *reencryptor) reencryptUDT(
+	value cqlRawValue,
+	groups []cqlBlobGroup,
+	nested map[string][]cqlBlobGroup,
+) (cqlRawValue, bool, error) {
+
+	if value.data == nil {
+		return value, false, nil
+	}
+	udt, err := parseCQLUDT(value)
+	if err != nil {
+		return value, false, err
+	}
+
+	changed := false
+	for _, group := range groups {
+		var newEncoding common.EncodingType
+		for _, field := range group.blobFields {
+			blob, err := r.reencryptBlob(udt.field(field), string(udt.field(group.encodingField)))
+			if err != nil {
+				return value, false, err
+			}
+			if blob != nil {
+				udt.setField(field, blob.Data)
+				newEncoding = blob.Encoding
+			}
+		}
+		if newEncoding != "" {
+			udt.setField(group.encodingField, []byte(newEncoding))
+			changed = true
+		}
+	}
+	for field, nestedGroups := range nested {
+		i := udt.fieldIndex(field)
+		if i < 0 {
+			continue
+		}
+		nestedValue := cqlRawValue{info: udt.info.Elements[i].Type, data: udt.fields[i]}
+		newNestedValue, nestedChanged, err := r.reencryptUDT(nestedValue, nestedGroups, nil)
+		if err != nil {
+			return value, false, err
+		}
+		if nestedChanged {
+			udt.fields[i] = newNestedValue.data
+			changed = true
+		}
+	}
+
+	if !changed {
+		return value, false, nil
+	}
+	return cqlRawValue{info: value.info, data: udt.marshal()}, true, nil
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler
+func (v *cqlRawValue) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	v.info = info
+	v.data = nil
+	if data != nil {
+		v.data = append([]byte{}, data...)
+	}
+	return nil
+}
+
+// MarshalCQL implements gocql.Marshaler
+func (v cqlRawValue) MarshalCQL(_ gocql.TypeInfo) ([]byte, error) {
+	return v.data, nil
+}
+
+func parseCQLUDT(value cqlRawValue) (*cqlUDT, error) {
+	info, ok := value.info.(gocql.UDTTypeInfo)
+	if !ok {
+		return nil, fmt.Errorf("type %v is not a user defined type", value.info)
+	}
+	udt := &cqlUDT{info: info, fields: make([][]byte, len(info.Elements))}
+	data := value.data
+	// values written before fields were added to the type end early, the missing fields are null
+	for i := 0; i < len(info.Elements) && len(data) > 0; i++ {
+		if len(data) < 4 {
+			return nil, errMalformedUDT
+		}
+		size := int32(binary.BigEndian.Uint32(data))
+		data = data[4:]
+		if size < 0 {
+			continue
+		}
+		if len(data) < int(size) {
+			return nil, errMalformedUDT
+		}
+		udt.fields[i] = data[:size:size]
+		data = data[size:]
+	}
+	return udt, nil
+}
+
+func (u *cqlUDT) fieldIndex(name string) int {
+	for i, element := range u.info.Elements {
+		if element.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (u *cqlUDT) field(name string) []byte {
+	if i := u.fieldIndex(name); i >= 0 {
+		return u.fields[i]
+	}
+	return nil
+}
+
+func (u *cqlUDT) setField(name string, data []byte) {
+	if i := u.fieldIndex(name); i >= 0 {
+		u.fields[i] = data
+	}
+}
+
+func (u *cqlUDT) marshal() []byte {
+	var buf []byte
+	for _, field := range u.fields {
+		if field == nil {
+			buf = append(buf, 0xff, 0xff, 0xff, 0xff)
+			continue
+		}
+		size := len(field)
+		buf = append(buf, byte(size>>24), byte(size>>16), byte(size>>8), byte(size))
+		buf = append(buf, field...)
+	}
+	return buf
+}
+
+// casUpdate runs a lightweight transaction and returns whether its condition held
+func casUpdate(query *gocql.Query) (bool, error) {
+	return query.MapScanCAS(make(map[string]interface{}))
+}
+
+func (r *reencryptor) reencryptSQL(c *cli.Context, driverName string, visibilityDBName string) {
+	db := connectToSQL(c, driverName, getRequiredOption(c, FlagDBName))
+	defer db.Close()
+	for _, table := range sqlReencryptTables {
+		stats, err := r.reencryptSQLTable(db, table)
+		r.report(table.name, stats, err)
+	}
+
+	visibilityDB := connectToSQL(c, driverName, visibilityDBName)
+	defer visibilityDB.Close()
+	for _, table := range sqlReencryptVisibilityTables {
+		stats, err := r.reencryptSQLTable(visibilityDB, table)
+		r.report(table.name, stats, err)
+	}
+}
+
+// connectToSQL connects to a mysql database, or to a sqlite database file in the directory of the address
+func connectToSQL(c *cli.Context, driverName string, dbName string) *sqlx.DB {
+	address := getRequiredOption(c, FlagAddress)
+	cfg := &config.SQL{
+		User:         c.String(FlagUsername),
+		Password:     c.String(FlagPassword),
+		DriverName:   driverName,
+		DatabaseName: dbName,
+		ConnectAddr:  address,
+	}
+	if driverName == dbTypeMySQL {
+		cfg.ConnectAddr = net.JoinHostPort(address, strconv.Itoa(c.Int(FlagPort)))
+		cfg.ConnectProtocol = "tcp"
+	}
+	db, err := storage.NewSQLXDB(cfg)
+	if err != nil {
+		ErrorAndExit("connect to SQL database failed", err)
+	}
+	return db
+}
+
+func (r *reencryptor) reencryptSQLTable(db *sqlx.DB, table sqlReencryptTable) (*reencryptStats, error) {
+	keyColumns := strings.Join(table.keyColumns, ", ")
+	dataColumns := []string{"data"}
+	if table.reencryptData == nil {
+		dataColumns = nil
+		for _, column := range table.blobColumns {
+			dataColumns = append(dataColumns, column.dataColumn, column.encodingColumn)
+		}
+	}
+	selectColumns := keyColumns + ", " + strings.Join(dataColumns, ", ")
+	// rows are paged by primary key, so that rewriting rows doesn't move them between pages
+	firstPageQuery := fmt.Sprintf("SELECT %v FROM %v ORDER BY %v LIMIT ?",
+		selectColumns, table.name, keyColumns)
+	nextPageQuery := fmt.Sprintf("SELECT %v FROM %v WHERE (%v) > (%v) ORDER BY %v LIMIT ?",
+		selectColumns, table.name, keyColumns, strings.TrimSuffix(strings.Repeat("?, ", len(table.keyColumns)), ", "), keyColumns)
+
+	stats := &reencryptStats{}
+	var lastKey []interface{}
+	for {
+		query := firstPageQuery
+		if lastKey != nil {
+			query = nextPageQuery
+		}
+		page, err := readSQLPage(db, query, append(lastKey, r.pageSize)...)
+		if err != nil {
+			return stats, err
+		}
+		for _, row := range page {
+			stats.scanned++
+			key := row[:len(table.keyColumns)]
+			if err := r.reencryptSQLRow(db, table, key, row[len(key):], stats); err != nil {
+				return stats, err
+			}
+		}
+		if len(page) < r.pageSize {
+			return stats, nil
+		}
+		lastKey = append([]interface{}{}, page[len(page)-1][:len(table.keyColumns)]...)
+	}
+}
+
+// reencryptSQLRow rewrites the blobs of a row, only if they are still the blobs which were read
+func (r *reencryptor) reencryptSQLRow(
+	db *sqlx.DB,
+	table sqlReencryptTable,
+	key []interface{},
+	values []interface{},
+	stats *reencryptStats,
+) error {
+
+	var setColumns, conditionColumns []string
+	var setArgs, conditionArgs []interface{}
+	if table.reencryptData != nil {
+		data := sqlBytes(values[0])
+		newData, err := table.reencryptData(r, data)
+		if err != nil {
+			return err
+		}
+		if newData != nil {
+			setColumns = append(setColumns, "data")
+			setArgs = append(setArgs, newData)
+			conditionColumns = append(conditionColumns, "data")
+			conditionArgs = append(conditionArgs, data)
+		}
+	} else {
+		for i, column := range table.blobColumns {
+			data := sqlBytes(values[2*i])
+			blob, err := r.reencryptBlob(data, string(sqlBytes(values[2*i+1])))
+			if err != nil {
+				return err
+			}
+			if blob != nil {
+				setColumns = append(setColumns, column.dataColumn, column.encodingColumn)
+				setArgs = append(setArgs, blob.Data, string(blob.Encoding))
+				conditionColumns = append(conditionColumns, column.dataColumn)
+				conditionArgs = append(conditionArgs, data)
+			}
+		}
+	}
+	if len(setColumns) == 0 {
+		stats.skipped++
+		return nil
+	}
+
+	query := fmt.Sprintf("UPDATE %v SET %v WHERE %v AND %v", table.name,
+		strings.Replace(whereColumns(setColumns), " AND ", ", ", -1),
+		whereColumns(table.keyColumns), whereColumns(conditionColumns))
+	args := append(append(setArgs, key...), conditionArgs...)
+	result, err := db.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	stats.recordUpdate(rowsAffected > 0)
+	return nil
+}
+
+// readSQLPage reads all rows of a page before any of them is rewritten. Values of text columns are
+// returned as strings, so that they compare in the collation of the column as the start of the next page
+func readSQLPage(db *sqlx.DB, query string, args ...interface{}) ([][]interface{}, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	var page [][]interface{}
+	for rows.Next() {
+		row := make([]interface{}, len(columnTypes))
+		dest := make([]interface{}, len(columnTypes))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, columnType := range columnTypes {
+			if b, ok := row[i].([]byte); ok && isSQLTextColumn(columnType) {
+				row[i] = string(b)
+			}
+		}
+		page = append(page, row)
+	}
+	return page, rows.Err()
+}
+
+func isSQLTextColumn(columnType *sql.ColumnType) bool {
+	typeName := strings.ToUpper(columnType.DatabaseTypeName())
+	return strings.Contains(typeName, "CHAR") || strings.Contains(typeName, "TEXT")
+}
+
+func sqlBytes(value interface{}) []byte {
+	switch v := value.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		return nil
+	}
+}
+
+func (r *reencryptor) reencryptExecutionInfo(data []byte) ([]byte, error) {
+	info := &sqlblobs.WorkflowExecutionInfo{}
+	if err := thriftRWDecode(data, info); err != nil {
+		return nil, err
+	}
+	changed, err := r.reencryptFields(
+		sqlBlobField{data: &info.CompletionEvent, encoding: &info.CompletionEventEncoding},
+		sqlBlobField{data: &info.AutoResetPoints, encoding: &info.AutoResetPointsEncoding},
+	)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return thriftRWEncode(info)
+}
+
+func (r *reencryptor) reencryptActivityInfo(data []byte) ([]byte, error) {
+	info := &sqlblobs.ActivityInfo{}
+	if err := thriftRWDecode(data, info); err != nil {
+		return nil, err
+	}
+	changed, err := r.reencryptFields(
+		sqlBlobField{data: &info.ScheduledEvent, encoding: &info.ScheduledEventEncoding},
+		sqlBlobField{data: &info.StartedEvent, encoding: &info.StartedEventEncoding},
+	)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return thriftRWEncode(info)
+}
+
+func (r *reencryptor) reencryptChildExecutionInfo(data []byte) ([]byte, error) {
+	info := &sqlblobs.ChildExecutionInfo{}
+	if err := thriftRWDecode(data, info); err != nil {
+		return nil, err
+	}
+	changed, err := r.reencryptFields(
+		sqlBlobField{data: &info.InitiatedEvent, encoding: &info.InitiatedEventEncoding},
+		sqlBlobField{data: &info.StartedEvent, encoding: &info.StartedEventEncoding},
+	)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return thriftRWEncode(info)
+}
+
+func (r *reencryptor) reencryptFields(fields ...sqlBlobField) (bool, error) {
+	changed := false
+	for _, field := range fields {
+		blob, err := r.reencryptBlob(*field.data, common.StringDefault(*field.encoding))
+		if err != nil {
+			return false, err
+		}
+		if blob != nil {
+			*field.data = blob.Data
+			*field.encoding = common.StringPtr(string(blob.Encoding))
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// thriftRWDecode decodes the thriftrw structs the SQL stores write into their data columns
+func thriftRWDecode(data []byte, target interface{ FromWire(wire.Value) error }) error {
+	value, err := protocol.Binary.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return err
+	}
+	return target.FromWire(value)
+}
+
+func thriftRWEncode(source interface{ ToWire() (wire.Value, error) }) ([]byte, error) {
+	value, err := source.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := protocol.Binary.Encode(value, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reencryptES re-encrypts the memos of the visibility documents in ElasticSearch
+func (r *reencryptor) reencryptES(c *cli.Context, indexName string) {
+	esClient := getESClient(c)
+	scroll := esClient.Scroll(indexName).
+		SearchSource(elastic.NewSearchSource().Version(true)).
+		Size(r.pageSize)
+
+	stats := &reencryptStats{}
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := scroll.Do(ctx)
+		cancel()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to scroll index %v", indexName), err)
+		}
+
+		bulkRequest := esClient.Bulk()
+		for _, hit := range resp.Hits.Hits {
+			stats.scanned++
+			req, err := r.reencryptESDoc(indexName, hit)
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to re-encrypt document %v", hit.Id), err)
+			}
+			if req == nil {
+				stats.skipped++
+				continue
+			}
+			bulkRequest.Add(req)
+		}
+		if bulkRequest.NumberOfActions() == 0 {
+			continue
+		}
+
+		ctx, cancel = newContext(c)
+		bulkResp, err := bulkRequest.Do(ctx)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Bulk failed", err)
+		}
+		for _, item := range bulkResp.Indexed() {
+			switch {
+			case item.Status >= http.StatusOK && item.Status < http.StatusMultipleChoices:
+				stats.reencrypted++
+			case item.Status == http.StatusConflict:
+				stats.conflicts++
+			default:
+				reason := ""
+				if item.Error != nil {
+					reason = item.Error.Reason
+				}
+				ErrorAndExit(fmt.Sprintf("Failed to index %v, status %v: %v", item.Id, item.Status, reason), nil)
+			}
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	scroll.Clear(ctx)
+	r.report(indexName, stats, nil)
+}
+
+func (r *reencryptor) reencryptESDoc(indexName string, hit *elastic.SearchHit) (elastic.BulkableRequest, error) {
+	if hit.Source == nil || hit.Version == nil {
+		return nil, errors.New("document has no source or version")
+	}
+	doc := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(*hit.Source))
+	// keep numbers as they are, int64 fields don't fit into float64
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	memo, _ := doc[definition.Memo].(string)
+	data, err := base64.StdEncoding.DecodeString(memo)
+	if err != nil {
+		return nil, err
+	}
+	encoding, _ := doc[definition.Encoding].(string)
+	blob, err := r.reencryptBlob(data, encoding)
+	if err != nil || blob == nil {
+		return nil, err
+	}
+	doc[definition.Memo] = blob.Data
+	doc[definition.Encoding] = string(blob.Encoding)
+
+	return elastic.NewBulkIndexRequest().
+		Index(indexName).
+		Type(esDocType).
+		Id(hit.Id).
+		VersionType(versionTypeExternalGTE).
+		Version(*hit.Version).
+		Doc(doc), nil
+}
+
+func whereColumns(columns []string) string {
+	conditions := make([]string, 0, len(columns))
+	for _, column := range columns {
+		conditions = append(conditions, column+" = ?")
+	}
+	return strings.Join(conditions, " AND ")
+}
@@ -23,6 +23,7 @@ package cli
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
@@ -462,7 +463,7 @@ type ClustersConfig struct {
 	TLS      messaging.TLS
 }
 
-func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, targets []string, producer messaging.Producer, session *gocql.Session) {
+func doRereplicate(ctx context.Context, shardID int, domainID, wid, rid string, minID, maxID int64, targets []string, producer messaging.Producer, session *gocql.Session) {
 	if minID <= 0 {
 		minID = 1
 	}
@@ -471,17 +472,17 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 	}
 
 	histV1 := cassandra.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyMgr := persistence.NewHistoryManagerImpl(histV1, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyMgr := persistence.NewHistoryManagerImpl(histV1, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit), nil, nil)
 
 	histV2 := cassandra.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit), nil, nil)
 
 	exeM, _ := cassandra.NewWorkflowExecutionPersistence(shardID, session, loggerimpl.NewNopLogger())
-	exeMgr := persistence.NewExecutionManagerImpl(exeM, loggerimpl.NewNopLogger())
+	exeMgr := persistence.NewExecutionManagerImpl(exeM, loggerimpl.NewNopLogger(), nil, nil)
 
 	for {
 		fmt.Printf("Start rereplicate for wid: %v, rid:%v \n", wid, rid)
-		resp, err := exeMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		resp, err := exeMgr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
 			DomainID: domainID,
 			Execution: shared.WorkflowExecution{
 				WorkflowId: common.StringPtr(wid),
@@ -511,7 +512,7 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 			BranchToken:         exeInfo.GetCurrentBranch(),
 		}
 
-		_, historyBatches, err := history.GetAllHistory(historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), true,
+		_, historyBatches, err := history.GetAllHistory(ctx, historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), true,
 			domainID, wid, rid, minID, maxID, exeInfo.EventStoreVersion, exeInfo.GetCurrentBranch(), common.IntPtr(shardID))
 
 		if err != nil {
@@ -528,7 +529,7 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 			if lastEvent.GetEventType() == shared.EventTypeWorkflowExecutionContinuedAsNew {
 				continueAsNew = true
 				newRunID = lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
-				resp, err := exeMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+				resp, err := exeMgr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
 					DomainID: domainID,
 					Execution: shared.WorkflowExecution{
 						WorkflowId: common.StringPtr(wid),
@@ -544,7 +545,7 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 			taskTemplate.Version = firstEvent.GetVersion()
 			taskTemplate.FirstEventID = firstEvent.GetEventId()
 			taskTemplate.NextEventID = lastEvent.GetEventId() + 1
-			task, err := history.GenerateReplicationTask(targets, taskTemplate, historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), batch, common.IntPtr(shardID))
+			task, err := history.GenerateReplicationTask(ctx, targets, taskTemplate, historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), batch, common.IntPtr(shardID))
 			if err != nil {
 				ErrorAndExit("GenerateReplicationTask error", err)
 			}
@@ -623,7 +624,9 @@ func AdminRereplicate(c *cli.Context) {
 			}
 
 			shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
-			doRereplicate(shardID, domainID, wid, rid, minID, maxID, targets, producer, session)
+			ctx, cancel := newContext(c)
+			doRereplicate(ctx, shardID, domainID, wid, rid, minID, maxID, targets, producer, session)
+			cancel()
 			fmt.Printf("Done processing line %v ...\n", idx)
 		}
 		if err := scanner.Err(); err != nil {
@@ -637,7 +640,9 @@ func AdminRereplicate(c *cli.Context) {
 		maxID := c.Int64(FlagMaxEventID)
 
 		shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
-		doRereplicate(shardID, domainID, wid, rid, minID, maxID, targets, producer, session)
+		ctx, cancel := newContext(c)
+		defer cancel()
+		doRereplicate(ctx, shardID, domainID, wid, rid, minID, maxID, targets, producer, session)
 	}
 }
 
@@ -0,0 +1,83 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminDescribeReplicationStatus prints the replication backlog of this cluster to the target cluster
+func AdminDescribeReplicationStatus(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+
+	request := &replicator.DescribeReplicationStatusRequest{
+		TargetCluster: common.StringPtr(targetCluster),
+		Domain:        getOptionalString(c.GlobalString(FlagDomain)),
+	}
+	if c.IsSet(FlagShardID) {
+		request.ShardIDs = []int32{int32(c.Int(FlagShardID))}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeReplicationStatus(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DescribeReplicationStatus failed.", err)
+	}
+
+	if !c.Bool(FlagPrintFullyDetail) {
+		resp.Shards = nil
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// checkReplicationBacklog exits if the replication tasks of the domain pending to the target cluster exceed maxBacklog
+func checkReplicationBacklog(c *cli.Context, domain string, targetCluster string, maxBacklog int64) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeReplicationStatus(ctx, &replicator.DescribeReplicationStatusRequest{
+		TargetCluster: common.StringPtr(targetCluster),
+		Domain:        common.StringPtr(domain),
+	})
+	if err != nil {
+		ErrorAndExit("Unable to check the replication backlog of the domain.", err)
+	}
+	if resp.GetTruncated() {
+		ErrorAndExit(fmt.Sprintf("Replication backlog to %s is too large to be counted, active cluster is not changed.", targetCluster), nil)
+	}
+
+	backlog := int64(0)
+	for _, domainStatus := range resp.Domains {
+		backlog += domainStatus.GetPendingTasks()
+	}
+	if backlog > maxBacklog {
+		ErrorAndExit(fmt.Sprintf("Replication backlog of domain %s to %s is %v tasks, exceeding %v, active cluster is not changed.",
+			domain, targetCluster, backlog, maxBacklog), nil)
+	}
+	fmt.Printf("Replication backlog of domain %s to %s is %v tasks.\n", domain, targetCluster, backlog)
+}
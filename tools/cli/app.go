@@ -115,6 +115,29 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on taskList",
 					Subcommands: newAdminTaskListCommands(),
 				},
+				{
+					Name:        "encryption",
+					Aliases:     []string{"enc"},
+					Usage:       "Run admin operation on encrypted data at rest",
+					Subcommands: newAdminEncryptionCommands(),
+				},
+				{
+					Name:        "replication",
+					Aliases:     []string{"rep"},
+					Usage:       "Run admin operation on cross cluster replication",
+					Subcommands: newAdminReplicationCommands(),
+				},
+				{
+					Name:        "dlq",
+					Usage:       "Run admin operation on the replication DLQ",
+					Subcommands: newAdminDLQCommands(),
+				},
+				{
+					Name:        "cluster",
+					Aliases:     []string{"cl"},
+					Usage:       "Run admin operation on cluster",
+					Subcommands: newAdminClusterCommands(),
+				},
 			},
 		},
 	}
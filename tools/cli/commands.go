@@ -264,6 +264,9 @@ func UpdateDomain(c *cli.Context) {
 
 	if c.IsSet(FlagActiveClusterName) {
 		activeCluster := c.String(FlagActiveClusterName)
+		if c.IsSet(FlagMaxReplicationBacklog) {
+			checkReplicationBacklog(c, domain, activeCluster, c.Int64(FlagMaxReplicationBacklog))
+		}
 		fmt.Printf("Will set active cluster name to: %s, other flag will be omitted.\n", activeCluster)
 		replicationConfig := &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(activeCluster),
@@ -271,6 +274,8 @@ func UpdateDomain(c *cli.Context) {
 		updateRequest = &shared.UpdateDomainRequest{
 			Name:                     common.StringPtr(domain),
 			ReplicationConfiguration: replicationConfig,
+			FailoverOperator:         common.StringPtr(getCurrentUserFromEnv()),
+			FailoverReason:           common.StringPtr(c.String(FlagReason)),
 		}
 	} else {
 		resp, err := frontendClient.DescribeDomain(ctx, &shared.DescribeDomainRequest{
@@ -143,6 +143,10 @@ func newDomainCommands() []cli.Command {
 					Name:  FlagActiveClusterNameWithAlias,
 					Usage: "Active cluster name",
 				},
+				cli.Int64Flag{
+					Name:  FlagMaxReplicationBacklog,
+					Usage: "Optional, only change the active cluster if the number of replication tasks of the domain pending to the new active cluster is at most this value, the backlog cannot be checked when replication tasks are published to kafka",
+				},
 				cli.StringFlag{ // use StringFlag instead of buggy StringSliceFlag
 					Name:  FlagClustersWithAlias,
 					Usage: "Clusters",
@@ -180,6 +184,49 @@ func newDomainCommands() []cli.Command {
 				UpdateDomain(c)
 			},
 		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Change the active cluster of a global domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagActiveClusterNameWithAlias,
+					Usage: "New active cluster name",
+				},
+				cli.BoolFlag{
+					Name:  FlagGraceful,
+					Usage: "Stop accepting new workflows and wait for the replication backlog to the new active cluster to drain before the failover",
+				},
+				cli.IntFlag{
+					Name:  FlagDrainTimeout,
+					Value: defaultDrainTimeoutInSeconds,
+					Usage: "Optional, max time in seconds to wait for the replication backlog to drain in a graceful failover",
+				},
+				cli.BoolFlag{
+					Name:  FlagFailoverStatus,
+					Usage: "Print the status of the latest graceful failover of the domain",
+				},
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the failover, recorded in the failover history of the domain",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Security token with permission",
+				},
+			},
+			Action: func(c *cli.Context) {
+				FailoverDomain(c)
+			},
+		},
+		{
+			Name:    "failover-history",
+			Aliases: []string{"fh"},
+			Usage:   "Show the failover history of a global domain",
+			Action: func(c *cli.Context) {
+				DomainFailoverHistory(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
@@ -0,0 +1,277 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/failover"
+	"github.com/urfave/cli"
+	s "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/client"
+)
+
+const (
+	defaultDrainTimeoutInSeconds = 300
+	gracefulFailoverPollInterval = 5 * time.Second
+)
+
+// FailoverDomain changes the active cluster of a global domain, a graceful failover is
+// executed by a system workflow and its progress is reported until it is done
+func FailoverDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	if c.Bool(FlagFailoverStatus) {
+		prettyPrintJSONObject(getGracefulFailoverStatus(c, domain))
+		return
+	}
+
+	targetCluster := getRequiredOption(c, FlagActiveClusterName)
+	if !c.Bool(FlagGraceful) {
+		failoverDomain(c, domain, targetCluster)
+		return
+	}
+
+	drainTimeout := time.Duration(c.Int(FlagDrainTimeout)) * time.Second
+	if drainTimeout <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s must be positive.", FlagDrainTimeout), nil)
+	}
+	startGracefulFailover(c, domain, targetCluster, drainTimeout)
+	waitForGracefulFailover(c, domain)
+}
+
+func failoverDomain(c *cli.Context, domain string, targetCluster string) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := frontendClient.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domain),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(targetCluster),
+		},
+		SecurityToken:    common.StringPtr(c.String(FlagSecurityToken)),
+		FailoverOperator: common.StringPtr(getCurrentUserFromEnv()),
+		FailoverReason:   common.StringPtr(c.String(FlagReason)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateDomain failed.", err)
+	}
+	fmt.Printf("Domain %s successfully failed over to %s.\n", domain, targetCluster)
+}
+
+func startGracefulFailover(c *cli.Context, domain string, targetCluster string, drainTimeout time.Duration) {
+	sdkClient := client.NewClient(cFactory.ClientFrontendClient(c), common.SystemLocalDomainName, &client.Options{})
+	options := client.StartWorkflowOptions{
+		ID:       failover.GetWorkflowID(domain),
+		TaskList: failover.TaskListName,
+		// the workflow waits at most the drain timeout, the rest covers the domain updates
+		ExecutionStartToCloseTimeout:    drainTimeout + time.Hour,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+		WorkflowIDReusePolicy:           client.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	params := failover.GracefulFailoverParams{
+		Domain:        domain,
+		TargetCluster: targetCluster,
+		DrainTimeout:  drainTimeout,
+		Operator:      getCurrentUserFromEnv(),
+		Reason:        c.String(FlagReason),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	we, err := sdkClient.StartWorkflow(ctx, options, failover.GracefulFailoverWorkflowTypeName, params)
+	if err != nil {
+		if _, ok := err.(*s.WorkflowExecutionAlreadyStartedError); ok {
+			ErrorAndExit(fmt.Sprintf("A graceful failover of domain %s is already running.", domain), err)
+		}
+		ErrorAndExit("Failed to start graceful failover.", err)
+	}
+	fmt.Printf("Graceful failover of domain %s to %s started, workflow ID: %s, run ID: %s\n",
+		domain, targetCluster, we.ID, we.RunID)
+}
+
+// waitForGracefulFailover reports the progress of the graceful failover until it is done,
+// the failover is not affected if the command is interrupted
+func waitForGracefulFailover(c *cli.Context, domain string) {
+	lastState := ""
+	for {
+		status := getGracefulFailoverStatus(c, domain)
+		switch status.State {
+		case failover.StateCompleted:
+			if status.ReplicationStatusUnknown {
+				fmt.Printf("Domain %s failed over to %s after the drain timeout, the replication backlog is unknown when replication tasks are published to kafka.\n",
+					domain, status.TargetCluster)
+			} else if status.TimedOut {
+				fmt.Printf("Domain %s failed over to %s after the drain timeout, %v replication tasks were pending.\n",
+					domain, status.TargetCluster, status.PendingTasks)
+			} else {
+				fmt.Printf("Domain %s gracefully failed over to %s.\n", domain, status.TargetCluster)
+			}
+			return
+		case failover.StateFailed:
+			ErrorAndExit(fmt.Sprintf("Graceful failover of domain %s failed: %s", domain, status.Error), nil)
+		case failover.StateWaitingForReplication:
+			if status.ReplicationStatusUnknown {
+				fmt.Printf("State: %s, replication backlog unknown, drain deadline: %s\n", status.State,
+					convertTime(status.DrainDeadline.UnixNano(), false))
+				break
+			}
+			fmt.Printf("State: %s, pending replication tasks: %v%s, drain deadline: %s\n", status.State, status.PendingTasks,
+				getTruncatedSuffix(status.PendingTasksTruncated), convertTime(status.DrainDeadline.UnixNano(), false))
+		default:
+			if status.State != lastState {
+				fmt.Printf("State: %s\n", status.State)
+			}
+		}
+		lastState = status.State
+		time.Sleep(gracefulFailoverPollInterval)
+	}
+}
+
+func getGracefulFailoverStatus(c *cli.Context, domain string) *failover.GracefulFailoverStatus {
+	sdkClient := client.NewClient(cFactory.ClientFrontendClient(c), common.SystemLocalDomainName, &client.Options{})
+	ctx, cancel := newContext(c)
+	defer cancel()
+	value, err := sdkClient.QueryWorkflow(ctx, failover.GetWorkflowID(domain), "", failover.StatusQueryType)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Unable to get the graceful failover status of domain %s.", domain), err)
+	}
+	status := &failover.GracefulFailoverStatus{}
+	if err := value.Get(status); err != nil {
+		ErrorAndExit("Unable to decode the graceful failover status.", err)
+	}
+	return status
+}
+
+func getTruncatedSuffix(truncated bool) string {
+	if truncated {
+		return "+"
+	}
+	return ""
+}
+
+// DomainFailoverHistory prints the failover history of a domain, newest failover first
+func DomainFailoverHistory(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(domain),
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation DescribeDomain failed.", err)
+		}
+		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domain), err)
+	}
+	if len(resp.FailoverHistory) == 0 {
+		fmt.Printf("Domain %s has no failover history.\n", domain)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Failover Time", "From Cluster", "To Cluster", "Operator", "Reason"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	for _, event := range resp.FailoverHistory {
+		table.Append([]string{
+			convertTime(event.GetFailoverTimeNano(), false),
+			event.GetFromCluster(),
+			event.GetToCluster(),
+			event.GetOperator(),
+			event.GetReason(),
+		})
+	}
+	table.Render()
+}
+
+// AdminFailoverDomains fails over a batch of global domains, given by name or selected by their
+// domain data, and prints the result of each failover
+func AdminFailoverDomains(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &admin.FailoverDomainsRequest{
+		TargetCluster: common.StringPtr(getRequiredOption(c, FlagActiveClusterName)),
+		Concurrency:   common.Int32Ptr(int32(c.Int(FlagConcurrency))),
+		Operator:      common.StringPtr(getCurrentUserFromEnv()),
+		Reason:        common.StringPtr(c.String(FlagReason)),
+		SecurityToken: common.StringPtr(c.String(FlagSecurityToken)),
+	}
+	if c.IsSet(FlagDomains) {
+		for _, domain := range strings.Split(c.String(FlagDomains), ",") {
+			if domain = strings.TrimSpace(domain); domain != "" {
+				request.Domains = append(request.Domains, domain)
+			}
+		}
+	}
+	if c.IsSet(FlagDomainData) {
+		filter, err := parseDomainDataKVs(c.String(FlagDomainData))
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagDomainData), err)
+		}
+		request.DomainDataFilter = filter
+	}
+	if (len(request.Domains) == 0) == (len(request.DomainDataFilter) == 0) {
+		ErrorAndExit(fmt.Sprintf("Exactly one of the options %s and %s is required.", FlagDomains, FlagDomainData), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.FailoverDomains(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation FailoverDomains failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(true)
+	table.SetColumnSeparator("|")
+	header := []string{"Domain", "From Cluster", "Succeeded", "Error"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	failed := 0
+	for _, result := range resp.Results {
+		if !result.GetSucceeded() {
+			failed++
+		}
+		table.Append([]string{
+			result.GetDomain(),
+			result.GetFromCluster(),
+			strconv.FormatBool(result.GetSucceeded()),
+			result.GetError(),
+		})
+	}
+	table.Render()
+	if failed > 0 {
+		ErrorAndExit(fmt.Sprintf("%v of %v domains failed to fail over.", failed, len(resp.Results)), nil)
+	}
+	fmt.Printf("%v domains successfully failed over to %s.\n", len(resp.Results), request.GetTargetCluster())
+}
@@ -162,6 +162,7 @@ const (
 	FlagMemoFile                    = "memo_file"
 	FlagSearchAttributesKey         = "search_attr_key"
 	FlagSearchAttributesVal         = "search_attr_value"
+	FlagSearchAttributesType        = "search_attr_type"
 	FlagAddBadBinary                = "add_bad_binary"
 	FlagRemoveBadBinary             = "remove_bad_binary"
 	FlagResetType                   = "reset_type"
@@ -169,6 +170,23 @@ const (
 	FlagResetBadBinaryChecksum      = "reset_bad_binary_checksum"
 	FlagListQuery                   = "query"
 	FlagListQueryWithAlias          = FlagListQuery + ", q"
+	FlagRepair                      = "repair"
+	FlagKeyringFile                 = "keyring_file"
+	FlagEncryptPlaintext            = "encrypt_plaintext"
+	FlagSourceCluster               = "source_cluster"
+	FlagLastMessageID               = "last_message_id"
+	FlagMaxReplicationBacklog       = "max_replication_backlog"
+	FlagGraceful                    = "graceful"
+	FlagDrainTimeout                = "drain_timeout"
+	FlagFailoverStatus              = "status"
+	FlagDomains                     = "domains"
+	FlagConcurrency                 = "concurrency"
+	FlagDBType                      = "db_type"
+	FlagDBName                      = "db_name"
+	FlagVisibilityDBName            = "visibility_db_name"
+	FlagRPS                         = "rps"
+	FlagCheckpointFile              = "checkpoint_file"
+	FlagVerifyOnly                  = "verify_only"
 )
 
 var flagsForExecution = []cli.Flag{
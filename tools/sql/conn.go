@@ -22,11 +22,13 @@ package sql
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/uber/cadence/tools/sql/mysql"
+	"github.com/uber/cadence/tools/sql/sqlite"
 )
 
 type (
@@ -39,8 +41,9 @@ type (
 		driverName string
 	}
 	sqlConn struct {
-		database string
-		db       *sqlx.DB
+		database   string
+		driverName string
+		db         *sqlx.DB
 	}
 )
 
@@ -64,19 +67,28 @@ const (
 		`new_version VARCHAR(64), ` +
 		`old_version VARCHAR(64), ` +
 		`PRIMARY KEY (year, month, update_time));`
+
+	listTablesSQLiteSQL = `SELECT name FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite_%'`
 )
 
 var _ schema.DB = (*sqlConn)(nil)
 
 func newConn(params *sqlConnectParams) (*sqlConn, error) {
-	if params.driverName != mysql.DriverName {
+	var db *sqlx.DB
+	var err error
+	switch params.driverName {
+	case mysql.DriverName:
+		db, err = mysql.NewConnection(params.host, params.port, params.user, params.password, params.database)
+	case sqlite.DriverName:
+		// a sqlite database is a single file, the database param is the path to it
+		db, err = sqlite.NewConnection(params.database)
+	default:
 		return nil, fmt.Errorf("unsupported database driver: %v", params.driverName)
 	}
-	db, err := mysql.NewConnection(params.host, params.port, params.user, params.password, params.database)
 	if err != nil {
 		return nil, err
 	}
-	return &sqlConn{db: db, database: params.database}, nil
+	return &sqlConn{db: db, database: params.database, driverName: params.driverName}, nil
 }
 
 // CreateSchemaVersionTables sets up the schema version tables
@@ -116,6 +128,10 @@ func (c *sqlConn) Exec(stmt string) error {
 // ListTables returns a list of tables in this database
 func (c *sqlConn) ListTables() ([]string, error) {
 	var tables []string
+	if c.driverName == sqlite.DriverName {
+		err := c.db.Select(&tables, listTablesSQLiteSQL)
+		return tables, err
+	}
 	err := c.db.Select(&tables, fmt.Sprintf("SHOW TABLES FROM %v", c.database))
 	return tables, err
 }
@@ -141,11 +157,18 @@ func (c *sqlConn) DropAllTables() error {
 
 // CreateDatabase creates a database if it doesn't exist
 func (c *sqlConn) CreateDatabase(name string) error {
+	if c.driverName == sqlite.DriverName {
+		// sqlite creates the database file on first connect
+		return nil
+	}
 	return c.Exec(fmt.Sprintf("CREATE database %v CHARACTER SET UTF8", name))
 }
 
 // DropDatabase drops a database
 func (c *sqlConn) DropDatabase(name string) error {
+	if c.driverName == sqlite.DriverName {
+		return os.Remove(name)
+	}
 	return c.Exec(fmt.Sprintf("DROP database %v", name))
 }
 
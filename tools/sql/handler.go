@@ -23,53 +23,53 @@ package sql
 import (
 	"fmt"
 	"log"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/urfave/cli"
 )
 
 // setupSchema executes the setupSchemaTask
-// using the given command line arguments
-// as input
+// on every endpoint using the given command
+// line arguments as input
 func setupSchema(cli *cli.Context) error {
 	params, err := parseConnectParams(cli)
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
-	conn, err := newConn(params)
-	if err != nil {
-		return handleErr(err)
-	}
-	defer conn.Close()
-	if err := schema.Setup(cli, conn); err != nil {
-		return handleErr(err)
-	}
-	return nil
+	return forEachEndpoint(params, func(p *sqlConnectParams) error {
+		conn, err := newConn(p)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return schema.Setup(cli, conn)
+	})
 }
 
 // updateSchema executes the updateSchemaTask
-// using the given command lien args as input
+// on every endpoint using the given command lien args as input
 func updateSchema(cli *cli.Context) error {
 	params, err := parseConnectParams(cli)
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
-	if params.database == schema.DryrunDBName {
-		p := *params
-		if err := doCreateDatabase(p, p.database); err != nil {
-			return handleErr(fmt.Errorf("error creating dryrun database: %v", err))
+	return forEachEndpoint(params, func(p *sqlConnectParams) error {
+		if p.database == schema.DryrunDBName {
+			if err := doCreateDatabase(*p, p.database); err != nil {
+				return fmt.Errorf("error creating dryrun database: %v", err)
+			}
+			defer doDropDatabase(*p, p.database)
 		}
-		defer doDropDatabase(p, p.database)
-	}
-	conn, err := newConn(params)
-	if err != nil {
-		return handleErr(err)
-	}
-	defer conn.Close()
-	if err := schema.Update(cli, conn); err != nil {
-		return handleErr(err)
-	}
-	return nil
+		conn, err := newConn(p)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return schema.Update(cli, conn)
+	})
 }
 
 // createDatabase creates a sql database
@@ -82,9 +82,30 @@ func createDatabase(cli *cli.Context) error {
 	if database == "" {
 		return handleErr(schema.NewConfigError("missing " + flag(schema.CLIOptKeyspace) + " argument "))
 	}
-	err = doCreateDatabase(*params, database)
-	if err != nil {
-		return handleErr(fmt.Errorf("error creating database:%v", err))
+	return forEachEndpoint(params, func(p *sqlConnectParams) error {
+		if err := doCreateDatabase(*p, database); err != nil {
+			return fmt.Errorf("error creating database:%v", err)
+		}
+		return nil
+	})
+}
+
+// forEachEndpoint runs f against every database in the comma separated
+// endpoint list, so that the same schema can be managed on all the
+// databases of a sharded store. Each endpoint is a host or a host:port
+func forEachEndpoint(params *sqlConnectParams, f func(p *sqlConnectParams) error) error {
+	for _, endpoint := range strings.Split(params.host, ",") {
+		p := *params
+		p.host = strings.TrimSpace(endpoint)
+		if host, port, err := net.SplitHostPort(p.host); err == nil {
+			p.host = host
+			if p.port, err = strconv.Atoi(port); err != nil {
+				return handleErr(schema.NewConfigError("invalid port in sql endpoint " + endpoint))
+			}
+		}
+		if err := f(&p); err != nil {
+			return handleErr(fmt.Errorf("endpoint %v: %v", p.host, err))
+		}
 	}
 	return nil
 }
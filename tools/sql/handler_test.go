@@ -58,3 +58,20 @@ func (s *HandlerTestSuite) TestValidateConnectParams() {
 	s.Nil(validateConnectParams(p, false))
 	s.Nil(validateConnectParams(p, true))
 }
+
+func (s *HandlerTestSuite) TestForEachEndpoint() {
+	p := &sqlConnectParams{host: "db1, db2:3307", port: 3306, database: "cadence"}
+	var visited []sqlConnectParams
+	err := forEachEndpoint(p, func(p *sqlConnectParams) error {
+		visited = append(visited, *p)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]sqlConnectParams{
+		{host: "db1", port: 3306, database: "cadence"},
+		{host: "db2", port: 3307, database: "cadence"},
+	}, visited)
+
+	p.host = "db1:port"
+	s.Error(forEachEndpoint(p, func(p *sqlConnectParams) error { return nil }))
+}
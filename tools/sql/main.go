@@ -24,7 +24,9 @@ import (
 	"os"
 
 	_ "github.com/go-sql-driver/mysql" // needed to load the mysql driver
+	_ "github.com/mattn/go-sqlite3"    // needed to load the sqlite driver
 	"github.com/uber/cadence/tools/common/schema"
+	"github.com/uber/cadence/tools/sql/sqlite"
 	"github.com/urfave/cli"
 )
 
@@ -34,6 +36,20 @@ func RunTool(args []string) error {
 	return app.Run(args)
 }
 
+// SetupSQLiteSchema sets up the schema in the given sqlite database file,
+// the database is left as is if it already has a schema version recorded
+func SetupSQLiteSchema(database string, config *schema.SetupConfig) error {
+	conn, err := newConn(&sqlConnectParams{database: database, driverName: sqlite.DriverName})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if version, err := conn.ReadSchemaVersion(); err == nil && version != "" {
+		return nil
+	}
+	return schema.SetupFromConfig(config, conn)
+}
+
 // root handler for all cli commands
 func cliHandler(c *cli.Context, handler func(c *cli.Context) error) {
 	quiet := c.GlobalBool(schema.CLIOptQuiet)
@@ -54,7 +70,7 @@ func buildCLIOptions() *cli.App {
 		cli.StringFlag{
 			Name:   schema.CLIFlagEndpoint,
 			Value:  "127.0.0.1",
-			Usage:  "hostname or ip address of sql host to connect to",
+			Usage:  "hostname or ip address of sql host to connect to, a comma separated list of host[:port] runs the command on every host",
 			EnvVar: "SQL_HOST",
 		},
 		cli.IntFlag{